@@ -0,0 +1,130 @@
+package addon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// GetConfigurationValues returns the configuration values the addon runs with, or an empty string if it has none
+func (a *Manager) GetConfigurationValues(addon *api.Addon) (string, error) {
+	var values string
+	_, err := a.eksAPI.DescribeAddonWithContext(context.TODO(), &eks.DescribeAddonInput{
+		ClusterName: &a.clusterConfig.Metadata.Name,
+		AddonName:   &addon.Name,
+	}, readConfigurationValues(&values))
+	if err != nil {
+		return "", fmt.Errorf("failed to get configuration values of addon %q: %v", addon.Name, err)
+	}
+	return values, nil
+}
+
+// DiffConfigurationValues compares two sets of configuration values given as JSON or YAML, and returns
+// the sorted paths, e.g. `resources.limits.memory`, of the values that are only set in one of them or differ
+func DiffConfigurationValues(desired, live string) ([]string, error) {
+	desiredValues, err := decodeConfigurationValues(desired)
+	if err != nil {
+		return nil, fmt.Errorf("invalid desired configuration values: %w", err)
+	}
+	liveValues, err := decodeConfigurationValues(live)
+	if err != nil {
+		return nil, fmt.Errorf("invalid live configuration values: %w", err)
+	}
+	var paths []string
+	diffValues("", desiredValues, liveValues, &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func decodeConfigurationValues(values string) (interface{}, error) {
+	decoded := map[string]interface{}{}
+	if values == "" {
+		return decoded, nil
+	}
+	jsonValues, err := yaml.YAMLToJSON([]byte(values))
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(jsonValues, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+func diffValues(path string, desired, live interface{}, paths *[]string) {
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	liveMap, liveIsMap := live.(map[string]interface{})
+	if !desiredIsMap || !liveIsMap {
+		if !reflect.DeepEqual(desired, live) {
+			*paths = append(*paths, path)
+		}
+		return
+	}
+
+	join := func(key string) string {
+		if path == "" {
+			return key
+		}
+		return path + "." + key
+	}
+	for key, desiredValue := range desiredMap {
+		liveValue, ok := liveMap[key]
+		if !ok {
+			*paths = append(*paths, join(key))
+			continue
+		}
+		diffValues(join(key), desiredValue, liveValue, paths)
+	}
+	for key := range liveMap {
+		if _, ok := desiredMap[key]; !ok {
+			*paths = append(*paths, join(key))
+		}
+	}
+}
+
+// readConfigurationValues reads configurationValues from the body of a DescribeAddon response.
+// The field is not modelled by the version of the AWS SDK in use, so the body is read before the SDK unmarshals it
+func readConfigurationValues(values *string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Unmarshal.PushFrontNamed(request.NamedHandler{
+			Name: "eksctl.ReadAddonConfigurationValues",
+			Fn: func(r *request.Request) {
+				if r.Error != nil || r.HTTPResponse == nil || r.HTTPResponse.Body == nil {
+					return
+				}
+				r.Error = decodeConfigurationValuesFromResponse(r, values)
+			},
+		})
+	}
+}
+
+func decodeConfigurationValuesFromResponse(r *request.Request, values *string) error {
+	data, err := ioutil.ReadAll(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+	if err != nil {
+		return awserr.New(request.ErrCodeSerialization, "failed to read response body", err)
+	}
+	r.HTTPResponse.Body = ioutil.NopCloser(bytes.NewReader(data))
+
+	var body struct {
+		Addon struct {
+			ConfigurationValues string `json:"configurationValues"`
+		} `json:"addon"`
+	}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return awserr.New(request.ErrCodeSerialization, "failed to decode response body", err)
+	}
+	*values = body.Addon.ConfigurationValues
+	return nil
+}
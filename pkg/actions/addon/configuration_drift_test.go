@@ -0,0 +1,92 @@
+package addon_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Configuration drift", func() {
+	DescribeTable("diffing configuration values", func(desired, live string, expectedPaths []string) {
+		paths, err := addon.DiffConfigurationValues(desired, live)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(paths).To(Equal(expectedPaths))
+	},
+		Entry("equal values", `{"replicaCount": 2}`, `{"replicaCount": 2}`, nil),
+		Entry("equal values in JSON and YAML", `{"resources": {"limits": {"memory": "256Mi"}}}`, "resources:\n  limits:\n    memory: 256Mi\n", nil),
+		Entry("a changed value", `{"replicaCount": 2}`, `{"replicaCount": 3}`, []string{"replicaCount"}),
+		Entry("a changed nested value", `{"resources": {"limits": {"memory": "256Mi", "cpu": "100m"}}}`, `{"resources": {"limits": {"memory": "512Mi", "cpu": "100m"}}}`, []string{"resources.limits.memory"}),
+		Entry("a value removed from the addon", `{"replicaCount": 2, "tolerations": []}`, `{"replicaCount": 2}`, []string{"tolerations"}),
+		Entry("a value added to the addon", `{"replicaCount": 2}`, `{"replicaCount": 2, "nodeSelector": {"role": "system"}}`, []string{"nodeSelector"}),
+		Entry("a changed list", `{"tolerations": [{"key": "a"}]}`, `{"tolerations": [{"key": "b"}]}`, []string{"tolerations"}),
+		Entry("a value whose type changed", `{"env": {"WARM_IP_TARGET": "5"}}`, `{"env": "none"}`, []string{"env"}),
+		Entry("an addon without configuration values", `{"replicaCount": 2}`, "", []string{"replicaCount"}),
+		Entry("several changes", `{"b": 1, "a": {"y": 1, "x": 1}}`, `{"b": 2, "a": {"x": 2}, "c": 3}`, []string{"a.x", "a.y", "b", "c"}),
+	)
+
+	It("errors when the values are not an object", func() {
+		_, err := addon.DiffConfigurationValues(`{"replicaCount": 2}`, `[1, 2]`)
+		Expect(err).To(MatchError(ContainSubstring("invalid live configuration values")))
+	})
+
+	Describe("reading the configuration values of an addon", func() {
+		var (
+			addonManager *addon.Manager
+			mockProvider *mockprovider.MockProvider
+		)
+
+		// mockDescribeAddon returns the response body to the request built by the AWS SDK with the given options
+		mockDescribeAddon := func(body string) {
+			eksClient := awseks.New(session.Must(session.NewSession(&aws.Config{
+				Region:      aws.String("us-west-2"),
+				Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+			})))
+			mockProvider.MockEKS().On("DescribeAddonWithContext", mock.Anything, mock.Anything, mock.Anything).Return(func(_ aws.Context, input *awseks.DescribeAddonInput, options ...request.Option) *awseks.DescribeAddonOutput {
+				req, output := eksClient.DescribeAddonRequest(input)
+				req.ApplyOptions(options...)
+				req.HTTPResponse = &http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{},
+					Body:       ioutil.NopCloser(strings.NewReader(body)),
+				}
+				req.Handlers.Unmarshal.Run(req)
+				Expect(req.Error).NotTo(HaveOccurred())
+				return output
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			mockProvider = mockprovider.NewMockProvider()
+			var err error
+			addonManager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+				Version: "1.21",
+				Name:    "my-cluster",
+			}}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, nil, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("reads them from the response", func() {
+			mockDescribeAddon(`{"addon": {"addonName": "coredns", "addonVersion": "v1.8.4-eksbuild.1", "configurationValues": "{\"replicaCount\":3}"}}`)
+
+			values, err := addonManager.GetConfigurationValues(&api.Addon{Name: "coredns"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(`{"replicaCount":3}`))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package addon
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// configurationValues returns the configuration values to send for the addon, the metrics-server addon
+// gets the replicas and high-availability settings of the cluster config so that they are kept across updates
+func (a *Manager) configurationValues(addon *api.Addon) (string, error) {
+	return a.clusterConfig.AddonConfigurationValues(addon)
+}
+
+// withConfigurationValues sets configurationValues in the body of a CreateAddon or UpdateAddon request.
+// The field is not modelled by the version of the AWS SDK in use, so it is added once the SDK has built the request body
+func withConfigurationValues(values string) request.Option {
+	return func(r *request.Request) {
+		r.Handlers.Build.PushBackNamed(request.NamedHandler{
+			Name: "eksctl.AddonConfigurationValues",
+			Fn: func(r *request.Request) {
+				if r.Error != nil {
+					return
+				}
+				r.Error = setConfigurationValues(r, values)
+			},
+		})
+	}
+}
+
+func setConfigurationValues(r *request.Request, values string) error {
+	// the API expects configurationValues to be a JSON string
+	jsonValues, err := yaml.YAMLToJSON([]byte(values))
+	if err != nil {
+		return awserr.New(request.ErrCodeSerialization, "failed to convert configurationValues to JSON", err)
+	}
+
+	body := map[string]interface{}{}
+	if r.Body != nil {
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			return awserr.New(request.ErrCodeSerialization, "failed to read request body", err)
+		}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &body); err != nil {
+				return awserr.New(request.ErrCodeSerialization, "failed to decode request body", err)
+			}
+		}
+	}
+	body["configurationValues"] = string(jsonValues)
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return awserr.New(request.ErrCodeSerialization, "failed to encode request body", err)
+	}
+	r.SetBufferBody(data)
+	return nil
+}
@@ -0,0 +1,156 @@
+package addon_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ConfigurationValues", func() {
+	var (
+		addonManager *addon.Manager
+		mockProvider *mockprovider.MockProvider
+		eksClient    *awseks.EKS
+		requestBody  map[string]interface{}
+	)
+
+	// buildBody builds the request the way the AWS SDK would send it, with the options passed to the EKS API
+	buildBody := func(req *request.Request, args mock.Arguments) {
+		for _, arg := range args[2:] {
+			Expect(arg).To(BeAssignableToTypeOf(request.Option(nil)))
+			req.ApplyOptions(arg.(request.Option))
+		}
+		Expect(req.Build()).To(Succeed())
+		data, err := ioutil.ReadAll(req.GetBody())
+		Expect(err).NotTo(HaveOccurred())
+		requestBody = map[string]interface{}{}
+		Expect(json.Unmarshal(data, &requestBody)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		requestBody = nil
+		mockProvider = mockprovider.NewMockProvider()
+		eksClient = awseks.New(session.Must(session.NewSession(&aws.Config{
+			Region:      aws.String("us-west-2"),
+			Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		})))
+
+		mockProvider.MockEKS().On("CreateAddonWithContext", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			Expect(args[1]).To(BeAssignableToTypeOf(&awseks.CreateAddonInput{}))
+			req, _ := eksClient.CreateAddonRequest(args[1].(*awseks.CreateAddonInput))
+			buildBody(req, args)
+		}).Return(&awseks.CreateAddonOutput{}, nil)
+
+		mockProvider.MockEKS().On("UpdateAddonWithContext", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			Expect(args[1]).To(BeAssignableToTypeOf(&awseks.UpdateAddonInput{}))
+			req, _ := eksClient.UpdateAddonRequest(args[1].(*awseks.UpdateAddonInput))
+			buildBody(req, args)
+		}).Return(&awseks.UpdateAddonOutput{}, nil)
+
+		mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{
+			Addon: &awseks.Addon{
+				AddonName:    aws.String("coredns"),
+				AddonVersion: aws.String("v1.8.4-eksbuild.1"),
+				Status:       aws.String("ACTIVE"),
+			},
+		}, nil)
+
+		mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Return(&awseks.DescribeAddonVersionsOutput{
+			Addons: []*awseks.AddonInfo{
+				{
+					AddonName:     aws.String("coredns"),
+					AddonVersions: []*awseks.AddonVersionInfo{{AddonVersion: aws.String("v1.8.4-eksbuild.1")}},
+				},
+			},
+		}, nil)
+
+		var err error
+		addonManager, err = addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.21",
+			Name:    "my-cluster",
+		}}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, testutils.NewFakeRawClient().ClientSet(), 5*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("sends YAML configuration values as JSON when creating an addon", func() {
+		err := addonManager.Create(&api.Addon{
+			Name: "coredns",
+			ConfigurationValues: `replicaCount: 3
+resources:
+  limits:
+    memory: 256Mi
+`,
+		}, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requestBody).To(HaveKeyWithValue("addonName", "coredns"))
+		Expect(requestBody).To(HaveKey("configurationValues"))
+		Expect(requestBody["configurationValues"]).To(MatchJSON(`{"replicaCount": 3, "resources": {"limits": {"memory": "256Mi"}}}`))
+	})
+
+	It("sends JSON configuration values when updating an addon", func() {
+		err := addonManager.Update(&api.Addon{
+			Name:                "coredns",
+			ConfigurationValues: `{"replicaCount": 2}`,
+		}, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requestBody).To(HaveKeyWithValue("addonVersion", "v1.8.4-eksbuild.1"))
+		Expect(requestBody["configurationValues"]).To(MatchJSON(`{"replicaCount": 2}`))
+	})
+
+	It("preserves the metrics-server replicas and high-availability settings when updating the addon", func() {
+		replicas := 3
+		var err error
+		addonManager, err = addon.New(&api.ClusterConfig{
+			Metadata: &api.ClusterMeta{
+				Version: "1.21",
+				Name:    "my-cluster",
+			},
+			MetricsServer: &api.MetricsServerConfig{Replicas: &replicas, HighAvailability: api.Enabled()},
+		}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, testutils.NewFakeRawClient().ClientSet(), 5*time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = addonManager.Update(&api.Addon{
+			Name:                "metrics-server",
+			ConfigurationValues: `{"resources": {"limits": {"memory": "200Mi"}}}`,
+		}, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(requestBody["configurationValues"]).To(MatchJSON(`{
+			"affinity": {
+				"podAntiAffinity": {
+					"requiredDuringSchedulingIgnoredDuringExecution": [{
+						"labelSelector": {"matchLabels": {"app.kubernetes.io/name": "metrics-server"}},
+						"topologyKey": "kubernetes.io/hostname"
+					}]
+				}
+			},
+			"podDisruptionBudget": {"enabled": true, "minAvailable": 1},
+			"replicas": 3,
+			"resources": {"limits": {"memory": "200Mi"}}
+		}`))
+	})
+
+	It("does not send configuration values when none are set", func() {
+		mockProvider.MockEKS().On("CreateAddon", mock.Anything).Return(&awseks.CreateAddonOutput{}, nil)
+
+		Expect(addonManager.Create(&api.Addon{Name: "coredns"}, false)).To(Succeed())
+		mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "CreateAddonWithContext", mock.Anything, mock.Anything, mock.Anything)
+	})
+})
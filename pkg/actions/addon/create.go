@@ -23,6 +23,7 @@ import (
 const (
 	kubeSystemNamespace = "kube-system"
 	vpcCNIName          = "vpc-cni"
+	ebsCSIDriverName    = "aws-ebs-csi-driver"
 )
 
 func (a *Manager) Create(addon *api.Addon, wait bool) error {
@@ -60,6 +61,9 @@ func (a *Manager) Create(addon *api.Addon, wait bool) error {
 	if a.withOIDC {
 		if addon.ServiceAccountRoleARN != "" {
 			logger.Info("using provided ServiceAccountRoleARN %q", addon.ServiceAccountRoleARN)
+			if err := a.validateServiceAccountRoleARN(addon, namespace, serviceAccount); err != nil {
+				return err
+			}
 			createAddonInput.ServiceAccountRoleArn = &addon.ServiceAccountRoleARN
 		} else if hasPoliciesSet(addon) {
 			outputRole, err := a.createRole(addon, namespace, serviceAccount)
@@ -106,7 +110,17 @@ func (a *Manager) Create(addon *api.Addon, wait bool) error {
 	}
 
 	logger.Info("creating addon")
-	output, err := a.eksAPI.CreateAddon(createAddonInput)
+	var output *eks.CreateAddonOutput
+	var err error
+	configurationValues, err := a.configurationValues(addon)
+	if err != nil {
+		return err
+	}
+	if configurationValues != "" {
+		output, err = a.eksAPI.CreateAddonWithContext(context.TODO(), createAddonInput, withConfigurationValues(configurationValues))
+	} else {
+		output, err = a.eksAPI.CreateAddon(createAddonInput)
+	}
 	if err != nil {
 		return errors.Wrapf(err, "failed to create addon %q", addon.Name)
 	}
@@ -195,11 +209,25 @@ func (a *Manager) getKnownServiceAccountLocation(addon *api.Addon) (string, stri
 	case vpcCNIName:
 		logger.Debug("found known service account location %s/%s", api.AWSNodeMeta.Namespace, api.AWSNodeMeta.Name)
 		return api.AWSNodeMeta.Namespace, api.AWSNodeMeta.Name
+	case ebsCSIDriverName:
+		logger.Debug("found known service account location %s/%s", kubeSystemNamespace, "ebs-csi-controller-sa")
+		return kubeSystemNamespace, "ebs-csi-controller-sa"
 	default:
 		return "", ""
 	}
 }
 
+// validateServiceAccountRoleARN checks that the provided role can be assumed through the OIDC provider
+// of the cluster, by the service account of the addon if its location is known
+func (a *Manager) validateServiceAccountRoleARN(addon *api.Addon, namespace, serviceAccount string) error {
+	if a.oidcManager == nil {
+		logger.Warning("not checking that serviceAccountRoleARN of addon %q can be assumed, as the IAM OIDC provider of the cluster is not known", addon.Name)
+		return nil
+	}
+	return errors.Wrapf(a.oidcManager.ValidateRoleTrust(addon.ServiceAccountRoleARN, namespace, serviceAccount),
+		"invalid serviceAccountRoleARN for addon %q", addon.Name)
+}
+
 func hasPoliciesSet(addon *api.Addon) bool {
 	return len(addon.AttachPolicyARNs) != 0 || addon.WellKnownPolicies.HasPolicy() || addon.AttachPolicy != nil
 }
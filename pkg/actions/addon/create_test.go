@@ -2,6 +2,7 @@ package addon_test
 
 import (
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/testutils"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
+	awsiam "github.com/aws/aws-sdk-go/service/iam"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
@@ -71,7 +73,7 @@ var _ = Describe("Create", func() {
 	JustBeforeEach(func() {
 		var err error
 
-		oidc, err = iamoidc.NewOpenIDConnectManager(nil, "456123987123", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", nil)
+		oidc, err = iamoidc.NewOpenIDConnectManager(mockProvider.IAM(), "456123987123", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", nil)
 		Expect(err).ToNot(HaveOccurred())
 		oidc.ProviderARN = "arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E"
 
@@ -490,17 +492,78 @@ var _ = Describe("Create", func() {
 
 	When("serviceAccountRoleARN is configured", func() {
 		It("uses the serviceAccountRoleARN to create the addon", func() {
+			mockRoleTrust(mockProvider, "foo", "")
 			err := manager.Create(&api.Addon{
 				Name:                  "my-addon",
 				Version:               "v1.0.0-eksbuild.1",
-				ServiceAccountRoleARN: "foo",
+				ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/foo",
 			}, false)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
 			Expect(*createAddonInput.ClusterName).To(Equal("my-cluster"))
 			Expect(*createAddonInput.AddonName).To(Equal("my-addon"))
 			Expect(*createAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.1"))
-			Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("foo"))
+			Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("arn:aws:iam::456123987123:role/foo"))
+		})
+
+		It("reuses a role that trusts the service account of a known addon", func() {
+			mockRoleTrust(mockProvider, "ebs-csi", "system:serviceaccount:kube-system:ebs-csi-controller-sa")
+			err := manager.Create(&api.Addon{
+				Name:                  "aws-ebs-csi-driver",
+				ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/ebs-csi",
+			}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeStackManager.CreateStackCallCount()).To(Equal(0))
+			Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("arn:aws:iam::456123987123:role/ebs-csi"))
+		})
+
+		It("returns an error when the role trusts a different service account", func() {
+			mockRoleTrust(mockProvider, "ebs-csi", "system:serviceaccount:kube-system:other-sa")
+			err := manager.Create(&api.Addon{
+				Name:                  "aws-ebs-csi-driver",
+				ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/ebs-csi",
+			}, false)
+			Expect(err).To(MatchError(`invalid serviceAccountRoleARN for addon "aws-ebs-csi-driver": the trust relationship of IAM role "ebs-csi" does not allow sts:AssumeRoleWithWebIdentity ` +
+				`through the OIDC provider "arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E" of the cluster for service account "kube-system/ebs-csi-controller-sa"`))
+			mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "CreateAddon", mock.Anything)
+		})
+
+		It("returns an error when the role does not trust the OIDC provider of the cluster", func() {
+			mockProvider.MockIAM().On("GetRole", mock.Anything).Return(&awsiam.GetRoleOutput{
+				Role: &awsiam.Role{
+					AssumeRolePolicyDocument: aws.String(url.QueryEscape(`{"Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`)),
+				},
+			}, nil)
+			err := manager.Create(&api.Addon{
+				Name:                  "my-addon",
+				ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/ec2",
+			}, false)
+			Expect(err).To(MatchError(`invalid serviceAccountRoleARN for addon "my-addon": the trust relationship of IAM role "ec2" does not allow sts:AssumeRoleWithWebIdentity ` +
+				`through the OIDC provider "arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E" of the cluster`))
+		})
+
+		It("accepts a role that trusts everyone and has conditions on non-string values", func() {
+			document := `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"sts:AssumeRoleWithWebIdentity",` +
+				`"Condition":{"Bool":{"aws:SecureTransport":true},"NumericLessThan":{"aws:MultiFactorAuthAge":[3600]}}}]}`
+			mockProvider.MockIAM().On("GetRole", mock.Anything).Return(&awsiam.GetRoleOutput{
+				Role: &awsiam.Role{
+					AssumeRolePolicyDocument: aws.String(url.QueryEscape(document)),
+				},
+			}, nil)
+			err := manager.Create(&api.Addon{
+				Name:                  "aws-ebs-csi-driver",
+				ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/everyone",
+			}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*createAddonInput.ServiceAccountRoleArn).To(Equal("arn:aws:iam::456123987123:role/everyone"))
+		})
+
+		It("returns an error when the ARN is not a role ARN", func() {
+			err := manager.Create(&api.Addon{
+				Name:                  "my-addon",
+				ServiceAccountRoleARN: "foo",
+			}, false)
+			Expect(err).To(MatchError(`invalid serviceAccountRoleARN for addon "my-addon": unexpected format of IAM role ARN: "foo"`))
 		})
 	})
 
@@ -521,3 +584,21 @@ var _ = Describe("Create", func() {
 		})
 	})
 })
+
+// mockRoleTrust mocks a role that trusts the OIDC provider of the test cluster, only for the given
+// service account subject if it is set
+func mockRoleTrust(p *mockprovider.MockProvider, roleName, subject string) {
+	condition := `"StringEquals":{"oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E:aud":"sts.amazonaws.com"}`
+	if subject != "" {
+		condition = fmt.Sprintf(`"StringLike":{"oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E:sub":%q}`, subject)
+	}
+	document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow",` +
+		`"Principal":{"Federated":"arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E"},` +
+		`"Action":["sts:AssumeRoleWithWebIdentity"],"Condition":{` + condition + `}}]}`
+	p.MockIAM().On("GetRole", &awsiam.GetRoleInput{RoleName: aws.String(roleName)}).Return(&awsiam.GetRoleOutput{
+		Role: &awsiam.Role{
+			RoleName:                 aws.String(roleName),
+			AssumeRolePolicyDocument: aws.String(url.QueryEscape(document)),
+		},
+	}, nil)
+}
@@ -1,7 +1,33 @@
 package addon
 
-import "time"
+import (
+	"time"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
 
 func (m *Manager) SetTimeout(timeout time.Duration) {
 	m.timeout = timeout
 }
+
+// SetNow sets the clock the expiry of webhook certificates is checked against, and returns a function restoring it
+func SetNow(f func() time.Time) func() {
+	prev := now
+	now = f
+	return func() {
+		now = prev
+	}
+}
+
+// CreatedAddons returns the addons created by a task tree returned by CreateAddonTasks, and those that are waited for
+func CreatedAddons(taskTree *tasks.TaskTree) ([]*api.Addon, []*api.Addon) {
+	task := taskTree.Tasks[0].(*createAddonTask)
+	var waitedFor []*api.Addon
+	for _, a := range task.addons {
+		if task.waitFor(a) {
+			waitedFor = append(waitedFor, a)
+		}
+	}
+	return task.addons, waitedFor
+}
@@ -1,7 +1,6 @@
 package addon
 
 import (
-	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,13 +15,15 @@ func CreateAddonTasks(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvid
 	var preAddons []*api.Addon
 	var postAddons []*api.Addon
 	for _, addon := range cfg.Addons {
-		if strings.ToLower(addon.Name) == "vpc-cni" {
+		if addon.IsCreatedBeforeNodeGroups() {
 			preAddons = append(preAddons, addon)
 		} else {
 			postAddons = append(postAddons, addon)
 		}
 	}
 
+	// addons created before the nodegroups, other than vpc-cni, are not waited for, as their pods can only run once
+	// nodes have joined
 	preTasks.Append(
 		&createAddonTask{
 			info:            "create addons",
@@ -41,6 +42,7 @@ func CreateAddonTasks(cfg *api.ClusterConfig, clusterProvider *eks.ClusterProvid
 			cfg:             cfg,
 			clusterProvider: clusterProvider,
 			forceAll:        forceAll,
+			wait:            true,
 			timeout:         timeout,
 		},
 	)
@@ -53,11 +55,18 @@ type createAddonTask struct {
 	clusterProvider *eks.ClusterProvider
 	addons          []*api.Addon
 	forceAll        bool
+	wait            bool
 	timeout         time.Duration
 }
 
 func (t *createAddonTask) Describe() string { return t.info }
 
+// waitFor returns true if the creation of the addon is waited for; vpc-cni always is, as it becomes
+// active before any node has joined and the nodegroups need it to become ready
+func (t *createAddonTask) waitFor(a *api.Addon) bool {
+	return t.wait || a.CanonicalName() == api.VPCCNIAddon
+}
+
 func (t *createAddonTask) Do(errorCh chan error) error {
 	clientSet, err := t.clusterProvider.NewStdClientSet(t.cfg)
 	if err != nil {
@@ -89,7 +98,7 @@ func (t *createAddonTask) Do(errorCh chan error) error {
 		if t.forceAll {
 			a.Force = true
 		}
-		err := addonManager.Create(a, true)
+		err := addonManager.Create(a, t.waitFor(a))
 		if err != nil {
 			go func() {
 				errorCh <- err
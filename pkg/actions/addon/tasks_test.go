@@ -0,0 +1,53 @@
+package addon_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Create addon tasks", func() {
+	names := func(addons []*api.Addon) []string {
+		var names []string
+		for _, a := range addons {
+			names = append(names, a.Name)
+		}
+		return names
+	}
+
+	It("creates vpc-cni and the addons set to be created first before the nodegroups", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Addons = []*api.Addon{
+			{Name: "coredns"},
+			{Name: "VPC-CNI"},
+			{Name: "cilium", CreateBeforeNodeGroups: true},
+			{Name: "aws-ebs-csi-driver"},
+		}
+
+		preTasks, postTasks := addon.CreateAddonTasks(cfg, nil, true, time.Minute)
+
+		preAddons, waitedFor := addon.CreatedAddons(preTasks)
+		Expect(names(preAddons)).To(Equal([]string{"VPC-CNI", "cilium"}))
+		Expect(names(waitedFor)).To(Equal([]string{"VPC-CNI"}))
+
+		postAddons, waitedFor := addon.CreatedAddons(postTasks)
+		Expect(names(postAddons)).To(Equal([]string{"coredns", "aws-ebs-csi-driver"}))
+		Expect(names(waitedFor)).To(Equal([]string{"coredns", "aws-ebs-csi-driver"}))
+	})
+
+	It("creates all addons after the nodegroups by default", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Addons = []*api.Addon{{Name: "coredns"}, {Name: "kube-proxy"}}
+
+		preTasks, postTasks := addon.CreateAddonTasks(cfg, nil, true, time.Minute)
+
+		preAddons, _ := addon.CreatedAddons(preTasks)
+		Expect(preAddons).To(BeEmpty())
+		postAddons, _ := addon.CreatedAddons(postTasks)
+		Expect(names(postAddons)).To(Equal([]string{"coredns", "kube-proxy"}))
+	})
+})
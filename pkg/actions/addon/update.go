@@ -1,6 +1,7 @@
 package addon
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -32,6 +33,11 @@ func (a *Manager) Update(addon *api.Addon, wait bool) error {
 		return err
 	}
 
+	expiringCertificates, err := a.expiringWebhookCertificates(addon)
+	if err != nil {
+		return err
+	}
+
 	if addon.Version == "" {
 		// preserve existing version
 		// Might be redundant, does the API care?
@@ -53,6 +59,12 @@ func (a *Manager) Update(addon *api.Addon, wait bool) error {
 
 	//check if we have been provided a different set of policies/role
 	if addon.ServiceAccountRoleARN != "" {
+		if a.withOIDC {
+			namespace, serviceAccount := a.getKnownServiceAccountLocation(addon)
+			if err := a.validateServiceAccountRoleARN(addon, namespace, serviceAccount); err != nil {
+				return err
+			}
+		}
 		updateAddonInput.ServiceAccountRoleArn = &addon.ServiceAccountRoleARN
 	} else if hasPoliciesSet(addon) {
 		serviceAccountRoleARN, err := a.updateWithNewPolicies(addon)
@@ -70,7 +82,16 @@ func (a *Manager) Update(addon *api.Addon, wait bool) error {
 	logger.Info("updating addon")
 	logger.Debug(updateAddonInput.String())
 
-	output, err := a.eksAPI.UpdateAddon(updateAddonInput)
+	var output *eks.UpdateAddonOutput
+	configurationValues, err := a.configurationValues(addon)
+	if err != nil {
+		return err
+	}
+	if configurationValues != "" {
+		output, err = a.eksAPI.UpdateAddonWithContext(context.TODO(), updateAddonInput, withConfigurationValues(configurationValues))
+	} else {
+		output, err = a.eksAPI.UpdateAddon(updateAddonInput)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to update addon %q: %v", addon.Name, err)
 	}
@@ -78,9 +99,11 @@ func (a *Manager) Update(addon *api.Addon, wait bool) error {
 		logger.Debug(output.String())
 	}
 	if wait {
-		return a.waitForAddonToBeActive(addon)
+		if err := a.waitForAddonToBeActive(addon); err != nil {
+			return err
+		}
 	}
-	return nil
+	return a.renewWebhookCertificates(addon, expiringCertificates)
 }
 
 func (a *Manager) updateWithNewPolicies(addon *api.Addon) (string, error) {
@@ -47,7 +47,7 @@ var _ = Describe("Update", func() {
 			return nil
 		}
 
-		oidc, err := iamoidc.NewOpenIDConnectManager(nil, "456123987123", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", nil)
+		oidc, err := iamoidc.NewOpenIDConnectManager(mockProvider.IAM(), "456123987123", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", nil)
 		Expect(err).ToNot(HaveOccurred())
 		oidc.ProviderARN = "arn:aws:iam::456123987123:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E"
 
@@ -247,10 +247,11 @@ var _ = Describe("Update", func() {
 		When("updating the policy", func() {
 			When("specifying a new serviceAccountRoleARN", func() {
 				It("updates the addon", func() {
+					mockRoleTrust(mockProvider, "new-role", "")
 					err := addonManager.Update(&api.Addon{
 						Name:                  "my-addon",
 						Version:               "v1.0.0-eksbuild.2",
-						ServiceAccountRoleARN: "new-arn",
+						ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/new-role",
 					}, false)
 
 					Expect(err).NotTo(HaveOccurred())
@@ -259,7 +260,18 @@ var _ = Describe("Update", func() {
 					Expect(*updateAddonInput.ClusterName).To(Equal("my-cluster"))
 					Expect(*updateAddonInput.AddonName).To(Equal("my-addon"))
 					Expect(*updateAddonInput.AddonVersion).To(Equal("v1.0.0-eksbuild.2"))
-					Expect(*updateAddonInput.ServiceAccountRoleArn).To(Equal("new-arn"))
+					Expect(*updateAddonInput.ServiceAccountRoleArn).To(Equal("arn:aws:iam::456123987123:role/new-role"))
+				})
+
+				It("returns an error when the role does not trust the service account of the addon", func() {
+					mockRoleTrust(mockProvider, "new-role", "system:serviceaccount:default:*")
+					err := addonManager.Update(&api.Addon{
+						Name:                  "vpc-cni",
+						Version:               "v1.0.0-eksbuild.2",
+						ServiceAccountRoleARN: "arn:aws:iam::456123987123:role/new-role",
+					}, false)
+					Expect(err).To(MatchError(ContainSubstring(`does not allow sts:AssumeRoleWithWebIdentity`)))
+					mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "UpdateAddon", mock.Anything)
 				})
 			})
 
@@ -0,0 +1,101 @@
+package addon
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// now returns the current time, the expiry of the webhook certificates is checked against it
+var now = time.Now
+
+// expiringWebhookCertificates reports the certificates of the webhooks of an addon that expire within the threshold,
+// and returns the secrets holding them. It is called before the addon is updated, so that an unreadable secret
+// fails the update before it is made
+func (a *Manager) expiringWebhookCertificates(addon *api.Addon) ([]string, error) {
+	webhookCertificates := addon.WebhookCertificates
+	if webhookCertificates == nil {
+		return nil, nil
+	}
+	if a.clientSet == nil {
+		return nil, fmt.Errorf("a Kubernetes client is required to check the webhook certificates of addon %q", addon.Name)
+	}
+
+	threshold := now().Add(webhookCertificates.ExpiryThreshold())
+	var expiring []string
+	for _, secretRef := range webhookCertificates.Secrets {
+		namespace, name := splitNamespacedName(secretRef)
+		secret, err := a.clientSet.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				logger.Warning("webhook certificate secret %q of addon %q not found", secretRef, addon.Name)
+				continue
+			}
+			return nil, errors.Wrapf(err, "getting webhook certificate secret %q", secretRef)
+		}
+		notAfter, err := certificateExpiry(secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "reading webhook certificate secret %q", secretRef)
+		}
+		if notAfter.Before(threshold) {
+			logger.Warning("webhook certificate in secret %q of addon %q expires on %s", secretRef, addon.Name, notAfter.UTC().Format(time.RFC3339))
+			expiring = append(expiring, secretRef)
+		} else {
+			logger.Debug("webhook certificate in secret %q of addon %q expires on %s", secretRef, addon.Name, notAfter.UTC().Format(time.RFC3339))
+		}
+	}
+	return expiring, nil
+}
+
+// renewWebhookCertificates restarts the deployments that renew the expiring webhook certificates of an addon
+func (a *Manager) renewWebhookCertificates(addon *api.Addon, expiring []string) error {
+	if len(expiring) == 0 {
+		return nil
+	}
+	webhookCertificates := addon.WebhookCertificates
+	if len(webhookCertificates.RestartDeployments) == 0 {
+		logger.Warning("renew the webhook certificates of addon %q, or set webhookCertificates.restartDeployments to restart the deployments renewing them", addon.Name)
+		return nil
+	}
+	for _, deploymentRef := range webhookCertificates.RestartDeployments {
+		namespace, name := splitNamespacedName(deploymentRef)
+		if err := defaultaddons.RestartDeployment(a.clientSet, namespace, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// certificateExpiry returns the expiry of the certificate in the tls.crt key of a secret, which is the first one of the chain
+func certificateExpiry(secret *corev1.Secret) (time.Time, error) {
+	data, ok := secret.Data[corev1.TLSCertKey]
+	if !ok {
+		return time.Time{}, fmt.Errorf("no %s key found", corev1.TLSCertKey)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return time.Time{}, fmt.Errorf("no PEM-encoded certificate found in %s", corev1.TLSCertKey)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "parsing %s", corev1.TLSCertKey)
+	}
+	return cert.NotAfter, nil
+}
+
+func splitNamespacedName(ref string) (string, string) {
+	parts := strings.SplitN(ref, "/", 2)
+	return parts[0], parts[1]
+}
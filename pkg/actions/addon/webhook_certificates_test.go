@@ -0,0 +1,176 @@
+package addon_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Update with webhook certificates", func() {
+	var (
+		mockProvider *mockprovider.MockProvider
+		clientSet    *fake.Clientset
+		restore      func()
+		clock        time.Time
+	)
+
+	newCertificateSecret := func(name string, notAfter time.Time) *corev1.Secret {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		template := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: "webhook.kube-system.svc"},
+			NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+			NotAfter:     notAfter,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		Expect(err).NotTo(HaveOccurred())
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-system"},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+			},
+		}
+	}
+
+	webhookDeployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook", Namespace: "kube-system"},
+	}
+
+	update := func(webhookCertificates *api.AddonWebhookCertificates, objects ...runtime.Object) error {
+		clientSet = fake.NewSimpleClientset(append(objects, webhookDeployment.DeepCopy())...)
+		addonManager, err := addon.New(&api.ClusterConfig{Metadata: &api.ClusterMeta{
+			Version: "1.18",
+			Name:    "my-cluster",
+		}}, mockProvider.EKS(), new(fakes.FakeStackManager), false, nil, clientSet, time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		return addonManager.Update(&api.Addon{
+			Name:                "my-addon",
+			WebhookCertificates: webhookCertificates,
+		}, false)
+	}
+
+	restartedAt := func() string {
+		deployment, err := clientSet.AppsV1().Deployments("kube-system").Get(context.TODO(), "webhook", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return deployment.Spec.Template.Annotations[defaultaddons.RestartedAtAnnotation]
+	}
+
+	BeforeEach(func() {
+		clock = time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)
+		restore = addon.SetNow(func() time.Time { return clock })
+
+		mockProvider = mockprovider.NewMockProvider()
+		mockProvider.MockEKS().On("DescribeAddon", mock.Anything).Return(&awseks.DescribeAddonOutput{
+			Addon: &awseks.Addon{
+				AddonName:    aws.String("my-addon"),
+				AddonVersion: aws.String("v1.0.0-eksbuild.1"),
+				Status:       aws.String(awseks.AddonStatusActive),
+			},
+		}, nil)
+		mockProvider.MockEKS().On("DescribeAddonVersions", mock.Anything).Return(&awseks.DescribeAddonVersionsOutput{
+			Addons: []*awseks.AddonInfo{{
+				AddonName:     aws.String("my-addon"),
+				AddonVersions: []*awseks.AddonVersionInfo{{AddonVersion: aws.String("v1.0.0-eksbuild.1")}},
+			}},
+		}, nil)
+		mockProvider.MockEKS().On("UpdateAddon", mock.Anything).Return(&awseks.UpdateAddonOutput{}, nil)
+	})
+
+	AfterEach(func() {
+		restore()
+	})
+
+	It("does not restart the deployments when the certificates are not expiring", func() {
+		err := update(&api.AddonWebhookCertificates{
+			Secrets:            []string{"kube-system/webhook-tls"},
+			RestartDeployments: []string{"kube-system/webhook"},
+		}, newCertificateSecret("webhook-tls", clock.Add(90*24*time.Hour)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedAt()).To(BeEmpty())
+	})
+
+	It("restarts the deployments when a certificate expires within the threshold", func() {
+		err := update(&api.AddonWebhookCertificates{
+			Secrets:            []string{"kube-system/webhook-tls", "kube-system/other-webhook-tls"},
+			RestartDeployments: []string{"kube-system/webhook"},
+		}, newCertificateSecret("webhook-tls", clock.Add(90*24*time.Hour)), newCertificateSecret("other-webhook-tls", clock.Add(10*24*time.Hour)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedAt()).NotTo(BeEmpty())
+	})
+
+	It("restarts the deployments when a certificate has expired", func() {
+		err := update(&api.AddonWebhookCertificates{
+			Secrets:            []string{"kube-system/webhook-tls"},
+			RestartDeployments: []string{"kube-system/webhook"},
+		}, newCertificateSecret("webhook-tls", clock.Add(-time.Hour)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedAt()).NotTo(BeEmpty())
+	})
+
+	It("uses the expiry threshold of the addon", func() {
+		err := update(&api.AddonWebhookCertificates{
+			Secrets:             []string{"kube-system/webhook-tls"},
+			ExpiryThresholdDays: aws.Int(100),
+			RestartDeployments:  []string{"kube-system/webhook"},
+		}, newCertificateSecret("webhook-tls", clock.Add(90*24*time.Hour)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedAt()).NotTo(BeEmpty())
+	})
+
+	It("only warns about expiring certificates when no deployment is set", func() {
+		err := update(&api.AddonWebhookCertificates{
+			Secrets: []string{"kube-system/webhook-tls"},
+		}, newCertificateSecret("webhook-tls", clock.Add(24*time.Hour)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedAt()).To(BeEmpty())
+	})
+
+	It("skips the secrets that do not exist", func() {
+		err := update(&api.AddonWebhookCertificates{
+			Secrets:            []string{"kube-system/webhook-tls"},
+			RestartDeployments: []string{"kube-system/webhook"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restartedAt()).To(BeEmpty())
+	})
+
+	It("fails on secrets without a certificate", func() {
+		secret := newCertificateSecret("webhook-tls", clock)
+		secret.Data = map[string][]byte{corev1.TLSCertKey: []byte("not a certificate")}
+		err := update(&api.AddonWebhookCertificates{
+			Secrets: []string{"kube-system/webhook-tls"},
+		}, secret)
+		Expect(err).To(MatchError(`reading webhook certificate secret "kube-system/webhook-tls": no PEM-encoded certificate found in tls.crt`))
+		mockProvider.MockEKS().AssertNotCalled(GinkgoT(), "UpdateAddon", mock.Anything)
+	})
+
+	It("does not check the certificates when they are not set", func() {
+		Expect(update(nil)).To(Succeed())
+		Expect(clientSet.Actions()).To(BeEmpty())
+	})
+})
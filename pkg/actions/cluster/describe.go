@@ -0,0 +1,183 @@
+package cluster
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// Sections of a cluster Description
+const (
+	ControlPlaneSection       = "controlPlane"
+	NodeGroupsSection         = "nodeGroups"
+	DefaultAddonsSection      = "defaultAddons"
+	AddonsSection             = "addons"
+	IAMServiceAccountsSection = "iamServiceAccounts"
+)
+
+// NodeGroupLister lists the nodegroups of a cluster
+type NodeGroupLister interface {
+	GetAll() ([]*manager.NodeGroupSummary, error)
+}
+
+// AddonLister lists the EKS managed addons of a cluster
+type AddonLister interface {
+	GetAll() ([]addon.Summary, error)
+}
+
+// IAMServiceAccountGetter gets the IAM service accounts of a cluster
+type IAMServiceAccountGetter interface {
+	Get(options irsa.GetOptions) ([]*api.ClusterIAMServiceAccount, error)
+}
+
+// KubernetesClient is a client of the Kubernetes API of a cluster
+type KubernetesClient interface {
+	kubernetes.RawClientInterface
+	ServerVersion() (string, error)
+}
+
+// Description is a snapshot of the state of a cluster.
+// A section that could not be described is left empty and its error is recorded in Errors
+type Description struct {
+	ControlPlane       *ControlPlaneDescription
+	NodeGroups         []*manager.NodeGroupSummary
+	DefaultAddons      []DefaultAddonDescription
+	Addons             []addon.Summary
+	IAMServiceAccounts []*api.ClusterIAMServiceAccount
+	Errors             []SectionError `json:",omitempty"`
+}
+
+// ControlPlaneDescription describes the control plane of a cluster
+type ControlPlaneDescription struct {
+	Name            string
+	Version         string
+	PlatformVersion string
+	Status          string
+	Endpoint        string
+	EnabledLogTypes []string
+}
+
+// DefaultAddonDescription describes an addon that is installed by default on EKS clusters
+type DefaultAddonDescription struct {
+	Name     string
+	UpToDate bool
+}
+
+// SectionError is an error that occurred while describing a section
+type SectionError struct {
+	Section string
+	Error   string
+}
+
+// Describer aggregates the state of a cluster
+type Describer struct {
+	cfg             *api.ClusterConfig
+	eksAPI          eksiface.EKSAPI
+	nodeGroups      NodeGroupLister
+	addons          AddonLister
+	serviceAccounts IAMServiceAccountGetter
+	rawClient       KubernetesClient
+}
+
+// NewDescriber creates a new Describer
+func NewDescriber(cfg *api.ClusterConfig, eksAPI eksiface.EKSAPI, nodeGroups NodeGroupLister, addons AddonLister,
+	serviceAccounts IAMServiceAccountGetter, rawClient KubernetesClient) *Describer {
+	return &Describer{
+		cfg:             cfg,
+		eksAPI:          eksAPI,
+		nodeGroups:      nodeGroups,
+		addons:          addons,
+		serviceAccounts: serviceAccounts,
+		rawClient:       rawClient,
+	}
+}
+
+// Describe describes each section of the cluster independently, so that an error in one section
+// does not prevent the others from being described
+func (d *Describer) Describe() *Description {
+	description := &Description{}
+	addError := func(section string, err error) {
+		logger.Warning("failed to describe %s of cluster %q: %v", section, d.cfg.Metadata.Name, err)
+		description.Errors = append(description.Errors, SectionError{Section: section, Error: err.Error()})
+	}
+
+	controlPlane, err := d.describeControlPlane()
+	if err != nil {
+		addError(ControlPlaneSection, err)
+	}
+	description.ControlPlane = controlPlane
+
+	if description.NodeGroups, err = d.nodeGroups.GetAll(); err != nil {
+		addError(NodeGroupsSection, err)
+	}
+
+	if description.DefaultAddons, err = d.describeDefaultAddons(); err != nil {
+		addError(DefaultAddonsSection, err)
+	}
+
+	if description.Addons, err = d.addons.GetAll(); err != nil {
+		addError(AddonsSection, err)
+	}
+
+	if description.IAMServiceAccounts, err = d.serviceAccounts.Get(irsa.GetOptions{}); err != nil {
+		addError(IAMServiceAccountsSection, err)
+	}
+
+	return description
+}
+
+func (d *Describer) describeControlPlane() (*ControlPlaneDescription, error) {
+	output, err := d.eksAPI.DescribeCluster(&awseks.DescribeClusterInput{
+		Name: &d.cfg.Metadata.Name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe cluster")
+	}
+
+	cluster := output.Cluster
+	controlPlane := &ControlPlaneDescription{
+		Name:            aws.StringValue(cluster.Name),
+		Version:         aws.StringValue(cluster.Version),
+		PlatformVersion: aws.StringValue(cluster.PlatformVersion),
+		Status:          aws.StringValue(cluster.Status),
+		Endpoint:        aws.StringValue(cluster.Endpoint),
+		EnabledLogTypes: []string{},
+	}
+	if cluster.Logging != nil {
+		for _, logSetup := range cluster.Logging.ClusterLogging {
+			if aws.BoolValue(logSetup.Enabled) {
+				controlPlane.EnabledLogTypes = append(controlPlane.EnabledLogTypes, aws.StringValueSlice(logSetup.Types)...)
+			}
+		}
+	}
+	return controlPlane, nil
+}
+
+func (d *Describer) describeDefaultAddons() ([]DefaultAddonDescription, error) {
+	controlPlaneVersion, err := d.rawClient.ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	var descriptions []DefaultAddonDescription
+	for _, addon := range defaultaddons.NewDefaultAddons(defaultaddons.AddonInput{
+		RawClient:           d.rawClient,
+		ControlPlaneVersion: controlPlaneVersion,
+		ClusterConfig:       d.cfg,
+	}) {
+		upToDate, err := addon.IsUpToDate()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check %s", addon.Name())
+		}
+		descriptions = append(descriptions, DefaultAddonDescription{Name: addon.Name(), UpToDate: upToDate})
+	}
+	return descriptions, nil
+}
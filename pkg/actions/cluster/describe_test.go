@@ -0,0 +1,177 @@
+package cluster_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type fakeNodeGroupLister struct {
+	summaries []*manager.NodeGroupSummary
+	err       error
+}
+
+func (f *fakeNodeGroupLister) GetAll() ([]*manager.NodeGroupSummary, error) {
+	return f.summaries, f.err
+}
+
+type fakeAddonLister struct {
+	summaries []addon.Summary
+	err       error
+}
+
+func (f *fakeAddonLister) GetAll() ([]addon.Summary, error) {
+	return f.summaries, f.err
+}
+
+type fakeIAMServiceAccountGetter struct {
+	serviceAccounts []*api.ClusterIAMServiceAccount
+	err             error
+}
+
+func (f *fakeIAMServiceAccountGetter) Get(_ irsa.GetOptions) ([]*api.ClusterIAMServiceAccount, error) {
+	return f.serviceAccounts, f.err
+}
+
+type fakeKubernetesClient struct {
+	*testutils.FakeRawClient
+	serverVersion string
+	err           error
+}
+
+func (f *fakeKubernetesClient) ServerVersion() (string, error) {
+	return f.serverVersion, f.err
+}
+
+var _ = Describe("Describe", func() {
+	var (
+		cfg             *api.ClusterConfig
+		p               *mockprovider.MockProvider
+		rawClient       *fakeKubernetesClient
+		nodeGroups      *fakeNodeGroupLister
+		addons          *fakeAddonLister
+		serviceAccounts *fakeIAMServiceAccountGetter
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "eu-west-1"
+		p = mockprovider.NewMockProvider()
+
+		rawClient = &fakeKubernetesClient{
+			FakeRawClient: testutils.NewFakeRawClient(),
+			serverVersion: "1.18.9",
+		}
+		rawClient.UseUnionTracker = true
+		for _, item := range testutils.LoadSamples("../../addons/default/testdata/sample-1.15.json") {
+			rc, err := rawClient.NewRawResource(item)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rc.CreateOrReplace(false)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		nodeGroups = &fakeNodeGroupLister{
+			summaries: []*manager.NodeGroupSummary{
+				{Name: "ng-1", Cluster: "my-cluster", DesiredCapacity: 2, MinSize: 1, MaxSize: 3, Version: "1.15"},
+			},
+		}
+		addons = &fakeAddonLister{
+			summaries: []addon.Summary{
+				{Name: "vpc-cni", Version: "v1.7.5-eksbuild.1", Status: "ACTIVE"},
+			},
+		}
+		serviceAccounts = &fakeIAMServiceAccountGetter{
+			serviceAccounts: []*api.ClusterIAMServiceAccount{
+				{ClusterIAMMeta: api.ClusterIAMMeta{Name: "s3-reader", Namespace: "default"}},
+			},
+		}
+	})
+
+	mockDescribeCluster := func() {
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+			Cluster: &awseks.Cluster{
+				Name:            aws.String("my-cluster"),
+				Version:         aws.String("1.16"),
+				PlatformVersion: aws.String("eks.3"),
+				Status:          aws.String(awseks.ClusterStatusActive),
+				Endpoint:        aws.String("https://endpoint.eks.amazonaws.com"),
+				Logging: &awseks.Logging{
+					ClusterLogging: []*awseks.LogSetup{
+						{Enabled: aws.Bool(true), Types: aws.StringSlice([]string{"api", "audit"})},
+						{Enabled: aws.Bool(false), Types: aws.StringSlice([]string{"scheduler"})},
+					},
+				},
+			},
+		}, nil)
+	}
+
+	describe := func() *cluster.Description {
+		return cluster.NewDescriber(cfg, p.EKS(), nodeGroups, addons, serviceAccounts, rawClient).Describe()
+	}
+
+	It("describes all sections of the cluster", func() {
+		mockDescribeCluster()
+
+		description := describe()
+		Expect(description.Errors).To(BeEmpty())
+		Expect(description.ControlPlane).To(Equal(&cluster.ControlPlaneDescription{
+			Name:            "my-cluster",
+			Version:         "1.16",
+			PlatformVersion: "eks.3",
+			Status:          awseks.ClusterStatusActive,
+			Endpoint:        "https://endpoint.eks.amazonaws.com",
+			EnabledLogTypes: []string{"api", "audit"},
+		}))
+		Expect(description.NodeGroups).To(Equal(nodeGroups.summaries))
+		Expect(description.DefaultAddons).To(ConsistOf(
+			cluster.DefaultAddonDescription{Name: "kube-proxy", UpToDate: false},
+			cluster.DefaultAddonDescription{Name: "aws-node", UpToDate: false},
+			cluster.DefaultAddonDescription{Name: "coredns", UpToDate: false},
+		))
+		Expect(description.Addons).To(Equal(addons.summaries))
+		Expect(description.IAMServiceAccounts).To(Equal(serviceAccounts.serviceAccounts))
+	})
+
+	It("describes the other sections when a section fails", func() {
+		mockDescribeCluster()
+		nodeGroups.summaries, nodeGroups.err = nil, errors.New("throttled")
+
+		description := describe()
+		Expect(description.Errors).To(ConsistOf(cluster.SectionError{Section: cluster.NodeGroupsSection, Error: "throttled"}))
+		Expect(description.NodeGroups).To(BeEmpty())
+		Expect(description.ControlPlane).NotTo(BeNil())
+		Expect(description.DefaultAddons).To(HaveLen(3))
+		Expect(description.Addons).To(HaveLen(1))
+		Expect(description.IAMServiceAccounts).To(HaveLen(1))
+	})
+
+	It("records an error for each failing section", func() {
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(nil, errors.New("access denied"))
+		rawClient.err = errors.New("connection refused")
+		addons.err = errors.New("failed to list addons")
+
+		description := describe()
+		Expect(description.ControlPlane).To(BeNil())
+		Expect(description.DefaultAddons).To(BeEmpty())
+		Expect(description.Errors).To(ConsistOf(
+			cluster.SectionError{Section: cluster.ControlPlaneSection, Error: "failed to describe cluster: access denied"},
+			cluster.SectionError{Section: cluster.DefaultAddonsSection, Error: "connection refused"},
+			cluster.SectionError{Section: cluster.AddonsSection, Error: "failed to list addons"},
+		))
+		Expect(description.NodeGroups).To(HaveLen(1))
+		Expect(description.IAMServiceAccounts).To(HaveLen(1))
+	})
+})
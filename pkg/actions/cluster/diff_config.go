@@ -0,0 +1,302 @@
+package cluster
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// FieldChange is a field whose value differs between two configs, with its old and new values
+// encoded as JSON, an empty value means that the field is unset
+type FieldChange struct {
+	Path string
+	Old  string `json:",omitempty"`
+	New  string `json:",omitempty"`
+}
+
+// ChangedItem is an item present in both configs, e.g. a nodegroup, whose fields differ.
+// The items of sections that are a single object, such as metadata, have no name
+type ChangedItem struct {
+	Name    string `json:",omitempty"`
+	Changes []FieldChange
+}
+
+// ConfigDiffSection lists the items of a section of the config that were added, removed or changed
+type ConfigDiffSection struct {
+	Name    string
+	Added   []string      `json:",omitempty"`
+	Removed []string      `json:",omitempty"`
+	Changed []ChangedItem `json:",omitempty"`
+}
+
+// ConfigDiff is a semantic diff between two ClusterConfigs, listing only the sections that differ
+type ConfigDiff struct {
+	Sections []ConfigDiffSection
+}
+
+// IsEmpty returns true if both configs are equivalent
+func (d *ConfigDiff) IsEmpty() bool {
+	return len(d.Sections) == 0
+}
+
+// DiffConfigs compares two ClusterConfigs once the defaults have been set on copies of them, so that a field
+// set to its default value in only one of them is not reported
+func DiffConfigs(oldConfig, newConfig *api.ClusterConfig) (*ConfigDiff, error) {
+	oldConfig, newConfig = withConfigDefaults(oldConfig), withConfigDefaults(newConfig)
+
+	sectionDiffs := []struct {
+		name string
+		diff func(name string, oldConfig, newConfig *api.ClusterConfig) (*ConfigDiffSection, error)
+	}{
+		{"metadata", objectSection(func(c *api.ClusterConfig) interface{} { return c.Metadata })},
+		{"nodeGroups", itemsSection(func(c *api.ClusterConfig) map[string]interface{} {
+			items := map[string]interface{}{}
+			for _, ng := range c.NodeGroups {
+				items[ng.Name] = ng
+			}
+			return items
+		})},
+		{"managedNodeGroups", itemsSection(func(c *api.ClusterConfig) map[string]interface{} {
+			items := map[string]interface{}{}
+			for _, ng := range c.ManagedNodeGroups {
+				items[ng.Name] = ng
+			}
+			return items
+		})},
+		{"fargateProfiles", itemsSection(func(c *api.ClusterConfig) map[string]interface{} {
+			items := map[string]interface{}{}
+			for _, fp := range c.FargateProfiles {
+				items[fp.Name] = fp
+			}
+			return items
+		})},
+		{"addons", itemsSection(func(c *api.ClusterConfig) map[string]interface{} {
+			items := map[string]interface{}{}
+			for _, addon := range c.Addons {
+				items[addon.Name] = addon
+			}
+			return items
+		})},
+		{"iam", objectSection(func(c *api.ClusterConfig) interface{} {
+			if c.IAM == nil {
+				return nil
+			}
+			iam := *c.IAM
+			iam.ServiceAccounts = nil
+			return iam
+		})},
+		{"iam.serviceAccounts", itemsSection(func(c *api.ClusterConfig) map[string]interface{} {
+			items := map[string]interface{}{}
+			if c.IAM != nil {
+				for _, sa := range c.IAM.ServiceAccounts {
+					items[sa.NameString()] = sa
+				}
+			}
+			return items
+		})},
+		{"other", objectSection(func(c *api.ClusterConfig) interface{} {
+			other := *c
+			other.TypeMeta = api.ClusterConfigTypeMeta()
+			other.Metadata = nil
+			other.NodeGroups = nil
+			other.ManagedNodeGroups = nil
+			other.FargateProfiles = nil
+			other.Addons = nil
+			other.IAM = nil
+			other.Status = nil
+			return other
+		})},
+	}
+
+	diff := &ConfigDiff{}
+	for _, sectionDiff := range sectionDiffs {
+		section, err := sectionDiff.diff(sectionDiff.name, oldConfig, newConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "comparing %s", sectionDiff.name)
+		}
+		if len(section.Added) > 0 || len(section.Removed) > 0 || len(section.Changed) > 0 {
+			diff.Sections = append(diff.Sections, *section)
+		}
+	}
+	return diff, nil
+}
+
+// withConfigDefaults returns a copy of the config with the defaults set by eksctl create cluster
+func withConfigDefaults(cfg *api.ClusterConfig) *api.ClusterConfig {
+	cfg = cfg.DeepCopy()
+	if cfg.VPC == nil {
+		cfg.VPC = api.NewClusterVPC()
+	}
+	if cfg.VPC.NAT == nil {
+		cfg.VPC.NAT = api.DefaultClusterNAT()
+	}
+	if !api.IsSetAndNonEmptyString(cfg.VPC.NAT.Gateway) {
+		cfg.VPC.NAT.Gateway = api.DefaultClusterNAT().Gateway
+	}
+	if cfg.VPC.ID == "" && cfg.VPC.CIDR == nil {
+		cidr := api.DefaultCIDR()
+		cfg.VPC.CIDR = &cidr
+	}
+	if cfg.VPC.AutoAllocateIPv6 == nil {
+		cfg.VPC.AutoAllocateIPv6 = api.Disabled()
+	}
+	api.SetClusterEndpointAccessDefaults(cfg.VPC)
+	api.SetClusterConfigDefaults(cfg)
+	for _, ng := range cfg.NodeGroups {
+		api.SetNodeGroupDefaults(ng, cfg.Metadata)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		api.SetManagedNodeGroupDefaults(ng, cfg.Metadata)
+	}
+	return cfg
+}
+
+// objectSection compares a section of the configs that is a single object
+func objectSection(get func(*api.ClusterConfig) interface{}) func(string, *api.ClusterConfig, *api.ClusterConfig) (*ConfigDiffSection, error) {
+	return func(name string, oldConfig, newConfig *api.ClusterConfig) (*ConfigDiffSection, error) {
+		changes, err := diffFields(get(oldConfig), get(newConfig))
+		if err != nil {
+			return nil, err
+		}
+		section := &ConfigDiffSection{Name: name}
+		if len(changes) > 0 {
+			section.Changed = []ChangedItem{{Changes: changes}}
+		}
+		return section, nil
+	}
+}
+
+// itemsSection compares a section of the configs that is a list of items identified by their names
+func itemsSection(get func(*api.ClusterConfig) map[string]interface{}) func(string, *api.ClusterConfig, *api.ClusterConfig) (*ConfigDiffSection, error) {
+	return func(name string, oldConfig, newConfig *api.ClusterConfig) (*ConfigDiffSection, error) {
+		oldItems, newItems := get(oldConfig), get(newConfig)
+		section := &ConfigDiffSection{Name: name}
+		for _, itemName := range sortedKeys(oldItems) {
+			newItem, ok := newItems[itemName]
+			if !ok {
+				section.Removed = append(section.Removed, itemName)
+				continue
+			}
+			changes, err := diffFields(oldItems[itemName], newItem)
+			if err != nil {
+				return nil, errors.Wrapf(err, "comparing %q", itemName)
+			}
+			if len(changes) > 0 {
+				section.Changed = append(section.Changed, ChangedItem{Name: itemName, Changes: changes})
+			}
+		}
+		for _, itemName := range sortedKeys(newItems) {
+			if _, ok := oldItems[itemName]; !ok {
+				section.Added = append(section.Added, itemName)
+			}
+		}
+		return section, nil
+	}
+}
+
+// diffFields returns the fields whose values differ between two objects. Lists of objects are compared
+// element by element, other lists as a whole
+func diffFields(oldObj, newObj interface{}) ([]FieldChange, error) {
+	oldFields, err := flattenFields(oldObj)
+	if err != nil {
+		return nil, err
+	}
+	newFields, err := flattenFields(newObj)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := map[string]interface{}{}
+	for path := range oldFields {
+		paths[path] = nil
+	}
+	for path := range newFields {
+		paths[path] = nil
+	}
+	var changes []FieldChange
+	for _, path := range sortedKeys(paths) {
+		if oldFields[path] != newFields[path] {
+			changes = append(changes, FieldChange{Path: path, Old: oldFields[path], New: newFields[path]})
+		}
+	}
+	return changes, nil
+}
+
+func flattenFields(obj interface{}) (map[string]string, error) {
+	fields := map[string]string{}
+	if obj == nil {
+		return fields, nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	if err := flattenValue("", value, fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func flattenValue(path string, value interface{}, fields map[string]string) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		for key, elem := range v {
+			elemPath := key
+			if path != "" {
+				elemPath = path + "." + key
+			}
+			if err := flattenValue(elemPath, elem, fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		if len(v) == 0 {
+			return nil
+		}
+		if !hasObjects(v) {
+			break
+		}
+		for i, elem := range v {
+			if err := flattenValue(fmt.Sprintf("%s[%d]", path, i), elem, fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	fields[path] = string(data)
+	return nil
+}
+
+func hasObjects(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,122 @@
+package cluster_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("DiffConfigs", func() {
+	var oldConfig, newConfig *api.ClusterConfig
+
+	newConfigWith := func(nodeGroupNames ...string) *api.ClusterConfig {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		for _, name := range nodeGroupNames {
+			ng := cfg.NewNodeGroup()
+			ng.Name = name
+			ng.InstanceType = "m5.large"
+		}
+		cfg.Addons = []*api.Addon{{Name: "vpc-cni", Version: "v1.9.0"}}
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{
+			{
+				ClusterIAMMeta:   api.ClusterIAMMeta{Name: "s3-reader", Namespace: "default"},
+				AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+			},
+		}
+		return cfg
+	}
+
+	BeforeEach(func() {
+		oldConfig = newConfigWith("ng-1", "ng-2")
+		newConfig = newConfigWith("ng-1", "ng-2")
+	})
+
+	It("finds no differences between equivalent configs", func() {
+		newConfig.NodeGroups[0].VolumeSize = aws.Int(api.DefaultNodeVolumeSize)
+		newConfig.VPC.NAT.Gateway = aws.String(api.ClusterSingleNAT)
+
+		diff, err := cluster.DiffConfigs(oldConfig, newConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.IsEmpty()).To(BeTrue())
+	})
+
+	It("lists the added, removed and changed items of each section", func() {
+		newConfig.NodeGroups = newConfig.NodeGroups[1:]
+		ng := newConfig.NewNodeGroup()
+		ng.Name = "ng-3"
+		newConfig.NodeGroups[0].InstanceType = "m5.xlarge"
+		newConfig.NodeGroups[0].DesiredCapacity = aws.Int(4)
+		newConfig.Addons[0].Version = "v1.10.0"
+		newConfig.IAM.ServiceAccounts[0].AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonS3FullAccess"}
+		newConfig.VPC.NAT.Gateway = aws.String(api.ClusterHighlyAvailableNAT)
+
+		diff, err := cluster.DiffConfigs(oldConfig, newConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Sections).To(Equal([]cluster.ConfigDiffSection{
+			{
+				Name:    "nodeGroups",
+				Added:   []string{"ng-3"},
+				Removed: []string{"ng-1"},
+				Changed: []cluster.ChangedItem{
+					{
+						Name: "ng-2",
+						Changes: []cluster.FieldChange{
+							{Path: "desiredCapacity", New: "4"},
+							{Path: "instanceType", Old: `"m5.large"`, New: `"m5.xlarge"`},
+						},
+					},
+				},
+			},
+			{
+				Name: "addons",
+				Changed: []cluster.ChangedItem{
+					{
+						Name:    "vpc-cni",
+						Changes: []cluster.FieldChange{{Path: "version", Old: `"v1.9.0"`, New: `"v1.10.0"`}},
+					},
+				},
+			},
+			{
+				Name: "iam.serviceAccounts",
+				Changed: []cluster.ChangedItem{
+					{
+						Name: "default/s3-reader",
+						Changes: []cluster.FieldChange{
+							{
+								Path: "attachPolicyARNs",
+								Old:  `["arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"]`,
+								New:  `["arn:aws:iam::aws:policy/AmazonS3FullAccess"]`,
+							},
+						},
+					},
+				},
+			},
+			{
+				Name: "other",
+				Changed: []cluster.ChangedItem{
+					{
+						Changes: []cluster.FieldChange{{Path: "vpc.nat.gateway", Old: `"Single"`, New: `"HighlyAvailable"`}},
+					},
+				},
+			},
+		}))
+	})
+
+	It("compares lists of objects element by element", func() {
+		newConfig.NodeGroups[0].Taints = []api.NodeGroupTaint{{Key: "team", Value: "a", Effect: "NoSchedule"}}
+
+		diff, err := cluster.DiffConfigs(oldConfig, newConfig)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Sections).To(HaveLen(1))
+		Expect(diff.Sections[0].Changed[0].Changes).To(ConsistOf(
+			cluster.FieldChange{Path: "taints[0].key", New: `"team"`},
+			cluster.FieldChange{Path: "taints[0].value", New: `"a"`},
+			cluster.FieldChange{Path: "taints[0].effect", New: `"NoSchedule"`},
+		))
+	})
+})
@@ -0,0 +1,409 @@
+package cluster
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// UnmanagedNodeGroupLister lists the nodegroups of a cluster that are not managed by EKS
+type UnmanagedNodeGroupLister interface {
+	GetUnmanagedNodeGroupSummaries(name string) ([]*manager.NodeGroupSummary, error)
+}
+
+// DumpedConfig is a ClusterConfig reconstructed from a live cluster on a best-effort basis.
+// Unrecovered lists the fields that could not be fully recovered and should be reviewed
+type DumpedConfig struct {
+	ClusterConfig *api.ClusterConfig
+	Unrecovered   []string
+}
+
+// ConfigDumper reconstructs the ClusterConfig of a live cluster
+type ConfigDumper struct {
+	cfg             *api.ClusterConfig
+	eksAPI          eksiface.EKSAPI
+	ec2API          ec2iface.EC2API
+	nodeGroups      UnmanagedNodeGroupLister
+	addons          AddonLister
+	serviceAccounts IAMServiceAccountGetter
+}
+
+// NewConfigDumper creates a new ConfigDumper
+func NewConfigDumper(cfg *api.ClusterConfig, eksAPI eksiface.EKSAPI, ec2API ec2iface.EC2API, nodeGroups UnmanagedNodeGroupLister,
+	addons AddonLister, serviceAccounts IAMServiceAccountGetter) *ConfigDumper {
+	return &ConfigDumper{
+		cfg:             cfg,
+		eksAPI:          eksAPI,
+		ec2API:          ec2API,
+		nodeGroups:      nodeGroups,
+		addons:          addons,
+		serviceAccounts: serviceAccounts,
+	}
+}
+
+// Dump introspects the cluster, its VPC, nodegroups, addons and IAM service accounts
+// and returns a ClusterConfig that reproduces them as closely as possible
+func (d *ConfigDumper) Dump() (*DumpedConfig, error) {
+	output, err := d.eksAPI.DescribeCluster(&awseks.DescribeClusterInput{
+		Name: &d.cfg.Metadata.Name,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe cluster %q", d.cfg.Metadata.Name)
+	}
+
+	dumped := &DumpedConfig{
+		ClusterConfig: &api.ClusterConfig{
+			TypeMeta: api.ClusterConfigTypeMeta(),
+			Metadata: &api.ClusterMeta{
+				Name:   d.cfg.Metadata.Name,
+				Region: d.cfg.Metadata.Region,
+			},
+			IAM: &api.ClusterIAM{},
+		},
+	}
+
+	cluster := output.Cluster
+	d.dumpClusterSettings(dumped, cluster)
+
+	if err := d.dumpVPC(dumped, cluster.ResourcesVpcConfig); err != nil {
+		return nil, err
+	}
+	if err := d.dumpNodeGroups(dumped); err != nil {
+		return nil, err
+	}
+	if err := d.dumpManagedNodeGroups(dumped); err != nil {
+		return nil, err
+	}
+	if err := d.dumpAddons(dumped); err != nil {
+		return nil, err
+	}
+	if err := d.dumpIAMServiceAccounts(dumped); err != nil {
+		return nil, err
+	}
+	return dumped, nil
+}
+
+func (d *DumpedConfig) addUnrecovered(format string, a ...interface{}) {
+	d.Unrecovered = append(d.Unrecovered, fmt.Sprintf(format, a...))
+}
+
+func (d *ConfigDumper) dumpClusterSettings(dumped *DumpedConfig, cluster *awseks.Cluster) {
+	cfg := dumped.ClusterConfig
+	cfg.Metadata.Version = aws.StringValue(cluster.Version)
+	cfg.Metadata.Tags = withoutReservedTags(aws.StringValueMap(cluster.Tags))
+
+	if cluster.KubernetesNetworkConfig != nil && cluster.KubernetesNetworkConfig.ServiceIpv4Cidr != nil {
+		cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+			ServiceIPv4CIDR: *cluster.KubernetesNetworkConfig.ServiceIpv4Cidr,
+		}
+	}
+
+	if cluster.Logging != nil {
+		var enabledTypes []string
+		for _, logSetup := range cluster.Logging.ClusterLogging {
+			if aws.BoolValue(logSetup.Enabled) {
+				enabledTypes = append(enabledTypes, aws.StringValueSlice(logSetup.Types)...)
+			}
+		}
+		if len(enabledTypes) > 0 {
+			cfg.CloudWatch = &api.ClusterCloudWatch{
+				ClusterLogging: &api.ClusterCloudWatchLogging{
+					EnableTypes: enabledTypes,
+				},
+			}
+		}
+	}
+
+	for _, encryptionConfig := range cluster.EncryptionConfig {
+		if encryptionConfig.Provider != nil && encryptionConfig.Provider.KeyArn != nil {
+			cfg.SecretsEncryption = &api.SecretsEncryption{
+				KeyARN:    *encryptionConfig.Provider.KeyArn,
+				Resources: aws.StringValueSlice(encryptionConfig.Resources),
+			}
+		}
+	}
+
+	dumped.addUnrecovered("fargateProfiles, identityProviders and gitops settings were not recovered")
+}
+
+func (d *ConfigDumper) dumpVPC(dumped *DumpedConfig, vpcConfig *awseks.VpcConfigResponse) error {
+	if vpcConfig == nil {
+		dumped.addUnrecovered("vpc: the cluster has no VPC configuration")
+		return nil
+	}
+
+	cfg := dumped.ClusterConfig
+	cfg.VPC = &api.ClusterVPC{
+		Network: api.Network{
+			ID: aws.StringValue(vpcConfig.VpcId),
+		},
+		ClusterEndpoints: &api.ClusterEndpoints{
+			PrivateAccess: vpcConfig.EndpointPrivateAccess,
+			PublicAccess:  vpcConfig.EndpointPublicAccess,
+		},
+	}
+	if publicAccessCIDRs := aws.StringValueSlice(vpcConfig.PublicAccessCidrs); !isPublicAccessFromAnywhere(publicAccessCIDRs) {
+		cfg.VPC.PublicAccessCIDRs = publicAccessCIDRs
+	}
+
+	var subnets []*ec2.Subnet
+	if len(vpcConfig.SubnetIds) > 0 {
+		output, err := d.ec2API.DescribeSubnets(&ec2.DescribeSubnetsInput{
+			SubnetIds: vpcConfig.SubnetIds,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe subnets of cluster %q", cfg.Metadata.Name)
+		}
+		subnets = output.Subnets
+	}
+
+	var public, private []*ec2.Subnet
+	for _, subnet := range subnets {
+		topology, ok := subnetTopologyFromTags(subnet)
+		if !ok {
+			topology = api.SubnetTopologyPrivate
+			if aws.BoolValue(subnet.MapPublicIpOnLaunch) {
+				topology = api.SubnetTopologyPublic
+			}
+			dumped.addUnrecovered("vpc.subnets: subnet %s has no ELB role tag, it was assumed to be %s because mapPublicIpOnLaunch is %t",
+				aws.StringValue(subnet.SubnetId), strings.ToLower(string(topology)), aws.BoolValue(subnet.MapPublicIpOnLaunch))
+		}
+		if topology == api.SubnetTopologyPublic {
+			public = append(public, subnet)
+		} else {
+			private = append(private, subnet)
+		}
+	}
+
+	if err := vpc.ImportSubnets(d.ec2API, cfg, api.SubnetTopologyPublic, public); err != nil {
+		return errors.Wrap(err, "failed to import public subnets")
+	}
+	if err := vpc.ImportSubnets(d.ec2API, cfg, api.SubnetTopologyPrivate, private); err != nil {
+		return errors.Wrap(err, "failed to import private subnets")
+	}
+
+	dumped.addUnrecovered("vpc: the VPC is referenced as an existing VPC; remove vpc.id and vpc.subnets to have eksctl create a new one")
+	return nil
+}
+
+func (d *ConfigDumper) dumpNodeGroups(dumped *DumpedConfig) error {
+	summaries, err := d.nodeGroups.GetUnmanagedNodeGroupSummaries("")
+	if err != nil {
+		return errors.Wrap(err, "failed to list nodegroups")
+	}
+
+	for _, summary := range summaries {
+		ng := &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				Name:         summary.Name,
+				InstanceType: summary.InstanceType,
+				AMI:          summary.ImageID,
+				ScalingConfig: &api.ScalingConfig{
+					DesiredCapacity: aws.Int(summary.DesiredCapacity),
+					MinSize:         aws.Int(summary.MinSize),
+					MaxSize:         aws.Int(summary.MaxSize),
+				},
+			},
+		}
+		dumped.ClusterConfig.NodeGroups = append(dumped.ClusterConfig.NodeGroups, ng)
+		dumped.addUnrecovered("nodeGroups[%s]: only the instance type, AMI and scaling config were recovered; volumes, SSH, IAM, labels, taints and bootstrap settings were not", summary.Name)
+	}
+	return nil
+}
+
+func (d *ConfigDumper) dumpManagedNodeGroups(dumped *DumpedConfig) error {
+	clusterName := dumped.ClusterConfig.Metadata.Name
+	var names []*string
+	err := d.eksAPI.ListNodegroupsPages(&awseks.ListNodegroupsInput{
+		ClusterName: &clusterName,
+	}, func(page *awseks.ListNodegroupsOutput, _ bool) bool {
+		names = append(names, page.Nodegroups...)
+		return true
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list managed nodegroups")
+	}
+
+	for _, name := range names {
+		describeOutput, err := d.eksAPI.DescribeNodegroup(&awseks.DescribeNodegroupInput{
+			ClusterName:   &clusterName,
+			NodegroupName: name,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to describe managed nodegroup %q", *name)
+		}
+		dumped.ClusterConfig.ManagedNodeGroups = append(dumped.ClusterConfig.ManagedNodeGroups, dumpManagedNodeGroup(dumped, describeOutput.Nodegroup))
+	}
+	return nil
+}
+
+func dumpManagedNodeGroup(dumped *DumpedConfig, nodeGroup *awseks.Nodegroup) *api.ManagedNodeGroup {
+	name := aws.StringValue(nodeGroup.NodegroupName)
+	ng := &api.ManagedNodeGroup{
+		NodeGroupBase: &api.NodeGroupBase{
+			Name:       name,
+			Labels:     withoutReservedTags(aws.StringValueMap(nodeGroup.Labels)),
+			Tags:       withoutReservedTags(aws.StringValueMap(nodeGroup.Tags)),
+			VolumeSize: intValue(nodeGroup.DiskSize),
+		},
+		Spot: aws.StringValue(nodeGroup.CapacityType) == awseks.CapacityTypesSpot,
+	}
+
+	if instanceTypes := aws.StringValueSlice(nodeGroup.InstanceTypes); len(instanceTypes) == 1 {
+		ng.InstanceType = instanceTypes[0]
+	} else {
+		ng.InstanceTypes = instanceTypes
+	}
+
+	if scalingConfig := nodeGroup.ScalingConfig; scalingConfig != nil {
+		ng.ScalingConfig = &api.ScalingConfig{
+			DesiredCapacity: intValue(scalingConfig.DesiredSize),
+			MinSize:         intValue(scalingConfig.MinSize),
+			MaxSize:         intValue(scalingConfig.MaxSize),
+		}
+	}
+
+	if updateConfig := nodeGroup.UpdateConfig; updateConfig != nil {
+		ng.UpdateConfig = &api.NodeGroupUpdateConfig{
+			MaxUnavailable:           intValue(updateConfig.MaxUnavailable),
+			MaxUnavailablePercentage: intValue(updateConfig.MaxUnavailablePercentage),
+		}
+	}
+
+	if remoteAccess := nodeGroup.RemoteAccess; remoteAccess != nil && remoteAccess.Ec2SshKey != nil {
+		ng.SSH = &api.NodeGroupSSH{
+			Allow:         api.Enabled(),
+			PublicKeyName: remoteAccess.Ec2SshKey,
+		}
+	}
+
+	for _, taint := range nodeGroup.Taints {
+		ng.Taints = append(ng.Taints, api.NodeGroupTaint{
+			Key:    aws.StringValue(taint.Key),
+			Value:  aws.StringValue(taint.Value),
+			Effect: taintEffect(aws.StringValue(taint.Effect)),
+		})
+	}
+
+	switch amiType := aws.StringValue(nodeGroup.AmiType); {
+	case strings.HasPrefix(amiType, "AL2_"):
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+	case strings.HasPrefix(amiType, "BOTTLEROCKET_"):
+		ng.AMIFamily = api.NodeImageFamilyBottlerocket
+	default:
+		dumped.addUnrecovered("managedNodeGroups[%s].amiFamily: the nodegroup uses AMI type %s", name, amiType)
+	}
+
+	if launchTemplate := nodeGroup.LaunchTemplate; launchTemplate != nil {
+		dumped.addUnrecovered("managedNodeGroups[%s]: the settings of launch template %s (e.g. volumes, SSH, security groups and user data) were not recovered",
+			name, aws.StringValue(launchTemplate.Name))
+	}
+	return ng
+}
+
+func (d *ConfigDumper) dumpAddons(dumped *DumpedConfig) error {
+	summaries, err := d.addons.GetAll()
+	if err != nil {
+		return errors.Wrap(err, "failed to list addons")
+	}
+
+	for _, summary := range summaries {
+		dumped.ClusterConfig.Addons = append(dumped.ClusterConfig.Addons, &api.Addon{
+			Name:                  summary.Name,
+			Version:               summary.Version,
+			ServiceAccountRoleARN: summary.IAMRole,
+		})
+	}
+	return nil
+}
+
+func (d *ConfigDumper) dumpIAMServiceAccounts(dumped *DumpedConfig) error {
+	serviceAccounts, err := d.serviceAccounts.Get(irsa.GetOptions{})
+	if err != nil {
+		return errors.Wrap(err, "failed to get IAM service accounts")
+	}
+
+	cfg := dumped.ClusterConfig
+	for _, sa := range serviceAccounts {
+		serviceAccount := &api.ClusterIAMServiceAccount{
+			ClusterIAMMeta: api.ClusterIAMMeta{
+				Name:      sa.Name,
+				Namespace: sa.Namespace,
+			},
+		}
+		if sa.Status != nil && sa.Status.RoleARN != nil {
+			serviceAccount.AttachRoleARN = *sa.Status.RoleARN
+		}
+		cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, serviceAccount)
+		dumped.addUnrecovered("iam.serviceAccounts[%s]: the existing role is attached as the policies and labels of the service account were not recovered", sa.NameString())
+	}
+
+	if len(cfg.IAM.ServiceAccounts) > 0 {
+		cfg.IAM.WithOIDC = api.Enabled()
+	} else {
+		dumped.addUnrecovered("iam.withOIDC: could not determine whether an IAM OIDC provider is associated with the cluster")
+	}
+	return nil
+}
+
+// withoutReservedTags returns the tags that were not set by eksctl or AWS
+func withoutReservedTags(tags map[string]string) map[string]string {
+	filtered := map[string]string{}
+	for k, v := range tags {
+		if !strings.HasPrefix(k, "alpha.eksctl.io/") && !strings.HasPrefix(k, "eksctl.cluster.k8s.io/") && !strings.HasPrefix(k, "aws:") {
+			filtered[k] = v
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+func subnetTopologyFromTags(subnet *ec2.Subnet) (api.SubnetTopology, bool) {
+	for _, tag := range subnet.Tags {
+		switch aws.StringValue(tag.Key) {
+		case "kubernetes.io/role/elb":
+			return api.SubnetTopologyPublic, true
+		case "kubernetes.io/role/internal-elb":
+			return api.SubnetTopologyPrivate, true
+		}
+	}
+	return "", false
+}
+
+func isPublicAccessFromAnywhere(cidrs []string) bool {
+	return len(cidrs) == 0 || (len(cidrs) == 1 && cidrs[0] == "0.0.0.0/0")
+}
+
+func taintEffect(effect string) corev1.TaintEffect {
+	switch effect {
+	case awseks.TaintEffectNoSchedule:
+		return corev1.TaintEffectNoSchedule
+	case awseks.TaintEffectPreferNoSchedule:
+		return corev1.TaintEffectPreferNoSchedule
+	case awseks.TaintEffectNoExecute:
+		return corev1.TaintEffectNoExecute
+	default:
+		return corev1.TaintEffect(effect)
+	}
+}
+
+func intValue(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	return aws.Int(int(*v))
+}
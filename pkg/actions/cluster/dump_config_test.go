@@ -0,0 +1,248 @@
+package cluster_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+type fakeUnmanagedNodeGroupLister struct {
+	summaries []*manager.NodeGroupSummary
+	err       error
+}
+
+func (f *fakeUnmanagedNodeGroupLister) GetUnmanagedNodeGroupSummaries(_ string) ([]*manager.NodeGroupSummary, error) {
+	return f.summaries, f.err
+}
+
+var _ = Describe("DumpConfig", func() {
+	var (
+		cfg             *api.ClusterConfig
+		p               *mockprovider.MockProvider
+		nodeGroups      *fakeUnmanagedNodeGroupLister
+		addons          *fakeAddonLister
+		serviceAccounts *fakeIAMServiceAccountGetter
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		p = mockprovider.NewMockProvider()
+
+		nodeGroups = &fakeUnmanagedNodeGroupLister{
+			summaries: []*manager.NodeGroupSummary{
+				{Name: "ng-1", DesiredCapacity: 2, MinSize: 1, MaxSize: 3, InstanceType: "m5.large", ImageID: "ami-123"},
+			},
+		}
+		addons = &fakeAddonLister{
+			summaries: []addon.Summary{
+				{Name: "vpc-cni", Version: "v1.7.5-eksbuild.1", IAMRole: "arn:aws:iam::123456789012:role/vpc-cni"},
+			},
+		}
+		roleARN := "arn:aws:iam::123456789012:role/s3-reader"
+		serviceAccounts = &fakeIAMServiceAccountGetter{
+			serviceAccounts: []*api.ClusterIAMServiceAccount{
+				{
+					ClusterIAMMeta: api.ClusterIAMMeta{Name: "s3-reader", Namespace: "default"},
+					Status:         &api.ClusterIAMServiceAccountStatus{RoleARN: &roleARN},
+				},
+			},
+		}
+
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+			Cluster: &awseks.Cluster{
+				Name:    aws.String("my-cluster"),
+				Version: aws.String("1.20"),
+				Tags: aws.StringMap(map[string]string{
+					"team":                          "platform",
+					api.ClusterNameTag:              "my-cluster",
+					"aws:cloudformation:stack-name": "eksctl-my-cluster-cluster",
+				}),
+				ResourcesVpcConfig: &awseks.VpcConfigResponse{
+					VpcId:                 aws.String("vpc-1"),
+					SubnetIds:             aws.StringSlice([]string{"subnet-public", "subnet-private", "subnet-untagged"}),
+					EndpointPrivateAccess: aws.Bool(true),
+					EndpointPublicAccess:  aws.Bool(true),
+					PublicAccessCidrs:     aws.StringSlice([]string{"1.2.3.4/32"}),
+				},
+				KubernetesNetworkConfig: &awseks.KubernetesNetworkConfigResponse{
+					ServiceIpv4Cidr: aws.String("172.20.0.0/16"),
+				},
+				Logging: &awseks.Logging{
+					ClusterLogging: []*awseks.LogSetup{
+						{Enabled: aws.Bool(true), Types: aws.StringSlice([]string{"api", "audit"})},
+						{Enabled: aws.Bool(false), Types: aws.StringSlice([]string{"scheduler"})},
+					},
+				},
+				EncryptionConfig: []*awseks.EncryptionConfig{
+					{Provider: &awseks.Provider{KeyArn: aws.String("arn:aws:kms:us-west-2:123456789012:key/1")}, Resources: aws.StringSlice([]string{"secrets"})},
+				},
+			},
+		}, nil)
+
+		subnet := func(id, az, cidr, roleTag string) *ec2.Subnet {
+			s := &ec2.Subnet{
+				SubnetId:         aws.String(id),
+				VpcId:            aws.String("vpc-1"),
+				AvailabilityZone: aws.String(az),
+				CidrBlock:        aws.String(cidr),
+			}
+			if roleTag != "" {
+				s.Tags = []*ec2.Tag{{Key: aws.String(roleTag), Value: aws.String("1")}}
+			}
+			return s
+		}
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				subnet("subnet-public", "us-west-2a", "192.168.0.0/19", "kubernetes.io/role/elb"),
+				subnet("subnet-private", "us-west-2a", "192.168.32.0/19", "kubernetes.io/role/internal-elb"),
+				subnet("subnet-untagged", "us-west-2b", "192.168.64.0/19", ""),
+			},
+		}, nil)
+		p.MockEC2().On("DescribeVpcs", mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("192.168.0.0/16")}},
+		}, nil)
+
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			pageFn := args[1].(func(*awseks.ListNodegroupsOutput, bool) bool)
+			pageFn(&awseks.ListNodegroupsOutput{
+				Nodegroups: aws.StringSlice([]string{"mng-1"}),
+			}, true)
+		}).Return(nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &awseks.Nodegroup{
+				NodegroupName: aws.String("mng-1"),
+				AmiType:       aws.String(awseks.AMITypesAl2Arm64),
+				CapacityType:  aws.String(awseks.CapacityTypesSpot),
+				InstanceTypes: aws.StringSlice([]string{"m6g.large", "m6g.xlarge"}),
+				DiskSize:      aws.Int64(50),
+				ScalingConfig: &awseks.NodegroupScalingConfig{
+					DesiredSize: aws.Int64(2),
+					MinSize:     aws.Int64(1),
+					MaxSize:     aws.Int64(4),
+				},
+				UpdateConfig: &awseks.NodegroupUpdateConfig{
+					MaxUnavailablePercentage: aws.Int64(25),
+				},
+				Labels: aws.StringMap(map[string]string{
+					"role":                 "worker",
+					api.NodeGroupNameLabel: "mng-1",
+				}),
+				Taints: []*awseks.Taint{
+					{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: aws.String(awseks.TaintEffectNoSchedule)},
+				},
+			},
+		}, nil)
+	})
+
+	dump := func() (*cluster.DumpedConfig, error) {
+		return cluster.NewConfigDumper(cfg, p.EKS(), p.EC2(), nodeGroups, addons, serviceAccounts).Dump()
+	}
+
+	It("reconstructs the config of the cluster", func() {
+		dumped, err := dump()
+		Expect(err).NotTo(HaveOccurred())
+
+		clusterConfig := dumped.ClusterConfig
+		Expect(clusterConfig.Metadata.Name).To(Equal("my-cluster"))
+		Expect(clusterConfig.Metadata.Region).To(Equal("us-west-2"))
+		Expect(clusterConfig.Metadata.Version).To(Equal("1.20"))
+		Expect(clusterConfig.Metadata.Tags).To(Equal(map[string]string{"team": "platform"}))
+		Expect(clusterConfig.KubernetesNetworkConfig.ServiceIPv4CIDR).To(Equal("172.20.0.0/16"))
+		Expect(clusterConfig.CloudWatch.ClusterLogging.EnableTypes).To(ConsistOf("api", "audit"))
+		Expect(clusterConfig.SecretsEncryption.KeyARN).To(Equal("arn:aws:kms:us-west-2:123456789012:key/1"))
+		Expect(clusterConfig.SecretsEncryption.Resources).To(Equal([]string{"secrets"}))
+
+		Expect(clusterConfig.VPC.ID).To(Equal("vpc-1"))
+		Expect(clusterConfig.VPC.CIDR.String()).To(Equal("192.168.0.0/16"))
+		Expect(clusterConfig.VPC.PublicAccessCIDRs).To(ConsistOf("1.2.3.4/32"))
+		Expect(*clusterConfig.VPC.ClusterEndpoints.PrivateAccess).To(BeTrue())
+		Expect(clusterConfig.VPC.Subnets.Public).To(HaveKeyWithValue("us-west-2a", api.AZSubnetSpec{
+			ID: "subnet-public", AZ: "us-west-2a", CIDR: clusterConfig.VPC.Subnets.Public["us-west-2a"].CIDR,
+		}))
+		Expect(clusterConfig.VPC.Subnets.Private.WithIDs()).To(ConsistOf("subnet-private", "subnet-untagged"))
+
+		Expect(clusterConfig.NodeGroups).To(HaveLen(1))
+		ng := clusterConfig.NodeGroups[0]
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.InstanceType).To(Equal("m5.large"))
+		Expect(ng.AMI).To(Equal("ami-123"))
+		Expect(*ng.DesiredCapacity).To(Equal(2))
+
+		Expect(clusterConfig.ManagedNodeGroups).To(HaveLen(1))
+		mng := clusterConfig.ManagedNodeGroups[0]
+		Expect(mng.Name).To(Equal("mng-1"))
+		Expect(mng.AMIFamily).To(Equal(api.NodeImageFamilyAmazonLinux2))
+		Expect(mng.Spot).To(BeTrue())
+		Expect(mng.InstanceTypes).To(ConsistOf("m6g.large", "m6g.xlarge"))
+		Expect(*mng.VolumeSize).To(Equal(50))
+		Expect(*mng.MaxSize).To(Equal(4))
+		Expect(*mng.UpdateConfig.MaxUnavailablePercentage).To(Equal(25))
+		Expect(mng.Labels).To(Equal(map[string]string{"role": "worker"}))
+		Expect(mng.Taints).To(ConsistOf(api.NodeGroupTaint{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule}))
+
+		Expect(clusterConfig.Addons).To(ConsistOf(&api.Addon{
+			Name:                  "vpc-cni",
+			Version:               "v1.7.5-eksbuild.1",
+			ServiceAccountRoleARN: "arn:aws:iam::123456789012:role/vpc-cni",
+		}))
+		Expect(api.IsEnabled(clusterConfig.IAM.WithOIDC)).To(BeTrue())
+		Expect(clusterConfig.IAM.ServiceAccounts).To(HaveLen(1))
+		Expect(clusterConfig.IAM.ServiceAccounts[0].AttachRoleARN).To(Equal("arn:aws:iam::123456789012:role/s3-reader"))
+	})
+
+	It("annotates the fields that could not be fully recovered", func() {
+		dumped, err := dump()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dumped.Unrecovered).To(ContainElements(
+			"vpc.subnets: subnet subnet-untagged has no ELB role tag, it was assumed to be private because mapPublicIpOnLaunch is false",
+			"nodeGroups[ng-1]: only the instance type, AMI and scaling config were recovered; volumes, SSH, IAM, labels, taints and bootstrap settings were not",
+			"iam.serviceAccounts[default/s3-reader]: the existing role is attached as the policies and labels of the service account were not recovered",
+		))
+	})
+
+	It("produces a valid config", func() {
+		dumped, err := dump()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(api.Register()).To(Succeed())
+		data, err := yaml.Marshal(dumped.ClusterConfig)
+		Expect(err).NotTo(HaveOccurred())
+		clusterConfig, err := eks.ParseConfig(data)
+		Expect(err).NotTo(HaveOccurred())
+
+		api.SetClusterConfigDefaults(clusterConfig)
+		Expect(api.ValidateClusterConfig(clusterConfig)).To(Succeed())
+		for i, ng := range clusterConfig.NodeGroups {
+			api.SetNodeGroupDefaults(ng, clusterConfig.Metadata)
+			Expect(api.ValidateNodeGroup(i, ng)).To(Succeed())
+		}
+		for i, ng := range clusterConfig.ManagedNodeGroups {
+			api.SetManagedNodeGroupDefaults(ng, clusterConfig.Metadata)
+			Expect(api.ValidateManagedNodeGroup(ng, i)).To(Succeed())
+		}
+	})
+
+	It("fails if the cluster cannot be described", func() {
+		p = mockprovider.NewMockProvider()
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(nil, errors.New("not found"))
+
+		_, err := dump()
+		Expect(err).To(MatchError(`failed to describe cluster "my-cluster": not found`))
+	})
+})
@@ -66,7 +66,7 @@ func (c *OwnedCluster) Upgrade(dryRun bool) error {
 	return nil
 }
 
-func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
+func (c *OwnedCluster) Delete(_ time.Duration, wait, force, retainVPC bool) error {
 	var (
 		clientSet kubernetes.Interface
 		oidc      *iamoidc.OpenIDConnectManager
@@ -112,6 +112,12 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 		}
 	}
 
+	if retainVPC {
+		if err := c.stackManager.RetainClusterStackVPC(); err != nil {
+			return errors.Wrap(err, "retaining the VPC of the cluster")
+		}
+	}
+
 	deleteOIDCProvider := clusterOperable && oidcSupported
 	tasks, err := c.stackManager.NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider, oidc, kubernetes.NewCachedClientSet(clientSet), wait, func(errs chan error, _ string) error {
 		logger.Info("trying to cleanup dangling network interfaces")
@@ -144,7 +150,11 @@ func (c *OwnedCluster) Delete(_ time.Duration, wait, force bool) error {
 		return err
 	}
 
-	logger.Success("all cluster resources were deleted")
+	if retainVPC {
+		logger.Success("all cluster resources were deleted, except for the retained VPC resources")
+	} else {
+		logger.Success("all cluster resources were deleted")
+	}
 
 	return gitops.DeleteKey(c.cfg)
 }
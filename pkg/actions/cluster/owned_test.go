@@ -1,6 +1,7 @@
 package cluster_test
 
 import (
+	"errors"
 	"time"
 
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
@@ -24,6 +25,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -98,7 +100,7 @@ var _ = Describe("Delete", func() {
 				return fakeClientSet, nil
 			})
 
-			err := c.Delete(time.Microsecond, false, false)
+			err := c.Delete(time.Microsecond, false, false, false)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
@@ -107,6 +109,55 @@ var _ = Describe("Delete", func() {
 		})
 	})
 
+	Context("when the VPC is retained", func() {
+		It("updates the cluster stack to retain the VPC before deleting the stacks", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusFailed),
+			}, nil)
+			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{}, nil)
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroupsWithContext", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+
+			retainedVPC := false
+			fakeStackManager.RetainClusterStackVPCStub = func() error {
+				retainedVPC = true
+				return nil
+			}
+			fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsStub = func(bool, *iamoidc.OpenIDConnectManager, kubernetes.ClientSetGetter, bool, func(chan error, string) error) (*tasks.TaskTree, error) {
+				Expect(retainedVPC).To(BeTrue())
+				return &tasks.TaskTree{
+					Tasks: []tasks.Task{&tasks.GenericTask{Doer: func() error {
+						ranDeleteClusterTasks = true
+						return nil
+					}}},
+				}, nil
+			}
+
+			c := cluster.NewOwnedCluster(cfg, ctl, fakeStackManager)
+
+			err := c.Delete(time.Microsecond, false, false, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeStackManager.RetainClusterStackVPCCallCount()).To(Equal(1))
+			Expect(ranDeleteClusterTasks).To(BeTrue())
+		})
+
+		It("does not delete any stack if the VPC cannot be retained", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: testutils.NewFakeCluster(clusterName, awseks.ClusterStatusFailed),
+			}, nil)
+			fakeStackManager.DeleteTasksForDeprecatedStacksReturns(&tasks.TaskTree{}, nil)
+			p.MockEC2().On("DescribeKeyPairs", mock.Anything).Return(&ec2.DescribeKeyPairsOutput{}, nil)
+			p.MockEC2().On("DescribeSecurityGroupsWithContext", mock.Anything, mock.Anything).Return(&ec2.DescribeSecurityGroupsOutput{}, nil)
+			fakeStackManager.RetainClusterStackVPCReturns(errors.New("stack update failed"))
+
+			c := cluster.NewOwnedCluster(cfg, ctl, fakeStackManager)
+
+			err := c.Delete(time.Microsecond, false, false, true)
+			Expect(err).To(MatchError("retaining the VPC of the cluster: stack update failed"))
+			Expect(fakeStackManager.NewTasksToDeleteClusterWithNodeGroupsCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when the cluster is inoperable", func() {
 		It("deletes the cluster without trying to query kubernetes", func() {
 			//mocks are in order of being called
@@ -137,7 +188,7 @@ var _ = Describe("Delete", func() {
 
 			c := cluster.NewOwnedCluster(cfg, ctl, fakeStackManager)
 
-			err := c.Delete(time.Microsecond, false, false)
+			err := c.Delete(time.Microsecond, false, false, false)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(fakeStackManager.DeleteTasksForDeprecatedStacksCallCount()).To(Equal(1))
 			Expect(ranDeleteDeprecatedTasks).To(BeTrue())
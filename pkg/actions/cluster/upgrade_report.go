@@ -0,0 +1,320 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// UpgradeReport is a record of an upgrade of a cluster, meant to be archived
+type UpgradeReport struct {
+	Cluster       string
+	Region        string
+	Plan          bool
+	StartedAt     time.Time
+	Duration      string
+	ControlPlane  ControlPlaneUpgrade
+	DefaultAddons []DefaultAddonUpgrade
+	Addons        []AddonUpgrade
+	NodeGroups    []NodeGroupUpgrade
+	Warnings      []string `json:",omitempty"`
+	Error         string   `json:",omitempty"`
+}
+
+// ControlPlaneUpgrade records the versions of the control plane before and after the upgrade
+type ControlPlaneUpgrade struct {
+	PreviousVersion string
+	Version         string
+	Upgraded        bool
+}
+
+// DefaultAddonUpgrade records the version of a default addon before and after the upgrade, and
+// whether it was up-to-date with the control plane version at each point
+type DefaultAddonUpgrade struct {
+	Name           string
+	VersionBefore  string
+	VersionAfter   string
+	UpToDateBefore bool
+	UpToDateAfter  bool
+}
+
+// AddonUpgrade records the version of an EKS managed addon before and after the upgrade
+type AddonUpgrade struct {
+	Name          string
+	VersionBefore string
+	VersionAfter  string
+	NewerVersion  string `json:",omitempty"`
+}
+
+// NodeGroupUpgrade records the version of a nodegroup after the upgrade. Nodegroups are not
+// upgraded along with the control plane, RequiresUpgrade is set for those that are behind it
+type NodeGroupUpgrade struct {
+	Name            string
+	Version         string
+	RequiresUpgrade bool
+}
+
+type defaultAddonState struct {
+	version  string
+	upToDate bool
+	checked  bool
+}
+
+// UpgradeReporter records the state of a cluster before and after an upgrade into an UpgradeReport.
+// Failing to record a section is not fatal, the failure is added to the warnings of the report, along
+// with the warnings logged between Start and Finish
+type UpgradeReporter struct {
+	cfg        *api.ClusterConfig
+	nodeGroups NodeGroupLister
+	addons     AddonLister
+	rawClient  KubernetesClient
+
+	report              *UpgradeReport
+	startedAt           time.Time
+	defaultAddonsBefore map[string]defaultAddonState
+	addonsBefore        map[string]string
+
+	mu sync.Mutex
+	// added counts the warnings added with Warning that have yet to be logged
+	added         map[string]int
+	restoreLogger func()
+}
+
+// NewUpgradeReporter creates a new UpgradeReporter
+func NewUpgradeReporter(cfg *api.ClusterConfig, nodeGroups NodeGroupLister, addons AddonLister, rawClient KubernetesClient) *UpgradeReporter {
+	return &UpgradeReporter{
+		cfg:        cfg,
+		nodeGroups: nodeGroups,
+		addons:     addons,
+		rawClient:  rawClient,
+		report: &UpgradeReport{
+			Cluster: cfg.Metadata.Name,
+			Region:  cfg.Metadata.Region,
+		},
+	}
+}
+
+// Warning logs a non-fatal warning and adds it to the report
+func (r *UpgradeReporter) Warning(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	r.mu.Lock()
+	r.report.Warnings = append(r.report.Warnings, message)
+	if r.added != nil {
+		r.added[message]++
+	}
+	r.mu.Unlock()
+	logger.Warning(format, args...)
+}
+
+// captureWarnings adds the warnings logged until Finish to the report
+func (r *UpgradeReporter) captureWarnings() {
+	line := logger.Line
+	r.added = map[string]int{}
+	r.restoreLogger = func() { logger.Line = line }
+	logger.Line = func(prefix, format string, a ...interface{}) string {
+		if prefix == logger.PreWarning {
+			message := strings.TrimSuffix(fmt.Sprintf(format, a...), "\n")
+			r.mu.Lock()
+			if r.added[message] > 0 {
+				r.added[message]--
+			} else {
+				r.report.Warnings = append(r.report.Warnings, message)
+			}
+			r.mu.Unlock()
+		}
+		return line(prefix, format, a...)
+	}
+}
+
+// Start records the state of the cluster before the upgrade
+func (r *UpgradeReporter) Start(controlPlaneVersion string, plan bool) {
+	r.startedAt = time.Now()
+	r.report.StartedAt = r.startedAt.UTC()
+	r.report.Plan = plan
+	r.report.ControlPlane.PreviousVersion = controlPlaneVersion
+	r.captureWarnings()
+
+	r.defaultAddonsBefore = r.defaultAddonStates("before")
+	r.addonsBefore = map[string]string{}
+	summaries, err := r.addons.GetAll()
+	if err != nil {
+		r.Warning("failed to get addons before the upgrade: %v", err)
+		return
+	}
+	for _, summary := range summaries {
+		r.addonsBefore[summary.Name] = summary.Version
+	}
+}
+
+// Finish records the state of the cluster after the upgrade and returns the report; upgradeErr is
+// the error the upgrade failed with, if any
+func (r *UpgradeReporter) Finish(controlPlaneVersion string, upgradeErr error) *UpgradeReport {
+	if r.restoreLogger != nil {
+		defer r.restoreLogger()
+	}
+	report := r.report
+	report.Duration = time.Since(r.startedAt).Round(time.Second).String()
+	if upgradeErr != nil {
+		report.Error = upgradeErr.Error()
+	}
+
+	report.ControlPlane.Version = controlPlaneVersion
+	report.ControlPlane.Upgraded = !report.Plan && controlPlaneVersion != report.ControlPlane.PreviousVersion
+
+	defaultAddonsAfter := r.defaultAddonStates("after")
+	for _, name := range r.defaultAddonNames() {
+		before, after := r.defaultAddonsBefore[name], defaultAddonsAfter[name]
+		report.DefaultAddons = append(report.DefaultAddons, DefaultAddonUpgrade{
+			Name:           name,
+			VersionBefore:  before.version,
+			VersionAfter:   after.version,
+			UpToDateBefore: before.upToDate,
+			UpToDateAfter:  after.upToDate,
+		})
+		if after.checked && !after.upToDate {
+			r.Warning("%s is not up-to-date with control plane version %q", name, controlPlaneVersion)
+		}
+	}
+
+	if summaries, err := r.addons.GetAll(); err != nil {
+		r.Warning("failed to get addons after the upgrade: %v", err)
+	} else {
+		for _, summary := range summaries {
+			report.Addons = append(report.Addons, AddonUpgrade{
+				Name:          summary.Name,
+				VersionBefore: r.addonsBefore[summary.Name],
+				VersionAfter:  summary.Version,
+				NewerVersion:  summary.NewerVersion,
+			})
+		}
+	}
+
+	if summaries, err := r.nodeGroups.GetAll(); err != nil {
+		r.Warning("failed to get nodegroups after the upgrade: %v", err)
+	} else {
+		for _, summary := range summaries {
+			requiresUpgrade := summary.Version != "" && summary.Version != controlPlaneVersion
+			report.NodeGroups = append(report.NodeGroups, NodeGroupUpgrade{
+				Name:            summary.Name,
+				Version:         summary.Version,
+				RequiresUpgrade: requiresUpgrade,
+			})
+			if requiresUpgrade {
+				r.Warning("nodegroup %q is at version %q, it needs to be upgraded to control plane version %q", summary.Name, summary.Version, controlPlaneVersion)
+			}
+		}
+	}
+
+	return report
+}
+
+func (r *UpgradeReporter) defaultAddonNames() []string {
+	var names []string
+	for _, addon := range defaultaddons.NewDefaultAddons(defaultaddons.AddonInput{ClusterConfig: r.cfg}) {
+		names = append(names, addon.Name())
+	}
+	return names
+}
+
+// defaultAddonStates returns the states of the default addons, the failures to check them are
+// added to the warnings. The addons are checked against the version of the API server, as the
+// checks require its patch version
+func (r *UpgradeReporter) defaultAddonStates(when string) map[string]defaultAddonState {
+	states := map[string]defaultAddonState{}
+	controlPlaneVersion, err := r.rawClient.ServerVersion()
+	if err != nil {
+		r.Warning("failed to check the default addons %s the upgrade: %v", when, err)
+		return states
+	}
+	for _, addon := range defaultaddons.NewDefaultAddons(defaultaddons.AddonInput{
+		RawClient:           r.rawClient,
+		ControlPlaneVersion: controlPlaneVersion,
+		ClusterConfig:       r.cfg,
+	}) {
+		var state defaultAddonState
+		var err error
+		if state.version, err = r.defaultAddonVersion(addon.Name()); err != nil {
+			r.Warning("failed to get the version of %s %s the upgrade: %v", addon.Name(), when, err)
+		}
+		if state.upToDate, err = addon.IsUpToDate(); err != nil {
+			r.Warning("failed to check %s %s the upgrade: %v", addon.Name(), when, err)
+		} else {
+			state.checked = true
+		}
+		states[addon.Name()] = state
+	}
+	return states
+}
+
+// defaultAddonVersion returns the image tag of the DaemonSet or Deployment of a default addon,
+// which are named after the addon, or an empty string if it has neither
+func (r *UpgradeReporter) defaultAddonVersion(name string) (string, error) {
+	apps := r.rawClient.ClientSet().AppsV1()
+	var containers []string
+	daemonSet, err := apps.DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), name, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		for _, container := range daemonSet.Spec.Template.Spec.Containers {
+			containers = append(containers, container.Image)
+		}
+	case apierrors.IsNotFound(err):
+		deployment, err := apps.Deployments(metav1.NamespaceSystem).Get(context.TODO(), name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			containers = append(containers, container.Image)
+		}
+	default:
+		return "", err
+	}
+	if len(containers) == 0 {
+		return "", nil
+	}
+	return addons.ImageTag(containers[0])
+}
+
+// WriteUpgradeReport writes report to path, as YAML if path has a .yaml or .yml extension and as
+// JSON otherwise
+func WriteUpgradeReport(report *UpgradeReport, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "creating upgrade report")
+	}
+	defer file.Close()
+
+	printer, err := printers.NewPrinter(upgradeReportFormat(path))
+	if err != nil {
+		return err
+	}
+	if err := printer.PrintObj(report, file); err != nil {
+		return errors.Wrapf(err, "writing upgrade report to %q", path)
+	}
+	return nil
+}
+
+func upgradeReportFormat(path string) printers.Type {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return printers.YAMLType
+	default:
+		return printers.JSONType
+	}
+}
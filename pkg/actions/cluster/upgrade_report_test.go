@@ -0,0 +1,203 @@
+package cluster_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+
+	"github.com/kris-nova/logger"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("UpgradeReporter", func() {
+	var (
+		cfg        *api.ClusterConfig
+		rawClient  *fakeKubernetesClient
+		nodeGroups *fakeNodeGroupLister
+		addons     *fakeAddonLister
+		reporter   *cluster.UpgradeReporter
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "eu-west-1"
+
+		rawClient = &fakeKubernetesClient{
+			FakeRawClient: testutils.NewFakeRawClient(),
+			serverVersion: "1.18.9",
+		}
+		rawClient.UseUnionTracker = true
+		for _, item := range testutils.LoadSamples("../../addons/default/testdata/sample-1.15.json") {
+			rc, err := rawClient.NewRawResource(item)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rc.CreateOrReplace(false)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		nodeGroups = &fakeNodeGroupLister{
+			summaries: []*manager.NodeGroupSummary{
+				{Name: "ng-1", Version: "1.18"},
+				{Name: "ng-2", Version: "1.19"},
+			},
+		}
+		addons = &fakeAddonLister{
+			summaries: []addon.Summary{
+				{Name: "vpc-cni", Version: "v1.7.5-eksbuild.1", NewerVersion: "v1.7.10-eksbuild.1"},
+			},
+		}
+		reporter = cluster.NewUpgradeReporter(cfg, nodeGroups, addons, rawClient)
+	})
+
+	It("captures each section of the upgrade", func() {
+		reporter.Start("1.18", false)
+		addons.summaries[0].Version = "v1.7.10-eksbuild.1"
+		addons.summaries[0].NewerVersion = ""
+		rawClient.serverVersion = "1.19.6"
+		report := reporter.Finish("1.19", nil)
+
+		Expect(report.Cluster).To(Equal("my-cluster"))
+		Expect(report.Region).To(Equal("eu-west-1"))
+		Expect(report.StartedAt).NotTo(BeZero())
+		Expect(report.Duration).NotTo(BeEmpty())
+		Expect(report.Error).To(BeEmpty())
+
+		Expect(report.ControlPlane.PreviousVersion).To(Equal("1.18"))
+		Expect(report.ControlPlane.Version).To(Equal("1.19"))
+		Expect(report.ControlPlane.Upgraded).To(BeTrue())
+
+		Expect(report.DefaultAddons).To(ConsistOf(
+			cluster.DefaultAddonUpgrade{Name: "kube-proxy", VersionBefore: "v1.15.11", VersionAfter: "v1.15.11"},
+			cluster.DefaultAddonUpgrade{Name: "aws-node", VersionBefore: "v1.5.7", VersionAfter: "v1.5.7"},
+			cluster.DefaultAddonUpgrade{Name: "coredns", VersionBefore: "v1.6.6", VersionAfter: "v1.6.6"},
+		))
+
+		Expect(report.Addons).To(ConsistOf(cluster.AddonUpgrade{
+			Name:          "vpc-cni",
+			VersionBefore: "v1.7.5-eksbuild.1",
+			VersionAfter:  "v1.7.10-eksbuild.1",
+		}))
+
+		Expect(report.NodeGroups).To(ConsistOf(
+			cluster.NodeGroupUpgrade{Name: "ng-1", Version: "1.18", RequiresUpgrade: true},
+			cluster.NodeGroupUpgrade{Name: "ng-2", Version: "1.19", RequiresUpgrade: false},
+		))
+
+		Expect(report.Warnings).To(ContainElements(
+			`kube-proxy is not up-to-date with control plane version "1.19"`,
+			`nodegroup "ng-1" is at version "1.18", it needs to be upgraded to control plane version "1.19"`,
+		))
+	})
+
+	It("does not report the control plane as upgraded in plan mode", func() {
+		reporter.Start("1.18", true)
+		report := reporter.Finish("1.18", nil)
+
+		Expect(report.Plan).To(BeTrue())
+		Expect(report.ControlPlane.Upgraded).To(BeFalse())
+		Expect(report.ControlPlane.Version).To(Equal("1.18"))
+	})
+
+	It("records the sections that could not be captured and the upgrade error", func() {
+		addons.err = errors.New("access denied")
+		nodeGroups.err = errors.New("throttled")
+		rawClient.err = errors.New("connection refused")
+
+		reporter.Start("1.18", false)
+		reporter.Warning("the cluster stack could not be updated")
+		report := reporter.Finish("1.18", errors.New("timed out waiting for the control plane"))
+
+		Expect(report.Error).To(Equal("timed out waiting for the control plane"))
+		Expect(report.ControlPlane.Upgraded).To(BeFalse())
+		Expect(report.Addons).To(BeEmpty())
+		Expect(report.NodeGroups).To(BeEmpty())
+		Expect(report.DefaultAddons).To(ConsistOf(
+			cluster.DefaultAddonUpgrade{Name: "kube-proxy"},
+			cluster.DefaultAddonUpgrade{Name: "aws-node"},
+			cluster.DefaultAddonUpgrade{Name: "coredns"},
+		))
+		Expect(report.Warnings).To(ContainElements(
+			"failed to check the default addons before the upgrade: connection refused",
+			"failed to get addons before the upgrade: access denied",
+			"the cluster stack could not be updated",
+			"failed to get addons after the upgrade: access denied",
+			"failed to get nodegroups after the upgrade: throttled",
+		))
+	})
+
+	It("records the warnings logged during the upgrade", func() {
+		line := logger.Line
+		reporter.Start("1.18", false)
+		logger.Warning("nodegroup %q has no stack", "ng-3")
+		reporter.Warning("the cluster stack could not be updated")
+		report := reporter.Finish("1.18", nil)
+		logger.Warning("logged after the upgrade")
+
+		Expect(report.Warnings).To(ContainElement(`nodegroup "ng-3" has no stack`))
+		Expect(report.Warnings).NotTo(ContainElement("logged after the upgrade"))
+		count := 0
+		for _, warning := range report.Warnings {
+			if warning == "the cluster stack could not be updated" {
+				count++
+			}
+		}
+		Expect(count).To(Equal(1))
+		Expect(reflect.ValueOf(logger.Line).Pointer()).To(Equal(reflect.ValueOf(line).Pointer()))
+	})
+
+	Describe("WriteUpgradeReport", func() {
+		var (
+			dir    string
+			report *cluster.UpgradeReport
+		)
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "upgrade-report")
+			Expect(err).NotTo(HaveOccurred())
+
+			reporter.Start("1.18", false)
+			report = reporter.Finish("1.19", nil)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		readReport := func(path string) (*cluster.UpgradeReport, []byte) {
+			data, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			written := &cluster.UpgradeReport{}
+			Expect(yaml.Unmarshal(data, written)).To(Succeed())
+			return written, data
+		}
+
+		It("writes the report as YAML to a .yaml file", func() {
+			path := filepath.Join(dir, "report.yaml")
+			Expect(cluster.WriteUpgradeReport(report, path)).To(Succeed())
+
+			written, data := readReport(path)
+			Expect(string(data)).To(ContainSubstring("PreviousVersion: \"1.18\""))
+			Expect(written.NodeGroups).To(Equal(report.NodeGroups))
+		})
+
+		It("writes the report as JSON otherwise", func() {
+			path := filepath.Join(dir, "report.json")
+			Expect(cluster.WriteUpgradeReport(report, path)).To(Succeed())
+
+			written, data := readReport(path)
+			Expect(string(data)).To(HavePrefix("{"))
+			Expect(written.ControlPlane).To(Equal(report.ControlPlane))
+			Expect(written.Warnings).To(Equal(report.Warnings))
+		})
+	})
+})
@@ -0,0 +1,243 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// ConfigProblem is a problem found while validating a ClusterConfig against AWS
+type ConfigProblem struct {
+	// Category is the kind of validation that failed, e.g. availabilityZones or amis
+	Category string
+	Err      error
+}
+
+func (p ConfigProblem) String() string {
+	return fmt.Sprintf("%s: %v", p.Category, p.Err)
+}
+
+// ConfigValidator validates a ClusterConfig against the AWS account it would be created in,
+// using only read-only API calls and without modifying the given config
+type ConfigValidator struct {
+	cfg      *api.ClusterConfig
+	provider api.ClusterProvider
+}
+
+// NewConfigValidator returns a new ConfigValidator
+func NewConfigValidator(cfg *api.ClusterConfig, provider api.ClusterProvider) *ConfigValidator {
+	return &ConfigValidator{
+		cfg:      cfg.DeepCopy(),
+		provider: provider,
+	}
+}
+
+// Validate runs all validations and returns the problems that were found
+func (v *ConfigValidator) Validate() []ConfigProblem {
+	if v.cfg.Metadata.Version == "" || v.cfg.Metadata.Version == "auto" {
+		v.cfg.Metadata.Version = api.DefaultVersion
+	}
+
+	var problems []ConfigProblem
+	for _, validation := range []struct {
+		category string
+		validate func() []error
+	}{
+		{"config", v.validateFeatures},
+		{"availabilityZones", v.validateAvailabilityZones},
+		{"instanceTypes", v.validateInstanceTypes},
+		{"amis", v.validateAMIs},
+		{"vpc", v.validateVPC},
+	} {
+		for _, err := range validation.validate() {
+			problems = append(problems, ConfigProblem{Category: validation.category, Err: err})
+		}
+	}
+	return problems
+}
+
+func (v *ConfigValidator) validateFeatures() []error {
+	var errs []error
+	if !api.IsSupportedVersion(v.cfg.Metadata.Version) {
+		errs = append(errs, fmt.Errorf("unsupported Kubernetes version %s", v.cfg.Metadata.Version))
+	}
+	if err := v.cfg.ValidatePrivateCluster(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.cfg.ValidateClusterEndpointConfig(); err != nil {
+		errs = append(errs, err)
+	}
+
+	var kubeNodeGroups []eks.KubeNodeGroup
+	for _, ng := range v.cfg.NodeGroups {
+		kubeNodeGroups = append(kubeNodeGroups, ng)
+	}
+	for _, ng := range v.cfg.ManagedNodeGroups {
+		kubeNodeGroups = append(kubeNodeGroups, ng)
+	}
+	if err := eks.ValidateFeatureCompatibility(v.cfg, kubeNodeGroups); err != nil {
+		errs = append(errs, err)
+	}
+	if err := iam.ValidateInstanceProfiles(v.provider.IAM(), v.cfg.NodeGroups, api.IsEnabled(v.cfg.IAM.WithOIDC)); err != nil {
+		errs = append(errs, err)
+	}
+	if err := iam.ValidateServiceRole(v.provider.IAM(), v.cfg.IAM); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (v *ConfigValidator) validateAvailabilityZones() []error {
+	zones := map[string][]string{}
+	for _, zone := range v.cfg.AvailabilityZones {
+		zones[zone] = append(zones[zone], "availabilityZones")
+	}
+	for _, np := range v.nodePools() {
+		ng := np.BaseNodeGroup()
+		for _, zone := range ng.AvailabilityZones {
+			zones[zone] = append(zones[zone], fmt.Sprintf("nodegroup %q", ng.Name))
+		}
+	}
+	if len(zones) == 0 {
+		return nil
+	}
+
+	output, err := v.provider.EC2().DescribeAvailabilityZones(&ec2.DescribeAvailabilityZonesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("region-name"),
+				Values: aws.StringSlice([]string{v.provider.Region()}),
+			},
+		},
+	})
+	if err != nil {
+		return []error{errors.Wrapf(err, "getting availability zones for %s", v.provider.Region())}
+	}
+
+	available := sets.NewString()
+	for _, zone := range output.AvailabilityZones {
+		if aws.StringValue(zone.State) == ec2.AvailabilityZoneStateAvailable {
+			available.Insert(aws.StringValue(zone.ZoneName))
+		}
+	}
+
+	var errs []error
+	for _, zone := range sets.StringKeySet(zones).List() {
+		if !available.Has(zone) {
+			errs = append(errs, fmt.Errorf("availability zone %q used by %v is not available in region %s", zone, zones[zone], v.provider.Region()))
+		}
+	}
+	return errs
+}
+
+func (v *ConfigValidator) validateInstanceTypes() []error {
+	instanceTypes := map[string][]string{}
+	addInstanceType := func(ng *api.NodeGroupBase, instanceType string) {
+		if instanceType != "" && instanceType != "mixed" {
+			instanceTypes[instanceType] = append(instanceTypes[instanceType], ng.Name)
+		}
+	}
+	for _, ng := range v.cfg.NodeGroups {
+		addInstanceType(ng.NodeGroupBase, ng.InstanceType)
+		if ng.InstancesDistribution != nil {
+			for _, instanceType := range ng.InstancesDistribution.InstanceTypes {
+				addInstanceType(ng.NodeGroupBase, instanceType)
+			}
+		}
+	}
+	for _, ng := range v.cfg.ManagedNodeGroups {
+		addInstanceType(ng.NodeGroupBase, ng.InstanceType)
+		for _, instanceType := range ng.InstanceTypes {
+			addInstanceType(ng.NodeGroupBase, instanceType)
+		}
+	}
+	if len(instanceTypes) == 0 {
+		return nil
+	}
+
+	names := sets.StringKeySet(instanceTypes).List()
+	offered := sets.NewString()
+	err := v.provider.EC2().DescribeInstanceTypeOfferingsPages(&ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeRegion),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: aws.StringSlice(names),
+			},
+		},
+	}, func(output *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range output.InstanceTypeOfferings {
+			offered.Insert(aws.StringValue(offering.InstanceType))
+		}
+		return true
+	})
+	if err != nil {
+		return []error{errors.Wrapf(err, "describing instance type offerings in %s", v.provider.Region())}
+	}
+
+	var errs []error
+	for _, instanceType := range names {
+		if !offered.Has(instanceType) {
+			errs = append(errs, fmt.Errorf("instance type %q used by nodegroups %v is not offered in region %s", instanceType, instanceTypes[instanceType], v.provider.Region()))
+		}
+	}
+	return errs
+}
+
+func (v *ConfigValidator) validateAMIs() []error {
+	var errs []error
+	for _, np := range v.nodePools() {
+		ng := np.BaseNodeGroup()
+		if managed, ok := np.(*api.ManagedNodeGroup); ok && managed.AMIFamily == api.NodeImageFamilyAmazonLinux2 && !api.IsAMI(managed.AMI) {
+			// EKS picks the AMI for AmazonLinux2 managed nodegroups
+			continue
+		}
+		if !api.IsAMI(ng.AMI) {
+			if err := eks.ResolveAMI(v.provider, v.cfg.Metadata.Version, np); err != nil {
+				errs = append(errs, errors.Wrapf(err, "nodegroup %q", ng.Name))
+				continue
+			}
+		}
+		if err := ami.Use(v.provider.EC2(), ng); err != nil {
+			errs = append(errs, errors.Wrapf(err, "nodegroup %q", ng.Name))
+		}
+	}
+	return errs
+}
+
+func (v *ConfigValidator) validateVPC() []error {
+	if !v.cfg.HasAnySubnets() {
+		return nil
+	}
+	if err := vpc.ImportSubnetsFromSpec(v.provider, v.cfg); err != nil {
+		return []error{err}
+	}
+	var errs []error
+	if err := v.cfg.HasSufficientSubnets(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.cfg.CanUseForPrivateNodeGroups(); err != nil {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+func (v *ConfigValidator) nodePools() []api.NodePool {
+	var nodePools []api.NodePool
+	for _, ng := range v.cfg.NodeGroups {
+		nodePools = append(nodePools, ng)
+	}
+	for _, ng := range v.cfg.ManagedNodeGroups {
+		nodePools = append(nodePools, ng)
+	}
+	return nodePools
+}
@@ -0,0 +1,132 @@
+package cluster_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ValidateConfig", func() {
+	var (
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+		p   *mockprovider.MockProvider
+	)
+
+	mockImages := func(images ...*ec2.Image) {
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(&ec2.DescribeImagesOutput{Images: images}, nil)
+	}
+
+	problemsOf := func(category string, problems []cluster.ConfigProblem) []string {
+		var errs []string
+		for _, problem := range problems {
+			if problem.Category == category {
+				errs = append(errs, problem.Err.Error())
+			}
+		}
+		return errs
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		api.SetClusterEndpointAccessDefaults(cfg.VPC)
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.large"
+		ng.AMI = "ami-123"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+
+		p = mockprovider.NewMockProvider()
+		p.MockEC2().On("DescribeAvailabilityZones", mock.Anything).Return(&ec2.DescribeAvailabilityZonesOutput{
+			AvailabilityZones: []*ec2.AvailabilityZone{
+				{ZoneName: aws.String("us-west-2a"), State: aws.String(ec2.AvailabilityZoneStateAvailable)},
+				{ZoneName: aws.String("us-west-2b"), State: aws.String(ec2.AvailabilityZoneStateAvailable)},
+				{ZoneName: aws.String("us-west-2c"), State: aws.String(ec2.AvailabilityZoneStateImpaired)},
+			},
+		}, nil)
+		p.MockEC2().On("DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args[1].(func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool)
+			fn(&ec2.DescribeInstanceTypeOfferingsOutput{
+				InstanceTypeOfferings: []*ec2.InstanceTypeOffering{
+					{InstanceType: aws.String("m5.large")},
+				},
+			}, true)
+		}).Return(nil)
+	})
+
+	It("finds no problems in a valid config", func() {
+		mockImages(&ec2.Image{
+			ImageId:        aws.String("ami-123"),
+			RootDeviceType: aws.String("ebs"),
+			RootDeviceName: aws.String("/dev/xvda"),
+			BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+				{DeviceName: aws.String("/dev/xvda"), Ebs: &ec2.EbsBlockDevice{Encrypted: aws.Bool(false)}},
+			},
+		})
+
+		Expect(cluster.NewConfigValidator(cfg, p).Validate()).To(BeEmpty())
+		Expect(ng.VolumeName).To(BeNil(), "the given config should not be modified")
+	})
+
+	It("reports an unsupported version", func() {
+		mockImages()
+		cfg.Metadata.Version = "1.10"
+
+		Expect(problemsOf("config", cluster.NewConfigValidator(cfg, p).Validate())).To(ContainElement("unsupported Kubernetes version 1.10"))
+	})
+
+	It("reports availability zones that are not available in the region", func() {
+		mockImages()
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2c"}
+		ng.AvailabilityZones = []string{"us-west-2z"}
+
+		Expect(problemsOf("availabilityZones", cluster.NewConfigValidator(cfg, p).Validate())).To(ConsistOf(
+			`availability zone "us-west-2c" used by [availabilityZones] is not available in region us-west-2`,
+			`availability zone "us-west-2z" used by [nodegroup "ng-1"] is not available in region us-west-2`,
+		))
+	})
+
+	It("reports instance types that are not offered in the region", func() {
+		mockImages()
+		ng.InstanceType = "mixed"
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes: []string{"m5.large", "x9.huge"},
+		}
+
+		Expect(problemsOf("instanceTypes", cluster.NewConfigValidator(cfg, p).Validate())).To(ConsistOf(
+			`instance type "x9.huge" used by nodegroups [ng-1] is not offered in region us-west-2`,
+		))
+	})
+
+	It("reports AMIs that cannot be found", func() {
+		mockImages()
+
+		Expect(problemsOf("amis", cluster.NewConfigValidator(cfg, p).Validate())).To(ConsistOf(
+			`nodegroup "ng-1": unable to find AMI ami-123`,
+		))
+	})
+
+	It("reports subnets that cannot be found", func() {
+		mockImages()
+		cfg.AvailabilityZones = nil
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMapping{
+				"us-west-2a": api.AZSubnetSpec{ID: "subnet-missing"},
+			},
+		}
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(nil, errors.New("InvalidSubnetID.NotFound"))
+
+		Expect(problemsOf("vpc", cluster.NewConfigValidator(cfg, p).Validate())).To(ConsistOf("InvalidSubnetID.NotFound"))
+	})
+})
@@ -0,0 +1,139 @@
+package irsa
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils/tasks"
+)
+
+// DanglingRole is the IAM role of an iamserviceaccount whose Kubernetes service account no longer exists
+type DanglingRole struct {
+	ServiceAccount string
+	RoleARN        string
+	StackName      string
+
+	stack *manager.Stack
+}
+
+// FindDanglingRoles returns the IAM roles created by eksctl for iamserviceaccounts of the cluster that no longer have
+// a Kubernetes service account, e.g. because the service account was deleted with kubectl or its deletion failed.
+// Roles created with roleOnly are not returned, as they are not meant to have a service account created by eksctl.
+// The stacks created before eksctl recorded whether a role is role-only are skipped, unless includeUntagged is set
+func (m *Manager) FindDanglingRoles(includeUntagged bool) ([]DanglingRole, error) {
+	stacks, err := m.stackManager.DescribeIAMServiceAccountStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "describing iamserviceaccount stacks")
+	}
+
+	var danglingRoles []DanglingRole
+	for _, s := range stacks {
+		roleOnly, tagged := roleOnlyTag(s)
+		if roleOnly {
+			continue
+		}
+		if !tagged && !includeUntagged {
+			logger.Info("skipping stack %q, created before eksctl recorded whether its role is used without a service account created by eksctl", aws.StringValue(s.StackName))
+			continue
+		}
+		name := manager.GetIAMServiceAccountName(s)
+		meta, err := api.ClusterIAMServiceAccountNameStringToClusterIAMMeta(name)
+		if err != nil {
+			return nil, err
+		}
+		exists, err := kubernetes.CheckServiceAccountExists(m.clientSet, meta.AsObjectMeta())
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			continue
+		}
+		danglingRoles = append(danglingRoles, DanglingRole{
+			ServiceAccount: name,
+			RoleARN:        roleARN(s),
+			StackName:      aws.StringValue(s.StackName),
+			stack:          s,
+		})
+	}
+	return danglingRoles, nil
+}
+
+// Cleanup deletes the stacks of the IAM roles returned by FindDanglingRoles, the Kubernetes service accounts are
+// not touched
+func (m *Manager) Cleanup(plan, wait, includeUntagged bool) error {
+	danglingRoles, err := m.FindDanglingRoles(includeUntagged)
+	if err != nil {
+		return err
+	}
+	if len(danglingRoles) == 0 {
+		logger.Info("no dangling IAM roles found for the iamserviceaccounts of cluster %q", m.clusterName)
+		return nil
+	}
+
+	taskTree := &tasks.TaskTree{Parallel: true, PlanMode: plan}
+	for _, role := range danglingRoles {
+		logger.Info("IAM role %q of iamserviceaccount %q has no serviceaccount in the cluster", role.RoleARN, role.ServiceAccount)
+		task := &deleteStackTask{
+			info:  fmt.Sprintf("delete IAM role for serviceaccount %q", role.ServiceAccount),
+			stack: role.stack,
+			call:  m.stackManager.DeleteStackBySpecSync,
+		}
+		if !wait {
+			task.info += " [async]"
+			task.call = func(s *manager.Stack, errs chan error) error {
+				_, err := m.stackManager.DeleteStackBySpec(s)
+				close(errs)
+				return err
+			}
+		}
+		taskTree.Append(task)
+	}
+
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred and IAM Role stacks haven't been deleted properly, you may wish to check CloudFormation console", len(errs))
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to delete dangling IAM role(s)")
+	}
+
+	logPlanModeWarning(plan)
+	return nil
+}
+
+// roleOnlyTag returns whether the stack is tagged as the stack of a role-only iamserviceaccount, and whether it has the tag
+func roleOnlyTag(s *manager.Stack) (roleOnly, tagged bool) {
+	for _, tag := range s.Tags {
+		if aws.StringValue(tag.Key) == api.IAMServiceAccountRoleOnlyTag {
+			return aws.StringValue(tag.Value) == "true", true
+		}
+	}
+	return false, false
+}
+
+func roleARN(s *manager.Stack) string {
+	for _, output := range s.Outputs {
+		if aws.StringValue(output.OutputKey) == "Role1" {
+			return aws.StringValue(output.OutputValue)
+		}
+	}
+	return ""
+}
+
+type deleteStackTask struct {
+	info  string
+	stack *manager.Stack
+	call  func(*manager.Stack, chan error) error
+}
+
+func (t *deleteStackTask) Describe() string { return t.info }
+func (t *deleteStackTask) Do(errs chan error) error {
+	return t.call(t.stack, errs)
+}
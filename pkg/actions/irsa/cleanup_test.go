@@ -0,0 +1,159 @@
+package irsa_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+)
+
+var _ = Describe("Cleanup", func() {
+	var (
+		irsaManager      *irsa.Manager
+		fakeStackManager *fakes.FakeStackManager
+		fakeClientSet    *fake.Clientset
+	)
+
+	untaggedServiceAccountStack := func(namespace, name string) *cloudformation.Stack {
+		stack := &cloudformation.Stack{
+			StackName: aws.String("eksctl-my-cluster-addon-iamserviceaccount-" + namespace + "-" + name),
+			Tags: []*cloudformation.Tag{
+				{Key: aws.String(api.IAMServiceAccountNameTag), Value: aws.String(namespace + "/" + name)},
+			},
+			Outputs: []*cloudformation.Output{
+				{OutputKey: aws.String("Role1"), OutputValue: aws.String("arn:aws:iam::123456789012:role/" + name)},
+			},
+		}
+		return stack
+	}
+
+	serviceAccountStack := func(namespace, name string, roleOnly bool) *cloudformation.Stack {
+		stack := untaggedServiceAccountStack(namespace, name)
+		stack.Tags = append(stack.Tags, &cloudformation.Tag{Key: aws.String(api.IAMServiceAccountRoleOnlyTag), Value: aws.String(strconv.FormatBool(roleOnly))})
+		return stack
+	}
+
+	createServiceAccount := func(namespace, name string) {
+		_, err := fakeClientSet.CoreV1().ServiceAccounts(namespace).Create(context.TODO(), &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		fakeStackManager = new(fakes.FakeStackManager)
+		fakeClientSet = fake.NewSimpleClientset()
+		irsaManager = irsa.New("my-cluster", fakeStackManager, nil, fakeClientSet)
+
+		fakeStackManager.DescribeIAMServiceAccountStacksReturns([]*cloudformation.Stack{
+			serviceAccountStack("default", "in-use", false),
+			serviceAccountStack("default", "deleted", false),
+			serviceAccountStack("kube-system", "deleted", false),
+			serviceAccountStack("default", "role-only", true),
+		}, nil)
+		createServiceAccount("default", "in-use")
+		createServiceAccount("default", "other")
+	})
+
+	It("finds the roles of the iamserviceaccounts without a service account", func() {
+		danglingRoles, err := irsaManager.FindDanglingRoles(false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(danglingRoles).To(HaveLen(2))
+		Expect(danglingRoles[0].ServiceAccount).To(Equal("default/deleted"))
+		Expect(danglingRoles[0].RoleARN).To(Equal("arn:aws:iam::123456789012:role/deleted"))
+		Expect(danglingRoles[0].StackName).To(Equal("eksctl-my-cluster-addon-iamserviceaccount-default-deleted"))
+		Expect(danglingRoles[1].ServiceAccount).To(Equal("kube-system/deleted"))
+	})
+
+	It("finds no dangling roles when all the service accounts exist", func() {
+		createServiceAccount("default", "deleted")
+		createServiceAccount("kube-system", "deleted")
+		Expect(irsaManager.FindDanglingRoles(false)).To(BeEmpty())
+	})
+
+	Context("with stacks created before the role-only tag", func() {
+		BeforeEach(func() {
+			fakeStackManager.DescribeIAMServiceAccountStacksReturns([]*cloudformation.Stack{
+				serviceAccountStack("default", "deleted", false),
+				untaggedServiceAccountStack("default", "helm-managed"),
+			}, nil)
+		})
+
+		It("skips the untagged stacks, which may be role-only", func() {
+			danglingRoles, err := irsaManager.FindDanglingRoles(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(danglingRoles).To(HaveLen(1))
+			Expect(danglingRoles[0].ServiceAccount).To(Equal("default/deleted"))
+		})
+
+		It("does not delete the untagged stacks", func() {
+			Expect(irsaManager.Cleanup(false, false, false)).To(Succeed())
+			Expect(fakeStackManager.DeleteStackBySpecCallCount()).To(Equal(1))
+			Expect(*fakeStackManager.DeleteStackBySpecArgsForCall(0).StackName).To(Equal("eksctl-my-cluster-addon-iamserviceaccount-default-deleted"))
+		})
+
+		It("includes the untagged stacks when asked to", func() {
+			danglingRoles, err := irsaManager.FindDanglingRoles(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(danglingRoles).To(HaveLen(2))
+			Expect(danglingRoles[1].ServiceAccount).To(Equal("default/helm-managed"))
+		})
+	})
+
+	It("returns the error of describing the stacks", func() {
+		fakeStackManager.DescribeIAMServiceAccountStacksReturns(nil, errors.New("access denied"))
+		_, err := irsaManager.FindDanglingRoles(false)
+		Expect(err).To(MatchError("describing iamserviceaccount stacks: access denied"))
+	})
+
+	It("deletes the stacks of the dangling roles", func() {
+		fakeStackManager.DeleteStackBySpecSyncStub = func(_ *cloudformation.Stack, errs chan error) error {
+			close(errs)
+			return nil
+		}
+		Expect(irsaManager.Cleanup(false, true, false)).To(Succeed())
+
+		Expect(fakeStackManager.DeleteStackBySpecSyncCallCount()).To(Equal(2))
+		var deleted []string
+		for i := 0; i < 2; i++ {
+			stack, _ := fakeStackManager.DeleteStackBySpecSyncArgsForCall(i)
+			deleted = append(deleted, *stack.StackName)
+		}
+		Expect(deleted).To(ConsistOf(
+			"eksctl-my-cluster-addon-iamserviceaccount-default-deleted",
+			"eksctl-my-cluster-addon-iamserviceaccount-kube-system-deleted",
+		))
+
+		serviceAccounts, err := fakeClientSet.CoreV1().ServiceAccounts("default").List(context.TODO(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceAccounts.Items).To(HaveLen(2))
+	})
+
+	It("deletes the stacks without waiting", func() {
+		Expect(irsaManager.Cleanup(false, false, false)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackBySpecCallCount()).To(Equal(2))
+		Expect(fakeStackManager.DeleteStackBySpecSyncCallCount()).To(Equal(0))
+	})
+
+	It("does not delete anything in plan mode", func() {
+		Expect(irsaManager.Cleanup(true, true, false)).To(Succeed())
+		Expect(fakeStackManager.DeleteStackBySpecCallCount()).To(Equal(0))
+		Expect(fakeStackManager.DeleteStackBySpecSyncCallCount()).To(Equal(0))
+	})
+
+	It("returns an error when a stack cannot be deleted", func() {
+		fakeStackManager.DeleteStackBySpecReturns(nil, errors.New("access denied"))
+		Expect(irsaManager.Cleanup(false, false, false)).To(MatchError("failed to delete dangling IAM role(s)"))
+	})
+})
@@ -9,9 +9,11 @@ import (
 	"github.com/pkg/errors"
 
 	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/iam"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils"
@@ -30,6 +32,10 @@ type CreateOpts struct {
 	DryRun                    bool
 	SkipOutdatedAddonsCheck   bool
 	ConfigFileProvided        bool
+	// NodeGroupParallelism is the maximum number of nodegroup stacks that are created at the same time
+	NodeGroupParallelism int
+	// SkipInstanceTypeOfferingsCheck skips checking that the instance types are offered in the nodegroups' AZs
+	SkipInstanceTypeOfferingsCheck bool
 }
 
 // Create creates a new nodegroup with the given options.
@@ -110,6 +116,19 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 
 	logFiltered := cmdutils.ApplyFilter(cfg, nodegroupFilter)
 	logFiltered()
+
+	if err := vpc.ValidateNodeGroupAvailabilityZones(cfg); err != nil {
+		return err
+	}
+
+	awsNodeUsesIRSA, err := m.init.DoesAWSNodeUseIRSA(ctl.Provider, m.clientSet)
+	if err != nil {
+		return errors.Wrap(err, "couldn't check aws-node for annotation")
+	}
+
+	if err := iam.ValidateInstanceProfiles(ctl.Provider.IAM(), cfg.NodeGroups, awsNodeUsesIRSA); err != nil {
+		return err
+	}
 	logMsg := func(resource string, count int) {
 		logger.Info("will create a CloudFormation stack for each of %d %s in cluster %q", count, resource, meta.Name)
 	}
@@ -132,7 +151,25 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 		return cmdutils.PrintNodeGroupDryRunConfig(clusterConfigCopy, os.Stdout)
 	}
 
-	if err := m.nodeCreationTasks(options, nodegroupFilter, supportsManagedNodes, isOwnedCluster); err != nil {
+	if !options.SkipInstanceTypeOfferingsCheck {
+		if err := vpc.ValidateInstanceTypeOfferings(ctl.Provider.EC2(), cfg); err != nil {
+			return err
+		}
+	}
+
+	if err := vpc.ValidateEFAPlacementGroups(ctl.Provider.EC2(), cfg); err != nil {
+		return err
+	}
+
+	if err := vpc.ValidateSecondaryNetworkInterfaces(ctl.Provider.EC2(), cfg); err != nil {
+		return err
+	}
+
+	if err := vpc.ValidateEFSMounts(ctl.Provider.EFS(), cfg); err != nil {
+		return err
+	}
+
+	if err := m.nodeCreationTasks(options, nodegroupFilter, supportsManagedNodes, isOwnedCluster, awsNodeUsesIRSA); err != nil {
 		return err
 	}
 
@@ -147,10 +184,9 @@ func (m *Manager) Create(options CreateOpts, nodegroupFilter filter.NodegroupFil
 	return nil
 }
 
-func (m *Manager) nodeCreationTasks(options CreateOpts, nodegroupFilter filter.NodegroupFilter, supportsManagedNodes, isOwnedCluster bool) error {
+func (m *Manager) nodeCreationTasks(options CreateOpts, nodegroupFilter filter.NodegroupFilter, supportsManagedNodes, isOwnedCluster, awsNodeUsesIRSA bool) error {
 	cfg := m.cfg
 	meta := cfg.Metadata
-	init := m.init
 
 	taskTree := &tasks.TaskTree{
 		Parallel: false,
@@ -160,15 +196,19 @@ func (m *Manager) nodeCreationTasks(options CreateOpts, nodegroupFilter filter.N
 		taskTree.Append(m.stackManager.NewClusterCompatTask())
 	}
 
-	awsNodeUsesIRSA, err := init.DoesAWSNodeUseIRSA(m.ctl.Provider, m.clientSet)
-	if err != nil {
-		return errors.Wrap(err, "couldn't check aws-node for annotation")
-	}
-
 	if !awsNodeUsesIRSA && api.IsEnabled(cfg.IAM.WithOIDC) {
 		logger.Debug("cluster has withOIDC enabled but is not using IRSA for CNI, will add CNI policy to node role")
 	}
 
+	if cfg.HasPrefixDelegation() && !cfg.IsAWSNodeDisabled() {
+		taskTree.Append(&tasks.GenericTask{
+			Description: "enable prefix delegation in aws-node",
+			Doer: func() error {
+				return defaultaddons.EnablePrefixDelegation(m.clientSet)
+			},
+		})
+	}
+
 	var vpcImporter vpc.Importer
 	if isOwnedCluster {
 		vpcImporter = vpc.NewStackConfigImporter(m.stackManager.MakeClusterStackName())
@@ -178,14 +218,16 @@ func (m *Manager) nodeCreationTasks(options CreateOpts, nodegroupFilter filter.N
 
 	allNodeGroupTasks := &tasks.TaskTree{
 		Parallel: true,
+		Limit:    options.NodeGroupParallelism,
 	}
+	// the nodegroup tasks are added directly to allNodeGroupTasks so that the limit applies to all of them
 	nodeGroupTasks := m.stackManager.NewUnmanagedNodeGroupTask(cfg.NodeGroups, !awsNodeUsesIRSA, vpcImporter)
 	if nodeGroupTasks.Len() > 0 {
-		allNodeGroupTasks.Append(nodeGroupTasks)
+		allNodeGroupTasks.Append(nodeGroupTasks.Tasks...)
 	}
 	managedTasks := m.stackManager.NewManagedNodeGroupTask(cfg.ManagedNodeGroups, !awsNodeUsesIRSA, vpcImporter)
 	if managedTasks.Len() > 0 {
-		allNodeGroupTasks.Append(managedTasks)
+		allNodeGroupTasks.Append(managedTasks.Tasks...)
 	}
 
 	taskTree.Append(allNodeGroupTasks)
@@ -211,20 +253,36 @@ func (m *Manager) postNodeCreationTasks(clientSet kubernetes.Interface, options
 		return fmt.Errorf("failed to create nodegroups for cluster %q", m.cfg.Metadata.Name)
 	}
 
+	// nodes only become ready once another CNI plugin is installed when aws-node is disabled
+	waitForNodes := !m.cfg.IsAWSNodeDisabled()
+	if !waitForNodes {
+		logger.Info("not waiting for nodes to become ready as awsNode.disable is set, they will be ready once a CNI plugin is installed")
+	}
+
 	if options.UpdateAuthConfigMap {
-		if err := m.kubeProvider.UpdateAuthConfigMap(m.cfg.NodeGroups, clientSet); err != nil {
-			return err
+		if waitForNodes {
+			if err := m.kubeProvider.UpdateAuthConfigMap(m.cfg.NodeGroups, clientSet); err != nil {
+				return err
+			}
+		} else {
+			for _, ng := range m.cfg.NodeGroups {
+				if err := authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
+					return err
+				}
+			}
 		}
 	}
 	logger.Success("created %d nodegroup(s) in cluster %q", len(m.cfg.NodeGroups), m.cfg.Metadata.Name)
 
-	for _, ng := range m.cfg.ManagedNodeGroups {
-		if err := m.kubeProvider.WaitForNodes(clientSet, ng); err != nil {
-			if m.cfg.PrivateCluster.Enabled {
-				logger.Info("error waiting for nodes to join the cluster; this command was likely run from outside the cluster's VPC as the API server is not reachable, nodegroup(s) should still be able to join the cluster, underlying error is: %v", err)
-				break
-			} else {
-				return err
+	if waitForNodes {
+		for _, ng := range m.cfg.ManagedNodeGroups {
+			if err := m.kubeProvider.WaitForNodes(clientSet, ng); err != nil {
+				if m.cfg.PrivateCluster.Enabled {
+					logger.Info("error waiting for nodes to join the cluster; this command was likely run from outside the cluster's VPC as the API server is not reachable, nodegroup(s) should still be able to join the cluster, underlying error is: %v", err)
+					break
+				} else {
+					return err
+				}
 			}
 		}
 	}
@@ -20,16 +20,20 @@ import (
 )
 
 type ngEntry struct {
-	version       string
-	opts          nodegroup.CreateOpts
-	mockCalls     func(*fakes.FakeKubeProvider, *fakes.FakeNodeGroupInitialiser, *utilFakes.FakeNodegroupFilter)
-	expectedCalls func(*fakes.FakeKubeProvider, *fakes.FakeNodeGroupInitialiser, *utilFakes.FakeNodegroupFilter)
-	expErr        error
+	version        string
+	disableAWSNode bool
+	opts           nodegroup.CreateOpts
+	mockCalls      func(*fakes.FakeKubeProvider, *fakes.FakeNodeGroupInitialiser, *utilFakes.FakeNodegroupFilter)
+	expectedCalls  func(*fakes.FakeKubeProvider, *fakes.FakeNodeGroupInitialiser, *utilFakes.FakeNodegroupFilter)
+	expErr         error
 }
 
 var _ = DescribeTable("Create", func(t ngEntry) {
 	cfg := newClusterConfig()
 	cfg.Metadata.Version = t.version
+	if t.disableAWSNode {
+		cfg.AWSNode = &api.AWSNodeConfig{Disable: true}
+	}
 
 	p := mockprovider.NewMockProvider()
 	ctl := &eks.ClusterProvider{
@@ -273,6 +277,19 @@ var _ = DescribeTable("Create", func(t ngEntry) {
 		expErr: nil,
 	}),
 
+	Entry("[happy path] does not wait for nodes to become ready when aws-node is disabled", ngEntry{
+		disableAWSNode: true,
+		mockCalls: func(k *fakes.FakeKubeProvider, init *fakes.FakeNodeGroupInitialiser, f *utilFakes.FakeNodegroupFilter) {
+			k.SupportsManagedNodesReturns(true, nil)
+		},
+		expectedCalls: func(k *fakes.FakeKubeProvider, init *fakes.FakeNodeGroupInitialiser, f *utilFakes.FakeNodegroupFilter) {
+			Expect(init.DoAllNodegroupStackTasksCallCount()).To(Equal(1))
+			Expect(k.UpdateAuthConfigMapCallCount()).To(Equal(0))
+			Expect(k.WaitForNodesCallCount()).To(Equal(0))
+		},
+		expErr: nil,
+	}),
+
 	Entry("[happy path] creates nodegroup with all the options", ngEntry{
 		opts: nodegroup.CreateOpts{
 			DryRun:                    true,
@@ -16,6 +16,8 @@ func (m *Manager) Drain(nodeGroups []eks.KubeNodeGroup, plan bool, maxGracePerio
 	if !plan {
 		for _, n := range nodeGroups {
 			nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, n, m.ctl.Provider.WaitTimeout(), maxGracePeriod, false, disableEviction)
+			nodeGroupDrainer.SetEventRecorder(m.drainEvents)
+			nodeGroupDrainer.SetEvictByPriority(m.byPriority)
 			if err := nodeGroupDrainer.Drain(); err != nil {
 				logger.Warning("error occurred during drain, to skip drain use '--drain=false' flag")
 				return err
@@ -1,6 +1,8 @@
 package nodegroup
 
 import (
+	"time"
+
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
@@ -22,3 +24,15 @@ func (m *Manager) MockKubeProvider(k eks.KubeProvider) {
 func (m *Manager) MockNodeGroupService(ngSvc eks.NodeGroupInitialiser) {
 	m.init = ngSvc
 }
+
+// SetNodeRotationPollInterval sets how often nodes are listed while waiting for replacement nodes
+func SetNodeRotationPollInterval(interval time.Duration) {
+	nodeRotationPollInterval = interval
+}
+
+type TagChange = tagChange
+
+// DiffTags returns the changes to apply to current to set all the tags in desired
+func DiffTags(current, desired map[string]string) []TagChange {
+	return diffTags(current, desired)
+}
@@ -30,14 +30,18 @@ func (m *Manager) GetAll() ([]*manager.NodeGroupSummary, error) {
 		}
 	}
 
-	managedNodeGroups, err := m.ctl.Provider.EKS().ListNodegroups(&eks.ListNodegroupsInput{
+	var managedNodeGroups []*string
+	err = m.ctl.Provider.EKS().ListNodegroupsPages(&eks.ListNodegroupsInput{
 		ClusterName: &m.cfg.Metadata.Name,
+	}, func(page *eks.ListNodegroupsOutput, _ bool) bool {
+		managedNodeGroups = append(managedNodeGroups, page.Nodegroups...)
+		return true
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	for _, managedNodeGroup := range managedNodeGroups.Nodegroups {
+	for _, managedNodeGroup := range managedNodeGroups {
 		describeOutput, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
 			ClusterName:   &m.cfg.Metadata.Name,
 			NodegroupName: managedNodeGroup,
@@ -0,0 +1,99 @@
+package nodegroup
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nodeLabelSnapshot holds the labels of the nodes being replaced that are re-applied to the nodes
+// replacing them, so that the labels set by external controllers are there as soon as the new nodes
+// join, rather than once the controllers have reconciled them
+type nodeLabelSnapshot struct {
+	patterns    []string
+	labels      map[string]string
+	conflicting sets.String
+}
+
+// newNodeLabelSnapshot returns a snapshot of the labels whose keys are in patterns; a pattern
+// ending with `*` matches all the keys with that prefix, e.g. `nvidia.com/*`
+func newNodeLabelSnapshot(patterns []string) *nodeLabelSnapshot {
+	return &nodeLabelSnapshot{
+		patterns:    patterns,
+		labels:      map[string]string{},
+		conflicting: sets.NewString(),
+	}
+}
+
+func (s *nodeLabelSnapshot) matches(key string) bool {
+	for _, pattern := range s.patterns {
+		if prefix := strings.TrimSuffix(pattern, "*"); prefix != pattern {
+			if strings.HasPrefix(key, prefix) {
+				return true
+			}
+		} else if key == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// add records the matching labels of node. Only labels that have the same value on all the nodes
+// are re-applied, as there is no telling which of the values a replacement node should get
+func (s *nodeLabelSnapshot) add(node corev1.Node) {
+	for key, value := range node.Labels {
+		if !s.matches(key) || s.conflicting.Has(key) {
+			continue
+		}
+		if existing, ok := s.labels[key]; ok && existing != value {
+			logger.Warning("label %q has different values on the nodes being replaced, it will not be re-applied", key)
+			delete(s.labels, key)
+			s.conflicting.Insert(key)
+			continue
+		}
+		s.labels[key] = value
+	}
+}
+
+// apply sets the recorded labels that node does not have yet; labels the node already has, e.g.
+// because a controller has already reconciled it, are left as they are
+func (s *nodeLabelSnapshot) apply(clientSet kubernetes.Interface, node corev1.Node) error {
+	missing := map[string]string{}
+	for key, value := range s.labels {
+		if _, ok := node.Labels[key]; !ok {
+			missing[key] = value
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": missing,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := clientSet.CoreV1().Nodes().Patch(context.TODO(), node.Name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "re-applying labels to node %q", node.Name)
+	}
+
+	var keys []string
+	for key := range missing {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	logger.Info("re-applied label(s) %s to node %q", strings.Join(keys, ", "), node.Name)
+	return nil
+}
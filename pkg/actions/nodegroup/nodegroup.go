@@ -10,6 +10,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/drain"
 	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
@@ -21,6 +22,8 @@ type Manager struct {
 	wait         WaitFunc
 	init         eks.NodeGroupInitialiser
 	kubeProvider eks.KubeProvider
+	drainEvents  drain.EventRecorder
+	byPriority   bool
 }
 
 type WaitFunc func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error
@@ -40,6 +43,16 @@ func New(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, clientSet kubernetes.
 	}
 }
 
+// SetDrainEventRecorder sets a recorder that receives the progress of node drains as events
+func (m *Manager) SetDrainEventRecorder(events drain.EventRecorder) {
+	m.drainEvents = events
+}
+
+// SetDrainByPriority makes node drains evict the pods of lower priorities first
+func (m *Manager) SetDrainByPriority(byPriority bool) {
+	m.byPriority = byPriority
+}
+
 func (m *Manager) hasStacks(name string) (bool, error) {
 	stacks, err := m.stackManager.ListNodeGroupStacks()
 	if err != nil {
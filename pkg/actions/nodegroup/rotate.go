@@ -0,0 +1,189 @@
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// nodeRotationPollInterval is how often nodes are listed while waiting for the
+// Auto Scaling group to replace the terminated ones
+var nodeRotationPollInterval = 15 * time.Second
+
+// RotateNodesOptions holds the options for rotating the nodes of a nodegroup
+type RotateNodesOptions struct {
+	// MaxAge is the age after which a node is rotated
+	MaxAge time.Duration
+	// MaxUnavailable is the number of nodes drained and terminated at a time
+	MaxUnavailable int
+	// MaxGracePeriod is the maximum time to wait for pods to terminate
+	MaxGracePeriod time.Duration
+	// DisableEviction deletes pods instead of evicting them, which bypasses PodDisruptionBudgets
+	DisableEviction bool
+	// PreserveLabels are the keys of the labels of the rotated nodes that are re-applied to the nodes
+	// replacing them as they join, a key ending with `*` matches all the keys with that prefix
+	PreserveLabels []string
+	// Plan only lists the nodes that would be rotated
+	Plan bool
+}
+
+// RotateNodes replaces the nodes of the nodegroup created more than MaxAge ago. Nodes are drained and
+// terminated MaxUnavailable at a time, and the next ones are only rotated once the Auto Scaling group
+// has brought the nodegroup back to its size with ready nodes
+func (m *Manager) RotateNodes(ng eks.KubeNodeGroup, options RotateNodesOptions) error {
+	if options.MaxUnavailable < 1 {
+		return fmt.Errorf("max unavailable must be at least 1, got %d", options.MaxUnavailable)
+	}
+
+	listOptions := ng.ListOptions()
+	nodes, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+	if err != nil {
+		return errors.Wrapf(err, "listing nodes of nodegroup %q", ng.NameString())
+	}
+	oldNodes, err := drain.NodesOlderThan(m.clientSet, listOptions, options.MaxAge, time.Now())
+	if err != nil {
+		return errors.Wrapf(err, "listing nodes of nodegroup %q", ng.NameString())
+	}
+
+	cmdutils.LogIntendedAction(options.Plan, "rotate %d of %d node(s) older than %s in nodegroup %q", len(oldNodes), len(nodes.Items), options.MaxAge, ng.NameString())
+	for _, node := range oldNodes {
+		logger.Info("node %q was created at %s", node.Name, node.CreationTimestamp.UTC().Format(time.RFC3339))
+	}
+	if options.Plan || len(oldNodes) == 0 {
+		cmdutils.LogPlanModeWarning(options.Plan && len(oldNodes) > 0)
+		return nil
+	}
+
+	nodeGroupDrainer := drain.NewNodeGroupDrainer(m.clientSet, ng, m.ctl.Provider.WaitTimeout(), options.MaxGracePeriod, false, options.DisableEviction)
+	nodeGroupDrainer.SetEventRecorder(m.drainEvents)
+	nodeGroupDrainer.SetEvictByPriority(m.byPriority)
+	labels := newNodeLabelSnapshot(options.PreserveLabels)
+	known := sets.NewString()
+	readyNodes := 0
+	for _, node := range nodes.Items {
+		known.Insert(node.Name)
+		if isNodeReady(node) {
+			readyNodes++
+		}
+	}
+	terminated := sets.NewString()
+	for start := 0; start < len(oldNodes); start += options.MaxUnavailable {
+		end := start + options.MaxUnavailable
+		if end > len(oldNodes) {
+			end = len(oldNodes)
+		}
+		batch := oldNodes[start:end]
+
+		var nodeNames []string
+		for _, node := range batch {
+			nodeNames = append(nodeNames, node.Name)
+			labels.add(node)
+		}
+		logger.Info("rotating node(s) %v", nodeNames)
+		if err := nodeGroupDrainer.DrainNodes(nodeNames); err != nil {
+			return err
+		}
+
+		for _, node := range batch {
+			if err := m.terminateNode(node); err != nil {
+				return err
+			}
+			terminated.Insert(node.Name)
+		}
+
+		if err := m.waitForReplacementNodes(ng, readyNodes, terminated, known, labels); err != nil {
+			return err
+		}
+	}
+
+	logger.Success("rotated %d node(s) in nodegroup %q", len(oldNodes), ng.NameString())
+	return nil
+}
+
+func (m *Manager) terminateNode(node corev1.Node) error {
+	instanceID, err := instanceIDFromProviderID(node.Spec.ProviderID)
+	if err != nil {
+		return errors.Wrapf(err, "node %q", node.Name)
+	}
+	logger.Info("terminating instance %q of node %q", instanceID, node.Name)
+	_, err = m.ctl.Provider.ASG().TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+		InstanceId:                     aws.String(instanceID),
+		ShouldDecrementDesiredCapacity: aws.Bool(false),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "terminating instance %q of node %q", instanceID, node.Name)
+	}
+	return nil
+}
+
+// waitForReplacementNodes waits until the nodegroup has as many ready nodes as it had
+// before the rotation, not counting the nodes that were terminated. The preserved labels
+// are applied to the nodes that are not known yet as soon as they are listed
+func (m *Manager) waitForReplacementNodes(ng eks.KubeNodeGroup, expected int, terminated, known sets.String, labels *nodeLabelSnapshot) error {
+	logger.Info("waiting for %d ready node(s) in nodegroup %q", expected, ng.NameString())
+	timeout := m.ctl.Provider.WaitTimeout()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		nodes, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), ng.ListOptions())
+		if err != nil {
+			return errors.Wrapf(err, "listing nodes of nodegroup %q", ng.NameString())
+		}
+		ready := 0
+		for _, node := range nodes.Items {
+			if !known.Has(node.Name) {
+				if err := labels.apply(m.clientSet, node); err != nil {
+					return err
+				}
+				known.Insert(node.Name)
+			}
+			if !terminated.Has(node.Name) && isNodeReady(node) {
+				ready++
+			}
+		}
+		if ready >= expected {
+			return nil
+		}
+		logger.Debug("%d of %d node(s) ready in nodegroup %q", ready, expected, ng.NameString())
+
+		select {
+		case <-timer.C:
+			return fmt.Errorf("timed out (after %s) waiting for %d ready node(s) in nodegroup %q, %d ready", timeout, expected, ng.NameString(), ready)
+		case <-time.After(nodeRotationPollInterval):
+		}
+	}
+}
+
+func isNodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// instanceIDFromProviderID returns the EC2 instance ID of a provider ID of the form aws:///<zone>/<instance-id>
+func instanceIDFromProviderID(providerID string) (string, error) {
+	if !strings.HasPrefix(providerID, "aws://") {
+		return "", fmt.Errorf("unexpected provider ID %q", providerID)
+	}
+	parts := strings.Split(providerID, "/")
+	instanceID := parts[len(parts)-1]
+	if !strings.HasPrefix(instanceID, "i-") {
+		return "", fmt.Errorf("unexpected provider ID %q", providerID)
+	}
+	return instanceID, nil
+}
@@ -0,0 +1,208 @@
+package nodegroup_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Rotate nodes", func() {
+	var (
+		p             *mockprovider.MockProvider
+		fakeClientSet *fake.Clientset
+		m             *nodegroup.Manager
+		ng            *api.NodeGroup
+		terminated    []string
+	)
+
+	newNode := func(instanceID string, age time.Duration) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "node-" + instanceID,
+				Labels:            map[string]string{api.NodeGroupNameLabel: "ng-1"},
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: corev1.NodeSpec{ProviderID: "aws:///us-west-2a/" + instanceID},
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		nodegroup.SetNodeRotationPollInterval(time.Millisecond)
+		terminated = nil
+		fakeClientSet = fake.NewSimpleClientset(
+			newNode("i-new", time.Hour),
+			newNode("i-old", 40*24*time.Hour),
+			newNode("i-older", 50*24*time.Hour),
+			newNode("i-oldest", 60*24*time.Hour),
+		)
+
+		p = mockprovider.NewMockProvider()
+		// the Auto Scaling group replaces a terminated instance with a new node
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+			input := args[0].(*autoscaling.TerminateInstanceInAutoScalingGroupInput)
+			Expect(*input.ShouldDecrementDesiredCapacity).To(BeFalse())
+			instanceID := *input.InstanceId
+			terminated = append(terminated, instanceID)
+
+			Expect(fakeClientSet.CoreV1().Nodes().Delete(context.TODO(), "node-"+instanceID, metav1.DeleteOptions{})).To(Succeed())
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), newNode(instanceID+"-replacement", 0), metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+
+		m = nodegroup.New(api.NewClusterConfig(), &eks.ClusterProvider{Provider: p}, fakeClientSet)
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+	})
+
+	It("drains and terminates the nodes older than the maximum age, the oldest first", func() {
+		err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 720 * time.Hour, MaxUnavailable: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(terminated).To(Equal([]string{"i-oldest", "i-older", "i-old"}))
+
+		nodes, err := fakeClientSet.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, node := range nodes.Items {
+			names = append(names, node.Name)
+		}
+		Expect(names).To(ConsistOf("node-i-new", "node-i-old-replacement", "node-i-older-replacement", "node-i-oldest-replacement"))
+	})
+
+	It("does not wait for the nodes that were not ready before the rotation", func() {
+		node := newNode("i-not-ready", time.Hour)
+		node.Status.Conditions[0].Status = corev1.ConditionFalse
+		_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 720 * time.Hour, MaxUnavailable: 2})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(terminated).To(Equal([]string{"i-oldest", "i-older", "i-old"}))
+	})
+
+	Context("with labels to preserve", func() {
+		setNodeLabels := func(name string, labels map[string]string) {
+			node, err := fakeClientSet.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			for k, v := range labels {
+				node.Labels[k] = v
+			}
+			_, err = fakeClientSet.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		nodeLabels := func(name string) map[string]string {
+			node, err := fakeClientSet.CoreV1().Nodes().Get(context.TODO(), name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return node.Labels
+		}
+
+		BeforeEach(func() {
+			for _, name := range []string{"node-i-old", "node-i-older", "node-i-oldest"} {
+				setNodeLabels(name, map[string]string{
+					"nvidia.com/gpu.product": "A100",
+					"nvidia.com/gpu.count":   "8",
+					"example.com/rack":       "rack-" + name,
+					"unrelated":              "true",
+				})
+			}
+		})
+
+		It("re-applies the matching labels that all the rotated nodes agree on to the replacement nodes", func() {
+			err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{
+				MaxAge:         720 * time.Hour,
+				MaxUnavailable: 3,
+				PreserveLabels: []string{"nvidia.com/*", "example.com/rack"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, name := range []string{"node-i-old-replacement", "node-i-older-replacement", "node-i-oldest-replacement"} {
+				Expect(nodeLabels(name)).To(Equal(map[string]string{
+					api.NodeGroupNameLabel:   "ng-1",
+					"nvidia.com/gpu.product": "A100",
+					"nvidia.com/gpu.count":   "8",
+				}))
+			}
+			Expect(nodeLabels("node-i-new")).NotTo(HaveKey("nvidia.com/gpu.product"))
+		})
+
+		It("does not override labels that replacement nodes already have", func() {
+			p.MockASG().ExpectedCalls = nil
+			p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+				instanceID := *args[0].(*autoscaling.TerminateInstanceInAutoScalingGroupInput).InstanceId
+				Expect(fakeClientSet.CoreV1().Nodes().Delete(context.TODO(), "node-"+instanceID, metav1.DeleteOptions{})).To(Succeed())
+				replacement := newNode(instanceID+"-replacement", 0)
+				replacement.Labels["nvidia.com/gpu.product"] = "H100"
+				_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), replacement, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+
+			err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{
+				MaxAge:         720 * time.Hour,
+				MaxUnavailable: 1,
+				PreserveLabels: []string{"nvidia.com/*"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodeLabels("node-i-oldest-replacement")).To(HaveKeyWithValue("nvidia.com/gpu.product", "H100"))
+			Expect(nodeLabels("node-i-oldest-replacement")).To(HaveKeyWithValue("nvidia.com/gpu.count", "8"))
+		})
+
+		It("does not re-apply labels without labels to preserve", func() {
+			err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 720 * time.Hour, MaxUnavailable: 3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodeLabels("node-i-old-replacement")).To(Equal(map[string]string{api.NodeGroupNameLabel: "ng-1"}))
+		})
+	})
+
+	It("cordons the nodes before terminating them", func() {
+		p.MockASG().ExpectedCalls = nil
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Return(nil, fmt.Errorf("terminate failed"))
+
+		err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 720 * time.Hour, MaxUnavailable: 1})
+		Expect(err).To(MatchError(`terminating instance "i-oldest" of node "node-i-oldest": terminate failed`))
+
+		node, err := fakeClientSet.CoreV1().Nodes().Get(context.TODO(), "node-i-oldest", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node.Spec.Unschedulable).To(BeTrue())
+		node, err = fakeClientSet.CoreV1().Nodes().Get(context.TODO(), "node-i-older", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(node.Spec.Unschedulable).To(BeFalse())
+	})
+
+	It("only lists the nodes that would be rotated in plan mode", func() {
+		err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 45 * 24 * time.Hour, MaxUnavailable: 1, Plan: true})
+		Expect(err).NotTo(HaveOccurred())
+		p.MockASG().AssertNotCalled(GinkgoT(), "TerminateInstanceInAutoScalingGroup", mock.Anything)
+	})
+
+	It("does not rotate nodes younger than the maximum age", func() {
+		err := m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 90 * 24 * time.Hour, MaxUnavailable: 1})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(terminated).To(BeEmpty())
+	})
+
+	It("fails when the provider ID of a node is not an EC2 instance", func() {
+		node := newNode("i-ancient", 100*24*time.Hour)
+		node.Spec.ProviderID = "kind://docker/node"
+		_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		err = m.RotateNodes(ng, nodegroup.RotateNodesOptions{MaxAge: 720 * time.Hour, MaxUnavailable: 1})
+		Expect(err).To(MatchError(`node "node-i-ancient": unexpected provider ID "kind://docker/node"`))
+	})
+})
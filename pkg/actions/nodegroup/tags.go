@@ -0,0 +1,343 @@
+package nodegroup
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// tagSpecificationResourceTypes are the resources launched with a nodegroup's instances that are tagged
+// by its launch template
+var tagSpecificationResourceTypes = []string{
+	ec2.ResourceTypeInstance,
+	ec2.ResourceTypeVolume,
+	ec2.ResourceTypeNetworkInterface,
+}
+
+const (
+	autoScalingGroupTagsPath = "Resources.NodeGroup.Properties.Tags"
+	// maxCreateTagsResources is the maximum number of resources EC2 CreateTags accepts in a call
+	maxCreateTagsResources = 1000
+)
+
+// UpdateTagsOptions holds the options for updating the tags of a nodegroup's instances
+type UpdateTagsOptions struct {
+	// Tags are the tags added to, or changed on, the instances
+	Tags map[string]string
+	// Plan only logs the tags that would be added or changed
+	Plan bool
+}
+
+// tagChange is a change to the value of a tag, a tag with no previous value is added
+type tagChange struct {
+	Key      string
+	Previous *string
+	Value    string
+}
+
+func (c tagChange) String() string {
+	if c.Previous == nil {
+		return fmt.Sprintf("add tag %q with value %q", c.Key, c.Value)
+	}
+	return fmt.Sprintf("change tag %q from %q to %q", c.Key, *c.Previous, c.Value)
+}
+
+// diffTags returns the changes to apply to current to set all the tags in desired, sorted by key
+func diffTags(current, desired map[string]string) []tagChange {
+	var changes []tagChange
+	for k, v := range desired {
+		previous, ok := current[k]
+		if !ok {
+			changes = append(changes, tagChange{Key: k, Value: v})
+		} else if previous != v {
+			changes = append(changes, tagChange{Key: k, Previous: aws.String(previous), Value: v})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+// UpdateTags adds the tags to the resources a nodegroup launches, and to the instances that are already running.
+// The tags of an unmanaged nodegroup are added to the Auto Scaling group of its stack, which propagates them to the
+// instances it launches without replacing the running ones. Managed nodegroups get a new version of their launch
+// template with the tags, used from their next upgrade, as rolling it out replaces all of their nodes
+func (m *Manager) UpdateTags(nodeGroupName string, options UpdateTagsOptions) error {
+	if len(options.Tags) == 0 {
+		return errors.New("at least one tag must be specified")
+	}
+	for k := range options.Tags {
+		if strings.HasPrefix(strings.ToLower(k), "aws:") {
+			return fmt.Errorf("tag %q uses the reserved prefix \"aws:\"", k)
+		}
+	}
+
+	nodeGroupType, err := m.stackManager.GetNodeGroupStackType(nodeGroupName)
+	if err != nil {
+		return errors.Wrapf(err, "getting the type of nodegroup %q", nodeGroupName)
+	}
+
+	var (
+		lt       *eks.LaunchTemplateSpecification
+		asgNames []string
+		template string
+	)
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		output, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+			ClusterName:   aws.String(m.cfg.Metadata.Name),
+			NodegroupName: aws.String(nodeGroupName),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing nodegroup %q", nodeGroupName)
+		}
+		if lt = output.Nodegroup.LaunchTemplate; lt == nil {
+			return fmt.Errorf("nodegroup %q does not use a launch template", nodeGroupName)
+		}
+		if output.Nodegroup.Resources != nil {
+			for _, group := range output.Nodegroup.Resources.AutoScalingGroups {
+				asgNames = append(asgNames, aws.StringValue(group.Name))
+			}
+		}
+	} else {
+		stack, err := m.stackManager.DescribeNodeGroupStack(nodeGroupName)
+		if err != nil {
+			return errors.Wrapf(err, "describing the stack of nodegroup %q", nodeGroupName)
+		}
+		asgName, err := m.stackManager.GetAutoScalingGroupName(stack)
+		if err != nil {
+			return errors.Wrapf(err, "getting the Auto Scaling group of nodegroup %q", nodeGroupName)
+		}
+		asgNames = []string{asgName}
+		if template, err = m.stackManager.GetStackTemplate(aws.StringValue(stack.StackName)); err != nil {
+			return errors.Wrapf(err, "error fetching template of nodegroup %q", nodeGroupName)
+		}
+	}
+
+	groups, err := m.describeAutoScalingGroups(asgNames)
+	if err != nil {
+		return errors.Wrapf(err, "describing the Auto Scaling groups of nodegroup %q", nodeGroupName)
+	}
+
+	var (
+		version  *ec2.LaunchTemplateVersion
+		changes  []tagChange
+		resource string
+	)
+	if nodeGroupType == api.NodeGroupTypeManaged {
+		if version, err = m.fetchLaunchTemplateVersion(lt); err != nil {
+			return errors.Wrapf(err, "fetching the launch template of nodegroup %q", nodeGroupName)
+		}
+		changes = diffTags(instanceTags(version.LaunchTemplateData), options.Tags)
+		resource = fmt.Sprintf("launch template %q", aws.StringValue(version.LaunchTemplateId))
+	} else {
+		changes = diffTags(autoScalingGroupTemplateTags(template), options.Tags)
+		resource = "the Auto Scaling group"
+	}
+
+	var instanceIDs []string
+	for _, group := range groups {
+		for _, instance := range group.Instances {
+			if !strings.HasPrefix(aws.StringValue(instance.LifecycleState), autoscaling.LifecycleStateTerminating) {
+				instanceIDs = append(instanceIDs, aws.StringValue(instance.InstanceId))
+			}
+		}
+	}
+
+	if len(changes) == 0 {
+		logger.Info("%s of nodegroup %q already has the tags", resource, nodeGroupName)
+	} else {
+		cmdutils.LogIntendedAction(options.Plan, "update %d tag(s) of %s of nodegroup %q", len(changes), resource, nodeGroupName)
+		for _, change := range changes {
+			logger.Info("%s", change)
+		}
+	}
+	cmdutils.LogIntendedAction(options.Plan, "tag %d running instance(s) of nodegroup %q", len(instanceIDs), nodeGroupName)
+	if options.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	if len(changes) > 0 {
+		if nodeGroupType == api.NodeGroupTypeManaged {
+			newVersion, err := m.createTaggedLaunchTemplateVersion(version, options.Tags)
+			if err != nil {
+				return errors.Wrapf(err, "creating launch template version for nodegroup %q", nodeGroupName)
+			}
+			logger.Info("created version %s of launch template %q for nodegroup %q", newVersion, aws.StringValue(version.LaunchTemplateId), nodeGroupName)
+			logger.Info("nodegroup %q will use the new version once upgraded with `eksctl upgrade nodegroup --launch-template-version=%s`", nodeGroupName, newVersion)
+		} else {
+			template, err := setAutoScalingGroupTemplateTags(template, changes)
+			if err != nil {
+				return errors.Wrapf(err, "adding the tags to the template of nodegroup %q", nodeGroupName)
+			}
+			if err := m.stackManager.UpdateNodeGroupStack(nodeGroupName, template); err != nil {
+				return errors.Wrapf(err, "error updating stack of nodegroup %q", nodeGroupName)
+			}
+		}
+	}
+
+	for i := 0; i < len(instanceIDs); i += maxCreateTagsResources {
+		end := i + maxCreateTagsResources
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+		if _, err := m.ctl.Provider.EC2().CreateTags(&ec2.CreateTagsInput{
+			Resources: aws.StringSlice(instanceIDs[i:end]),
+			Tags:      ec2Tags(options.Tags),
+		}); err != nil {
+			return errors.Wrapf(err, "tagging the instances of nodegroup %q", nodeGroupName)
+		}
+	}
+	logger.Success("updated the tags of nodegroup %q", nodeGroupName)
+	return nil
+}
+
+// autoScalingGroupTemplateTags returns the tags of the Auto Scaling group in a nodegroup template, tags whose value
+// is not a literal string are skipped
+func autoScalingGroupTemplateTags(template string) map[string]string {
+	tags := map[string]string{}
+	for _, tag := range gjson.Get(template, autoScalingGroupTagsPath).Array() {
+		if value := tag.Get("Value"); value.Type == gjson.String {
+			tags[tag.Get("Key").String()] = value.String()
+		}
+	}
+	return tags
+}
+
+// setAutoScalingGroupTemplateTags applies the changes to the tags of the Auto Scaling group in a nodegroup template,
+// all of them propagated at launch
+func setAutoScalingGroupTemplateTags(template string, changes []tagChange) (string, error) {
+	indexes := map[string]int{}
+	for i, tag := range gjson.Get(template, autoScalingGroupTagsPath).Array() {
+		indexes[tag.Get("Key").String()] = i
+	}
+	var err error
+	for _, change := range changes {
+		path := fmt.Sprintf("%s.-1", autoScalingGroupTagsPath)
+		if i, ok := indexes[change.Key]; ok {
+			path = fmt.Sprintf("%s.%d", autoScalingGroupTagsPath, i)
+		}
+		template, err = sjson.Set(template, path, map[string]string{
+			"Key":               change.Key,
+			"Value":             change.Value,
+			"PropagateAtLaunch": "true",
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return template, nil
+}
+
+func (m *Manager) describeAutoScalingGroups(names []string) ([]*autoscaling.Group, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	output, err := m.ctl.Provider.ASG().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice(names),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output.AutoScalingGroups, nil
+}
+
+// createTaggedLaunchTemplateVersion creates a version of the launch template from version, with tags
+// merged into the tag specifications of the resources it launches, and returns its number
+func (m *Manager) createTaggedLaunchTemplateVersion(version *ec2.LaunchTemplateVersion, tags map[string]string) (string, error) {
+	tagSpecs := map[string]map[string]string{}
+	for _, spec := range version.LaunchTemplateData.TagSpecifications {
+		resourceTags := map[string]string{}
+		for _, tag := range spec.Tags {
+			resourceTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		tagSpecs[aws.StringValue(spec.ResourceType)] = resourceTags
+	}
+
+	var requestTagSpecs []*ec2.LaunchTemplateTagSpecificationRequest
+	for _, spec := range version.LaunchTemplateData.TagSpecifications {
+		if resourceType := aws.StringValue(spec.ResourceType); !isTaggedResourceType(resourceType) {
+			requestTagSpecs = append(requestTagSpecs, &ec2.LaunchTemplateTagSpecificationRequest{
+				ResourceType: spec.ResourceType,
+				Tags:         spec.Tags,
+			})
+		}
+	}
+	for _, resourceType := range tagSpecificationResourceTypes {
+		resourceTags, ok := tagSpecs[resourceType]
+		if !ok {
+			resourceTags = map[string]string{}
+		}
+		for k, v := range tags {
+			resourceTags[k] = v
+		}
+		requestTagSpecs = append(requestTagSpecs, &ec2.LaunchTemplateTagSpecificationRequest{
+			ResourceType: aws.String(resourceType),
+			Tags:         ec2Tags(resourceTags),
+		})
+	}
+
+	output, err := m.ctl.Provider.EC2().CreateLaunchTemplateVersion(&ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateId:   version.LaunchTemplateId,
+		SourceVersion:      aws.String(strconv.FormatInt(aws.Int64Value(version.VersionNumber), 10)),
+		VersionDescription: aws.String("eksctl: update tags"),
+		LaunchTemplateData: &ec2.RequestLaunchTemplateData{
+			TagSpecifications: requestTagSpecs,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10), nil
+}
+
+func instanceTags(data *ec2.ResponseLaunchTemplateData) map[string]string {
+	tags := map[string]string{}
+	if data == nil {
+		return tags
+	}
+	for _, spec := range data.TagSpecifications {
+		if aws.StringValue(spec.ResourceType) == ec2.ResourceTypeInstance {
+			for _, tag := range spec.Tags {
+				tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+			}
+		}
+	}
+	return tags
+}
+
+func isTaggedResourceType(resourceType string) bool {
+	for _, t := range tagSpecificationResourceTypes {
+		if t == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+func ec2Tags(tags map[string]string) []*ec2.Tag {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var ec2Tags []*ec2.Tag
+	for _, k := range keys {
+		ec2Tags = append(ec2Tags, &ec2.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return ec2Tags
+}
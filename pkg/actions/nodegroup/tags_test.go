@@ -0,0 +1,250 @@
+package nodegroup_test
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Update tags", func() {
+	const (
+		clusterName = "my-cluster"
+		ngName      = "my-ng"
+	)
+
+	var (
+		p            *mockprovider.MockProvider
+		m            *nodegroup.Manager
+		stackManager *fakes.FakeStackManager
+		options      nodegroup.UpdateTagsOptions
+	)
+
+	launchTemplateVersion := &ec2.LaunchTemplateVersion{
+		LaunchTemplateId: aws.String("lt-1234"),
+		VersionNumber:    aws.Int64(3),
+		LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+			TagSpecifications: []*ec2.LaunchTemplateTagSpecification{
+				{
+					ResourceType: aws.String("instance"),
+					Tags: []*ec2.Tag{
+						{Key: aws.String("Name"), Value: aws.String("my-cluster-my-ng-Node")},
+						{Key: aws.String("team"), Value: aws.String("platform")},
+					},
+				},
+				{
+					ResourceType: aws.String("spot-instances-request"),
+					Tags:         []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+				},
+			},
+		},
+	}
+
+	instances := []*autoscaling.Instance{
+		{InstanceId: aws.String("i-1"), LifecycleState: aws.String(autoscaling.LifecycleStateInService)},
+		{InstanceId: aws.String("i-2"), LifecycleState: aws.String(autoscaling.LifecycleStatePending)},
+		{InstanceId: aws.String("i-3"), LifecycleState: aws.String(autoscaling.LifecycleStateTerminatingWait)},
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+		stackManager = new(fakes.FakeStackManager)
+		m.SetStackManager(stackManager)
+
+		options = nodegroup.UpdateTagsOptions{
+			Tags: map[string]string{"team": "data", "cost-center": "1234"},
+		}
+
+		p.MockEC2().On("DescribeLaunchTemplateVersions", &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String("lt-1234"),
+			Versions:         []*string{aws.String("3")},
+		}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{launchTemplateVersion},
+		}, nil)
+		p.MockEC2().On("CreateLaunchTemplateVersion", mock.Anything).Return(&ec2.CreateLaunchTemplateVersionOutput{
+			LaunchTemplateVersion: &ec2.LaunchTemplateVersion{
+				LaunchTemplateId: aws.String("lt-1234"),
+				VersionNumber:    aws.Int64(4),
+			},
+		}, nil)
+		p.MockEC2().On("CreateTags", mock.Anything).Return(&ec2.CreateTagsOutput{}, nil)
+	})
+
+	Describe("the tag diff", func() {
+		It("lists the added and changed tags sorted by key", func() {
+			changes := nodegroup.DiffTags(
+				map[string]string{"team": "platform", "env": "prod", "Name": "node"},
+				map[string]string{"team": "data", "env": "prod", "cost-center": "1234"},
+			)
+			Expect(changes).To(Equal([]nodegroup.TagChange{
+				{Key: "cost-center", Value: "1234"},
+				{Key: "team", Previous: aws.String("platform"), Value: "data"},
+			}))
+			Expect(changes[0].String()).To(Equal(`add tag "cost-center" with value "1234"`))
+			Expect(changes[1].String()).To(Equal(`change tag "team" from "platform" to "data"`))
+		})
+
+		It("has no changes when the tags are already set", func() {
+			Expect(nodegroup.DiffTags(map[string]string{"team": "data"}, map[string]string{"team": "data"})).To(BeEmpty())
+		})
+	})
+
+	Context("an unmanaged nodegroup", func() {
+		const template = `{
+  "Resources": {
+    "NodeGroup": {
+      "Type": "AWS::AutoScaling::AutoScalingGroup",
+      "Properties": {
+        "Tags": [
+          {"Key": "Name", "Value": "my-cluster-my-ng-Node", "PropagateAtLaunch": "true"},
+          {"Key": "team", "Value": "platform", "PropagateAtLaunch": "true"}
+        ]
+      }
+    }
+  }
+}`
+
+		BeforeEach(func() {
+			stackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeUnmanaged, nil)
+			stackManager.DescribeNodeGroupStackReturns(&manager.Stack{StackName: aws.String("eksctl-my-cluster-nodegroup-my-ng")}, nil)
+			stackManager.GetAutoScalingGroupNameReturns("my-asg", nil)
+			stackManager.GetStackTemplateReturns(template, nil)
+
+			p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+				AutoScalingGroupNames: aws.StringSlice([]string{"my-asg"}),
+			}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{{
+					AutoScalingGroupName: aws.String("my-asg"),
+					Instances:            instances,
+				}},
+			}, nil)
+		})
+
+		It("only logs the tag delta in plan mode", func() {
+			options.Plan = true
+			Expect(m.UpdateTags(ngName, options)).To(Succeed())
+			Expect(stackManager.UpdateNodeGroupStackCallCount()).To(BeZero())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "CreateTags", mock.Anything)
+		})
+
+		It("adds the tags to the Auto Scaling group of the nodegroup stack", func() {
+			Expect(m.UpdateTags(ngName, options)).To(Succeed())
+
+			Expect(stackManager.GetStackTemplateArgsForCall(0)).To(Equal("eksctl-my-cluster-nodegroup-my-ng"))
+			Expect(stackManager.UpdateNodeGroupStackCallCount()).To(Equal(1))
+			name, updated := stackManager.UpdateNodeGroupStackArgsForCall(0)
+			Expect(name).To(Equal(ngName))
+			Expect(updated).To(MatchJSON(`{
+  "Resources": {
+    "NodeGroup": {
+      "Type": "AWS::AutoScaling::AutoScalingGroup",
+      "Properties": {
+        "Tags": [
+          {"Key": "Name", "Value": "my-cluster-my-ng-Node", "PropagateAtLaunch": "true"},
+          {"Key": "team", "Value": "data", "PropagateAtLaunch": "true"},
+          {"Key": "cost-center", "Value": "1234", "PropagateAtLaunch": "true"}
+        ]
+      }
+    }
+  }
+}`))
+			p.MockEC2().AssertNotCalled(GinkgoT(), "CreateLaunchTemplateVersion", mock.Anything)
+		})
+
+		It("tags the running instances that are not terminating", func() {
+			Expect(m.UpdateTags(ngName, options)).To(Succeed())
+			p.MockEC2().AssertCalled(GinkgoT(), "CreateTags", &ec2.CreateTagsInput{
+				Resources: aws.StringSlice([]string{"i-1", "i-2"}),
+				Tags: []*ec2.Tag{
+					{Key: aws.String("cost-center"), Value: aws.String("1234")},
+					{Key: aws.String("team"), Value: aws.String("data")},
+				},
+			})
+		})
+
+		It("tags at most 1000 instances per call", func() {
+			var many []*autoscaling.Instance
+			for i := 0; i < 2500; i++ {
+				many = append(many, &autoscaling.Instance{
+					InstanceId:     aws.String(fmt.Sprintf("i-%d", i)),
+					LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+				})
+			}
+			p = mockprovider.NewMockProvider()
+			m = nodegroup.New(api.NewClusterConfig(), &eks.ClusterProvider{Provider: p}, nil)
+			m.SetStackManager(stackManager)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{{AutoScalingGroupName: aws.String("my-asg"), Instances: many}},
+			}, nil)
+			var tagged []int
+			p.MockEC2().On("CreateTags", mock.Anything).Run(func(args mock.Arguments) {
+				tagged = append(tagged, len(args.Get(0).(*ec2.CreateTagsInput).Resources))
+			}).Return(&ec2.CreateTagsOutput{}, nil)
+
+			Expect(m.UpdateTags(ngName, options)).To(Succeed())
+			Expect(tagged).To(Equal([]int{1000, 1000, 500}))
+		})
+
+		It("does not update the stack when the Auto Scaling group already has the tags", func() {
+			options.Tags = map[string]string{"team": "platform"}
+			Expect(m.UpdateTags(ngName, options)).To(Succeed())
+			Expect(stackManager.UpdateNodeGroupStackCallCount()).To(BeZero())
+			p.MockEC2().AssertNumberOfCalls(GinkgoT(), "CreateTags", 1)
+		})
+	})
+
+	Context("a managed nodegroup", func() {
+		BeforeEach(func() {
+			stackManager.GetNodeGroupStackTypeReturns(api.NodeGroupTypeManaged, nil)
+			p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				ClusterName:   aws.String(clusterName),
+				NodegroupName: aws.String(ngName),
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &awseks.Nodegroup{
+					NodegroupName: aws.String(ngName),
+					LaunchTemplate: &awseks.LaunchTemplateSpecification{
+						Id:      aws.String("lt-1234"),
+						Version: aws.String("3"),
+					},
+					Resources: &awseks.NodegroupResources{
+						AutoScalingGroups: []*awseks.AutoScalingGroup{{Name: aws.String("eks-my-ng-1234")}},
+					},
+				},
+			}, nil)
+			p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{
+				AutoScalingGroups: []*autoscaling.Group{{
+					AutoScalingGroupName: aws.String("eks-my-ng-1234"),
+					Instances:            instances,
+				}},
+			}, nil)
+		})
+
+		It("creates a launch template version without rolling it out and tags the running instances", func() {
+			Expect(m.UpdateTags(ngName, options)).To(Succeed())
+			p.MockEC2().AssertNumberOfCalls(GinkgoT(), "CreateLaunchTemplateVersion", 1)
+			p.MockEC2().AssertNumberOfCalls(GinkgoT(), "CreateTags", 1)
+			p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupVersion", mock.Anything)
+			p.MockASG().AssertNotCalled(GinkgoT(), "UpdateAutoScalingGroup", mock.Anything)
+		})
+	})
+
+	It("rejects tags with the reserved aws: prefix", func() {
+		options.Tags = map[string]string{"aws:cloudformation:stack-name": "stack"}
+		Expect(m.UpdateTags(ngName, options)).To(MatchError(`tag "aws:cloudformation:stack-name" uses the reserved prefix "aws:"`))
+	})
+})
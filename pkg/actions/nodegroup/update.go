@@ -2,16 +2,28 @@ package nodegroup
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
+// Update updates the nodegroups of the config, the AMI of unmanaged nodegroups, the updateConfig and instance types
+// of managed nodegroups
 func (m *Manager) Update() error {
+	for _, ng := range m.cfg.NodeGroups {
+		if err := m.updateUnmanagedNodegroup(ng); err != nil {
+			return err
+		}
+	}
 	for _, ng := range m.cfg.ManagedNodeGroups {
 		if err := m.updateNodegroup(ng); err != nil {
 			return err
@@ -23,7 +35,7 @@ func (m *Manager) Update() error {
 func (m *Manager) updateNodegroup(ng *api.ManagedNodeGroup) error {
 	logger.Info("checking that nodegroup %s is a managed nodegroup", ng.Name)
 
-	_, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
 		ClusterName:   &m.cfg.Metadata.Name,
 		NodegroupName: &ng.Name,
 	})
@@ -35,28 +47,174 @@ func (m *Manager) updateNodegroup(ng *api.ManagedNodeGroup) error {
 		return err
 	}
 
-	if ng.UpdateConfig == nil {
+	instanceTypes, err := m.instanceTypesUpdate(ng, output.Nodegroup)
+	if err != nil {
+		return err
+	}
+
+	if ng.UpdateConfig == nil && instanceTypes == nil {
 		return fmt.Errorf("the submitted config does not contain any changes for nodegroup %s", ng.Name)
 	}
 
-	updateConfig, err := updateUpdateConfig(ng)
-	if err != nil {
-		return err
+	if ng.UpdateConfig != nil {
+		updateConfig, err := updateUpdateConfig(ng)
+		if err != nil {
+			return err
+		}
+
+		_, err = m.ctl.Provider.EKS().UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+			UpdateConfig:  updateConfig,
+			ClusterName:   &m.cfg.Metadata.Name,
+			NodegroupName: &ng.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update nodegroup %s: %w", ng.Name, err)
+		}
+
+		if instanceTypes != nil {
+			// EKS only allows one update of a nodegroup at a time
+			if err := m.waitForNodegroupActive(ng.Name, fmt.Sprintf("waiting for update of nodegroup %q to complete", ng.Name)); err != nil {
+				return err
+			}
+		}
 	}
 
-	_, err = m.ctl.Provider.EKS().UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
-		UpdateConfig:  updateConfig,
-		ClusterName:   &m.cfg.Metadata.Name,
-		NodegroupName: &ng.Name,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to update nodegroup %s: %w", ng.Name, err)
+	if instanceTypes != nil {
+		if err := m.updateInstanceTypes(ng.Name, output.Nodegroup, instanceTypes); err != nil {
+			return err
+		}
 	}
 
 	logger.Info("nodegroup %s successfully updated", ng.Name)
 	return nil
 }
 
+// instanceTypesUpdate returns the instance types that the nodegroup should be updated to,
+// or nil if instanceTypes is not set or matches the instance types of the nodegroup.
+// Only instance types defined in the nodegroup's launch template can be changed in place, and the
+// new instance types must have the same architecture as the nodegroup's AMI
+func (m *Manager) instanceTypesUpdate(ng *api.ManagedNodeGroup, nodeGroup *eks.Nodegroup) ([]string, error) {
+	if len(ng.InstanceTypes) == 0 {
+		return nil, nil
+	}
+
+	currentInstanceTypes := aws.StringValueSlice(nodeGroup.InstanceTypes)
+	inLaunchTemplate := false
+	if len(currentInstanceTypes) == 0 && nodeGroup.LaunchTemplate != nil {
+		launchTemplateData, err := m.fetchLaunchTemplateData(nodeGroup.LaunchTemplate)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error fetching launch template of nodegroup %q", ng.Name)
+		}
+		if launchTemplateData.InstanceType != nil {
+			currentInstanceTypes = []string{*launchTemplateData.InstanceType}
+			inLaunchTemplate = true
+		}
+	}
+
+	if sets.NewString(currentInstanceTypes...).Equal(sets.NewString(ng.InstanceTypes...)) {
+		return nil, nil
+	}
+
+	logger.Info("instance types of nodegroup %q will be changed from %v to %v", ng.Name, currentInstanceTypes, ng.InstanceTypes)
+
+	if !inLaunchTemplate {
+		return nil, fmt.Errorf("instanceTypes of nodegroup %q cannot be changed in place because EKS does not allow changing the instance types set on a nodegroup; "+
+			"only an instance type defined in the nodegroup's launch template can be changed. Create a new nodegroup with the desired instance types instead", ng.Name)
+	}
+	if len(ng.InstanceTypes) > 1 {
+		return nil, fmt.Errorf("instanceTypes of nodegroup %q can only be changed to a single instance type, as its launch template defines one instance type", ng.Name)
+	}
+
+	if err := validateInstanceTypesArchitecture(ng.Name, nodeGroup, currentInstanceTypes[0], ng.InstanceTypes); err != nil {
+		return nil, err
+	}
+	return ng.InstanceTypes, nil
+}
+
+// validateInstanceTypesArchitecture checks that the new instance types have the same architecture as the
+// nodegroup's AMI, or as its current instance type if it uses a custom AMI
+func validateInstanceTypesArchitecture(ngName string, nodeGroup *eks.Nodegroup, currentInstanceType string, instanceTypes []string) error {
+	var isARM bool
+	switch amiType := aws.StringValue(nodeGroup.AmiType); amiType {
+	case eks.AMITypesAl2Arm64:
+		isARM = true
+	case eks.AMITypesAl2X8664, eks.AMITypesAl2X8664Gpu:
+		isARM = false
+	default:
+		isARM = utils.IsARMInstanceType(currentInstanceType)
+	}
+
+	for _, instanceType := range instanceTypes {
+		if utils.IsARMInstanceType(instanceType) != isARM {
+			return fmt.Errorf("instance type %q does not have the same architecture as the current instance type %q of nodegroup %q", instanceType, currentInstanceType, ngName)
+		}
+	}
+	return nil
+}
+
+func (m *Manager) fetchLaunchTemplateData(lt *eks.LaunchTemplateSpecification) (*ec2.ResponseLaunchTemplateData, error) {
+	version, err := m.fetchLaunchTemplateVersion(lt)
+	if err != nil {
+		return nil, err
+	}
+	return version.LaunchTemplateData, nil
+}
+
+// fetchLaunchTemplateVersion returns the version of the launch template lt refers to, its default version
+// if lt has no version
+func (m *Manager) fetchLaunchTemplateVersion(lt *eks.LaunchTemplateSpecification) (*ec2.LaunchTemplateVersion, error) {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId:   lt.Id,
+		LaunchTemplateName: lt.Name,
+	}
+	if lt.Id != nil {
+		input.LaunchTemplateName = nil
+	}
+	if lt.Version != nil {
+		input.Versions = []*string{lt.Version}
+	} else {
+		input.Versions = []*string{aws.String("$Default")}
+	}
+	output, err := m.ctl.Provider.EC2().DescribeLaunchTemplateVersions(input)
+	if err != nil {
+		return nil, err
+	}
+	if len(output.LaunchTemplateVersions) != 1 {
+		return nil, errors.New("failed to find launch template version")
+	}
+	return output.LaunchTemplateVersions[0], nil
+}
+
+// updateInstanceTypes creates a new version of the nodegroup's launch template with the given instance type
+// and rolls it out to the nodegroup
+func (m *Manager) updateInstanceTypes(ngName string, nodeGroup *eks.Nodegroup, instanceTypes []string) error {
+	lt := nodeGroup.LaunchTemplate
+	input := &ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateId:   lt.Id,
+		LaunchTemplateName: lt.Name,
+		SourceVersion:      lt.Version,
+		VersionDescription: aws.String(fmt.Sprintf("eksctl: change instance type to %s", instanceTypes[0])),
+		LaunchTemplateData: &ec2.RequestLaunchTemplateData{
+			InstanceType: aws.String(instanceTypes[0]),
+		},
+	}
+	if lt.Id != nil {
+		input.LaunchTemplateName = nil
+	}
+	output, err := m.ctl.Provider.EC2().CreateLaunchTemplateVersion(input)
+	if err != nil {
+		return errors.Wrapf(err, "error creating launch template version for nodegroup %q", ngName)
+	}
+	version := strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10)
+	logger.Info("created version %s of launch template %q for nodegroup %q", version, aws.StringValue(output.LaunchTemplateVersion.LaunchTemplateId), ngName)
+
+	_, _, err = m.upgrade(managed.UpgradeOptions{
+		NodegroupName:         ngName,
+		LaunchTemplateVersion: version,
+	})
+	return err
+}
+
 func updateUpdateConfig(ng *api.ManagedNodeGroup) (*eks.NodegroupUpdateConfig, error) {
 	logger.Info("updating nodegroup %s's UpdateConfig", ng.Name)
 	updateConfig := &eks.NodegroupUpdateConfig{}
@@ -2,14 +2,23 @@ package nodegroup
 
 import (
 	"errors"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
@@ -136,4 +145,254 @@ var _ = Describe("Update", func() {
 		err := m.Update()
 		Expect(err).NotTo(HaveOccurred())
 	})
+
+	Context("changing instance types", func() {
+		var launchTemplateInstanceType string
+
+		mockLaunchTemplateNodegroup := func(amiType string) {
+			p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+			}).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &awseks.Nodegroup{
+					AmiType: aws.String(amiType),
+					Version: aws.String("1.21"),
+					LaunchTemplate: &awseks.LaunchTemplateSpecification{
+						Id:      aws.String("lt-1234"),
+						Name:    aws.String("my-lt"),
+						Version: aws.String("2"),
+					},
+				},
+			}, nil)
+
+			p.MockEC2().On("DescribeLaunchTemplateVersions", &ec2.DescribeLaunchTemplateVersionsInput{
+				LaunchTemplateId: aws.String("lt-1234"),
+				Versions:         aws.StringSlice([]string{"2"}),
+			}).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+				LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{
+					{
+						LaunchTemplateData: &ec2.ResponseLaunchTemplateData{
+							InstanceType: aws.String(launchTemplateInstanceType),
+						},
+					},
+				},
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			launchTemplateInstanceType = "m5.large"
+		})
+
+		It("[happy path] creates a new launch template version and rolls it out", func() {
+			mockLaunchTemplateNodegroup(awseks.AMITypesAl2X8664)
+
+			p.MockEC2().On("CreateLaunchTemplateVersion", mock.MatchedBy(func(input *ec2.CreateLaunchTemplateVersionInput) bool {
+				return aws.StringValue(input.LaunchTemplateId) == "lt-1234" && input.LaunchTemplateName == nil &&
+					aws.StringValue(input.SourceVersion) == "2" &&
+					aws.StringValue(input.LaunchTemplateData.InstanceType) == "m5.xlarge"
+			})).Return(&ec2.CreateLaunchTemplateVersionOutput{
+				LaunchTemplateVersion: &ec2.LaunchTemplateVersion{
+					LaunchTemplateId: aws.String("lt-1234"),
+					VersionNumber:    aws.Int64(3),
+				},
+			}, nil)
+
+			p.MockEKS().On("UpdateNodegroupVersion", &awseks.UpdateNodegroupVersionInput{
+				ClusterName:   &clusterName,
+				NodegroupName: &ngName,
+				Force:         aws.Bool(false),
+				Version:       aws.String("1.21"),
+				LaunchTemplate: &awseks.LaunchTemplateSpecification{
+					Id:      aws.String("lt-1234"),
+					Version: aws.String("3"),
+				},
+			}).Return(&awseks.UpdateNodegroupVersionOutput{}, nil)
+
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m5.xlarge"}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			Expect(m.Update()).To(Succeed())
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UpdateNodegroupVersion", 1)
+		})
+
+		It("waits for the updateConfig change to complete before changing instance types", func() {
+			mockLaunchTemplateNodegroup(awseks.AMITypesAl2X8664)
+
+			p.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(nil, nil)
+			p.MockEC2().On("CreateLaunchTemplateVersion", mock.Anything).Return(&ec2.CreateLaunchTemplateVersionOutput{
+				LaunchTemplateVersion: &ec2.LaunchTemplateVersion{
+					LaunchTemplateId: aws.String("lt-1234"),
+					VersionNumber:    aws.Int64(3),
+				},
+			}, nil)
+			p.MockEKS().On("UpdateNodegroupVersion", mock.Anything).Return(&awseks.UpdateNodegroupVersionOutput{}, nil)
+
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m5.xlarge"}
+			cfg.ManagedNodeGroups[0].UpdateConfig = &api.NodeGroupUpdateConfig{
+				MaxUnavailable: aws.Int(2),
+			}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			waitCalls := 0
+			m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
+				p.MockEC2().AssertNotCalled(GinkgoT(), "CreateLaunchTemplateVersion", mock.Anything)
+				waitCalls++
+				return nil
+			})
+			Expect(m.Update()).To(Succeed())
+			Expect(waitCalls).To(Equal(1))
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UpdateNodegroupVersion", 1)
+		})
+
+		It("does nothing when the instance type is unchanged", func() {
+			mockLaunchTemplateNodegroup(awseks.AMITypesAl2X8664)
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m5.large"}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			Expect(m.Update()).To(MatchError("the submitted config does not contain any changes for nodegroup my-ng"))
+			p.MockEC2().AssertNotCalled(GinkgoT(), "CreateLaunchTemplateVersion", mock.Anything)
+		})
+
+		It("fails when the new instance type has a different architecture", func() {
+			mockLaunchTemplateNodegroup(awseks.AMITypesAl2X8664)
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m6g.large"}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			Expect(m.Update()).To(MatchError(`instance type "m6g.large" does not have the same architecture as the current instance type "m5.large" of nodegroup "my-ng"`))
+		})
+
+		It("uses the architecture of the current instance type for custom AMIs", func() {
+			launchTemplateInstanceType = "m6g.large"
+			mockLaunchTemplateNodegroup(awseks.AMITypesCustom)
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m5.large"}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			Expect(m.Update()).To(MatchError(ContainSubstring(`instance type "m5.large" does not have the same architecture`)))
+		})
+
+		It("fails when more than one instance type is set for a nodegroup using a launch template", func() {
+			mockLaunchTemplateNodegroup(awseks.AMITypesAl2X8664)
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m5.xlarge", "m5a.xlarge"}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			Expect(m.Update()).To(MatchError(ContainSubstring("can only be changed to a single instance type")))
+		})
+
+		It("fails when the instance types are set on the nodegroup itself", func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+				Nodegroup: &awseks.Nodegroup{
+					AmiType:       aws.String(awseks.AMITypesAl2X8664),
+					InstanceTypes: aws.StringSlice([]string{"m5.large"}),
+				},
+			}, nil)
+			cfg.ManagedNodeGroups[0].InstanceTypes = []string{"m5.xlarge"}
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			Expect(m.Update()).To(MatchError(ContainSubstring(`instanceTypes of nodegroup "my-ng" cannot be changed in place`)))
+			p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupVersion", mock.Anything)
+		})
+	})
+
+	Context("updating the AMI of unmanaged nodegroups", func() {
+		const template = `{"Resources":{"NodeGroup":{"Type":"AWS::AutoScaling::AutoScalingGroup","UpdatePolicy":{"AutoScalingRollingUpdate":{}}},` +
+			`"NodeGroupLaunchTemplate":{"Type":"AWS::EC2::LaunchTemplate","Properties":{"LaunchTemplateData":{"ImageId":"ami-123"}}}}}`
+
+		var (
+			fakeStackManager *fakes.FakeStackManager
+			ng               *api.NodeGroup
+		)
+
+		BeforeEach(func() {
+			ng = &api.NodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name: ngName,
+					AMI:  "ami-456",
+				},
+			}
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+			cfg.ManagedNodeGroups = nil
+
+			fakeStackManager = new(fakes.FakeStackManager)
+			fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{
+				StackName: aws.String("eksctl-my-cluster-nodegroup-my-ng"),
+				Tags: []*cloudformation.Tag{
+					{
+						Key:   aws.String(api.NodeGroupNameTag),
+						Value: aws.String(ngName),
+					},
+					{
+						Key:   aws.String(api.NodeGroupTypeTag),
+						Value: aws.String(string(api.NodeGroupTypeUnmanaged)),
+					},
+				},
+			}, nil)
+			fakeStackManager.GetStackTemplateReturns(template, nil)
+			fakeStackManager.GetAutoScalingGroupNameReturns("asg-my-ng", nil)
+
+			m = New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+			m.stackManager = fakeStackManager
+		})
+
+		It("[happy path] updates the stack and starts an instance refresh with instanceRefresh", func() {
+			ng.InstanceRefresh = &api.InstanceRefresh{
+				MinHealthyPercentage: aws.Int(75),
+				InstanceWarmup:       aws.Int(120),
+			}
+			p.MockASG().On("StartInstanceRefresh", &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String("asg-my-ng"),
+				Strategy:             aws.String(autoscaling.RefreshStrategyRolling),
+				Preferences: &autoscaling.RefreshPreferences{
+					MinHealthyPercentage: aws.Int64(75),
+					InstanceWarmup:       aws.Int64(120),
+				},
+			}).Return(&autoscaling.StartInstanceRefreshOutput{InstanceRefreshId: aws.String("refresh-1")}, nil)
+
+			Expect(m.Update()).To(Succeed())
+
+			Expect(fakeStackManager.GetStackTemplateArgsForCall(0)).To(Equal("eksctl-my-cluster-nodegroup-my-ng"))
+			Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(Equal(1))
+			name, updatedTemplate := fakeStackManager.UpdateNodeGroupStackArgsForCall(0)
+			Expect(name).To(Equal(ngName))
+			Expect(gjson.Get(updatedTemplate, launchTemplateImageIDPath).String()).To(Equal("ami-456"))
+			Expect(gjson.Get(updatedTemplate, nodeGroupUpdatePolicyPath).Exists()).To(BeFalse())
+			p.MockASG().AssertNumberOfCalls(GinkgoT(), "StartInstanceRefresh", 1)
+		})
+
+		It("keeps the rolling update of the stack without instanceRefresh", func() {
+			Expect(m.Update()).To(Succeed())
+
+			_, updatedTemplate := fakeStackManager.UpdateNodeGroupStackArgsForCall(0)
+			Expect(gjson.Get(updatedTemplate, launchTemplateImageIDPath).String()).To(Equal("ami-456"))
+			Expect(gjson.Get(updatedTemplate, nodeGroupUpdatePolicyPath).Exists()).To(BeTrue())
+			p.MockASG().AssertNotCalled(GinkgoT(), "StartInstanceRefresh", mock.Anything)
+		})
+
+		It("fails when the AMI is unchanged", func() {
+			ng.AMI = "ami-123"
+			Expect(m.Update()).To(MatchError("the submitted config does not contain any changes for nodegroup my-ng"))
+			Expect(fakeStackManager.UpdateNodeGroupStackCallCount()).To(BeZero())
+		})
+
+		It("fails when the AMI is not set to an AMI ID", func() {
+			ng.AMI = api.NodeImageResolverAutoSSM
+			Expect(m.Update()).To(MatchError(ContainSubstring(`the ami of nodegroup "my-ng" must be set to the ID of the AMI`)))
+			Expect(fakeStackManager.DescribeNodeGroupStackCallCount()).To(BeZero())
+		})
+
+		It("fails for managed nodegroups", func() {
+			fakeStackManager.DescribeNodeGroupStackReturns(&manager.Stack{
+				Tags: []*cloudformation.Tag{
+					{
+						Key:   aws.String(api.NodeGroupNameTag),
+						Value: aws.String(ngName),
+					},
+					{
+						Key:   aws.String(api.NodeGroupTypeTag),
+						Value: aws.String(string(api.NodeGroupTypeManaged)),
+					},
+				},
+			}, nil)
+			Expect(m.Update()).To(MatchError(ContainSubstring(`nodegroup "my-ng" is a managed nodegroup`)))
+		})
+	})
 })
@@ -0,0 +1,110 @@
+package nodegroup
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+const (
+	launchTemplateImageIDPath = "Resources.NodeGroupLaunchTemplate.Properties.LaunchTemplateData.ImageId"
+	nodeGroupUpdatePolicyPath = "Resources.NodeGroup.UpdatePolicy"
+)
+
+// updateUnmanagedNodegroup updates the AMI of an unmanaged nodegroup. With instanceRefresh, the nodes are replaced
+// by an instance refresh of its Auto Scaling group once its stack has been updated, otherwise by the rolling update
+// of its stack
+func (m *Manager) updateUnmanagedNodegroup(ng *api.NodeGroup) error {
+	if !api.IsAMI(ng.AMI) {
+		return fmt.Errorf("the ami of nodegroup %q must be set to the ID of the AMI to update it to", ng.Name)
+	}
+
+	stack, err := m.stackManager.DescribeNodeGroupStack(ng.Name)
+	if err != nil {
+		return errors.Wrapf(err, "could not find unmanaged nodegroup with name %q", ng.Name)
+	}
+	nodeGroupType, err := manager.GetNodeGroupType(stack.Tags)
+	if err != nil {
+		return err
+	}
+	if nodeGroupType != api.NodeGroupTypeUnmanaged {
+		return fmt.Errorf("nodegroup %q is a managed nodegroup, it must be set in managedNodeGroups", ng.Name)
+	}
+
+	template, err := m.stackManager.GetStackTemplate(aws.StringValue(stack.StackName))
+	if err != nil {
+		return errors.Wrapf(err, "error fetching template of nodegroup %q", ng.Name)
+	}
+	currentAMI := gjson.Get(template, launchTemplateImageIDPath)
+	if !currentAMI.Exists() {
+		return fmt.Errorf("unexpected error: failed to find the AMI of the launch template of nodegroup %q", ng.Name)
+	}
+	if currentAMI.String() == ng.AMI {
+		return fmt.Errorf("the submitted config does not contain any changes for nodegroup %s", ng.Name)
+	}
+
+	template, err = updateUnmanagedNodeGroupTemplate(template, ng)
+	if err != nil {
+		return err
+	}
+	logger.Info("updating the AMI of nodegroup %q from %q to %q", ng.Name, currentAMI.String(), ng.AMI)
+	if err := m.stackManager.UpdateNodeGroupStack(ng.Name, template); err != nil {
+		return errors.Wrapf(err, "error updating stack of nodegroup %q", ng.Name)
+	}
+
+	if ng.InstanceRefresh == nil {
+		logger.Info("nodegroup %s successfully updated", ng.Name)
+		return nil
+	}
+	asgName, err := m.stackManager.GetAutoScalingGroupName(stack)
+	if err != nil {
+		return err
+	}
+	output, err := m.ctl.Provider.ASG().StartInstanceRefresh(newStartInstanceRefreshInput(asgName, ng.InstanceRefresh))
+	if err != nil {
+		return errors.Wrapf(err, "failed to start instance refresh of nodegroup %q", ng.Name)
+	}
+	logger.Info("started instance refresh %s of nodegroup %q, its progress can be followed with 'aws autoscaling describe-instance-refreshes --auto-scaling-group-name %s'",
+		aws.StringValue(output.InstanceRefreshId), ng.Name, asgName)
+	return nil
+}
+
+// updateUnmanagedNodeGroupTemplate sets the AMI of the launch template of the nodegroup. With instanceRefresh, the
+// update policy of the Auto Scaling group is removed so that updating the stack does not replace the nodes
+func updateUnmanagedNodeGroupTemplate(template string, ng *api.NodeGroup) (string, error) {
+	template, err := sjson.Set(template, launchTemplateImageIDPath, ng.AMI)
+	if err != nil {
+		return "", errors.Wrap(err, "setting AMI of launch template")
+	}
+	if ng.InstanceRefresh != nil {
+		if template, err = sjson.Delete(template, nodeGroupUpdatePolicyPath); err != nil {
+			return "", errors.Wrap(err, "removing update policy of Auto Scaling group")
+		}
+	}
+	return template, nil
+}
+
+func newStartInstanceRefreshInput(asgName string, instanceRefresh *api.InstanceRefresh) *autoscaling.StartInstanceRefreshInput {
+	input := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(asgName),
+		Strategy:             aws.String(autoscaling.RefreshStrategyRolling),
+	}
+	if instanceRefresh.MinHealthyPercentage != nil || instanceRefresh.InstanceWarmup != nil {
+		input.Preferences = &autoscaling.RefreshPreferences{}
+		if instanceRefresh.MinHealthyPercentage != nil {
+			input.Preferences.MinHealthyPercentage = aws.Int64(int64(*instanceRefresh.MinHealthyPercentage))
+		}
+		if instanceRefresh.InstanceWarmup != nil {
+			input.Preferences.InstanceWarmup = aws.Int64(int64(*instanceRefresh.InstanceWarmup))
+		}
+	}
+	return input
+}
@@ -1,7 +1,10 @@
 package nodegroup
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 
@@ -11,12 +14,32 @@ import (
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/blang/semver"
 	"github.com/kris-nova/logger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/managed"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
 func (m *Manager) Upgrade(options managed.UpgradeOptions, wait bool) error {
+	if len(options.PreserveLabels) == 0 {
+		return m.upgradeNodeGroup(options, wait)
+	}
+
+	reapplyLabels, err := m.preserveNodeLabels(options.NodegroupName, options.PreserveLabels)
+	if err != nil {
+		return err
+	}
+	upgradeErr := m.upgradeNodeGroup(options, wait)
+	if err := reapplyLabels(); err != nil && upgradeErr == nil {
+		return err
+	}
+	return upgradeErr
+}
+
+func (m *Manager) upgradeNodeGroup(options managed.UpgradeOptions, wait bool) error {
 	stackCollection := manager.NewStackCollection(m.ctl.Provider, m.cfg)
 	hasStacks, err := m.hasStacks(options.NodegroupName)
 	if err != nil {
@@ -34,12 +57,13 @@ func (m *Manager) Upgrade(options managed.UpgradeOptions, wait bool) error {
 		return managedService.UpgradeNodeGroup(options)
 	}
 
-	if err := m.upgrade(options); err != nil {
+	update, previous, err := m.upgrade(options)
+	if err != nil {
 		return err
 	}
 
 	if wait {
-		return m.waitForUpgrade(options)
+		return m.waitForUpgrade(options, update, previous)
 	}
 
 	logger.Info("nodegroup upgrade request submitted successfully")
@@ -48,7 +72,67 @@ func (m *Manager) Upgrade(options managed.UpgradeOptions, wait bool) error {
 
 }
 
-func (m *Manager) upgrade(options managed.UpgradeOptions) error {
+// preserveNodeLabels snapshots the labels matching patterns on the current nodes of the nodegroup and
+// re-applies them to the nodes that join it while it is being upgraded. The returned function stops
+// watching for new nodes, after re-applying the labels to the nodes that joined since the last poll
+func (m *Manager) preserveNodeLabels(nodegroupName string, patterns []string) (func() error, error) {
+	listOptions := metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", api.EKSNodeGroupNameLabel, nodegroupName),
+	}
+	nodes, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing nodes of nodegroup %q", nodegroupName)
+	}
+	labels := newNodeLabelSnapshot(patterns)
+	known := sets.NewString()
+	for _, node := range nodes.Items {
+		labels.add(node)
+		known.Insert(node.Name)
+	}
+
+	applyToNewNodes := func() error {
+		nodes, err := m.clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+		if err != nil {
+			return errors.Wrapf(err, "listing nodes of nodegroup %q", nodegroupName)
+		}
+		for _, node := range nodes.Items {
+			if known.Has(node.Name) {
+				continue
+			}
+			if err := labels.apply(m.clientSet, node); err != nil {
+				return err
+			}
+			known.Insert(node.Name)
+		}
+		return nil
+	}
+
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(nodeRotationPollInterval):
+			}
+			if err := applyToNewNodes(); err != nil {
+				logger.Warning("%v", err)
+			}
+		}
+	}()
+
+	return func() error {
+		close(stop)
+		<-done
+		return applyToNewNodes()
+	}, nil
+}
+
+// upgrade starts the upgrade of the nodegroup and returns the update along with the nodegroup as it
+// was before the upgrade
+func (m *Manager) upgrade(options managed.UpgradeOptions) (*eks.Update, *eks.Nodegroup, error) {
 	input := &eks.UpdateNodegroupVersionInput{
 		ClusterName:   &m.cfg.Metadata.Name,
 		Force:         &options.ForceUpgrade,
@@ -62,13 +146,13 @@ func (m *Manager) upgrade(options managed.UpgradeOptions) error {
 	})
 
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	if options.LaunchTemplateVersion != "" {
 		lt := describeNodegroupOutput.Nodegroup.LaunchTemplate
 		if lt == nil || (lt.Id == nil && lt.Name == nil) {
-			return errors.New("cannot update launch template version because the nodegroup is not configured to use one")
+			return nil, nil, errors.New("cannot update launch template version because the nodegroup is not configured to use one")
 		}
 
 		input.LaunchTemplate = &eks.LaunchTemplateSpecification{
@@ -87,7 +171,7 @@ func (m *Manager) upgrade(options managed.UpgradeOptions) error {
 		// Use the current Kubernetes version
 		version, err := semver.ParseTolerant(*describeNodegroupOutput.Nodegroup.Version)
 		if err != nil {
-			return errors.Wrapf(err, "unexpected error parsing Kubernetes version %q", *describeNodegroupOutput.Nodegroup.Version)
+			return nil, nil, errors.Wrapf(err, "unexpected error parsing Kubernetes version %q", *describeNodegroupOutput.Nodegroup.Version)
 		}
 		input.Version = aws.String(fmt.Sprintf("%v.%v", version.Major, version.Minor))
 	}
@@ -95,30 +179,171 @@ func (m *Manager) upgrade(options managed.UpgradeOptions) error {
 	upgradeResponse, err := m.ctl.Provider.EKS().UpdateNodegroupVersion(input)
 
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
+	var update *eks.Update
 	if upgradeResponse != nil {
 		logger.Debug("upgrade response for %q: %s", options.NodegroupName, upgradeResponse.String())
+		update = upgradeResponse.Update
 	}
 
 	logger.Info("upgrade of nodegroup %q in progress", options.NodegroupName)
+	return update, describeNodegroupOutput.Nodegroup, nil
+}
+
+func (m *Manager) waitForUpgrade(options managed.UpgradeOptions, update *eks.Update, previous *eks.Nodegroup) error {
+	msg := fmt.Sprintf("waiting for upgrade of nodegroup %q to complete", options.NodegroupName)
+	if update == nil || update.Id == nil {
+		if err := m.waitForNodegroupActive(options.NodegroupName, msg); err != nil {
+			return err
+		}
+		logger.Info("nodegroup successfully upgraded")
+		return nil
+	}
+
+	if err := m.waitForNodegroupUpdate(options.NodegroupName, *update.Id, msg); err != nil {
+		upgradeErr := m.updateFailure(options.NodegroupName, *update.Id, "upgrade")
+		if upgradeErr == nil {
+			// the update has not failed, e.g. it is still in progress after the wait timed out
+			return err
+		}
+		if !options.RollbackOnFailure {
+			return upgradeErr
+		}
+		return m.rollbackUpgrade(options, previous, upgradeErr)
+	}
+	logger.Info("nodegroup successfully upgraded")
 	return nil
 }
 
-func (m *Manager) waitForUpgrade(options managed.UpgradeOptions) error {
+// updateFailure returns an error describing why the nodegroup update failed, or nil if it has not
+// failed or its status cannot be described; operation names the update in the error
+func (m *Manager) updateFailure(nodegroupName, updateID, operation string) error {
+	output, err := m.ctl.Provider.EKS().DescribeUpdate(&eks.DescribeUpdateInput{
+		Name:          &m.cfg.Metadata.Name,
+		NodegroupName: &nodegroupName,
+		UpdateId:      &updateID,
+	})
+	if err != nil {
+		logger.Warning("unable to describe update %q of nodegroup %q: %v", updateID, nodegroupName, err)
+		return nil
+	}
+
+	status := aws.StringValue(output.Update.Status)
+	if status != eks.UpdateStatusFailed && status != eks.UpdateStatusCancelled {
+		return nil
+	}
+	var reasons []string
+	for _, e := range output.Update.Errors {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+	}
+	if len(reasons) == 0 {
+		return fmt.Errorf("%s of nodegroup %q %s", operation, nodegroupName, strings.ToLower(status))
+	}
+	return fmt.Errorf("%s of nodegroup %q %s: %s", operation, nodegroupName, strings.ToLower(status), strings.Join(reasons, "; "))
+}
+
+// rollbackUpgrade updates the nodegroup back to the launch template version it had before the failed
+// upgrade. EKS rejects updates to an older AMI release version, so the release version the nodes are
+// on is kept as it is, and the steps to go back to the previous one are logged if the upgrade changed
+// it. upgradeErr is returned with the outcome of the rollback in either case
+func (m *Manager) rollbackUpgrade(options managed.UpgradeOptions, previous *eks.Nodegroup, upgradeErr error) error {
+	output, err := m.ctl.Provider.EKS().DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		NodegroupName: &options.NodegroupName,
+	})
+	if err != nil {
+		return errors.Wrapf(upgradeErr, "failed to roll back nodegroup %q: %v", options.NodegroupName, err)
+	}
+	current := output.Nodegroup
+
+	usesCustomAMI := aws.StringValue(previous.AmiType) == eks.AMITypesCustom
+	releaseVersionChanged := !usesCustomAMI && aws.StringValue(current.ReleaseVersion) != aws.StringValue(previous.ReleaseVersion)
+	if releaseVersionChanged {
+		logger.Warning("EKS does not downgrade the AMI release version of nodegroups; to go back to release version %s, create a new nodegroup with `releaseVersion: %s` and delete nodegroup %q with `eksctl delete nodegroup --cluster=%s --name=%s`, which drains its nodes",
+			*previous.ReleaseVersion, *previous.ReleaseVersion, options.NodegroupName, m.cfg.Metadata.Name, options.NodegroupName)
+	}
+
+	if options.LaunchTemplateVersion == "" || previous.LaunchTemplate == nil || previous.LaunchTemplate.Version == nil {
+		if releaseVersionChanged {
+			return errors.Wrapf(upgradeErr, "nodegroup %q has to be rolled back to release version %s manually", options.NodegroupName, *previous.ReleaseVersion)
+		}
+		return errors.Wrapf(upgradeErr, "nodegroup %q was not rolled back, as the upgrade did not change its launch template version", options.NodegroupName)
+	}
+
+	input := &eks.UpdateNodegroupVersionInput{
+		ClusterName:   &m.cfg.Metadata.Name,
+		Force:         &options.ForceUpgrade,
+		NodegroupName: &options.NodegroupName,
+		LaunchTemplate: &eks.LaunchTemplateSpecification{
+			Version: previous.LaunchTemplate.Version,
+		},
+	}
+	if previous.LaunchTemplate.Id != nil {
+		input.LaunchTemplate.Id = previous.LaunchTemplate.Id
+	} else {
+		input.LaunchTemplate.Name = previous.LaunchTemplate.Name
+	}
+	if !usesCustomAMI {
+		// without a release version, EKS would move the nodes to the latest one
+		input.Version = current.Version
+		input.ReleaseVersion = current.ReleaseVersion
+	}
+
+	logger.Warning("%v; rolling nodegroup %q back to launch template version %s", upgradeErr, options.NodegroupName, *previous.LaunchTemplate.Version)
+	rollbackOutput, err := m.ctl.Provider.EKS().UpdateNodegroupVersion(input)
+	if err != nil {
+		return errors.Wrapf(upgradeErr, "failed to roll back nodegroup %q: %v", options.NodegroupName, err)
+	}
+
+	msg := fmt.Sprintf("waiting for rollback of nodegroup %q to complete", options.NodegroupName)
+	if err := m.waitForNodegroupUpdate(options.NodegroupName, *rollbackOutput.Update.Id, msg); err != nil {
+		if rollbackErr := m.updateFailure(options.NodegroupName, *rollbackOutput.Update.Id, "rollback"); rollbackErr != nil {
+			err = rollbackErr
+		}
+		return errors.Wrapf(upgradeErr, "failed to roll back nodegroup %q: %v", options.NodegroupName, err)
+	}
+	logger.Info("nodegroup %q rolled back to launch template version %s", options.NodegroupName, *previous.LaunchTemplate.Version)
+	if releaseVersionChanged {
+		return errors.Wrapf(upgradeErr, "nodegroup %q was rolled back to launch template version %s, its release version has to be rolled back to %s manually", options.NodegroupName, *previous.LaunchTemplate.Version, *previous.ReleaseVersion)
+	}
+	return errors.Wrapf(upgradeErr, "nodegroup %q was rolled back to launch template version %s", options.NodegroupName, *previous.LaunchTemplate.Version)
+}
 
+func (m *Manager) waitForNodegroupUpdate(nodegroupName, updateID, msg string) error {
+	newRequest := func() *request.Request {
+		input := &eks.DescribeUpdateInput{
+			Name:          &m.cfg.Metadata.Name,
+			NodegroupName: &nodegroupName,
+			UpdateId:      &updateID,
+		}
+		req, _ := m.ctl.Provider.EKS().DescribeUpdateRequest(input)
+		return req
+	}
+
+	acceptors := waiters.MakeAcceptors(
+		"Update.Status",
+		eks.UpdateStatusSuccessful,
+		[]string{
+			eks.UpdateStatusCancelled,
+			eks.UpdateStatusFailed,
+		},
+	)
+
+	return m.wait(nodegroupName, msg, acceptors, newRequest, m.ctl.Provider.WaitTimeout(), nil)
+}
+
+func (m *Manager) waitForNodegroupActive(nodegroupName, msg string) error {
 	newRequest := func() *request.Request {
 		input := &eks.DescribeNodegroupInput{
 			ClusterName:   &m.cfg.Metadata.Name,
-			NodegroupName: &options.NodegroupName,
+			NodegroupName: &nodegroupName,
 		}
 		req, _ := m.ctl.Provider.EKS().DescribeNodegroupRequest(input)
 		return req
 	}
 
-	msg := fmt.Sprintf("waiting for upgrade of nodegroup %q to complete", options.NodegroupName)
-
 	acceptors := waiters.MakeAcceptors(
 		"Nodegroup.Status",
 		eks.NodegroupStatusActive,
@@ -127,10 +352,5 @@ func (m *Manager) waitForUpgrade(options managed.UpgradeOptions) error {
 		},
 	)
 
-	err := m.wait(options.NodegroupName, msg, acceptors, newRequest, m.ctl.Provider.WaitTimeout(), nil)
-	if err != nil {
-		return err
-	}
-	logger.Info("nodegroup successfully upgraded")
-	return nil
+	return m.wait(nodegroupName, msg, acceptors, newRequest, m.ctl.Provider.WaitTimeout(), nil)
 }
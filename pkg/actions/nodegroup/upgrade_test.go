@@ -0,0 +1,240 @@
+package nodegroup_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/managed"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Upgrade", func() {
+	const (
+		clusterName = "my-cluster"
+		ngName      = "my-ng"
+	)
+
+	var (
+		p        *mockprovider.MockProvider
+		m        *nodegroup.Manager
+		options  managed.UpgradeOptions
+		waitMsgs []string
+		failWait map[string]bool
+	)
+
+	mockDescribeNodegroup := func(releaseVersion string) {
+		p.MockEKS().On("DescribeNodegroup", &awseks.DescribeNodegroupInput{
+			ClusterName:   aws.String(clusterName),
+			NodegroupName: aws.String(ngName),
+		}).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &awseks.Nodegroup{
+				NodegroupName:  aws.String(ngName),
+				Version:        aws.String("1.20"),
+				ReleaseVersion: aws.String(releaseVersion),
+				AmiType:        aws.String(awseks.AMITypesAl2X8664),
+				LaunchTemplate: &awseks.LaunchTemplateSpecification{
+					Id:      aws.String("lt-1234"),
+					Version: aws.String("3"),
+				},
+			},
+		}, nil).Once()
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, nil)
+		m.SetStackManager(new(fakes.FakeStackManager))
+
+		waitMsgs = nil
+		failWait = map[string]bool{}
+		m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
+			waitMsgs = append(waitMsgs, msg)
+			for prefix := range failWait {
+				if strings.HasPrefix(msg, prefix) {
+					return errors.New("ResourceNotReady: failed waiting for successful resource state")
+				}
+			}
+			return nil
+		})
+
+		options = managed.UpgradeOptions{
+			NodegroupName:         ngName,
+			LaunchTemplateVersion: "4",
+		}
+
+		mockDescribeNodegroup("1.20.4-20210621")
+
+		p.MockEKS().On("DescribeUpdateRequest", mock.Anything).Return(p.Client.MockRequestForGivenOutput(&awseks.DescribeUpdateInput{}, &awseks.DescribeUpdateOutput{}), nil)
+	})
+
+	mockUpdateNodegroupVersion := func(launchTemplateVersion string, updateID string) {
+		p.MockEKS().On("UpdateNodegroupVersion", mock.MatchedBy(func(input *awseks.UpdateNodegroupVersionInput) bool {
+			return input.LaunchTemplate != nil && aws.StringValue(input.LaunchTemplate.Version) == launchTemplateVersion
+		})).Return(&awseks.UpdateNodegroupVersionOutput{
+			Update: &awseks.Update{Id: aws.String(updateID)},
+		}, nil).Once()
+	}
+
+	mockUpdateStatus := func(updateID, status string, errs ...*awseks.ErrorDetail) {
+		p.MockEKS().On("DescribeUpdate", &awseks.DescribeUpdateInput{
+			Name:          aws.String(clusterName),
+			NodegroupName: aws.String(ngName),
+			UpdateId:      aws.String(updateID),
+		}).Return(&awseks.DescribeUpdateOutput{
+			Update: &awseks.Update{Id: aws.String(updateID), Status: aws.String(status), Errors: errs},
+		}, nil)
+	}
+
+	podEvictionFailure := &awseks.ErrorDetail{
+		ErrorCode:    aws.String(awseks.ErrorCodePodEvictionFailure),
+		ErrorMessage: aws.String("Reached max retries while trying to evict pods from nodes in node group my-ng"),
+	}
+
+	It("waits for the update of the nodegroup to succeed", func() {
+		mockUpdateNodegroupVersion("4", "upgrade-1")
+
+		Expect(m.Upgrade(options, true)).To(Succeed())
+		Expect(waitMsgs).To(Equal([]string{`waiting for upgrade of nodegroup "my-ng" to complete`}))
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeUpdate", mock.Anything)
+	})
+
+	It("reports why the update failed without rolling back by default", func() {
+		mockUpdateNodegroupVersion("4", "upgrade-1")
+		mockUpdateStatus("upgrade-1", awseks.UpdateStatusFailed, podEvictionFailure)
+		failWait["waiting for upgrade"] = true
+
+		err := m.Upgrade(options, true)
+		Expect(err).To(MatchError(`upgrade of nodegroup "my-ng" failed: PodEvictionFailure: Reached max retries while trying to evict pods from nodes in node group my-ng`))
+		p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UpdateNodegroupVersion", 1)
+	})
+
+	It("does not roll back an update that has not failed", func() {
+		options.RollbackOnFailure = true
+		mockUpdateNodegroupVersion("4", "upgrade-1")
+		mockUpdateStatus("upgrade-1", awseks.UpdateStatusInProgress)
+		failWait["waiting for upgrade"] = true
+
+		err := m.Upgrade(options, true)
+		Expect(err).To(MatchError(ContainSubstring("ResourceNotReady")))
+		p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UpdateNodegroupVersion", 1)
+	})
+
+	Context("with rollback on failure", func() {
+		BeforeEach(func() {
+			options.RollbackOnFailure = true
+			mockUpdateNodegroupVersion("4", "upgrade-1")
+			mockUpdateStatus("upgrade-1", awseks.UpdateStatusFailed, podEvictionFailure)
+			failWait["waiting for upgrade"] = true
+		})
+
+		It("rolls the nodegroup back to its previous launch template version, keeping its release version", func() {
+			mockDescribeNodegroup("1.20.4-20210621")
+			mockUpdateNodegroupVersion("3", "rollback-1")
+
+			err := m.Upgrade(options, true)
+			Expect(err).To(MatchError(`nodegroup "my-ng" was rolled back to launch template version 3: upgrade of nodegroup "my-ng" failed: PodEvictionFailure: Reached max retries while trying to evict pods from nodes in node group my-ng`))
+			Expect(waitMsgs).To(Equal([]string{
+				`waiting for upgrade of nodegroup "my-ng" to complete`,
+				`waiting for rollback of nodegroup "my-ng" to complete`,
+			}))
+
+			p.MockEKS().AssertCalled(GinkgoT(), "UpdateNodegroupVersion", &awseks.UpdateNodegroupVersionInput{
+				ClusterName:    aws.String(clusterName),
+				NodegroupName:  aws.String(ngName),
+				Force:          aws.Bool(false),
+				Version:        aws.String("1.20"),
+				ReleaseVersion: aws.String("1.20.4-20210621"),
+				LaunchTemplate: &awseks.LaunchTemplateSpecification{
+					Id:      aws.String("lt-1234"),
+					Version: aws.String("3"),
+				},
+			})
+		})
+
+		It("does not downgrade a release version the upgrade changed", func() {
+			mockDescribeNodegroup("1.20.4-20210722")
+			mockUpdateNodegroupVersion("3", "rollback-1")
+
+			err := m.Upgrade(options, true)
+			Expect(err).To(MatchError(ContainSubstring(`nodegroup "my-ng" was rolled back to launch template version 3, its release version has to be rolled back to 1.20.4-20210621 manually`)))
+			p.MockEKS().AssertCalled(GinkgoT(), "UpdateNodegroupVersion", mock.MatchedBy(func(input *awseks.UpdateNodegroupVersionInput) bool {
+				return aws.StringValue(input.LaunchTemplate.Version) == "3" && aws.StringValue(input.ReleaseVersion) == "1.20.4-20210722"
+			}))
+		})
+
+		It("only reports the manual steps without a launch template version to roll back to", func() {
+			options.LaunchTemplateVersion = ""
+			p.MockEKS().On("UpdateNodegroupVersion", mock.Anything).Return(&awseks.UpdateNodegroupVersionOutput{
+				Update: &awseks.Update{Id: aws.String("upgrade-1")},
+			}, nil).Once()
+			mockDescribeNodegroup("1.20.4-20210722")
+
+			err := m.Upgrade(options, true)
+			Expect(err).To(MatchError(ContainSubstring(`nodegroup "my-ng" has to be rolled back to release version 1.20.4-20210621 manually: upgrade of nodegroup "my-ng" failed`)))
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "UpdateNodegroupVersion", 1)
+		})
+
+		It("reports a rollback that fails", func() {
+			mockDescribeNodegroup("1.20.4-20210621")
+			mockUpdateNodegroupVersion("3", "rollback-1")
+			mockUpdateStatus("rollback-1", awseks.UpdateStatusFailed)
+			failWait["waiting for rollback"] = true
+
+			err := m.Upgrade(options, true)
+			Expect(err).To(MatchError(ContainSubstring(`failed to roll back nodegroup "my-ng": rollback of nodegroup "my-ng" failed: upgrade of nodegroup "my-ng" failed: PodEvictionFailure`)))
+		})
+	})
+
+	Context("with preserved labels", func() {
+		var clientSet *fake.Clientset
+
+		newNode := func(name string, labels map[string]string) *corev1.Node {
+			labels[api.EKSNodeGroupNameLabel] = ngName
+			return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		}
+
+		BeforeEach(func() {
+			clientSet = fake.NewSimpleClientset(
+				newNode("old-1", map[string]string{"nvidia.com/gpu.product": "A100", "example.com/rack": "r1"}),
+				newNode("old-2", map[string]string{"nvidia.com/gpu.product": "A100", "example.com/rack": "r2"}),
+			)
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = clusterName
+			m = nodegroup.New(cfg, &eks.ClusterProvider{Provider: p}, clientSet)
+			m.SetStackManager(new(fakes.FakeStackManager))
+			m.SetWaiter(func(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string) error) error {
+				_, err := clientSet.CoreV1().Nodes().Create(context.TODO(), newNode("new-1", map[string]string{}), metav1.CreateOptions{})
+				return err
+			})
+			options.PreserveLabels = []string{"nvidia.com/*", "example.com/rack"}
+			mockUpdateNodegroupVersion("4", "upgrade-1")
+		})
+
+		It("re-applies the labels that have the same value on all the nodes to the nodes that joined during the upgrade", func() {
+			Expect(m.Upgrade(options, true)).To(Succeed())
+
+			node, err := clientSet.CoreV1().Nodes().Get(context.TODO(), "new-1", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(node.Labels).To(HaveKeyWithValue("nvidia.com/gpu.product", "A100"))
+			Expect(node.Labels).NotTo(HaveKey("example.com/rack"))
+		})
+	})
+})
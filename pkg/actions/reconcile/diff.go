@@ -0,0 +1,355 @@
+package reconcile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/iam"
+)
+
+// Action describes what needs to happen to a resource to match the desired config
+type Action string
+
+const (
+	// ActionCreate means the resource is present in the config but not in the cluster
+	ActionCreate Action = "create"
+	// ActionUpdate means the resource exists in both but its settings differ
+	ActionUpdate Action = "update"
+	// ActionDelete means the resource exists in the cluster but not in the config
+	ActionDelete Action = "delete"
+)
+
+// Resource kinds covered by reconciliation
+const (
+	ResourceAddon            = "addon"
+	ResourceNodeGroup        = "nodegroup"
+	ResourceManagedNodeGroup = "managed nodegroup"
+	ResourceLogging          = "cluster logging"
+	ResourceEndpointAccess   = "cluster endpoint access"
+	ResourceIdentityMapping  = "iam identity mapping"
+	ResourceAccountMapping   = "iam account mapping"
+)
+
+// Change is a single difference between the desired config and the live cluster
+type Change struct {
+	Resource string
+	Name     string
+	Action   Action
+	Detail   string
+}
+
+// IsDestructive returns true if applying the change removes a resource
+func (c Change) IsDestructive() bool {
+	return c.Action == ActionDelete
+}
+
+func (c Change) String() string {
+	s := fmt.Sprintf("%s %s", c.Action, c.Resource)
+	if c.Name != "" {
+		s = fmt.Sprintf("%s %q", s, c.Name)
+	}
+	if c.Detail != "" {
+		s = fmt.Sprintf("%s (%s)", s, c.Detail)
+	}
+	return s
+}
+
+// LiveNodeGroup is the reconciliation view of a nodegroup that exists in the cluster
+type LiveNodeGroup struct {
+	Name            string
+	Managed         bool
+	MinSize         int
+	MaxSize         int
+	DesiredCapacity int
+}
+
+// State is the live state of the cluster that is compared against the config
+type State struct {
+	Addons []addon.Summary
+	// AddonConfigurationValues are the configuration values of the addons, by name,
+	// they are only fetched for the addons whose configuration values are set in the config
+	AddonConfigurationValues map[string]string
+	NodeGroups               []LiveNodeGroup
+	EnabledLogTypes          sets.String
+	EndpointAccess           *api.ClusterEndpoints
+	// Identities and Accounts are the mappings in the aws-auth ConfigMap,
+	// they are only fetched when the config sets iamIdentityMappings
+	Identities []iam.Identity
+	Accounts   []string
+}
+
+// Diff computes the changes needed to bring the live state in line with the
+// desired config. Logging, endpoint access and IAM identity mappings are only
+// compared when the config sets them explicitly
+func Diff(cfg *api.ClusterConfig, live *State) []Change {
+	var changes []Change
+	changes = append(changes, diffAddons(cfg, live)...)
+	changes = append(changes, diffNodeGroups(cfg, live.NodeGroups)...)
+
+	if cfg.CloudWatch != nil && cfg.CloudWatch.ClusterLogging != nil {
+		if c, ok := diffLogging(cfg, live.EnabledLogTypes); ok {
+			changes = append(changes, c)
+		}
+	}
+
+	if cfg.VPC != nil && cfg.VPC.ClusterEndpoints != nil && live.EndpointAccess != nil {
+		if c, ok := diffEndpointAccess(cfg.VPC.ClusterEndpoints, live.EndpointAccess); ok {
+			changes = append(changes, c)
+		}
+	}
+
+	if cfg.IAMIdentityMappings != nil {
+		changes = append(changes, diffIdentityMappings(cfg.IAMIdentityMappings, live.Identities, live.Accounts)...)
+	}
+	return changes
+}
+
+// HasDestructive returns true if any of the changes is destructive
+func HasDestructive(changes []Change) bool {
+	for _, c := range changes {
+		if c.IsDestructive() {
+			return true
+		}
+	}
+	return false
+}
+
+func diffAddons(cfg *api.ClusterConfig, live *State) []Change {
+	var changes []Change
+	liveByName := map[string]addon.Summary{}
+	for _, s := range live.Addons {
+		liveByName[s.Name] = s
+	}
+
+	desiredNames := sets.NewString()
+	for _, a := range cfg.Addons {
+		desiredNames.Insert(a.Name)
+		summary, ok := liveByName[a.Name]
+		if !ok {
+			changes = append(changes, Change{Resource: ResourceAddon, Name: a.Name, Action: ActionCreate})
+			continue
+		}
+		var diffs []string
+		if !addonUpToDate(a, summary) {
+			diffs = append(diffs, fmt.Sprintf("version %s -> %s", summary.Version, a.Version))
+		}
+		if detail := diffAddonConfigurationValues(cfg, a, live.AddonConfigurationValues); detail != "" {
+			diffs = append(diffs, detail)
+		}
+		if len(diffs) > 0 {
+			changes = append(changes, Change{
+				Resource: ResourceAddon,
+				Name:     a.Name,
+				Action:   ActionUpdate,
+				Detail:   strings.Join(diffs, "; "),
+			})
+		}
+	}
+
+	for _, s := range live.Addons {
+		if !desiredNames.Has(s.Name) {
+			changes = append(changes, Change{Resource: ResourceAddon, Name: s.Name, Action: ActionDelete})
+		}
+	}
+	return changes
+}
+
+// diffAddonConfigurationValues describes how the configuration values of the addon drifted from the config.
+// Addons whose configuration values are not set in the config are not compared, as updates leave them untouched
+func diffAddonConfigurationValues(cfg *api.ClusterConfig, a *api.Addon, live map[string]string) string {
+	desired, err := cfg.AddonConfigurationValues(a)
+	if err != nil || desired == "" {
+		return ""
+	}
+	paths, err := addon.DiffConfigurationValues(desired, live[a.Name])
+	if err != nil {
+		logger.Warning("comparing the configuration values of addon %q: %v", a.Name, err)
+		return "configurationValues differ"
+	}
+	if len(paths) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("configurationValues differ at %s", strings.Join(paths, ", "))
+}
+
+// addonUpToDate follows the version matching used by `eksctl update addon`
+func addonUpToDate(desired *api.Addon, live addon.Summary) bool {
+	switch desired.Version {
+	case "":
+		return true
+	case "latest":
+		return live.NewerVersion == ""
+	default:
+		return strings.Contains(live.Version, desired.Version)
+	}
+}
+
+func diffNodeGroups(cfg *api.ClusterConfig, live []LiveNodeGroup) []Change {
+	var changes []Change
+	liveByName := map[string]LiveNodeGroup{}
+	for _, ng := range live {
+		liveByName[ng.Name] = ng
+	}
+
+	desiredNames := sets.NewString()
+	check := func(ng *api.NodeGroupBase, resource string) {
+		desiredNames.Insert(ng.Name)
+		liveNG, ok := liveByName[ng.Name]
+		if !ok {
+			changes = append(changes, Change{Resource: resource, Name: ng.Name, Action: ActionCreate})
+			return
+		}
+		if detail := diffScaling(ng.ScalingConfig, liveNG); detail != "" {
+			changes = append(changes, Change{Resource: resource, Name: ng.Name, Action: ActionUpdate, Detail: detail})
+		}
+	}
+
+	for _, ng := range cfg.NodeGroups {
+		check(ng.NodeGroupBase, ResourceNodeGroup)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		check(ng.NodeGroupBase, ResourceManagedNodeGroup)
+	}
+
+	for _, ng := range live {
+		if desiredNames.Has(ng.Name) {
+			continue
+		}
+		resource := ResourceNodeGroup
+		if ng.Managed {
+			resource = ResourceManagedNodeGroup
+		}
+		changes = append(changes, Change{Resource: resource, Name: ng.Name, Action: ActionDelete})
+	}
+	return changes
+}
+
+func diffScaling(desired *api.ScalingConfig, live LiveNodeGroup) string {
+	if desired == nil {
+		return ""
+	}
+	var diffs []string
+	compare := func(field string, want *int, have int) {
+		if want != nil && *want != have {
+			diffs = append(diffs, fmt.Sprintf("%s %d -> %d", field, have, *want))
+		}
+	}
+	compare("minSize", desired.MinSize, live.MinSize)
+	compare("maxSize", desired.MaxSize, live.MaxSize)
+	compare("desiredCapacity", desired.DesiredCapacity, live.DesiredCapacity)
+	return strings.Join(diffs, ", ")
+}
+
+func diffLogging(cfg *api.ClusterConfig, live sets.String) (Change, bool) {
+	desired := sets.NewString()
+	if cfg.HasClusterCloudWatchLogging() {
+		desired.Insert(cfg.CloudWatch.ClusterLogging.EnableTypes...)
+	}
+	if live == nil {
+		live = sets.NewString()
+	}
+	if desired.Equal(live) {
+		return Change{}, false
+	}
+
+	var diffs []string
+	if toEnable := desired.Difference(live); toEnable.Len() > 0 {
+		diffs = append(diffs, fmt.Sprintf("enable %s", strings.Join(toEnable.List(), ", ")))
+	}
+	if toDisable := live.Difference(desired); toDisable.Len() > 0 {
+		diffs = append(diffs, fmt.Sprintf("disable %s", strings.Join(toDisable.List(), ", ")))
+	}
+	return Change{Resource: ResourceLogging, Action: ActionUpdate, Detail: strings.Join(diffs, "; ")}, true
+}
+
+func diffEndpointAccess(desired, live *api.ClusterEndpoints) (Change, bool) {
+	var diffs []string
+	compare := func(field string, want, have *bool) {
+		if want != nil && aws.BoolValue(want) != aws.BoolValue(have) {
+			diffs = append(diffs, fmt.Sprintf("%s %t -> %t", field, aws.BoolValue(have), *want))
+		}
+	}
+	compare("privateAccess", desired.PrivateAccess, live.PrivateAccess)
+	compare("publicAccess", desired.PublicAccess, live.PublicAccess)
+	if len(diffs) == 0 {
+		return Change{}, false
+	}
+	return Change{Resource: ResourceEndpointAccess, Action: ActionUpdate, Detail: strings.Join(diffs, ", ")}, true
+}
+
+// diffIdentityMappings compares the mappings in the config with those in the
+// aws-auth ConfigMap, ignoring the ones managed for nodegroups and Fargate.
+// A mapping whose username or groups differ is deleted and created again
+func diffIdentityMappings(desired []*api.IAMIdentityMapping, live []iam.Identity, liveAccounts []string) []Change {
+	var (
+		changes  []Change
+		matched  = make([]bool, len(live))
+		accounts = sets.NewString()
+	)
+
+	for _, m := range desired {
+		if m.Account != "" {
+			accounts.Insert(m.Account)
+			continue
+		}
+		found := false
+		for i, identity := range live {
+			if !matched[i] && !authconfigmap.IsManagedIdentity(identity) && identityMatches(m, identity) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			changes = append(changes, Change{
+				Resource: ResourceIdentityMapping,
+				Name:     m.ARN,
+				Action:   ActionCreate,
+				Detail:   identityDetail(m.Username, m.Groups),
+			})
+		}
+	}
+
+	for i, identity := range live {
+		if matched[i] || authconfigmap.IsManagedIdentity(identity) {
+			continue
+		}
+		changes = append(changes, Change{
+			Resource: ResourceIdentityMapping,
+			Name:     identity.ARN(),
+			Action:   ActionDelete,
+			Detail:   identityDetail(identity.Username(), identity.Groups()),
+		})
+	}
+
+	currentAccounts := sets.NewString(liveAccounts...)
+	for _, account := range accounts.Difference(currentAccounts).List() {
+		changes = append(changes, Change{Resource: ResourceAccountMapping, Name: account, Action: ActionCreate})
+	}
+	for _, account := range currentAccounts.Difference(accounts).List() {
+		changes = append(changes, Change{Resource: ResourceAccountMapping, Name: account, Action: ActionDelete})
+	}
+	return changes
+}
+
+func identityMatches(m *api.IAMIdentityMapping, identity iam.Identity) bool {
+	if m.ARN != identity.ARN() || m.Username != identity.Username() || len(m.Groups) != len(identity.Groups()) {
+		return false
+	}
+	for i, g := range m.Groups {
+		if identity.Groups()[i] != g {
+			return false
+		}
+	}
+	return true
+}
+
+func identityDetail(username string, groups []string) string {
+	return fmt.Sprintf("username %q, groups %q", username, groups)
+}
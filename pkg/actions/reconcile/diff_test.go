@@ -0,0 +1,316 @@
+package reconcile_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/reconcile"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/iam"
+)
+
+var _ = Describe("Diff", func() {
+	var (
+		cfg  *api.ClusterConfig
+		live *reconcile.State
+	)
+
+	newNodeGroup := func(name string, desired int) *api.NodeGroup {
+		ng := api.NewNodeGroup()
+		ng.Name = name
+		ng.DesiredCapacity = aws.Int(desired)
+		return ng
+	}
+
+	newManagedNodeGroup := func(name string, desired int) *api.ManagedNodeGroup {
+		ng := api.NewManagedNodeGroup()
+		ng.Name = name
+		ng.DesiredCapacity = aws.Int(desired)
+		return ng
+	}
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Addons = []*api.Addon{{Name: "vpc-cni", Version: "v1.7.5"}}
+		cfg.NodeGroups = []*api.NodeGroup{newNodeGroup("ng-1", 2)}
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{newManagedNodeGroup("mng-1", 3)}
+
+		live = &reconcile.State{
+			Addons: []addon.Summary{{Name: "vpc-cni", Version: "v1.7.5-eksbuild.1"}},
+			NodeGroups: []reconcile.LiveNodeGroup{
+				{Name: "ng-1", DesiredCapacity: 2},
+				{Name: "mng-1", Managed: true, DesiredCapacity: 3},
+			},
+			EnabledLogTypes: sets.NewString(),
+			EndpointAccess: &api.ClusterEndpoints{
+				PrivateAccess: api.Disabled(),
+				PublicAccess:  api.Enabled(),
+			},
+		}
+	})
+
+	When("the cluster matches the config", func() {
+		It("returns no changes", func() {
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+	})
+
+	When("an addon is missing from the cluster", func() {
+		It("creates it", func() {
+			cfg.Addons = append(cfg.Addons, &api.Addon{Name: "coredns"})
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceAddon,
+				Name:     "coredns",
+				Action:   reconcile.ActionCreate,
+			}))
+			Expect(reconcile.HasDestructive(changes)).To(BeFalse())
+		})
+	})
+
+	When("an addon version has drifted", func() {
+		It("updates it", func() {
+			cfg.Addons[0].Version = "v1.8.0"
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceAddon,
+				Name:     "vpc-cni",
+				Action:   reconcile.ActionUpdate,
+				Detail:   "version v1.7.5-eksbuild.1 -> v1.8.0",
+			}))
+		})
+
+		It("updates it when set to latest and a newer version exists", func() {
+			cfg.Addons[0].Version = "latest"
+			live.Addons[0].NewerVersion = "v1.8.0-eksbuild.1"
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Action).To(Equal(reconcile.ActionUpdate))
+		})
+
+		It("does nothing when set to latest and no newer version exists", func() {
+			cfg.Addons[0].Version = "latest"
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+	})
+
+	When("the configuration values of an addon have drifted", func() {
+		BeforeEach(func() {
+			cfg.Addons[0].ConfigurationValues = `{"env": {"WARM_IP_TARGET": "5"}, "resources": {"limits": {"memory": "256Mi"}}}`
+		})
+
+		It("updates it with the configuration values of the config", func() {
+			live.AddonConfigurationValues = map[string]string{
+				"vpc-cni": `{"env": {"WARM_IP_TARGET": "2", "WARM_ENI_TARGET": "1"}, "resources": {"limits": {"memory": "256Mi"}}}`,
+			}
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceAddon,
+				Name:     "vpc-cni",
+				Action:   reconcile.ActionUpdate,
+				Detail:   "configurationValues differ at env.WARM_ENI_TARGET, env.WARM_IP_TARGET",
+			}))
+		})
+
+		It("reports the version and configuration changes together", func() {
+			cfg.Addons[0].Version = "v1.8.0"
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceAddon,
+				Name:     "vpc-cni",
+				Action:   reconcile.ActionUpdate,
+				Detail:   "version v1.7.5-eksbuild.1 -> v1.8.0; configurationValues differ at env, resources",
+			}))
+		})
+
+		It("does nothing when they match, regardless of their format", func() {
+			live.AddonConfigurationValues = map[string]string{
+				"vpc-cni": "resources:\n  limits:\n    memory: 256Mi\nenv:\n  WARM_IP_TARGET: \"5\"\n",
+			}
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+
+		It("ignores the configuration values when they are not set in the config", func() {
+			cfg.Addons[0].ConfigurationValues = ""
+			live.AddonConfigurationValues = map[string]string{"vpc-cni": `{"env": {"WARM_IP_TARGET": "2"}}`}
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+	})
+
+	When("an addon is not in the config", func() {
+		It("deletes it and marks the change as destructive", func() {
+			live.Addons = append(live.Addons, addon.Summary{Name: "kube-proxy", Version: "v1.19.6-eksbuild.2"})
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceAddon,
+				Name:     "kube-proxy",
+				Action:   reconcile.ActionDelete,
+			}))
+			Expect(reconcile.HasDestructive(changes)).To(BeTrue())
+		})
+	})
+
+	When("nodegroups have drifted", func() {
+		It("creates missing nodegroups", func() {
+			cfg.NodeGroups = append(cfg.NodeGroups, newNodeGroup("ng-2", 1))
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, newManagedNodeGroup("mng-2", 1))
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(
+				reconcile.Change{Resource: reconcile.ResourceNodeGroup, Name: "ng-2", Action: reconcile.ActionCreate},
+				reconcile.Change{Resource: reconcile.ResourceManagedNodeGroup, Name: "mng-2", Action: reconcile.ActionCreate},
+			))
+		})
+
+		It("deletes nodegroups that are not in the config", func() {
+			cfg.ManagedNodeGroups = nil
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(ConsistOf(
+				reconcile.Change{Resource: reconcile.ResourceManagedNodeGroup, Name: "mng-1", Action: reconcile.ActionDelete},
+			))
+			Expect(reconcile.HasDestructive(changes)).To(BeTrue())
+		})
+
+		It("scales nodegroups whose scaling config differs", func() {
+			cfg.NodeGroups[0].DesiredCapacity = aws.Int(4)
+			cfg.NodeGroups[0].MaxSize = aws.Int(5)
+			live.NodeGroups[0].MaxSize = 2
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceNodeGroup,
+				Name:     "ng-1",
+				Action:   reconcile.ActionUpdate,
+				Detail:   "maxSize 2 -> 5, desiredCapacity 2 -> 4",
+			}))
+		})
+	})
+
+	When("cluster logging has drifted", func() {
+		It("updates logging when set in the config", func() {
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api", "audit"}
+			live.EnabledLogTypes = sets.NewString("api", "scheduler")
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceLogging,
+				Action:   reconcile.ActionUpdate,
+				Detail:   "enable audit; disable scheduler",
+			}))
+		})
+
+		It("ignores logging when not set in the config", func() {
+			cfg.CloudWatch = nil
+			live.EnabledLogTypes = sets.NewString("api")
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+	})
+
+	When("endpoint access has drifted", func() {
+		It("updates endpoint access", func() {
+			cfg.VPC.ClusterEndpoints = &api.ClusterEndpoints{
+				PrivateAccess: api.Enabled(),
+				PublicAccess:  api.Enabled(),
+			}
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(ConsistOf(reconcile.Change{
+				Resource: reconcile.ResourceEndpointAccess,
+				Action:   reconcile.ActionUpdate,
+				Detail:   "privateAccess false -> true",
+			}))
+			Expect(reconcile.HasDestructive(changes)).To(BeFalse())
+		})
+	})
+
+	When("IAM identity mappings have drifted", func() {
+		const (
+			nodeRole  = "arn:aws:iam::123456789012:role/eksctl-my-cluster-nodegroup-ng-1-NodeInstanceRole"
+			adminRole = "arn:aws:iam::123456789012:role/admin"
+			devUser   = "arn:aws:iam::123456789012:user/dev"
+		)
+
+		mustIdentity := func(arn, username string, groups ...string) iam.Identity {
+			identity, err := iam.NewIdentity(arn, username, groups)
+			Expect(err).NotTo(HaveOccurred())
+			return identity
+		}
+
+		BeforeEach(func() {
+			live.Identities = []iam.Identity{
+				mustIdentity(nodeRole, "system:node:{{EC2PrivateDNSName}}", "system:bootstrappers", "system:nodes"),
+				mustIdentity(adminRole, "admin", "system:masters"),
+			}
+			live.Accounts = []string{"111122223333"}
+		})
+
+		It("ignores identity mappings when not set in the config", func() {
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+
+		It("returns no changes when the mappings match, preserving nodegroup roles", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{
+				{ARN: adminRole, Username: "admin", Groups: []string{"system:masters"}},
+				{Account: "111122223333"},
+			}
+			Expect(reconcile.Diff(cfg, live)).To(BeEmpty())
+		})
+
+		It("adds missing mappings", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{
+				{ARN: adminRole, Username: "admin", Groups: []string{"system:masters"}},
+				{ARN: devUser, Username: "dev", Groups: []string{"dev"}},
+				{Account: "111122223333"},
+				{Account: "444455556666"},
+			}
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(ConsistOf(
+				reconcile.Change{
+					Resource: reconcile.ResourceIdentityMapping,
+					Name:     devUser,
+					Action:   reconcile.ActionCreate,
+					Detail:   `username "dev", groups ["dev"]`,
+				},
+				reconcile.Change{Resource: reconcile.ResourceAccountMapping, Name: "444455556666", Action: reconcile.ActionCreate},
+			))
+			Expect(reconcile.HasDestructive(changes)).To(BeFalse())
+		})
+
+		It("removes mappings that are not in the config and marks the change as destructive", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{}
+			changes := reconcile.Diff(cfg, live)
+			Expect(changes).To(ConsistOf(
+				reconcile.Change{
+					Resource: reconcile.ResourceIdentityMapping,
+					Name:     adminRole,
+					Action:   reconcile.ActionDelete,
+					Detail:   `username "admin", groups ["system:masters"]`,
+				},
+				reconcile.Change{Resource: reconcile.ResourceAccountMapping, Name: "111122223333", Action: reconcile.ActionDelete},
+			))
+			Expect(reconcile.HasDestructive(changes)).To(BeTrue())
+		})
+
+		It("replaces mappings whose groups differ", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{
+				{ARN: adminRole, Username: "admin", Groups: []string{"admins"}},
+				{Account: "111122223333"},
+			}
+			Expect(reconcile.Diff(cfg, live)).To(ConsistOf(
+				reconcile.Change{
+					Resource: reconcile.ResourceIdentityMapping,
+					Name:     adminRole,
+					Action:   reconcile.ActionCreate,
+					Detail:   `username "admin", groups ["admins"]`,
+				},
+				reconcile.Change{
+					Resource: reconcile.ResourceIdentityMapping,
+					Name:     adminRole,
+					Action:   reconcile.ActionDelete,
+					Detail:   `username "admin", groups ["system:masters"]`,
+				},
+			))
+		})
+	})
+
+	Describe("Change", func() {
+		It("describes itself", func() {
+			c := reconcile.Change{Resource: reconcile.ResourceNodeGroup, Name: "ng-1", Action: reconcile.ActionUpdate, Detail: "minSize 1 -> 2"}
+			Expect(c.String()).To(Equal(`update nodegroup "ng-1" (minSize 1 -> 2)`))
+		})
+	})
+})
@@ -0,0 +1,356 @@
+package reconcile
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	eksctl "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// Options controls how changes are applied
+type Options struct {
+	Plan             bool
+	AllowDestructive bool
+	Wait             bool
+	MaxGracePeriod   time.Duration
+	DisableEviction  bool
+}
+
+// Reconciler brings an existing cluster in line with a config file by only
+// applying the changes between the two
+type Reconciler struct {
+	cfg          *api.ClusterConfig
+	ctl          *eksctl.ClusterProvider
+	clientSet    kubernetes.Interface
+	addonManager *addon.Manager
+}
+
+// New creates a new Reconciler
+func New(cfg *api.ClusterConfig, ctl *eksctl.ClusterProvider, clientSet kubernetes.Interface, addonManager *addon.Manager) *Reconciler {
+	return &Reconciler{
+		cfg:          cfg,
+		ctl:          ctl,
+		clientSet:    clientSet,
+		addonManager: addonManager,
+	}
+}
+
+// LiveState fetches the state of the cluster that is subject to reconciliation
+func (r *Reconciler) LiveState() (*State, error) {
+	addons, err := r.addonManager.GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroups, err := r.liveNodeGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, _, err := r.ctl.GetCurrentClusterConfigForLogging(r.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	vpcConfig, err := r.ctl.GetCurrentClusterVPCConfig(r.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	addonConfigurationValues, err := r.liveAddonConfigurationValues(addons)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{
+		Addons:                   addons,
+		AddonConfigurationValues: addonConfigurationValues,
+		NodeGroups:               nodeGroups,
+		EnabledLogTypes:          enabled,
+		EndpointAccess:           vpcConfig.ClusterEndpoints,
+	}
+
+	if r.cfg.IAMIdentityMappings != nil {
+		acm, err := authconfigmap.NewFromClientSet(r.clientSet)
+		if err != nil {
+			return nil, err
+		}
+		if state.Identities, err = acm.Identities(); err != nil {
+			return nil, err
+		}
+		if state.Accounts, err = acm.Accounts(); err != nil {
+			return nil, err
+		}
+	}
+	return state, nil
+}
+
+// liveAddonConfigurationValues fetches the configuration values of the existing addons whose configuration
+// values are set in the config
+func (r *Reconciler) liveAddonConfigurationValues(live []addon.Summary) (map[string]string, error) {
+	existing := sets.NewString()
+	for _, s := range live {
+		existing.Insert(s.Name)
+	}
+
+	values := map[string]string{}
+	for _, a := range r.cfg.Addons {
+		desired, err := r.cfg.AddonConfigurationValues(a)
+		if err != nil {
+			return nil, err
+		}
+		if desired == "" || !existing.Has(a.Name) {
+			continue
+		}
+		if values[a.Name], err = r.addonManager.GetConfigurationValues(a); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+func (r *Reconciler) liveNodeGroups() ([]LiveNodeGroup, error) {
+	summaries, err := nodegroup.New(r.cfg, r.ctl, r.clientSet).GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	managed := sets.NewString()
+	output, err := r.ctl.Provider.EKS().ListNodegroups(&eks.ListNodegroupsInput{
+		ClusterName: &r.cfg.Metadata.Name,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing managed nodegroups")
+	}
+	for _, name := range output.Nodegroups {
+		managed.Insert(*name)
+	}
+
+	var nodeGroups []LiveNodeGroup
+	for _, s := range summaries {
+		nodeGroups = append(nodeGroups, LiveNodeGroup{
+			Name:            s.Name,
+			Managed:         managed.Has(s.Name),
+			MinSize:         s.MinSize,
+			MaxSize:         s.MaxSize,
+			DesiredCapacity: s.DesiredCapacity,
+		})
+	}
+	return nodeGroups, nil
+}
+
+// Reconcile computes the diff against the live cluster, logs it and, unless
+// in plan mode, applies it. Destructive changes are refused unless allowed
+func (r *Reconciler) Reconcile(options Options) error {
+	live, err := r.LiveState()
+	if err != nil {
+		return err
+	}
+
+	changes := Diff(r.cfg, live)
+	if len(changes) == 0 {
+		logger.Info("cluster %q is up to date with the given config", r.cfg.Metadata.Name)
+		return nil
+	}
+
+	logger.Info("%d change(s) detected for cluster %q:", len(changes), r.cfg.Metadata.Name)
+	for _, c := range changes {
+		logger.Info("  %s", c)
+	}
+
+	if HasDestructive(changes) && !options.AllowDestructive {
+		if options.Plan {
+			logger.Warning("destructive changes will be refused unless --allow-destructive is set")
+		} else {
+			return errors.New("refusing to apply destructive changes, re-run with --allow-destructive to apply them")
+		}
+	}
+
+	if options.Plan {
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	if err := r.applyNodeGroupChanges(changes, options); err != nil {
+		return err
+	}
+
+	for _, c := range changes {
+		if err := r.apply(c, options); err != nil {
+			return errors.Wrapf(err, "failed to %s", c)
+		}
+	}
+
+	if err := r.applyIdentityMappingChanges(changes); err != nil {
+		return err
+	}
+
+	logger.Success("applied %d change(s) to cluster %q", len(changes), r.cfg.Metadata.Name)
+	return nil
+}
+
+func (r *Reconciler) apply(c Change, options Options) error {
+	switch c.Resource {
+	case ResourceAddon:
+		return r.applyAddonChange(c, options)
+	case ResourceLogging:
+		return r.ctl.UpdateClusterConfigForLogging(r.cfg)
+	case ResourceEndpointAccess:
+		return r.ctl.UpdateClusterConfigForEndpoints(r.cfg)
+	}
+	return nil
+}
+
+func (r *Reconciler) applyAddonChange(c Change, options Options) error {
+	switch c.Action {
+	case ActionCreate:
+		return r.addonManager.Create(r.findAddon(c.Name), options.Wait)
+	case ActionUpdate:
+		return r.addonManager.Update(r.findAddon(c.Name), options.Wait)
+	case ActionDelete:
+		return r.addonManager.Delete(&api.Addon{Name: c.Name})
+	}
+	return nil
+}
+
+func (r *Reconciler) findAddon(name string) *api.Addon {
+	for _, a := range r.cfg.Addons {
+		if a.Name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// applyNodeGroupChanges handles all nodegroup changes together, as creation and
+// deletion work on sets of nodegroups rather than on individual ones
+func (r *Reconciler) applyNodeGroupChanges(changes []Change, options Options) error {
+	var (
+		toCreate         bool
+		toDelete         []*api.NodeGroup
+		toDeleteManaged  []*api.ManagedNodeGroup
+		nodeGroupManager = nodegroup.New(r.cfg, r.ctl, r.clientSet)
+		scalingConfigs   = r.desiredScalingConfigs()
+	)
+
+	for _, c := range changes {
+		if c.Resource != ResourceNodeGroup && c.Resource != ResourceManagedNodeGroup {
+			continue
+		}
+		switch c.Action {
+		case ActionCreate:
+			toCreate = true
+		case ActionUpdate:
+			ng := &api.NodeGroup{NodeGroupBase: &api.NodeGroupBase{
+				Name:          c.Name,
+				ScalingConfig: scalingConfigs[c.Name],
+			}}
+			if err := nodeGroupManager.Scale(ng); err != nil {
+				return err
+			}
+		case ActionDelete:
+			base := &api.NodeGroupBase{Name: c.Name}
+			if c.Resource == ResourceManagedNodeGroup {
+				toDeleteManaged = append(toDeleteManaged, &api.ManagedNodeGroup{NodeGroupBase: base})
+			} else {
+				base.IAM = &api.NodeGroupIAM{}
+				toDelete = append(toDelete, &api.NodeGroup{NodeGroupBase: base})
+			}
+		}
+	}
+
+	if toCreate {
+		// nodegroup creation filters the config in place, so it's given a copy
+		// and only picks up the nodegroups that don't exist yet
+		createManager := nodegroup.New(r.cfg.DeepCopy(), r.ctl, r.clientSet)
+		if err := createManager.Create(nodegroup.CreateOpts{
+			UpdateAuthConfigMap: true,
+			ConfigFileProvided:  true,
+		}, filter.NewNodeGroupFilter()); err != nil {
+			return err
+		}
+	}
+
+	if len(toDelete)+len(toDeleteManaged) == 0 {
+		return nil
+	}
+
+	stackManager := r.ctl.NewStackManager(r.cfg)
+	for _, ng := range toDelete {
+		if err := r.ctl.GetNodeGroupIAM(stackManager, ng); err != nil {
+			logger.Warning("continuing with deletion, error getting instance role ARN for nodegroup %q: %v", ng.Name, err)
+		}
+	}
+
+	var kubeNodeGroups []eksctl.KubeNodeGroup
+	for _, ng := range toDelete {
+		kubeNodeGroups = append(kubeNodeGroups, ng)
+	}
+	for _, ng := range toDeleteManaged {
+		kubeNodeGroups = append(kubeNodeGroups, ng)
+	}
+	if err := nodeGroupManager.Drain(kubeNodeGroups, false, options.MaxGracePeriod, options.DisableEviction); err != nil {
+		return err
+	}
+
+	if err := nodeGroupManager.Delete(toDelete, toDeleteManaged, options.Wait, false); err != nil {
+		return err
+	}
+
+	for _, ng := range toDelete {
+		if ng.IAM.InstanceRoleARN == "" {
+			continue
+		}
+		if err := authconfigmap.RemoveNodeGroup(r.clientSet, ng); err != nil {
+			logger.Warning(err.Error())
+		}
+	}
+	return nil
+}
+
+// applyIdentityMappingChanges rewrites the aws-auth ConfigMap once for all
+// identity and account mapping changes
+func (r *Reconciler) applyIdentityMappingChanges(changes []Change) error {
+	hasChanges := false
+	for _, c := range changes {
+		if c.Resource == ResourceIdentityMapping || c.Resource == ResourceAccountMapping {
+			hasChanges = true
+			break
+		}
+	}
+	if !hasChanges {
+		return nil
+	}
+
+	acm, err := authconfigmap.NewFromClientSet(r.clientSet)
+	if err != nil {
+		return err
+	}
+	if err := acm.SetIdentityMappings(r.cfg.IAMIdentityMappings); err != nil {
+		return err
+	}
+	if err := acm.Save(); err != nil {
+		return errors.Wrap(err, "saving auth ConfigMap")
+	}
+	return nil
+}
+
+func (r *Reconciler) desiredScalingConfigs() map[string]*api.ScalingConfig {
+	scalingConfigs := map[string]*api.ScalingConfig{}
+	for _, np := range cmdutils.ToNodePools(r.cfg) {
+		base := np.BaseNodeGroup()
+		scalingConfigs[base.Name] = base.ScalingConfig
+	}
+	return scalingConfigs
+}
@@ -0,0 +1,11 @@
+package reconcile_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestReconcile(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,11 @@
+package addons_test
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestAddons(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
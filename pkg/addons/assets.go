@@ -3,6 +3,7 @@
 // assets/efa-device-plugin.yaml (3.084kB)
 // assets/neuron-device-plugin.yaml (3.623kB)
 // assets/nvidia-device-plugin.yaml (2.369kB)
+// assets/spot-interruption-handler.yaml (3.875kB)
 // assets/vpc-admission-webhook-config.yaml (524B)
 // assets/vpc-admission-webhook-csr.yaml (234B)
 // assets/vpc-admission-webhook-dep.yaml (1.675kB)
@@ -137,6 +138,26 @@ func nvidiaDevicePluginYaml() (*asset, error) {
 	return a, nil
 }
 
+var _spotInterruptionHandlerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x96\x5f\x8f\xe2\x36\x10\xc0\xdf\xf9\x14\x23\xaa\xbe\x35\xec\xb2\xd7\xab\x4e\x79\xcb\x81\x6f\x0f\x09\x02\x0a\xec\xf5\xa1\x3a\x21\xe3\x0c\xe0\xe2\xd8\xae\xed\xb0\xd0\x4f\x5f\x39\x24\xbb\x04\xc2\x96\xbd\xbd\x53\x2b\x47\x5a\x34\x1e\xff\x66\x3c\xff\xbc\x41\x10\xb4\xa8\xe6\x5f\xd0\x58\xae\x64\x08\xdb\x6e\x6b\xc3\x65\x1a\xc2\x14\xcd\x96\x33\x8c\x18\x53\xb9\x74\xad\x0c\x1d\x4d\xa9\xa3\x61\x0b\x40\xd2\x0c\x43\xa0\x8f\x36\x90\x2a\xc5\xc0\xa1\xc9\xb8\xa4\x8e\x2b\x19\xac\xa9\x4c\x05\x9a\x52\xc9\x6a\xca\x30\x84\x4d\xbe\xc0\xc0\xee\xad\xc3\xac\x05\x20\xe8\x02\x85\xf5\x1c\x00\xaa\x75\xc7\xef\x1a\x89\x0e\x6d\x87\xab\x9b\x2b\xd8\xa7\x2e\x9b\x05\x65\x1d\x9a\xbb\xb5\x32\xfc\xef\xc2\x8d\xce\xe6\x43\x01\x7b\xba\x4c\x4f\xe4\xd6\xa1\x49\x94\xc0\xd7\xdf\xe4\xcd\x0e\x9b\x5c\x60\x71\xe1\x00\xa8\xe6\xf7\x46\xe5\xda\x86\xf0\x47\xbb\xfd\xb5\x08\x82\x41\xab\x72\xc3\xb0\x90\x79\x88\x2d\x37\xb6\x68\x16\x85\x70\x85\xae\xfd\x0b\xb4\x05\xb7\xc5\x5f\x4d\x1d\x5b\xfb\x1f\xb9\x4e\xa9\xc3\xf6\xd7\xeb\xd0\x5a\xa5\x2f\x92\x5f\x81\xb9\xc1\x2d\x67\xfe\x8e\xa7\x3c\x66\xf0\x82\x47\xb8\x73\x28\x7d\xc2\x6c\x13\x34\xa5\x98\x29\x69\xd1\x35\x7a\xd8\x80\xa3\x5a\x7f\x1f\x50\x13\x04\xb7\x28\x9d\xbd\x70\xb7\xa7\x04\x7c\x7d\x63\x25\x7e\xe4\x32\xe5\x72\xf5\x5f\x14\xa4\x12\x98\xe0\xd2\x13\xaa\x58\xbc\xe0\x7c\x0b\xe0\xbc\x8b\xae\x72\xd5\xe6\x8b\x3f\x91\xb9\xb2\xf6\x1b\xe7\x0a\xc0\x55\x24\x80\x8b\x13\xe5\x34\x09\xbe\x32\x9e\xe3\xdd\x2f\xea\x61\x8a\xff\xab\x09\x66\x35\x32\x7f\xdc\xa2\x40\xe6\x94\xf1\xbf\x01\x32\x5f\x55\xc3\x23\xf6\x37\xd2\x01\x0e\x73\x61\xea\x0c\x75\xb8\xda\x1f\xe8\x6e\xaf\x31\x84\x44\x09\xc1\xe5\xea\xa1\x50\x28\xe4\xe6\x58\x52\xd9\xcd\xe8\xee\x41\xd2\x2d\xe5\x82\x2e\x04\x86\x70\xf7\xfe\xe7\x16\x80\xc3\x4c\x8b\x27\xad\xe3\x78\x02\xd4\xa3\xf2\x06\xdf\x01\xaa\xe8\xf8\x65\x6b\xd5\x12\x5f\x07\x00\xd0\x86\x2b\xc3\xdd\xbe\x27\xa8\xb5\x87\x53\x87\x6a\x39\x1c\x64\x86\x3b\xce\xa8\x28\xb5\x7f\x02\xb7\x46\x28\x09\xa0\x95\x10\xb6\x90\x54\x37\xac\xbc\x00\xb5\x04\xee\x2c\x78\x06\x2c\x95\x01\x2e\x1d\x1a\x93\x6b\x7f\x05\x90\xca\x71\x86\xb6\x64\xae\x95\x75\x31\xba\x47\x65\x36\x21\x38\x93\x63\x29\x4f\xa5\x9d\x28\xc1\xd9\xfe\xa9\x9d\x3e\x71\x63\xdd\xef\xdc\xad\x3f\x1f\x8e\x94\x8a\x74\xb9\xe4\x92\xbb\x32\x7b\xfe\xf3\x66\xa3\x33\xa9\x9f\x7f\x7f\xe5\xdc\x60\xda\xcf\x0d\x97\xab\x29\x5b\x63\x9a\xfb\x94\x0e\x56\x52\x3d\x89\xc9\x0e\x59\xee\xfd\x3c\x3e\x79\x60\x4e\xcb\x2a\x9c\xa1\xc9\x8e\xf2\x77\xf8\x82\x43\x59\x92\x9d\x36\x68\x7d\x83\x9d\x69\xf8\x2f\x80\x0d\xee\x43\xa8\xa7\x5b\xd9\x56\x4d\xa9\x5c\x4a\xa3\xa1\xbe\xe8\x61\x20\x1b\x15\xb6\x54\xe4\xd8\x68\xc6\xaf\x00\x04\x97\xf9\xee\xb2\x13\x54\xe8\x35\xed\xe0\xc6\x32\x27\xbc\x1b\x56\x2b\x17\x1c\x67\xea\xa4\x56\xbe\xb3\x77\x6d\x9f\xec\x76\xb9\xed\x94\xf0\xb8\x7a\xd8\x82\x23\x23\x64\xc7\xad\xab\xe2\xc4\x94\x74\x94\x4b\x34\x35\xe5\x2b\xbb\xe6\xf0\xf1\x8c\xae\x30\x04\x9d\x2f\x04\x67\x1d\x64\xa6\x43\x1f\xed\x8d\x3f\x8c\xec\xee\xe6\x25\x48\xb8\xed\x76\xba\xef\x3a\xef\x4e\x61\x93\x5c\x88\xaa\x62\x07\xcb\x58\xb9\x89\x41\x8b\xe5\xe4\xae\x9a\x94\xe5\x45\xb7\x29\xe9\x70\xe7\x9e\x9d\xf7\xcb\x20\x4d\xc7\x52\xec\x13\xa5\xdc\x27\x2e\xf0\xd0\x87\xb5\x9e\x28\x15\x73\x19\xd9\x58\x49\xaf\x78\x69\xfb\xc1\xa2\x09\xa1\x7b\x7b\x7b\x7b\xbe\x57\x3e\x63\x67\x9b\x54\x08\xf5\x38\x31\x7c\xcb\x05\xae\x90\x58\x46\x45\x71\xf1\x10\x96\x54\xd8\x63\x23\x28\xb7\x75\xdf\xab\xe0\xc7\xe3\x3e\x99\xc7\xd1\x88\xb4\x1a\x6a\xe1\x93\x51\x59\xfd\x98\x5f\x4b\x8e\x22\x2d\xdf\xd8\xd3\x55\xec\x4d\xa8\x5b\x87\xc5\xac\xeb\xf8\xbc\xfa\x21\xd5\x68\x7b\x32\xee\xff\x18\xd3\xd5\x74\xeb\xc8\x4b\xa6\xbd\xd9\xe9\x24\xea\xfd\x60\xdb\xc5\x9b\xde\xe8\x00\x89\xa3\x8f\x43\x32\x9f\x4e\xc6\xb3\xf9\x20\x9e\x91\x24\x79\x98\xcc\x06\xe3\x78\xde\x4f\xa2\x41\x3c\x88\xef\x4f\xac\x14\x8e\x85\xf5\x16\x6c\x26\xf6\x3e\x93\xfe\xc3\x90\xf4\xe7\xe4\x0b\x89\x67\xff\xc6\x2b\x0a\xe5\x45\x60\x42\x3e\x46\xc3\x28\xee\x91\xf9\x68\x1c\x0f\x66\xe3\xe4\x9b\x60\x7d\x32\x24\x33\x32\x1f\x8e\x7b\xd1\x70\xde\x8f\x66\xd1\xab\xef\x37\xb8\x8f\xc7\x09\x99\xf7\x23\x32\x1a\xc7\xf3\x29\x99\x4d\x5f\x8d\xf0\x05\x37\x23\xc9\x68\x10\x47\x45\xac\xef\x93\xa8\x47\xe6\x13\x92\x0c\xc6\xfd\x0b\xb0\xa0\xdb\x8c\x2a\xfa\xe6\x95\xac\xee\xdd\xed\x31\xec\xf9\xff\xf2\x9a\xbe\x7f\xf3\xd0\xba\x13\x29\x00\xd3\x79\x08\xef\x6f\xb3\x13\x71\x86\x99\x32\xfb\x10\x7e\xfb\x75\xc4\x6b\x5b\x82\x67\xfc\x9c\x52\xa9\x77\xef\x3e\x8c\x78\xeb\x9f\x01\x00\x31\x6e\x05\x2c\x23\x0f\x00\x00")
+
+func spotInterruptionHandlerYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_spotInterruptionHandlerYaml,
+		"spot-interruption-handler.yaml",
+	)
+}
+
+func spotInterruptionHandlerYaml() (*asset, error) {
+	bytes, err := spotInterruptionHandlerYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "spot-interruption-handler.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x55, 0x22, 0x9b, 0x48, 0xa0, 0xf5, 0xcd, 0x6a, 0x40, 0x1e, 0xdd, 0xb5, 0x38, 0x96, 0x15, 0xd8, 0xfc, 0x52, 0x87, 0x5f, 0x36, 0x3, 0x24, 0x15, 0x48, 0x3, 0x24, 0x7f, 0xd6, 0xa0, 0x77, 0xe7}}
+	return a, nil
+}
+
 var _vpcAdmissionWebhookConfigYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x4f\x6b\xf3\x30\x0c\xc6\xef\xf9\x14\x22\xf7\xa4\xf4\xf6\xe2\xdb\x4b\x29\x63\x87\xc1\x18\x63\x3b\x8c\x1d\x14\x47\x4d\x45\x62\xcb\x58\x76\x4a\xf7\xe9\x47\xfe\xb4\xac\xb0\xd5\x17\xdb\x7a\xa4\xdf\x63\xc9\x18\xf8\x8d\xa2\xb2\x78\x03\xd8\x3a\xd6\xe9\x18\xa9\x63\x4d\x11\x13\x8b\xaf\xfb\x7f\x5a\xb3\x6c\xc6\x6d\x43\x09\xb7\x45\xcf\xbe\x35\xf0\x94\x13\x26\xf6\xdd\x3b\x35\x47\x91\x7e\x27\xfe\xc0\x5d\x5e\x2a\x0a\x47\x09\x5b\x4c\x68\x0a\x00\x8f\x8e\x0c\x8c\xc1\x56\x57\x7a\x75\x5a\x8a\x2a\x7b\xe8\xd6\x0c\x0d\x68\xc9\x40\x9f\x1b\xaa\xf4\xac\x89\x5c\x01\x30\x60\x43\x83\x4e\x10\x00\x0c\xe1\x0f\x4a\xb1\xee\x73\x62\x75\xcf\xaf\x46\x87\x5f\xe2\xf1\xa4\xb5\x15\x37\x63\xed\xc0\xe4\xd3\xf2\xfa\xc5\x08\x40\x29\x8e\x6c\xe9\x72\xbd\xdb\xc2\x4d\xce\xaf\x4d\x2c\x2b\x60\x3a\x1a\x28\x37\x6e\x1a\x1b\x95\x73\x3c\xe6\x81\xf4\xe2\x52\x81\x04\x5a\xc6\xa7\x06\x3e\xa0\xdc\xbd\xec\xff\xbf\xee\x4b\xf8\xbc\x32\x30\xf0\x43\x94\x1c\x26\xbd\x2c\x6f\xe2\xeb\x0f\xce\xca\xb8\xfd\xa1\x45\x52\xc9\xd1\xd2\xac\x04\x69\x75\xd5\x0e\xc8\x43\x8e\xf4\x2c\x03\xdb\xb3\x81\xc7\xce\x4b\xa4\xe2\x3b\x00\x00\xff\xff\x49\xee\x9e\x02\x0c\x02\x00\x00")
 
 func vpcAdmissionWebhookConfigYamlBytes() ([]byte, error) {
@@ -351,6 +372,7 @@ var _bindata = map[string]func() (*asset, error){
 	"efa-device-plugin.yaml":            efaDevicePluginYaml,
 	"neuron-device-plugin.yaml":         neuronDevicePluginYaml,
 	"nvidia-device-plugin.yaml":         nvidiaDevicePluginYaml,
+	"spot-interruption-handler.yaml":    spotInterruptionHandlerYaml,
 	"vpc-admission-webhook-config.yaml": vpcAdmissionWebhookConfigYaml,
 	"vpc-admission-webhook-csr.yaml":    vpcAdmissionWebhookCsrYaml,
 	"vpc-admission-webhook-dep.yaml":    vpcAdmissionWebhookDepYaml,
@@ -406,6 +428,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"efa-device-plugin.yaml": {efaDevicePluginYaml, map[string]*bintree{}},
 	"neuron-device-plugin.yaml": {neuronDevicePluginYaml, map[string]*bintree{}},
 	"nvidia-device-plugin.yaml": {nvidiaDevicePluginYaml, map[string]*bintree{}},
+	"spot-interruption-handler.yaml": {spotInterruptionHandlerYaml, map[string]*bintree{}},
 	"vpc-admission-webhook-config.yaml": {vpcAdmissionWebhookConfigYaml, map[string]*bintree{}},
 	"vpc-admission-webhook-csr.yaml": {vpcAdmissionWebhookCsrYaml, map[string]*bintree{}},
 	"vpc-admission-webhook-dep.yaml": {vpcAdmissionWebhookDepYaml, map[string]*bintree{}},
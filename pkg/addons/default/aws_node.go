@@ -9,6 +9,7 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -63,6 +64,46 @@ func DoesAWSNodeSupportMultiArch(rawClient kubernetes.RawClientInterface, region
 	return false, nil
 }
 
+// IsAWSNodeUpToDate returns whether the images of the `aws-node` add-on match the released version
+func IsAWSNodeUpToDate(rawClient kubernetes.RawClientInterface, region string) (bool, error) {
+	clusterDaemonSet, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			logger.Warning("%q was not found", AWSNode)
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "getting %q", AWSNode)
+	}
+
+	list, err := LoadAsset(AWSNode, "yaml")
+	if err != nil {
+		return false, err
+	}
+
+	for _, rawObj := range list.Items {
+		resource, err := rawClient.NewRawResource(rawObj.Object)
+		if err != nil {
+			return false, err
+		}
+		if resource.GVK.Kind != "DaemonSet" {
+			continue
+		}
+		daemonSet, ok := resource.Info.Object.(*appsv1.DaemonSet)
+		if !ok {
+			return false, fmt.Errorf("expected type %T; got %T", &appsv1.DaemonSet{}, resource.Info.Object)
+		}
+		if err := useAWSNodeRegionalImages(daemonSet, region); err != nil {
+			return false, err
+		}
+		tagMismatch, err := awsNodeImageTagsDiffer(daemonSet, clusterDaemonSet)
+		if err != nil {
+			return false, err
+		}
+		return !tagMismatch, nil
+	}
+	return true, nil
+}
+
 // UpdateAWSNode will update the `aws-node` add-on and returns true
 // if an update is available.
 func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan bool) (bool, error) {
@@ -93,39 +134,14 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 			if !ok {
 				return false, fmt.Errorf("expected type %T; got %T", &appsv1.Deployment{}, resource.Info.Object)
 			}
-			container := &daemonSet.Spec.Template.Spec.Containers[0]
-			initContainer := &daemonSet.Spec.Template.Spec.InitContainers[0]
-			imageParts := strings.Split(container.Image, ":")
-			if len(imageParts) != 2 {
-				return false, fmt.Errorf("invalid container image: %s", container.Image)
-			}
-
-			container.Image = awsNodeImageFormatPrefix + ":" + imageParts[1]
-			initContainer.Image = awsNodeInitImageFormatPrefix + ":" + imageParts[1]
-			if err := addons.UseRegionalImage(&daemonSet.Spec.Template, region); err != nil {
+			if err := useAWSNodeRegionalImages(daemonSet, region); err != nil {
 				return false, err
 			}
-
-			containerTagMismatch, err := addons.ImageTagsDiffer(
-				container.Image,
-				clusterDaemonSet.Spec.Template.Spec.Containers[0].Image,
-			)
+			tagMismatch, err = awsNodeImageTagsDiffer(daemonSet, clusterDaemonSet)
 			if err != nil {
 				return false, err
 			}
-
-			initContainerTagMismatch := true // Will be true by default if the init containers don't exist
-			if len(clusterDaemonSet.Spec.Template.Spec.InitContainers) > 0 {
-				initContainerTagMismatch, err = addons.ImageTagsDiffer(
-					initContainer.Image,
-					clusterDaemonSet.Spec.Template.Spec.InitContainers[0].Image,
-				)
-				if err != nil {
-					return false, err
-				}
-			}
-
-			tagMismatch = containerTagMismatch || initContainerTagMismatch
+			preserveConfiguredAWSNodeEnv(daemonSet, clusterDaemonSet)
 
 		case "CustomResourceDefinition":
 			if plan {
@@ -166,3 +182,97 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region string, plan
 	logger.Info("%q is now up-to-date", AWSNode)
 	return false, nil
 }
+
+// IsAWSNodePresent returns true if the `aws-node` daemonset exists in the cluster
+func IsAWSNodePresent(clientSet kubernetes.Interface) (bool, error) {
+	if _, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{}); err != nil {
+		if apierrs.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "getting %q", AWSNode)
+	}
+	return true, nil
+}
+
+// DeleteAWSNode deletes the `aws-node` daemonset, for clusters that use another CNI plugin, and
+// returns true if it is present but was not deleted, which only happens in plan mode
+func DeleteAWSNode(clientSet kubernetes.Interface, plan bool) (bool, error) {
+	present, err := IsAWSNodePresent(clientSet)
+	if err != nil {
+		return false, err
+	}
+	if !present {
+		logger.Info("%q is not present", AWSNode)
+		return false, nil
+	}
+	if plan {
+		logger.Critical("(plan) %q would be deleted", AWSNode)
+		return true, nil
+	}
+	if err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Delete(context.TODO(), AWSNode, metav1.DeleteOptions{}); err != nil && !apierrs.IsNotFound(err) {
+		return false, errors.Wrapf(err, "deleting %q", AWSNode)
+	}
+	logger.Info("deleted %q", AWSNode)
+	return false, nil
+}
+
+// preserveConfiguredAWSNodeEnv copies the values of the environment variables set by eksctl
+// from the aws-node container in the cluster to the desired one, so that updating aws-node
+// does not revert custom networking nor prefix delegation
+func preserveConfiguredAWSNodeEnv(desired, current *appsv1.DaemonSet) {
+	var currentEnv []corev1.EnvVar
+	for _, container := range current.Spec.Template.Spec.Containers {
+		if container.Name == AWSNode {
+			currentEnv = container.Env
+		}
+	}
+	for i, container := range desired.Spec.Template.Spec.Containers {
+		if container.Name != AWSNode {
+			continue
+		}
+		for _, name := range configuredAWSNodeEnv {
+			for _, envVar := range currentEnv {
+				if envVar.Name == name && envVar.ValueFrom == nil {
+					container.Env = setEnvVar(container.Env, name, envVar.Value)
+				}
+			}
+		}
+		desired.Spec.Template.Spec.Containers[i] = container
+	}
+}
+
+func useAWSNodeRegionalImages(daemonSet *appsv1.DaemonSet, region string) error {
+	container := &daemonSet.Spec.Template.Spec.Containers[0]
+	initContainer := &daemonSet.Spec.Template.Spec.InitContainers[0]
+	imageParts := strings.Split(container.Image, ":")
+	if len(imageParts) != 2 {
+		return fmt.Errorf("invalid container image: %s", container.Image)
+	}
+
+	container.Image = awsNodeImageFormatPrefix + ":" + imageParts[1]
+	initContainer.Image = awsNodeInitImageFormatPrefix + ":" + imageParts[1]
+	return addons.UseRegionalImage(&daemonSet.Spec.Template, region)
+}
+
+func awsNodeImageTagsDiffer(desired, current *appsv1.DaemonSet) (bool, error) {
+	containerTagMismatch, err := addons.ImageTagsDiffer(
+		desired.Spec.Template.Spec.Containers[0].Image,
+		current.Spec.Template.Spec.Containers[0].Image,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	initContainerTagMismatch := true // Will be true by default if the init containers don't exist
+	if len(current.Spec.Template.Spec.InitContainers) > 0 {
+		initContainerTagMismatch, err = addons.ImageTagsDiffer(
+			desired.Spec.Template.Spec.InitContainers[0].Image,
+			current.Spec.Template.Spec.InitContainers[0].Image,
+		)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return containerTagMismatch || initContainerTagMismatch, nil
+}
@@ -8,10 +8,15 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 
 	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
 	"github.com/weaveworks/eksctl/pkg/testutils"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 var _ = Describe("default addons - aws-node", func() {
@@ -56,6 +61,14 @@ var _ = Describe("default addons - aws-node", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(needsUpdate).To(BeTrue())
 		})
+		It("reports that the 1.15 sample is not up to date", func() {
+			loadSample("testdata/sample-1.15.json")
+			rawClient.AssumeObjectsMissing = false
+
+			upToDate, err := IsAWSNodeUpToDate(rawClient, "eu-west-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upToDate).To(BeFalse())
+		})
 		It("reports that sample with 1.7.6 doesn't need an update", func() {
 			loadSample("testdata/sample-1.16-v1.7.json")
 			rawClient.AssumeObjectsMissing = false
@@ -184,4 +197,132 @@ var _ = Describe("default addons - aws-node", func() {
 			Expect(needsUpdate).To(BeFalse())
 		})
 	})
+
+	Describe("preserves the environment variables set by eksctl", func() {
+		var rawClient *testutils.FakeRawClient
+
+		awsNodeEnv := func(daemonSet *appsv1.DaemonSet) map[string]string {
+			env := map[string]string{}
+			for _, e := range daemonSet.Spec.Template.Spec.Containers[0].Env {
+				env[e.Name] = e.Value
+			}
+			return env
+		}
+
+		BeforeEach(func() {
+			rawClient = testutils.NewFakeRawClient()
+			rawClient.AssumeObjectsMissing = true
+			for _, item := range testutils.LoadSamples("testdata/sample-1.15.json") {
+				if daemonSet, ok := item.(*appsv1.DaemonSet); ok && daemonSet.Name == AWSNode {
+					daemonSet.Spec.Template.Spec.Containers[0].Env = append(daemonSet.Spec.Template.Spec.Containers[0].Env,
+						corev1.EnvVar{Name: "ENABLE_PREFIX_DELEGATION", Value: "true"},
+						corev1.EnvVar{Name: "WARM_PREFIX_TARGET", Value: "2"},
+					)
+				}
+				rc, err := rawClient.NewRawResource(item)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = rc.CreateOrReplace(false)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			rawClient.AssumeObjectsMissing = false
+		})
+
+		It("keeps prefix delegation enabled when updating aws-node", func() {
+			_, err := UpdateAWSNode(rawClient, "eu-west-1", false)
+			Expect(err).ToNot(HaveOccurred())
+
+			rawClient.ClientSetUseUpdatedObjects = true
+			awsNode, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			env := awsNodeEnv(awsNode)
+			Expect(env).To(HaveKeyWithValue("ENABLE_PREFIX_DELEGATION", "true"))
+			// variables not set by eksctl are reset to the values of the manifest
+			Expect(env).To(HaveKeyWithValue("WARM_PREFIX_TARGET", "1"))
+		})
+	})
+
+	Describe("when aws-node is disabled", func() {
+		var (
+			rawClient *testutils.FakeRawClient
+			cfg       *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			rawClient = testutils.NewFakeRawClient()
+			rawClient.AssumeObjectsMissing = true
+			for _, item := range testutils.LoadSamples("testdata/sample-1.15.json") {
+				rc, err := rawClient.NewRawResource(item)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = rc.CreateOrReplace(false)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			rawClient.AssumeObjectsMissing = false
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Region = "eu-west-1"
+			cfg.AWSNode = &api.AWSNodeConfig{Disable: true}
+		})
+
+		It("neither reports nor applies an update", func() {
+			awsNode := NewAWSNode(AddonInput{RawClient: rawClient, ClusterConfig: cfg})
+			upToDate, err := awsNode.IsUpToDate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upToDate).To(BeTrue())
+
+			updateRequired, err := awsNode.Update(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+			Expect(rawClient.Collection.Updated()).To(BeEmpty())
+		})
+
+		It("reports that the daemonset would be deleted in plan mode when deleteIfPresent is set", func() {
+			cfg.AWSNode.DeleteIfPresent = true
+			awsNode := NewAWSNode(AddonInput{RawClient: rawClient, ClusterConfig: cfg})
+			upToDate, err := awsNode.IsUpToDate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upToDate).To(BeFalse())
+
+			updateRequired, err := awsNode.Update(true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+			Expect(rawClient.Collection.Updated()).To(BeEmpty())
+		})
+	})
+
+	Describe("DeleteAWSNode", func() {
+		It("deletes the daemonset", func() {
+			clientSet := fake.NewSimpleClientset(&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: AWSNode, Namespace: metav1.NamespaceSystem},
+			})
+			updateRequired, err := DeleteAWSNode(clientSet, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+
+			_, err = clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("does nothing if the daemonset is not present", func() {
+			updateRequired, err := DeleteAWSNode(fake.NewSimpleClientset(), false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+		})
+	})
+
+	Describe("IsAWSNodePresent", func() {
+		It("reports whether the daemonset exists without deleting it", func() {
+			clientSet := fake.NewSimpleClientset(&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: AWSNode, Namespace: metav1.NamespaceSystem},
+			})
+			present, err := IsAWSNodePresent(clientSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(present).To(BeTrue())
+			Expect(clientSet.Actions()).To(HaveLen(1))
+			Expect(clientSet.Actions()[0].GetVerb()).To(Equal("get"))
+
+			present, err = IsAWSNodePresent(fake.NewSimpleClientset())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(present).To(BeFalse())
+		})
+	})
 })
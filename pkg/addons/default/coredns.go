@@ -2,6 +2,7 @@ package defaultaddons
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -12,6 +13,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclient "k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/fargate/coredns"
@@ -75,8 +78,10 @@ func IsCoreDNSUpToDate(rawClient kubernetes.RawClientInterface, region, controlP
 }
 
 // UpdateCoreDNS will update the `coredns` add-on and returns true
-// if an update is available
-func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlaneVersion string, plan bool) (bool, error) {
+// if an update is available. The compute type and topology spread constraints set on the existing deployment
+// are preserved, unless coreDNSConfig specifies new ones. The pod labels and annotations of the existing deployment
+// are preserved too, and those of coreDNSConfig are added to them
+func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlaneVersion string, coreDNSConfig *api.CoreDNSConfig, plan bool) (bool, error) {
 	kubeDNSSevice, err := rawClient.ClientSet().CoreV1().Services(metav1.NamespaceSystem).Get(context.TODO(), KubeDNS, metav1.GetOptions{})
 	if err != nil {
 		if apierrs.IsNotFound(err) {
@@ -120,12 +125,17 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 			if err := addons.UseRegionalImage(template, region); err != nil {
 				return false, err
 			}
-			if computeType, ok := kubeDNSDeployment.Spec.Template.Annotations[coredns.ComputeTypeAnnotationKey]; ok {
+			preservePodMetadata(template, &kubeDNSDeployment.Spec.Template)
+			if coreDNSConfig != nil {
+				mergePodMetadata(template, coreDNSConfig.PodLabels, coreDNSConfig.PodAnnotations)
+			}
+			if computeType, ok := coreDNSComputeType(kubeDNSDeployment, coreDNSConfig); ok {
 				if template.Annotations == nil {
 					template.Annotations = make(map[string]string)
 				}
 				template.Annotations[coredns.ComputeTypeAnnotationKey] = computeType
 			}
+			template.Spec.TopologySpreadConstraints = coreDNSTopologySpreadConstraints(kubeDNSDeployment, coreDNSConfig)
 			tagMismatch, err = addons.ImageTagsDiffer(
 				template.Spec.Containers[0].Image,
 				kubeDNSDeployment.Spec.Template.Spec.Containers[0].Image,
@@ -158,6 +168,43 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 	return false, nil
 }
 
+// SetCoreDNSTopologySpreadConstraints replaces the topology spread constraints of the CoreDNS pods
+func SetCoreDNSTopologySpreadConstraints(clientSet kubeclient.Interface, constraints []corev1.TopologySpreadConstraint) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"topologySpreadConstraints": constraints,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal patch for %q", CoreDNS)
+	}
+
+	if _, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Patch(context.TODO(), CoreDNS, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to set topology spread constraints on %q", CoreDNS)
+	}
+	logger.Info("set %d topology spread constraint(s) on %q", len(constraints), CoreDNS)
+	return nil
+}
+
+func coreDNSTopologySpreadConstraints(existing *appsv1.Deployment, coreDNSConfig *api.CoreDNSConfig) []corev1.TopologySpreadConstraint {
+	if coreDNSConfig != nil && len(coreDNSConfig.TopologySpreadConstraints) > 0 {
+		return coreDNSConfig.TopologySpreadConstraints
+	}
+	return existing.Spec.Template.Spec.TopologySpreadConstraints
+}
+
+func coreDNSComputeType(existing *appsv1.Deployment, coreDNSConfig *api.CoreDNSConfig) (string, bool) {
+	if coreDNSConfig != nil && coreDNSConfig.ComputeType != "" {
+		return coreDNSConfig.ComputeType, true
+	}
+	computeType, ok := existing.Spec.Template.Annotations[coredns.ComputeTypeAnnotationKey]
+	return computeType, ok
+}
+
 func loadAssetCoreDNS(controlPlaneVersion string) (*metav1.List, error) {
 	if strings.HasPrefix(controlPlaneVersion, "1.10.") {
 		return nil, errors.New("CoreDNS is not supported on Kubernetes 1.10")
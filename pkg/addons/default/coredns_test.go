@@ -5,9 +5,13 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 
 	da "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
 	"github.com/weaveworks/eksctl/pkg/testutils"
 )
@@ -41,7 +45,7 @@ var _ = Describe("default addons - coredns", func() {
 		})
 
 		It("updates coredns to the correct version", func() {
-			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, false)
+			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, nil, false)
 			Expect(err).ToNot(HaveOccurred())
 
 			updateReqs := []string{
@@ -64,10 +68,145 @@ var _ = Describe("default addons - coredns", func() {
 		})
 	})
 
+	Context("UpdateCoreDNS with topology spread constraints", func() {
+		var zoneConstraint corev1.TopologySpreadConstraint
+
+		BeforeEach(func() {
+			createCoreDNSFromTestSample(rawClient, ct, kubernetesVersion)
+			zoneConstraint = corev1.TopologySpreadConstraint{
+				MaxSkew:           1,
+				TopologyKey:       "topology.kubernetes.io/zone",
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"k8s-app": "kube-dns"},
+				},
+			}
+			coreDNS, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), da.CoreDNS, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			coreDNS.TypeMeta = metav1.TypeMeta{Kind: "Deployment", APIVersion: "apps/v1"}
+			coreDNS.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{zoneConstraint}
+			rc, err := rawClient.NewRawResource(coreDNS)
+			Expect(err).ToNot(HaveOccurred())
+			_, err = rc.CreateOrReplace(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreDNSTopologySpreadConstraints(rawClient)).To(ConsistOf(zoneConstraint))
+		})
+
+		It("preserves the existing constraints when the image is updated", func() {
+			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, nil, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(coreDNSImage(rawClient)).To(HaveSuffix(":v1.6.6-eksbuild.1"))
+			Expect(coreDNSTopologySpreadConstraints(rawClient)).To(ConsistOf(zoneConstraint))
+		})
+
+		It("replaces the existing constraints with the configured ones", func() {
+			hostConstraint := corev1.TopologySpreadConstraint{
+				MaxSkew:           1,
+				TopologyKey:       "kubernetes.io/hostname",
+				WhenUnsatisfiable: corev1.DoNotSchedule,
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"k8s-app": "kube-dns"},
+				},
+			}
+			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, &api.CoreDNSConfig{
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{hostConstraint},
+			}, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(coreDNSImage(rawClient)).To(HaveSuffix(":v1.6.6-eksbuild.1"))
+			Expect(coreDNSTopologySpreadConstraints(rawClient)).To(ConsistOf(hostConstraint))
+		})
+	})
+
+	Context("UpdateCoreDNS with a compute type", func() {
+		BeforeEach(func() {
+			createCoreDNSFromTestSample(rawClient, ct, kubernetesVersion)
+		})
+
+		It("sets the configured compute type", func() {
+			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, &api.CoreDNSConfig{
+				ComputeType: api.CoreDNSComputeTypeFargate,
+			}, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			coreDNS, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), da.CoreDNS, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreDNS.Spec.Template.Annotations).To(HaveKeyWithValue("eks.amazonaws.com/compute-type", "fargate"))
+		})
+	})
+
+	Context("UpdateCoreDNS with pod labels and annotations", func() {
+		BeforeEach(func() {
+			createCoreDNSFromTestSample(rawClient, ct, kubernetesVersion)
+		})
+
+		It("adds them to the CoreDNS pods and preserves them across updates", func() {
+			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, &api.CoreDNSConfig{
+				PodLabels:      map[string]string{"sidecar.istio.io/inject": "true"},
+				PodAnnotations: map[string]string{"example.com/policy": "dns"},
+			}, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, nil, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			coreDNS, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), da.CoreDNS, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreDNS.Spec.Template.Labels).To(HaveKeyWithValue("sidecar.istio.io/inject", "true"))
+			Expect(coreDNS.Spec.Template.Labels).To(HaveKeyWithValue("k8s-app", "kube-dns"))
+			Expect(coreDNS.Spec.Template.Annotations).To(HaveKeyWithValue("example.com/policy", "dns"))
+		})
+	})
+
+	Context("SetCoreDNSPodMetadata", func() {
+		It("adds the labels and annotations to the CoreDNS pods", func() {
+			clientSet := fake.NewSimpleClientset(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: da.CoreDNS, Namespace: metav1.NamespaceSystem},
+				Spec: appsv1.DeploymentSpec{
+					Template: corev1.PodTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{
+							Labels: map[string]string{"k8s-app": "kube-dns"},
+						},
+					},
+				},
+			})
+			Expect(da.SetCoreDNSPodMetadata(clientSet, map[string]string{"team": "platform"}, nil)).To(Succeed())
+
+			coreDNS, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), da.CoreDNS, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreDNS.Spec.Template.Labels).To(Equal(map[string]string{"k8s-app": "kube-dns", "team": "platform"}))
+			Expect(coreDNS.Spec.Template.Annotations).To(BeEmpty())
+		})
+	})
+
+	Context("SetCoreDNSTopologySpreadConstraints", func() {
+		It("sets the constraints on the CoreDNS deployment", func() {
+			clientSet := fake.NewSimpleClientset(&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: da.CoreDNS, Namespace: metav1.NamespaceSystem},
+			})
+			constraint := corev1.TopologySpreadConstraint{
+				MaxSkew:           1,
+				TopologyKey:       "topology.kubernetes.io/zone",
+				WhenUnsatisfiable: corev1.DoNotSchedule,
+			}
+			Expect(da.SetCoreDNSTopologySpreadConstraints(clientSet, []corev1.TopologySpreadConstraint{constraint})).To(Succeed())
+
+			coreDNS, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), da.CoreDNS, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(coreDNS.Spec.Template.Spec.TopologySpreadConstraints).To(ConsistOf(constraint))
+		})
+
+		It("errors if CoreDNS does not exist", func() {
+			err := da.SetCoreDNSTopologySpreadConstraints(fake.NewSimpleClientset(), nil)
+			Expect(err).To(MatchError(ContainSubstring(`failed to set topology spread constraints on "coredns"`)))
+		})
+	})
+
 	Context("IsCoreDNSUpToDate", func() {
 		BeforeEach(func() {
 			createCoreDNSFromTestSample(rawClient, ct, kubernetesVersion)
-			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, false)
+			_, err := da.UpdateCoreDNS(rawClient, region, controlPlaneVersion, nil, false)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -134,3 +273,10 @@ func coreDNSImage(rawClient *testutils.FakeRawClient) string {
 
 	return coreDNS.Spec.Template.Spec.Containers[0].Image
 }
+
+func coreDNSTopologySpreadConstraints(rawClient *testutils.FakeRawClient) []corev1.TopologySpreadConstraint {
+	coreDNS, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), da.CoreDNS, metav1.GetOptions{})
+	Expect(err).ToNot(HaveOccurred())
+
+	return coreDNS.Spec.Template.Spec.TopologySpreadConstraints
+}
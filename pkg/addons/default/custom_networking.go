@@ -0,0 +1,176 @@
+package defaultaddons
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	customNetworkConfigEnv = "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG"
+	eniConfigLabelDefEnv   = "ENI_CONFIG_LABEL_DEF"
+	// ENIConfigs are named after availability zones, the CNI selects the ENIConfig of a node by its zone label
+	eniConfigLabelDef = "topology.kubernetes.io/zone"
+	// prefixDelegationEnv enables prefix delegation in the CNI
+	prefixDelegationEnv = "ENABLE_PREFIX_DELEGATION"
+)
+
+// configuredAWSNodeEnv are the environment variables of aws-node set by eksctl, whose values
+// in the cluster are preserved when aws-node is updated
+var configuredAWSNodeEnv = []string{
+	customNetworkConfigEnv,
+	eniConfigLabelDefEnv,
+	prefixDelegationEnv,
+}
+
+// ENIConfigResource is the resource of the ENIConfig custom resources, whose CRD is part of the aws-node manifest
+var ENIConfigResource = schema.GroupVersionResource{
+	Group:    "crd.k8s.amazonaws.com",
+	Version:  "v1alpha1",
+	Resource: "eniconfigs",
+}
+
+// MakeENIConfigs returns the ENIConfigs that place the pods of each availability zone in the
+// pod subnet of that zone. The ENIConfigs are named after the availability zones
+func MakeENIConfigs(customNetworking *api.CustomNetworking) []*unstructured.Unstructured {
+	var azs []string
+	for az := range customNetworking.PodSubnets {
+		azs = append(azs, az)
+	}
+	sort.Strings(azs)
+
+	var eniConfigs []*unstructured.Unstructured
+	for _, az := range azs {
+		spec := map[string]interface{}{
+			"subnet": customNetworking.PodSubnets[az],
+		}
+		if len(customNetworking.SecurityGroups) > 0 {
+			var securityGroups []interface{}
+			for _, sg := range customNetworking.SecurityGroups {
+				securityGroups = append(securityGroups, sg)
+			}
+			spec["securityGroups"] = securityGroups
+		}
+		eniConfigs = append(eniConfigs, &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": ENIConfigResource.GroupVersion().String(),
+				"kind":       "ENIConfig",
+				"metadata": map[string]interface{}{
+					"name": az,
+				},
+				"spec": spec,
+			},
+		})
+	}
+	return eniConfigs
+}
+
+// ApplyENIConfigs creates the ENIConfigs of the pod subnets, or replaces them if they exist
+func ApplyENIConfigs(dynamicClient dynamic.Interface, customNetworking *api.CustomNetworking) error {
+	client := dynamicClient.Resource(ENIConfigResource)
+	for _, eniConfig := range MakeENIConfigs(customNetworking) {
+		existing, err := client.Get(context.TODO(), eniConfig.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrs.IsNotFound(err):
+			if _, err := client.Create(context.TODO(), eniConfig, metav1.CreateOptions{}); err != nil {
+				return errors.Wrapf(err, "creating ENIConfig %q", eniConfig.GetName())
+			}
+			logger.Info("created ENIConfig %q", eniConfig.GetName())
+		case err != nil:
+			return errors.Wrapf(err, "getting ENIConfig %q", eniConfig.GetName())
+		default:
+			eniConfig.SetResourceVersion(existing.GetResourceVersion())
+			if _, err := client.Update(context.TODO(), eniConfig, metav1.UpdateOptions{}); err != nil {
+				return errors.Wrapf(err, "replacing ENIConfig %q", eniConfig.GetName())
+			}
+			logger.Info("replaced ENIConfig %q", eniConfig.GetName())
+		}
+	}
+	return nil
+}
+
+// SetAWSNodeCustomNetworking enables custom networking in the aws-node DaemonSet,
+// making the CNI pick the ENIConfig of each node by its availability zone
+func SetAWSNodeCustomNetworking(clientSet kubeclient.Interface) error {
+	env := map[string]string{
+		customNetworkConfigEnv: "true",
+		eniConfigLabelDefEnv:   eniConfigLabelDef,
+	}
+	if err := setAWSNodeEnv(clientSet, env); err != nil {
+		return errors.Wrapf(err, "enabling custom networking in %q", AWSNode)
+	}
+	logger.Info("enabled custom networking in %q", AWSNode)
+	return nil
+}
+
+// EnablePrefixDelegation enables prefix delegation in the aws-node DaemonSet, making the CNI
+// assign /28 prefixes rather than individual addresses to the network interfaces of the nodes
+func EnablePrefixDelegation(clientSet kubeclient.Interface) error {
+	if err := setAWSNodeEnv(clientSet, map[string]string{prefixDelegationEnv: "true"}); err != nil {
+		return errors.Wrapf(err, "enabling prefix delegation in %q", AWSNode)
+	}
+	logger.Info("enabled prefix delegation in %q", AWSNode)
+	return nil
+}
+
+// setAWSNodeEnv sets environment variables of the aws-node container of the aws-node DaemonSet
+func setAWSNodeEnv(clientSet kubeclient.Interface, env map[string]string) error {
+	daemonSet, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "getting %q", AWSNode)
+	}
+
+	var names []string
+	for name := range env {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	updated := false
+	for i, container := range daemonSet.Spec.Template.Spec.Containers {
+		if container.Name != AWSNode {
+			continue
+		}
+		for _, name := range names {
+			container.Env = setEnvVar(container.Env, name, env[name])
+		}
+		daemonSet.Spec.Template.Spec.Containers[i] = container
+		updated = true
+	}
+	if !updated {
+		return errors.Errorf("no %q container found in %q", AWSNode, AWSNode)
+	}
+
+	_, err = clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Update(context.TODO(), daemonSet, metav1.UpdateOptions{})
+	return err
+}
+
+// ConfigureCustomNetworking applies the ENIConfigs of the pod subnets and enables custom networking in aws-node
+func ConfigureCustomNetworking(dynamicClient dynamic.Interface, clientSet kubeclient.Interface, customNetworking *api.CustomNetworking) error {
+	if err := ApplyENIConfigs(dynamicClient, customNetworking); err != nil {
+		return err
+	}
+	return SetAWSNodeCustomNetworking(clientSet)
+}
+
+func setEnvVar(env []corev1.EnvVar, name, value string) []corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			env[i].Value = value
+			env[i].ValueFrom = nil
+			return env
+		}
+	}
+	return append(env, corev1.EnvVar{Name: name, Value: value})
+}
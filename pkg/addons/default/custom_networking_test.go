@@ -0,0 +1,141 @@
+package defaultaddons_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("default addons - custom networking", func() {
+	var (
+		clientSet        *fake.Clientset
+		dynamicClient    *dynamicfake.FakeDynamicClient
+		customNetworking *api.CustomNetworking
+	)
+
+	eniConfigSubnet := func(name string) string {
+		eniConfig, err := dynamicClient.Resource(ENIConfigResource).Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		subnet, _, err := unstructured.NestedString(eniConfig.Object, "spec", "subnet")
+		Expect(err).NotTo(HaveOccurred())
+		return subnet
+	}
+
+	awsNodeEnv := func() map[string]string {
+		ds, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), AWSNode, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		env := map[string]string{}
+		for _, e := range ds.Spec.Template.Spec.Containers[0].Env {
+			env[e.Name] = e.Value
+		}
+		return env
+	}
+
+	BeforeEach(func() {
+		clientSet = fake.NewSimpleClientset(&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: AWSNode, Namespace: metav1.NamespaceSystem},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: AWSNode,
+							Env: []corev1.EnvVar{
+								{Name: "AWS_VPC_K8S_CNI_LOGLEVEL", Value: "DEBUG"},
+								{Name: "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG", Value: "false"},
+							},
+						}},
+					},
+				},
+			},
+		})
+		dynamicClient = dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+		customNetworking = &api.CustomNetworking{
+			PodSubnets: map[string]string{
+				"us-west-2b": "subnet-2",
+				"us-west-2a": "subnet-1",
+			},
+		}
+	})
+
+	It("generates an ENIConfig per availability zone", func() {
+		eniConfigs := MakeENIConfigs(customNetworking)
+		Expect(eniConfigs).To(HaveLen(2))
+		for i, az := range []string{"us-west-2a", "us-west-2b"} {
+			data, err := eniConfigs[i].MarshalJSON()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(MatchJSON(fmt.Sprintf(`{
+				"apiVersion": "crd.k8s.amazonaws.com/v1alpha1",
+				"kind": "ENIConfig",
+				"metadata": {"name": %q},
+				"spec": {"subnet": "subnet-%d"}
+			}`, az, i+1)))
+		}
+	})
+
+	It("sets the security groups of the ENIConfigs", func() {
+		customNetworking.SecurityGroups = []string{"sg-1", "sg-2"}
+		data, err := MakeENIConfigs(customNetworking)[0].MarshalJSON()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(MatchJSON(`{
+			"apiVersion": "crd.k8s.amazonaws.com/v1alpha1",
+			"kind": "ENIConfig",
+			"metadata": {"name": "us-west-2a"},
+			"spec": {"subnet": "subnet-1", "securityGroups": ["sg-1", "sg-2"]}
+		}`))
+	})
+
+	It("creates the ENIConfigs, or replaces existing ones", func() {
+		Expect(ApplyENIConfigs(dynamicClient, &api.CustomNetworking{PodSubnets: map[string]string{"us-west-2a": "subnet-old"}})).To(Succeed())
+		Expect(eniConfigSubnet("us-west-2a")).To(Equal("subnet-old"))
+
+		Expect(ApplyENIConfigs(dynamicClient, customNetworking)).To(Succeed())
+		Expect(eniConfigSubnet("us-west-2a")).To(Equal("subnet-1"))
+		Expect(eniConfigSubnet("us-west-2b")).To(Equal("subnet-2"))
+	})
+
+	It("enables custom networking in aws-node", func() {
+		Expect(SetAWSNodeCustomNetworking(clientSet)).To(Succeed())
+		Expect(awsNodeEnv()).To(Equal(map[string]string{
+			"AWS_VPC_K8S_CNI_LOGLEVEL":           "DEBUG",
+			"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG": "true",
+			"ENI_CONFIG_LABEL_DEF":               "topology.kubernetes.io/zone",
+		}))
+	})
+
+	It("enables prefix delegation in aws-node", func() {
+		Expect(EnablePrefixDelegation(clientSet)).To(Succeed())
+		Expect(awsNodeEnv()).To(Equal(map[string]string{
+			"AWS_VPC_K8S_CNI_LOGLEVEL":           "DEBUG",
+			"AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG": "false",
+			"ENABLE_PREFIX_DELEGATION":           "true",
+		}))
+	})
+
+	It("applies the ENIConfigs and enables custom networking", func() {
+		Expect(ConfigureCustomNetworking(dynamicClient, clientSet, customNetworking)).To(Succeed())
+		Expect(eniConfigSubnet("us-west-2a")).To(Equal("subnet-1"))
+		Expect(awsNodeEnv()).To(HaveKeyWithValue("AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG", "true"))
+	})
+
+	It("does not enable custom networking when the ENIConfigs cannot be applied", func() {
+		dynamicClient.PrependReactor("create", "eniconfigs", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, fmt.Errorf("no matches for kind ENIConfig")
+		})
+		err := ConfigureCustomNetworking(dynamicClient, clientSet, customNetworking)
+		Expect(err).To(MatchError(`creating ENIConfig "us-west-2a": no matches for kind ENIConfig`))
+		Expect(awsNodeEnv()).To(HaveKeyWithValue("AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG", "false"))
+	})
+})
@@ -0,0 +1,24 @@
+package defaultaddons
+
+import "time"
+
+func SetRolloutPollInterval(interval time.Duration) {
+	rolloutPollInterval = interval
+}
+
+// SaveRegistry returns a function restoring the registered default addons
+func SaveRegistry() func() {
+	saved := append([]registeredAddon{}, registry...)
+	return func() {
+		registry = saved
+	}
+}
+
+// SetNow sets the current time the maintenance window is checked against, and returns a function restoring it
+func SetNow(f func() time.Time) func() {
+	saved := now
+	now = f
+	return func() {
+		now = saved
+	}
+}
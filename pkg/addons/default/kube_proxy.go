@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	v1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -53,8 +54,12 @@ func IsKubeProxyUpToDate(clientSet kubernetes.Interface, controlPlaneVersion str
 	return desiredTag == imageTag, nil
 }
 
-// UpdateKubeProxy updates image tag for kube-system:daemonset/kube-proxy based to match controlPlaneVersion
-func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string, plan bool) (bool, error) {
+// UpdateKubeProxy updates image tag for kube-system:daemonset/kube-proxy based to match controlPlaneVersion.
+// When skipImageTag is set the image is left as is and the node selectors and tolerations are reconciled
+// instead, so that kube-proxy runs on every node; this is meant for test clusters that do not use the EKS
+// images, e.g. kind.
+// The pod labels and annotations of kubeProxyConfig are added to the daemonset, those already set on it are preserved
+func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string, kubeProxyConfig *api.KubeProxyConfig, plan, skipImageTag bool) (bool, error) {
 	printer := printers.NewJSONPrinter()
 
 	d, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), KubeProxy, metav1.GetOptions{})
@@ -76,9 +81,18 @@ func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string,
 	}
 
 	hasArm64NodeSelector := daemeonSetHasArm64NodeSelector(d, archLabel)
+	toleratesAllTaints := true
+	if skipImageTag {
+		// a daemonset without an arch node selector, as found on non-EKS clusters, already runs on arm64 nodes
+		hasArm64NodeSelector = hasArm64NodeSelector || !daemonSetHasArchNodeSelector(d, archLabel)
+		toleratesAllTaints = daemonSetToleratesAllTaints(d)
+	}
 	if !hasArm64NodeSelector {
 		logger.Info("missing arm64 nodeSelector value")
 	}
+	if !toleratesAllTaints {
+		logger.Info("missing toleration of all taints")
+	}
 
 	if numContainers := len(d.Spec.Template.Spec.Containers); !(numContainers >= 1) {
 		return false, fmt.Errorf("%s has %d containers, expected at least 1", KubeProxy, numContainers)
@@ -89,19 +103,34 @@ func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string,
 	}
 
 	image := &d.Spec.Template.Spec.Containers[0].Image
-	imageParts := strings.Split(*image, ":")
+	imageUpToDate := true
+	var desiredImage string
+	if skipImageTag {
+		logger.Info("skipping the image tag check for %q", KubeProxy)
+	} else {
+		imageParts := strings.Split(*image, ":")
+
+		if len(imageParts) != 2 {
+			return false, fmt.Errorf("unexpected image format %q for %q", *image, KubeProxy)
+		}
+
+		desiredTag, err := kubeProxyImageTag(controlPlaneVersion)
+		if err != nil {
+			return false, err
+		}
+		logger.Debug("imageParts = %v, desiredTag = %s", imageParts, desiredTag)
 
-	if len(imageParts) != 2 {
-		return false, fmt.Errorf("unexpected image format %q for %q", *image, KubeProxy)
+		imageUpToDate = imageParts[1] == desiredTag
+		imageParts[1] = desiredTag
+		desiredImage = strings.Join(imageParts, ":")
 	}
 
-	desiredTag, err := kubeProxyImageTag(controlPlaneVersion)
-	if err != nil {
-		return false, err
+	podMetadataUpToDate := true
+	if kubeProxyConfig != nil {
+		podMetadataUpToDate = !mergePodMetadata(&d.Spec.Template, kubeProxyConfig.PodLabels, kubeProxyConfig.PodAnnotations)
 	}
 
-	if imageParts[1] == desiredTag && hasArm64NodeSelector {
-		logger.Debug("imageParts = %v, desiredTag = %s", imageParts, desiredTag)
+	if imageUpToDate && hasArm64NodeSelector && toleratesAllTaints && podMetadataUpToDate {
 		logger.Info("%q is already up-to-date", KubeProxy)
 		return false, nil
 	}
@@ -111,8 +140,9 @@ func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string,
 		return true, nil
 	}
 
-	imageParts[1] = desiredTag
-	*image = strings.Join(imageParts, ":")
+	if !imageUpToDate {
+		*image = desiredImage
+	}
 
 	if err := printer.LogObj(logger.Debug, KubeProxy+" [updated] = \\\n%s\n", d); err != nil {
 		return false, err
@@ -122,6 +152,11 @@ func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string,
 		addArm64NodeSelector(d, archLabel)
 	}
 
+	if !toleratesAllTaints {
+		// the toleration EKS sets on kube-proxy
+		d.Spec.Template.Spec.Tolerations = append(d.Spec.Template.Spec.Tolerations, corev1.Toleration{Operator: corev1.TolerationOpExists})
+	}
+
 	if _, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Update(context.TODO(), d, metav1.UpdateOptions{}); err != nil {
 		return false, err
 	}
@@ -130,6 +165,31 @@ func UpdateKubeProxy(clientSet kubernetes.Interface, controlPlaneVersion string,
 	return false, nil
 }
 
+func daemonSetHasArchNodeSelector(daemonSet *v1.DaemonSet, archLabel string) bool {
+	if daemonSet.Spec.Template.Spec.Affinity != nil &&
+		daemonSet.Spec.Template.Spec.Affinity.NodeAffinity != nil &&
+		daemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		for _, nodeSelectorTerms := range daemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			for _, nodeSelector := range nodeSelectorTerms.MatchExpressions {
+				if nodeSelector.Key == archLabel {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// daemonSetToleratesAllTaints returns true if the pods of the daemonset tolerate every taint, and so run on every node
+func daemonSetToleratesAllTaints(daemonSet *v1.DaemonSet) bool {
+	for _, toleration := range daemonSet.Spec.Template.Spec.Tolerations {
+		if toleration.Key == "" && toleration.Operator == corev1.TolerationOpExists && toleration.Effect == "" {
+			return true
+		}
+	}
+	return false
+}
+
 func daemeonSetHasArm64NodeSelector(daemonSet *v1.DaemonSet, archLabel string) bool {
 	if daemonSet.Spec.Template.Spec.Affinity != nil &&
 		daemonSet.Spec.Template.Spec.Affinity.NodeAffinity != nil &&
@@ -7,8 +7,11 @@ import (
 	. "github.com/onsi/gomega"
 
 	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
@@ -24,20 +27,199 @@ var _ = Describe("default addons - kube-proxy", func() {
 		})
 
 		It("can update to multi-architecture image based on control plane version", func() {
-			_, err := UpdateKubeProxy(clientSet, "1.16.0", false)
+			_, err := UpdateKubeProxy(clientSet, "1.16.0", nil, false, false)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(kubeProxyImage(clientSet)).To(Equal("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/kube-proxy:v1.16.0-eksbuild.1"))
 			Expect(kubeProxyNodeSelectorValues(clientSet)).To(ConsistOf("amd64", "arm64"))
 		})
 
 		It("can dry-run update based on control plane version", func() {
-			_, err := UpdateKubeProxy(clientSet, "1.16.1", true)
+			_, err := UpdateKubeProxy(clientSet, "1.16.1", nil, true, false)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(kubeProxyImage(clientSet)).To(Equal("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/kube-proxy:v1.15.11"))
 		})
 	})
+
+	Context("UpdateKubeProxy skipping the image tag", func() {
+		It("only reconciles the node selectors and is idempotent", func() {
+			clientSet, _ := testutils.NewFakeClientSetWithSamples("testdata/sample-1.15.json")
+
+			_, err := UpdateKubeProxy(clientSet, "1.16.0", nil, false, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeProxyImage(clientSet)).To(Equal("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/kube-proxy:v1.15.11"))
+			Expect(kubeProxyNodeSelectorValues(clientSet)).To(ConsistOf("amd64", "arm64"))
+
+			clientSet.ClearActions()
+			updateRequired, err := UpdateKubeProxy(clientSet, "1.16.0", nil, true, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+			Expect(kubeProxyUpdates(clientSet)).To(BeZero())
+		})
+
+		It("does not update a kube-proxy without the EKS image scheme or an arch node selector", func() {
+			clientSet := fake.NewSimpleClientset(&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: KubeProxy, Namespace: metav1.NamespaceSystem},
+				Spec: appsv1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+							Tolerations:  []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+							Containers: []corev1.Container{
+								{Name: KubeProxy, Image: "localhost:5000/kube-proxy:v1.21.1"},
+							},
+						},
+					},
+				},
+			})
+
+			updateRequired, err := UpdateKubeProxy(clientSet, "1.21.1", nil, false, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+			Expect(kubeProxyUpdates(clientSet)).To(BeZero())
+			Expect(kubeProxyImage(clientSet)).To(Equal("localhost:5000/kube-proxy:v1.21.1"))
+
+			_, err = UpdateKubeProxy(clientSet, "1.21.1", nil, false, false)
+			Expect(err).To(MatchError(`unexpected image format "localhost:5000/kube-proxy:v1.21.1" for "kube-proxy"`))
+		})
+	})
+
+	Context("UpdateKubeProxy tolerations", func() {
+		newKubeProxy := func(tolerations ...corev1.Toleration) *fake.Clientset {
+			return fake.NewSimpleClientset(&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: KubeProxy, Namespace: metav1.NamespaceSystem},
+				Spec: appsv1.DaemonSetSpec{
+					Template: corev1.PodTemplateSpec{
+						Spec: corev1.PodSpec{
+							Tolerations: tolerations,
+							Containers: []corev1.Container{
+								{Name: KubeProxy, Image: "localhost:5000/kube-proxy:v1.21.1"},
+							},
+						},
+					},
+				},
+			})
+		}
+
+		It("adds the toleration of all taints and is idempotent", func() {
+			criticalAddonsOnly := corev1.Toleration{Key: "CriticalAddonsOnly", Operator: corev1.TolerationOpExists}
+			clientSet := newKubeProxy(criticalAddonsOnly)
+
+			updateRequired, err := UpdateKubeProxy(clientSet, "1.21.1", nil, true, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+			Expect(kubeProxyUpdates(clientSet)).To(BeZero())
+
+			_, err = UpdateKubeProxy(clientSet, "1.21.1", nil, false, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeProxyPodTemplate(clientSet).Spec.Tolerations).To(Equal([]corev1.Toleration{
+				criticalAddonsOnly,
+				{Operator: corev1.TolerationOpExists},
+			}))
+			Expect(kubeProxyImage(clientSet)).To(Equal("localhost:5000/kube-proxy:v1.21.1"))
+
+			clientSet.ClearActions()
+			updateRequired, err = UpdateKubeProxy(clientSet, "1.21.1", nil, false, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+			Expect(kubeProxyUpdates(clientSet)).To(BeZero())
+		})
+
+		It("leaves the tolerations alone when the image tag is updated", func() {
+			clientSet, _ := testutils.NewFakeClientSetWithSamples("testdata/sample-1.15.json")
+			tolerations := kubeProxyPodTemplate(clientSet).Spec.Tolerations
+
+			_, err := UpdateKubeProxy(clientSet, "1.16.0", nil, false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeProxyImage(clientSet)).To(HaveSuffix(":v1.16.0-eksbuild.1"))
+			Expect(kubeProxyPodTemplate(clientSet).Spec.Tolerations).To(Equal(tolerations))
+		})
+	})
+
+	Context("UpdateKubeProxy with pod labels and annotations", func() {
+		var (
+			clientSet       *fake.Clientset
+			kubeProxyConfig *api.KubeProxyConfig
+		)
+
+		BeforeEach(func() {
+			clientSet, _ = testutils.NewFakeClientSetWithSamples("testdata/sample-1.15.json")
+			kubeProxyConfig = &api.KubeProxyConfig{
+				PodLabels:      map[string]string{"sidecar.istio.io/inject": "false"},
+				PodAnnotations: map[string]string{"example.com/policy": "node"},
+			}
+		})
+
+		It("adds them to the kube-proxy pods and they survive later updates", func() {
+			_, err := UpdateKubeProxy(clientSet, "1.16.0", kubeProxyConfig, false, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = UpdateKubeProxy(clientSet, "1.17.0", nil, false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeProxyImage(clientSet)).To(HaveSuffix(":v1.17.0-eksbuild.1"))
+
+			template := kubeProxyPodTemplate(clientSet)
+			Expect(template.Labels).To(Equal(map[string]string{
+				"k8s-app":                 "kube-proxy",
+				"sidecar.istio.io/inject": "false",
+			}))
+			Expect(template.Annotations).To(HaveKeyWithValue("example.com/policy", "node"))
+		})
+
+		It("updates kube-proxy when only the labels are missing", func() {
+			_, err := UpdateKubeProxy(clientSet, "1.16.0", nil, false, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			updateRequired, err := UpdateKubeProxy(clientSet, "1.16.0", kubeProxyConfig, true, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+
+			clientSet.ClearActions()
+			_, err = UpdateKubeProxy(clientSet, "1.16.0", kubeProxyConfig, false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kubeProxyUpdates(clientSet)).To(Equal(1))
+			Expect(kubeProxyPodTemplate(clientSet).Labels).To(HaveKeyWithValue("sidecar.istio.io/inject", "false"))
+
+			clientSet.ClearActions()
+			updateRequired, err = UpdateKubeProxy(clientSet, "1.16.0", kubeProxyConfig, false, false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+			Expect(kubeProxyUpdates(clientSet)).To(BeZero())
+		})
+	})
+
+	Context("SetKubeProxyPodMetadata", func() {
+		It("adds the labels and annotations to the kube-proxy pods", func() {
+			clientSet, _ := testutils.NewFakeClientSetWithSamples("testdata/sample-1.15.json")
+			Expect(SetKubeProxyPodMetadata(clientSet, nil, map[string]string{"example.com/policy": "node"})).To(Succeed())
+
+			template := kubeProxyPodTemplate(clientSet)
+			Expect(template.Labels).To(Equal(map[string]string{"k8s-app": "kube-proxy"}))
+			Expect(template.Annotations).To(HaveKeyWithValue("example.com/policy", "node"))
+		})
+
+		It("errors if kube-proxy does not exist", func() {
+			err := SetKubeProxyPodMetadata(fake.NewSimpleClientset(), map[string]string{"team": "platform"}, nil)
+			Expect(err).To(MatchError(ContainSubstring(`failed to set pod labels and annotations on "kube-proxy"`)))
+		})
+	})
 })
 
+func kubeProxyPodTemplate(clientSet *fake.Clientset) corev1.PodTemplateSpec {
+	kubeProxy, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), KubeProxy, metav1.GetOptions{})
+	Expect(err).ToNot(HaveOccurred())
+	return kubeProxy.Spec.Template
+}
+
+func kubeProxyUpdates(clientSet *fake.Clientset) int {
+	updates := 0
+	for _, action := range clientSet.Actions() {
+		if action.GetVerb() == "update" {
+			updates++
+		}
+	}
+	return updates
+}
+
 func kubeProxyImage(clientSet *fake.Clientset) string {
 	kubeProxy, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), KubeProxy, metav1.GetOptions{})
 
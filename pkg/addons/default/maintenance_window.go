@@ -0,0 +1,73 @@
+package defaultaddons
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+)
+
+const maintenanceWindowTimeFormat = "15:04"
+
+// now returns the current time, the maintenance window is checked against it
+var now = time.Now
+
+// MaintenanceWindow is a daily window of time, in UTC, in which default add-ons may be updated.
+// A window whose end is before its start ends on the next day
+type MaintenanceWindow struct {
+	start, end time.Duration
+}
+
+// ParseMaintenanceWindow parses a window of the form HH:MM-HH:MM, e.g. 22:00-02:00
+func ParseMaintenanceWindow(window string) (*MaintenanceWindow, error) {
+	parts := strings.Split(window, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid maintenance window %q, expected HH:MM-HH:MM in UTC", window)
+	}
+	var bounds [2]time.Duration
+	for i, part := range parts {
+		t, err := time.Parse(maintenanceWindowTimeFormat, strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window %q, expected HH:MM-HH:MM in UTC", window)
+		}
+		bounds[i] = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	}
+	if bounds[0] == bounds[1] {
+		return nil, fmt.Errorf("invalid maintenance window %q, its start and end must differ", window)
+	}
+	return &MaintenanceWindow{start: bounds[0], end: bounds[1]}, nil
+}
+
+// Contains returns true if t is in the window
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second
+	if w.start < w.end {
+		return offset >= w.start && offset < w.end
+	}
+	return offset >= w.start || offset < w.end
+}
+
+func (w *MaintenanceWindow) String() string {
+	format := func(d time.Duration) string {
+		return time.Time{}.Add(d).Format(maintenanceWindowTimeFormat)
+	}
+	return fmt.Sprintf("%s-%s UTC", format(w.start), format(w.end))
+}
+
+// InMaintenanceWindow returns true if the add-ons can be updated now, which is always the case
+// when no maintenance window is set
+func (input AddonInput) InMaintenanceWindow() bool {
+	return input.MaintenanceWindow == nil || input.MaintenanceWindow.Contains(now())
+}
+
+// skipOutsideMaintenanceWindow returns true if the update of the add-on must be skipped, as it would be applied
+// outside of the maintenance window. Plans are never skipped, as they do not change the add-on
+func skipOutsideMaintenanceWindow(input AddonInput, name string, plan bool) bool {
+	if plan || input.InMaintenanceWindow() {
+		return false
+	}
+	logger.Warning("skipping the update of %q, as it is outside of the maintenance window %s", name, input.MaintenanceWindow)
+	return true
+}
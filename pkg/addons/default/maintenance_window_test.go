@@ -0,0 +1,126 @@
+package defaultaddons_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	kubeclient "k8s.io/client-go/kubernetes"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+// countingRawClient records whether an add-on update reached the cluster
+type countingRawClient struct {
+	*testutils.FakeRawClient
+	clientSetCalls int
+}
+
+func (c *countingRawClient) ClientSet() kubeclient.Interface {
+	c.clientSetCalls++
+	return c.FakeRawClient.ClientSet()
+}
+
+var _ = Describe("default addons maintenance window", func() {
+	at := func(clock string) time.Time {
+		t, err := time.Parse(time.RFC3339, "2021-07-01T"+clock+"Z")
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	DescribeTable("times in the window", func(window, clock string, contained bool) {
+		w, err := ParseMaintenanceWindow(window)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Contains(at(clock))).To(Equal(contained))
+	},
+		Entry("start of the window", "02:00-04:00", "02:00:00", true),
+		Entry("within the window", "02:00-04:00", "03:59:59", true),
+		Entry("end of the window", "02:00-04:00", "04:00:00", false),
+		Entry("before the window", "02:00-04:00", "01:59:59", false),
+		Entry("before midnight in a window over midnight", "22:00-02:00", "23:30:00", true),
+		Entry("after midnight in a window over midnight", "22:00-02:00", "01:00:00", true),
+		Entry("outside of a window over midnight", "22:00-02:00", "12:00:00", false),
+	)
+
+	It("converts times to UTC", func() {
+		w, err := ParseMaintenanceWindow("02:00-04:00")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Contains(at("01:00:00").In(time.FixedZone("UTC-2", -2*60*60)))).To(BeFalse())
+		Expect(w.Contains(time.Date(2021, 7, 1, 5, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)))).To(BeTrue())
+		Expect(w.String()).To(Equal("02:00-04:00 UTC"))
+	})
+
+	DescribeTable("invalid windows", func(window, errMsg string) {
+		_, err := ParseMaintenanceWindow(window)
+		Expect(err).To(MatchError(errMsg))
+	},
+		Entry("no end", "02:00", `invalid maintenance window "02:00", expected HH:MM-HH:MM in UTC`),
+		Entry("invalid time", "02:00-25:00", `invalid maintenance window "02:00-25:00", expected HH:MM-HH:MM in UTC`),
+		Entry("empty window", "02:00-02:00", `invalid maintenance window "02:00-02:00", its start and end must differ`),
+	)
+
+	Describe("updates of the built-in add-ons", func() {
+		var (
+			rawClient *countingRawClient
+			input     AddonInput
+			restore   func()
+		)
+
+		BeforeEach(func() {
+			rawClient = &countingRawClient{FakeRawClient: testutils.NewFakeRawClient()}
+			window, err := ParseMaintenanceWindow("02:00-04:00")
+			Expect(err).NotTo(HaveOccurred())
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Region = "eu-west-1"
+			input = AddonInput{
+				RawClient:           rawClient,
+				ControlPlaneVersion: "1.21.2",
+				ClusterConfig:       cfg,
+				MaintenanceWindow:   window,
+			}
+		})
+
+		AfterEach(func() {
+			restore()
+		})
+
+		It("skips the updates outside of the window", func() {
+			restore = SetNow(func() time.Time { return at("12:00:00") })
+			Expect(input.InMaintenanceWindow()).To(BeFalse())
+
+			for _, addon := range []DefaultAddon{NewKubeProxy(input, false), NewAWSNode(input), NewCoreDNS(input)} {
+				updateRequired, err := addon.Update(false)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updateRequired).To(BeFalse())
+			}
+			Expect(rawClient.clientSetCalls).To(BeZero())
+		})
+
+		It("plans the updates outside of the window", func() {
+			restore = SetNow(func() time.Time { return at("12:00:00") })
+			_, err := NewKubeProxy(input, false).Update(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rawClient.clientSetCalls).NotTo(BeZero())
+		})
+
+		It("applies the updates in the window", func() {
+			restore = SetNow(func() time.Time { return at("03:00:00") })
+			Expect(input.InMaintenanceWindow()).To(BeTrue())
+			_, err := NewKubeProxy(input, false).Update(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rawClient.clientSetCalls).NotTo(BeZero())
+		})
+
+		It("applies the updates at any time without a window", func() {
+			restore = SetNow(func() time.Time { return at("12:00:00") })
+			input.MaintenanceWindow = nil
+			Expect(input.InMaintenanceWindow()).To(BeTrue())
+			_, err := NewKubeProxy(input, false).Update(false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rawClient.clientSetCalls).NotTo(BeZero())
+		})
+	})
+})
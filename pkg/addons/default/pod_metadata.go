@@ -0,0 +1,96 @@
+package defaultaddons
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubeclient "k8s.io/client-go/kubernetes"
+)
+
+// mergePodMetadata sets the labels and annotations on the pod template and returns true if any of them changed
+func mergePodMetadata(template *corev1.PodTemplateSpec, labels, annotations map[string]string) bool {
+	changed := false
+	merge := func(existing *map[string]string, values map[string]string) {
+		for k, v := range values {
+			if current, ok := (*existing)[k]; ok && current == v {
+				continue
+			}
+			if *existing == nil {
+				*existing = make(map[string]string)
+			}
+			(*existing)[k] = v
+			changed = true
+		}
+	}
+	merge(&template.Labels, labels)
+	merge(&template.Annotations, annotations)
+	return changed
+}
+
+// preservePodMetadata copies the labels and annotations of the existing pod template that are not set on the
+// desired one, so that those added to the pods of an add-on survive its replacement
+func preservePodMetadata(desired, existing *corev1.PodTemplateSpec) {
+	preserve := func(desired *map[string]string, existing map[string]string) {
+		for k, v := range existing {
+			if _, ok := (*desired)[k]; ok {
+				continue
+			}
+			if *desired == nil {
+				*desired = make(map[string]string)
+			}
+			(*desired)[k] = v
+		}
+	}
+	preserve(&desired.Labels, existing.Labels)
+	preserve(&desired.Annotations, existing.Annotations)
+}
+
+// SetCoreDNSPodMetadata adds the labels and annotations to the CoreDNS pods
+func SetCoreDNSPodMetadata(clientSet kubeclient.Interface, labels, annotations map[string]string) error {
+	patch, err := podMetadataPatch(labels, annotations)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal patch for %q", CoreDNS)
+	}
+	if _, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Patch(context.TODO(), CoreDNS, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to set pod labels and annotations on %q", CoreDNS)
+	}
+	logger.Info("set %d pod label(s) and %d pod annotation(s) on %q", len(labels), len(annotations), CoreDNS)
+	return nil
+}
+
+// SetKubeProxyPodMetadata adds the labels and annotations to the kube-proxy pods
+func SetKubeProxyPodMetadata(clientSet kubeclient.Interface, labels, annotations map[string]string) error {
+	patch, err := podMetadataPatch(labels, annotations)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal patch for %q", KubeProxy)
+	}
+	if _, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Patch(context.TODO(), KubeProxy, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to set pod labels and annotations on %q", KubeProxy)
+	}
+	logger.Info("set %d pod label(s) and %d pod annotation(s) on %q", len(labels), len(annotations), KubeProxy)
+	return nil
+}
+
+// podMetadataPatch returns a merge patch adding the labels and annotations to a pod template, a map that is not set
+// is left out as null would remove all the labels or annotations of the template
+func podMetadataPatch(labels, annotations map[string]string) ([]byte, error) {
+	metadata := map[string]interface{}{}
+	if len(labels) > 0 {
+		metadata["labels"] = labels
+	}
+	if len(annotations) > 0 {
+		metadata["annotations"] = annotations
+	}
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": metadata,
+			},
+		},
+	})
+}
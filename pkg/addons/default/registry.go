@@ -0,0 +1,174 @@
+package defaultaddons
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// DefaultAddon is an add-on that eksctl keeps in line with the Kubernetes version of the control plane
+type DefaultAddon interface {
+	// Name returns the name of the add-on
+	Name() string
+	// IsUpToDate returns whether the add-on already runs the version expected for the control plane
+	IsUpToDate() (bool, error)
+	// Update updates the add-on and returns true if an update is required but was not applied,
+	// which only happens in plan mode
+	Update(plan bool) (bool, error)
+}
+
+// AddonInput holds the cluster details default add-ons are checked and updated against
+type AddonInput struct {
+	RawClient           kubernetes.RawClientInterface
+	ControlPlaneVersion string
+	ClusterConfig       *api.ClusterConfig
+	// MaintenanceWindow, when set, skips the updates of the built-in add-ons outside of it.
+	// Registered add-ons can check it with InMaintenanceWindow
+	MaintenanceWindow *MaintenanceWindow
+}
+
+// DefaultAddonFactory returns the DefaultAddon for a cluster
+type DefaultAddonFactory func(input AddonInput) DefaultAddon
+
+type registeredAddon struct {
+	name    string
+	factory DefaultAddonFactory
+}
+
+var (
+	registryMutex sync.Mutex
+	registry      = []registeredAddon{
+		{name: KubeProxy, factory: func(input AddonInput) DefaultAddon { return NewKubeProxy(input, false) }},
+		{name: AWSNode, factory: NewAWSNode},
+		{name: CoreDNS, factory: NewCoreDNS},
+	}
+)
+
+// Register adds a default add-on that is checked and updated after the built-in ones, in the order
+// add-ons are registered. It is meant for programs embedding eksctl that deploy their own add-ons
+func Register(name string, factory DefaultAddonFactory) error {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	for _, addon := range registry {
+		if addon.name == name {
+			return fmt.Errorf("default addon %q is already registered", name)
+		}
+	}
+	registry = append(registry, registeredAddon{name: name, factory: factory})
+	return nil
+}
+
+// NewDefaultAddons returns the built-in and registered default add-ons for a cluster
+func NewDefaultAddons(input AddonInput) []DefaultAddon {
+	registryMutex.Lock()
+	defer registryMutex.Unlock()
+
+	var defaultAddons []DefaultAddon
+	for _, addon := range registry {
+		defaultAddons = append(defaultAddons, addon.factory(input))
+	}
+	return defaultAddons
+}
+
+// UpdateAll updates the default add-ons in order and returns true if any of them requires an update
+// that was not applied, which only happens in plan mode
+func UpdateAll(defaultAddons []DefaultAddon, plan bool) (bool, error) {
+	updateRequired := false
+	for _, addon := range defaultAddons {
+		required, err := addon.Update(plan)
+		if err != nil {
+			return false, errors.Wrapf(err, "updating %s", addon.Name())
+		}
+		updateRequired = updateRequired || required
+	}
+	return updateRequired, nil
+}
+
+type kubeProxy struct {
+	input        AddonInput
+	skipImageTag bool
+}
+
+// NewKubeProxy returns the kube-proxy add-on, when skipImageTag is set only its node selectors are updated
+func NewKubeProxy(input AddonInput, skipImageTag bool) DefaultAddon {
+	return &kubeProxy{input: input, skipImageTag: skipImageTag}
+}
+
+func (k *kubeProxy) Name() string { return KubeProxy }
+
+func (k *kubeProxy) IsUpToDate() (bool, error) {
+	return IsKubeProxyUpToDate(k.input.RawClient.ClientSet(), k.input.ControlPlaneVersion)
+}
+
+func (k *kubeProxy) Update(plan bool) (bool, error) {
+	if skipOutsideMaintenanceWindow(k.input, KubeProxy, plan) {
+		return false, nil
+	}
+	return UpdateKubeProxy(k.input.RawClient.ClientSet(), k.input.ControlPlaneVersion, k.input.ClusterConfig.KubeProxy, plan, k.skipImageTag)
+}
+
+type awsNode struct {
+	input AddonInput
+}
+
+// NewAWSNode returns the aws-node add-on
+func NewAWSNode(input AddonInput) DefaultAddon {
+	return &awsNode{input: input}
+}
+
+func (a *awsNode) Name() string { return AWSNode }
+
+// IsUpToDate always returns true when aws-node is disabled, unless it is present and must be deleted
+func (a *awsNode) IsUpToDate() (bool, error) {
+	if a.input.ClusterConfig.IsAWSNodeDisabled() {
+		if !a.input.ClusterConfig.AWSNode.DeleteIfPresent {
+			return true, nil
+		}
+		present, err := IsAWSNodePresent(a.input.RawClient.ClientSet())
+		return !present, err
+	}
+	return IsAWSNodeUpToDate(a.input.RawClient, a.input.ClusterConfig.Metadata.Region)
+}
+
+// Update leaves aws-node as it is when it is disabled, or deletes it if awsNode.deleteIfPresent is set
+func (a *awsNode) Update(plan bool) (bool, error) {
+	if skipOutsideMaintenanceWindow(a.input, AWSNode, plan) {
+		return false, nil
+	}
+	if a.input.ClusterConfig.IsAWSNodeDisabled() {
+		if a.input.ClusterConfig.AWSNode.DeleteIfPresent {
+			return DeleteAWSNode(a.input.RawClient.ClientSet(), plan)
+		}
+		logger.Info("skipping %q as awsNode.disable is set", AWSNode)
+		return false, nil
+	}
+	return UpdateAWSNode(a.input.RawClient, a.input.ClusterConfig.Metadata.Region, plan)
+}
+
+type coreDNS struct {
+	input AddonInput
+}
+
+// NewCoreDNS returns the coredns add-on, updated with the coreDNS settings of the cluster config
+func NewCoreDNS(input AddonInput) DefaultAddon {
+	return &coreDNS{input: input}
+}
+
+func (c *coreDNS) Name() string { return CoreDNS }
+
+func (c *coreDNS) IsUpToDate() (bool, error) {
+	return IsCoreDNSUpToDate(c.input.RawClient, c.input.ClusterConfig.Metadata.Region, c.input.ControlPlaneVersion)
+}
+
+func (c *coreDNS) Update(plan bool) (bool, error) {
+	if skipOutsideMaintenanceWindow(c.input, CoreDNS, plan) {
+		return false, nil
+	}
+	return UpdateCoreDNS(c.input.RawClient, c.input.ClusterConfig.Metadata.Region, c.input.ControlPlaneVersion, c.input.ClusterConfig.CoreDNS, plan)
+}
@@ -0,0 +1,134 @@
+package defaultaddons_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+type fakeAddon struct {
+	name      string
+	input     AddonInput
+	upToDate  bool
+	updateErr error
+	updates   *[]string
+}
+
+func (f *fakeAddon) Name() string { return f.name }
+
+func (f *fakeAddon) IsUpToDate() (bool, error) { return f.upToDate, nil }
+
+func (f *fakeAddon) Update(plan bool) (bool, error) {
+	if f.updateErr != nil {
+		return false, f.updateErr
+	}
+	*f.updates = append(*f.updates, f.name)
+	return plan && !f.upToDate, nil
+}
+
+var _ = Describe("default addons registry", func() {
+	var (
+		restoreRegistry func()
+		input           AddonInput
+		updates         []string
+	)
+
+	BeforeEach(func() {
+		restoreRegistry = SaveRegistry()
+		updates = nil
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Region = "eu-west-1"
+		input = AddonInput{
+			RawClient:           testutils.NewFakeRawClient(),
+			ControlPlaneVersion: "1.21.2",
+			ClusterConfig:       cfg,
+		}
+	})
+
+	AfterEach(func() {
+		restoreRegistry()
+	})
+
+	newFakeAddon := func(name string, upToDate bool) DefaultAddonFactory {
+		return func(input AddonInput) DefaultAddon {
+			return &fakeAddon{name: name, input: input, upToDate: upToDate, updates: &updates}
+		}
+	}
+
+	addonNames := func(defaultAddons []DefaultAddon) []string {
+		var names []string
+		for _, addon := range defaultAddons {
+			names = append(names, addon.Name())
+		}
+		return names
+	}
+
+	It("returns the built-in addons", func() {
+		Expect(addonNames(NewDefaultAddons(input))).To(Equal([]string{KubeProxy, AWSNode, CoreDNS}))
+	})
+
+	It("returns registered addons after the built-in ones, in registration order", func() {
+		Expect(Register("node-agent", newFakeAddon("node-agent", true))).To(Succeed())
+		Expect(Register("log-shipper", newFakeAddon("log-shipper", false))).To(Succeed())
+
+		defaultAddons := NewDefaultAddons(input)
+		Expect(addonNames(defaultAddons)).To(Equal([]string{KubeProxy, AWSNode, CoreDNS, "node-agent", "log-shipper"}))
+		Expect(defaultAddons[3].(*fakeAddon).input).To(Equal(input))
+	})
+
+	It("rejects addons registered twice", func() {
+		Expect(Register("node-agent", newFakeAddon("node-agent", true))).To(Succeed())
+		Expect(Register("node-agent", newFakeAddon("node-agent", true))).To(MatchError(`default addon "node-agent" is already registered`))
+		Expect(Register(CoreDNS, newFakeAddon(CoreDNS, true))).To(MatchError(`default addon "coredns" is already registered`))
+	})
+
+	It("reports whether registered addons are up to date", func() {
+		Expect(Register("node-agent", newFakeAddon("node-agent", false))).To(Succeed())
+
+		defaultAddons := NewDefaultAddons(input)
+		upToDate, err := defaultAddons[len(defaultAddons)-1].IsUpToDate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(upToDate).To(BeFalse())
+	})
+
+	Describe("UpdateAll", func() {
+		It("updates the addons in order", func() {
+			defaultAddons := []DefaultAddon{
+				newFakeAddon("node-agent", true)(input),
+				newFakeAddon("log-shipper", true)(input),
+			}
+
+			updateRequired, err := UpdateAll(defaultAddons, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updateRequired).To(BeFalse())
+			Expect(updates).To(Equal([]string{"node-agent", "log-shipper"}))
+		})
+
+		It("returns whether an update is required in plan mode", func() {
+			defaultAddons := []DefaultAddon{
+				newFakeAddon("node-agent", true)(input),
+				newFakeAddon("log-shipper", false)(input),
+			}
+
+			updateRequired, err := UpdateAll(defaultAddons, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updateRequired).To(BeTrue())
+		})
+
+		It("stops at the first addon failing to update", func() {
+			defaultAddons := []DefaultAddon{
+				&fakeAddon{name: "node-agent", updateErr: errors.New("forbidden"), updates: &updates},
+				newFakeAddon("log-shipper", true)(input),
+			}
+
+			_, err := UpdateAll(defaultAddons, false)
+			Expect(err).To(MatchError("updating node-agent: forbidden"))
+			Expect(updates).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,130 @@
+package defaultaddons
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// RestartedAtAnnotation is set on a pod template to trigger a rolling restart, the same way `kubectl rollout restart` does
+const RestartedAtAnnotation = "eksctl.io/restartedAt"
+
+var rolloutPollInterval = 5 * time.Second
+
+// RestartDaemonSet triggers a rolling restart of all pods of the given daemonset
+func RestartDaemonSet(clientSet kubernetes.Interface, namespace, name string) (string, error) {
+	restartedAt, patch, err := restartPatch()
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal patch for daemonset %q", name)
+	}
+
+	if _, err := clientSet.AppsV1().DaemonSets(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return "", errors.Wrapf(err, "failed to patch daemonset %q", name)
+	}
+	logger.Info(`daemonset "%s/%s" restarted`, namespace, name)
+	return restartedAt, nil
+}
+
+// RestartDeployment triggers a rolling restart of all pods of the given deployment
+func RestartDeployment(clientSet kubernetes.Interface, namespace, name string) error {
+	_, patch, err := restartPatch()
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal patch for deployment %q", name)
+	}
+
+	if _, err := clientSet.AppsV1().Deployments(namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return errors.Wrapf(err, "failed to patch deployment %q", name)
+	}
+	logger.Info(`deployment "%s/%s" restarted`, namespace, name)
+	return nil
+}
+
+func restartPatch() (string, []byte, error) {
+	restartedAt := time.Now().Format(time.RFC3339)
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]string{
+						RestartedAtAnnotation: restartedAt,
+					},
+				},
+			},
+		},
+	})
+	return restartedAt, patch, err
+}
+
+// RestartDaemonSetAndWait restarts the given daemonset and waits for the rollout to complete,
+// reporting which nodes are still running pods from before the restart
+func RestartDaemonSetAndWait(clientSet kubernetes.Interface, namespace, name string, timeout time.Duration) error {
+	restartedAt, err := RestartDaemonSet(clientSet, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	logger.Info(`waiting for rollout of daemonset "%s/%s" to complete`, namespace, name)
+	err = wait.PollImmediate(rolloutPollInterval, timeout, func() (bool, error) {
+		ds, err := clientSet.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return false, errors.Wrapf(err, "getting daemonset %q", name)
+		}
+		if err := logRolloutProgress(clientSet, ds, restartedAt); err != nil {
+			return false, err
+		}
+		return isDaemonSetRolledOut(ds), nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf(`timed out after %s waiting for rollout of daemonset "%s/%s" to complete`, timeout, namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.Info(`rollout of daemonset "%s/%s" is complete`, namespace, name)
+	return nil
+}
+
+func isDaemonSetRolledOut(ds *appsv1.DaemonSet) bool {
+	return ds.Generation <= ds.Status.ObservedGeneration &&
+		ds.Status.UpdatedNumberScheduled >= ds.Status.DesiredNumberScheduled &&
+		ds.Status.NumberAvailable >= ds.Status.DesiredNumberScheduled
+}
+
+func logRolloutProgress(clientSet kubernetes.Interface, ds *appsv1.DaemonSet, restartedAt string) error {
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return errors.Wrapf(err, "parsing selector of daemonset %q", ds.Name)
+	}
+	pods, err := clientSet.CoreV1().Pods(ds.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "listing pods of daemonset %q", ds.Name)
+	}
+
+	var pending []string
+	for _, pod := range pods.Items {
+		if pod.Annotations[RestartedAtAnnotation] != restartedAt {
+			pending = append(pending, pod.Spec.NodeName)
+		}
+	}
+	sort.Strings(pending)
+
+	logger.Info("daemonset %q: %d of %d node(s) updated", ds.Name, ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled)
+	if len(pending) > 0 {
+		logger.Info("daemonset %q: waiting for pods to restart on node(s): %s", ds.Name, strings.Join(pending, ", "))
+	}
+	return nil
+}
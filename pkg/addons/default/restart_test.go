@@ -0,0 +1,77 @@
+package defaultaddons_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("default addons - daemonset restart", func() {
+	var clientSet *fake.Clientset
+
+	setRolloutStatus := func(desired, updated, available int32) {
+		ds, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), KubeProxy, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		ds.Status.DesiredNumberScheduled = desired
+		ds.Status.UpdatedNumberScheduled = updated
+		ds.Status.NumberAvailable = available
+		ds.Status.ObservedGeneration = ds.Generation
+		_, err = clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).UpdateStatus(context.TODO(), ds, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	restartedAt := func() string {
+		ds, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), KubeProxy, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		return ds.Spec.Template.Annotations[RestartedAtAnnotation]
+	}
+
+	BeforeEach(func() {
+		clientSet, _ = testutils.NewFakeClientSetWithSamples("testdata/sample-1.15.json")
+		SetRolloutPollInterval(10 * time.Millisecond)
+
+		_, err := clientSet.CoreV1().Pods(metav1.NamespaceSystem).Create(context.TODO(), &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kube-proxy-abcde",
+				Namespace: metav1.NamespaceSystem,
+				Labels:    map[string]string{"k8s-app": "kube-proxy"},
+			},
+			Spec: corev1.PodSpec{NodeName: "ip-192-168-1-1.ec2.internal"},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("annotates the pod template with the restart time", func() {
+		value, err := RestartDaemonSet(clientSet, metav1.NamespaceSystem, KubeProxy)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).NotTo(BeEmpty())
+		Expect(restartedAt()).To(Equal(value))
+		Expect(kubeProxyImage(clientSet)).To(Equal("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/kube-proxy:v1.15.11"))
+	})
+
+	It("waits for the rollout to complete", func() {
+		setRolloutStatus(2, 2, 2)
+		Expect(RestartDaemonSetAndWait(clientSet, metav1.NamespaceSystem, KubeProxy, time.Second)).To(Succeed())
+		Expect(restartedAt()).NotTo(BeEmpty())
+	})
+
+	It("times out when the rollout doesn't complete", func() {
+		setRolloutStatus(2, 1, 1)
+		err := RestartDaemonSetAndWait(clientSet, metav1.NamespaceSystem, KubeProxy, 50*time.Millisecond)
+		Expect(err).To(MatchError(ContainSubstring(`waiting for rollout of daemonset "kube-system/kube-proxy" to complete`)))
+		Expect(restartedAt()).NotTo(BeEmpty())
+	})
+
+	It("fails when the daemonset doesn't exist", func() {
+		_, err := RestartDaemonSet(clientSet, metav1.NamespaceSystem, "does-not-exist")
+		Expect(err).To(MatchError(ContainSubstring(`failed to patch daemonset "does-not-exist"`)))
+	})
+})
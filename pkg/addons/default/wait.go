@@ -0,0 +1,119 @@
+package defaultaddons
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// rolloutStatusFunc returns an empty string when the workload of an add-on is rolled out and ready,
+// or the reason why it is not
+type rolloutStatusFunc func(clientSet kubernetes.Interface) (string, error)
+
+// defaultAddonWorkloads maps the built-in default add-ons to the rollout status of their workload,
+// registered add-ons are only checked for being up-to-date
+var defaultAddonWorkloads = map[string]rolloutStatusFunc{
+	KubeProxy: daemonSetRolloutStatus(metav1.NamespaceSystem, KubeProxy),
+	AWSNode:   daemonSetRolloutStatus(metav1.NamespaceSystem, AWSNode),
+	CoreDNS:   deploymentRolloutStatus(metav1.NamespaceSystem, CoreDNS),
+}
+
+// WaitForAll waits for the workloads of the default add-ons to be fully rolled out and ready, and reports
+// which ones are not on timeout. Once ready, add-ons that do not run the version expected for the control plane are reported
+func WaitForAll(clientSet kubernetes.Interface, defaultAddons []DefaultAddon, timeout time.Duration) error {
+	pending := defaultAddons
+	unready := map[string]string{}
+	logger.Info("waiting for the default addons to become ready")
+	err := wait.PollImmediate(rolloutPollInterval, timeout, func() (bool, error) {
+		var stillPending []DefaultAddon
+		for _, addon := range pending {
+			rolloutStatus, ok := defaultAddonWorkloads[addon.Name()]
+			if !ok {
+				continue
+			}
+			status, err := rolloutStatus(clientSet)
+			if err != nil {
+				return false, errors.Wrapf(err, "checking rollout status of %s", addon.Name())
+			}
+			if status != "" {
+				unready[addon.Name()] = status
+				stillPending = append(stillPending, addon)
+				continue
+			}
+			logger.Info("%s is ready", addon.Name())
+		}
+		pending = stillPending
+		return len(pending) == 0, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		var reasons []string
+		for _, addon := range pending {
+			reasons = append(reasons, fmt.Sprintf("%s (%s)", addon.Name(), unready[addon.Name()]))
+		}
+		return fmt.Errorf("timed out after %s waiting for the default addons to become ready, not ready: %s", timeout, strings.Join(reasons, ", "))
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, addon := range defaultAddons {
+		upToDate, err := addon.IsUpToDate()
+		if err != nil {
+			return errors.Wrapf(err, "checking %s", addon.Name())
+		}
+		if !upToDate {
+			logger.Warning("%s is ready but does not run the version expected for the control plane, run 'eksctl utils update-default-addons' to update it", addon.Name())
+		}
+	}
+	logger.Success("all default addons are ready")
+	return nil
+}
+
+func daemonSetRolloutStatus(namespace, name string) rolloutStatusFunc {
+	return func(clientSet kubernetes.Interface) (string, error) {
+		ds, err := clientSet.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return "", errors.Wrapf(err, "getting daemonset %q", name)
+		}
+		if isDaemonSetRolledOut(ds) {
+			return "", nil
+		}
+		return fmt.Sprintf("%d of %d pod(s) updated and %d available", ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled, ds.Status.NumberAvailable), nil
+	}
+}
+
+func deploymentRolloutStatus(namespace, name string) rolloutStatusFunc {
+	return func(clientSet kubernetes.Interface) (string, error) {
+		deployment, err := clientSet.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return "", errors.Wrapf(err, "getting deployment %q", name)
+		}
+		if isDeploymentRolledOut(deployment) {
+			return "", nil
+		}
+		return fmt.Sprintf("%d of %d replica(s) updated and %d available", deployment.Status.UpdatedReplicas, deploymentReplicas(deployment), deployment.Status.AvailableReplicas), nil
+	}
+}
+
+func isDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := deploymentReplicas(deployment)
+	return deployment.Generation <= deployment.Status.ObservedGeneration &&
+		deployment.Status.UpdatedReplicas >= replicas &&
+		deployment.Status.Replicas <= deployment.Status.UpdatedReplicas &&
+		deployment.Status.AvailableReplicas >= replicas
+}
+
+func deploymentReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
@@ -0,0 +1,90 @@
+package defaultaddons_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("default addons - wait for readiness", func() {
+	var (
+		clientSet     *fake.Clientset
+		defaultAddons []DefaultAddon
+	)
+
+	setDaemonSetStatus := func(name string, desired, updated, available int32) {
+		ds, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		ds.Status.DesiredNumberScheduled = desired
+		ds.Status.UpdatedNumberScheduled = updated
+		ds.Status.NumberAvailable = available
+		ds.Status.ObservedGeneration = ds.Generation
+		_, err = clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).UpdateStatus(context.TODO(), ds, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	setDeploymentStatus := func(name string, updated, available int32) {
+		deployment, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(context.TODO(), name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		replicas := int32(2)
+		deployment.Spec.Replicas = &replicas
+		deployment.Status.Replicas = updated
+		deployment.Status.UpdatedReplicas = updated
+		deployment.Status.AvailableReplicas = available
+		deployment.Status.ObservedGeneration = deployment.Generation
+		_, err = clientSet.AppsV1().Deployments(metav1.NamespaceSystem).UpdateStatus(context.TODO(), deployment, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		clientSet, _ = testutils.NewFakeClientSetWithSamples("testdata/sample-1.15.json")
+		SetRolloutPollInterval(10 * time.Millisecond)
+		defaultAddons = []DefaultAddon{
+			&fakeAddon{name: KubeProxy, upToDate: true},
+			&fakeAddon{name: AWSNode, upToDate: true},
+			&fakeAddon{name: CoreDNS, upToDate: false},
+			&fakeAddon{name: "registered-addon", upToDate: true},
+		}
+	})
+
+	It("returns once all addons are rolled out and ready", func() {
+		setDaemonSetStatus(KubeProxy, 2, 2, 2)
+		setDaemonSetStatus(AWSNode, 2, 2, 2)
+		setDeploymentStatus(CoreDNS, 2, 2)
+		Expect(WaitForAll(clientSet, defaultAddons, time.Second)).To(Succeed())
+	})
+
+	It("reports the addons that are not ready on timeout", func() {
+		setDaemonSetStatus(KubeProxy, 2, 2, 2)
+		setDaemonSetStatus(AWSNode, 2, 2, 1)
+		setDeploymentStatus(CoreDNS, 1, 0)
+		err := WaitForAll(clientSet, defaultAddons, 50*time.Millisecond)
+		Expect(err).To(MatchError("timed out after 50ms waiting for the default addons to become ready, not ready: " +
+			"aws-node (2 of 2 pod(s) updated and 1 available), coredns (1 of 2 replica(s) updated and 0 available)"))
+	})
+
+	It("waits for addons that become ready while polling", func() {
+		setDaemonSetStatus(KubeProxy, 2, 2, 2)
+		setDaemonSetStatus(AWSNode, 2, 2, 2)
+		setDeploymentStatus(CoreDNS, 2, 1)
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(50 * time.Millisecond)
+			setDeploymentStatus(CoreDNS, 2, 2)
+		}()
+		Expect(WaitForAll(clientSet, defaultAddons, 5*time.Second)).To(Succeed())
+	})
+
+	It("fails when the workload of an addon doesn't exist", func() {
+		Expect(clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Delete(context.TODO(), AWSNode, metav1.DeleteOptions{})).To(Succeed())
+		err := WaitForAll(clientSet, defaultAddons, time.Second)
+		Expect(err).To(MatchError(ContainSubstring(`checking rollout status of aws-node: getting daemonset "aws-node"`)))
+	})
+})
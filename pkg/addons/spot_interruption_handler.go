@@ -0,0 +1,46 @@
+package addons
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/assetutil"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// A SpotInterruptionHandler deploys the AWS Node Termination Handler to a cluster, which cordons and drains
+// the nodes labelled with api.SpotInterruptionHandlerLabel when they receive a spot interruption notice
+type SpotInterruptionHandler struct {
+	rawClient kubernetes.RawClientInterface
+	planMode  bool
+}
+
+// NewSpotInterruptionHandler creates a new SpotInterruptionHandler
+func NewSpotInterruptionHandler(rawClient kubernetes.RawClientInterface, planMode bool) *SpotInterruptionHandler {
+	return &SpotInterruptionHandler{
+		rawClient: rawClient,
+		planMode:  planMode,
+	}
+}
+
+// Deploy creates or replaces the resources of the AWS Node Termination Handler.
+// It does not wait for the daemonset to become ready, as the nodes it runs on may not have joined the cluster yet
+func (s *SpotInterruptionHandler) Deploy() error {
+	list, err := kubernetes.NewList(assetutil.MustLoad(spotInterruptionHandlerYamlBytes))
+	if err != nil {
+		return errors.Wrap(err, "creating list from spot interruption handler manifest")
+	}
+
+	for _, rawObj := range list.Items {
+		rawResource, err := s.rawClient.NewRawResource(rawObj.Object)
+		if err != nil {
+			return errors.Wrap(err, "creating raw resource from list item")
+		}
+		status, err := rawResource.CreateOrReplace(s.planMode)
+		if err != nil {
+			return errors.Wrapf(err, "calling create or replace on raw spot interruption handler %s", rawResource.GVK.Kind)
+		}
+		logger.Info(status)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package addons_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("Spot interruption handler", func() {
+	var rawClient *testutils.FakeRawClient
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+	})
+
+	It("creates the AWS Node Termination Handler and its RBAC resources", func() {
+		Expect(addons.NewSpotInterruptionHandler(rawClient, false).Deploy()).To(Succeed())
+
+		var kinds []string
+		for _, item := range rawClient.Collection.CreatedItems() {
+			kinds = append(kinds, item.GetObjectKind().GroupVersionKind().Kind)
+		}
+		Expect(kinds).To(ConsistOf("ServiceAccount", "ClusterRole", "ClusterRoleBinding", "DaemonSet"))
+	})
+
+	It("only schedules the daemonset on Linux nodes of nodegroups with the handler enabled", func() {
+		Expect(addons.NewSpotInterruptionHandler(rawClient, false).Deploy()).To(Succeed())
+
+		daemonSet, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.Background(), "aws-node-termination-handler", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		terms := daemonSet.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+		Expect(terms).To(HaveLen(1))
+		Expect(terms[0].MatchExpressions).To(ConsistOf(
+			corev1.NodeSelectorRequirement{
+				Key:      "kubernetes.io/os",
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"linux"},
+			},
+			corev1.NodeSelectorRequirement{
+				Key:      api.SpotInterruptionHandlerLabel,
+				Operator: corev1.NodeSelectorOpIn,
+				Values:   []string{"true"},
+			},
+		))
+		Expect(daemonSet.Spec.Template.Spec.Containers[0].Env).To(ContainElement(corev1.EnvVar{Name: "ENABLE_SPOT_INTERRUPTION_DRAINING", Value: "true"}))
+	})
+
+	It("does not create anything in plan mode", func() {
+		Expect(addons.NewSpotInterruptionHandler(rawClient, true).Deploy()).To(Succeed())
+		Expect(rawClient.Collection.Created()).To(BeEmpty())
+	})
+})
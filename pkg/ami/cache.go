@@ -0,0 +1,187 @@
+package ami
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const (
+	// DefaultCacheTTL is how long a resolved AMI is reused before it is resolved again
+	DefaultCacheTTL = time.Hour
+	// CacheFilenameEnvName defines an environment property to persist the AMI cache to the given file,
+	// so that it is shared between eksctl invocations
+	CacheFilenameEnvName = "EKSCTL_AMI_CACHE_FILENAME"
+)
+
+var (
+	defaultCache     *Cache
+	defaultCacheOnce sync.Once
+)
+
+// DefaultCache returns the AMI cache shared by all resolutions in this process, which is persisted
+// to the file set in the EKSCTL_AMI_CACHE_FILENAME environment variable, if any
+func DefaultCache() *Cache {
+	defaultCacheOnce.Do(func() {
+		defaultCache = NewCache(DefaultCacheTTL, os.Getenv(CacheFilenameEnvName))
+	})
+	return defaultCache
+}
+
+type cacheEntry struct {
+	ImageID    string    `json:"imageID"`
+	ResolvedAt time.Time `json:"resolvedAt"`
+}
+
+// Cache holds resolved AMI IDs keyed by region, Kubernetes version, image family and architecture
+type Cache struct {
+	mu       sync.Mutex
+	ttl      time.Duration
+	filename string
+	loaded   bool
+	entries  map[string]cacheEntry
+	now      func() time.Time
+}
+
+// NewCache creates a Cache whose entries expire after ttl. When filename is set, entries are also
+// read from and written to that file
+func NewCache(ttl time.Duration, filename string) *Cache {
+	return &Cache{
+		ttl:      ttl,
+		filename: filename,
+		entries:  map[string]cacheEntry{},
+		now:      time.Now,
+	}
+}
+
+// Get returns the cached AMI ID for key, if it has not expired
+func (c *Cache) Get(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+	entry, ok := c.entries[key]
+	if !ok || c.now().Sub(entry.ResolvedAt) >= c.ttl {
+		return "", false
+	}
+	return entry.ImageID, true
+}
+
+// Put caches imageID for key
+func (c *Cache) Put(key, imageID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.load()
+	c.entries[key] = cacheEntry{ImageID: imageID, ResolvedAt: c.now()}
+	if c.filename == "" {
+		return
+	}
+	// merge with entries written by other eksctl processes in the meantime
+	entries, err := readCacheFile(c.filename)
+	if err != nil {
+		logger.Warning("unable to read AMI cache %s: %v", c.filename, err)
+		entries = map[string]cacheEntry{}
+	}
+	for k, entry := range c.entries {
+		if existing, ok := entries[k]; !ok || existing.ResolvedAt.Before(entry.ResolvedAt) {
+			entries[k] = entry
+		}
+	}
+	if err := writeCacheFile(c.filename, entries); err != nil {
+		logger.Warning("unable to update AMI cache %s: %v", c.filename, err)
+	}
+}
+
+func (c *Cache) load() {
+	if c.loaded || c.filename == "" {
+		return
+	}
+	c.loaded = true
+	entries, err := readCacheFile(c.filename)
+	if err != nil {
+		logger.Warning("unable to read AMI cache %s: %v", c.filename, err)
+		return
+	}
+	for k, entry := range entries {
+		c.entries[k] = entry
+	}
+}
+
+func readCacheFile(filename string) (map[string]cacheEntry, error) {
+	entries := map[string]cacheEntry{}
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entries, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("unable to parse file %s: %w", filename, err)
+	}
+	return entries, nil
+}
+
+func writeCacheFile(filename string, entries map[string]cacheEntry) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, data, 0600)
+}
+
+// CachingResolver is a Resolver that caches the AMIs resolved by its delegate
+type CachingResolver struct {
+	source   string
+	delegate Resolver
+	cache    *Cache
+}
+
+// NewCachingResolver creates a CachingResolver. source identifies how delegate resolves AMIs,
+// e.g. auto or auto-ssm, so that AMIs resolved in different ways are cached separately
+func NewCachingResolver(source string, delegate Resolver, cache *Cache) Resolver {
+	return &CachingResolver{
+		source:   source,
+		delegate: delegate,
+		cache:    cache,
+	}
+}
+
+// Resolve returns the cached AMI for the region, version, image family and architecture of
+// instanceType, or resolves and caches it
+func (r *CachingResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
+	key := cacheKey(r.source, region, version, instanceType, imageFamily)
+	if id, ok := r.cache.Get(key); ok {
+		logger.Debug("using cached AMI %s for %s", id, key)
+		return id, nil
+	}
+
+	id, err := r.delegate.Resolve(region, version, instanceType, imageFamily)
+	if err != nil || id == "" {
+		return id, err
+	}
+	r.cache.Put(key, id)
+	return id, nil
+}
+
+func cacheKey(source, region, version, instanceType, imageFamily string) string {
+	arch := instanceEC2ArchName(instanceType)
+	if utils.IsGPUInstanceType(instanceType) {
+		arch += "-gpu"
+	}
+	if source == "" {
+		source = "default"
+	}
+	return fmt.Sprintf("%s/%s/%s/%s/%s", source, region, version, imageFamily, arch)
+}
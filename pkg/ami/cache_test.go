@@ -0,0 +1,149 @@
+package ami_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+type countingResolver struct {
+	calls int
+	id    string
+	err   error
+}
+
+func (r *countingResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
+	r.calls++
+	return r.id, r.err
+}
+
+var _ = Describe("AMI cache", func() {
+	var (
+		delegate *countingResolver
+		cache    *Cache
+		now      time.Time
+		resolver Resolver
+	)
+
+	BeforeEach(func() {
+		delegate = &countingResolver{id: "ami-123"}
+		now = time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+		cache = NewCache(time.Hour, "")
+		cache.SetNow(func() time.Time { return now })
+		resolver = NewCachingResolver("auto-ssm", delegate, cache)
+	})
+
+	It("resolves the AMI on a miss and reuses it on a hit", func() {
+		for i := 0; i < 3; i++ {
+			id, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("ami-123"))
+		}
+		Expect(delegate.calls).To(Equal(1))
+	})
+
+	It("caches AMIs per region, version, image family and architecture", func() {
+		for _, query := range [][]string{
+			{"us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2},
+			{"eu-west-1", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2},
+			{"us-west-2", "1.19", "m5.large", api.NodeImageFamilyAmazonLinux2},
+			{"us-west-2", "1.20", "m5.large", api.NodeImageFamilyBottlerocket},
+			{"us-west-2", "1.20", "m6g.large", api.NodeImageFamilyAmazonLinux2},
+			{"us-west-2", "1.20", "p2.xlarge", api.NodeImageFamilyAmazonLinux2},
+		} {
+			_, err := resolver.Resolve(query[0], query[1], query[2], query[3])
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(delegate.calls).To(Equal(6))
+
+		_, err := resolver.Resolve("us-west-2", "1.20", "m5.xlarge", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.calls).To(Equal(6), "instance types of the same architecture should share the AMI")
+	})
+
+	It("does not share AMIs between resolvers of different sources", func() {
+		other := NewCachingResolver("auto", delegate, cache)
+		_, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = other.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.calls).To(Equal(2))
+	})
+
+	It("resolves the AMI again once the TTL has expired", func() {
+		_, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(59 * time.Minute)
+		_, err = resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(delegate.calls).To(Equal(1))
+
+		delegate.id = "ami-456"
+		now = now.Add(time.Minute)
+		id, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(Equal("ami-456"))
+		Expect(delegate.calls).To(Equal(2))
+	})
+
+	It("does not cache failed resolutions", func() {
+		delegate.err = errors.New("throttled")
+		_, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).To(MatchError("throttled"))
+
+		delegate.err = nil
+		id, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(id).To(Equal("ami-123"))
+		Expect(delegate.calls).To(Equal(2))
+	})
+
+	Context("with a cache file", func() {
+		var dir, filename string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = os.MkdirTemp("", "ami-cache")
+			Expect(err).NotTo(HaveOccurred())
+			filename = filepath.Join(dir, "cache", "amis.json")
+			cache = NewCache(time.Hour, filename)
+			cache.SetNow(func() time.Time { return now })
+			resolver = NewCachingResolver("auto-ssm", delegate, cache)
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("shares resolved AMIs with caches using the same file", func() {
+			_, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(filename).To(BeAnExistingFile())
+
+			other := NewCache(time.Hour, filename)
+			other.SetNow(func() time.Time { return now })
+			id, err := NewCachingResolver("auto-ssm", delegate, other).Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("ami-123"))
+			Expect(delegate.calls).To(Equal(1))
+		})
+
+		It("ignores an unreadable cache file", func() {
+			Expect(os.MkdirAll(filepath.Dir(filename), 0700)).To(Succeed())
+			Expect(os.WriteFile(filename, []byte("not json"), 0600)).To(Succeed())
+
+			id, err := resolver.Resolve("us-west-2", "1.20", "m5.large", api.NodeImageFamilyAmazonLinux2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal("ami-123"))
+			Expect(delegate.calls).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,7 @@
+package ami
+
+import "time"
+
+func (c *Cache) SetNow(now func() time.Time) {
+	c.now = now
+}
@@ -1,8 +1,12 @@
 package v1alpha5
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
 )
 
 // Addon holds the EKS addon configuration
@@ -28,14 +32,76 @@ type Addon struct {
 	// Each tag consists of a key and an optional value, both of which you define.
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+	// ConfigurationValues holds the configuration of the addon as a JSON or YAML object,
+	// which must match the configuration schema of the addon version
+	// +optional
+	ConfigurationValues string `json:"configurationValues,omitempty"`
+	// WebhookCertificates are checked for expiry when the addon is updated.
+	// See [Webhook certificates](/usage/addons/#webhook-certificates)
+	// +optional
+	WebhookCertificates *AddonWebhookCertificates `json:"webhookCertificates,omitempty"`
+	// CreateBeforeNodeGroups creates the addon before the nodegroups of new clusters, as is always done for
+	// `vpc-cni`, e.g. for CNI plugins without which the nodes cannot become ready. Such addons are not waited
+	// for, as they only become active once nodes have joined the cluster.
+	// See [Creating addons before nodegroups](/usage/addons/#creating-addons-before-nodegroups)
+	// +optional
+	CreateBeforeNodeGroups bool `json:"createBeforeNodeGroups,omitempty"`
 	// Force applies the add-on to overwrite an existing add-on
 	Force bool `json:"-"`
 }
 
+// DefaultWebhookCertificateExpiryThresholdDays is how many days before their expiry webhook certificates are reported by default
+const DefaultWebhookCertificateExpiryThresholdDays = 30
+
+// AddonWebhookCertificates holds the secrets of the certificates served by the webhooks of an addon
+type AddonWebhookCertificates struct {
+	// Secrets holding the certificates of the webhooks, as `namespace/name`.
+	// The certificates are read from their `tls.crt` key
+	// +required
+	Secrets []string `json:"secrets"`
+	// ExpiryThresholdDays is how many days before their expiry the certificates are reported.
+	// Defaults to `30`
+	// +optional
+	ExpiryThresholdDays *int `json:"expiryThresholdDays,omitempty"`
+	// RestartDeployments are restarted, to renew the certificates, when one of them expires within the threshold.
+	// Deployments are referenced as `namespace/name`, only a warning is logged when no deployment is set
+	// +optional
+	RestartDeployments []string `json:"restartDeployments,omitempty"`
+}
+
+// ExpiryThreshold returns how long before their expiry the certificates are reported
+func (w *AddonWebhookCertificates) ExpiryThreshold() time.Duration {
+	days := DefaultWebhookCertificateExpiryThresholdDays
+	if w.ExpiryThresholdDays != nil {
+		days = *w.ExpiryThresholdDays
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
 func (a Addon) CanonicalName() string {
 	return strings.ToLower(a.Name)
 }
 
+// AddonConfigurationValues returns the configuration values of the addon, the metrics-server addon
+// gets the replicas and high-availability settings of the cluster config merged in, and the vpc-cni
+// addon the network policy settings
+func (c *ClusterConfig) AddonConfigurationValues(a *Addon) (string, error) {
+	switch a.CanonicalName() {
+	case MetricsServerAddon:
+		return c.MetricsServer.ConfigurationValues(a.ConfigurationValues)
+	case VPCCNIAddon:
+		if c.AWSNode != nil {
+			return c.AWSNode.NetworkPolicy.ConfigurationValues(a.ConfigurationValues)
+		}
+	}
+	return a.ConfigurationValues, nil
+}
+
+// IsCreatedBeforeNodeGroups returns true if the addon is created before the nodegroups of a new cluster
+func (a Addon) IsCreatedBeforeNodeGroups() bool {
+	return a.CanonicalName() == VPCCNIAddon || a.CreateBeforeNodeGroups
+}
+
 func (a Addon) Validate() error {
 	if a.Name == "" {
 		return fmt.Errorf("name required")
@@ -45,9 +111,67 @@ func (a Addon) Validate() error {
 		return err
 	}
 
+	if err := a.validateConfigurationValues(); err != nil {
+		return err
+	}
+
+	if err := a.validateWebhookCertificates(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateConfigurationValues checks that configurationValues, if set, is a JSON or YAML object
+func (a Addon) validateConfigurationValues() error {
+	if a.ConfigurationValues == "" {
+		return nil
+	}
+	_, err := parseConfigurationValues(a.ConfigurationValues)
+	return err
+}
+
+func (a Addon) validateWebhookCertificates() error {
+	w := a.WebhookCertificates
+	if w == nil {
+		return nil
+	}
+	if len(w.Secrets) == 0 {
+		return fmt.Errorf("webhookCertificates.secrets of addon %q must be set", a.Name)
+	}
+	isNamespacedName := func(ref string) bool {
+		parts := strings.Split(ref, "/")
+		return len(parts) == 2 && parts[0] != "" && parts[1] != ""
+	}
+	for _, secret := range w.Secrets {
+		if !isNamespacedName(secret) {
+			return fmt.Errorf("invalid secret %q in webhookCertificates.secrets of addon %q, expected namespace/name", secret, a.Name)
+		}
+	}
+	for _, deployment := range w.RestartDeployments {
+		if !isNamespacedName(deployment) {
+			return fmt.Errorf("invalid deployment %q in webhookCertificates.restartDeployments of addon %q, expected namespace/name", deployment, a.Name)
+		}
+	}
+	if w.ExpiryThresholdDays != nil && *w.ExpiryThresholdDays < 1 {
+		return fmt.Errorf("webhookCertificates.expiryThresholdDays of addon %q must be at least 1", a.Name)
+	}
 	return nil
 }
 
+// parseConfigurationValues parses configuration values given as a JSON or YAML object
+func parseConfigurationValues(configurationValues string) (map[string]interface{}, error) {
+	jsonValues, err := yaml.YAMLToJSON([]byte(configurationValues))
+	if err != nil {
+		return nil, fmt.Errorf("configurationValues must be valid JSON or YAML: %w", err)
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(jsonValues, &values); err != nil || values == nil {
+		return nil, fmt.Errorf("configurationValues must be a JSON or YAML object")
+	}
+	return values, nil
+}
+
 func (a Addon) checkOnlyOnePolicyProviderIsSet() error {
 	setPolicyProviders := 0
 	if a.AttachPolicy != nil {
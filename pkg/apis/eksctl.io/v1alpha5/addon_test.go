@@ -58,5 +58,85 @@ var _ = Describe("Addon", func() {
 				Expect(err).To(MatchError("at most one of wellKnownPolicies, serviceAccountRoleARN, attachPolicyARNs and attachPolicy can be specified"))
 			})
 		})
+
+		When("configurationValues is set", func() {
+			It("accepts a JSON or YAML object", func() {
+				Expect(v1alpha5.Addon{
+					Name:                "coredns",
+					ConfigurationValues: `{"replicaCount": 3}`,
+				}.Validate()).To(Succeed())
+
+				Expect(v1alpha5.Addon{
+					Name:                "coredns",
+					ConfigurationValues: "replicaCount: 3\nresources:\n  limits:\n    memory: 256Mi\n",
+				}.Validate()).To(Succeed())
+			})
+
+			It("errors if it is malformed", func() {
+				err := v1alpha5.Addon{
+					Name:                "coredns",
+					ConfigurationValues: `{"replicaCount": 3`,
+				}.Validate()
+				Expect(err).To(MatchError(ContainSubstring("configurationValues must be valid JSON or YAML")))
+			})
+
+			It("errors if it is not an object", func() {
+				err := v1alpha5.Addon{
+					Name:                "coredns",
+					ConfigurationValues: `["replicaCount"]`,
+				}.Validate()
+				Expect(err).To(MatchError("configurationValues must be a JSON or YAML object"))
+			})
+		})
+
+		When("webhookCertificates is set", func() {
+			validate := func(w *v1alpha5.AddonWebhookCertificates) error {
+				return v1alpha5.Addon{Name: "my-addon", WebhookCertificates: w}.Validate()
+			}
+
+			It("accepts secrets and deployments referenced as namespace/name", func() {
+				Expect(validate(&v1alpha5.AddonWebhookCertificates{
+					Secrets:            []string{"kube-system/webhook-tls"},
+					RestartDeployments: []string{"kube-system/webhook"},
+				})).To(Succeed())
+			})
+
+			It("requires secrets", func() {
+				Expect(validate(&v1alpha5.AddonWebhookCertificates{})).To(MatchError(`webhookCertificates.secrets of addon "my-addon" must be set`))
+			})
+
+			It("rejects references without a namespace", func() {
+				Expect(validate(&v1alpha5.AddonWebhookCertificates{
+					Secrets: []string{"webhook-tls"},
+				})).To(MatchError(`invalid secret "webhook-tls" in webhookCertificates.secrets of addon "my-addon", expected namespace/name`))
+				Expect(validate(&v1alpha5.AddonWebhookCertificates{
+					Secrets:            []string{"kube-system/webhook-tls"},
+					RestartDeployments: []string{"kube-system/"},
+				})).To(MatchError(`invalid deployment "kube-system/" in webhookCertificates.restartDeployments of addon "my-addon", expected namespace/name`))
+			})
+
+			It("rejects thresholds under a day", func() {
+				days := 0
+				Expect(validate(&v1alpha5.AddonWebhookCertificates{
+					Secrets:             []string{"kube-system/webhook-tls"},
+					ExpiryThresholdDays: &days,
+				})).To(MatchError(`webhookCertificates.expiryThresholdDays of addon "my-addon" must be at least 1`))
+			})
+		})
+	})
+
+	Describe("IsCreatedBeforeNodeGroups", func() {
+		It("is true for vpc-cni", func() {
+			Expect(v1alpha5.Addon{Name: "vpc-cni"}.IsCreatedBeforeNodeGroups()).To(BeTrue())
+			Expect(v1alpha5.Addon{Name: "VPC-CNI"}.IsCreatedBeforeNodeGroups()).To(BeTrue())
+		})
+
+		It("is true for addons set to be created before the nodegroups", func() {
+			Expect(v1alpha5.Addon{Name: "cilium", CreateBeforeNodeGroups: true}.IsCreatedBeforeNodeGroups()).To(BeTrue())
+		})
+
+		It("is false for other addons", func() {
+			Expect(v1alpha5.Addon{Name: "coredns"}.IsCreatedBeforeNodeGroups()).To(BeFalse())
+		})
 	})
 })
@@ -0,0 +1,226 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// VPCCNIAddon is the name of the EKS addon of the Amazon VPC CNI plugin, which runs aws-node
+const VPCCNIAddon = "vpc-cni"
+
+// NetworkPolicyMinimumVersion is the first Kubernetes version for which EKS supports
+// the enforcement of network policies by the VPC CNI
+const NetworkPolicyMinimumVersion = "1.25"
+
+// NetworkPolicyMinimumVPCCNIVersion is the first version of the vpc-cni addon that ships the
+// network policy agent
+const NetworkPolicyMinimumVPCCNIVersion = "1.14.0"
+
+// NetworkPolicyStrictModeMinimumVPCCNIVersion is the first version of the vpc-cni addon that
+// supports the strict enforcing mode
+const NetworkPolicyStrictModeMinimumVPCCNIVersion = "1.17.1"
+
+// Values for `awsNode.networkPolicy.enforcingMode`
+const (
+	// NetworkPolicyEnforcingModeStandard allows all traffic to and from pods until
+	// network policies select them
+	NetworkPolicyEnforcingModeStandard = "standard"
+	// NetworkPolicyEnforcingModeStrict denies all traffic to and from pods unless
+	// network policies allow it
+	NetworkPolicyEnforcingModeStrict = "strict"
+)
+
+// AWSNodeConfig holds the configuration of the aws-node daemonset of the Amazon VPC CNI plugin
+type AWSNodeConfig struct {
+	// Disable stops eksctl from managing aws-node, for clusters that use another
+	// CNI plugin such as Cilium. It is neither installed as an addon nor updated
+	// with the default addons
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+
+	// DeleteIfPresent deletes the aws-node daemonset EKS installs in new clusters,
+	// before their nodegroups are created. Requires `disable`
+	// +optional
+	DeleteIfPresent bool `json:"deleteIfPresent,omitempty"`
+
+	// NetworkPolicy configures the enforcement of Kubernetes network policies
+	// by the network policy agent of the vpc-cni addon.
+	// See [Network policies](/usage/vpc-networking/#network-policies)
+	// +optional
+	NetworkPolicy *AWSNodeNetworkPolicy `json:"networkPolicy,omitempty"`
+}
+
+// AWSNodeNetworkPolicy holds the network policy settings of aws-node
+type AWSNodeNetworkPolicy struct {
+	// Enable turns on the network policy agent of the vpc-cni addon, which must be
+	// listed in `addons` with version 1.14.0 or above. Requires Kubernetes 1.25 or above
+	// +optional
+	Enable bool `json:"enable,omitempty"`
+
+	// EnforcingMode is the enforcing mode of the network policies of new pods. Valid variants are:
+	// `"standard"` (default): pods accept all traffic until network policies select them;
+	// `"strict"`: pods deny all traffic until network policies allow it
+	// +optional
+	EnforcingMode string `json:"enforcingMode,omitempty"`
+}
+
+// IsNetworkPolicyEnabled returns true if aws-node must enforce network policies
+func (c *ClusterConfig) IsNetworkPolicyEnabled() bool {
+	return c.AWSNode != nil && c.AWSNode.NetworkPolicy != nil && c.AWSNode.NetworkPolicy.Enable
+}
+
+func setAWSNodeDefaults(c *AWSNodeConfig) {
+	if c.NetworkPolicy != nil && c.NetworkPolicy.Enable && c.NetworkPolicy.EnforcingMode == "" {
+		c.NetworkPolicy.EnforcingMode = NetworkPolicyEnforcingModeStandard
+	}
+}
+
+// IsAWSNodeDisabled returns true if eksctl must not install nor update aws-node
+func (c *ClusterConfig) IsAWSNodeDisabled() bool {
+	return c.AWSNode != nil && c.AWSNode.Disable
+}
+
+// Validate validates the aws-node configuration against the rest of the cluster config
+func (c *AWSNodeConfig) Validate(cfg *ClusterConfig) error {
+	if c == nil {
+		return nil
+	}
+	if c.DeleteIfPresent && !c.Disable {
+		return fmt.Errorf("awsNode.deleteIfPresent requires awsNode.disable")
+	}
+	if !c.Disable {
+		return c.NetworkPolicy.validate(cfg.Metadata.Version, cfg.Addons)
+	}
+	for i, addon := range cfg.Addons {
+		if addon.CanonicalName() == VPCCNIAddon {
+			return fmt.Errorf("addons[%d]: the %s addon cannot be installed when awsNode.disable is set", i, VPCCNIAddon)
+		}
+	}
+	if cfg.VPC != nil && cfg.VPC.CustomNetworking != nil {
+		return fmt.Errorf("vpc.customNetworking cannot be set when awsNode.disable is set, as it configures aws-node")
+	}
+	if c.NetworkPolicy != nil && c.NetworkPolicy.Enable {
+		return fmt.Errorf("awsNode.networkPolicy cannot be enabled when awsNode.disable is set, as it configures aws-node")
+	}
+	for _, ng := range cfg.AllNodeGroups() {
+		if IsEnabled(ng.PrefixDelegation) {
+			return fmt.Errorf("prefixDelegation of nodegroup %q cannot be enabled when awsNode.disable is set, as it configures aws-node", ng.Name)
+		}
+	}
+	return nil
+}
+
+// validatePrefixDelegation rejects prefix delegation when the vpc-cni addon is installed, as eksctl enables it
+// in the aws-node daemonset, which is managed by the addon
+func validatePrefixDelegation(cfg *ClusterConfig) error {
+	if !cfg.HasPrefixDelegation() {
+		return nil
+	}
+	for i, addon := range cfg.Addons {
+		if addon.CanonicalName() == VPCCNIAddon {
+			return fmt.Errorf("addons[%d]: prefixDelegation cannot be enabled when the %s addon is installed, set ENABLE_PREFIX_DELEGATION in the configurationValues of the addon instead", i, VPCCNIAddon)
+		}
+	}
+	return nil
+}
+
+func (n *AWSNodeNetworkPolicy) validate(version string, addons []*Addon) error {
+	if n == nil {
+		return nil
+	}
+	switch n.EnforcingMode {
+	case "", NetworkPolicyEnforcingModeStandard, NetworkPolicyEnforcingModeStrict:
+	default:
+		return fmt.Errorf("invalid value %q for awsNode.networkPolicy.enforcingMode, valid options: %s, %s", n.EnforcingMode, NetworkPolicyEnforcingModeStandard, NetworkPolicyEnforcingModeStrict)
+	}
+	if !n.Enable {
+		if n.EnforcingMode != "" {
+			return fmt.Errorf("awsNode.networkPolicy.enforcingMode requires awsNode.networkPolicy.enable")
+		}
+		return nil
+	}
+	if version != "" && version != "auto" {
+		supported, err := utils.IsMinVersion(NetworkPolicyMinimumVersion, version)
+		if err != nil {
+			return errors.Wrapf(err, "checking if network policies are supported for Kubernetes version %s", version)
+		}
+		if !supported {
+			return fmt.Errorf("awsNode.networkPolicy is only supported for Kubernetes version %s and above, got %s", NetworkPolicyMinimumVersion, version)
+		}
+	}
+
+	// the aws-node manifest bundled with eksctl predates the network policy agent
+	minimumVersion := NetworkPolicyMinimumVPCCNIVersion
+	if n.EnforcingMode == NetworkPolicyEnforcingModeStrict {
+		minimumVersion = NetworkPolicyStrictModeMinimumVPCCNIVersion
+	}
+	for i, addon := range addons {
+		if addon.CanonicalName() != VPCCNIAddon {
+			continue
+		}
+		if addon.Version == "latest" {
+			return nil
+		}
+		if addon.Version == "" {
+			return fmt.Errorf("addons[%d]: the version of the %s addon must be set to %s or above, or to latest, when awsNode.networkPolicy.enable is set", i, VPCCNIAddon, minimumVersion)
+		}
+		supported, err := utils.IsMinVersion(minimumVersion, vpcCNIAddonSemver(addon.Version))
+		if err != nil {
+			return errors.Wrapf(err, "addons[%d]: checking if version %s of the %s addon supports network policies", i, addon.Version, VPCCNIAddon)
+		}
+		if !supported {
+			return fmt.Errorf("addons[%d]: version %s of the %s addon does not support awsNode.networkPolicy with the %s enforcing mode, %s or above is required", i, addon.Version, VPCCNIAddon, n.mode(), minimumVersion)
+		}
+		return nil
+	}
+	return fmt.Errorf("awsNode.networkPolicy.enable requires the %s addon version %s or above in addons, the aws-node manifest bundled with eksctl does not include the network policy agent", VPCCNIAddon, minimumVersion)
+}
+
+func (n *AWSNodeNetworkPolicy) mode() string {
+	if n.EnforcingMode == "" {
+		return NetworkPolicyEnforcingModeStandard
+	}
+	return n.EnforcingMode
+}
+
+// vpcCNIAddonSemver strips the EKS build suffix of vpc-cni addon versions such as v1.14.1-eksbuild.1,
+// which semver would otherwise treat as a pre-release
+func vpcCNIAddonSemver(version string) string {
+	return strings.SplitN(version, "-", 2)[0]
+}
+
+// ConfigurationValues returns the configuration values of the vpc-cni addon with the settings of
+// the network policy agent merged in
+func (n *AWSNodeNetworkPolicy) ConfigurationValues(values string) (string, error) {
+	if n == nil || !n.Enable {
+		return values, nil
+	}
+	merged := map[string]interface{}{}
+	if values != "" {
+		var err error
+		if merged, err = parseConfigurationValues(values); err != nil {
+			return "", err
+		}
+	}
+
+	merged["enableNetworkPolicy"] = "true"
+	if n.EnforcingMode == NetworkPolicyEnforcingModeStrict {
+		env, _ := merged["env"].(map[string]interface{})
+		if env == nil {
+			env = map[string]interface{}{}
+		}
+		env["NETWORK_POLICY_ENFORCING_MODE"] = NetworkPolicyEnforcingModeStrict
+		merged["env"] = env
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,143 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AWSNode", func() {
+	var cfg *ClusterConfig
+
+	BeforeEach(func() {
+		cfg = NewClusterConfig()
+		cfg.AWSNode = &AWSNodeConfig{Disable: true, DeleteIfPresent: true}
+	})
+
+	It("accepts disabling and deleting aws-node", func() {
+		Expect(cfg.AWSNode.Validate(cfg)).To(Succeed())
+		Expect(cfg.IsAWSNodeDisabled()).To(BeTrue())
+	})
+
+	It("is enabled by default", func() {
+		Expect(NewClusterConfig().IsAWSNodeDisabled()).To(BeFalse())
+	})
+
+	It("requires disable to delete aws-node", func() {
+		cfg.AWSNode.Disable = false
+		Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("awsNode.deleteIfPresent requires awsNode.disable"))
+	})
+
+	It("rejects the vpc-cni addon", func() {
+		cfg.Addons = []*Addon{{Name: "coredns"}, {Name: "vpc-cni"}}
+		Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("addons[1]: the vpc-cni addon cannot be installed when awsNode.disable is set"))
+	})
+
+	It("rejects custom networking", func() {
+		cfg.VPC.CustomNetworking = &CustomNetworking{}
+		Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("vpc.customNetworking cannot be set when awsNode.disable is set, as it configures aws-node"))
+	})
+
+	It("rejects prefix delegation", func() {
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{{NodeGroupBase: &NodeGroupBase{Name: "ng", PrefixDelegation: Enabled()}}}
+		Expect(cfg.AWSNode.Validate(cfg)).To(MatchError(`prefixDelegation of nodegroup "ng" cannot be enabled when awsNode.disable is set, as it configures aws-node`))
+	})
+
+	Describe("prefixDelegation", func() {
+		BeforeEach(func() {
+			cfg.AWSNode = nil
+			cfg.NodeGroups = []*NodeGroup{{NodeGroupBase: &NodeGroupBase{Name: "ng", PrefixDelegation: Enabled()}}}
+		})
+
+		It("accepts prefix delegation without the vpc-cni addon", func() {
+			cfg.Addons = []*Addon{{Name: "coredns"}}
+			Expect(validatePrefixDelegation(cfg)).To(Succeed())
+		})
+
+		It("rejects prefix delegation with the vpc-cni addon", func() {
+			cfg.Addons = []*Addon{{Name: "coredns"}, {Name: "vpc-cni"}}
+			Expect(validatePrefixDelegation(cfg)).To(MatchError("addons[1]: prefixDelegation cannot be enabled when the vpc-cni addon is installed, set ENABLE_PREFIX_DELEGATION in the configurationValues of the addon instead"))
+		})
+	})
+
+	Describe("networkPolicy", func() {
+		BeforeEach(func() {
+			cfg.AWSNode = &AWSNodeConfig{NetworkPolicy: &AWSNodeNetworkPolicy{Enable: true}}
+			cfg.Addons = []*Addon{{Name: "vpc-cni", Version: "v1.14.1-eksbuild.1"}}
+			cfg.Metadata.Version = NetworkPolicyMinimumVersion
+		})
+
+		It("defaults to the standard enforcing mode", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.AWSNode.NetworkPolicy.EnforcingMode).To(Equal(NetworkPolicyEnforcingModeStandard))
+			Expect(cfg.IsNetworkPolicyEnabled()).To(BeTrue())
+			Expect(cfg.AWSNode.Validate(cfg)).To(Succeed())
+		})
+
+		It("accepts the strict enforcing mode", func() {
+			cfg.AWSNode.NetworkPolicy.EnforcingMode = NetworkPolicyEnforcingModeStrict
+			cfg.Addons[0].Version = "1.17.1"
+			Expect(cfg.AWSNode.Validate(cfg)).To(Succeed())
+		})
+
+		It("accepts the latest vpc-cni addon", func() {
+			cfg.AWSNode.NetworkPolicy.EnforcingMode = NetworkPolicyEnforcingModeStrict
+			cfg.Addons[0].Version = "latest"
+			Expect(cfg.AWSNode.Validate(cfg)).To(Succeed())
+		})
+
+		It("rejects unknown enforcing modes", func() {
+			cfg.AWSNode.NetworkPolicy.EnforcingMode = "permissive"
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError(`invalid value "permissive" for awsNode.networkPolicy.enforcingMode, valid options: standard, strict`))
+		})
+
+		It("requires enable to set the enforcing mode", func() {
+			cfg.AWSNode.NetworkPolicy = &AWSNodeNetworkPolicy{EnforcingMode: NetworkPolicyEnforcingModeStrict}
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("awsNode.networkPolicy.enforcingMode requires awsNode.networkPolicy.enable"))
+		})
+
+		It("rejects Kubernetes versions that do not support network policies", func() {
+			cfg.Metadata.Version = Version1_21
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("awsNode.networkPolicy is only supported for Kubernetes version 1.25 and above, got 1.21"))
+		})
+
+		It("requires the vpc-cni addon", func() {
+			cfg.Addons = []*Addon{{Name: "coredns"}}
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("awsNode.networkPolicy.enable requires the vpc-cni addon version 1.14.0 or above in addons, the aws-node manifest bundled with eksctl does not include the network policy agent"))
+		})
+
+		It("requires the version of the vpc-cni addon to be set", func() {
+			cfg.Addons[0].Version = ""
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("addons[0]: the version of the vpc-cni addon must be set to 1.14.0 or above, or to latest, when awsNode.networkPolicy.enable is set"))
+		})
+
+		It("rejects versions of the vpc-cni addon without the network policy agent", func() {
+			cfg.Addons[0].Version = "v1.13.4-eksbuild.1"
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("addons[0]: version v1.13.4-eksbuild.1 of the vpc-cni addon does not support awsNode.networkPolicy with the standard enforcing mode, 1.14.0 or above is required"))
+		})
+
+		It("rejects versions of the vpc-cni addon without the strict enforcing mode", func() {
+			cfg.AWSNode.NetworkPolicy.EnforcingMode = NetworkPolicyEnforcingModeStrict
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("addons[0]: version v1.14.1-eksbuild.1 of the vpc-cni addon does not support awsNode.networkPolicy with the strict enforcing mode, 1.17.1 or above is required"))
+		})
+
+		It("merges the network policy settings into the configuration values of the vpc-cni addon", func() {
+			cfg.AWSNode.NetworkPolicy.EnforcingMode = NetworkPolicyEnforcingModeStrict
+			cfg.Addons[0].ConfigurationValues = `{"env": {"WARM_IP_TARGET": "2"}}`
+			values, err := cfg.AddonConfigurationValues(cfg.Addons[0])
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(MatchJSON(`{"enableNetworkPolicy": "true", "env": {"WARM_IP_TARGET": "2", "NETWORK_POLICY_ENFORCING_MODE": "strict"}}`))
+		})
+
+		It("leaves the configuration values of the vpc-cni addon as they are when network policies are disabled", func() {
+			cfg.AWSNode.NetworkPolicy.Enable = false
+			cfg.Addons[0].ConfigurationValues = `{"env": {"WARM_IP_TARGET": "2"}}`
+			Expect(cfg.AddonConfigurationValues(cfg.Addons[0])).To(Equal(`{"env": {"WARM_IP_TARGET": "2"}}`))
+		})
+
+		It("cannot be enabled when aws-node is disabled", func() {
+			cfg.AWSNode.Disable = true
+			cfg.Addons = nil
+			Expect(cfg.AWSNode.Validate(cfg)).To(MatchError("awsNode.networkPolicy cannot be enabled when awsNode.disable is set, as it configures aws-node"))
+		})
+	})
+})
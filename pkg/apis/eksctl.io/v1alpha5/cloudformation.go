@@ -0,0 +1,152 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+)
+
+// CloudFormationConfig holds the settings of the CloudFormation stacks created by eksctl
+type CloudFormationConfig struct {
+	// Capabilities are acknowledged for all stacks, in addition to the IAM capabilities
+	// eksctl requests for the stacks that create IAM resources. Valid entries are
+	// `CAPABILITY_IAM`, `CAPABILITY_NAMED_IAM` and `CAPABILITY_AUTO_EXPAND`
+	// +optional
+	Capabilities []string `json:"capabilities,omitempty"`
+
+	// StackPolicy is a [stack policy](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/protect-stack-resources.html)
+	// document set on the stacks when they are created
+	// +optional
+	StackPolicy InlineDocument `json:"stackPolicy,omitempty"`
+
+	// NotificationARNs are the ARNs of the SNS topics the events of the stacks are published to,
+	// they are set on the stacks when they are created or updated
+	// +optional
+	NotificationARNs []string `json:"notificationARNs,omitempty"`
+
+	// Timeouts are the maximum waiting times for the operations on the stacks,
+	// the `--cfn-create-timeout`, `--cfn-update-timeout` and `--cfn-delete-timeout` flags take precedence
+	// +optional
+	Timeouts *CloudFormationTimeouts `json:"timeouts,omitempty"`
+}
+
+// CloudFormationTimeouts holds the maximum waiting times for the creation, update and deletion of the stacks,
+// as durations such as `60m` or `1h30m`. Unset timeouts default to `--timeout`
+type CloudFormationTimeouts struct {
+	// +optional
+	Create string `json:"create,omitempty"`
+	// +optional
+	Update string `json:"update,omitempty"`
+	// +optional
+	Delete string `json:"delete,omitempty"`
+}
+
+// maxStackNotificationARNs is the maximum number of SNS topics CloudFormation publishes the events of a stack to
+const maxStackNotificationARNs = 5
+
+// Validate validates the CloudFormation settings, the SNS topics must be in the region of the cluster
+// when it is known
+func (c *CloudFormationConfig) Validate(region string) error {
+	if c == nil {
+		return nil
+	}
+	for i, capability := range c.Capabilities {
+		if !isStackCapabilitySupported(capability) {
+			return fmt.Errorf("invalid value %q for cloudFormation.capabilities[%d], valid options: %s", capability, i, strings.Join(cloudformation.Capability_Values(), ", "))
+		}
+	}
+	if c.StackPolicy != nil {
+		if _, ok := c.StackPolicy["Statement"]; !ok {
+			return errors.New("cloudFormation.stackPolicy must contain a Statement")
+		}
+	}
+	if len(c.NotificationARNs) > maxStackNotificationARNs {
+		return fmt.Errorf("cloudFormation.notificationARNs cannot contain more than %d SNS topics", maxStackNotificationARNs)
+	}
+	seen := map[string]bool{}
+	for i, notificationARN := range c.NotificationARNs {
+		parsed, err := arn.Parse(notificationARN)
+		if err != nil {
+			return errors.Wrapf(err, "invalid ARN %q for cloudFormation.notificationARNs[%d]", notificationARN, i)
+		}
+		if parsed.Service != "sns" || parsed.Resource == "" {
+			return fmt.Errorf("cloudFormation.notificationARNs[%d] must be the ARN of an SNS topic, got %q", i, notificationARN)
+		}
+		if region != "" && parsed.Region != region {
+			return fmt.Errorf("cloudFormation.notificationARNs[%d]: SNS topic %q must be in the region of the cluster (%s)", i, notificationARN, region)
+		}
+		if seen[notificationARN] {
+			return fmt.Errorf("cloudFormation.notificationARNs[%d]: SNS topic %q is listed more than once", i, notificationARN)
+		}
+		seen[notificationARN] = true
+	}
+	if c.Timeouts != nil {
+		for _, timeout := range []struct{ operation, value string }{
+			{"create", c.Timeouts.Create}, {"update", c.Timeouts.Update}, {"delete", c.Timeouts.Delete},
+		} {
+			if timeout.value == "" {
+				continue
+			}
+			if d, err := time.ParseDuration(timeout.value); err != nil || d <= 0 {
+				return fmt.Errorf("invalid value %q for cloudFormation.timeouts.%s: must be a positive duration, e.g. 60m", timeout.value, timeout.operation)
+			}
+		}
+	}
+	return nil
+}
+
+// StackTimeouts returns the timeouts of the operations on the stacks, overridden by the non-zero timeouts
+// of flags. It must be called on a validated config
+func (c *CloudFormationConfig) StackTimeouts(flags StackTimeouts) StackTimeouts {
+	if c == nil || c.Timeouts == nil {
+		return flags
+	}
+	timeout := func(flag time.Duration, value string) time.Duration {
+		if flag > 0 || value == "" {
+			return flag
+		}
+		d, _ := time.ParseDuration(value)
+		return d
+	}
+	return StackTimeouts{
+		Create: timeout(flags.Create, c.Timeouts.Create),
+		Update: timeout(flags.Update, c.Timeouts.Update),
+		Delete: timeout(flags.Delete, c.Timeouts.Delete),
+	}
+}
+
+// StackNotificationARNs returns the ARNs of the SNS topics the events of the stacks are published to
+func (c *CloudFormationConfig) StackNotificationARNs() []*string {
+	if c == nil || len(c.NotificationARNs) == 0 {
+		return nil
+	}
+	return aws.StringSlice(c.NotificationARNs)
+}
+
+// StackPolicyBody returns the JSON stack policy document, or nil if there is none
+func (c *CloudFormationConfig) StackPolicyBody() (*string, error) {
+	if c == nil || c.StackPolicy == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(c.StackPolicy)
+	if err != nil {
+		return nil, errors.Wrap(err, "serialising cloudFormation.stackPolicy")
+	}
+	body := string(data)
+	return &body, nil
+}
+
+func isStackCapabilitySupported(capability string) bool {
+	for _, c := range cloudformation.Capability_Values() {
+		if c == capability {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,68 @@
+package v1alpha5
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CloudFormationConfig", func() {
+	It("accepts the CloudFormation capabilities and a stack policy", func() {
+		cfg := NewClusterConfig()
+		cfg.CloudFormation = &CloudFormationConfig{
+			Capabilities: []string{"CAPABILITY_AUTO_EXPAND", "CAPABILITY_NAMED_IAM"},
+			StackPolicy:  InlineDocument{"Statement": []interface{}{}},
+		}
+		Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+		body, err := cfg.CloudFormation.StackPolicyBody()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*body).To(MatchJSON(`{"Statement": []}`))
+	})
+
+	It("rejects unknown capabilities", func() {
+		c := &CloudFormationConfig{Capabilities: []string{"CAPABILITY_IAM", "CAPABILITY_ALL"}}
+		Expect(c.Validate("us-west-2")).To(MatchError(`invalid value "CAPABILITY_ALL" for cloudFormation.capabilities[1], valid options: CAPABILITY_IAM, CAPABILITY_NAMED_IAM, CAPABILITY_AUTO_EXPAND`))
+	})
+
+	It("rejects a stack policy without statements", func() {
+		c := &CloudFormationConfig{StackPolicy: InlineDocument{"Version": "2012-10-17"}}
+		Expect(c.Validate("us-west-2")).To(MatchError("cloudFormation.stackPolicy must contain a Statement"))
+	})
+
+	It("accepts the ARNs of SNS topics for the stack notifications", func() {
+		c := &CloudFormationConfig{NotificationARNs: []string{"arn:aws:sns:us-west-2:123456789012:stack-events", "arn:aws:sns:us-west-2:123456789012:ops"}}
+		Expect(c.Validate("us-west-2")).To(Succeed())
+		Expect(aws.StringValueSlice(c.StackNotificationARNs())).To(Equal(c.NotificationARNs))
+	})
+
+	DescribeTable("rejects invalid notification ARNs", func(notificationARNs []string, errMsg string) {
+		c := &CloudFormationConfig{NotificationARNs: notificationARNs}
+		Expect(c.Validate("us-west-2")).To(MatchError(ContainSubstring(errMsg)))
+	},
+		Entry("not an ARN", []string{"stack-events"}, `invalid ARN "stack-events" for cloudFormation.notificationARNs[0]`),
+		Entry("not an SNS topic", []string{"arn:aws:sqs:us-west-2:123456789012:stack-events"}, `cloudFormation.notificationARNs[0] must be the ARN of an SNS topic, got "arn:aws:sqs:us-west-2:123456789012:stack-events"`),
+		Entry("topic in another region", []string{"arn:aws:sns:eu-west-1:123456789012:stack-events"}, `cloudFormation.notificationARNs[0]: SNS topic "arn:aws:sns:eu-west-1:123456789012:stack-events" must be in the region of the cluster (us-west-2)`),
+		Entry("duplicate topics", []string{"arn:aws:sns:us-west-2:123456789012:a", "arn:aws:sns:us-west-2:123456789012:a"}, `cloudFormation.notificationARNs[1]: SNS topic "arn:aws:sns:us-west-2:123456789012:a" is listed more than once`),
+		Entry("too many topics", []string{"arn:aws:sns:us-west-2:123456789012:a", "arn:aws:sns:us-west-2:123456789012:b", "arn:aws:sns:us-west-2:123456789012:c",
+			"arn:aws:sns:us-west-2:123456789012:d", "arn:aws:sns:us-west-2:123456789012:e", "arn:aws:sns:us-west-2:123456789012:f"}, "cloudFormation.notificationARNs cannot contain more than 5 SNS topics"),
+	)
+
+	DescribeTable("rejects invalid timeouts", func(timeouts CloudFormationTimeouts, errMsg string) {
+		c := &CloudFormationConfig{Timeouts: &timeouts}
+		Expect(c.Validate("us-west-2")).To(MatchError(errMsg))
+	},
+		Entry("not a duration", CloudFormationTimeouts{Create: "60"}, `invalid value "60" for cloudFormation.timeouts.create: must be a positive duration, e.g. 60m`),
+		Entry("negative duration", CloudFormationTimeouts{Delete: "-1h"}, `invalid value "-1h" for cloudFormation.timeouts.delete: must be a positive duration, e.g. 60m`),
+	)
+
+	It("has no stack policy by default", func() {
+		var c *CloudFormationConfig
+		Expect(c.Validate("us-west-2")).To(Succeed())
+		body, err := c.StackPolicyBody()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(body).To(BeNil())
+		Expect(c.StackNotificationARNs()).To(BeNil())
+	})
+})
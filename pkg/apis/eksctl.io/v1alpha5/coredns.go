@@ -0,0 +1,156 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kris-nova/logger"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Values for `CoreDNSConfig.ComputeType`
+const (
+	// CoreDNSComputeTypeEC2 schedules CoreDNS on EC2 nodes
+	CoreDNSComputeTypeEC2 = "ec2"
+	// CoreDNSComputeTypeFargate schedules CoreDNS on Fargate
+	CoreDNSComputeTypeFargate = "fargate"
+)
+
+// CoreDNSFargateProfileName is the name of the Fargate profile that is added
+// when CoreDNS is scheduled on Fargate and no Fargate profile selects its pods
+const CoreDNSFargateProfileName = "fp-coredns"
+
+// CoreDNSConfig holds the configuration of the CoreDNS deployment
+type CoreDNSConfig struct {
+	// ComputeType sets whether CoreDNS is scheduled on `ec2` nodes or on `fargate`.
+	// When set to `fargate`, a Fargate profile selecting the CoreDNS pods is added
+	// unless one already exists
+	// +optional
+	ComputeType string `json:"computeType,omitempty"`
+
+	// TopologySpreadConstraints are set on the CoreDNS pods, e.g. to spread replicas
+	// across availability zones. They are preserved when CoreDNS is updated.
+	// `labelSelector` defaults to the CoreDNS pod labels and `whenUnsatisfiable` defaults to `ScheduleAnyway`
+	// +optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// PodLabels are added to the labels of the CoreDNS pods, e.g. for service mesh injection.
+	// They are preserved when CoreDNS is updated. `k8s-app` selects the CoreDNS pods and cannot be set
+	// +optional
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+
+	// PodAnnotations are added to the annotations of the CoreDNS pods. They are preserved when CoreDNS is updated
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+}
+
+// CoreDNSPodLabels are the labels of the CoreDNS pods
+var CoreDNSPodLabels = map[string]string{
+	"k8s-app": "kube-dns",
+}
+
+// SelectsCoreDNS returns true if the Fargate profile selector matches the CoreDNS pods
+func SelectsCoreDNS(selector FargateProfileSelector) bool {
+	if selector.Namespace != "kube-system" {
+		return false
+	}
+	for k, v := range selector.Labels {
+		if CoreDNSPodLabels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func hasCoreDNSFargateProfile(profiles []*FargateProfile) bool {
+	for _, profile := range profiles {
+		for _, selector := range profile.Selectors {
+			if SelectsCoreDNS(selector) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetCoreDNSComputeTypeDefaults adds a Fargate profile selecting CoreDNS, and sets the compute type of the
+// coredns addon, when CoreDNS is scheduled on Fargate. It is only meant for new clusters, the Fargate
+// profiles and addons of existing clusters are left as they are
+func (c *ClusterConfig) SetCoreDNSComputeTypeDefaults() {
+	if c.CoreDNS == nil || c.CoreDNS.ComputeType != CoreDNSComputeTypeFargate {
+		return
+	}
+	if !hasCoreDNSFargateProfile(c.FargateProfiles) {
+		logger.Info("adding Fargate profile %q to schedule CoreDNS on Fargate", CoreDNSFargateProfileName)
+		labels := map[string]string{}
+		for k, v := range CoreDNSPodLabels {
+			labels[k] = v
+		}
+		c.FargateProfiles = append(c.FargateProfiles, &FargateProfile{
+			Name: CoreDNSFargateProfileName,
+			Selectors: []FargateProfileSelector{
+				{
+					Namespace: "kube-system",
+					Labels:    labels,
+				},
+			},
+		})
+	}
+	for _, addon := range c.Addons {
+		// the managed CoreDNS addon schedules CoreDNS on Fargate through its configuration values
+		if strings.ToLower(addon.Name) == "coredns" && addon.ConfigurationValues == "" {
+			addon.ConfigurationValues = `{"computeType":"Fargate"}`
+		}
+	}
+}
+
+func setCoreDNSDefaults(c *CoreDNSConfig) {
+	for i := range c.TopologySpreadConstraints {
+		constraint := &c.TopologySpreadConstraints[i]
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = &metav1.LabelSelector{
+				MatchLabels: map[string]string{},
+			}
+			for k, v := range CoreDNSPodLabels {
+				constraint.LabelSelector.MatchLabels[k] = v
+			}
+		}
+		if constraint.WhenUnsatisfiable == "" {
+			constraint.WhenUnsatisfiable = corev1.ScheduleAnyway
+		}
+	}
+}
+
+// Validate validates the CoreDNS configuration
+func (c *CoreDNSConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	switch c.ComputeType {
+	case "", CoreDNSComputeTypeEC2, CoreDNSComputeTypeFargate:
+	default:
+		return fmt.Errorf("coreDNS.computeType must be one of %s or %s", CoreDNSComputeTypeEC2, CoreDNSComputeTypeFargate)
+	}
+	for i, constraint := range c.TopologySpreadConstraints {
+		path := fmt.Sprintf("coreDNS.topologySpreadConstraints[%d]", i)
+		if constraint.MaxSkew < 1 {
+			return fmt.Errorf("%s.maxSkew must be greater than 0", path)
+		}
+		if constraint.TopologyKey == "" {
+			return fmt.Errorf("%s.topologyKey must be set", path)
+		}
+		switch constraint.WhenUnsatisfiable {
+		case "", corev1.DoNotSchedule, corev1.ScheduleAnyway:
+		default:
+			return fmt.Errorf("%s.whenUnsatisfiable must be one of %s or %s", path, corev1.DoNotSchedule, corev1.ScheduleAnyway)
+		}
+		if constraint.LabelSelector != nil {
+			if _, err := metav1.LabelSelectorAsSelector(constraint.LabelSelector); err != nil {
+				return fmt.Errorf("%s.labelSelector is invalid: %w", path, err)
+			}
+		}
+	}
+	return validatePodMetadata("coreDNS", c.PodLabels, c.PodAnnotations, CoreDNSPodLabels)
+}
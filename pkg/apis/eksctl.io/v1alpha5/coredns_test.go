@@ -0,0 +1,134 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("CoreDNS", func() {
+	zoneConstraint := func() corev1.TopologySpreadConstraint {
+		return corev1.TopologySpreadConstraint{
+			MaxSkew:     1,
+			TopologyKey: "topology.kubernetes.io/zone",
+		}
+	}
+
+	It("defaults the label selector and whenUnsatisfiable of topology spread constraints", func() {
+		cfg := NewClusterConfig()
+		cfg.CoreDNS = &CoreDNSConfig{
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneConstraint()},
+		}
+		SetClusterConfigDefaults(cfg)
+
+		constraint := cfg.CoreDNS.TopologySpreadConstraints[0]
+		Expect(constraint.WhenUnsatisfiable).To(Equal(corev1.ScheduleAnyway))
+		Expect(constraint.LabelSelector).To(Equal(&metav1.LabelSelector{
+			MatchLabels: map[string]string{"k8s-app": "kube-dns"},
+		}))
+		Expect(ValidateClusterConfig(cfg)).To(Succeed())
+	})
+
+	It("does not override a configured label selector", func() {
+		constraint := zoneConstraint()
+		constraint.WhenUnsatisfiable = corev1.DoNotSchedule
+		constraint.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "dns"}}
+		c := &CoreDNSConfig{TopologySpreadConstraints: []corev1.TopologySpreadConstraint{constraint}}
+		setCoreDNSDefaults(c)
+		Expect(c.TopologySpreadConstraints).To(ConsistOf(constraint))
+	})
+
+	Context("computeType", func() {
+		It("adds a Fargate profile selecting CoreDNS when scheduling CoreDNS on Fargate", func() {
+			cfg := NewClusterConfig()
+			cfg.CoreDNS = &CoreDNSConfig{ComputeType: CoreDNSComputeTypeFargate}
+			cfg.SetCoreDNSComputeTypeDefaults()
+
+			Expect(cfg.FargateProfiles).To(ConsistOf(&FargateProfile{
+				Name: "fp-coredns",
+				Selectors: []FargateProfileSelector{
+					{
+						Namespace: "kube-system",
+						Labels:    map[string]string{"k8s-app": "kube-dns"},
+					},
+				},
+			}))
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("does not add a Fargate profile if one already selects CoreDNS", func() {
+			cfg := NewClusterConfig()
+			cfg.CoreDNS = &CoreDNSConfig{ComputeType: CoreDNSComputeTypeFargate}
+			cfg.SetDefaultFargateProfile()
+			cfg.SetCoreDNSComputeTypeDefaults()
+
+			Expect(cfg.FargateProfiles).To(HaveLen(1))
+			Expect(cfg.FargateProfiles[0].Name).To(Equal("fp-default"))
+		})
+
+		It("does not add a Fargate profile when scheduling CoreDNS on EC2", func() {
+			cfg := NewClusterConfig()
+			cfg.CoreDNS = &CoreDNSConfig{ComputeType: CoreDNSComputeTypeEC2}
+			cfg.SetCoreDNSComputeTypeDefaults()
+
+			Expect(cfg.FargateProfiles).To(BeEmpty())
+		})
+
+		It("sets the compute type of the CoreDNS addon", func() {
+			cfg := NewClusterConfig()
+			cfg.CoreDNS = &CoreDNSConfig{ComputeType: CoreDNSComputeTypeFargate}
+			cfg.Addons = []*Addon{{Name: "coredns"}, {Name: "kube-proxy"}}
+			cfg.SetCoreDNSComputeTypeDefaults()
+
+			Expect(cfg.Addons[0].ConfigurationValues).To(MatchJSON(`{"computeType": "Fargate"}`))
+			Expect(cfg.Addons[1].ConfigurationValues).To(BeEmpty())
+		})
+
+		It("is not applied with the defaults of every command", func() {
+			cfg := NewClusterConfig()
+			cfg.CoreDNS = &CoreDNSConfig{ComputeType: CoreDNSComputeTypeFargate}
+			cfg.Addons = []*Addon{{Name: "coredns"}}
+			SetClusterConfigDefaults(cfg)
+
+			Expect(cfg.FargateProfiles).To(BeEmpty())
+			Expect(cfg.Addons[0].ConfigurationValues).To(BeEmpty())
+		})
+
+		It("rejects an unknown compute type", func() {
+			cfg := NewClusterConfig()
+			cfg.CoreDNS = &CoreDNSConfig{ComputeType: "lambda"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("coreDNS.computeType must be one of ec2 or fargate"))
+		})
+
+		DescribeTable("SelectsCoreDNS", func(selector FargateProfileSelector, expected bool) {
+			Expect(SelectsCoreDNS(selector)).To(Equal(expected))
+		},
+			Entry("kube-system namespace", FargateProfileSelector{Namespace: "kube-system"}, true),
+			Entry("CoreDNS pod labels", FargateProfileSelector{Namespace: "kube-system", Labels: map[string]string{"k8s-app": "kube-dns"}}, true),
+			Entry("other labels", FargateProfileSelector{Namespace: "kube-system", Labels: map[string]string{"app": "dns"}}, false),
+			Entry("other namespace", FargateProfileSelector{Namespace: "default"}, false),
+		)
+	})
+
+	DescribeTable("rejects malformed topology spread constraints", func(update func(*corev1.TopologySpreadConstraint), expectedErr string) {
+		constraint := zoneConstraint()
+		update(&constraint)
+		cfg := NewClusterConfig()
+		cfg.CoreDNS = &CoreDNSConfig{
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{zoneConstraint(), constraint},
+		}
+		Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("maxSkew not set", func(c *corev1.TopologySpreadConstraint) { c.MaxSkew = 0 }, "coreDNS.topologySpreadConstraints[1].maxSkew must be greater than 0"),
+		Entry("topologyKey not set", func(c *corev1.TopologySpreadConstraint) { c.TopologyKey = "" }, "coreDNS.topologySpreadConstraints[1].topologyKey must be set"),
+		Entry("unknown whenUnsatisfiable", func(c *corev1.TopologySpreadConstraint) { c.WhenUnsatisfiable = "Never" },
+			"coreDNS.topologySpreadConstraints[1].whenUnsatisfiable must be one of DoNotSchedule or ScheduleAnyway"),
+		Entry("invalid labelSelector", func(c *corev1.TopologySpreadConstraint) {
+			c.LabelSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "k8s-app", Operator: "Matches"}},
+			}
+		}, "coreDNS.topologySpreadConstraints[1].labelSelector is invalid"),
+	)
+})
@@ -42,6 +42,10 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		}
 	}
 
+	if cfg.IAM.PermissionsBoundary != "" {
+		inheritPermissionsBoundary(cfg)
+	}
+
 	if cfg.HasClusterCloudWatchLogging() && cfg.ContainsWildcardCloudWatchLogging() {
 		cfg.CloudWatch.ClusterLogging.EnableTypes = SupportedCloudWatchClusterLogTypes()
 	}
@@ -53,6 +57,83 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 	if cfg.VPC != nil && cfg.VPC.ManageSharedNodeSecurityGroupRules == nil {
 		cfg.VPC.ManageSharedNodeSecurityGroupRules = Enabled()
 	}
+
+	if cfg.CoreDNS != nil {
+		setCoreDNSDefaults(cfg.CoreDNS)
+	}
+
+	if cfg.AWSNode != nil {
+		setAWSNodeDefaults(cfg.AWSNode)
+	}
+
+	if cfg.NodeGroupDefaults != nil {
+		for _, ng := range cfg.NodeGroups {
+			inheritNodeGroupDefaults(ng.NodeGroupBase, cfg.NodeGroupDefaults)
+		}
+		for _, ng := range cfg.ManagedNodeGroups {
+			inheritNodeGroupDefaults(ng.NodeGroupBase, cfg.NodeGroupDefaults)
+		}
+	}
+}
+
+// inheritPermissionsBoundary sets the cluster-wide permissions boundary on the roles that
+// eksctl creates and that don't have a permissions boundary of their own. Roles that are
+// provided by ARN are not created by eksctl and are left alone
+func inheritPermissionsBoundary(cfg *ClusterConfig) {
+	boundary := cfg.IAM.PermissionsBoundary
+	if !IsSetAndNonEmptyString(cfg.IAM.ServiceRoleARN) && !IsSetAndNonEmptyString(cfg.IAM.ServiceRolePermissionsBoundary) {
+		cfg.IAM.ServiceRolePermissionsBoundary = aws.String(boundary)
+	}
+	if !IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) && !IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRolePermissionsBoundary) {
+		cfg.IAM.FargatePodExecutionRolePermissionsBoundary = aws.String(boundary)
+	}
+	for _, sa := range cfg.IAM.ServiceAccounts {
+		if sa.AttachRoleARN == "" && sa.PermissionsBoundary == "" {
+			sa.PermissionsBoundary = boundary
+		}
+	}
+	for _, addon := range cfg.Addons {
+		if addon.ServiceAccountRoleARN == "" && addon.PermissionsBoundary == "" {
+			addon.PermissionsBoundary = boundary
+		}
+	}
+
+	inheritNodeGroup := func(ng *NodeGroupBase) {
+		if ng.IAM == nil {
+			ng.IAM = &NodeGroupIAM{}
+		}
+		if ng.IAM.InstanceRoleARN == "" && ng.IAM.InstanceProfileARN == "" && ng.IAM.InstanceRolePermissionsBoundary == "" {
+			ng.IAM.InstanceRolePermissionsBoundary = boundary
+		}
+	}
+	for _, ng := range cfg.NodeGroups {
+		inheritNodeGroup(ng.NodeGroupBase)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		inheritNodeGroup(ng.NodeGroupBase)
+	}
+}
+
+// inheritNodeGroupDefaults sets the volume fields of a nodegroup that are not set
+// from the cluster-wide defaults. IOPS and throughput are only inherited if the
+// nodegroup uses the default volume type, as they depend on it
+func inheritNodeGroupDefaults(ng *NodeGroupBase, defaults *NodeGroupDefaults) {
+	volumeType := DefaultNodeVolumeType
+	if defaults.VolumeType != nil {
+		volumeType = *defaults.VolumeType
+	}
+	if ng.VolumeType == nil {
+		ng.VolumeType = aws.String(volumeType)
+	} else if *ng.VolumeType != volumeType {
+		return
+	}
+
+	if ng.VolumeIOPS == nil && defaults.VolumeIOPS != nil {
+		ng.VolumeIOPS = aws.Int(*defaults.VolumeIOPS)
+	}
+	if ng.VolumeThroughput == nil && defaults.VolumeThroughput != nil {
+		ng.VolumeThroughput = aws.Int(*defaults.VolumeThroughput)
+	}
 }
 
 // IAMServiceAccountsWithImplicitServiceAccounts adds implicitly created
@@ -70,6 +151,7 @@ func IAMServiceAccountsWithImplicitServiceAccounts(cfg *ClusterConfig) []*Cluste
 				AttachPolicyARNs: []string{
 					fmt.Sprintf("arn:%s:iam::aws:policy/%s", Partition(cfg.Metadata.Region), IAMPolicyAmazonEKSCNIPolicy),
 				},
+				PermissionsBoundary: cfg.IAM.PermissionsBoundary,
 			}
 			serviceAccounts = append(serviceAccounts, &awsNode)
 		}
@@ -110,6 +192,10 @@ func SetNodeGroupDefaults(ng *NodeGroup, meta *ClusterMeta) {
 		ng.SecurityGroups.WithShared = Enabled()
 	}
 
+	if IsEnabled(ng.SpotInterruptionHandler) {
+		ng.Labels[SpotInterruptionHandlerLabel] = "true"
+	}
+
 	setContainerRuntimeDefault(ng)
 }
 
@@ -169,14 +255,26 @@ func setNodeGroupBaseDefaults(ng *NodeGroupBase, meta *ClusterMeta) {
 	if ng.AMIFamily == NodeImageFamilyBottlerocket {
 		setBottlerocketNodeGroupDefaults(ng)
 	}
+	if ng.LocalNVMe != nil {
+		setLocalNVMeDefaults(ng.LocalNVMe)
+	}
+}
+
+func setLocalNVMeDefaults(nvme *LocalNVMe) {
+	if nvme.MountPath == "" {
+		nvme.MountPath = DefaultLocalNVMeMountPath
+	}
+	if nvme.Mode == "" {
+		nvme.Mode = LocalNVMeModeRAID0
+	}
 }
 
 func setVolumeDefaults(ng *NodeGroupBase, template *LaunchTemplate) {
 	if ng.VolumeType == nil {
-		ng.VolumeType = &DefaultNodeVolumeType
+		ng.VolumeType = aws.String(DefaultNodeVolumeType)
 	}
 	if ng.VolumeSize == nil && template == nil {
-		ng.VolumeSize = &DefaultNodeVolumeSize
+		ng.VolumeSize = aws.Int(DefaultNodeVolumeSize)
 	}
 	if *ng.VolumeType == NodeVolumeTypeGP3 {
 		if ng.VolumeIOPS == nil {
@@ -189,6 +287,9 @@ func setVolumeDefaults(ng *NodeGroupBase, template *LaunchTemplate) {
 	if *ng.VolumeType == NodeVolumeTypeIO1 && ng.VolumeIOPS == nil {
 		ng.VolumeIOPS = aws.Int(DefaultNodeVolumeIO1IOPS)
 	}
+	if *ng.VolumeType == NodeVolumeTypeIO2 && ng.VolumeIOPS == nil {
+		ng.VolumeIOPS = aws.Int(DefaultNodeVolumeIO2IOPS)
+	}
 }
 
 func setContainerRuntimeDefault(ng *NodeGroup) {
@@ -1,6 +1,7 @@
 package v1alpha5
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -180,6 +181,26 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Context("Spot interruption handler", func() {
+		It("labels the nodes of nodegroups with the handler enabled", func() {
+			testNodeGroup := NodeGroup{
+				NodeGroupBase: &NodeGroupBase{
+					SpotInterruptionHandler: Enabled(),
+				},
+			}
+			SetNodeGroupDefaults(&testNodeGroup, &ClusterMeta{})
+			Expect(testNodeGroup.Labels).To(HaveKeyWithValue(SpotInterruptionHandlerLabel, "true"))
+		})
+
+		It("does not label the nodes of other nodegroups", func() {
+			testNodeGroup := NodeGroup{
+				NodeGroupBase: &NodeGroupBase{},
+			}
+			SetNodeGroupDefaults(&testNodeGroup, &ClusterMeta{})
+			Expect(testNodeGroup.Labels).NotTo(HaveKey(SpotInterruptionHandlerLabel))
+		})
+	})
+
 	Describe("Cluster Managed Shared Node Security Group settings", func() {
 		var (
 			cfg *ClusterConfig
@@ -212,6 +233,144 @@ var _ = Describe("ClusterConfig validation", func() {
 
 	})
 
+	Describe("nodeGroupDefaults", func() {
+		var (
+			cfg *ClusterConfig
+			ng  *NodeGroup
+			mng *ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Name = "cluster"
+			ng = cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.VolumeType = nil
+			mng = NewManagedNodeGroup()
+			mng.Name = "mng"
+			mng.VolumeType = nil
+			cfg.ManagedNodeGroups = []*ManagedNodeGroup{mng}
+			cfg.NodeGroupDefaults = &NodeGroupDefaults{
+				VolumeType:       aws.String(NodeVolumeTypeGP3),
+				VolumeIOPS:       aws.Int(6000),
+				VolumeThroughput: aws.Int(250),
+			}
+		})
+
+		It("are inherited by nodegroups and managed nodegroups", func() {
+			SetClusterConfigDefaults(cfg)
+			SetNodeGroupDefaults(ng, cfg.Metadata)
+			SetManagedNodeGroupDefaults(mng, cfg.Metadata)
+
+			for _, base := range []*NodeGroupBase{ng.NodeGroupBase, mng.NodeGroupBase} {
+				Expect(*base.VolumeType).To(Equal(NodeVolumeTypeGP3))
+				Expect(*base.VolumeIOPS).To(Equal(6000))
+				Expect(*base.VolumeThroughput).To(Equal(250))
+			}
+		})
+
+		It("are overridden by the nodegroup", func() {
+			ng.VolumeIOPS = aws.Int(4000)
+			SetClusterConfigDefaults(cfg)
+			SetNodeGroupDefaults(ng, cfg.Metadata)
+
+			Expect(*ng.VolumeIOPS).To(Equal(4000))
+			Expect(*ng.VolumeThroughput).To(Equal(250))
+		})
+
+		It("only pass IOPS and throughput on to nodegroups that use the same volume type", func() {
+			ng.VolumeType = aws.String(NodeVolumeTypeIO1)
+			SetClusterConfigDefaults(cfg)
+			SetNodeGroupDefaults(ng, cfg.Metadata)
+
+			Expect(*ng.VolumeType).To(Equal(NodeVolumeTypeIO1))
+			Expect(*ng.VolumeIOPS).To(Equal(DefaultNodeVolumeIO1IOPS))
+			Expect(ng.VolumeThroughput).To(BeNil())
+		})
+
+		It("default to gp3 when only IOPS and throughput are set", func() {
+			cfg.NodeGroupDefaults.VolumeType = nil
+			SetClusterConfigDefaults(cfg)
+			SetNodeGroupDefaults(ng, cfg.Metadata)
+
+			Expect(*ng.VolumeType).To(Equal(NodeVolumeTypeGP3))
+			Expect(*ng.VolumeIOPS).To(Equal(6000))
+		})
+
+		It("are validated", func() {
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.NodeGroupDefaults.VolumeIOPS = aws.Int(MaxGP3Iops + 1)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("value for nodeGroupDefaults.volumeIOPS must be within range 3000-16000"))
+
+			cfg.NodeGroupDefaults.VolumeIOPS = nil
+			cfg.NodeGroupDefaults.VolumeThroughput = aws.Int(MaxThroughput + 1)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("value for nodeGroupDefaults.volumeThroughput must be within range 125-1000"))
+
+			cfg.NodeGroupDefaults.VolumeType = aws.String(NodeVolumeTypeGP2)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("nodeGroupDefaults.volumeThroughput is only supported for gp3 volume type"))
+
+			cfg.NodeGroupDefaults.VolumeType = aws.String("gp1")
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(HavePrefix(`nodeGroupDefaults.volumeType "gp1" is not supported`)))
+		})
+	})
+
+	Describe("iam.permissionsBoundary", func() {
+		const boundary = "arn:aws:iam::123456789012:policy/boundary"
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Name = "cluster"
+			cfg.IAM.PermissionsBoundary = boundary
+			cfg.IAM.WithOIDC = Enabled()
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{
+				{ClusterIAMMeta: ClusterIAMMeta{Name: "s3-reader"}},
+				{ClusterIAMMeta: ClusterIAMMeta{Name: "own-boundary"}, PermissionsBoundary: "arn:aws:iam::123456789012:policy/other"},
+				{ClusterIAMMeta: ClusterIAMMeta{Name: "existing-role"}, AttachRoleARN: "arn:aws:iam::123456789012:role/existing"},
+			}
+			cfg.Addons = []*Addon{{Name: "aws-ebs-csi-driver"}, {Name: "coredns", ServiceAccountRoleARN: "arn:aws:iam::123456789012:role/existing"}}
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng"
+			ng.IAM = nil
+			cfg.ManagedNodeGroups = []*ManagedNodeGroup{NewManagedNodeGroup(), NewManagedNodeGroup()}
+			cfg.ManagedNodeGroups[1].IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/existing"
+		})
+
+		It("is inherited by all the roles created by eksctl", func() {
+			SetClusterConfigDefaults(cfg)
+
+			Expect(*cfg.IAM.ServiceRolePermissionsBoundary).To(Equal(boundary))
+			Expect(*cfg.IAM.FargatePodExecutionRolePermissionsBoundary).To(Equal(boundary))
+			Expect(cfg.IAM.ServiceAccounts[0].PermissionsBoundary).To(Equal(boundary))
+			Expect(cfg.Addons[0].PermissionsBoundary).To(Equal(boundary))
+			Expect(cfg.NodeGroups[0].IAM.InstanceRolePermissionsBoundary).To(Equal(boundary))
+			Expect(cfg.ManagedNodeGroups[0].IAM.InstanceRolePermissionsBoundary).To(Equal(boundary))
+
+			serviceAccounts := IAMServiceAccountsWithImplicitServiceAccounts(cfg)
+			Expect(serviceAccounts[len(serviceAccounts)-1].Name).To(Equal(AWSNodeMeta.Name))
+			Expect(serviceAccounts[len(serviceAccounts)-1].PermissionsBoundary).To(Equal(boundary))
+		})
+
+		It("does not override the permissions boundary of a role", func() {
+			cfg.IAM.ServiceRolePermissionsBoundary = aws.String("arn:aws:iam::123456789012:policy/other")
+			SetClusterConfigDefaults(cfg)
+
+			Expect(*cfg.IAM.ServiceRolePermissionsBoundary).To(Equal("arn:aws:iam::123456789012:policy/other"))
+			Expect(cfg.IAM.ServiceAccounts[1].PermissionsBoundary).To(Equal("arn:aws:iam::123456789012:policy/other"))
+		})
+
+		It("is not set on roles that are not created by eksctl", func() {
+			cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/existing")
+			SetClusterConfigDefaults(cfg)
+
+			Expect(cfg.IAM.ServiceRolePermissionsBoundary).To(BeNil())
+			Expect(cfg.IAM.ServiceAccounts[2].PermissionsBoundary).To(BeEmpty())
+			Expect(cfg.Addons[1].PermissionsBoundary).To(BeEmpty())
+			Expect(cfg.ManagedNodeGroups[1].IAM.InstanceRolePermissionsBoundary).To(BeEmpty())
+		})
+	})
+
 	Describe("ClusterConfig", func() {
 		var cfg *ClusterConfig
 
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -14,9 +16,18 @@ const (
 
 // ClusterIAM holds all IAM attributes of a cluster
 type ClusterIAM struct {
+	// ARN of an existing IAM role used by the control plane, eksctl does not create one when
+	// it is set. See [Using an existing service role](/usage/iam-policies/#using-an-existing-service-role)
 	// +optional
 	ServiceRoleARN *string `json:"serviceRoleARN,omitempty"`
 
+	// ARN of the permissions boundary applied to every IAM role created by eksctl, i.e. the service role,
+	// the fargate pod execution role, nodegroup instance roles, IAM service account and addon roles.
+	// A permissions boundary set for a specific role takes precedence.
+	// See [Permissions Boundary](/usage/iam-permissions-boundary/)
+	// +optional
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+
 	// permissions boundary for all identity-based entities created by eksctl.
 	// See [AWS Permission Boundary](https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies_boundaries.html)
 	// +optional
@@ -31,6 +42,16 @@ type ClusterIAM struct {
 	// +optional
 	FargatePodExecutionRolePermissionsBoundary *string `json:"fargatePodExecutionRolePermissionsBoundary,omitempty"`
 
+	// ARNs of managed policies to attach to the fargate pod execution role created by eksctl,
+	// in addition to `AmazonEKSFargatePodExecutionRolePolicy`. See [EKS Fargate Support](/usage/fargate-support/)
+	// +optional
+	FargatePodExecutionRoleAttachPolicyARNs []string `json:"fargatePodExecutionRoleAttachPolicyARNs,omitempty"`
+
+	// inline policy to attach to the fargate pod execution role created by eksctl.
+	// See [EKS Fargate Support](/usage/fargate-support/)
+	// +optional
+	FargatePodExecutionRoleAttachPolicy InlineDocument `json:"fargatePodExecutionRoleAttachPolicy,omitempty"`
+
 	// enables the IAM OIDC provider as well as IRSA for the Amazon CNI plugin
 	// +optional
 	WithOIDC *bool `json:"withOIDC,omitempty"`
@@ -44,6 +65,106 @@ type ClusterIAM struct {
 	// necessary to run the VPC controller in the control plane
 	// Defaults to `true`
 	VPCResourceControllerPolicy *bool `json:"vpcResourceControllerPolicy,omitempty"`
+
+	// Tags are applied to the IAM OIDC provider and to all IAM roles created by eksctl,
+	// in addition to `metadata.tags`. A tag set here overrides a tag with the same key in `metadata.tags`
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Limits on the tags of IAM resources.
+// See [IAM tagging rules](https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html#id_tags_rules_creating)
+const (
+	maxIAMTags           = 50
+	maxIAMTagKeyLength   = 128
+	maxIAMTagValueLength = 256
+)
+
+// reservedIAMTagCount is the number of tags that eksctl adds to IAM resources itself,
+// i.e. the Name tag, the cluster name tags, the eksctl version tag and the iamserviceaccount name tag
+const reservedIAMTagCount = 5
+
+// IAMTags returns the tags applied to the IAM resources created by eksctl
+func (c *ClusterConfig) IAMTags() map[string]string {
+	tags := map[string]string{}
+	if c.Metadata != nil {
+		for k, v := range c.Metadata.Tags {
+			tags[k] = v
+		}
+	}
+	if c.IAM != nil {
+		for k, v := range c.IAM.Tags {
+			tags[k] = v
+		}
+	}
+	return tags
+}
+
+func (c *ClusterConfig) validateIAMTags() error {
+	tags := c.IAMTags()
+	if len(tags) > maxIAMTags-reservedIAMTagCount {
+		return fmt.Errorf("at most %d tags can be applied to IAM resources as eksctl adds %d tags of its own, got %d tags from metadata.tags and iam.tags",
+			maxIAMTags-reservedIAMTagCount, reservedIAMTagCount, len(tags))
+	}
+	for k, v := range tags {
+		if k == "" || len(k) > maxIAMTagKeyLength {
+			return fmt.Errorf("invalid IAM tag key %q: must be between 1 and %d characters long", k, maxIAMTagKeyLength)
+		}
+		if strings.HasPrefix(strings.ToLower(k), "aws:") {
+			return fmt.Errorf("invalid IAM tag key %q: the aws: prefix is reserved for use by AWS", k)
+		}
+		if len(v) > maxIAMTagValueLength {
+			return fmt.Errorf("invalid value for IAM tag %q: must be at most %d characters long", k, maxIAMTagValueLength)
+		}
+	}
+	return nil
+}
+
+// IAMIdentityMapping maps an IAM role or user to a Kubernetes username and groups,
+// or maps all the IAM users and roles of an account to their ARN
+type IAMIdentityMapping struct {
+	// ARN of the IAM role or user
+	// +optional
+	ARN string `json:"arn,omitempty"`
+
+	// Kubernetes username the IAM role or user is mapped to
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// Kubernetes groups the IAM role or user is mapped to
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+
+	// ID of an account whose IAM users and roles are mapped, cannot be set with the other fields
+	// +optional
+	Account string `json:"account,omitempty"`
+}
+
+// ValidateIAMIdentityMappings validates the mappings of IAM identities in the aws-auth ConfigMap
+func ValidateIAMIdentityMappings(mappings []*IAMIdentityMapping) error {
+	for i, m := range mappings {
+		path := fmt.Sprintf("iamIdentityMappings[%d]", i)
+		if m.Account != "" {
+			if m.ARN != "" || m.Username != "" || len(m.Groups) > 0 {
+				return fmt.Errorf("%s.account cannot be set with %[1]s.arn, %[1]s.username or %[1]s.groups", path)
+			}
+			continue
+		}
+		if m.ARN == "" {
+			return fmt.Errorf("%s.arn or %[1]s.account must be set", path)
+		}
+		parsed, err := arn.Parse(m.ARN)
+		if err != nil {
+			return errors.Wrapf(err, "invalid ARN %q in %s.arn", m.ARN, path)
+		}
+		if parsed.Service != "iam" || !(strings.HasPrefix(parsed.Resource, "role/") || strings.HasPrefix(parsed.Resource, "user/")) {
+			return fmt.Errorf("invalid ARN %q in %s.arn: not an IAM role or user ARN", m.ARN, path)
+		}
+		if m.Username == "" && len(m.Groups) == 0 {
+			return fmt.Errorf("%s.username or %[1]s.groups must be set", path)
+		}
+	}
+	return nil
 }
 
 // ClusterIAMMeta holds information we can use to create ObjectMeta for service
@@ -0,0 +1,66 @@
+package v1alpha5_test
+
+import (
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("IAM tags", func() {
+	It("merges metadata.tags and iam.tags, with iam.tags taking precedence", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Tags = map[string]string{"team": "platform", "env": "dev"}
+		cfg.IAM.Tags = map[string]string{"env": "prod", "cost-center": "42"}
+
+		Expect(cfg.IAMTags()).To(Equal(map[string]string{
+			"team":        "platform",
+			"env":         "prod",
+			"cost-center": "42",
+		}))
+		Expect(cfg.Metadata.Tags).To(HaveKeyWithValue("env", "dev"))
+	})
+
+	It("returns no tags when none are set", func() {
+		Expect(api.NewClusterConfig().IAMTags()).To(BeEmpty())
+	})
+
+	DescribeTable("validates the tags applied to IAM resources", func(tags map[string]string, expectedErr string) {
+		cfg := api.NewClusterConfig()
+		cfg.IAM.Tags = tags
+		err := api.ValidateClusterConfig(cfg)
+		if expectedErr == "" {
+			Expect(err).NotTo(HaveOccurred())
+			return
+		}
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("valid tags", map[string]string{"team": "platform"}, ""),
+		Entry("too many tags", func() map[string]string {
+			tags := map[string]string{}
+			for i := 0; i < 46; i++ {
+				tags[fmt.Sprintf("tag-%d", i)] = "value"
+			}
+			return tags
+		}(), "at most 45 tags can be applied to IAM resources as eksctl adds 5 tags of its own, got 46 tags"),
+		Entry("key too long", map[string]string{strings.Repeat("k", 129): "value"}, "must be between 1 and 128 characters long"),
+		Entry("empty key", map[string]string{"": "value"}, "must be between 1 and 128 characters long"),
+		Entry("reserved prefix", map[string]string{"aws:team": "platform"}, "the aws: prefix is reserved for use by AWS"),
+		Entry("value too long", map[string]string{"team": strings.Repeat("v", 257)}, `invalid value for IAM tag "team": must be at most 256 characters long`),
+	)
+
+	It("counts metadata.tags towards the limit", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Tags = map[string]string{}
+		cfg.IAM.Tags = map[string]string{}
+		for i := 0; i < 23; i++ {
+			cfg.Metadata.Tags[fmt.Sprintf("cluster-tag-%d", i)] = "value"
+			cfg.IAM.Tags[fmt.Sprintf("iam-tag-%d", i)] = "value"
+		}
+		Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("got 46 tags from metadata.tags and iam.tags")))
+	})
+})
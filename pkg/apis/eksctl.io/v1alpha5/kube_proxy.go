@@ -0,0 +1,57 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"sort"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// KubeProxyConfig holds the configuration of the kube-proxy daemonset
+type KubeProxyConfig struct {
+	// PodLabels are added to the labels of the kube-proxy pods, e.g. for service mesh injection.
+	// They are preserved when kube-proxy is updated. `k8s-app` selects the kube-proxy pods and cannot be set
+	// +optional
+	PodLabels map[string]string `json:"podLabels,omitempty"`
+
+	// PodAnnotations are added to the annotations of the kube-proxy pods. They are preserved when kube-proxy is updated
+	// +optional
+	PodAnnotations map[string]string `json:"podAnnotations,omitempty"`
+}
+
+// KubeProxyPodLabels are the labels of the kube-proxy pods
+var KubeProxyPodLabels = map[string]string{
+	"k8s-app": "kube-proxy",
+}
+
+// Validate validates the kube-proxy configuration
+func (k *KubeProxyConfig) Validate() error {
+	if k == nil {
+		return nil
+	}
+	return validatePodMetadata("kubeProxy", k.PodLabels, k.PodAnnotations, KubeProxyPodLabels)
+}
+
+// validatePodMetadata validates the labels and annotations set on the pods of a default add-on, whose
+// selector labels cannot be changed as they select the pods of its deployment or daemonset
+func validatePodMetadata(path string, labels, annotations, selectorLabels map[string]string) error {
+	if errs := metav1validation.ValidateLabels(labels, field.NewPath(path, "podLabels")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	if errs := apivalidation.ValidateAnnotations(annotations, field.NewPath(path, "podAnnotations")); len(errs) > 0 {
+		return errs.ToAggregate()
+	}
+	var keys []string
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, ok := selectorLabels[key]; ok {
+			return fmt.Errorf("%s.podLabels cannot set %q, as it selects the pods of the add-on", path, key)
+		}
+	}
+	return nil
+}
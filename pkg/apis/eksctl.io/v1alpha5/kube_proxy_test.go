@@ -0,0 +1,43 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pod labels and annotations of the default add-ons", func() {
+	It("accepts valid labels and annotations", func() {
+		cfg := NewClusterConfig()
+		cfg.CoreDNS = &CoreDNSConfig{
+			PodLabels:      map[string]string{"sidecar.istio.io/inject": "true"},
+			PodAnnotations: map[string]string{"example.com/policy": "allow dns from all namespaces"},
+		}
+		cfg.KubeProxy = &KubeProxyConfig{
+			PodLabels: map[string]string{"team": "platform"},
+		}
+		Expect(ValidateClusterConfig(cfg)).To(Succeed())
+	})
+
+	DescribeTable("rejects invalid labels and annotations", func(update func(*ClusterConfig), expectedErr string) {
+		cfg := NewClusterConfig()
+		update(cfg)
+		Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("invalid label key", func(cfg *ClusterConfig) {
+			cfg.KubeProxy = &KubeProxyConfig{PodLabels: map[string]string{"mesh/inject/sidecar": "true"}}
+		}, `kubeProxy.podLabels: Invalid value: "mesh/inject/sidecar"`),
+		Entry("invalid label value", func(cfg *ClusterConfig) {
+			cfg.CoreDNS = &CoreDNSConfig{PodLabels: map[string]string{"mesh": "not a value"}}
+		}, `coreDNS.podLabels: Invalid value: "not a value"`),
+		Entry("invalid annotation key", func(cfg *ClusterConfig) {
+			cfg.CoreDNS = &CoreDNSConfig{PodAnnotations: map[string]string{"example.com/": "dns"}}
+		}, `coreDNS.podAnnotations: Invalid value: "example.com/"`),
+		Entry("CoreDNS selector label", func(cfg *ClusterConfig) {
+			cfg.CoreDNS = &CoreDNSConfig{PodLabels: map[string]string{"k8s-app": "dns"}}
+		}, `coreDNS.podLabels cannot set "k8s-app", as it selects the pods of the add-on`),
+		Entry("kube-proxy selector label", func(cfg *ClusterConfig) {
+			cfg.KubeProxy = &KubeProxyConfig{PodLabels: map[string]string{"k8s-app": "kube-proxy"}}
+		}, `kubeProxy.podLabels cannot set "k8s-app", as it selects the pods of the add-on`),
+	)
+})
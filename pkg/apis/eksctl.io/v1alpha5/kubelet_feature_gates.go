@@ -0,0 +1,112 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// kubeletFeatureGate holds the Kubernetes versions a kubelet feature gate was added and removed in
+type kubeletFeatureGate struct {
+	added   string
+	removed string
+}
+
+// knownKubeletFeatureGates are the feature gates of the kubelet that nodegroups are commonly
+// created with, the kubelet refuses to start with a feature gate it does not know
+var knownKubeletFeatureGates = map[string]kubeletFeatureGate{
+	"CPUManager":                                     {added: "1.8"},
+	"CPUManagerPolicyOptions":                        {added: "1.22"},
+	"CSIMigration":                                   {added: "1.14"},
+	"DisableCloudProviders":                          {added: "1.22"},
+	"DownwardAPIHugePages":                           {added: "1.20"},
+	"DynamicKubeletConfig":                           {added: "1.4", removed: "1.24"},
+	"EphemeralContainers":                            {added: "1.16"},
+	"ExecProbeTimeout":                               {added: "1.20"},
+	"GracefulNodeShutdown":                           {added: "1.20"},
+	"GracefulNodeShutdownBasedOnPodPriority":         {added: "1.23"},
+	"InPlacePodVerticalScaling":                      {added: "1.27"},
+	"IPv6DualStack":                                  {added: "1.15", removed: "1.25"},
+	"KubeletCredentialProviders":                     {added: "1.20"},
+	"KubeletInUserNamespace":                         {added: "1.22"},
+	"KubeletPodResources":                            {added: "1.13"},
+	"KubeletPodResourcesGetAllocatable":              {added: "1.21"},
+	"LocalStorageCapacityIsolation":                  {added: "1.7"},
+	"LocalStorageCapacityIsolationFSQuotaMonitoring": {added: "1.15"},
+	"MemoryManager":                                  {added: "1.21"},
+	"NodeSwap":                                       {added: "1.22"},
+	"PodAndContainerStatsFromCRI":                    {added: "1.23"},
+	"ProbeTerminationGracePeriod":                    {added: "1.21"},
+	"RotateKubeletServerCertificate":                 {added: "1.7"},
+	"SeccompDefault":                                 {added: "1.22"},
+	"SidecarContainers":                              {added: "1.28"},
+	"SizeMemoryBackedVolumes":                        {added: "1.20"},
+	"TopologyManager":                                {added: "1.16"},
+}
+
+var kubeletFeatureGateName = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
+func validateKubeletFeatureGates(ng *NodeGroup, path string) error {
+	if len(ng.KubeletFeatureGates) == 0 {
+		return nil
+	}
+	for _, gate := range sortedKubeletFeatureGates(ng.KubeletFeatureGates) {
+		if !kubeletFeatureGateName.MatchString(gate) {
+			return fmt.Errorf("invalid feature gate %q in %s.kubeletFeatureGates, feature gate names are in UpperCamelCase", gate, path)
+		}
+	}
+	if ng.KubeletExtraConfig == nil {
+		return nil
+	}
+	extraFeatureGates, ok := (*ng.KubeletExtraConfig)["featureGates"]
+	if !ok {
+		return nil
+	}
+	extraGates, ok := extraFeatureGates.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("%s.kubeletExtraConfig.featureGates must be a map of feature gates to booleans to be merged with %s.kubeletFeatureGates", path, path)
+	}
+	for _, gate := range sortedKubeletFeatureGates(ng.KubeletFeatureGates) {
+		if enabled, ok := extraGates[gate]; ok && enabled != ng.KubeletFeatureGates[gate] {
+			return fmt.Errorf("%s.kubeletFeatureGates sets %s to %t but %s.kubeletExtraConfig sets it to %v", path, gate, ng.KubeletFeatureGates[gate], path, enabled)
+		}
+	}
+	return nil
+}
+
+// UnknownKubeletFeatureGates returns the feature gates that are not known to exist in the kubelet of the given
+// Kubernetes version, the list of known feature gates is not exhaustive
+func UnknownKubeletFeatureGates(featureGates map[string]bool, version string) []string {
+	var unknown []string
+	for _, gate := range sortedKubeletFeatureGates(featureGates) {
+		known, ok := knownKubeletFeatureGates[gate]
+		if !ok {
+			unknown = append(unknown, gate)
+			continue
+		}
+		if version == "" || version == "auto" {
+			continue
+		}
+		if added, err := utils.IsMinVersion(known.added, version); err == nil && !added {
+			unknown = append(unknown, gate)
+			continue
+		}
+		if known.removed != "" {
+			if removed, err := utils.IsMinVersion(known.removed, version); err == nil && removed {
+				unknown = append(unknown, gate)
+			}
+		}
+	}
+	return unknown
+}
+
+func sortedKubeletFeatureGates(featureGates map[string]bool) []string {
+	var gates []string
+	for gate := range featureGates {
+		gates = append(gates, gate)
+	}
+	sort.Strings(gates)
+	return gates
+}
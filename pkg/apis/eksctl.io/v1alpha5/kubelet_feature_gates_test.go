@@ -0,0 +1,20 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UnknownKubeletFeatureGates", func() {
+	DescribeTable("returns the feature gates the kubelet of a version is not known to have", func(featureGates map[string]bool, version string, unknown []string) {
+		Expect(UnknownKubeletFeatureGates(featureGates, version)).To(Equal(unknown))
+	},
+		Entry("known feature gates", map[string]bool{"CPUManager": true, "MemoryManager": false}, "1.21", nil),
+		Entry("feature gate added in a later version", map[string]bool{"InPlacePodVerticalScaling": true, "CPUManager": true}, "1.21", []string{"InPlacePodVerticalScaling"}),
+		Entry("feature gate added in the version", map[string]bool{"InPlacePodVerticalScaling": true}, "1.27", nil),
+		Entry("removed feature gate", map[string]bool{"DynamicKubeletConfig": true}, "1.24", []string{"DynamicKubeletConfig"}),
+		Entry("unknown feature gates", map[string]bool{"UnknownGate": true, "AnotherGate": false}, "1.21", []string{"AnotherGate", "UnknownGate"}),
+		Entry("unknown version", map[string]bool{"InPlacePodVerticalScaling": true, "UnknownGate": true}, "", []string{"UnknownGate"}),
+	)
+})
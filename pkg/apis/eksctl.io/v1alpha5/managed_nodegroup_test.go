@@ -137,7 +137,7 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 		err := ValidateManagedNodeGroup(mng, 0)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("cannot set instanceType, ami, ssh.allow, ssh.enableSSM, ssh.sourceSecurityGroupIds, securityGroups, " +
-			"volumeSize, instanceName, instancePrefix, maxPodsPerNode, disableIMDSv1, disablePodIMDS, preBootstrapCommands, overrideBootstrapCommand, placement in managedNodeGroup when a launch template is supplied"))
+			"volumeSize, instanceName, instancePrefix, maxPodsPerNode, disableIMDSv1, disablePodIMDS, preBootstrapCommands, overrideBootstrapCommand, placement, tenancy, hostResourceGroupARN, cpuCredits, prefixDelegation, efsMounts in managedNodeGroup when a launch template is supplied"))
 	},
 		Entry("instanceType", &NodeGroupBase{
 			InstanceType: "m5.xlarge",
@@ -164,6 +164,18 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 				AttachIDs: []string{"sg-custom"},
 			},
 		}),
+		Entry("tenancy", &NodeGroupBase{
+			Tenancy: TenancyDedicated,
+		}),
+		Entry("cpuCredits", &NodeGroupBase{
+			CPUCredits: aws.String(CPUCreditsStandard),
+		}),
+		Entry("prefixDelegation", &NodeGroupBase{
+			PrefixDelegation: Enabled(),
+		}),
+		Entry("efsMounts", &NodeGroupBase{
+			EFSMounts: []EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}},
+		}),
 	)
 
 	type updateConfigEntry struct {
@@ -212,6 +224,22 @@ var _ = Describe("Managed Nodegroup Validation", func() {
 			maxSize:     aws.Int(5),
 			valid:       false,
 		}),
+		Entry("returns an error if max unavailable is less than 1", updateConfigEntry{
+			unavailable: aws.Int(0),
+			valid:       false,
+		}),
+		Entry("max unavailable percentage set to 100", updateConfigEntry{
+			unavailablePercentage: aws.Int(100),
+			valid:                 true,
+		}),
+		Entry("returns an error if max unavailable percentage is less than 1", updateConfigEntry{
+			unavailablePercentage: aws.Int(0),
+			valid:                 false,
+		}),
+		Entry("returns an error if max unavailable percentage is greater than 100", updateConfigEntry{
+			unavailablePercentage: aws.Int(101),
+			valid:                 false,
+		}),
 		Entry("returns an error if both maxUnavailable and maxUnavailablePercentage are not set", updateConfigEntry{
 			valid: false,
 		}),
@@ -0,0 +1,112 @@
+package v1alpha5
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// MetricsServerAddon is the name of the metrics-server EKS addon
+const MetricsServerAddon = "metrics-server"
+
+// DefaultMetricsServerHAReplicas is the number of metrics-server replicas used in
+// high-availability mode when replicas is not set
+const DefaultMetricsServerHAReplicas = 2
+
+// metricsServerValueKeys are the configuration values of the metrics-server addon set from MetricsServerConfig
+var metricsServerValueKeys = []string{"replicas", "affinity", "podDisruptionBudget"}
+
+// MetricsServerConfig holds the configuration of the metrics-server addon
+type MetricsServerConfig struct {
+	// Replicas is the number of metrics-server replicas
+	// +optional
+	Replicas *int `json:"replicas,omitempty"`
+
+	// HighAvailability spreads the metrics-server replicas across nodes with pod
+	// anti-affinity and protects them with a PodDisruptionBudget. It runs
+	// 2 replicas unless `replicas` is set
+	// +optional
+	HighAvailability *bool `json:"highAvailability,omitempty"`
+}
+
+// Validate validates the metrics-server configuration against the addons of the cluster
+func (c *MetricsServerConfig) Validate(addons []*Addon) error {
+	if c == nil {
+		return nil
+	}
+	if c.Replicas != nil {
+		if *c.Replicas < 1 {
+			return fmt.Errorf("metricsServer.replicas must be greater than 0")
+		}
+		if IsEnabled(c.HighAvailability) && *c.Replicas < 2 {
+			return fmt.Errorf("metricsServer.replicas must be at least 2 when metricsServer.highAvailability is enabled")
+		}
+	}
+
+	for i, addon := range addons {
+		if addon.CanonicalName() != MetricsServerAddon {
+			continue
+		}
+		if addon.ConfigurationValues == "" {
+			return nil
+		}
+		values, err := parseConfigurationValues(addon.ConfigurationValues)
+		if err != nil {
+			return fmt.Errorf("addons[%d].%w", i, err)
+		}
+		for _, key := range metricsServerValueKeys {
+			if _, ok := values[key]; ok {
+				return fmt.Errorf("addons[%d].configurationValues cannot set %q when metricsServer is set", i, key)
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("metricsServer is set but the %s addon is not in addons", MetricsServerAddon)
+}
+
+// ConfigurationValues returns the configuration values of the metrics-server addon,
+// merging the replicas and high-availability settings into values
+func (c *MetricsServerConfig) ConfigurationValues(values string) (string, error) {
+	if c == nil {
+		return values, nil
+	}
+	merged := map[string]interface{}{}
+	if values != "" {
+		var err error
+		if merged, err = parseConfigurationValues(values); err != nil {
+			return "", err
+		}
+	}
+
+	if c.Replicas != nil {
+		merged["replicas"] = *c.Replicas
+	}
+	if IsEnabled(c.HighAvailability) {
+		if c.Replicas == nil {
+			merged["replicas"] = DefaultMetricsServerHAReplicas
+		}
+		merged["affinity"] = map[string]interface{}{
+			"podAntiAffinity": map[string]interface{}{
+				"requiredDuringSchedulingIgnoredDuringExecution": []interface{}{
+					map[string]interface{}{
+						"labelSelector": map[string]interface{}{
+							"matchLabels": map[string]interface{}{
+								"app.kubernetes.io/name": MetricsServerAddon,
+							},
+						},
+						"topologyKey": "kubernetes.io/hostname",
+					},
+				},
+			},
+		}
+		merged["podDisruptionBudget"] = map[string]interface{}{
+			"enabled":      true,
+			"minAvailable": 1,
+		}
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
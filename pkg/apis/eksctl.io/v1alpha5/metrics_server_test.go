@@ -0,0 +1,82 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MetricsServer", func() {
+	intPtr := func(i int) *int { return &i }
+
+	DescribeTable("configuration values", func(c *MetricsServerConfig, values, expected string) {
+		merged, err := c.ConfigurationValues(values)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(merged).To(MatchJSON(expected))
+	},
+		Entry("replicas", &MetricsServerConfig{Replicas: intPtr(3)}, "", `{"replicas": 3}`),
+		Entry("high availability with the default replicas", &MetricsServerConfig{HighAvailability: Enabled()}, "", `{
+			"affinity": {
+				"podAntiAffinity": {
+					"requiredDuringSchedulingIgnoredDuringExecution": [{
+						"labelSelector": {"matchLabels": {"app.kubernetes.io/name": "metrics-server"}},
+						"topologyKey": "kubernetes.io/hostname"
+					}]
+				}
+			},
+			"podDisruptionBudget": {"enabled": true, "minAvailable": 1},
+			"replicas": 2
+		}`),
+		Entry("high availability with replicas and other configuration values", &MetricsServerConfig{Replicas: intPtr(3), HighAvailability: Enabled()}, "resources:\n  limits:\n    memory: 200Mi\n", `{
+			"affinity": {
+				"podAntiAffinity": {
+					"requiredDuringSchedulingIgnoredDuringExecution": [{
+						"labelSelector": {"matchLabels": {"app.kubernetes.io/name": "metrics-server"}},
+						"topologyKey": "kubernetes.io/hostname"
+					}]
+				}
+			},
+			"podDisruptionBudget": {"enabled": true, "minAvailable": 1},
+			"replicas": 3,
+			"resources": {"limits": {"memory": "200Mi"}}
+		}`),
+		Entry("high availability disabled", &MetricsServerConfig{HighAvailability: Disabled()}, "", `{}`),
+	)
+
+	It("returns the configuration values unchanged when metricsServer is not set", func() {
+		var c *MetricsServerConfig
+		Expect(c.ConfigurationValues(`{"replicas": 1}`)).To(Equal(`{"replicas": 1}`))
+	})
+
+	Describe("validation", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Addons = []*Addon{{Name: "metrics-server"}}
+			cfg.MetricsServer = &MetricsServerConfig{Replicas: intPtr(2), HighAvailability: Enabled()}
+		})
+
+		It("accepts replicas and high availability", func() {
+			Expect(cfg.MetricsServer.Validate(cfg.Addons)).To(Succeed())
+		})
+
+		It("rejects invalid replicas", func() {
+			cfg.MetricsServer.Replicas = intPtr(0)
+			Expect(cfg.MetricsServer.Validate(cfg.Addons)).To(MatchError("metricsServer.replicas must be greater than 0"))
+
+			cfg.MetricsServer.Replicas = intPtr(1)
+			Expect(cfg.MetricsServer.Validate(cfg.Addons)).To(MatchError("metricsServer.replicas must be at least 2 when metricsServer.highAvailability is enabled"))
+		})
+
+		It("requires the metrics-server addon", func() {
+			cfg.Addons = []*Addon{{Name: "coredns"}}
+			Expect(cfg.MetricsServer.Validate(cfg.Addons)).To(MatchError("metricsServer is set but the metrics-server addon is not in addons"))
+		})
+
+		It("rejects configuration values setting the same options", func() {
+			cfg.Addons[0].ConfigurationValues = `{"replicas": 4}`
+			Expect(cfg.MetricsServer.Validate(cfg.Addons)).To(MatchError(`addons[0].configurationValues cannot set "replicas" when metricsServer is set`))
+		})
+	})
+})
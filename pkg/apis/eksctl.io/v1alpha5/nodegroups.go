@@ -1,5 +1,12 @@
 package v1alpha5
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
 // HasInstanceType returns whether some node in the group fulfils the type check
 func HasInstanceType(nodeGroup *NodeGroup, hasType func(string) bool) bool {
 	if hasType(nodeGroup.InstanceType) {
@@ -78,3 +85,127 @@ func (c *ClusterConfig) AllNodeGroups() []*NodeGroupBase {
 	}
 	return baseNodeGroups
 }
+
+// HasPrefixDelegation returns true if any nodegroup enables prefix delegation
+func (c *ClusterConfig) HasPrefixDelegation() bool {
+	for _, ng := range c.AllNodeGroups() {
+		if IsEnabled(ng.PrefixDelegation) {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpandNodeGroupArchitectures replaces the nodegroups and managed nodegroups that set `architectures`
+// with a nodegroup per architecture, each with the instance types of that architecture
+func ExpandNodeGroupArchitectures(cfg *ClusterConfig) error {
+	var nodeGroups []*NodeGroup
+	for i, ng := range cfg.NodeGroups {
+		if len(ng.Architectures) == 0 {
+			nodeGroups = append(nodeGroups, ng)
+			continue
+		}
+		path := fmt.Sprintf("nodeGroups[%d].architectures", i)
+		instanceTypes, err := instanceTypesByArchitecture(path, ng.NodeGroupBase, ng.InstanceTypeList())
+		if err != nil {
+			return err
+		}
+		for _, arch := range ng.Architectures {
+			archNG := ng.DeepCopy()
+			setArchitectureNodeGroup(archNG.NodeGroupBase, ng.Name, arch)
+			if HasMixedInstances(archNG) {
+				archNG.InstancesDistribution.InstanceTypes = instanceTypes[arch]
+			} else {
+				archNG.InstanceType = instanceTypes[arch][0]
+			}
+			nodeGroups = append(nodeGroups, archNG)
+		}
+	}
+	cfg.NodeGroups = nodeGroups
+
+	var managedNodeGroups []*ManagedNodeGroup
+	for i, ng := range cfg.ManagedNodeGroups {
+		if len(ng.Architectures) == 0 {
+			managedNodeGroups = append(managedNodeGroups, ng)
+			continue
+		}
+		path := fmt.Sprintf("managedNodeGroups[%d].architectures", i)
+		instanceTypes, err := instanceTypesByArchitecture(path, ng.NodeGroupBase, ng.InstanceTypeList())
+		if err != nil {
+			return err
+		}
+		for _, arch := range ng.Architectures {
+			archNG := ng.DeepCopy()
+			setArchitectureNodeGroup(archNG.NodeGroupBase, ng.Name, arch)
+			if len(archNG.InstanceTypes) > 0 {
+				archNG.InstanceTypes = instanceTypes[arch]
+			} else {
+				archNG.InstanceType = instanceTypes[arch][0]
+			}
+			managedNodeGroups = append(managedNodeGroups, archNG)
+		}
+	}
+	cfg.ManagedNodeGroups = managedNodeGroups
+	return nil
+}
+
+// instanceTypesByArchitecture groups the instance types of a nodegroup by the architectures it sets,
+// every architecture must have at least one instance type, and every instance type an architecture
+func instanceTypesByArchitecture(path string, ng *NodeGroupBase, instanceTypeList []string) (map[string][]string, error) {
+	if ng.Name == "" {
+		return nil, fmt.Errorf("%s requires the nodegroup name to be set", path)
+	}
+	if ng.AMI != "" && ng.AMI != NodeImageResolverAuto && ng.AMI != NodeImageResolverAutoSSM {
+		return nil, fmt.Errorf("%s cannot be used with a custom AMI, as the AMI is selected per architecture", path)
+	}
+	if ng.InstanceSelector != nil && !ng.InstanceSelector.IsZero() {
+		return nil, fmt.Errorf("%s cannot be used with instanceSelector", path)
+	}
+
+	instanceTypes := map[string][]string{}
+	for _, arch := range ng.Architectures {
+		if arch != ArchitectureX8664 && arch != ArchitectureARM64 {
+			return nil, fmt.Errorf("%s: unsupported architecture %q, must be one of %s, %s", path, arch, ArchitectureX8664, ArchitectureARM64)
+		}
+		if _, ok := instanceTypes[arch]; ok {
+			return nil, fmt.Errorf("%s: duplicate architecture %q", path, arch)
+		}
+		instanceTypes[arch] = nil
+	}
+	for _, instanceType := range instanceTypeList {
+		if instanceType == "" {
+			continue
+		}
+		arch := instanceArchitecture(instanceType)
+		if _, ok := instanceTypes[arch]; !ok {
+			return nil, fmt.Errorf("%s: instance type %q is %s, which is not one of the architectures", path, instanceType, arch)
+		}
+		instanceTypes[arch] = append(instanceTypes[arch], instanceType)
+	}
+	for _, arch := range ng.Architectures {
+		if len(instanceTypes[arch]) == 0 {
+			return nil, fmt.Errorf("%s: no instance types of architecture %q are set", path, arch)
+		}
+	}
+	return instanceTypes, nil
+}
+
+func instanceArchitecture(instanceType string) string {
+	if utils.IsARMInstanceType(instanceType) {
+		return ArchitectureARM64
+	}
+	return ArchitectureX8664
+}
+
+func setArchitectureNodeGroup(ng *NodeGroupBase, logicalName, arch string) {
+	ng.Name = fmt.Sprintf("%s-%s", logicalName, strings.ReplaceAll(arch, "_", "-"))
+	ng.Architectures = nil
+	if ng.Labels == nil {
+		ng.Labels = map[string]string{}
+	}
+	ng.Labels[LogicalNodeGroupNameLabel] = logicalName
+	if ng.Tags == nil {
+		ng.Tags = map[string]string{}
+	}
+	ng.Tags[LogicalNodeGroupNameLabel] = logicalName
+}
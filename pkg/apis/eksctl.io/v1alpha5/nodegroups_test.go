@@ -0,0 +1,90 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("nodegroup architectures", func() {
+	var cfg *ClusterConfig
+
+	BeforeEach(func() {
+		cfg = NewClusterConfig()
+	})
+
+	It("expands a nodegroup into a nodegroup per architecture with shared labels and taints", func() {
+		ng := NewNodeGroup()
+		ng.Name = "workers"
+		ng.Architectures = []string{ArchitectureX8664, ArchitectureARM64}
+		ng.InstancesDistribution = &NodeGroupInstancesDistribution{
+			InstanceTypes: []string{"m5.large", "m6g.large", "c5.large", "c6g.large"},
+		}
+		ng.Labels = map[string]string{"role": "worker"}
+		ng.Taints = []NodeGroupTaint{{Key: "dedicated", Value: "worker", Effect: "NoSchedule"}}
+		unchanged := NewNodeGroup()
+		unchanged.Name = "other"
+		cfg.NodeGroups = []*NodeGroup{ng, unchanged}
+
+		Expect(ExpandNodeGroupArchitectures(cfg)).To(Succeed())
+		Expect(cfg.NodeGroups).To(HaveLen(3))
+
+		x86, arm, other := cfg.NodeGroups[0], cfg.NodeGroups[1], cfg.NodeGroups[2]
+		Expect(x86.Name).To(Equal("workers-x86-64"))
+		Expect(x86.InstancesDistribution.InstanceTypes).To(Equal([]string{"m5.large", "c5.large"}))
+		Expect(arm.Name).To(Equal("workers-arm64"))
+		Expect(arm.InstancesDistribution.InstanceTypes).To(Equal([]string{"m6g.large", "c6g.large"}))
+		for _, archNG := range []*NodeGroup{x86, arm} {
+			Expect(archNG.Architectures).To(BeEmpty())
+			Expect(archNG.Labels).To(Equal(map[string]string{"role": "worker", LogicalNodeGroupNameLabel: "workers"}))
+			Expect(archNG.Tags).To(HaveKeyWithValue(LogicalNodeGroupNameLabel, "workers"))
+			Expect(archNG.Taints).To(Equal(ng.Taints))
+		}
+		Expect(other).To(BeIdenticalTo(unchanged))
+		Expect(ng.Labels).NotTo(HaveKey(LogicalNodeGroupNameLabel))
+	})
+
+	It("expands a managed nodegroup into a nodegroup per architecture", func() {
+		ng := NewManagedNodeGroup()
+		ng.Name = "managed"
+		ng.Architectures = []string{ArchitectureARM64, ArchitectureX8664}
+		ng.InstanceTypes = []string{"t3.large", "t4g.large"}
+		cfg.ManagedNodeGroups = []*ManagedNodeGroup{ng}
+
+		Expect(ExpandNodeGroupArchitectures(cfg)).To(Succeed())
+		Expect(cfg.ManagedNodeGroups).To(HaveLen(2))
+		Expect(cfg.ManagedNodeGroups[0].Name).To(Equal("managed-arm64"))
+		Expect(cfg.ManagedNodeGroups[0].InstanceTypes).To(Equal([]string{"t4g.large"}))
+		Expect(cfg.ManagedNodeGroups[1].Name).To(Equal("managed-x86-64"))
+		Expect(cfg.ManagedNodeGroups[1].InstanceTypes).To(Equal([]string{"t3.large"}))
+	})
+
+	DescribeTable("rejects invalid architectures", func(update func(*NodeGroup), errMsg string) {
+		ng := NewNodeGroup()
+		ng.Name = "workers"
+		ng.Architectures = []string{ArchitectureX8664, ArchitectureARM64}
+		ng.InstancesDistribution = &NodeGroupInstancesDistribution{
+			InstanceTypes: []string{"m5.large", "m6g.large"},
+		}
+		update(ng)
+		cfg.NodeGroups = []*NodeGroup{ng}
+		Expect(ExpandNodeGroupArchitectures(cfg)).To(MatchError(errMsg))
+	},
+		Entry("unsupported architecture", func(ng *NodeGroup) {
+			ng.Architectures = []string{"riscv64"}
+		}, `nodeGroups[0].architectures: unsupported architecture "riscv64", must be one of x86_64, arm64`),
+		Entry("duplicate architecture", func(ng *NodeGroup) {
+			ng.Architectures = []string{ArchitectureARM64, ArchitectureARM64}
+		}, `nodeGroups[0].architectures: duplicate architecture "arm64"`),
+		Entry("instance type of another architecture", func(ng *NodeGroup) {
+			ng.Architectures = []string{ArchitectureX8664}
+		}, `nodeGroups[0].architectures: instance type "m6g.large" is arm64, which is not one of the architectures`),
+		Entry("architecture without instance types", func(ng *NodeGroup) {
+			ng.InstancesDistribution = nil
+			ng.InstanceType = "m5.large"
+		}, `nodeGroups[0].architectures: no instance types of architecture "arm64" are set`),
+		Entry("custom AMI", func(ng *NodeGroup) {
+			ng.AMI = "ami-123"
+		}, "nodeGroups[0].architectures cannot be used with a custom AMI, as the AMI is selected per architecture"),
+	)
+})
@@ -1,6 +1,6 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// assets/schema.json (87.655kB)
+// assets/schema.json (162.915kB)
 
 package v1alpha5
 
@@ -69,7 +69,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _schemaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x73\x1b\x37\xf2\xe0\xff\xfb\x29\x50\xcc\xd6\x6f\xed\x2d\x8e\x68\x39\xbb\xd9\x6c\x36\xa7\x3a\x5a\x52\x1c\x9e\x23\x89\x27\xca\xc9\x5d\x24\xd7\x1a\x9c\x01\x49\xac\x86\xc0\x2c\x80\x91\xc4\xfc\x9c\xfb\xec\x57\x78\xcd\x13\x33\x9c\x97\x6c\x6d\x95\xfe\xb2\x3c\x9c\x69\x34\x1a\x8d\x7e\xa1\xbb\xf1\xdf\x7f\x00\x60\xf4\x47\x86\x56\xa3\xef\xc0\xe8\xab\x49\x80\x56\x98\x60\x81\x29\xe1\x93\xe3\x30\xe6\x02\xb1\x63\x4a\x56\x78\x3d\x1a\xcb\x17\xc5\x2e\x42\xf2\x45\xba\xfc\x17\xf2\x85\x7e\xf6\x47\xee\x6f\xd0\x16\xca\xc7\x1b\x21\xa2\xef\x26\x93\x7f\x71\x4a\x3c\xfd\xf4\x80\xb2\xf5\x24\x60\x70\x25\xbc\x57\x7f\x9b\xe8\x67\x5f\xe9\xef\x32\x43\x8d\xbe\x03\x12\x0f\x00\x46\xd3\x5f\x17\xf1\x92\x20\x71\x06\xa3\x08\x93\x75\xf2\x03\x00\x23\x18\x04\xea\x6d\x18\xce\x19\x8d\x10\x13\x18\xf1\xcc\xef\x95\xd3\xb0\x20\x17\x11\xf2\x47\xe6\xe5\xdf\xc7\x16\xaa\x63\x46\xea\x79\x80\xb8\xcf\x70\x24\x41\xa8\x99\xd1\x30\xe0\x80\x2b\x40\x40\x50\x30\xfd\x15\x6c\x35\x8a\xfc\x00\xcc\x56\x40\x6c\x10\xb8\x45\x3b\x80\x39\x80\x04\x4c\x7f\x1d\x03\xb1\x81\x02\xc0\x90\x53\xb0\x44\x3e\xdd\x22\xae\xde\x21\x70\x8b\x00\xd5\xef\x1b\x68\x54\x6c\x10\xbb\xc7\x1c\x81\x98\xa3\x04\x90\xa0\x80\xa1\x15\x62\xf2\x0f\xb1\xc1\x76\xec\x83\x14\xc3\x07\x0f\x13\x81\xc2\x10\xff\xcb\xdb\x88\x6d\xe8\x3d\x7d\x8c\x03\xb4\x82\x71\x28\x24\x76\xff\xfd\xbb\x5e\x0a\xb3\x10\xa3\xdc\x22\x65\x16\x3d\xaa\x58\x6a\xf8\x5b\xee\xff\x99\x85\xe4\x82\x49\xc6\x19\x67\x7f\x2b\x90\xc6\x87\x04\x2c\x11\xa0\x5b\x2c\x04\x0a\x00\x2e\x13\x23\xff\xf9\x1e\x4a\x37\x00\x97\x40\xfb\x3d\x05\x3c\xf2\x71\xc0\x8a\xb3\x70\xb3\xf0\x1a\x8b\x4d\xbc\x3c\xf0\xe9\xf6\xd3\x3d\x82\x77\xe8\x9e\xb2\x5b\xfe\x09\xdd\x72\x5f\x84\x9f\xa2\xdb\xf5\xa7\x58\xe0\x90\x7f\xc2\x91\xa4\xf7\x6c\x7e\x8e\x84\x7b\x44\x1c\xec\xa1\x5a\xfa\x55\x69\xa3\x44\x6a\x71\x19\x0a\x2e\x58\x80\x24\xde\xd7\x39\xb8\xe3\xdc\xda\x14\x67\x69\xfe\xfb\x61\xbc\x67\x33\xaf\x60\xc8\x51\x9e\x31\x82\x40\x51\x39\xe1\x08\x86\xfe\x1d\x63\x86\x82\x3c\x06\x92\x4b\xcb\xa3\x54\x72\x8f\x10\xd0\xdf\xcc\x69\x88\xfd\x5d\xb3\x15\x98\x91\x10\x13\x74\x42\xfd\x78\x8b\x88\xa8\xe5\x2e\xbd\xf1\x20\x88\x14\x78\x10\x98\x6f\xe4\xb6\xd0\xe3\xb6\x62\xae\xfd\xd0\x9c\x2b\x9d\x9d\xe1\xf4\xf2\x9c\x17\x67\x89\x05\xda\x16\x1f\xd6\xb0\x43\x0e\x78\xe6\x3d\xc8\x18\xdc\xd5\x52\x23\xc4\x5c\x48\xf1\x21\x91\xb0\x62\x64\x36\x3d\xd3\xf3\xc1\x52\xc6\x74\x22\x4b\x0b\xb0\x4e\xfa\x28\x7e\x69\xba\x17\x32\xdf\x45\x88\x6d\x31\xe7\x92\x27\xde\xd0\x98\x04\x90\x95\x18\xa8\x85\x20\x9a\x5e\x9e\x5b\xe4\x33\x80\xc1\xd2\x40\x56\x93\xe0\x9c\xfa\x18\x0a\xd4\x8a\x3c\xad\x00\x3b\x27\xca\x11\xbb\xc3\x3e\x9a\xfa\x3e\x8d\x89\xb8\xa4\x21\x9a\x5e\x9e\x77\xa1\x98\x80\xeb\x12\xf7\xed\x55\xe5\x9d\x98\xb1\xa0\xc2\x5d\x04\xbf\xda\x20\xb0\x45\x02\x06\x50\x40\x45\x84\x28\x0a\x77\x5a\x61\x21\xe0\x6b\x7b\xc7\x10\x47\x32\xd8\x3d\x16\x1b\xe0\x43\x81\xd6\x94\xe1\xdf\xa0\x84\x02\x20\x09\x00\x65\x6b\x48\xcc\x83\x03\x70\x0a\xfd\x0d\x10\x70\x0d\x7c\x4a\xe4\x67\x8a\x21\xa1\x52\x00\xf2\x65\x48\x00\x8d\xf4\x6c\xc1\x1d\x0c\x63\x34\x06\x4b\x2a\x36\xf2\xa5\xfb\x0d\xf6\x37\x60\x47\x63\xa0\x64\x0d\x3a\x68\xb5\xc8\xff\x59\x93\x71\x28\xff\x22\xab\xdc\x21\xc6\x71\x4e\xda\x37\xe6\xb2\x7b\x14\x86\xef\x08\xbd\x27\x73\x23\x00\x9a\x89\xf5\x5f\x4a\x9f\xd5\x71\xcf\x8a\x32\x23\x54\x30\x91\x04\xda\x6e\x29\xc9\x49\x9d\x56\xcb\xb7\x1f\x5a\x47\x6d\xac\x64\x9b\x83\xac\x7b\x77\x77\x9d\xfe\xa8\xf8\x6d\xb4\x47\x36\xd6\x2e\x51\x51\x4a\xb4\xb2\x12\xea\x2d\x75\xb9\x05\x4e\xdf\x2d\x00\x94\xe6\x83\xe4\xe5\x15\x5e\xc7\x4c\xf1\x78\x4b\x0b\xba\x0e\x52\xce\x52\xb1\xee\x52\x48\xe3\xe0\x17\x28\xfc\x4d\x13\x3b\xd6\x6c\xd3\x9f\xe8\x7a\x9d\x77\x77\x6a\x98\xb6\x34\x90\xfd\xba\x23\xbf\x14\x70\x18\x64\x15\x7c\x4a\x04\xc4\x84\x1b\x82\x81\x08\x32\xb8\x45\x02\x31\x0e\x18\x0a\xa1\xb4\x93\x05\x05\x19\x5a\x35\x5d\x94\xd6\x80\xeb\xd7\xa8\x4c\xf8\xca\xa5\x42\x04\x2e\x43\x74\xb5\x8b\xca\xc2\xa5\x99\x35\x35\xce\xff\x8a\x48\xbc\xcd\x2d\x84\x25\x77\x84\x0b\xaf\xca\x87\x71\x80\x85\xeb\xb1\xd8\x20\x22\xb0\x0f\x05\x65\xe5\x9f\x25\xb1\x18\x0d\x43\xc4\xce\x20\x81\x6b\xe4\x78\x45\xba\xe4\x41\x1c\xba\x7e\x82\x61\x58\x7e\xf8\xe7\x51\xee\xc9\x87\x01\x4c\x44\x45\x52\xa9\x41\x42\xbd\x18\x72\x01\x35\xb1\xc1\x0b\x8e\x10\xb8\x4e\x97\x4b\xda\xbf\xfc\xc3\x8b\x49\xcc\xe1\x1a\x4d\x7c\xf9\xfc\x5e\x3e\xf7\x0c\x0f\x7b\x06\xc4\xe4\x2b\xf3\x40\x73\x89\x87\x1e\xe0\x36\x0a\x11\x7f\xf9\xf2\x00\xfc\x0c\x43\x1c\x00\x44\x04\x93\x76\x22\x64\xe8\x3b\xf0\xf1\x46\x52\xfd\x66\xf4\x71\xac\xfe\x94\xb4\x4e\xff\x93\xa1\xb0\x7d\x58\xa2\xab\xfd\x21\xa1\x66\xf2\x79\x18\xda\x3f\xff\x7c\x33\xfa\xd8\x52\xc1\xef\x21\xcc\xf7\x10\x6c\x18\x5a\xfd\x8f\x9b\x51\x67\x82\xdc\x8c\x8e\x0a\xd4\xfd\x7e\x02\x8f\xdc\x54\xfa\xde\xa7\x01\x3a\xfa\xaf\x7f\xc7\x54\xfc\x03\x46\x58\xff\xf1\xfd\x44\x3d\x1d\xe7\x7f\x95\x14\xac\xfd\x3d\x43\xd4\x9a\xf7\x4a\x74\xae\x79\x37\x21\x7d\xdd\xb8\x61\x58\xf3\xeb\x9f\x73\xbf\x1d\x74\x15\xa7\x59\x39\x31\xa4\x2c\x45\xac\x5e\xe6\x59\x8b\xcf\xac\x78\x5b\x89\xda\x16\xbc\x53\xae\xea\x50\xe1\x5e\x6f\xdd\x5a\xad\x59\x0b\xe0\x16\x93\x7c\x14\x21\xc2\x3f\x1b\xc3\xa5\xb9\x5f\x2f\x75\x74\x53\xe9\x5c\x11\x2d\x54\xf1\x86\x86\x52\xcd\xed\x7a\xa7\x88\xb7\xf0\x0c\x9d\xda\x60\xa4\x43\x3c\x07\x98\x4e\xee\x0e\x61\x18\x6d\xe0\x5f\xb3\xa8\x7d\x70\x8f\x7f\x07\x71\x08\x97\x38\xc4\x62\xf7\x2b\x25\x5d\xb5\x55\x1f\x12\xf8\x2e\x1b\xa8\x86\xe8\x65\xd3\xa9\x4e\x5f\x2c\x0a\x3a\x81\xc7\x51\x44\x99\x68\xa2\x16\x5e\xb6\x92\xbf\x8b\x96\x32\x36\x2f\x4c\x0d\x5a\x52\x9e\xba\xa9\xb4\x82\x6c\x0d\x05\x9a\x33\xba\xc2\x61\xe3\x65\x72\x53\xf0\x87\x1c\xac\x5e\x8b\xb7\xc6\xa2\xd9\xaa\xbd\xc5\xf5\xce\xf6\x0f\x3f\xbd\xff\x3f\xe0\xe7\x43\x70\x72\x3a\xbf\x3c\x3d\x9e\x5e\xcd\x2e\xce\xc1\xf9\xc5\xd5\xec\xf8\xf4\x00\x6c\x84\x88\xf8\x77\x93\x4c\x64\x73\x92\x46\x36\x27\x9a\xed\x27\x98\xf3\x18\xf1\xc9\xeb\xbf\x7f\xf3\x35\x78\x8b\x05\x40\x0f\x11\xe5\x88\xe7\x8d\x70\x20\xfd\xa8\x1f\xc2\xf8\x01\xdc\x1d\x5a\x17\x15\x41\x16\x62\xc4\x00\x16\xc8\xbc\x44\x57\x60\x8d\x05\x8d\xda\xb9\x68\x4f\x73\x06\x55\xab\x26\x7f\x6b\xba\x70\x17\x45\x4a\x14\x66\xbe\x0f\xd1\xd7\x0a\xd1\x7b\x1c\x86\xf2\x15\x81\x49\x8c\xa4\x92\x58\xaa\x23\x81\x00\x60\x02\x56\xb1\x88\x19\x32\x38\x83\x28\x84\x84\x8f\x01\x43\x51\x08\x7d\x65\xca\x6c\x90\xa2\x48\x7e\x00\xb8\xa4\x77\xed\x22\x5d\x5f\x14\x51\xe7\x4a\x60\xb8\x6d\x25\xf5\x66\xd3\xb3\xaa\x68\xbd\xb4\x91\xc4\x6e\xce\xe8\x1d\x0e\x10\xeb\x27\x21\x66\x05\x68\xbd\x64\x84\x52\xd6\xbd\xb5\x5b\xde\x6a\xd8\xab\xd8\x6e\xe3\x25\x62\x04\x09\xc4\xcf\x91\x90\xdb\xac\x64\x6e\xd4\x4c\xff\x5d\xc5\xc7\xce\x91\xb6\xca\xda\x0c\xce\x69\x80\xde\x32\x1a\x97\xf7\x55\x2b\xca\x9f\x15\xa0\x0d\x11\x56\x57\x3a\x50\x42\x5c\x2b\xfc\x80\xd2\x4d\x89\x06\x54\xf8\x63\xb2\xf6\x48\xf2\xc6\x4b\xb5\x0f\xae\xcd\xcc\x40\xfa\x43\xf2\x11\xba\xe5\x9e\xf9\x59\x7d\xc7\x87\xd0\x96\x0e\x4c\x6e\x46\x47\x45\xc4\xa5\x8e\x54\xf8\x95\xbe\x2f\x23\x75\x33\x3a\x2a\x4f\xa2\x5a\xc9\x26\xa6\x66\x9b\x2d\x79\x86\x04\xac\x38\x37\x18\x86\x25\x06\xe5\x85\x1f\x28\x03\x98\xac\x28\xdb\xa6\x41\x5d\xeb\xdf\x01\xe5\x40\x3b\x56\xdb\xc5\x22\xed\x74\xe3\xbe\x51\x1b\xf2\x42\x93\x45\x8c\x18\xbe\x83\x02\x99\xd5\x69\xb6\x94\xf3\xfc\x37\x75\x04\x84\x61\x48\xef\x53\x15\x22\xa5\x3e\x04\xab\x38\x0c\x77\x9e\x19\x39\xf1\x7e\x30\x31\x71\x6e\x42\x15\xfb\x81\x0d\xe4\x80\xc6\x42\x9d\xac\x00\x49\x30\x29\x64\x00\xf4\x7d\xc4\xf9\x58\x11\xc5\x82\xd0\xcf\xd4\x51\xfc\x2f\x0b\x60\x22\xb0\x1c\x60\x6e\x7c\xfa\x00\xdc\x61\x08\x7e\x9e\x1f\x03\x44\x82\x88\x62\x22\xda\x19\x2b\x4f\x77\x16\xce\x35\xe5\xc8\x67\x48\xf0\x53\xe2\xb3\x9d\x9d\x43\x83\x65\x5d\x94\x3e\x73\x42\xbf\x8b\xfc\x56\x3b\xfe\xe7\xf9\x71\x57\x7f\xbf\xc6\x71\x75\xc9\xa1\x06\x0a\xad\x60\x4c\xd4\x9a\x04\x85\x39\x8f\x4b\xce\x70\xf5\x36\x72\x8b\xb5\x5a\x35\x58\xe3\xbd\xd4\x7a\xa0\x6e\xdf\xb0\x96\x1b\x0a\x1e\x49\xd9\xd4\x1d\x24\xb6\x02\x01\xc7\x52\x64\x99\x6d\x33\x36\xb6\xa1\x36\xff\x50\xa0\x0f\xcd\x0c\xc1\xc0\x74\x3e\x6b\x1c\x55\xe9\x03\x38\xe5\x0b\x4f\x49\x46\xcf\x9c\xe0\x78\xc6\xec\x4a\x99\x2f\xc7\xe0\xea\x5d\x65\x13\xdb\xa8\x41\x5e\x7e\xa4\xc7\x6b\xa3\x24\x9a\x90\x7b\xc1\x80\xaf\xb2\xcb\xec\xb6\xf8\xe0\x0a\xfd\x9c\x26\xbb\xbd\x41\x28\xdd\x30\xe2\x54\xc9\x92\x2a\x3b\x72\x49\x69\x88\x60\xc5\xfe\x8e\xe2\x65\x88\xfd\xb6\x00\x5a\xed\xeb\x3c\x92\x55\x63\x0f\xc2\x85\xfa\xa4\xc9\x4a\x67\x18\x61\x25\x58\x11\x4b\x64\xa8\x15\xbb\x19\x85\xdb\xf2\x18\xab\x25\x70\xd7\x12\x4b\x47\xa5\xc1\xe2\x5a\xc1\x40\x83\xd3\x07\xe4\xc7\x12\x5c\xb3\xf4\x81\x3a\x15\xcd\x68\x68\x3c\xb6\xe5\x0e\x44\x34\xd0\x09\x1e\x1a\x6f\xa9\x88\xa6\xf3\x19\x3f\x00\x57\x1b\xcc\x81\x7a\x15\x73\x00\x83\x40\x47\x2e\xa5\x07\x97\x9a\xff\xe0\xf2\xcd\xf4\x58\x39\x88\x30\x16\x9b\xe4\x28\xfc\x00\x28\x93\x7a\x4e\x03\x90\xa0\x0d\x24\xde\x1f\x5e\x58\x4f\x3f\xa0\x3e\x3f\x80\xf7\xfc\x00\x6e\xe1\x6f\x94\x28\x97\x1f\xdd\xf2\x49\x08\x05\xe2\x62\x12\x73\xc4\xd6\x31\x0e\xd0\x24\xa2\x81\x87\x2c\x10\x4f\xe2\x73\x20\x17\xa6\x9d\x7d\xf5\x99\x66\x9c\x5a\x69\x43\x4d\xf3\x66\x74\x54\xa6\xe2\xfe\x28\x58\x81\x5d\xe6\x83\x26\xda\x38\x93\x60\x24\x45\x24\xa5\x0c\x06\x92\xc8\x20\x99\x8f\x22\xea\x47\xc3\x15\xa7\xef\x16\xc0\x44\xd8\xc0\xa2\x10\x6d\x34\x5f\x7b\x26\xdc\xd7\xd2\x69\xea\x87\x58\xc9\xc4\x2e\x22\x73\x33\x3a\x72\xe0\x5e\xbd\x18\xf9\xbc\x80\x7e\x3e\x4e\x2a\x35\x16\x39\xa8\x43\xb8\x3c\x06\x4f\xb9\x1f\x14\xa2\xea\x80\x82\x21\x39\x47\x4c\xb2\xf9\x2f\x66\x01\x67\xd3\x33\x60\xb0\x00\x76\x72\x1f\x5e\x4c\x30\xdc\x1a\x48\x16\xd0\xe4\x2b\x45\x48\x4f\xaa\x67\xcf\x9c\x95\x29\xfb\xa6\xdd\xb2\xb6\xc4\x2f\xb3\x8e\x2d\x50\xba\x19\x1d\xb9\xe6\xb5\x77\x75\x7b\x24\x73\x65\x20\x7c\xa6\x0d\x0a\xc3\x10\x58\xab\xd7\x5b\x42\x29\x0f\xd5\x7f\x30\xe2\x86\xa2\x4a\x40\x1a\x93\x47\xaf\xb6\x14\x8f\x29\x7a\xc0\xa2\x57\x2f\xc9\x67\xd3\x33\x2b\xe2\xde\x73\xc4\xde\x2a\x11\xa7\xe5\xed\x3f\x6d\x46\xce\x3f\x0d\x6a\x18\xf1\x0e\x12\x7d\xc8\x39\x36\x13\xdb\x5d\xe6\x74\x33\x3a\xaa\xa0\x5f\x35\x63\xdd\x45\xfe\x25\xe2\x34\x66\x3e\x3a\x4e\x8e\x6c\xdd\xe9\xb5\x45\xe3\xac\xd6\x2f\x57\x89\x46\x26\x0f\x3d\xc9\x8c\xda\x01\x82\xe4\x0c\x4c\x1e\x23\x8b\xf5\x86\x92\x2e\x67\x7a\x5e\x9c\x6c\x33\xfd\x44\x85\x75\xdb\x05\x96\x1f\x77\xf0\x34\x1b\x4e\xb0\x18\x39\x89\x2a\xf7\xfb\xc5\xec\xe4\xb8\x0f\x05\xb5\x4f\x9e\xce\x41\xc2\x03\x91\x71\x1e\x01\xe4\xe0\x1e\x85\xa1\xfc\x77\x76\xb9\x98\x26\x7a\x67\xaa\x38\x08\x1c\x9f\xcf\x40\x14\xc6\x6b\x4c\xda\x45\xe4\x07\x1a\xb3\xa3\xd9\x5e\x10\x72\xcd\x85\xd7\x7e\x9b\xa4\x00\xaf\x85\xe5\xe2\x5a\xd6\x1a\xb5\xdb\x70\x6b\x0d\xe8\x7b\x48\x11\x24\x17\x0b\x0a\xc1\xf0\x32\x16\xc8\xe4\x7d\xfa\x85\x58\x41\xc3\x74\xf5\x3d\xd0\x2a\xbc\x0b\x15\x76\x6d\x52\xfc\x41\x08\x15\x30\x5f\x39\x54\x4f\x81\x47\xc9\x2c\xde\x9b\xcf\x1a\xc2\x25\x0a\x9f\x36\x8a\x5d\xf3\xe0\xe5\x77\x3c\x82\x7e\xf3\x8f\x5b\x6d\xe2\x62\x0a\x6b\x3a\x5c\x99\xbc\x63\x37\x63\x0c\xb8\x39\xb2\x61\xee\x7b\x04\x7c\x48\x74\xe1\x53\x62\xd3\x5d\x28\xe2\x4b\xf6\x55\xf2\xac\x68\xfd\xb5\xdc\x3d\xbd\x87\xab\xd8\x5e\x05\x33\xbc\xc9\x46\xfb\xa2\x75\x32\x69\x21\x59\x7e\x82\xc3\xd6\xcf\x54\x8e\xe2\xe4\xfb\xe7\xba\x9a\x26\x75\x47\xfd\xe3\x3d\x99\x02\x16\x15\xdd\x28\x2c\x17\xea\xc5\x13\xad\x81\x3b\xa7\xda\xe9\x64\xd1\x6a\x39\x77\x50\xf3\x8b\x15\x17\xe9\x70\xf4\x80\x74\xed\x31\xa2\x93\x34\x72\x9d\xce\xf7\xeb\xaa\xda\x23\xf3\x08\xf9\x78\x85\x7d\xbd\xe6\xaa\xac\x15\x13\x2e\x10\x0c\x2c\xd2\x2a\x79\x2b\x91\xbd\xde\x1a\x11\xc4\x94\xf3\x95\x7c\xd1\xee\x40\x7c\x88\x01\x2b\xa9\x71\x41\xc2\x5e\xce\x95\xc6\x6e\x07\xf0\x0a\x50\x12\xee\x92\x9d\x5e\x08\x27\x68\x54\xf8\x86\xc6\x61\x00\x96\x28\xf1\x47\xe5\xf2\xd1\x58\xe8\xff\x63\xb2\x9e\x58\xdd\x6b\x52\x66\xfa\xf0\xd1\x67\x46\xcd\x1d\xe9\x10\x50\xc4\x0d\xf3\xa9\xaa\x54\xec\x42\xc3\x70\xc2\x7f\x52\x65\x71\xd2\xe1\x97\x08\x25\xde\x58\x2f\x29\xd0\x0a\x58\x03\x1b\x75\xb0\xda\xae\x8e\xc6\xa8\xeb\xe8\xb6\xae\x3e\xaa\xb6\xd0\xa9\xaa\x78\x2a\xaf\x38\xf7\x28\x85\x32\x9f\xba\x44\xa5\x4b\x60\x14\x39\x70\x48\x17\x52\x57\xaf\x15\x56\x3b\xad\x50\x84\x24\xe8\x55\x88\xd5\x1e\x7e\x23\x3b\x78\x51\xdc\xe8\x95\xd6\x30\x6b\x19\x37\x6d\xc5\x64\x16\xf8\x80\x0b\xa2\x59\x23\x6b\xc5\x55\xed\xc4\x86\xbd\x24\xf6\xc2\x73\x11\xbc\xe8\xd4\xd7\xd4\xef\x67\x19\x16\xad\xdb\x65\xfe\x3f\x8d\x90\x40\x3e\x82\xc8\x96\x58\x30\x69\xf8\x24\x3c\x8a\xd7\x84\x32\x1d\xcd\xfd\xa8\xc3\xb9\x2d\x4b\x82\xea\x61\xea\x6a\x16\x0d\x38\x29\x63\x79\x84\x90\x40\xdd\xac\x0d\x7b\x14\x03\x47\x4d\x26\x57\xf8\xd4\x6d\xfa\x68\xc6\xe8\x8e\x9f\x0a\x36\xfe\xb2\x00\x1a\x10\xd8\x50\x6e\x0c\x03\xcc\x3b\x21\xdd\x04\xde\xd3\xb7\x00\xd4\x41\xb3\xf4\x7e\xe0\xda\xcc\x46\xc7\x1c\x1d\x07\x10\xad\xa8\xd3\x19\xee\x60\xe5\xe2\x75\xb3\xd6\x45\x6e\x77\x90\x61\x48\x44\x5a\x0b\x78\x78\x70\xf8\x37\x5b\xb5\x77\x78\x70\xf8\x6d\xe6\xef\xbf\xa7\x7f\xbf\x7e\x75\x33\xfa\x08\x5e\x18\x44\x5f\xda\xa7\x87\xad\xcb\xfc\x5c\x58\x64\xeb\xd2\x24\x3a\x35\x65\x6b\x12\xc3\xfa\x9f\xff\x5e\xfb\xf3\xeb\x57\xb9\x9f\xb3\x33\x2a\xbc\x78\x58\x28\x90\xab\x5a\x30\x09\xb3\x49\xfe\xb7\x9c\x58\xb1\x2a\x56\xce\xc6\xf1\xec\xef\xe5\x67\x85\x31\xf4\xb3\xc3\x8a\x34\xf2\x56\xba\xb8\x42\x19\x39\x58\xaf\xb8\x9d\x1f\x33\x16\x99\x54\x3e\x6b\x37\x31\xcc\x64\x73\xb6\x4f\x0a\x6a\x04\xcc\xa5\xce\xcf\xa7\x57\x4d\x6c\xa5\x35\x14\xe8\x1e\xf6\x09\x5f\x54\xec\xcd\x1f\xf1\x7a\x13\xee\xa6\x3a\xc3\x30\x44\x72\x0b\x5a\xa3\x0f\x71\x00\xc1\x46\xfd\x0e\xa0\x7d\x01\x9c\x4f\xaf\x80\xc1\x46\x6d\xd1\x05\x26\x6b\xc7\x77\x5c\x3d\xce\xbe\x5d\xd8\xda\x27\x98\xdb\x01\x03\xfd\x27\x97\x6f\x0f\xbb\xd5\x0b\xb3\xcb\x6f\xcc\x16\xf3\xcc\xc2\xd4\x13\xae\x01\x55\x3f\xf5\x2c\x28\x43\x83\x3c\xac\x1a\x6a\x64\x64\x82\xc6\xa2\x89\x54\x28\xd0\xa0\xb8\xc9\x1d\x80\x00\xb0\xab\x33\xc4\xee\xb7\xac\x3b\xc8\xa6\x95\x44\xf5\xf3\x59\xbd\xfb\x78\x24\xf3\x89\x6b\x03\xea\x3e\x69\x6d\xd2\x2c\x9b\xb9\xcb\xc5\xce\x7b\x4e\x95\xab\x53\x1f\xfb\x02\x6c\xb5\x1c\x76\x12\x65\x2c\x06\x74\x95\x92\xec\x76\x12\x00\x0d\xdd\x74\xaf\x6b\x7b\x76\x53\x03\xc8\xb5\x98\x3f\xcf\x8f\x1b\x9d\xc3\xc4\x82\x4e\xc3\x90\xfa\x50\xa0\xd9\xfc\xee\x9b\x3e\x71\xbf\x69\x0e\xd6\xcf\xdf\x00\xe9\x90\x21\x2e\xb4\x83\x3d\xbf\xfb\x06\x1c\xcf\x4e\x2e\xc1\x32\xa4\xfe\xad\x0e\xd2\x4e\xfe\xfa\x0d\x90\x2b\x84\x1f\x92\x90\x8e\xc4\xbb\x55\x4c\x68\xa8\x41\x9d\x5c\xf9\xf9\x1b\xe9\xf9\xd5\x49\xcf\x35\xa3\x97\x52\xa5\x6b\xe3\xb3\x08\x99\x72\x2d\x55\xc9\x91\x96\x5d\xcc\x67\x49\xee\xe1\x5d\xe4\x7b\x44\x97\x0e\xa8\x1e\x0f\x49\x85\x8d\x7e\xdd\x13\xd4\x13\x1b\x94\xcd\x27\x87\x11\xf6\x74\xfa\xaf\x97\xd4\x67\x0c\x50\xe6\x35\x0c\x22\xb6\xb4\xab\x34\xe1\xea\xcc\x23\xf4\x20\x18\x94\xbc\xf3\xe5\x4e\xe2\x54\x06\x57\x22\x79\x34\x23\xdb\x63\x0e\xb9\xec\x63\x80\x0e\xd6\x07\x00\xea\x5f\xe4\xdb\x56\x48\x18\xc9\x00\x24\x00\xb2\x03\x30\xf0\x36\xb4\x2c\x78\x9a\x2c\xca\x63\xe1\xe0\xa4\x79\x8b\x2e\x92\xd9\xe3\x32\x55\x4c\xb2\xd8\x40\xa6\x2b\x4a\x16\xc8\x8f\x19\x16\x3b\x55\x59\x72\x19\x3b\x0a\xe0\xdb\x4a\x35\x69\xb5\xfa\x30\x94\x56\x51\x10\x00\x6e\xe0\x03\x55\x16\x01\x58\x1c\x9a\xd3\x4b\x12\x80\x15\xa3\x5b\x25\x52\x8c\x81\x92\x58\xbf\x85\x8f\xe4\xbb\x2a\x82\xad\xb0\xd6\xa5\x52\xf9\x57\x4c\x06\xb6\xa9\xbd\x8a\x49\xb6\x34\x51\x67\x7d\xd2\xed\x36\x26\xd8\xcf\x1d\x79\xe5\x12\xc3\xd4\x28\xb9\xef\x0c\x50\xdd\x68\x0d\xf8\xca\x93\x00\x4b\x64\x2d\xad\x00\xdc\x6f\x10\x01\xb1\xb4\xdb\x8c\xef\x9c\x78\xd3\x79\xec\x78\x3b\xeb\xf4\x99\x88\x4d\x88\xd8\x20\x75\x8f\xc0\x86\x2d\x14\x32\x4e\x55\x8d\xbd\xa5\x4b\x4d\xbe\xac\x94\xd3\xf5\x82\xa9\x96\xd6\x6c\x20\x57\x2c\x23\xaa\x8d\xc5\x73\xfb\x2d\x97\x52\x3b\x29\x30\x69\xc5\x84\xbd\x06\x72\x12\x91\x67\x25\x4d\x0f\x8f\xf4\x05\xbc\x85\xfa\xd8\x56\x73\xde\x5c\x32\x5e\x4e\x8c\xbd\x54\xa2\x35\xe5\x56\xc9\x79\x4b\x24\xee\x11\x22\x0e\x76\x55\x6c\xda\x8a\x36\x8f\x83\x81\x9b\x68\x6e\x41\xdd\x83\x7c\x5a\xeb\x20\x4f\x77\x87\x0c\x72\xf2\x60\xf1\xb6\xb5\xba\xab\x01\xe5\x9e\x50\xc9\x6f\x02\x7b\xf7\xe5\xc2\xa5\x8a\x0b\xb8\xdc\xa2\x9d\x8e\x62\x4e\x7f\x35\xb4\x27\x77\x88\x60\x44\x7c\x64\xd2\xd1\x55\x28\xd8\x94\x46\x7f\x78\x31\xb1\x45\xd2\x13\x86\x94\xf4\xf1\x30\xdc\x7a\x90\x04\xde\x5d\xe4\x4f\x5e\x66\x13\x64\xaf\x8d\x74\x7a\xc0\x3a\xa6\xfc\xf3\xfc\x98\x57\xda\x7e\x31\x47\x9e\x7d\x53\x82\xf2\x54\x97\x6e\xcf\x8f\xb9\xa0\x5b\x2f\x77\x30\xf6\xb2\x9d\x5a\xd8\x3b\xc3\x8c\x39\x58\x3b\xb9\x9b\xd1\x51\x96\x16\xba\xea\x3f\x9d\xee\x5e\xab\xb2\xc5\x14\x6f\x46\x47\x0e\xe2\xc9\x11\x3b\xf7\xf5\xca\x37\xb9\x56\x3e\x47\xa5\x90\x71\xf0\x9d\xdb\x68\x6d\xb0\xe3\xda\xd9\x50\xe3\x1a\xaf\xb1\xa0\xa1\xea\xfc\x99\x5a\x1d\x34\xa0\xe3\xbd\x0e\xe9\x12\x86\xb6\xb1\xbc\x6a\x7e\x13\x86\xc0\xdf\xe0\x30\xe8\xe8\x81\x37\x81\x98\x73\xc5\x0b\xad\x93\x06\x6e\x35\x3e\xcc\x69\x9a\x2d\xe0\x8a\x34\x92\xed\xf6\x6f\x15\x0c\xb7\xc5\x31\x68\xd9\xe6\x6c\x7a\xa6\xb2\x74\xfe\xc4\xc1\xf4\xf2\x5c\x6a\x6e\x93\xc9\xca\x62\xa2\x8b\x1a\x29\x11\xd4\xa2\xd6\x6e\x5a\x6d\x61\x57\xd8\x06\x21\xf2\x05\xed\xd9\x5b\x27\xcf\x42\x0b\x03\x73\x08\xb3\x4b\x6b\x38\x9d\xaa\x68\x8d\x6f\x8d\x33\x90\x62\x31\xa4\x50\x1d\xaf\xd9\xe6\x87\x3d\xc8\xd9\x6f\x24\x37\x71\x9d\x39\x54\x2d\xd8\xe7\x4a\x9a\xf9\x31\x63\x88\x88\x42\xf6\x41\xaf\x0d\xd1\x02\x6c\x1b\x53\xe2\x33\xdb\xe2\x16\x57\x93\x00\x67\x98\x3f\xa0\xc0\xa8\x4c\xa0\x3a\x0d\xa8\x64\x67\x7b\x7d\x87\x5a\x4e\xe9\xfa\x98\x05\x55\x17\x81\x10\x4a\x90\xad\xcf\x09\xc6\x4a\x5a\xda\xa0\x80\x8d\xbc\x59\xcf\x4e\xb5\xd1\x32\xad\xb3\xda\x91\xfc\x89\xa0\xec\x5c\xce\x27\x75\x10\xff\x3e\x73\x60\x9e\xa6\x16\x98\x43\xf3\x56\x24\x6f\x01\xa9\xea\xb0\xbd\x95\x7d\x54\x3c\x35\x75\x69\x12\xa7\xe4\xad\x37\x96\x8a\xe7\xaa\x3c\x97\x54\xd9\xcf\x06\xd1\x32\x8f\x1b\x4e\x13\xd2\x4e\xe4\xc0\xa6\x2c\x24\x92\x2e\x11\x7f\x6e\xe1\xda\x4c\xb0\x76\x1c\xa4\xc6\x52\x49\xd4\x4c\x23\x8b\x45\x57\xcf\x34\x36\x5b\xbe\x7c\xe9\x52\x8e\x86\x99\x66\x06\x0a\x33\x90\x70\x4f\x46\xef\x17\xb4\x55\x3b\x01\x35\xc0\x08\x0d\x73\xab\x9a\x94\x4d\x35\xa4\x45\x02\x4e\x07\xe3\xb4\x90\x1d\x90\x12\x8d\xe1\xf7\x10\x19\x55\x65\x5d\x83\x6e\xf0\x1e\xb6\x53\xd3\xed\xdd\xd5\x68\x4a\x36\x77\x18\x3f\x34\x6a\xaf\x12\x3a\xd4\x55\x85\x59\x1a\xc6\x0f\x3f\x84\x79\xf9\xe9\x48\x53\x24\x20\x93\x55\x08\x23\xa9\x7a\x35\x1b\x2a\xd4\x93\xbf\x22\xc8\xb9\x3a\x23\x50\x18\xc8\x27\xaa\xc3\xe6\x92\x52\xc1\x05\x83\x91\x6a\xfc\x65\x22\xa9\x1c\xa1\xa4\x41\xea\x2a\x8c\x1f\xfc\xe0\x00\x53\x55\xa2\x3e\x51\x1a\x3a\x5b\xe2\x46\x89\x52\xe0\xab\x32\xa2\xfb\x72\x21\x9f\x12\xe2\xce\xed\xbe\xc6\x22\x69\xb7\xd9\xcf\x0a\x66\x28\xa2\x1c\x0b\xca\x76\x49\x86\xa1\x49\xbe\x3d\x00\xc7\xfa\xfe\x31\x84\xc5\x06\x31\xf0\x56\x1d\x71\x02\xaa\xfe\x0a\xe1\xb2\xbd\x69\xdc\x67\xac\x8e\x82\x20\x4b\xa8\x71\x91\xd7\x07\x91\x04\xa6\xdd\x24\xd4\x7d\x60\x33\x9d\x5c\xf5\x01\x41\xae\xd5\x39\x54\x0d\x5f\x33\x64\x50\xda\x7a\xa5\xa7\x79\x11\x71\x70\x45\x69\x78\x8b\x05\x78\x61\x7a\xcc\xbe\x6c\x2e\x2e\x1e\x1b\x8f\x92\x4c\xf9\xa1\x20\x2f\x1a\xdc\xa3\xe8\x56\xe0\xad\xef\x4c\x84\x85\x4d\xa9\xa2\xb6\x90\xab\xb0\x5c\xba\x71\x2b\x36\x65\x63\x82\x0e\x34\x4a\xcd\xdd\x84\x6f\x71\xa3\x62\xd9\x04\x68\x39\x92\x54\x23\xa3\xed\xcb\x75\x02\x40\x07\xb6\x2c\x83\x08\xaa\xca\xc8\x24\x07\x41\xf0\xa6\x30\xa8\x14\x4a\xb9\xf6\x32\xb6\x75\xf5\xe9\x49\x3b\x41\x30\xd4\x98\x4e\xb9\x28\x09\x08\xf3\xa6\x6b\x0d\x89\x2e\xec\xdb\xad\x68\x94\xa4\xb8\x29\xdc\x7e\x44\xe1\x16\x58\x40\x00\xab\xc3\x90\x7f\xc5\xc4\x57\x5b\x4f\x1d\x29\xc2\xa4\x05\xb7\x99\xa9\x69\x92\x35\x18\x01\x1f\x03\x21\x27\x75\xa5\xc0\x68\x46\xd9\x4b\xf9\x66\x2b\xaa\x9a\xeb\x47\x2c\x66\x94\x80\x1d\x8d\xd9\x23\xb0\x5b\x9b\x81\x3a\x2a\x1d\x96\x9f\x7d\xca\x95\xe3\x9a\x4d\xfd\xd9\x95\x91\x22\x84\x14\x66\x46\xe6\x4b\xab\x23\xed\xe6\x47\x02\x10\x62\x72\x2b\x7f\xc7\xc2\xa5\x33\x0e\xc0\xf5\x5b\xdd\xd9\x5c\x75\xff\xf9\xf0\x62\xa2\xdb\x67\x7a\xff\x8e\xb1\x7f\xcb\x05\xcc\xb5\x2c\x1b\x52\x7b\xf5\x46\x3c\x73\x1e\x54\xc6\xf9\x66\x74\x94\x9d\x57\x9a\x21\x94\x91\xda\x17\x51\xa3\x34\xc9\x55\xde\xf2\xae\xd9\x2f\xca\x46\xef\xbe\x5f\x5e\x17\xd9\x78\xc0\x2d\x52\x86\xdd\x71\x57\x28\x6a\x7c\x71\x2e\xb7\x96\x4d\x6b\xa6\x39\xa7\x02\x7d\xa7\xab\x6f\x54\xe8\xcf\x34\x5c\x55\x32\x97\x86\x81\x36\x5a\x81\x50\xb7\xf3\x7c\x0e\xae\xff\x2c\x13\xc9\x31\x7e\xa9\xd1\xff\xde\xf8\x90\xb2\xe7\x1a\x87\x86\x8c\xf5\x37\x70\x5e\x3f\xc5\x81\x7f\x33\xfa\xf8\x9d\x6e\x17\x65\xbb\xa1\x25\x7d\xa3\x06\xcd\xb2\x97\x63\xe5\x72\xd8\x9b\x8d\xea\x4e\x57\x97\xc0\x86\x48\x3b\x77\x2f\x02\x25\xe8\x62\x55\xdd\xf2\xb7\xc2\x60\x9a\x9d\x1c\x57\x5f\xf7\xf0\x7b\x69\x90\xaa\x72\xdb\x12\x3d\xf2\xec\x9f\xa4\x37\x20\x7b\xa2\x9f\x24\x52\xa9\xd7\xd2\x7e\x7b\xb5\x77\xa4\x2c\x43\xba\x9c\x6c\x21\x26\x69\xf2\xc0\xeb\xbf\x79\x92\xac\x9e\x1d\xf7\x60\x07\xb7\x61\x0b\x27\xab\xdd\x0c\xca\xbd\xf4\x06\xc1\x57\x65\x3b\x54\x90\x26\x93\x88\x90\x6c\xdb\x7c\xe7\x9c\x06\x0e\x5b\x6b\x87\xcc\x92\x65\x97\x71\x8c\xfe\xd7\xe2\xe2\x7c\xf2\x7f\xa7\x67\x3f\x25\xad\x71\xf8\x18\xf0\xd8\xdf\x00\xc8\x81\x4a\x54\x70\x5c\x0b\x46\x99\xfb\xda\xd3\xa6\xeb\xf2\x78\x08\xd4\xb8\x71\x33\x69\x45\x13\xdf\x19\x37\xaf\xbc\x9e\x33\x8a\xa7\xcc\xdf\x60\x81\x7c\x11\xb3\x3e\x62\xef\x78\xfe\x1e\x64\x41\xd9\x03\xae\xd3\xe3\xd7\xda\xbe\x27\x52\xb6\xef\x22\x64\xaf\xde\x2b\x49\xc8\x87\x6f\xbf\xf9\xe7\x37\x7f\xb9\x19\x7d\x54\xf7\x0a\x6e\x83\xf4\x6f\xb6\x55\x7f\xb7\x92\x91\x3d\xf1\xc9\x8a\x53\x8d\x58\xbe\x28\x28\x77\xeb\x9e\xc4\xb5\xe6\x67\x89\x7e\xee\xe7\x26\x62\x57\x0f\x5a\x2c\x03\x52\x43\x95\x1e\xca\x01\xf6\x5f\x2f\xb3\x8e\xaa\xcf\xaa\x25\x29\x8b\xd7\x67\x96\x7a\xd2\xea\x76\x2f\xe6\xa4\x87\xc4\xdb\x25\x62\x92\xaa\x6f\xe7\xef\xf9\x01\x98\x09\xd5\xc8\x6b\xa9\xce\x80\xa4\xc6\x7f\x95\x89\x15\x13\x4a\xbc\xb7\xf3\xf7\x79\xc2\xb7\x4c\xde\x7d\x84\xe1\x9d\x64\xda\xa2\x2d\xed\xd5\x97\x28\x8f\xa8\x06\x07\xae\x36\x08\xc4\x04\x0b\x9b\x4c\xac\x0c\xdc\xb7\xf8\x4d\x0f\x12\xec\x83\xec\x9c\xdd\xdd\xf1\xfc\xfd\xa3\x70\x81\x06\x3c\xc4\x82\x6a\x48\x1d\xad\x8c\x22\x1a\x76\x39\x8b\xfb\x60\x5c\x2d\x03\x07\xf1\x0d\xb4\x26\xc8\x09\x1b\x7b\xe0\x66\xad\xea\x96\x5a\xa5\x1e\x56\x4e\x13\x54\xdd\x05\xd5\x40\x21\x98\x40\xf8\x6c\x7e\xf7\x97\xe3\xd9\xc9\x65\x1f\x85\x30\x3b\xb9\x04\x0c\x92\x75\x72\xb8\x86\x18\x02\x1f\x6d\x9b\x92\xf9\x47\x25\x69\xd5\xe5\xed\x6b\x82\x82\x76\xc2\xdd\x09\x5b\x0b\xdd\x64\x00\x23\x6c\x0b\xc3\x74\xe4\xab\x22\x5d\x06\x61\x92\xf4\xae\x67\xe3\x37\xa5\xc9\xa0\xad\x99\xa4\x09\xac\x1c\x93\xfc\x04\x63\xe2\x6f\xae\xd0\x36\x0a\xf3\x35\x94\x15\x4e\x14\x0e\x9a\xbb\x50\x7b\x2b\x68\xea\x18\x47\x23\x06\x84\xc1\x0c\xcc\x4e\x5a\xf1\x86\xe3\x73\xb7\x24\xec\xdd\x5d\xa1\x38\x92\x81\x08\x4e\x32\x82\x38\x5b\x3f\x12\x56\xbc\x7f\x75\x71\x72\x61\x6f\xd3\x04\x7f\x34\x5f\x8f\xc1\x1f\x7f\x52\x9d\xad\x7b\x4d\xfe\x91\x50\xea\xb8\x89\xf2\x19\xc6\x77\x55\xb7\xdf\xd6\x6d\xa5\x1c\x0b\x97\x2e\x9e\x1b\x38\xb7\x15\x6e\x71\xef\x7e\x7c\x3b\x70\xad\xf3\xb7\xc1\xf4\x6c\x96\xb9\xda\x5b\xe7\x74\xc3\x2d\x4e\x2f\x56\x18\x83\x8f\x30\x16\xd4\xe3\x7c\xfb\xd1\xfc\xfd\x71\x2c\x5d\x81\x8f\x5c\x40\x81\xfd\x76\x06\xb0\x1d\xbe\x7c\xc1\x6b\x79\xe8\x9b\xd1\x51\x06\x49\xe9\xbc\xd9\xba\x78\x8b\x50\xa1\xb7\x86\x7c\x9c\x3c\xa2\xcc\x3c\xd5\x68\x5a\x23\xd7\xb9\xeb\xe0\x16\xff\x00\xb7\xb8\xba\xb5\x5f\xf7\x08\x8b\xee\xb0\xfd\x13\x26\xf1\xc3\xeb\x72\xe7\x92\xf7\xcb\x98\x88\xf8\xf5\xab\x57\x7f\xb1\x1d\x4e\xf4\x93\xc3\x6f\xd3\x27\x6f\xa8\x10\x21\x62\xd4\xbf\x45\xc9\xfd\xe8\xbf\x60\x12\xd0\x7b\xbe\x50\xa5\x9c\xaf\x5f\x1d\xfe\xfd\x98\x32\xd5\xa9\x5a\x5d\x29\x5d\xf9\xd6\x0f\x71\x18\xee\x7b\xeb\xd5\x5f\x8a\xb0\x86\x6d\xb6\x90\x25\x48\xa3\x16\x28\x29\x8d\x6a\x1a\xaa\xa4\x64\xab\x79\x29\x4b\xc9\x9a\xd7\xea\x89\xdb\xe6\xc3\x1c\xbd\x9b\x7f\x58\x58\x82\x86\xfd\x5f\xb2\x84\x6d\xe2\xcb\x55\xbe\x0f\x40\x86\x2f\xdd\xbf\x48\x42\x17\x7f\xc9\x52\xb7\xf8\x5b\x3d\x49\xf7\xbe\x9d\xa3\xe3\x9e\xb7\x0b\xc4\xdb\xef\x81\x42\xbe\x5e\xc4\x3c\x42\x24\x98\x33\xea\x23\xce\x1f\xf9\xee\xee\x5a\xe9\x8c\x10\xb8\x66\x28\x44\x77\x90\x08\x95\x3b\x1a\x50\x9f\xd7\x5f\xa1\x31\xfd\x65\xa1\x3a\x9a\xfe\x60\x53\x64\x1c\x97\x4f\xdc\x73\x2f\xe9\x0a\xef\xc5\x51\x00\x05\xd2\xad\x99\xd5\xe5\x13\x5f\xf9\x2b\x92\xfe\xce\x73\x2f\x78\x8c\x86\x21\x26\x6b\xfd\xcc\xe3\x9a\x52\x91\xa5\x54\x9f\x2a\xf6\x27\x3b\xa9\x9b\xd1\x51\x69\x0d\xaa\x8b\xe1\x3f\xef\xcd\xef\xb5\xa6\x1f\xde\x62\xa1\xef\x1d\xd5\x39\x71\xb6\x15\xee\xf4\xd7\x54\xc7\x4b\x25\xc9\x7d\x18\xaa\xda\xae\xdf\x28\x41\x1e\xbc\x87\x0c\x79\x5a\xa7\xea\x1f\xda\xad\xaa\x1e\xb6\xa4\xd1\x9b\x0c\x64\x6e\x22\x2d\x61\x5b\x4d\xed\x65\x56\xca\x34\x3a\x3e\x4c\x0c\xb1\x4a\x01\x55\x1d\x4d\x48\x32\x87\x57\x94\x81\xec\xf7\x1d\xca\x48\x9b\x43\x75\x4e\x3c\x40\x5c\x5a\x8e\xc7\x30\x82\x3e\x16\x95\x96\x8a\x8d\x96\xb8\x61\xe8\x2a\xec\xd9\xd9\xc9\xe2\xee\xb0\x4f\xe1\xbf\xb1\x63\x79\xda\x51\xc4\x98\xf0\x49\x7f\x44\xdb\xa4\xd2\xa4\xf1\xaa\x21\x5f\x03\x41\x6f\x11\x69\x47\xb6\x21\x87\x4a\xb5\x65\x6a\xb6\x57\xd0\x68\x4e\x03\x09\xa8\x0f\x91\x4c\x21\xb5\xba\x96\xe3\xec\x64\x91\x4e\x40\x45\x07\x88\x69\x5b\x98\x75\x89\x23\x1a\xb4\x23\xce\x10\x43\x34\x21\x0a\x5a\xf2\x8b\x48\xe0\x2d\xfe\x0d\x55\x3a\xd1\x6d\x6e\xc6\xb9\x3e\x7d\xb3\x50\x01\x80\xad\xb9\x16\x6f\xaf\x8a\x3b\x3d\x7e\x5d\x56\x01\x68\xc9\x3d\x6a\xf1\xea\x70\x37\x94\x45\xa7\xb1\x4e\x6a\x88\xc5\xcd\xe8\xa8\x38\xc1\x9a\x66\x2a\x2b\x78\xaa\x2f\xf1\xed\x43\x59\xdd\x45\xc1\xc4\x60\xe1\x03\xde\xc6\x5b\x5d\xbf\x8f\x82\x4c\x14\xf3\xf4\x87\xa9\x67\x6f\x0c\xb6\xd5\x3f\x3e\x64\xaa\x6a\xcf\x34\x7e\x50\xb7\x28\x61\x6e\x7a\x44\xb4\x22\xe7\x63\xe1\xe0\x26\x9b\x02\x71\x82\x04\xc4\x21\x0a\xce\x28\xc1\x82\x2a\x45\xda\x83\x88\x7a\x1d\x54\x50\x33\x30\x80\xc1\x36\x85\xdc\x86\x16\x7b\x40\x39\xa7\x84\xe1\xb6\xa5\x4a\x9b\x4d\xcf\x2a\x40\x99\x98\x6c\x83\xee\xfd\xb5\xdf\xcf\x55\x33\xa8\x3e\x10\x1c\x07\x7f\x35\x33\x2b\x1d\x17\x36\xd3\xd3\x36\x25\x44\x2a\x54\x67\x48\xba\xa3\xa6\xde\x0f\xb7\x76\xee\x57\xfb\x93\x36\xf6\x7e\xff\xe5\xcc\xc9\x94\x0c\x10\xd8\x1b\x4a\xf2\x87\x55\x1d\xa9\x5a\x09\xce\x49\x8c\x2f\x5f\x14\xd5\xe0\xca\xa9\x8a\xa8\x35\xa8\xe6\xf4\x42\xa4\xbb\xe1\x42\x90\xb4\xb5\x42\x31\x4a\x6a\xcc\x1f\x5b\x8a\x99\xdc\xf0\xdf\x75\x91\xba\x0c\xe5\xa4\xce\x16\x3e\xcc\x69\xc0\xe7\x88\x49\xb9\xd5\xc9\x70\xdd\xc2\x87\x05\xfe\xad\xe3\xb7\x98\x74\xfe\xb6\xeb\x45\x5d\xf4\x0e\x31\x86\x03\x94\x24\x6d\x1f\xd3\xed\x16\x92\x3e\x07\x10\x17\x06\x64\xd2\xc2\xfc\x4f\x3c\xcd\xa8\x8f\x54\xb9\x88\x7a\xbd\xd5\x72\x27\x40\x1d\x3d\xcc\xab\xe0\x3b\x27\x9c\x94\x10\x37\xcc\xbf\x4f\x5e\x6f\x7e\xaa\x9b\x56\x29\xeb\x0e\x57\x98\x98\xd2\x38\x6d\x33\xa4\x37\x92\xf0\x08\xde\xb7\x3d\xbb\xeb\x39\x94\x9b\x26\xac\xb4\xfe\x5f\x4e\x98\xeb\x9b\x8c\x91\xc4\x7a\x45\x19\x2a\x2c\xad\x95\xc3\x89\x73\xd5\x25\x55\xb6\xe3\x10\x15\xb4\x53\x8d\xf2\xce\x13\xc7\x65\x00\xb3\xee\xda\x76\xdf\x4b\xdd\xa1\xda\xbe\x3e\xe6\x75\xcf\x94\x47\x7b\x2b\xca\x3c\xc5\xc1\x30\xf4\x12\x91\xa7\x5b\x3f\x75\x13\xb6\x06\xaf\x46\x1d\x78\x1a\x21\x73\x33\x3a\x2a\xcf\x51\x35\xfc\xa9\x41\x32\xa3\xdf\x94\x03\x58\x55\xde\x10\x22\xc8\xd1\xcf\xbd\xcf\x27\x55\x51\xfc\xd9\x2c\x39\xd4\xb3\x19\x50\xef\x12\x7f\x09\x05\xea\x05\xad\x64\x5a\x11\xb4\x2d\x6c\xe7\x4c\x73\x3d\x85\x1a\xd6\x7c\x26\x06\xf9\xe2\x6d\x55\x67\xaf\x88\x96\x64\x63\x17\xff\x0e\x02\x09\xa9\x23\xc3\x35\x03\xd2\x8c\x21\x38\xdf\xb4\xa5\xcd\xe2\xc7\xfa\x29\xa6\xed\xb1\x39\xdf\xd8\xbe\x73\xda\xc8\xc0\xbc\xeb\x94\x9b\x02\x75\x4f\xf2\x0b\xf7\x1c\xd1\xa1\xd5\x72\x88\xb4\x4b\xb7\xd2\x7d\xb0\x9c\x04\x78\x52\x5d\x3a\xa6\x51\x14\x62\xd3\x5e\x43\xee\xf4\x34\xc0\x0c\xde\xa6\x4d\x2f\x69\x29\xa3\x92\x83\x17\x49\x7b\xcb\x97\x63\x50\x00\x23\xa5\xc3\xb9\x65\x83\xa4\x57\x47\x0d\x2c\x0b\xa9\x15\xf5\x9f\x34\xee\x0d\x5c\x1c\x01\x5d\x1d\x97\x5b\xf5\x6b\x4a\x04\xc1\x15\xcc\x75\x8d\xec\xbe\x3d\x34\x52\xaa\x92\x23\x8a\xc2\x9d\x9d\x73\x37\x49\xb1\x17\x98\x93\x2e\xfa\x08\xa9\x94\xca\xd6\x84\x0c\xef\xb3\x9f\x36\x14\x8c\x1b\x7a\xaf\x94\x97\xfa\x14\x24\xa0\x5a\x66\xad\x36\x02\xe8\x9c\xee\x1d\x0d\xe3\x2d\x3a\x25\x3e\xdb\x45\x62\x7f\xc4\xb2\x06\xc6\xec\x62\x5e\x19\x5d\xaf\xf5\xc9\xf4\xe7\xef\xb6\xfc\x1d\xda\xcd\x4e\xba\x78\x67\x1a\x42\xd7\xd0\x98\xfe\xba\x89\x4b\x59\xb7\xa6\x6b\xbc\x86\xcb\x9d\x68\x19\x43\xa9\xf8\x2a\xdd\xbf\xdf\xbe\xaa\xc1\xf9\x6a\xc3\x68\xbc\xde\x44\x71\xa5\x25\xd2\x80\xf0\x0d\x62\x5a\x1d\xd2\x64\xd6\x91\xca\x8e\xc1\x1c\xbc\x35\x57\xa3\xcc\x63\x16\x51\x8e\xc0\x62\x71\x32\xd6\x6f\x7c\x5d\xfd\x86\x71\xcf\x4c\xb2\xb5\xb6\xf5\x6c\xd5\xfe\x06\xaf\x37\x40\x24\x53\x2f\x64\xe0\x60\x7a\x68\xc0\xaa\x9a\x1d\x69\x36\xa2\x00\x48\xe6\x4c\x46\xe6\xbe\x7d\xe5\x98\x86\x01\xf8\xf1\xc4\x3c\x16\xf6\x71\x4a\x57\x90\x9c\x92\xc8\xd7\x86\x4d\x9c\x59\x47\x85\x7c\x99\x2a\x62\xe5\x3f\xfa\xba\xc9\x47\x1d\xe9\x97\x1d\x09\xd3\xc3\xd2\x48\x6e\x92\x66\xbf\xe2\x7e\xf9\xab\x94\xca\xb9\x37\x45\xf9\xcd\x86\x84\xcf\xa8\xb7\x75\xf4\x75\x93\xdc\x98\x75\x54\x4a\x89\x29\x7e\x29\xb5\x1f\x3d\x2c\x3e\xe2\x7e\xf9\x91\x78\x94\xeb\x91\xd2\x9c\xb5\xaa\xc0\x73\x6d\x8e\x82\xc3\xc8\xad\x0e\xff\x57\x1d\x2c\xd4\x1c\x4f\x3b\x02\x70\x8e\x78\x9e\x5b\xac\x16\xbc\x8c\x72\x2c\xb8\x36\x50\x50\xb4\x5e\x0b\x07\x2c\x79\x12\x8e\xaa\x1d\xbf\xea\x08\xe6\x9e\xe4\xa1\xaa\x73\x53\xb7\x28\x75\xeb\xa5\x1a\x95\x5b\xad\x0a\xdd\x3a\xb6\x4e\x0d\xec\x8b\x56\x35\x09\x69\x56\xe6\x17\x54\x1f\xaa\x3b\x03\x87\xee\x23\xd1\xba\x13\xa5\xcc\x6f\x4b\x77\x6e\x47\xf5\x29\x61\xe5\x69\x4b\xd1\x67\x2f\xdb\xc0\x55\xd6\x5f\xf5\x81\x40\x75\x1c\xc5\xfc\xd0\x2f\x63\x9f\xa1\x88\x21\x8e\x88\xbe\xd8\xe5\xf4\xdd\xc2\xcb\xf6\xe4\xd7\xbe\x81\xca\x9d\x56\x2a\x46\xda\x79\x52\xae\x4b\xb7\x22\x8a\xa4\x90\xc7\x48\x17\x08\x06\x4a\xcc\xdf\xab\xd0\x3f\x63\x19\x02\xef\xcf\xd1\x78\x24\x04\xd4\xf8\x49\x62\x35\x12\x0c\xfb\xfc\x98\x86\x72\xfd\xf3\x51\xa8\x8a\xcc\xea\x35\x83\x24\x0e\xa1\xdc\xd5\xcd\x13\xac\xb3\x1f\x75\xb9\x72\x43\xa3\xf9\xa8\x31\x83\x8e\x3a\x24\x3b\x33\x07\xc6\x83\x30\xa3\xea\x96\xb4\xd4\xd7\x87\x5b\x0f\x57\xfb\x1b\x63\xd5\xe7\xeb\xda\xcf\x5d\xbf\x3e\x5c\x82\x63\xba\x9c\x1e\xe4\x9e\x99\x93\x9f\x30\x4b\x21\x3d\xa4\xd1\x15\x9a\x35\xd3\x18\x34\x8d\xb1\x09\xea\x37\xa3\x23\x07\xe5\xca\x1d\x38\x9e\xeb\x0e\x9e\xeb\x0e\x9e\xeb\x0e\x9e\xeb\x0e\x9e\xeb\x0e\x9e\x7e\xdd\x41\x9d\x45\x03\x5a\xc7\x57\xcb\xd0\x5a\x5b\x13\x7b\x3c\x9b\xe7\xb2\x88\xe7\xb2\x88\xe7\xb2\x88\x86\xab\xfa\x5c\x16\xd1\x88\x4c\x3d\xcb\x22\xfc\x10\x72\x8e\xfd\x9f\x28\x0c\xde\xc0\x50\xba\xf1\xec\x1c\x6e\xbf\x20\xb7\x4d\xcd\x05\x90\x08\xa8\x3e\xd4\x4b\x83\x14\x37\xed\x25\x63\xd5\x98\x5a\xfb\x13\xed\xcf\xa8\x5a\x03\xaf\xa0\x99\xca\xbc\x39\x39\xaf\x3c\x80\x69\x60\x77\x5e\x1f\x6b\x1b\x19\x06\x01\x43\xbc\xfa\x86\x2c\x7b\x4f\x94\x1e\xd3\x0b\x08\xf7\xcc\x27\x2f\xd3\xce\xba\x27\xe7\x0b\x10\x52\x7a\x9b\x8f\xbb\xed\xa7\xc7\xde\xd4\x99\xea\xd1\x6f\x46\x47\xf9\x19\xa8\x3c\x19\x27\x46\x6e\x22\x5a\x4d\x7f\x19\x13\x81\x7b\x5d\x37\x94\xbd\xab\x80\x69\x68\xe0\xc5\xf1\xe5\xec\x65\x36\xd1\x32\x19\x8f\xdb\xce\xaf\x24\x1f\x8b\x6c\x77\x27\x42\xdb\x71\xdc\x34\x88\xe2\x63\x86\x02\x5c\x3e\x18\x6e\x31\xfb\xcc\x61\xe4\xf5\xd5\xd7\xe0\x3d\x09\xa5\xe0\x44\x41\xb7\x92\x90\x65\xcc\xb8\x80\xcb\x10\x79\x11\x62\xca\x57\x26\x3e\xf2\x92\x13\x70\x2f\xb6\xe0\xbd\x2d\x0d\x90\x0e\x0a\x8c\xc1\x9d\x72\x1e\x28\x09\x77\x8a\x06\x57\x9e\xc4\x3f\x3d\x37\xef\x7a\xb8\xda\xaf\xae\xa4\xc3\x54\x6e\x46\x47\x59\x12\x6a\xdf\x75\xdf\xe4\x9c\x4b\xfb\x5c\x6e\xf6\x5c\x6e\xf6\x5c\x6e\xf6\x5c\x6e\x56\x41\xd9\xe7\x72\xb3\xe7\x72\xb3\xaa\x53\xe1\xe7\x72\xb3\xff\xd0\x72\x33\x7e\x82\xb9\x0e\x0c\x38\x42\x51\xfb\x58\xc3\x09\xc3\x39\xdc\x6d\xbc\x44\x21\x12\xa7\xea\x3e\xd6\x16\x89\x73\x85\x5e\xad\x0d\x9c\x13\xfc\x1b\x02\x1f\xcd\x70\x1f\x93\xfe\xb8\xb9\xc8\x3f\xfe\x0d\x93\xb5\x27\x36\xc8\x33\xef\x4d\xda\xc9\xf4\x8a\x03\x85\x32\xd8\xc4\xdf\x90\x48\xe9\x20\xac\xf9\xc9\xc6\xa1\xd3\x26\xb5\xff\xb1\x85\x70\xcf\xa5\x5e\xcf\xa5\x5e\xcf\xa5\x5e\xcf\xa5\x5e\xcf\xa5\x5e\xff\xc1\xa5\x5e\x8f\x54\x00\xf5\x5c\x2f\xf4\x5c\x2f\xf4\x5c\x2f\xd4\x94\xfa\x4f\x1a\xf7\xce\xf5\x42\xee\x1d\xaf\xdf\xfd\x45\xaa\x8f\x3d\x55\x13\x4f\xa0\xe0\x47\x40\xb6\x46\x42\xad\xc1\xf4\xf2\xfc\x29\x9c\x33\x69\x8c\x8c\xfd\x32\xec\x29\x53\x23\xd0\x4e\x32\x3d\xd7\x45\xd5\x99\x30\x35\x30\x9e\xeb\xa2\x9e\xeb\xa2\x9e\xeb\xa2\x9e\xeb\xa2\x9e\xeb\xa2\x9e\xeb\xa2\xc0\x73\x5d\x14\x78\xae\x8b\xca\x90\xed\x8b\xd4\x45\xe5\x43\xfa\xf9\x45\x2e\xe7\x9c\x66\x7e\xcf\xa4\x87\x64\x9f\x56\x65\x6c\xd5\x18\xd9\x65\xc7\xa2\xca\xd4\xcc\x0d\x94\xa4\x39\xd5\x9f\x3e\x64\xbf\x29\x66\xf5\x98\x9f\x86\xb8\x77\x27\x7f\x8b\x65\x9a\x83\x09\xc4\x06\x0a\x75\x1f\x4c\xe2\x63\xab\xbb\x29\xcb\x5e\x4d\xb3\x3b\x79\xba\x8f\xa3\x86\x29\x15\x92\xbc\x71\xe7\x16\x56\x16\x8a\x68\xde\x9a\x06\x5b\x4c\xd2\x74\xe8\x2e\x06\xb1\x4d\x08\x1c\xfc\x78\x28\xb9\x96\x06\x92\x1d\xb8\xce\xee\x91\x24\x09\xd1\x79\x8d\x62\xf6\x4d\x8f\xf2\xdc\xff\x27\x5f\x65\x06\xf1\xe8\xca\xb3\x90\xda\xf9\xfd\x39\xd4\x6a\xef\x48\xec\x84\xcc\xcd\xe8\xc8\x39\xdd\xc2\xa9\x53\x2b\x0d\xec\x5c\x6f\xd7\x32\x0e\xb8\x97\x54\x54\x39\xc7\xe7\xa5\xa4\xd1\x25\x94\x96\x9a\xcb\x15\x6c\xb6\x8d\x3a\x0d\xe1\xde\x41\xb3\xe9\x59\x93\x8d\x03\x85\x80\xfe\x66\xae\x72\xb1\x1f\x3d\xb6\xe0\xdc\x71\xa9\x7d\xa3\xee\xdd\x9e\x5e\x9e\xf7\x39\x4f\xbe\xa4\x83\x80\xe8\x9b\x54\x20\x61\xcc\x11\xdb\x62\x2e\x4d\x6e\xfe\x86\xc6\x24\x80\x7b\x2f\xd3\x73\x82\xbc\xc7\x62\x33\x0d\x02\x4a\xe6\xf6\x0e\xcc\xd6\x09\x14\xf9\xcf\x3b\x6e\xb9\x12\xa7\xd4\xaf\x61\xcd\xda\xd4\xd1\xbc\x05\x2d\x6b\x69\x34\xe0\xbe\x57\xa9\x67\xd3\xb3\xac\x56\xa3\x2b\x00\xd3\x3d\xd8\x72\x93\xef\x87\x57\xb9\xa3\xab\xf8\xa0\x7a\x7b\x87\xcb\x19\x59\x33\xc4\x2b\xb3\x65\x6b\xb5\x21\x8c\xa2\x33\x54\x3e\x44\xe9\x92\x0f\xb7\x8a\xc3\xd0\x1e\x6d\x08\x0a\xa6\x06\x72\xab\x13\xc0\x7a\x50\x75\x33\x98\x33\x74\x87\xd1\xfd\xe3\x4d\x04\xd8\x11\x86\x9b\x50\x02\xd2\x3d\xb1\x58\xd0\x85\x0f\xc3\xfd\x76\x4e\x93\x49\x25\x77\xec\xea\x7c\x5d\x9b\xbb\x6e\x0b\x47\xda\x1e\xd5\x36\x86\xea\x9c\x9a\x8f\x98\xd0\x37\x9a\x0d\x32\x37\xa9\x54\x8d\xbf\xad\x8c\xcf\x20\x00\x0c\xf9\x94\x05\x8a\xd8\x97\x34\x16\x08\xfc\xf5\x6b\x80\x09\xa0\x52\xea\xa9\x83\x27\x1a\xde\xe9\x0b\xd8\x4f\xce\x17\xaf\x0e\x81\xbf\x81\x61\x88\xc8\x1a\x1d\x80\x33\xca\x10\xc0\x24\x2d\x89\x36\x81\x9a\x95\x14\x4b\xe0\x7a\x83\x18\x4a\xed\x38\x39\x13\xd3\x97\x80\x1d\x60\xaa\x72\x2e\x27\x39\x05\x3f\x81\xfe\x16\x4d\x02\xc2\x5f\x1d\x4e\x98\x44\xe5\xaf\x5f\x4f\xbe\xe2\x48\x78\x71\xe4\x41\x0f\xc3\xad\xc7\x68\x88\xba\xe5\x7c\x7e\xce\x89\x97\xcd\xc6\xa1\xe6\x7e\x33\x3a\x92\x44\xad\xce\x51\x52\x25\xea\xbf\x40\xe1\xef\x95\x53\xce\xcf\xd1\x72\xaf\x6c\x6c\xca\x65\x04\xdd\x83\xd3\x37\x0b\x70\xbc\x98\x81\x17\xa7\x21\xe4\x02\xfb\xe0\x4d\x48\xfd\x5b\xb0\x10\x92\x7c\x89\xad\xaa\xfe\x0f\xd7\x08\xcc\x88\x40\x6c\x05\x7d\xf4\x12\x04\x0c\xdf\x75\xdc\x68\x83\x0d\xee\xa6\xd0\xaa\x9b\xf6\x40\x0f\x02\x31\x02\xc3\x9a\xa2\x9f\x26\x14\x86\x81\xb1\x8c\x2d\x3c\x2f\x20\x1c\x44\x8c\xfe\x0b\xf9\x22\xb9\x19\x5c\x49\x18\x5d\xe7\x9b\xb0\x76\x2b\x5a\xf6\x18\xc6\x39\xfb\x15\xaf\x4c\x2a\xad\xa5\x1a\xde\xc2\x35\x7a\x13\xe3\x30\xe8\x27\xfe\x54\x5a\xb2\xc6\x57\xe9\x97\xd3\xe3\xcb\x94\x2f\x52\x5e\xb8\x44\x6b\xcc\x05\xdb\xbd\x34\x0a\xe8\x00\x5c\x6d\x30\x07\x98\x83\x98\xa3\x55\x1c\x2a\x00\x4b\x89\x0e\x26\xeb\xb1\xfa\x9f\xb9\xe8\x7e\x0c\x20\x38\x9e\xa9\xda\x04\x29\x3c\xa4\xa3\x4f\x10\xd2\xc2\x25\x8a\xf9\x06\xa8\x99\xa8\xff\x9e\x1e\x5f\xb6\x5b\x8b\x27\x86\xbb\x73\xa1\x1e\x2e\x61\xa5\x41\x5f\x5e\xe1\x56\xb6\x76\x8e\x07\xdc\x4a\xbf\x62\x9f\x55\xa8\x51\x87\x4d\x57\x6d\x24\x15\x84\x63\x81\xa7\x0b\x82\x61\xec\x34\x36\x8b\x64\x72\x8b\xeb\xc7\x30\xd2\xa5\x85\x9c\xec\xd6\xae\x96\x79\x1e\x48\x85\x39\xbe\x27\x83\xb9\xea\xf6\xe0\x5c\xe7\xa7\x12\x05\xaa\x0c\x79\xdf\x04\xf3\x2f\x91\x29\xc0\x1c\x22\x63\xcd\x02\x05\xcc\x40\x55\x39\x6b\x75\x05\x22\xd9\x4a\x62\xe4\xbf\x9e\xc4\x1c\xb1\xb5\x2a\x11\xb1\xb0\x3c\x0b\xcb\x14\xba\xa9\x5d\xa7\x5a\x3f\x77\x2b\x6d\x2b\x65\xb1\x0d\x8a\xde\xcd\xe8\xc8\x45\x84\x24\xa9\xad\x0e\xf1\x66\x99\x6d\x9f\xef\x5e\x15\xe7\xf0\x5b\xf8\x30\x67\xb8\x9a\x5d\x74\xd1\x4a\xe5\xc4\x28\x01\x01\xda\x42\x12\x80\x48\x41\x71\x8e\x41\xc9\x89\x7a\xe7\x0d\xe4\xa8\x69\xf1\x5e\xc5\x80\xee\x23\x73\x3b\xc0\x1c\x31\x1f\x11\x01\xd7\x68\xba\xa4\x77\xa8\xc7\x78\x39\x16\xbb\x54\xf7\xa7\x5f\xbf\xf2\x0e\x5f\xbd\xfa\xd0\x8a\x39\x6b\xbe\x4c\xe7\x74\xf8\xca\x3d\x2b\xc9\x5b\xd3\x30\xa4\xbe\x32\x86\x17\x82\x41\x81\xd6\x9d\x42\x44\x12\x92\x15\x47\x73\x4a\xcb\x09\xfb\x1d\xa8\x71\xe8\xbd\xee\x46\x0c\xc7\x87\x29\x2d\x5e\x77\x55\x88\x55\xfd\xf2\x12\xfe\xde\xc7\x8f\x2d\xd9\xa9\x96\xba\xfb\x17\x71\x5c\x27\xb9\x07\x54\x7b\xee\x80\xf1\x75\x5e\x6c\x25\x69\xc8\xf2\x71\x5a\xcc\x3b\x69\xde\x99\xac\x6e\xb0\x52\x7e\x71\x61\x94\x9b\xd1\x51\x1e\x9d\x9a\xfe\x61\x8b\xb7\xcd\xa2\x5a\x2a\x14\x39\x3b\xf9\x72\x99\x70\x1a\x03\xc4\x41\xba\x74\xc0\x9e\x59\xeb\x14\x35\xde\x2f\x51\xb0\xd3\x00\x4e\xe1\x70\x8f\xc5\xe6\x27\xea\xc3\xb0\x97\x33\xa1\xd0\x01\xb0\x80\x03\x90\x8c\x1f\x6a\x44\xb2\x99\xca\xe0\x9c\x0a\x7b\x6b\xbe\x49\x5d\x29\x75\x67\x6c\x67\x06\x3c\x3e\x02\xa9\x90\x12\x2c\x76\xd7\x08\x4b\x52\x2e\x36\x90\xf5\xab\x63\x35\x53\x51\x5d\xfe\xf2\x93\xe1\x0a\x36\x80\x5b\x4a\xd6\xca\x18\x4d\x71\xd5\x05\xa3\xdd\x2a\x27\x86\x1f\xb0\x8a\x56\xad\x64\x7a\xba\x8b\xdd\x24\x76\xf2\xf0\x20\xb2\xd3\xa7\x44\x30\x1a\xf2\xd2\x8e\xaa\x49\xe4\x6f\x72\x88\xda\x14\x66\x85\xf0\x5b\xfc\xd8\x2c\xa4\x1f\xd2\x5e\x81\xec\xd9\x0a\x48\x8d\x75\x2f\xfd\x64\xb9\x7c\x6a\x99\x17\x8b\x1f\x0b\xb2\x3d\x62\xf4\x0e\x07\x28\x30\xee\x74\x30\x06\x54\x6c\x10\xbb\xc7\x1c\x01\x2c\xe4\x53\xbc\x26\x94\xa1\xe0\x00\x5c\x90\x70\x07\x28\x41\x80\xae\xc0\x3c\x5e\x86\xd8\x7f\x87\x76\x73\x28\x36\xe3\xf4\xbf\x2a\xe1\x3b\xf9\xdf\x39\xdc\x22\x1b\x40\x4c\x32\x60\xdb\xd5\x54\x3d\xe1\x69\xb8\xa3\x61\xca\x91\x59\xf0\x52\x01\x74\x7b\xcf\xad\x14\xda\xbd\x96\xf3\xa6\x44\x50\x53\x3b\x11\x73\x4c\xd6\x60\xb1\x38\xfb\xf0\x62\x82\x25\x5f\x06\xb1\xca\x94\xf9\x8a\xf3\x8d\xa7\x63\x25\xed\x42\xca\x15\xe3\x66\x74\x7f\xc5\x30\x37\xa3\xa3\x2a\xdc\xaa\x23\xba\x91\xa5\x6f\x8f\xe4\x56\xbd\x46\xe0\x16\x29\x44\x97\x48\x2a\xd2\xb4\x28\x41\xa3\x22\x31\xbb\x45\x3b\x7f\x03\x31\x39\x00\x59\x86\x52\xe2\x43\x6f\xdb\x3b\x18\xc6\x28\xcb\x27\xad\x08\xf7\x88\x68\xd4\x93\xae\xc1\x09\x76\x43\xf2\x11\xc9\xe4\x98\xa8\x32\x8d\x27\x42\xca\xc7\x44\xa9\x9e\xac\x52\x1c\xf4\x20\xeb\xd5\x06\x81\x08\x8a\x8d\xc5\x54\xe2\x18\xf5\x62\x11\x23\xfa\x92\xa9\x18\xd5\xac\xac\xc3\x9b\xd1\xff\x9b\x1c\x70\xbe\x99\xe0\xe0\x9f\x8c\xc3\x83\x28\x5e\xde\x8c\xb2\x02\x50\x0e\xd0\x6f\x51\x3e\xef\x84\x74\xd6\x71\x69\x52\xfa\xf1\xfe\x89\xb9\x9d\x67\x55\x8f\xb4\xc8\x26\x9c\xce\x1e\xb9\x92\xb6\xab\xc1\xa4\x34\x7f\x25\x57\x3a\x05\x68\xa5\x68\xd8\x4f\x01\xa7\xee\x1a\xd0\x77\x95\xf6\xa6\xb2\x49\xd3\x9a\xc7\xbc\xea\x56\x99\x82\x7d\xb2\x2c\xda\x40\x77\xdb\x64\xfa\xda\xa8\x26\x09\x88\xab\x15\xf2\x1b\x96\xa7\xdf\x7e\xcb\x0f\x30\xfd\x04\x23\xfc\xc9\xa7\x0c\x7d\xba\x3b\x3c\x50\xe3\x9c\x6a\x18\x4e\x2e\xbd\xdd\xab\x0c\x9d\x9f\xa9\x3d\xd0\xf8\xc3\x56\xdc\x78\x9b\xe7\x2e\x3d\xd2\xb8\x44\x91\x41\x18\x26\xdb\xec\x1f\xbc\x8b\x97\x88\x11\xa4\xb2\x4b\xd5\xf2\x34\x65\x8c\x7a\x28\x6e\x06\x78\xef\xae\x29\xab\xe4\x83\x2d\x7c\x78\x4f\x4c\x8a\x7f\xd8\xab\x2a\x89\x23\x91\xf4\x19\xca\xf4\x16\x32\xc5\xb1\x1b\x28\x8c\xe1\xe9\xd3\x2d\x02\x71\x3a\x26\xb8\xdf\x20\xa2\x8b\xc8\xa4\xa1\x95\xc9\xb5\x05\x2f\x6c\x1f\x82\x00\x40\x6e\x60\xb6\xb3\x03\x3f\x1b\x52\x4e\x6e\xce\x13\x37\x0d\xdf\x3d\x69\x32\x47\x09\x9a\x4f\x8c\xd4\x59\xc4\x3a\xca\x80\x02\xb7\x37\x59\xaa\xc1\x1c\x78\x95\xb1\xec\x0e\x49\x26\x93\xef\x92\x89\xdb\x05\x76\x4e\x76\x5c\xcc\x4e\x8e\x67\x01\x22\x02\x8b\xdd\x5c\x7b\x9f\xac\xe1\xc5\x08\xd9\x73\x23\xce\x63\xc4\xde\x5f\xfe\x94\x3f\x3b\xc5\x88\x88\xd9\x49\x8b\x73\x43\xfb\x45\x07\xe5\xa1\x03\x18\xc7\x21\xc4\x95\x3e\xeb\xfe\xcf\x7b\xb4\xd7\x4a\x28\xd0\xe1\xe3\xae\xad\x75\xec\xe2\xa8\x59\x3f\xed\xc6\x47\x5f\xba\xef\xc1\x5e\x04\x63\x8e\x98\x5c\x87\xce\x1c\x64\x01\xb4\xe4\xa1\x56\x22\xac\xdd\xbe\xab\x9c\x5d\x35\xd6\x15\x1b\xaa\x6a\xa3\x54\xf3\x62\x71\xe9\x07\x34\xc5\x95\xa1\x1c\x21\x5f\x25\x22\x13\x20\x25\x98\x0d\x9c\xb1\x6c\xa3\xde\xd3\x77\x0b\x00\x63\xb1\xf9\x8d\x74\x38\x3a\x6a\x39\x40\x5e\xa6\x46\x88\xc1\x7c\x8b\xbd\xea\x00\x69\x42\x86\x1f\xc2\xf8\x61\xca\xca\xbb\xe4\xf3\x35\x4c\x48\x0f\x6f\x7c\x5d\xa2\x07\x42\x4c\x10\x80\x6c\xad\x2a\x86\x6c\x74\x18\x01\x89\x2a\x08\x20\xda\x52\x02\x4e\x4e\xe7\x97\xa7\xc7\xd3\xab\xd3\x93\x56\x06\x43\xef\xc1\x9c\x7b\x30\xa5\xe6\x8f\x28\xdc\xda\x75\xf8\x0f\xa1\xaa\x44\x19\x58\x9c\x1f\x9f\xae\x95\xc3\x39\x29\x2b\x81\x61\x61\x5f\x3f\x83\x04\xaf\x10\xaf\x6e\x6b\xdd\x24\x3c\x7c\xac\x40\xaa\x18\xb5\xca\x62\x53\x0b\xbd\xb5\x90\x6d\x04\xe6\x2d\x16\xe0\x12\x45\x14\x50\xa2\x4f\x65\xc3\xb0\x2b\x6d\x06\x19\xd0\x49\x1d\x55\x5e\xdc\x23\xd2\x26\xc7\x54\x30\x24\x12\xb7\x08\x45\x40\x30\xe8\xdf\x4a\x01\x24\x91\xfc\x13\x07\x7c\x47\x7c\x29\x84\x54\xc6\xda\x3f\x74\xc8\x09\x73\x20\x85\xee\x1d\x0c\x11\x11\xf2\x4b\x53\x6a\x2b\x0d\x3e\xcf\x5b\x63\xe1\xc9\xaf\x3c\x01\xd7\x6a\xce\xfa\x11\xa1\x02\x71\x8f\xa1\x15\xc0\x44\x53\xa0\x23\x35\x9f\x0a\xce\x95\x16\x15\x8f\x60\x75\x2a\x51\x83\x45\x39\xd6\x87\x89\x20\x81\x25\x9d\x15\xa6\xfa\x5a\x5b\xbe\x50\xb8\xe8\x6b\xf9\x8a\x1b\x0a\x1d\xac\x0f\xc0\xaa\x07\x7d\x1f\x61\xf8\x0a\x23\x12\x06\x17\xa4\xfa\x8a\xad\x46\xa7\x74\x84\x0b\x16\xfb\x42\x63\x24\x28\x90\x40\x3d\xd5\x51\x7d\x4b\x03\xdd\x3f\x49\x77\x23\x56\xa8\x06\x28\x0a\xe9\x4e\xc5\x5c\x21\xcf\xbc\xdb\x91\x52\x8f\x3c\x7a\xb3\xd4\xb9\x7b\x2c\x36\x72\x09\xfa\x92\xd1\x86\x02\x07\xd1\x06\x8d\x00\x76\xb4\x45\xab\x34\x42\x49\x2c\x3a\xb7\xa5\x8b\x72\x2e\xa6\x74\x2a\xf7\xc4\x54\x6a\xa6\xfa\x07\xb1\x3d\xcd\x01\xb9\xa4\x66\xde\xcf\xb6\x3d\x89\x19\x0a\xa1\x48\x4f\x0e\xa8\xa5\xb4\xba\x31\x23\x11\x91\x69\x92\x42\xb2\x71\xa5\x20\x65\x28\xa2\x1c\x0b\xca\x76\x52\xc4\x49\x11\xd8\x3c\x06\xf0\xf9\x31\xcb\x59\xbb\x73\x47\xbb\xd3\x9a\xab\x43\x69\x1c\xb5\xaa\x57\x6d\xc5\x93\x29\xf8\x41\xd6\x3c\xed\x84\x5a\xee\x82\x9a\x94\x16\x35\x5e\xa7\x66\xd0\xf2\xb4\xd5\x4d\x4a\x8c\x2a\x68\xe7\x4f\x9c\x92\x20\xa2\x98\x88\x85\xbe\x8e\xa1\xa3\x05\x3c\xce\xff\xea\xec\x7a\x63\xf3\xe4\xcb\x24\x49\xd1\x4a\x73\x9d\xcb\x3f\x86\x74\xcd\x47\xb9\x87\x1f\x06\x30\xbc\x53\x72\x67\xb2\xd2\x90\x21\x8a\xbd\xa4\xc2\x04\x27\xb7\x31\x17\x60\x89\x80\xed\x83\x2f\x6d\x64\xdb\x3d\xd4\x56\x6b\xe8\x36\x4b\x88\x08\xa6\x60\x9a\xfe\x53\xf9\x89\xdb\xab\x1b\x33\xd3\xb5\x8f\xe4\x24\x5b\xdf\xd9\xf8\x19\xe6\x90\x6d\x95\x94\x9f\x4c\xcd\xbd\x88\x99\xf9\xd5\xbc\x25\xa7\x5c\xb8\x23\xd1\xa9\x3a\x51\xff\xeb\x16\x6c\x6d\x99\x52\xf3\x4a\xf6\xad\x00\x54\xe5\x38\x3b\xdb\x2e\xb6\x5b\x1f\xdf\xae\x70\x6b\x8c\x86\x56\x12\x0d\x95\xdb\xd9\xd4\x6c\xf1\x41\xa4\x5e\xf6\x16\xa4\x72\x48\x79\xdf\xec\xdb\xdc\xb1\xd4\x1c\x7a\x41\x2a\xaa\xc2\xfd\x26\xe2\x90\xc6\x22\x8a\x45\xcf\x3c\x88\x0b\x05\x04\x04\x98\xa9\xf6\x41\xbb\xc4\x85\xb6\x37\xff\x05\xf2\x2f\x89\x12\x10\xe6\x46\x73\x0e\x5e\xac\x55\xb7\x34\x81\x92\xdf\x8c\x3f\xde\xee\x60\xe5\x51\xc7\xce\x30\xe9\xc1\xe4\xfb\x7f\xc7\xd8\xbf\xe5\x02\x32\xe1\x49\xa5\xef\x49\x63\xad\x22\xe7\x89\x21\xdd\x9e\xad\x07\x51\xe9\x4a\x4d\xe3\x7f\xcb\x41\xc1\x42\x8e\x6a\x91\x3d\x00\xc7\x3a\x49\x0d\x82\x25\x83\xc4\xdf\x8c\x81\x74\x07\xd5\xd5\x5b\xca\x81\xdf\x40\xbe\xc9\x18\xb0\xed\x44\xea\x90\xe3\x3a\x69\xa3\x13\x14\x7a\x50\x46\xe5\xe9\x51\x06\xde\x5f\xfe\x04\xaa\xb1\x6d\x35\xe9\x2e\x20\x4d\xf1\x21\x2f\x37\xb9\x83\x51\xe4\x05\xe8\x6e\x88\xe6\x74\x86\x58\x2e\xd6\x1a\x3b\x77\xf1\xd0\xb6\xbc\xbe\x0c\x46\xdd\x75\x03\x41\xba\x03\xd2\x4d\x45\x8d\x42\xb5\x97\xbd\x65\x6d\x64\x18\x04\xd9\xa8\x51\x6a\x2a\x77\x32\xde\x1f\x0b\x95\x9c\xec\xbc\x44\x11\x6d\x22\x38\xf5\x0e\xe8\x99\x3c\xb6\xc6\xc2\x6c\x25\x10\x93\x00\x31\xdb\x2d\xd2\xe0\x5d\x10\xff\x58\x2a\xda\x7b\x1c\x86\x3a\x41\x55\x6e\x39\xe9\xb5\xfc\x97\x0a\xd6\xa1\x60\xac\x63\x1a\x5b\xa8\xbe\xed\xe8\x10\x0f\x87\x15\xdc\x46\xff\xd8\x87\x99\xdb\xda\xd9\x42\xdc\x37\x56\xa8\x60\x18\xbc\x2d\x6e\xd6\x9b\x33\xc2\xca\xdf\x40\xb2\x46\xbc\x4f\x74\xaf\xdb\x28\xce\x49\xaf\xc2\xf8\x61\x80\x6c\xc4\x54\x0d\x66\x57\x4e\x85\x7d\xea\x96\xed\x9e\xc9\x45\x23\xe3\x34\x2a\x36\xe9\xc3\x40\x8f\x83\x85\x93\x6e\x11\x14\x9b\x2f\x77\x76\x71\x89\x42\x28\xf0\x9d\x4e\x9a\xd4\x77\xa8\x9a\x42\x8b\x42\x64\x40\x53\xc0\xfc\x70\x11\xf1\x34\xc6\xa0\x26\x6f\x2e\xda\x92\x7c\xb3\xc2\x24\xc8\xa6\x33\xe5\xc2\xef\xaa\xcd\xb8\xa1\xcf\xf5\x8d\xea\x44\xe8\xf1\x1d\x17\x68\x7b\x33\x1a\x83\x9b\xd1\x12\x72\x74\x33\xfa\xd0\x75\xed\xbe\xe8\x74\xb4\x23\x94\x99\x92\xcd\x03\xd5\xff\xca\xa9\xe9\xbf\x3e\xec\x67\x0a\x6d\x1c\x2f\x16\x3f\xf6\xcf\xf1\x9d\x67\xd2\x61\xad\xd1\x6d\xd2\x5d\xed\x51\xa7\x9c\x49\x2c\x36\x88\x08\xec\xcb\x9f\x3b\x52\xbf\xdf\x48\x4e\x42\xc4\xac\x8f\x20\xbd\x32\x0b\x2f\x91\x90\x86\x91\xc1\xad\xc4\x07\x6a\xcd\x4d\xa2\x4d\x4e\xef\xe6\x36\x7b\x6b\x29\xf2\x58\x43\x57\xdb\x6d\x6b\x2c\xfe\x67\xda\x1f\xf1\x3b\xca\xd6\x13\x39\x62\x85\x1d\x57\x48\x12\xe8\xa9\xb1\x24\x88\x47\x57\x58\x9d\x06\xe9\x68\xb9\x4a\xde\x1b\x97\xec\xa5\x92\xe8\x76\xe9\xc0\x22\x65\x4b\xc6\x41\xed\x5e\x1f\xda\x02\xde\x1b\x33\x86\x45\xf1\x98\xdc\x01\xad\xa5\xe3\xe3\x44\xaa\xf7\x8f\x9a\xb3\x6b\x17\xc8\x67\x48\x70\xd3\xed\xb8\x51\x73\x8b\x5b\xb4\x9b\x5e\x9e\x37\xcf\x4e\x33\xef\x3f\x56\x7e\xb2\x13\x97\xe1\xe3\x37\xef\xce\x16\x00\x25\x54\x4a\xf2\x5a\x06\x8a\xdf\x54\x41\xcf\xad\xd5\x2f\x28\x0c\xdf\x11\x7a\xdf\xae\x39\xe0\x20\x2d\xe4\x54\xdf\xa4\x5c\x7b\xa4\x72\x9f\xb7\x03\xb0\x40\x08\x5c\xa7\x0f\xc0\xf4\x97\x05\x08\xa8\xcf\xeb\xdb\x8d\xa0\x5b\x6e\x2f\xa3\xcd\xb4\xf2\x28\x83\x57\x9d\x46\xda\x05\x2d\x9a\xa3\xdd\xac\xf5\x48\x1b\x54\x6f\x46\x47\x0e\x52\x7c\x3f\x81\x47\xd5\xdd\xf2\x6b\xce\x49\xe1\x3d\xcf\x76\xb6\x3e\xd6\x95\xa8\x83\x2f\xab\x2e\xdc\x53\x15\x80\xf7\xdc\x0b\x29\x0c\x3c\xd3\xd1\x80\x79\x7e\x32\xa6\x59\x6a\x89\x10\xb0\x18\x75\x5d\xe9\xda\x71\x06\x59\xf3\x36\x73\xea\xc1\x07\x7b\x27\x72\x33\x3a\x2a\x53\xac\x33\x43\x0c\xd4\x40\x51\x91\x2b\xdb\xc6\x2f\xa1\x9d\x59\xe4\xdc\x6f\xf9\x35\xee\xd4\xfd\xaf\xcb\x72\xd6\xe0\x37\x50\x4f\xc2\x9b\xd1\x51\x69\xa2\x9d\x97\x06\x2d\xf9\xf1\x62\xf6\xf8\x5b\x14\x2d\xb9\xe7\x73\x5c\xde\x98\x92\x15\xed\x8f\xba\xe9\x5f\x61\xe5\x32\xed\xbe\x6f\x13\x2f\xcc\xe3\x78\xcd\x27\xe5\x6f\x6d\xbb\x46\xfd\x3f\x2f\x4a\xda\xf4\x0e\xb8\x33\xab\xa6\x52\xdb\xa9\xbc\x07\xea\x52\x3a\xbb\x89\xd4\x7d\xd5\x57\x9f\x69\xd5\x57\x75\xab\xbe\xaa\x59\xf5\x82\x14\x5b\x86\x74\xad\x76\x86\xee\xb4\xc7\x93\xf2\x6e\x4c\xd6\x29\xa0\x1d\x81\x5b\xec\x7b\x91\xbd\x5a\x06\x93\xf5\x90\xeb\x5e\x31\x99\xf2\xba\x0f\x85\xbc\x5d\xf9\x32\xa1\xba\xaf\xfc\x80\x3d\x30\xf3\x8d\x29\x6b\x1a\x52\x9a\x45\xcf\xbd\xdf\x78\x93\x67\xbf\x92\xa4\x5c\x4e\x74\x14\x56\x0b\x4b\x11\x0b\xca\x30\x0c\xd5\x8e\x3a\xd8\x06\x5d\xd6\xbb\xe5\x3c\x5a\xed\xf3\x76\xd8\xdf\x8c\x8e\x4a\x44\xea\xbc\xd4\x5f\xba\x71\x67\xbb\x85\x18\x64\x90\xa1\x8e\xe4\xdb\xf5\xbb\xac\xb6\x77\x2b\x36\x5e\x85\x69\x54\xa7\x96\xeb\x84\xf7\x20\x2e\xa5\x3a\x9a\x57\x1d\x70\xa4\xbc\xf3\xe9\x76\x4b\x49\xda\x30\xbb\x4d\xef\xca\xfd\x90\x72\xae\x62\xba\x79\x3e\xdd\x23\x78\x87\xee\x29\xbb\xe5\x9f\xf4\xdd\xdc\x9f\xa2\xdb\xf5\xa7\x58\xe0\x90\x7f\xc2\x11\x41\xe2\x60\x36\x3f\xcf\x5f\xc0\x52\x11\xa3\x2a\xf1\x30\x01\xb3\x39\x80\x41\xc0\x10\x57\xad\x84\x8e\x67\x27\x97\x80\x50\xd1\x2e\xbf\x6b\x0f\x98\xdc\xbc\xf6\x54\x55\x57\xcf\x21\x07\x25\x7f\x2f\x68\xe6\xa3\xf2\x09\xc1\xbe\x8b\x17\xae\xd2\xb2\xe2\xec\x3e\x70\x9f\x15\x94\xea\x72\x20\x09\x42\xc4\x41\x4c\xb6\x90\xf1\x0d\x0c\xd5\xe5\x97\x4b\x2a\x36\x60\x0b\xa3\x6b\x8d\xfa\x07\xfd\x8f\x3a\xad\xbc\xfe\x50\x18\xb8\x29\x8d\xfb\x8f\xf4\x07\xbb\xe1\x7f\xff\xc3\xef\x7f\xf8\xff\x01\x00\x00\xff\xff\x0a\xa2\xe4\x70\x67\x56\x01\x00")
+var _schemaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x6b\x73\xdb\xb6\xb6\xe8\x77\xff\x0a\x8c\x7a\xe6\xde\x64\x8f\x1e\x71\xda\x66\xb7\x39\xfb\x66\x46\x75\x1e\x5b\xa7\xb5\xa3\x89\x9c\xf6\xec\xc6\x99\x0a\x22\x21\x09\x35\x45\x70\x03\xa0\x1d\xb5\xc9\x7f\xbf\xb3\xf0\x20\x41\x12\xa4\x48\x49\x8e\xd3\x39\x67\x26\x1f\x62\x91\x04\xd6\x5a\x58\x6f\x2c\x2c\xfc\x79\x82\x50\xef\x3f\x38\x59\xf6\x9e\xa2\xde\x57\xa3\x90\x2c\x69\x4c\x25\x65\xb1\x18\x9d\x45\xa9\x90\x84\x9f\xb1\x78\x49\x57\xbd\x3e\xbc\x28\xb7\x09\x81\x17\xd9\xe2\x77\x12\x48\xfd\xdb\x7f\x88\x60\x4d\x36\x18\x7e\x5e\x4b\x99\x3c\x1d\x8d\x7e\x17\x2c\x1e\xe8\x5f\x87\x8c\xaf\x46\x21\xc7\x4b\x39\x78\xf4\xf7\x91\xfe\xed\x2b\xfd\x9d\x33\x55\xef\x29\x02\x38\x10\xea\x8d\x7f\x99\x5d\xb0\x90\x98\x39\xed\xcf\x08\xf5\x12\xce\x12\xc2\x25\x25\xf9\xcb\xf0\xaf\x17\x92\x88\x48\x32\x59\x4e\x39\x11\x24\x96\x85\x87\x0e\xc0\x0b\xc6\x22\x82\xe3\x5e\xdf\x7d\x18\x12\x11\x70\x9a\x00\x08\x00\xbd\x1e\x4a\x20\xb9\x26\x08\xdf\x8a\x41\xcc\x42\x82\x42\x4c\x36\x2c\x16\x44\xa2\x17\x3f\xce\x10\x8d\x85\xc4\x51\x24\x10\x8d\x51\x4c\x6e\x51\xa0\x49\x24\xfa\x68\x41\x96\x8c\x13\xf8\x96\x72\x04\x5f\xae\x38\x4b\x13\x81\x30\x27\x28\xe0\x04\x4b\x12\x0e\xd1\x1b\xf2\xef\x94\x72\x22\xd0\x3c\xa4\x02\x2f\x22\x32\x2f\x02\xf4\x61\x40\x63\x49\xa2\x88\xfe\x3e\x58\xcb\x4d\x34\xb8\x3f\x00\xff\x11\xb0\x90\x3c\x33\x50\xfe\x63\xa4\xfe\x2a\x13\x6f\x89\xd3\x08\x08\xde\x5b\xe2\x48\x90\x5e\xf6\xf0\x53\xfe\x5e\xcf\x8c\x70\xc8\xb2\x08\xc9\x12\x81\xc8\xb5\x08\x64\x84\x96\x9c\x6d\xd0\x06\xc7\x78\x45\xe3\x55\x46\x84\x3e\x5a\x32\x9e\xe1\x8a\xe4\x1a\x4b\x94\x0a\x82\x70\xcc\xe4\x9a\x70\x74\x76\x31\x41\x49\x94\xae\x68\x8c\x44\x1a\xac\x11\x16\xe8\x8c\x46\x34\xdd\x0c\xd1\x44\x22\x2a\x50\x4c\xa8\x7a\xd1\x90\x8f\x84\xf0\x0a\x8e\x11\x0e\x43\x16\xa3\x98\x71\x94\x26\x21\xac\x21\xba\xa5\x72\x0d\x44\x44\x06\x7f\xfd\x8a\xe8\xb4\x8e\x7f\x41\x8c\xda\xad\x76\x4c\xe4\x2d\xe3\xd7\x53\x16\xd1\x60\x5b\x5e\x73\xbf\x92\x31\x02\x7f\x51\xf8\xb2\x89\x1d\x02\xa5\x1a\x52\x6e\xe4\x80\xc4\x4b\xc6\x03\xb2\x21\xb1\x44\x6c\x89\x7e\x4c\x17\x84\xc7\x4a\x4a\x0c\x30\x28\x01\x68\x28\x11\x68\xb1\x55\x68\x16\x7e\xdf\x22\xbc\x32\x9f\xc2\xb3\x9b\x24\x18\x04\x31\xd5\x24\x18\xa2\x19\x21\xe8\xdd\x45\x69\x9c\xf7\x0f\x46\xa9\xc0\x2b\x32\x82\x97\xcd\x60\x34\x5e\x8d\xbe\x32\xff\x1f\xd8\x17\x1f\x76\x62\x8a\xcf\x8d\xd7\x3f\x30\x5a\x73\xb2\xfc\x7f\x57\xbd\x96\xe8\x5c\xf5\x9e\x95\x49\xf1\x8f\x11\x7e\xe6\x70\xc2\x49\x89\x23\x7a\x09\x27\x4b\xc2\x39\x09\x5f\xf3\x90\xf0\xde\x53\xf4\xae\xaa\x19\x72\x1a\x55\x74\xb9\xf3\x28\x2e\xf0\x87\xf9\xfd\xbd\x7d\xa1\x87\xc3\x50\x19\x2d\x1c\x4d\x5d\x3b\xa1\x14\x53\xff\xc4\xcf\x48\x6b\x16\x85\x9a\x87\x2c\xe9\x31\x3c\xb2\x24\xf3\x28\x58\xf3\x64\xbc\xc1\x7f\xb0\x18\xfd\x3c\x3d\x73\xc4\x30\xc3\x63\xd7\x3a\x1f\x79\xda\x13\x87\xe2\xd6\x7a\x5e\x14\x88\xd5\xc2\x88\x92\xf8\x50\x25\x2d\x53\x1e\x0b\xc4\xe2\x6e\x9c\xd8\x47\xb7\x6b\x1a\xac\xd1\x26\x15\x12\x2d\x08\x8a\xa8\x00\x8d\x44\x63\x34\x57\x12\x28\xe6\x5a\xdf\xde\x10\x2e\x80\x46\xa7\xc3\xd3\x6f\x86\x8f\x10\xe3\x08\x2f\xd8\x0d\x71\xec\x95\x23\x1f\xa7\xc3\xc7\xdf\x66\xaf\x74\x12\xc1\x63\x23\xa1\x8d\xa8\xc6\xc4\xd8\xd0\xe3\xe2\xd3\x4e\x2b\x6b\x15\x49\xe3\xd5\x39\x0b\x6b\x17\x59\x48\x4e\xe3\x55\xe3\x1a\x67\xe3\xa0\x0d\x30\x28\x5b\x56\xe9\x04\xea\x88\x2d\x95\x6b\x94\xb0\x50\x0c\xd1\xcf\x38\xa2\x21\xba\xc1\x9c\xe2\x58\x2a\x67\xe3\x29\x9a\x5f\xf5\x84\xc4\x71\x88\x79\x78\xd5\x9b\xa3\x07\x06\x8b\x87\x4f\xd5\x37\x08\x07\x01\x49\x24\xc2\x51\x84\x24\xc7\xcb\x25\x0d\x50\x1a\x4b\x1a\x55\x67\x12\x24\x22\x81\x04\x28\x36\xff\xa9\x47\xe5\x34\x90\x57\xbd\xb9\x19\x29\x24\xf1\xb6\xcd\x38\x38\x8a\xd8\x2d\xa2\xb2\x13\xb3\x1c\x8b\x1a\x9a\x49\xfe\xcf\xbf\x53\x26\xff\xd3\x92\x45\xff\x65\x59\xe6\x48\x04\x2a\x4e\x04\x94\x2a\x4c\x73\x14\x9a\x19\x48\x81\x3e\x16\x97\xe2\x0b\x24\x4e\x37\x05\x3b\x00\xff\xfc\xef\xaa\xdf\x01\xcc\x9c\xab\x11\x7a\x9f\xfd\xff\xd3\x49\x89\xd3\x1b\xad\x8d\xd1\x70\xf9\xf8\xf9\xfa\x29\xa9\x38\xb2\x45\x29\x90\x6b\x8b\x04\x91\x92\xc6\x2b\x25\x1b\x56\xc1\x67\xb8\xb6\x37\x18\x6d\x46\x2d\xda\x83\x5f\x67\xe9\x22\x26\xf2\x1c\x27\x09\x48\x77\x2e\xfb\x75\xf8\xfd\x79\xb2\xcb\x5f\x33\x43\xce\x12\x12\xf4\x2a\x4b\xe0\x89\x0f\xeb\x09\x25\xd4\x40\x48\x32\x34\xfe\x15\x6d\x34\x88\x62\x88\x26\x5a\x92\xae\xc9\x16\xfc\x58\x1c\xa3\xf1\xaf\x7d\xed\xd2\xe3\x48\x30\xb4\x20\x01\xdb\x18\x27\x29\xc6\x9b\x4c\xf2\xcc\x68\xca\xe1\xbf\xa5\x82\x28\x77\xd9\x0e\x24\x19\x52\xcc\x01\x93\xc9\x35\xb5\x73\x0f\x3b\x2e\xc2\x17\x05\xb1\x23\x6b\x7f\x7e\xf2\xaf\xbb\x5a\xa4\x16\xf6\x1f\xff\x71\x80\x59\x08\x70\x0c\x66\x8f\x6d\xa8\x54\xc6\xbb\x4a\x8c\xe2\xe7\x3b\x28\xdd\x62\xb8\x6c\xb4\x8c\xf1\x10\xea\x05\x34\xe4\xed\x42\x8e\x15\x95\xeb\x74\x31\x0c\xd8\xe6\xe3\x2d\xc1\x37\xe4\x96\xf1\x6b\xf1\x51\x87\x63\x1f\x93\xeb\xd5\xc7\x54\xd2\x48\x7c\xa4\x49\x4c\xe4\x70\x32\xbd\x20\xd2\x3f\x23\x0d\x77\x50\x6d\x4f\x5d\x45\x5d\x3d\xd8\xc3\x7f\xb8\x7f\x29\x2c\x3b\x29\xab\x22\x63\x80\x2f\xe2\x40\xdd\xe3\xda\xe1\x08\x8b\x10\x00\x97\x56\x67\xa9\xe5\x1e\x29\x71\xb0\xae\x78\x9b\x0d\x2b\x30\x89\x23\x1a\x93\xe7\x2c\x48\x37\x45\x3f\xbf\x4e\x55\x60\xab\xf3\x42\xf3\x0d\xc8\x87\x9e\xb7\x13\x73\xed\x1e\x2d\x1b\xec\x53\xdf\x8f\xe1\xf8\xcd\x45\x11\x7f\x58\x31\x49\x36\xe5\x1f\x1b\xd8\xa1\x30\xb8\xf3\x1e\xe6\x1c\x37\x07\xbf\xe0\x5b\x82\xfa\x00\x20\xac\x1a\x99\x8c\xcf\x73\xb3\xbc\x1f\x59\x3a\x0c\x7b\xe2\x41\x21\x8b\xc9\x55\x24\xf3\x33\x8e\xd2\x12\x8b\x54\x69\xd1\x84\xe4\xae\x08\x09\x78\x18\x12\x1e\x18\xfd\xd7\xec\xf5\x05\x78\xcf\xff\x1a\x9f\xff\x84\xb4\xcd\x29\x78\xe3\x1b\x2c\x83\xb5\x67\x24\x9d\x87\x2c\x0e\x68\x7c\xf2\x4e\x74\xbb\x5f\x48\xfd\x4b\xa1\x72\x8d\x3f\xa8\x6c\x24\xc4\x82\xaf\x54\x96\xef\x90\xd0\x4e\x27\x07\x4d\xd6\x51\x61\x94\xa7\x12\xdd\x44\xa2\xf1\x74\x6d\xce\xaa\x0f\x88\x83\xca\x8e\x6e\xf1\x56\xa0\x90\xc5\x44\xe5\xb4\xe6\x26\x78\x9a\xf7\x11\x19\xae\x86\xea\xb7\x3c\x9e\x15\x2a\x40\x62\xa9\x34\xc4\xb1\x73\x08\x14\xe0\x38\x66\xd2\x18\x53\xc4\x09\x0e\xb7\x43\x34\x53\xd9\x3c\x00\x4a\xc5\x16\x08\xde\xb8\xc5\x14\xec\xd0\x92\x71\x05\x82\x5c\x93\x2d\x62\x71\xb4\xb5\x9f\xe2\x40\xd2\x1b\x82\x58\x1c\xd8\xa1\xd7\xf8\x86\xa0\xdf\x19\x8d\x49\xa8\x90\x32\x28\x98\xfc\xcf\x19\xe0\x0f\x7e\xbe\xa2\xbe\xb0\x89\xd4\x1c\xf3\x2c\x21\xa4\x5f\x18\x7d\x15\x98\x2f\x06\xfa\x87\x81\xfe\x62\x90\x7f\xd1\x31\x33\x74\xdc\x05\xd0\x71\x80\x59\x05\xe3\xfc\xff\x45\xd6\xa2\x92\xb3\x6a\x4d\xf1\xab\xde\xb3\x9d\xeb\xa8\xb2\x59\x75\xe1\x4c\x53\xd6\x13\xac\x65\xb3\xba\xf3\x7e\x97\x10\xbe\xa1\x02\x12\x1b\xe2\x07\x96\x42\x00\xb4\xdd\x31\x4c\x93\x98\x8e\xdf\x5c\x58\x35\xe1\x0c\x8c\x16\x66\x64\xa5\xc2\x85\x60\x01\xc5\x92\x74\x62\xbf\x4e\x03\x7b\x11\x15\x84\xdf\xd0\x80\x8c\x83\x80\xa5\xb1\x7c\xc3\x22\x32\x7e\x73\xb1\x0f\xc5\x24\x5e\x55\x0c\xcb\xce\x40\xa6\x71\xf4\xc2\xf8\xf5\x01\x8c\x8f\xe0\x97\x6b\x82\x36\x44\xe2\x10\x4b\xac\xa8\x9b\x24\x91\xa2\x86\xc3\xb6\x86\x38\x60\x5e\x41\xaf\xa1\x00\x4b\xb2\x62\x9c\xfe\xa1\xb5\x3b\x8e\x43\xc4\xf8\x0a\xc7\xe6\x87\x21\x7a\x81\x41\xd0\xf0\x0a\x05\x2c\x16\x54\x48\xa5\x56\xb1\x8a\x08\xe0\x65\x1c\x23\xa6\xac\x0f\x8e\xd0\x0d\xd8\xd9\x3e\x5a\x30\xb9\x86\x97\xb4\xbe\xdc\xb2\x14\xf2\xf8\x34\x26\xc3\x4e\x8b\xfc\xd7\x42\xc6\x13\xfa\x94\x59\xc5\x1a\xc9\x12\xb7\xd4\xf1\x81\xfb\xe9\x2d\x59\xac\x19\xbb\x3e\x03\x5e\x5a\x52\xc0\x52\xb4\x73\x6b\xc7\xa0\x58\x7e\xf1\x7c\xdd\xc4\x46\xc1\x9a\x04\xd7\x5a\x47\x22\xf2\x21\xa1\x7c\x8b\x6e\xd7\x24\x76\xb4\x3d\x15\x76\xaf\xc6\x58\x24\x33\x05\x0a\x9c\x39\x2a\x46\xc8\x60\x31\x70\x5f\xea\x68\x77\x3a\x43\x56\xab\x9f\x7d\xc0\x5c\xf5\x9e\xf9\x10\x29\xed\x29\xe4\x00\xf7\x6e\x49\x14\xfd\x18\xb3\xdb\x78\x6a\xdc\xd2\x76\xab\xf2\x4b\xe5\xb3\xa6\xe5\x00\x0b\xa9\x5d\x5d\x30\xf9\x01\xdb\x6c\x58\x5c\xf0\x85\x3b\x91\x70\xf7\x68\x7b\xc6\x88\x2a\x42\xf3\xb0\xfb\x4e\xad\xdb\x14\xd5\xd4\x3c\x73\x7f\xf7\xd9\xac\xc6\x25\x72\x1e\x2a\xed\xed\xfc\xed\x8b\x1a\x9c\xc7\xb7\x8d\x82\x64\xbc\xa2\x8a\xa3\x5b\x89\x5a\x9b\x62\xe3\xfe\x89\x9f\x09\x72\xbf\x1e\xf6\xd4\xb5\x14\x16\xa0\xcd\x00\x69\x1f\x21\xd4\x8d\x54\x8d\xcf\x7f\xf1\x20\xbe\x33\x64\x17\x24\xe0\x44\x8a\xf6\x51\xbb\xd6\x35\x97\x6b\x4e\x04\x00\xf9\x1c\x6f\x45\x9d\xb2\x04\x16\x5f\x11\xde\x28\x37\x6b\x76\x0b\xfb\xf2\x5b\x14\xe2\x6d\xe6\x5b\xe9\x6a\x08\xa3\x3b\x80\x08\xae\xa0\x2b\x87\x9d\x93\x84\x71\xd0\x1f\x9d\xc4\xea\xb8\x93\xe5\xd6\xe4\xeb\x47\xd9\xef\x99\x18\x2a\x8a\x0b\x89\xb9\x7c\x4e\x92\x88\x6d\x21\x63\x71\x7f\x09\x00\x03\x0a\x09\xfb\x60\x8d\x39\x01\x87\xbf\x8c\x2b\x84\xc0\x24\x46\xe0\xef\x6b\xbf\x6d\xa3\xa9\x42\x74\x70\x45\xb5\x6d\x91\x76\xe5\x87\xc8\x41\xcc\xd0\x69\x49\x38\x89\x03\x5d\x06\x31\x07\x5d\x23\x12\x1c\x90\x11\xfc\x6f\xde\xd7\xd1\x14\x46\xb7\x98\xc7\xa0\xd6\xa8\x40\x11\x5b\xad\xa0\x38\x02\x0c\x57\xcc\x50\x98\x0d\x08\x26\x42\x10\xd9\x69\x75\xef\x01\x47\x1d\x13\x15\x11\xcd\x42\xa3\x3d\xd0\x3d\xf1\xac\x73\x26\xa2\xf7\xc5\x3b\xb0\xd8\xb0\x5e\x65\x5a\x1a\x0a\x22\xa3\x70\x45\xdf\xb7\xea\x43\x74\x59\xfe\x4c\xb3\x0a\x0e\x75\x09\x8b\x96\xf5\xb9\x8c\xc4\x30\xe0\x72\x0e\x2e\x6b\xa7\x55\xef\x04\x5d\xc3\x7a\xb5\x04\x54\x8f\x60\xa0\x35\x9f\x2a\x98\xf7\x34\xc8\x76\x71\x73\x94\xbd\x1a\xd6\x79\x6c\xd8\xdc\x61\xcc\xaa\xf2\x3e\xcc\x78\x19\x98\x2c\x05\x0b\x34\x81\x98\x8c\x84\xb6\x76\xc4\x12\x17\x5e\xb5\x45\x42\x19\xac\x6d\x56\xee\x28\x13\x16\x4c\xe1\x59\xc4\xd2\xf0\x25\xe3\x1b\x65\x26\xdb\x17\x04\x06\x38\xc1\x0b\x1a\xd1\xca\x93\xcf\x29\x6a\x38\xb8\x8e\xd9\x6d\x44\xc2\x95\xf1\x9f\x61\x47\x55\x48\x1c\x00\xff\x52\x45\x60\x15\x33\xd8\x08\x6b\x32\x3e\x47\x2e\xe0\xb6\x38\x0c\xd2\xf3\x04\xa2\x40\x18\x03\x5e\xd4\x63\xe8\xed\x30\xed\x01\x29\x77\x92\x13\xc1\x52\x1e\x90\x6c\x8f\x99\xc4\x92\x53\xc3\xfa\xf3\xb3\xf1\x74\xfc\xc3\xe4\xa7\xc9\xe5\xbf\x7e\x9b\x8c\xcf\xe7\xfd\xc2\x2f\x17\xe3\xf3\x17\xcf\xd5\xef\x2a\x82\x73\x1f\x8d\xdf\x5e\xbe\xfe\xed\xc5\x7f\x4f\xc7\x17\xcf\xbb\x15\x2a\x7e\x51\xe8\x6b\x49\x77\xd0\x9a\x8c\xcf\x8d\xc0\xf7\xab\x0f\x33\x72\x58\x9d\x00\x44\xa9\xbc\xe5\x50\xc6\xbc\xd7\x3b\xf1\xf0\x4c\x2f\x66\x46\x00\x28\x8b\xef\x75\xe3\xc0\xcd\xec\xcf\x2e\x66\x48\xb2\x84\x06\xa6\xd2\xec\x86\xc4\xb9\xcc\x1a\x0a\x03\xdf\x24\xe9\x22\xa2\x62\x0d\x49\x51\x06\xfb\x99\x90\x83\xe0\x20\xe4\xd2\xd6\xc8\x98\x97\x6d\x54\xb8\x75\x8b\x49\x51\x5e\x62\xd8\x89\x77\xee\x17\xd2\x13\x0f\xa1\xa1\x3c\x21\xa8\x56\x53\x1d\x67\x7f\x0b\xa3\x77\x6a\x78\xb3\x25\xf5\xfe\x01\xd4\x50\x8b\xa7\xa3\x51\xc8\x02\x31\xc4\xb7\x62\x88\x55\xdd\x17\x6c\x57\x8e\xc6\xbf\xcc\x8a\x6a\x71\x14\x81\x32\x97\xa3\xb7\x82\xf0\x57\x29\x0d\xc9\x28\xe1\x4c\x92\x40\x0e\xd4\xa0\x83\x5c\x30\x40\x4c\x1f\xe6\x1b\x5e\x2d\x49\xd3\x69\xe5\xb0\x53\x53\x78\x87\x58\x5c\xf5\x9e\xb9\x14\x83\x7c\x41\x77\xbc\xbc\xeb\x2c\xe9\x86\xb0\x54\x8a\x76\x8b\x5c\xc4\xe1\xd2\x7e\xdb\xb4\xd8\x1b\xfc\x81\x6e\xd2\x8d\x4a\x8b\x2b\x5f\x87\x6e\x48\xae\xdc\xc0\x9e\x29\x7a\x64\x35\x68\x56\x65\xc2\xd3\xf9\x60\x10\x2c\xe3\x81\x46\x60\x60\x40\x05\x5d\xae\x7f\xd7\x0c\x9c\xfd\xae\x75\xb9\x7e\xa4\xcb\x2a\xf3\x47\xcb\x08\xaf\x04\x92\xf8\x9a\xa0\x84\x93\x80\x84\xe0\x06\x77\x5a\xe9\x43\xf0\xd0\xba\xd4\x87\x4c\x49\x29\xfb\xf0\xaa\x2a\x65\x1f\x8a\xf6\x2d\x3f\xa2\x7b\x3a\x78\xae\x7d\xea\xd5\x28\x87\x26\xcd\xef\x3c\x33\x60\x1e\x3d\x59\xe1\x16\x04\x01\xa9\x8b\x1c\x6a\x05\xc2\x88\x00\x78\x62\xda\xcb\xc8\x40\xeb\xe2\xe8\xed\x37\x53\x83\x87\x77\x59\x15\xbe\x7a\x1f\x4f\xa1\x50\xf8\xad\xc1\x6a\x7e\xaa\x94\x18\xef\xf3\xa5\x66\xc4\xd6\x5f\x9e\x94\x46\x68\xe6\x2d\x8d\x4f\x75\xb6\x2a\xe4\x47\x66\x99\x66\x49\x56\x0b\x48\xa1\xc4\x55\x63\xaf\xb4\x8a\x22\xa1\xb3\xcb\x6d\x85\x1b\x0b\x14\x9a\xa4\x16\x54\x2b\xc1\x66\xa0\x40\xf3\x27\x8f\x36\x73\x30\xb0\xf3\xd3\xf5\xd7\x8f\x36\xf3\x21\x7a\xab\x4a\xa9\xad\x08\x64\x87\x0e\x24\x03\x75\x65\x7e\x9e\xef\xc1\x92\x77\x8d\x89\x56\x37\x4f\x1e\x6d\xac\x76\xc9\xb6\x52\x15\x66\xe6\xd7\x26\xfc\xac\xc2\x32\xcf\xcc\x17\x65\x99\x50\xfb\x46\x4a\x9e\x7e\xc1\x32\x58\xb7\x12\x07\xbd\x3f\xf3\x13\x5b\xad\x8a\x55\x7e\x8d\xd6\xab\x34\x91\xfd\x7a\x5f\xf5\x58\x84\xe1\x28\x6c\x1a\xb0\x58\x62\xa8\x09\xd0\x19\x53\x94\x60\x8e\x37\x04\xf6\xb6\x11\x27\xe0\x33\x80\xbf\x87\x72\x14\x5a\x73\x4d\xe7\x81\x9b\xd7\xa8\x4a\xf8\xda\xa5\xd2\x75\xa8\x97\xdb\x84\xec\x19\x0b\xf4\x8b\x4f\xbd\xf5\xb4\x40\xee\x84\x96\x5e\x85\x1f\xd3\x90\x4a\xdf\xcf\x72\x4d\x62\x09\xc6\x8a\x15\x93\xbc\x36\x4d\x2f\x39\x8b\x22\xc2\xcf\xe1\xdc\x55\x29\x0f\x0c\xff\x7a\x50\xa5\x12\xa6\x91\xef\x11\x8e\xa2\xea\x8f\x7f\xcb\xb9\xac\x58\xd4\xeb\x70\x5b\xc7\x00\x47\x91\x14\xcc\x11\xe4\xe6\x94\xfc\x33\xa4\x89\x8d\x1e\x08\x38\xc6\x93\x2f\x17\x78\x8b\x79\xd1\x46\x00\xbf\xdf\xc2\xef\x03\xc3\xc3\x03\x33\xc4\xe8\x2b\xf3\x83\x66\xbf\x01\xf9\x80\x37\x49\x44\xc4\xc3\x87\x9e\x30\x53\x95\xb5\xe3\x84\x5e\xf5\xc0\x27\xbb\xd2\xb4\xce\xff\x70\x28\x6c\x7f\xac\xd0\xd5\x3e\xc8\xa8\x69\x7f\xc0\x51\x64\xff\xfb\xb7\xab\xde\xbc\x5b\xae\x7c\x17\x61\x2a\x7b\x76\xdd\x09\x02\xc5\x15\x45\xea\x82\x53\xee\xa7\x92\x5b\x85\x8e\x13\x5a\x28\x41\xef\x17\x9f\x02\x05\x1b\x9f\x3b\x44\x6d\x78\xaf\x42\xe7\x86\x77\x33\xd2\x37\xbc\x83\xa3\xa8\xe1\xe9\xdf\x0a\xcf\x86\xfb\xaa\x53\x57\x4f\x1c\x53\x97\x12\xde\xac\xf3\xcc\x02\x5b\x66\xe9\xaa\x51\xbb\x0e\xef\xd5\xab\x95\x54\x9f\x7f\xc7\xcb\x96\x2b\x38\xd2\xd0\xbb\xa6\x71\xb1\x78\x36\xa1\x3f\x9b\x9d\xd1\x0a\x15\xeb\x54\xb4\x39\xfe\xd8\x4e\x3b\xfb\x8d\xeb\x38\x4f\x67\xee\xd6\x6a\x27\x9e\x97\x5c\xc0\x4b\x80\x34\xd8\x83\x9a\xd3\x15\xda\xcb\x1f\x52\x36\xba\x39\xc5\x51\xb2\xc6\xdf\xf6\x4e\x7c\xca\xb7\x30\xff\x0d\xa6\x91\xce\xa3\x6e\x7f\x65\xf1\xbe\xd6\xca\x79\xf8\xa9\xef\xc3\xa2\x89\x04\xb7\xe2\xc2\x73\x60\xa9\x86\xe2\x85\x73\xe3\x85\xa9\x6a\x3d\xc6\xc2\x3e\xac\xf5\x01\xed\x41\x8a\xfc\xd8\x5f\xdf\x54\xa3\x98\xc2\x74\x73\x6e\x31\x6c\x7d\x40\xd7\x14\x6d\xbc\x15\x60\x95\xaa\x8f\x33\x43\x54\x3e\x7f\x99\xc2\x07\x03\xf3\x01\x1c\x37\x1b\xe8\x0f\xba\x15\x71\xdc\x13\xba\x15\xab\xd2\x16\xbb\xab\xde\xb3\x3a\x4a\xd5\x57\x86\x04\x85\x48\xb2\x1d\xc7\x78\xf7\x17\x9a\x18\xc7\xd2\xcf\x54\x62\xba\xf9\x00\x15\x4d\x64\x11\x84\x49\x4c\x59\x12\xef\x0c\xbb\xf7\x38\x1d\x7c\xf8\xe4\xf5\x74\x2c\x87\x1d\x5d\x82\x88\x46\x02\xce\x4a\x6e\x98\x48\x13\xd8\xfc\x6f\xe3\x89\x75\xe3\xf9\x59\x47\xb7\xa6\xe8\xbf\x18\xb0\x1a\xb8\x8d\x71\xf2\xfc\x62\xd6\x92\x44\xfa\xe5\xc3\x15\x93\x19\xc8\xd9\x6c\x3e\xa6\x1e\xf0\x8c\xee\xc5\x7d\x89\xf9\x0a\x4b\x32\xe5\x6c\x49\xa3\xd6\x56\xc1\x4f\x9a\x97\x85\xb1\x72\x5a\xef\x61\x2b\x56\x54\xb6\x5b\x8e\x57\x54\x36\x2e\xc2\xcb\x9f\xde\xfe\x37\xfa\xf9\x14\x3d\x7f\x31\x7d\xf3\xe2\x6c\x7c\x39\x79\x7d\x81\x2e\x5e\x5f\x4e\xce\x5e\x0c\x91\xcd\x69\xe7\xe7\x87\x46\xf9\xf9\xa1\x91\x16\xea\x11\x15\x22\x25\x62\xf4\xf8\xfb\x27\x5f\xa3\x57\x54\x42\x55\x02\x13\x44\x94\xa8\x0e\xb6\xe3\x65\x94\x7e\x40\x37\xa7\xb6\x14\x92\x60\x1e\x51\x68\x41\x21\x4d\xf6\x14\x96\x66\x45\xa1\x55\x44\xa7\x85\xfe\x32\x31\xa8\x5b\x35\x96\x88\xd6\x0b\xf7\x3a\x11\x8d\x6b\xb7\x0b\xd0\xc7\x0a\xd0\x5b\x1a\x45\x80\x8b\xa4\x71\x4a\xc0\x27\x5d\xa8\xa3\x82\xea\xd4\xf9\x32\x95\x29\x27\x06\x66\x94\x44\x38\x16\x7d\xc4\x49\x12\xe1\xc0\x96\x26\xc0\x9a\x16\x27\xe8\x7e\xce\xfc\x5e\x01\xf5\xae\x04\xc5\x9b\x4e\x1a\x7f\x32\x3e\xf7\x2f\x29\xc5\x9b\x49\x08\x51\x99\xdc\x9a\x43\xa7\x87\xe9\x88\xc9\xf8\xbc\x34\x5e\x3e\x6f\xb3\x9e\x68\xe2\x14\x7b\x74\x13\x44\x4c\x6d\x9f\xb3\x08\x4a\x8a\x52\x01\xbe\x0d\xd0\x1e\xeb\x52\x75\xd5\xe7\xc7\xba\x49\x10\xc4\x23\xad\xc7\xcf\x71\xa2\xcb\x4c\xb2\x3f\xa1\x2a\x88\x93\x80\xc5\x01\x85\x5e\x2b\x92\xe5\x27\x7a\xa0\xfa\x0a\x07\x12\x0e\x3d\x6c\xd1\x3c\xdb\xd9\x36\xef\xce\xfb\x08\x27\x98\xcb\xac\x36\x25\x3b\x57\x0a\xe5\x74\x78\xe5\x1a\x6d\xc5\x22\xf9\x79\x05\xc5\xce\x46\x89\x1a\x27\x53\x47\xb8\x0a\xa7\x1c\x19\x85\x5d\x66\x65\x29\xde\x0c\xa8\x21\xe9\xc0\xce\xd5\xd1\xc0\xde\x1f\xfd\x74\x82\xa0\x4c\xc4\x2c\x12\x3f\x1e\x29\x2b\xfe\x83\x9f\x6e\x57\xbd\x67\xf5\x34\xaf\x77\x21\xec\x40\x53\xce\x6e\x68\x48\xf8\x81\x42\x52\x1a\xad\xad\x88\x9c\x78\x5e\xd2\x21\x74\x09\x9a\x52\x54\xd7\x22\xe6\xb4\x9e\xa1\x5a\xdf\xdd\xe1\xe6\x75\xba\x00\x9f\xe2\x43\xcb\xfd\xf5\x1f\xed\xeb\x87\xbb\x55\x30\xf3\x20\x81\xa9\xf3\x10\xe8\x98\x8e\x95\x77\xfc\x5a\x1a\xe8\x96\x1f\xa6\x7f\x8b\x41\xae\x35\x45\x7c\x1f\x7b\x67\x32\xd2\x50\x7f\x3c\xb0\x13\xf7\x9d\x97\x46\x73\x57\xfb\x53\xdf\xc7\x46\xbb\x15\x34\x48\xe0\xbb\x8b\x5c\x3c\x55\xaa\x36\x53\x61\x0a\x7e\x08\x1f\x73\x01\x7e\xa8\xa4\xee\x9d\x95\xf3\xfc\x41\xf6\x11\xb9\x16\x03\xf3\x58\x45\xbc\xe2\x18\x41\x85\x07\x12\x68\x93\x94\xfd\xa1\x01\x07\x3d\xa0\xe0\xab\x7c\x5f\x05\xea\xaa\xf7\xac\x8a\x44\xbd\x22\xc9\x92\x60\xad\xb8\xc4\x48\xe5\x39\x91\xb8\x76\x38\x4e\x03\x31\x83\xe2\xc0\x96\xa7\xe9\xcf\xdd\x4f\x0c\xd7\x35\x2d\x6d\x2e\x2f\xe0\x58\xd1\x00\x0e\xf2\xc6\x21\x5a\xd3\xd5\x7a\xe0\x66\x9d\x2a\x7b\xcc\x73\x03\xdc\x40\x55\x12\xf2\xb9\xed\xbe\x93\x95\xf3\x24\xd0\x36\x4a\x15\x19\xda\x9a\x8f\xca\x31\x95\x3d\x25\xbb\x23\xa4\xda\x48\x15\xc1\x35\x26\x6a\x2f\xa0\xbd\x4b\x15\x5b\x79\x7b\xae\x77\x18\x45\xbb\xe5\xba\xa8\x7c\xd6\xb4\x58\x34\x5e\x13\x4e\x4d\xe6\x00\x6a\x18\x73\x9e\x54\xb4\xa8\xb2\x2a\x4a\xe3\x88\x08\x73\xd4\x53\xed\x84\x42\x16\x02\xfa\x74\x2c\x29\x31\xf4\xdc\x08\x12\xdd\x10\xd1\x69\x31\xee\x16\x92\x66\x0a\x1f\xa6\x1f\x8f\xaa\x18\x5f\x32\x68\xe9\xb7\xb4\x69\x2b\xb5\x08\x76\x1b\x06\xc1\x76\xce\x3b\x8f\xea\xf3\xe9\xcb\x4e\xc4\xdf\x39\x6b\x4b\xc5\xd8\x46\xa3\x25\x9c\xde\x60\x49\x8c\xaa\x6a\xc7\xd4\xd3\xe2\x37\x4d\x04\x54\xbd\x9e\xf2\xd0\x0b\xc2\x3a\x8c\x96\x69\x14\x6d\x07\x66\x66\x9b\xe5\x04\xdf\x5f\x67\x7e\x63\xa6\xb8\x0d\xad\xb1\x40\x2c\x95\xea\x48\x2d\x02\x82\x81\xc5\x05\x5f\x97\x08\xa8\x1a\x88\x43\x64\x87\xd0\xbf\x81\x1b\x3b\xfe\x65\x86\xcc\x49\x2c\x75\x1c\x5e\x6f\xec\x84\xe8\x86\x62\xd5\x41\x8e\xc4\x61\xc2\x68\x2c\x45\xa7\x05\xf9\x72\xb1\xf0\xae\xa9\xa9\x0b\x7f\x11\x07\x7c\x6b\x71\x68\xb1\xac\xb3\xca\x67\xde\xd1\xd3\x64\xc5\x71\x48\xba\x14\x68\xbe\x2d\x7c\xd2\xc4\x2f\xa5\xc4\xab\x49\x0e\x96\xb2\xac\x81\x8f\xf1\x76\x2c\x61\xa7\x81\xbd\x78\xdf\x24\x41\x3b\x6c\x8d\x5c\xfc\x3c\x3d\x73\x96\xe7\xa4\x34\x60\xe3\x76\x64\xc3\xbe\x9a\xcf\x19\x69\xe1\xd5\xd6\xae\x5f\x7d\x5a\xdf\x79\x02\xf9\x8a\xc6\x70\x6a\x47\x4a\xc2\x79\x0c\x54\xec\x57\x76\xff\x9c\x5f\x92\x3a\xe5\xe2\x1a\x08\xe7\x57\x63\x89\x2e\xbc\x0f\xe3\x06\xf3\x5b\x49\xae\x3a\x8f\x5c\x7f\x43\xef\xc7\xf9\xd3\xf6\x8d\x42\xe7\xc9\x61\x7b\x63\x30\xcf\x26\x9c\xf3\x53\xd1\x47\x74\x1e\xac\x0a\xb9\x55\x9b\xdd\xab\xec\xbb\xee\xb3\x7b\x8d\x91\xa0\x50\x7b\x61\x34\x5e\xdf\xa4\xc3\xc0\x2f\xc3\x81\xed\x61\x6b\x56\x08\x8d\xa7\x93\x0c\x8e\x9d\x8a\xf4\x80\x81\x73\xd6\x1e\x28\xa3\x36\x30\x87\x70\x07\x26\x84\xce\xe5\xa7\x20\xa3\xea\xdd\xde\x53\x67\x5f\x36\x1b\xb4\x74\x72\xbd\x97\xed\xd7\x16\x5e\x30\xc3\x97\xf6\xcb\x2b\x85\x06\xef\x7d\x9b\xeb\x2f\x32\x45\xdd\xa2\x58\xc9\x70\xfe\x58\x19\xb3\xb2\xaa\x29\xb7\x90\xc9\x9e\x99\x19\xe1\x5f\x4f\x15\xe6\x07\x5d\x07\x38\x29\x0d\xd4\xa8\x9a\x8a\x40\xd6\xcd\x7d\x14\x2e\xd4\xa1\x8b\xd1\xc9\x08\x27\x54\x59\x76\xc2\x33\xf3\x67\x2d\xa6\xe3\x2b\xb5\xe6\xc4\xbd\x06\xf7\x2d\x31\xe4\x66\x5b\x2c\xae\x55\x36\x2c\x7c\xf1\x81\x04\x29\x0c\xd7\xae\x33\x87\x45\xc8\x47\x21\x48\x04\x42\x16\x4c\x79\xe9\xaa\x9f\x24\x34\xc0\xd0\x44\x01\x1f\x62\x3c\x9d\x88\x21\xba\x84\x7e\x76\xea\x55\xe8\x0f\x14\x86\x3a\xe1\x07\xa1\x8f\xd3\xf6\xf4\xcd\x0f\xe3\x33\x95\xf0\x84\xbc\x6b\xd6\x65\xc2\xe4\x39\xa7\x2c\x44\x19\xd8\x08\xe0\x6e\x3e\x38\x41\xae\x85\x3d\x64\x00\x79\xd1\x95\x3e\x64\xc0\xc2\x01\xb1\x83\x0c\x00\x9e\x21\xa8\x88\x6e\xae\xf1\x67\xc2\x38\x77\xb0\x8f\x85\xe6\x55\xef\x59\x95\x8a\xf5\x6e\x79\x1d\xbb\xb8\x7d\x02\x5a\x39\x23\x1d\xce\xc6\x50\xf5\xaa\x75\x89\xb2\x1e\x64\x96\x74\x06\x24\xa0\x3a\xca\x10\xd4\x54\xae\xec\x77\x1b\xbe\x81\x43\xf8\x26\xcd\x8b\x66\xa5\xed\x67\x33\xdc\xc0\x78\x62\x1d\xd3\x43\x47\x87\xb5\x12\x52\x95\xe1\xbb\xea\x3d\xf3\xa0\x73\xd0\x0a\x7e\x11\x47\xd4\x6c\x24\x6f\x5b\x64\x1c\x46\xcc\xca\x79\xc3\xb9\x6e\xdf\xfd\xe2\xc7\xd9\x4b\x3f\x41\xb4\x1f\x3a\xbf\x73\x8e\xf9\x4c\xf8\xea\x64\x54\x3b\xa4\x4d\x92\xea\xf3\x32\xe0\xd4\xd3\x52\xa4\xc4\x83\x25\x66\x6b\xe2\x22\x6f\x8b\x2a\x7b\x0a\xa0\x9e\x90\x77\xbf\xdc\x87\x01\x76\xf4\xc5\x48\x8e\x4a\xf5\x5d\x3d\xc2\xa0\x9d\x14\xd5\x46\x8f\xdc\x10\xbe\xcd\x76\x0d\xbd\x0c\x3c\x24\x43\x73\xca\x48\x65\x1c\xd4\x8b\xfd\x1d\x74\xea\xe7\x99\x3f\x7d\x4b\x4b\x6c\x3e\x14\x7d\x35\x99\x1d\xcb\xec\x4c\xaa\x6c\x8d\x4e\xb4\xc2\xd7\x62\x88\xc6\x7e\xc8\x21\x85\x09\xec\x83\x91\x48\x48\x00\x47\xba\xd4\xa8\xea\xf8\x9c\x70\x8e\x95\x19\xfe\x71\x98\x19\x59\xba\x66\x0c\x04\x5b\x88\xce\x24\x03\x3b\x49\x77\xc5\xf1\x3f\x9c\xd8\x9a\xd8\x15\x99\xa8\xa5\x2f\xf8\x3a\x9e\x85\xa9\x97\x8e\x62\xef\xa4\xb6\x36\xb1\x31\xfb\x32\x19\x9f\xcf\x0a\xa3\xe6\x33\x17\xe6\xee\x64\x33\x4b\x84\x56\xce\xa7\x39\x17\x6f\x76\xde\x4d\x40\x61\xd8\x13\x38\xc1\x40\x81\x2c\x72\xef\x1f\x8c\x28\xde\x98\x91\xec\x40\x50\xa0\x89\x57\x64\x00\x81\xf5\xc0\x94\xfb\xab\xa4\x44\x37\x56\xed\x08\x9f\xb3\xa2\x1d\x40\xba\xea\x3d\xf3\xe1\xb5\x73\x75\x0f\x0f\x77\x8c\x24\xe2\x18\x91\x0f\x54\xc0\x1e\x50\x2e\x6b\x36\x26\x30\x3b\xc3\x70\x84\x46\x55\x14\x91\xbe\x91\x3d\x14\x32\xb8\xcd\x85\x65\x9d\x0c\xa0\x61\x8f\xda\xb9\xa2\xb6\x8f\x4c\xa9\x74\x38\x9f\xc5\x60\xa0\x66\x2a\xaa\x17\xe3\x44\xe4\x05\xb6\x03\xfb\xd1\xc0\x7c\xa4\x42\x80\xbd\x34\xce\x1d\xe3\xe9\x97\xe7\x96\x08\x39\x75\xc3\x7e\x32\xb5\x62\x07\x47\x4b\x58\x25\x71\x00\x7b\x78\x75\x9c\x6d\x87\x61\x73\x96\x83\x05\x06\x0a\xaa\x3f\xe0\x2c\x51\x45\x47\x1b\x26\x80\x60\x32\x07\xcf\x31\x2e\x4d\x01\xe1\x64\x7c\x5e\x3d\x5c\xaf\xf3\x08\xbf\x59\xca\xfe\x66\x40\xa3\xb6\x4b\x40\x27\xd6\x38\x26\x8e\xed\x82\xdc\x7d\x70\xba\xea\x3d\xab\xa1\x5f\x3d\x5b\x7c\x51\xcd\x46\x1d\x9b\x6e\x1b\xa6\xbc\x9e\x3c\x3f\x43\x89\xc9\x78\x2b\x13\x0b\x81\x52\x14\x65\xa2\x29\x5a\x44\x07\xb0\xa9\xae\x72\xf6\x43\x40\x77\x0e\x96\x19\x1a\x76\x82\xd7\xb3\x26\x9c\x20\x76\x43\x38\xa7\xd0\x98\x17\xab\xb6\xa4\xd9\x65\x61\x6a\x23\x15\x3a\x79\xd2\xb8\x3c\x48\x27\xfe\xb9\x2b\xc4\xb2\x3d\xf8\x1c\xb0\x2c\xba\xd9\x07\xc7\xfa\xf1\x8a\xe8\xb6\x69\x4d\x9a\x04\x6f\x4c\x47\x8b\xb3\xec\x6c\x9a\x3f\x85\x52\xce\x91\x36\xb2\x88\x0a\xe4\xcd\x76\x52\xd6\x63\x72\x8b\x62\x02\xe2\x6e\x3a\xf5\xf2\x54\x9b\x5d\xd8\xb4\x33\xda\x3a\xd2\x9b\x84\x15\xfd\xdd\x6d\x19\xef\x74\xf2\x9c\xa8\x92\xa7\xc4\x4b\x54\x60\x4c\x90\x88\x43\x28\xa8\x77\x35\x45\x1d\x23\x0a\x04\x1d\x48\xe1\x2c\xf8\xe4\xcd\x6c\x9c\xc5\x6e\xe6\x5e\xae\xfc\x9c\x4a\x27\xc2\x1d\x6b\xce\x3d\xb3\xe7\x8e\xed\x2b\x75\x07\x75\x14\xbb\xd5\x95\xbd\xbe\xf7\xc3\xa9\x27\x94\x74\xde\xac\x09\xfb\x4b\xd3\xd5\xbc\xb5\xe7\xd8\xe5\x9c\x56\xb7\x4f\x7a\x3e\xbe\xaa\xe2\x6e\x1d\xcd\x5e\x4b\xd9\x76\x5e\x03\x75\x74\xcc\x3d\x09\xab\x1d\xb1\x94\x9c\x2e\x52\xd3\x36\x0f\x5b\xef\x3a\x9b\xba\xe5\xfd\x18\x3b\x46\xab\xd9\x75\x50\x65\x65\x2d\x76\x1e\x54\xf3\x78\x5c\xbc\xf8\xb5\x99\x02\xee\x3b\x47\xb3\xaf\x3b\xf5\x74\x84\x17\x24\xfa\xb2\x41\xdc\xb7\xf5\x7c\xd6\x39\xb1\xf5\xc7\x27\xa5\x41\x3a\x75\x27\xce\xa7\xab\x92\xb7\xef\x67\x8c\x23\x0a\x87\xb3\x61\x86\x6e\x89\x3a\xd8\x08\x27\x35\xf3\x50\xf4\xb5\x22\x3e\xb0\xaf\x52\xea\xe5\xa0\xb5\xa3\xf4\x1c\x3c\x5d\x8d\x78\xcd\x0a\x5a\xa7\x95\xa0\xb9\x3a\xed\xd8\x9b\x33\x46\x55\x58\x43\x9f\x75\xe0\x2a\x65\xaf\xa9\x28\x23\x58\x1c\xb5\x9d\x42\xda\x63\x96\x6c\x92\x4f\x7d\x3f\x45\xfe\xf7\x22\x9f\xea\x45\x3e\xfa\x99\x35\xcf\x25\xe2\x94\xa8\xd0\x84\x9e\x49\x18\xc0\xf4\xe0\xb0\xe7\xd3\x5a\x3f\xff\x10\x9e\xe8\x3c\xb8\x17\x55\xeb\xca\xb7\x13\x8c\x92\x95\xf3\x8e\xe8\xf3\x98\x8e\x42\x42\x6f\x8c\xed\x5e\xbb\xe1\x84\x2c\xc7\xa1\xeb\x01\x33\x7a\x49\x03\x4c\x70\xb1\xdb\x56\x35\xd1\x63\x56\xc8\x08\x83\x45\x51\xa9\x67\x68\xeb\x6b\x80\x3e\x83\x32\xa8\x4c\xf7\x0e\x56\x24\x86\x73\x88\x24\xcc\xbf\xe8\x44\x8e\xa3\x4c\x58\x4b\x8d\xd7\x71\xb4\x3d\x24\x56\xd1\xd0\x6d\xe1\x7e\x3c\xd5\x9f\xda\x4a\x7a\x29\x0b\xaa\x41\x11\x6b\x96\x46\x21\x14\x36\xd9\xc0\xd9\xde\xec\x63\x2f\xce\x19\x59\xdb\x1b\xaf\xbc\xab\xda\x9d\x70\x9f\x0d\x34\x2f\x89\x85\xc4\x32\x15\x5d\x65\xdb\x40\x68\x00\x9c\xe9\x31\xbc\xe3\x7f\x51\xc9\x21\x48\x6d\x01\x40\x59\x78\x78\xc8\xea\x75\x1b\xac\x85\x8f\x7a\xb4\x6b\x3b\xf6\x0c\x71\x33\x45\xdf\xe4\x07\x34\xc2\x5b\xf3\x61\xaf\xd6\x70\x3a\x0f\x7c\x46\xa1\xca\xa7\x3e\x55\x59\xfa\x4d\x29\x8c\xbb\x0c\x21\x63\xef\xd6\x9d\xa5\x9e\xca\xc3\xd9\x9a\xe5\x7d\x2a\xdb\xba\x8f\xdf\xca\x0f\x36\x42\xda\xc2\x1b\xe6\x66\x71\xdc\x1f\x1b\xe4\xb1\x1b\x93\xd9\xc1\x8f\xb8\x20\x5a\x85\x59\x5b\xe3\xa1\x5d\xc7\x05\xd8\x3d\x9e\x8f\xe0\xe5\xa0\xde\xdf\x8b\xa9\x1c\xf0\x71\xb2\xca\x56\xd0\xa5\x46\x6d\xa4\xf2\x65\xa4\x04\x0a\x54\xc3\x7c\x41\x25\x87\xbc\x69\xc6\xa3\x74\x15\x33\xae\xf7\x2d\xcc\x41\xee\x8e\xcd\xd8\x9a\xc7\x74\x0f\x37\xdb\x64\x75\x67\x75\xdb\x22\x25\xd0\x84\xb5\x61\x8f\x72\xe2\xa8\x0d\x72\xa5\x4f\xbd\xd0\x19\xc6\xd8\x1f\x3e\xe0\x5d\x30\x51\x7a\x20\xb4\x66\xc2\x38\x06\x54\xec\x05\x74\x9b\xf1\xbc\x98\x7c\x51\x1e\x80\xda\x6c\x86\xe8\x07\xaf\x0c\x36\xa6\x63\x76\x75\xa7\xa4\x13\x75\xf6\x1e\xb7\x05\xa3\xe6\x75\xee\x7f\xfa\xb0\x6e\xc1\x0b\xbe\xeb\xf4\xe7\x57\xbd\xd3\xe1\xe9\xdf\x6d\xbf\xc4\xd3\xe1\xe9\x77\xce\xff\xbf\xcf\xff\xff\xf8\xd1\x55\x6f\x9e\xdf\xad\x6f\x7f\x3d\xed\xdc\x60\x71\xd7\xa5\xfe\x00\x4e\x43\xc3\x40\x80\xb0\xf9\xf1\xf7\x8d\x8f\x1f\x3f\x2a\x3c\x76\x31\x2a\xbd\x78\x5a\x78\xb1\x5e\xb3\x00\x6d\xda\x9c\xf1\x07\xc4\x0a\xef\xe9\xdf\xbe\xf3\xfc\xf6\x7d\xf5\xb7\xd2\x1c\xea\xdb\xc7\xa7\x35\xad\x02\x4e\x4a\xec\xd3\x68\x8b\x6b\x8c\x91\x87\xf5\x1a\x2e\x27\x3b\x7a\x2e\xd2\x74\x48\x14\x48\xc7\xa5\x91\xd5\x2e\x7b\x1d\x16\x68\x35\x98\xcf\x9c\x5f\x8c\x2f\xdb\xf8\x4a\xb0\x43\x72\x8b\xb7\xc7\x97\xcd\x7f\xd2\xd5\x3a\xda\x8e\xf5\x69\xa6\x88\x80\x08\x5a\xa7\x4f\xed\xbf\xc2\x31\x70\xb8\x6d\xc9\xbe\x80\x2e\xc6\x97\xc8\x40\xa3\x44\x74\x46\xe3\x95\xe7\x3b\x28\xfd\x28\xbe\x5d\x12\xed\xe7\x54\xd8\x09\x4d\x4b\x3b\x01\x6f\x1f\x57\xd4\x4b\xd8\x15\x05\xb3\x03\x9e\xee\x98\x1a\xe1\x86\xa1\x9a\x51\x77\x87\x32\x34\x28\x8e\xd5\x40\x0d\x33\x0a\x60\xae\xa1\x68\xa3\x15\x4a\x34\x28\x7c\x82\xbc\x03\x21\xd4\x33\x90\x1d\x43\xfa\x0d\x0d\x8e\x23\xb4\xb0\x2a\x41\xf1\xc0\xe2\x2e\x1e\x71\x3e\xf1\x09\xe0\x2c\x5d\xc4\xc5\x4b\xc0\x76\x1d\xbf\x6a\x17\x2e\x8f\x7f\xd5\x23\x57\xba\x24\x7d\xaa\x1c\x89\x3a\x74\xc0\x93\xd2\xc0\x6d\x8e\x67\xf5\xaa\x50\x1c\x65\x81\x74\x6c\x69\x26\x51\x31\xaa\x1e\x1d\x09\x43\xe7\xb6\xcb\xb6\x73\x20\xdf\x62\xc2\x89\xda\x16\x0b\x89\x53\xc9\xc6\x51\xc4\xe0\x22\xac\xc9\xf4\xe6\x49\x9d\x5a\x6d\x93\xf7\x1b\x17\xc6\xfa\xf9\x49\x7e\x47\x12\x04\xd8\xd3\x9b\x27\xe8\x6c\xf2\xfc\x0d\x5a\x44\x2c\xb8\x56\xa9\x34\x34\xfa\xf6\x09\x94\xce\x2e\xe9\x87\x2c\xa5\x03\x70\x17\x26\xd9\x41\x9c\xa3\x4d\x9a\xcd\x99\x31\x0f\x9c\x45\xa5\x21\x6f\xc7\x93\x79\xdf\xba\x8f\x79\xdf\xba\x8f\xda\xaf\xfd\x98\x5c\xaf\x3e\xa6\x92\x46\xe2\x23\x4d\x62\x22\x87\x93\xe9\x45\x5d\xab\x9d\xa0\xfe\x30\x64\xc3\xec\x67\xe5\xaf\x9a\xd6\x09\xea\xd9\xde\xd9\x2e\x08\xf6\x40\x18\xf4\x03\x98\x4e\xde\x3f\xa8\xe9\x89\x6a\x5f\x1f\xe8\xd7\x07\x92\x0d\xe4\x9a\xb8\xe7\x4c\x71\x42\x4d\x3f\x91\x81\x3d\x16\xd8\xb1\x95\x43\xab\xe6\xac\xfb\x01\x62\x5b\xd7\x54\x10\xae\xaf\xb1\x33\x35\x3f\x53\xa8\x17\x9d\x91\x20\xe5\x54\x6e\xd5\xf1\xe8\x37\x69\x44\xda\x2e\x4b\xf3\x18\x4d\x8b\x04\x57\xf0\x71\x1a\x48\xd3\xe5\x05\xe6\x44\x0b\x22\x6f\x09\xf1\x94\x24\x21\x61\x06\x47\x2b\x18\x3d\x6f\xbb\x5a\xf8\x59\xed\xe6\xa5\xb1\x3d\xd4\x93\xd5\xc9\x8b\x4e\xab\xf4\x59\x01\xf3\xaf\x4c\x2a\x24\xdb\x98\x33\xfb\xed\x6f\x95\x28\x7f\xd5\x44\x7d\x5b\xfa\x04\xe5\x60\x50\x3d\x15\xa8\x8f\x51\xce\x88\x7d\x64\x1b\x1a\xaa\xa3\xa4\x34\x46\xba\x25\xb0\x51\xc9\xd0\x74\x39\x36\xd7\x39\x02\x3a\xc2\x54\xca\x9e\x95\xc7\xa9\x15\x38\x3d\xa3\xf3\xd3\xc3\xbd\x6a\xb7\x8e\x8c\xc0\x4e\xf1\xac\x80\x0d\x0d\x6c\xcb\x73\xd7\x0b\x1d\xf9\x20\x39\x06\x85\x7d\x7f\xdb\xdf\x60\x88\x72\x73\xaf\x4d\x96\xdd\x5b\x04\x46\xea\x23\x32\x5c\x0d\x11\xd6\x4f\xe0\x6d\x6b\x99\x2d\xe9\x60\x80\x78\x8b\x70\x38\x58\xb3\xaa\xb5\x6f\xb3\x7a\x77\x05\xc3\x89\x87\x38\x3d\x1a\x96\x69\x5d\x47\x54\xf7\x2b\x2d\xac\xb3\x35\xe6\xba\xbf\xda\x6e\x15\xd9\xd5\x95\x80\x50\x31\xc0\x11\x84\x5c\x61\x58\x56\x24\x5a\xef\xc0\x46\x73\x9c\xdf\x9d\x9a\xdd\x68\x63\x43\xce\x3a\xed\xa3\xa0\x56\x07\x85\x4a\xaf\x98\xe3\xd0\xa6\x87\x4d\x51\x25\xa9\xe9\xe0\x9e\xf4\x34\xa6\x41\x61\x9f\xb9\xa8\xf2\xca\x2d\x9f\xec\xa9\x72\xa6\x0c\x1d\x14\xdd\xc0\x81\x03\xb7\x7f\xb9\x3a\x59\xa1\x0e\x45\x98\x84\x55\x96\xc2\x2a\x42\x27\xba\x85\x84\xff\x4b\xc4\x36\x44\x6c\x51\xc0\x1b\x63\xd9\xc9\x0d\x83\x4c\x86\x77\x20\xb7\xef\xc3\xfd\x6a\x39\xdd\x77\x29\x77\x8d\x85\x29\x64\x67\xb7\x8e\x7f\x64\xc2\x8c\xeb\xef\x04\xf8\x86\x59\xb7\x87\x4e\x4c\x78\xd0\x44\x27\x1e\x34\xa1\x79\x0c\x53\x9b\x95\xf7\x4b\xc1\xc9\xf4\xe6\x9b\x02\x5e\x56\x41\x9b\x3a\x01\x1b\x58\x54\xd3\xd1\x7d\x84\x4b\xfa\x1a\xfc\x1f\x02\x55\x42\xf6\xc2\x70\x9a\x67\xb1\x69\x0c\x97\x8b\xf2\x2c\x21\xa3\x3b\x10\xfe\xc1\xd4\x29\x26\xb0\x44\x40\xbf\x08\x07\x24\x33\xe4\x2a\xb4\xaa\x18\x7c\xe3\x81\xcc\x2c\xf5\x14\xec\xa2\xd6\xff\xc8\xa8\x3c\x08\x68\xc8\x3b\x7a\xf2\x7f\x4d\xda\xec\x74\x6e\x4a\x34\xb9\xea\x3d\x2b\x51\xb3\xde\xb1\xb1\x3a\xe8\x95\xe9\xb0\xf3\xa7\x8f\xe9\x0c\x73\x36\x71\xdd\x03\x7c\x8d\x15\xf5\x6a\x43\x8b\x87\x2a\xaa\xcd\x55\x2c\xd8\x1c\xeb\x9f\x57\x75\xac\xd2\xad\x9d\xd6\xf6\x6e\x20\xf0\x13\xcd\xef\x5d\x1c\x40\x3e\x00\x2c\xe1\x64\xa0\xb2\x49\x24\x2c\x18\xb1\xd9\xab\x4e\x74\xd8\x31\x94\x1f\x21\xe3\x87\x75\x31\x26\x36\x2b\xd7\x84\xd6\x35\xd9\x6a\x21\x1a\xff\x6a\x68\x1f\xdf\x90\x98\x3a\x87\xbf\xd5\x26\xa4\xe9\x8b\xf8\xfe\xc1\xc8\x76\x48\x1c\x71\xa2\xfc\x8e\x01\x9c\x4f\xc6\x71\x38\xb8\x49\x82\xd1\x43\xf7\x6c\xc7\x3b\x63\x52\xed\xb9\xc5\x9f\xa7\x67\xf5\x4a\x23\x15\x24\x3f\x02\x09\x0f\xcd\x15\x2a\x4a\xde\x06\x85\x12\x8a\x87\xdd\x7c\x99\x9d\x18\x3a\xc2\xdb\x88\xdc\x55\xef\x99\x4b\x0b\x90\x58\x17\xdd\x9d\x3a\xa0\x03\x8a\x57\xbd\x67\x1e\xe2\xc1\x8c\x7b\xdf\xbd\x45\x0b\xad\xee\x40\x0b\xf5\x6a\x95\x8c\x87\xef\xfc\x91\x96\xfb\xa2\xd5\x67\xd5\x27\x35\xb2\xd8\x2d\x24\xe8\x37\x64\x1e\x9d\x67\xe0\x70\x39\x7f\x06\xf5\xd9\x2d\x8f\x4b\xe5\x7e\xd8\x36\xff\x52\xcd\x29\x1c\x31\x05\xbc\x8a\xd8\x02\x47\xd6\x9c\x81\xce\x83\x33\x31\xc1\x9a\x46\xa1\xf9\x31\x07\x65\x97\x1c\xb4\x1f\xb1\x98\x14\xb6\x77\xa0\x85\xa6\x25\x5b\x8b\xd4\xb0\xe6\xe5\x97\x1c\xaf\xa0\xac\xfd\x00\xa5\x8b\xd1\xe5\xeb\xf3\x9f\xd0\xd2\x8c\x04\x86\xdc\x6c\x12\x12\x5e\x2a\xac\xca\xcd\x36\x1c\x81\x9c\xeb\x43\x6b\x62\x78\xd5\xa3\x6c\x98\x7f\x33\x5c\xf1\x24\x18\xde\x9c\x0e\x03\x4e\xaf\x7a\x43\x81\xe3\x70\xc1\x3e\xfc\x46\x37\x78\x05\x4d\x49\xde\x90\x15\x15\x12\x8a\x63\x28\xe7\x8c\x43\x95\xbf\x04\xdb\x3f\xe7\xe6\xc1\xb9\xfe\x7d\x5e\xbe\x7f\x59\x1d\xb7\x54\xb6\x0d\xda\x18\x66\xa7\x30\x3b\x29\xaa\xbd\x91\xd5\xbb\x61\x16\x63\xbd\x11\x56\x8b\xb5\x7e\x5c\xc4\xdc\xec\x9a\xd5\xe3\xaf\x67\x28\x11\xc1\xee\xb5\xb5\x24\x45\x46\x89\x4f\xa5\x5d\x6c\x67\xc8\x32\xab\xd4\x48\x8e\xfb\x4e\xad\xe3\x5e\xe5\xb4\xc2\x63\x07\x8a\xa6\x4b\x04\x4a\x2f\x76\x29\x5f\xd9\xe0\x04\xae\x7f\x30\x14\x85\x9a\x1e\x61\x6b\xf9\x6d\x98\x62\x0b\xd7\x28\xb7\x14\x37\x2b\x3b\x0f\x59\x70\x4d\xf8\x90\xb2\xa7\xe8\x5d\x7e\x72\x5c\xbf\x34\x34\x16\x08\xb6\x0c\xae\x7a\xef\xbb\x1d\x4d\x3e\x04\x2a\xcd\x06\x2e\x68\x9a\x9b\xea\xc1\xd3\xcf\xdf\x1b\x56\xa9\x0b\x9f\x8b\xd5\x34\x27\x25\xba\x37\x9a\xb5\x32\x03\xe5\x33\x94\xb5\xd0\x11\xd5\xb2\x4d\x3a\xf8\x44\x13\x6d\x08\x87\xd4\x03\x8d\x0d\x55\x8b\x4f\x4d\x39\x99\x72\x1b\x43\xdd\xa9\x79\xc1\x98\x14\x92\xe3\xdc\x22\xb6\xef\xe1\x7e\x17\x50\x54\xd4\x7f\x83\x1d\x6c\x61\x0c\x60\x92\x29\xe3\xb2\x6d\xbc\xed\x77\x69\x61\x84\x37\x38\x5e\x39\x7a\x24\x03\xb2\x24\x9a\xbb\x03\xf0\xcb\xb3\x29\x82\xfe\x52\x88\xc3\x88\xea\x42\x7f\x93\x61\x82\x0b\x0f\x2d\x5d\xf3\x60\x03\x0e\xd7\xe5\x41\x89\x3e\x26\xa2\x5a\x37\x89\xac\xd4\x9f\xc6\x41\x94\x86\x04\x9d\x3e\x7a\xfc\xed\x23\xf4\x00\x76\xb7\x22\x22\xf5\x05\x0e\xdf\x7c\xf3\x35\x7a\x40\x3e\x48\x12\x43\x7d\x8e\x4a\x88\xe8\x5d\x26\xd8\x69\x0c\xd1\x2d\x59\xac\x19\xbb\x16\x0f\x87\xc8\xf6\xcf\x05\x3d\x01\x5f\xc1\x63\x18\x71\xf0\xe4\xdb\x6f\xbf\xfe\xb6\x93\x9c\xff\x55\x71\xdc\x53\x0f\xe4\x5c\x76\x64\x39\x07\x1a\x42\xf2\x90\x40\xa4\x66\x63\xd1\x2a\xf9\xaa\x11\x71\x7b\x21\xee\x3c\x45\x49\x42\xdd\xbb\xf8\x5a\x08\x64\xc0\x36\x49\x2a\xd5\x35\xbc\x85\x07\x55\x83\xd9\x24\x43\x02\xf6\x0a\x6e\xd7\x04\x62\x98\xec\xa2\x3d\x38\xb1\x68\x2e\x1b\x0e\x41\xaa\xe6\x24\x78\x3c\x37\x7c\xc7\xb8\xfa\xc5\x9c\x54\x9f\x0f\xd1\x2f\x90\xbb\x06\xf7\x40\xb2\xfc\x67\xc8\xe2\xd8\x76\x6f\x89\x6e\x19\x8d\x04\x89\x48\x60\x0a\x58\xf3\x4b\xfd\x74\x5e\xc6\x36\x1e\x35\xd7\x22\x40\xb7\x21\x1c\x71\x82\xc3\xad\x8e\x9d\x44\x27\xa1\x69\x85\x94\x29\x68\x0e\x1e\x5b\x07\xc8\xc5\x4f\x3f\x34\xd8\x98\x17\x8a\xa8\xfa\xde\x38\x3e\xd6\x19\xd2\x99\xf8\x00\x47\xb0\x70\xfc\x25\xd6\xa4\xbb\xad\x63\x9d\x12\x45\x6b\xa6\x5c\xdc\x87\xe8\xb2\xe6\x92\x11\xfb\xd6\x9e\xf7\xa2\x7c\x1e\x20\xea\x7c\x9e\xfc\x25\x58\xa4\x9f\xee\xfd\x8c\x7e\x3d\x69\x74\xff\x00\x1f\x55\x8c\x93\xe8\x9e\xfe\xde\x10\xb1\x46\x34\x06\x0e\x50\x4d\x7f\xdb\x93\x6d\x88\xe6\xd7\xdf\x41\x45\x46\x32\x37\x92\x20\x2a\x13\x2a\x8d\x98\xef\xe7\x74\xbd\xe3\xea\x7e\xd0\xd2\xe2\x6f\x70\x33\xe2\xbf\x37\x86\x2d\xd8\x49\xb2\x84\x45\x6c\xb5\x9d\x25\xa0\x15\xcf\x58\x0c\x4e\x1e\x8d\x0f\x74\xc7\xae\xbf\x13\x43\xca\x3e\xe2\x84\x7e\x0c\x18\x27\x1f\x6f\x4e\x87\x97\x35\x13\x1d\xc3\x61\x03\x2b\xc1\xe2\x0a\x79\xcc\xd2\x40\x18\xac\x26\x75\x2e\x5f\x0a\x38\x13\xa2\x9a\xdc\xef\x24\xba\x43\x34\x57\xdc\x3e\x53\xab\xc3\xf8\xdc\x6e\x70\x66\x11\x93\x03\x8c\xe5\x20\x58\xb1\x39\x0c\xf8\x36\x16\x58\x52\xb1\xa4\xb0\xc9\x58\xfc\x74\x3e\x33\xf6\x64\x1c\x6f\x6f\xf1\xb6\x5b\x00\x77\x5f\xb4\xd0\x8c\x5b\x20\x88\x65\xdf\x96\x64\xd1\x23\x54\x68\xe3\x1b\x45\xbf\x5a\x24\x93\x79\xcf\x61\xf3\x93\x12\x57\x35\x7a\x88\xae\xdb\x93\xd3\xbb\x41\x3e\xbc\x3a\xb9\xde\x9a\x1e\xd9\xef\xf4\x06\x6c\x96\xb0\xce\x85\xc6\xfd\x93\x76\x6c\xd3\x7d\xe4\xa2\x97\x59\xce\x72\xb6\x70\x34\x13\x16\x56\x0b\x83\xef\xd7\x94\x6d\x70\xe2\x93\x04\xcb\xb8\x93\xe7\x99\x09\x30\xc9\x50\xa3\x86\x41\x44\x60\xa7\x14\x22\x6a\x23\x69\xf6\x05\xd5\x44\x28\xcb\x7e\xeb\xbd\x4b\x33\xc6\xcf\xd3\xb3\x62\xbc\xe3\x0e\x6c\xde\x59\x52\xae\xbb\x5b\xcc\x6f\x92\x60\x58\xcc\xa2\xcf\xb5\x34\x16\x8a\x12\x84\x1d\xb9\x93\xd2\xf8\x82\xf1\xd6\xa2\x5e\x45\xde\xaa\x85\xd6\x24\x68\x61\x0c\x0b\xdb\x1c\x6d\x2d\x60\x57\x36\xdc\x6d\xce\x5c\x72\x17\x2b\x4c\xec\xcf\xa0\x39\xcd\xde\x91\xbe\xcd\x6a\x89\x03\xb8\xcc\xb7\xe1\x13\x1d\x85\x80\x61\x53\xe7\xdb\xe8\x52\x75\x61\xed\xea\x14\x7d\x66\xd0\xf6\x8c\xf6\x1d\xcd\x52\xb7\x89\x75\x74\x85\x6c\xf9\x17\x0c\x7c\x0d\x9e\x4a\x66\x20\x71\x52\x5f\x3c\x99\x2d\x46\x7b\x7d\x7d\xa4\x89\x0b\xea\xfc\xc5\xcb\xd9\x79\xb9\xe1\x92\xff\x10\x34\x44\xe0\xb3\xad\x90\x64\x33\x79\xee\x70\x52\x6f\x03\x9f\x4f\xb1\x5c\x57\xe9\x5c\x67\x0f\x0a\x43\xb9\x4f\xaa\x42\xd6\x2c\x3d\x16\x6d\x18\x10\x09\x05\x9c\x51\x4e\xf3\xa5\x18\x3c\x3a\x7d\xfc\xf5\x37\xdf\x3e\xf9\xfb\x77\xdf\xe3\x45\x10\x92\xe5\xa3\x6e\xfe\x55\xd3\xf0\x26\x76\xf7\xcc\x51\x75\x4e\xbc\xb4\xda\x1f\xeb\xf1\x42\xb0\x28\x95\x04\x25\x58\xae\x11\x96\xe6\xfe\xbb\x12\x9c\xe0\xbd\xaa\x95\xe9\x18\xfd\x76\x1f\x7d\x4f\xc9\xdd\x83\x9d\xf6\x11\x5b\x1c\xa3\x17\x2f\x67\x05\xd8\x0d\xe0\xd6\x77\xd6\xea\x32\x2b\x29\x82\xb7\xd5\x1b\x68\x4d\xa2\xc4\x39\x6e\xbd\x8b\x72\x87\xcf\x54\x10\x4c\x93\x05\x9a\xea\xd4\xd7\x6e\xf1\x74\xfb\xf1\xec\x96\xc0\xe3\x1c\xa3\x2f\x65\xaa\xba\x95\x63\xd4\x8d\x91\x0d\x91\xf1\x11\x70\x52\xb9\x9f\xe5\x41\xfd\xbb\x6c\xa7\xdd\xff\x2b\xa0\x43\x19\xf8\x63\xa6\x85\x1d\xb4\x70\x55\xba\x9b\xc1\xa6\x8d\x01\xad\x1b\x5a\x5d\xc7\xf6\xa2\x2b\x4c\x5c\xd5\xd6\x33\xf1\xc7\xe6\x45\x16\xb2\xb1\x5a\x3e\x63\x61\xce\x4e\x7e\x8b\x9a\x85\x38\xa7\x37\x20\xda\x54\xe3\x23\x08\x0b\x22\x86\x55\x3e\xc5\x66\x4b\x4b\x28\x77\x21\xe7\x61\x33\x9d\x78\x10\xb5\x4d\x69\xf6\x67\x9f\x4b\x88\xca\x52\xce\x61\x6f\xbe\xd8\x76\xa4\xc2\xcc\x5d\x50\xed\x30\xac\x1f\x2f\x7f\x88\xf5\xd9\x9c\x59\x6d\x87\x2c\xac\x66\xab\xc8\x30\x7f\xc8\xac\x07\xa2\xc3\x08\x5b\xd7\x00\xd8\xe9\xe5\x24\x61\xb6\xa0\x43\x34\x01\x9f\x35\x26\xb6\x53\x70\xd8\x87\x72\xdf\xcc\xff\xb1\x47\xee\x6c\x75\xf9\x2d\x8d\x22\xc8\x8a\x81\xbb\xdb\x8d\xe4\x5f\x08\xc8\x27\x1e\xd2\x7f\x59\x0d\xda\xdf\x3a\x9d\x32\xf2\x9e\x22\xa6\x5b\x46\x27\x92\x77\x18\xa9\x2e\x8e\x3b\x29\x21\xd3\xa9\x5d\x82\xcf\x92\x78\x35\xaf\x47\xb2\x1a\x1a\x2a\x18\xa5\x52\x31\xc0\xfb\xf8\x2c\x5a\xe7\x19\x9f\xdf\x5e\xbe\x6e\x7b\x95\x64\x9a\xce\xb2\x5e\x8d\x72\xdd\xb5\x0e\x07\x4d\xd2\xe0\xa9\x64\x66\xa6\x95\xc7\xa2\xdb\xe6\x56\xa8\x56\xe7\xb6\xdc\x7f\xcf\xe2\x02\x0d\x9d\xdb\x0d\x15\x64\x46\x2f\x40\xbd\x58\x6e\xf7\x4b\xd6\xaa\x9b\x82\x3a\xc2\x0c\x2d\xb2\x21\xf9\x4a\x94\x28\x5b\xa2\x59\x4b\x5a\x64\xc3\xe9\x53\x55\x26\x82\x38\x1e\x25\x5a\x8f\x7f\x80\xca\xa8\xeb\xe7\x5c\x61\xd5\x43\x04\xfc\x00\xdf\xa9\xad\x78\xef\xeb\x34\x19\x4a\xf5\x5e\x46\xe9\x87\x36\x29\xde\x65\xe4\x31\x57\x35\x6e\x69\x94\x7e\x78\x19\x15\xf5\x67\x95\x46\x38\x46\x4e\x3b\x31\x9c\x80\xe9\xd5\x6c\xa8\x40\xcf\xfe\x97\x60\xd8\xde\x89\xb7\x48\x41\x00\xcf\x00\xe4\xbc\x8a\x49\x5d\x45\x6f\xb2\x86\x82\x10\x64\x4b\xd4\x96\x51\xfa\x21\x08\x87\x94\xa9\x5b\x58\x46\xca\x42\x3b\xed\x65\x20\x66\x03\x9f\x63\x59\x05\x74\x07\xe5\xbf\x28\xc0\x33\xb8\x33\xce\x87\x2b\x9a\xa9\xb4\x57\x89\x1f\x20\xf0\xe0\xae\x72\x92\x30\x41\x25\x33\x05\x84\xce\xa5\x44\x43\x74\x86\xe1\xc8\x06\x22\x54\xd5\x50\xbc\x52\xbd\x0d\x10\xe3\xe8\x15\x95\x11\x5e\x74\x13\xfe\x43\xe7\xda\x53\x11\xb8\x84\xea\x97\x79\xfd\x28\x9a\xc0\x64\xef\x80\xd3\x4a\xbb\x31\xea\x15\x28\x1b\x85\xcb\x80\x94\x51\xc6\x40\x3a\x97\x0c\xca\x25\x80\xe5\x7f\x45\xe5\xeb\x44\xa0\x4b\xc6\xa2\x6b\x2a\xd1\x03\xc5\x48\x37\x8f\x1f\xb6\x57\x17\x77\x0d\x47\x45\xa7\xbc\x2c\xe9\x8b\xdd\x46\xbc\xcc\x9b\x95\x95\xac\x31\xdc\x65\x92\xe3\x92\x50\x02\xe0\x20\x8b\xc0\xbc\xb9\xe0\xd6\x08\x65\x6b\x82\x1e\x69\x16\x8f\xf1\xb6\x54\x7c\x45\x65\x1b\xc5\x9c\x0d\x6a\xfc\xb3\x76\x3a\xda\xbe\x6c\x01\xf1\x11\x52\x27\xa6\x2d\x83\x48\xa6\xfa\x47\x03\x27\x63\xf4\x43\x69\x52\x9b\x01\x33\xe1\xcf\x10\x3d\x7f\x31\x7d\xf3\xe2\x6c\x7c\xf9\xe2\x79\x37\x45\x70\xac\x39\xb3\x29\x33\xf6\x41\xa8\x07\x96\x0d\x17\x5d\xd7\x06\x12\xbd\xb6\x6f\x77\xa2\x91\x95\x2e\x9d\x3c\xf9\x27\x89\x36\xc8\x0e\x04\xf5\xf5\x01\x8b\x7f\x4f\x63\x55\x24\xa3\x8a\x4b\xa1\x1c\x0c\x58\xe3\xe6\xd4\x62\x6a\x6e\xcd\x3e\x1a\x01\xef\x02\x20\x2f\x75\x41\x61\xb4\xa3\xec\x1b\x78\xb3\x13\x55\x75\xab\x8a\x0c\x32\x16\xa3\x2d\x4b\xf9\x1d\xb0\x5b\x97\x89\xf6\x34\x3a\xbc\x88\x7d\xce\x95\xfd\x06\xa1\xfe\xec\xc6\x48\x11\x02\x94\x99\xd1\xf9\xe0\x75\x58\x32\xa8\x1a\x8f\x88\xc6\xb0\xdb\x84\xa8\xf4\xd9\x8c\x21\x7a\xf7\x8a\x4a\x96\x08\xa4\x2e\xed\x7b\xff\x60\xb4\x52\x7f\x0e\xfe\x9d\xd2\xe0\x5a\x48\x5c\xb8\x80\xf8\x98\xd6\xeb\x60\xc0\x9d\xe3\x7d\x55\x98\xaf\x7a\xcf\x5c\xbc\xf2\xc3\xbc\x66\xed\x7b\x9a\x5c\x6d\x14\xf7\xb2\xe8\x79\x37\xc8\x0b\xb0\xfd\x01\xf2\xf2\xb8\xcc\xc6\x47\x14\x91\xea\xd8\x7b\x4a\x85\xa2\xc6\xbd\x73\xb9\xf5\x6c\x3a\x33\xcd\x05\x93\xe4\xa9\x6e\xbb\xab\xb2\x95\x50\x93\x05\x01\x2c\xe8\x5c\x16\xc1\x5d\x68\xe0\x53\x81\x07\x23\x3e\x0b\xd7\x7f\x16\x44\x0a\x8c\x3f\x19\x9f\x4f\xcc\x7d\x99\xb6\xe7\x5e\x0b\x21\xb0\xbd\xbb\xdd\x1f\xab\xae\x60\x13\xef\xeb\x5d\x5c\x1c\x67\x2d\xd9\x6f\xd7\x4c\xe8\x06\xe1\xa9\xb0\xa7\x12\x60\xc3\x46\x97\x4c\x6c\x70\x92\x90\xb0\x5f\xac\xb5\xcc\xf7\xec\xd4\x61\x64\xb4\xa4\x24\x0a\xbb\x45\x85\x77\x08\x46\x06\x45\x26\x49\x40\x38\x7e\x48\xeb\x61\xa7\x8b\x3a\x90\x06\x42\x29\x20\x56\x27\x8c\xeb\xc6\xf0\x82\x6b\x7a\x75\xdd\xd7\xd6\x85\x93\x5c\x32\x52\xe5\x03\x5d\xed\x7a\xab\x85\x41\x92\x75\xa2\xc5\x3e\xe3\x9f\x78\x90\xea\xc1\x6b\x07\xee\xdd\x3a\xb0\xd8\xd1\x5a\x40\xb3\x27\xb6\x1d\x66\xd8\xd3\x30\x60\x1e\xf7\x7c\x04\xaa\x32\x97\xf3\x8b\x11\xc2\xe3\x18\x14\x5d\x52\x17\x57\xd1\x53\x0a\xd4\x47\x0c\x50\x39\x9a\xcf\xfa\xf0\xb2\x1e\x20\x8a\x32\x22\x95\x35\x42\x51\x73\xc0\x36\x8c\x3a\x29\xea\x6e\x5d\xec\x5a\x93\x7b\x05\xb2\x68\x08\x8c\x15\xf0\xa4\xa0\x6a\x36\x0a\x14\x73\x57\x96\xaa\xce\x64\x18\x51\xc8\x7f\xe9\x26\x1e\x35\x9d\x9d\x19\x0d\x83\xab\xde\xfc\xa9\xbe\xbe\xd7\xde\xfc\x6c\x77\xfb\xf8\x51\xfb\x2c\xc3\x5c\x85\x2e\xc6\xed\x66\xf5\x37\x2c\x86\xc1\x8e\xd1\x78\xd8\xbf\x08\x2c\x26\xaf\x97\x85\x17\x5b\xf8\xab\x80\x4c\x85\x0b\x2a\x60\xe5\x93\xd4\x5d\xb8\x52\xa1\x47\xd1\x0f\xca\xda\x96\x10\xdb\xa9\x23\xab\x1e\x55\xaf\xe5\x77\x8b\xe7\x8d\x57\x47\x79\xe3\xd5\x91\x7e\x79\xb4\x88\xd8\x62\xb4\xc1\x34\xce\x3b\x9e\x3c\xfe\xfb\x00\xc8\x3a\xb0\xf3\x0e\xb7\x78\x13\x3d\x1c\x76\xbf\x32\xa6\x15\x06\x79\xc0\x71\x54\x78\x55\x17\x93\x1a\xd2\x38\x0d\x46\x32\xb1\x2d\xde\x9d\x98\x0b\x58\x9d\xce\xfc\x33\xe7\xab\x96\x99\x39\x4b\x96\xad\x93\x21\xfb\xaf\xd9\xeb\x8b\xd1\xbf\xc6\xe7\x3f\x65\x97\x23\x8a\x3e\x12\x69\xb0\x86\x4e\x2b\xaa\xd5\xa3\x01\x19\x25\x98\xe3\x0d\x91\xa0\x94\x18\x2f\x5c\x0b\xd8\x79\x5d\xee\x0e\x80\x86\x7c\xde\x04\xf2\x3b\x71\x40\xde\x90\x25\x27\x62\xdd\xc6\x3b\xa6\xe6\x93\x5f\x30\xdf\xd4\xf7\x34\x02\x94\x57\x65\x65\x51\xc2\x3c\x4e\x37\x0b\xc2\xc1\x45\xd5\xb5\xd7\xd0\xac\x3e\x26\xb7\xaa\x64\x4d\xb5\xb8\x50\xd9\x8f\x05\x64\xe1\xe1\x44\x25\x5e\x42\xdd\x05\x95\xe0\xb1\xd0\xd8\xe6\xe1\xfb\x95\xf3\x1f\x6b\x82\x23\x68\x96\xb5\x26\xc1\x35\x5a\x71\x88\x78\x12\xc2\x29\xcb\xee\x98\x83\xfe\x82\x68\x16\x60\x15\x9b\xac\x4a\xdd\x60\x76\x2f\xd8\x17\x04\x76\x06\x75\xc6\xf6\x50\xef\x49\xe3\x7f\xaa\xb1\xb6\x53\xc2\x03\x12\x4b\xbc\x22\x87\x2c\x53\x92\x8d\x62\x21\x09\x89\x80\x5a\x40\x14\xe0\x04\x07\x60\x1b\xd4\xa9\xee\x4d\x2a\x20\x43\x0f\x5a\xc0\x41\x14\x76\x4a\x23\xe2\x94\x22\x42\xc0\x63\x02\xb8\xb0\x48\x85\xef\x1f\x75\x5a\x87\xcf\x09\x57\xc5\x50\xb4\xb3\x5f\xde\xa5\xc8\x71\x2c\xcb\x52\xc5\x08\x1d\x56\x33\xae\x21\x83\x06\x31\x59\x51\x97\x9d\x10\x71\x2d\xf0\xc6\x85\xca\x5b\x17\x67\x2b\xd0\xbe\x42\x7c\xaf\x69\xbc\x5a\xc8\x57\xc6\x51\xa7\x86\x82\x24\x1d\xf3\x60\x4d\x25\x09\x64\xca\x0f\x71\xbe\xce\xa6\x6f\x91\x3b\x94\x45\xe2\xc5\xd9\xe3\x1c\x11\xd0\x6b\x43\x54\xe3\xa7\x7d\xf8\xee\xc9\x6f\x4f\xbe\x81\x0b\x34\xe6\x57\x3d\xbc\x09\xf3\xff\xf3\x8d\xfa\x7f\x27\xbe\x3e\x10\x1e\xd7\xa9\xd3\x80\x15\x2f\xa7\x70\x9f\x2b\x58\x1b\x1e\xf3\x4d\xe9\x71\x1b\xe7\x4f\x4f\x5a\x78\x13\x58\x79\x13\x7a\x7e\x84\x09\x6a\x1c\xc5\xfc\xd5\xde\x2a\x49\xc5\x21\x1a\x4c\xa8\x8b\x3d\xa9\x29\x3c\xca\xf5\xf7\xab\xe9\x5b\x31\x44\x13\x09\x19\x0f\x9b\xee\x90\x0c\x3d\x72\x4a\x17\x62\x16\x0f\x5e\x4d\xdf\x16\x09\xdf\xb1\x9f\xed\x1d\x4c\x9f\xcd\x9e\xe9\x21\x50\xfc\x64\xc3\x0e\xba\x1f\xb7\x08\xa8\x1e\x0e\xc1\x36\x78\x1a\x53\x59\x50\x89\xaf\xe8\x0f\x07\x90\x60\xd7\xc8\x5e\xec\x6e\xce\xa6\x6f\xef\x84\x0b\xf4\xc0\xfb\x63\x53\x1e\x69\x4f\x5b\x51\x06\xc3\x2e\xa7\xf3\x8b\x92\x83\x7e\xbd\x0e\x3c\xa2\xfd\x28\x28\x1b\x5b\xff\x65\x93\xbc\x19\x4c\xbb\x08\xd5\x66\xac\x82\x25\x80\x9c\xc0\x94\xb3\x0f\xdb\xf6\x0d\x45\xfe\xa2\x6d\x25\xa0\x7f\x0d\xc4\x72\x1f\xb6\x3b\x9a\x3a\x38\x2f\xe6\x07\xa2\x3b\xb1\xeb\xe7\x04\xc5\x13\x6b\xfc\xa5\x5b\x4c\x94\x68\x73\x40\x3b\x06\x2f\xf1\xea\x1a\x4d\x94\xa6\xbd\xbb\x5e\x13\x77\x8e\xdf\xce\x8e\x13\x1d\x51\xad\x63\xb0\x93\x12\x0f\x34\xea\xda\x2f\xe5\x44\xbd\x83\x7b\x88\xc9\x86\xc5\x2e\xba\xed\x3d\xf0\xf6\x63\x57\x94\xad\x4e\xc0\x9a\x93\xf5\xed\x95\x2e\x4d\x5e\xe2\x0d\xad\xbf\x6e\xdd\x08\x67\x69\xe5\x0a\xe0\x4f\xa6\x68\xa9\xc6\xb0\x00\xe3\x30\xe4\x44\x08\x08\xc5\x84\xa0\x2b\xe8\x78\x25\x59\xce\x13\x86\x1f\x45\xad\x17\x0e\xcd\xbb\xc1\xef\x86\xb2\xaa\x55\x2c\xe0\x12\xa5\x6f\x9c\x41\x7d\x63\xf5\xcd\x77\x4f\x4a\xdf\x3d\xd9\xf1\x5d\x37\xff\xef\xb8\x98\xba\x0e\x3a\xa0\x58\x74\xdf\x3b\x21\x5f\x1a\xea\x49\xed\x50\x1d\xe9\xe1\x8f\x0b\x00\xa4\xc2\x7b\x10\xf9\x41\x03\xdd\x9d\xfe\xbf\x99\x06\x06\x80\x83\xfe\x07\x30\x1d\x7c\xae\x7b\xd2\xd9\x32\x6e\xc2\x09\x9a\x9b\x96\xd5\x93\xe9\x5c\x05\x51\x06\x75\xe8\x3b\x32\x31\xf9\x83\x05\x41\x18\xcd\x47\xa7\x8f\xe7\x40\xcb\xf9\xe8\xf1\x37\x73\xe7\x6e\x2e\xb8\x85\x26\x26\x88\x2d\x91\x5c\x93\xff\xcf\xde\x93\xf6\xc6\x8d\x23\xfb\xdd\xbf\x82\xe8\x2c\xf6\x25\x40\xcb\x57\x66\xf7\x65\x77\x16\x06\x1c\x3b\x87\x31\xe3\xc4\x70\x67\x36\xc0\xda\x83\xd7\x74\x8b\xdd\xd6\x8b\x5a\x6a\x88\x92\x8f\x79\x98\xff\xfe\x50\x64\xf1\x90\x44\xea\xe8\x6e\x27\x99\x5d\x7d\x18\x4c\xac\x26\x8b\x64\x55\xb1\x58\x2c\xd6\xa1\xf3\xc0\xc3\x7c\x31\x01\x5e\x2f\x7e\x71\x4f\x52\x12\x4b\xcf\x14\xa9\xd4\x38\x5f\xd9\x65\xef\x40\xe7\x0a\xd3\xb9\x4d\xf6\x0e\x7f\x50\xdf\xfa\xac\x62\x4d\x71\xab\xa5\x85\xc1\x42\x8d\xa6\x5b\x11\xb7\x98\x90\x52\x97\x19\x56\xc1\x54\x60\xe8\xeb\xab\xbb\x76\x81\x55\x12\xa7\x3f\xd3\x22\x99\xdd\x7e\x62\xcb\x55\x5c\x2e\x31\xe8\x79\x61\x8a\xc2\xfa\xa2\xbd\xf2\xb6\xad\xd6\x4d\x13\xd3\xcb\x89\x91\x1c\x67\x46\xce\x4e\x7b\xb1\xa3\xa3\xbb\xee\xfd\xbb\xa3\x02\xec\xf6\x26\x8a\x10\x6b\xc9\x3c\x50\x07\x20\xb1\xa7\xfd\xa7\x8f\xa7\x1f\x09\x2f\x56\x90\xda\x90\xfc\x09\x7b\x8f\xc9\x9f\x7e\x86\x0c\x26\xf9\x46\x8b\x7f\xa2\x29\xad\xbb\xb1\xc2\x91\x83\x00\x35\xae\x6a\xda\x4a\x65\x16\x4e\x67\x34\xfe\xf0\xcf\x73\xd6\x45\x07\x58\xa6\x21\xdb\x80\xd8\xef\xd3\x7b\x7d\x2b\xc4\x00\xf5\x65\x9a\x81\xa5\x98\x4a\x69\x6a\xae\x8c\x39\x7c\xbf\x4b\xe3\x62\x29\xe2\x2d\x81\x07\x96\x5e\x35\x20\xa3\x51\xb8\x8f\xe7\x39\x5b\x8a\x32\xac\xea\x05\xd9\x09\x11\x1e\x13\xc4\xa3\xf9\xe5\xf1\xd9\xe9\x3e\xa1\x59\x56\xae\xf6\x3a\xbd\x1e\x71\x5d\x20\x37\x4d\x62\xe1\x02\xcf\x31\x39\x01\x64\x91\x71\x42\xed\xa7\x21\x3c\x09\x2e\xec\xd3\x5d\x20\xa5\x76\xbc\x6f\x03\x3d\xf6\x28\xdc\x51\x57\x77\x5d\x8c\xe1\x08\x80\x1d\x31\xf9\x2e\x1a\x46\xbd\x21\x1c\x34\x62\x52\xed\x4a\xc6\x13\xa7\xc9\x50\xd8\x84\xe3\x1a\x53\x34\xf4\x62\x91\x4d\x40\x5b\xb8\xdc\x5b\x26\xf9\x5e\x72\xb7\x64\xeb\x8a\x1c\x83\x26\x33\x84\x14\x05\xbd\xc4\xce\x78\xc7\x8d\xc1\x96\x4b\x0d\xc8\x26\x1f\x9f\xa6\x73\x55\x71\x4b\xbd\x27\xc8\xad\x94\x16\x1e\x8e\x93\xc4\xd0\x6e\xcd\x2b\x2c\xf2\x03\xed\xc5\x2a\xe1\xa4\xa7\xc9\x63\x7e\x6b\x93\x7d\xc3\x5b\xd9\xb7\x5b\x40\x49\xd0\x9f\x8b\x92\x17\xa2\x00\x5e\xb5\x34\xcd\x56\x52\x7d\x18\xd2\xff\xc2\x59\x76\x4a\x73\x7a\x41\xb3\xce\x69\x02\xdc\x1e\x1c\x36\x24\xc3\xbd\x7a\x4d\x95\xdd\xda\xee\x7f\x77\x7e\x76\xfe\x06\x1e\xf0\x73\xae\xf2\x95\x6b\x57\x47\x8d\x52\x50\x86\xa7\xd2\x4b\x61\xaa\x04\xe1\xb2\x88\xf3\x08\xfa\x81\x58\xcb\x48\x48\x73\xaa\x9f\xe9\xc1\xa7\x02\x8a\xa4\x41\x2a\xe5\x47\x32\x8b\xd3\x22\x0c\xc0\x05\x05\xed\x2a\xd3\x9c\x3d\xe4\x7b\xf2\xb3\x64\x8f\x29\x3c\xdb\xcb\xcf\x0f\x01\xbf\x65\x71\x2c\x77\xfd\x54\xce\x0c\xdd\x49\x8e\x35\x3a\xad\x31\x45\x03\x5d\xd2\x46\xd7\x97\xd5\x6f\x6c\x7c\xef\x99\x21\x43\x00\xfd\x02\xe8\x17\x88\x7e\xfd\xea\x62\x75\x45\x15\x26\x27\x16\xf8\x52\x07\xc0\xe6\x58\x93\x50\x6b\xa8\xd3\x27\x4c\x66\xb7\x28\x61\x51\x35\xb1\x70\x69\x79\xd2\xaf\x85\xb8\xeb\xd1\x91\x9f\x1a\xfe\x3a\x5a\x74\x19\x6d\x70\xae\x4c\xc4\xd3\xc5\x23\xb9\xc2\xc4\x5a\xc7\xe7\x67\xa6\x98\x91\xfc\x16\xd0\x65\x14\xa0\x82\xb9\xf7\x62\x4c\xa6\x50\x21\x3b\xe0\x7c\x39\xc5\x7f\x4f\x85\x47\xdd\x14\x72\x06\x44\xb3\x7e\x49\xa9\xd4\xf0\x35\xdc\x39\x86\xbe\x1e\x1d\x59\x93\x04\x84\x28\x1d\x41\x4d\x08\x89\x62\x7f\xd6\x9f\x34\x2d\xe5\x34\xf1\xbb\x17\xa5\x1b\x1b\xa1\x3c\x3a\xe4\xf1\x92\xfe\x96\x26\x3f\x47\x49\xf1\x70\x08\x6a\x5f\x59\x1d\xfc\xe5\xa6\x48\xf2\xe2\x70\x7f\x1f\x9e\x76\xad\x2f\x07\xaf\xcc\x97\xd7\x69\x9e\xc7\x2c\x83\x62\x15\xb9\xfa\x26\x8b\xe8\xaa\xbf\x3e\x47\x49\x98\xde\xf3\x09\x98\x88\xb3\xc3\xfd\x83\xbf\x41\x7a\x4d\x5d\xef\xc6\xdb\xea\x6d\x11\xc7\x6d\xad\xf6\x7f\xa8\xc2\xea\xa7\x8e\xb6\x69\x93\x36\x7a\xca\xda\x9e\x47\x31\x34\x18\x2b\x35\x77\x35\x3a\x78\xd5\xd8\xc8\xc6\x6b\x43\x33\x89\xea\x86\x06\xcd\xd8\xef\xd3\xb1\x44\x90\xee\x1d\xf7\x7f\xf0\x8f\xe8\x57\x85\x6d\xcc\x77\xd1\x88\xbd\xed\x09\xb1\xd8\xd8\xfd\xcb\xc1\xab\xfa\x2f\x36\xfa\xab\xbf\x49\x9c\x57\xbf\x36\x23\xba\xb5\x75\x09\xbb\x2d\xad\x2b\x28\x6d\x57\xf9\xa9\xf5\x78\xda\x55\x37\xa9\x08\x17\xeb\xc7\xdf\xc7\x2e\x21\xd4\xae\x87\xb0\x87\x15\x4d\xc4\x2b\x41\xc4\x8d\x87\x8c\x3a\x37\xcd\x87\x15\xcb\x08\xf8\x86\xd8\xb3\x1e\x13\x70\x74\x0f\xc9\xf4\x1f\xf0\xff\xa3\xe0\x1f\xf6\x8f\x47\xd3\xb1\xac\x40\xa9\x0f\x6b\xad\x45\xc2\xec\x84\xc2\x19\xe5\xbc\x04\x50\x58\x88\x41\x7b\x3d\x3e\x3f\xc3\x04\x42\x34\x2f\xb5\xd8\x25\x32\x19\xf1\x98\x00\x09\x31\x33\x24\xe4\x0d\x02\x39\xa1\xaa\x09\xde\x3c\x8a\x5b\x25\x96\xbe\xdc\x25\x13\x79\x3a\xb0\xb0\x04\x0a\x86\x66\x64\x2a\x1d\x46\xa6\x02\xd0\x54\xb8\x84\xf4\x3b\x9e\xb6\x81\x40\xdc\xa9\x71\xfe\x23\xfc\xfd\xe7\x45\xfe\x63\xf0\xe7\x38\xff\xd1\x6e\xfa\xe7\x85\xde\xa0\x7f\x08\xbc\xca\x25\x49\xe4\xe2\xbc\xad\x44\xd8\x02\xcf\xcd\xe7\x2b\x5f\x4c\x0a\xbe\x62\x49\x78\x81\xea\xd9\xb7\xdb\x23\x5c\x4e\xc4\xa4\x35\xac\x3b\x43\x92\x14\x6e\x54\x51\x6e\xd7\x59\x85\xe5\x4a\xff\xc3\x13\x50\x1c\xdf\xea\x7c\x15\xf2\x79\x92\x13\xa3\x99\x1f\xff\xeb\x92\xdd\xd0\x18\xa8\x28\x75\xf2\x4b\xe9\x0b\xf8\x4b\x22\xfd\x49\x1f\xa7\xa8\x8b\x67\x2c\x66\x77\x34\xc9\x45\x12\x29\xc8\x86\x61\x5c\xba\xe1\xaf\x5d\x7a\xcf\x77\xa9\x10\xbb\xc2\x57\xfa\xf8\xf3\xa4\x3c\xf6\x1e\x98\x2a\x79\x2e\xae\x33\x22\x0e\x75\x8f\xde\xf3\x80\xe6\x79\x16\xdd\x14\x39\x0b\xe4\xd4\x84\x17\xef\xe3\x2e\xb0\xfb\xb3\xd9\x3c\x31\xbf\xf3\x52\x83\x20\x4b\x63\x40\x81\xfc\x16\x20\x9a\x94\x3a\xcd\x65\x05\xa0\x2b\x24\x23\xdc\x67\x4b\x78\xd3\xed\x9a\x6f\x11\x08\x15\x3e\x83\xb2\x16\x70\xd9\x3d\xd0\xdd\xfb\x5d\x26\x9e\x9c\x96\x92\xf1\x2d\x82\x2a\xee\xd7\xda\x65\x95\xb6\xd8\xc0\xe7\xfa\xfe\xdd\xd1\xf5\x7a\x74\x54\x63\x43\x50\xb5\x05\x92\xba\xdd\x70\x5a\x89\x0a\x55\x82\xdb\xf8\xa6\xe1\xbe\x63\x65\xfb\xfe\x57\x9a\x7c\x43\xd1\xf1\x73\xb4\x8c\x72\x72\x85\xe5\xa7\x52\x82\xce\x5b\x33\x72\xfc\x2f\x73\x87\x02\xbe\x46\x0c\xec\x3d\x83\xe4\xe4\x01\xbd\xa7\x19\x2b\xa1\xa6\x1f\x97\xcb\x61\x6b\xb4\xe8\x32\xd0\xf5\xe8\xc8\x39\x5b\x3f\xb6\x6f\x6c\xb5\xec\xef\x5d\xc2\x61\xb4\xe5\xc7\xab\xd1\x8d\xbc\x4e\x6f\x3a\x73\x1b\xe8\x07\x76\xff\x4a\x0d\xaa\x7e\xae\x74\x6d\x50\x9d\x0b\x9f\xad\x8a\x93\x8c\x85\x51\xdd\xb4\x54\x61\xa4\xa6\x95\x29\x43\x1d\xda\xa8\x67\x02\x20\xbe\xf1\x89\xd9\x80\xd2\x20\xf8\x04\x4e\xf6\xab\x9b\x22\xe3\xb9\x08\x37\x5f\xb1\x4c\xa4\x40\x4a\x66\x46\x05\x68\x3f\x0e\xde\x9c\x1c\xd6\x65\x85\x06\x1a\xc8\xe1\x79\x70\x43\x39\x83\xe8\x17\xb0\x76\xcc\xd8\x2a\xe7\xe2\x30\x78\x31\x26\x77\xe2\x76\x26\xec\xea\xc2\xb1\xa8\x66\xbe\x87\xa5\xa3\x69\x50\x4f\xf5\xf9\xa7\xc3\x31\xf9\xf4\x12\xfe\xa3\x42\x4a\x7c\xfa\x61\xf1\xc2\xfb\x86\x02\x80\x42\x9a\x85\x70\xf7\x8d\x81\x91\xb1\x38\x8c\x8d\x07\xbd\x60\x7c\x02\x8b\x32\xc2\x68\x06\xbe\x0c\xb8\x02\x71\x33\x2d\x12\xd1\x9f\x49\x50\x90\x48\xdc\xf4\x13\x6b\x26\xf4\x26\xbd\x63\x08\x40\xad\x59\x60\x9d\x72\x12\xa7\x60\xc1\x84\xe8\x78\x99\x7d\x18\x32\x4f\x1b\xd3\x0c\x99\xa5\x3c\xef\x77\xb3\xed\x47\xea\xce\x27\xc1\x46\x24\xbd\x1e\x1d\xe9\xa6\x6e\x96\x82\x8d\xff\xf4\x74\xb7\x2f\xab\x8a\x01\x4a\xd7\xd2\x4d\x58\xc1\x06\xae\x79\xa2\x02\xfd\xe9\xb9\xc3\x7d\x49\x56\x8b\x2d\xb5\x25\xc4\xf0\x6e\xfb\x4d\x12\x23\x4f\x4e\x30\xf0\xa4\xcd\x4d\xd9\x0d\x23\xe2\x40\xb2\xb3\xf3\xd3\xc9\xdd\x81\x0f\xc2\x4d\x9a\xc6\x8c\x26\x8d\xf2\x0c\xf1\x21\x11\xc3\xac\x12\xab\x4b\x96\x53\x61\xac\x44\xe7\x0b\x95\xce\x51\x0c\x79\x48\xf2\xf4\x0b\x4b\x78\xaf\xfd\xb4\xcd\xa1\x8c\x99\xc3\x3c\x4c\x7b\x70\x74\x91\x86\x30\xe7\x4d\x90\x24\xdc\x5e\xb8\xb8\xa5\x02\x28\xb3\x00\xe1\x17\x94\xa4\x89\x48\xf8\x66\x3b\x7d\x80\x03\x55\x2f\xe4\x6c\x63\x88\x4e\x48\x49\x78\xcf\x43\xff\xf4\xc3\xa4\x11\x39\x34\x0c\xe1\x40\x86\xeb\x29\x09\x53\x88\xe8\xc2\xa0\x6b\xc6\xd3\x18\x6a\x4d\xa3\x03\x8c\xa2\x36\x54\x05\x52\xa2\x55\x28\xc3\xf2\x7a\x8b\x35\x3a\xc9\x22\xba\x63\x1c\x7d\x8b\x41\xc3\xbe\x82\xf6\x65\xf0\xcd\x37\x90\x30\xe1\x81\x6c\x1f\x60\xfb\x7e\xca\xd8\x13\xaf\xa7\x9b\xc6\x5d\x5f\xc4\xf5\xe8\xa8\x8e\x09\xbf\x96\xc7\x6e\xf8\xc7\x55\x1e\x2d\xa3\xdf\x58\xb8\x09\xeb\x8b\xbc\x2c\x8c\x93\xab\x37\xaf\x27\x62\xe5\xcb\xe8\x37\xb1\xca\xf5\x54\x17\x76\xc3\x03\x84\xc2\x42\x71\xa2\xf5\x23\x8e\x9a\xce\x66\xa7\x6d\x7d\x16\xd7\xa3\xa3\xea\x02\x1b\x70\x3b\xa7\x6f\xc4\x3c\x36\xc2\xac\x5d\x21\x68\x49\x1f\xa2\x65\xb1\x84\xed\x9f\xde\xb3\xd0\x0a\x13\x79\xf3\xf6\x38\x90\x8b\x36\xb5\x6c\x66\x34\x0b\xad\x32\xb9\x11\x70\x5c\x84\x89\x3b\x76\xc9\xb1\x76\x42\x33\x59\xc1\xd1\xc8\x65\x2e\xc8\x58\x8e\x73\xaa\x9b\x4c\xc1\x14\xc2\x59\x3e\x86\x70\x4c\xe9\x2e\x30\xa3\x5c\xd8\x48\x30\x26\x72\xae\x72\x31\x78\xc0\xf7\x54\xae\xbe\x83\xd5\xab\x6a\xf4\xd8\x4e\xe9\x16\x9b\x23\xc2\xc3\x35\x5c\x14\xb2\xe9\x7a\xbb\x75\x8b\x65\x5d\x0e\xc7\x6a\xfc\xfb\xd8\xc5\x83\xed\xb7\xdd\x4a\x35\x10\x5d\x31\x45\xd9\x5a\x24\x82\x6f\xd8\x1c\x1c\x0f\x72\xe5\xca\xaf\x1f\x71\x57\xe0\x43\xfa\xc9\x5b\x4b\x09\x2a\xc5\xc3\x4c\x49\x4e\xb3\x05\xa8\x6b\xd0\x59\x91\x18\xca\x4d\xb0\x19\x8b\xee\x18\xf9\xf0\x76\x42\xf2\x8c\xce\xe1\xe2\x2a\xce\x53\x3d\x34\x1e\x00\xd5\x69\x6a\xf1\xcf\xe6\x3c\x10\x53\xe6\x7b\x2f\x7a\x31\xdf\x1f\x63\xe1\xb5\x93\xc2\x5a\x2f\xc8\xab\xca\x22\x1a\xe4\x95\xd8\x41\xa7\x2c\xa7\x51\xcc\xc2\xf3\x34\x81\x54\x59\xe5\xfc\x56\xbd\xa5\x97\x14\x80\x22\x5c\x2b\x44\xc0\x64\x69\x20\xf7\xa2\x46\x33\x28\xe7\x92\x40\x19\xba\xc4\xa4\xfc\x42\x4b\xd9\xac\xde\x0a\x14\x59\x41\xa7\x1b\x80\xac\xf3\xfd\xa3\xe8\x90\x19\xb9\x4e\x59\x28\x0a\x8e\x87\xe4\x7d\xca\xf1\x6a\x63\xae\x20\xc0\x21\xd2\xa1\x53\xf0\xd1\x58\x0b\x0c\x0c\xd6\x14\xef\x2a\x53\x80\x3e\x25\x39\x4b\x68\x32\x7b\xec\x85\xa5\xaf\x35\x45\x29\x14\x61\x9e\x4a\x1e\xaa\xd9\x3a\x09\x11\xd1\x65\x4f\x7d\xf2\xec\xf8\xdc\x03\x0a\x27\xfa\xa1\x3d\x7d\x54\x63\xff\x8b\x8c\xcd\xa3\x87\x4d\x20\x38\x62\xcb\x1b\x56\x76\x56\xed\xd5\xc4\x69\xc6\x86\xa5\xd4\x48\x30\x5f\x38\xa3\x1e\xd7\xb4\x8d\xb5\xc3\x6d\x5c\x7b\x87\x62\xeb\xad\xfd\xbb\x1e\x71\x3e\xb8\x25\xc8\xbd\x8e\x34\x83\x06\x4a\xe2\x48\x96\xab\x54\x33\xab\x64\x2f\xec\x87\x55\x2f\xb8\x1d\xc7\x94\xbf\x83\x32\x10\xee\xc0\x37\xd3\x68\x14\xfb\x22\x10\x1a\x38\xbd\x12\xb5\xd0\x91\x10\x89\xac\xf4\x0e\x77\xd6\xaa\xc7\x3b\x5e\xf4\x55\xf1\x19\x7d\x03\x5a\x97\x48\xeb\x0c\xe5\xc6\x8e\xc3\xb9\xbd\x09\x31\xba\x79\x13\x4e\x84\xfd\x17\x1f\x6b\xe5\x39\xde\xc5\xcd\xb3\xab\x42\x02\x2a\xc3\xd5\x99\x13\x8c\xd6\x98\xd4\x28\x81\x70\x26\x0d\xf0\xe7\x9e\xda\xd3\xd3\x2f\xa3\xa6\xf9\x78\xe6\x7d\x3d\x3a\x72\x2f\xd8\xaf\x0b\x2d\xe9\xc3\x45\x1a\xf2\x0b\x96\x7d\x68\x08\x49\x68\xb4\xbd\x2d\xe9\xc3\x24\xfa\x6d\xcd\xbe\x51\xb2\x76\xdf\x0e\x69\x15\x9d\xfd\xd2\x3b\x96\x65\x51\xc8\x74\xfe\xf1\x93\x74\xb9\xa4\x49\xd8\x02\xab\x89\x93\x3f\x22\x48\xed\xef\xfa\x5f\xdc\x22\x23\xec\x74\xc9\x31\xbd\xf8\x4a\x03\x75\x78\x86\xfa\xe0\x3b\x17\xac\xef\x63\xdd\x36\xef\x85\x6e\xde\xb4\x64\x23\x65\x80\x93\x2b\x57\x3e\x73\x57\x94\x2c\x8e\x85\xba\x40\xaf\x5a\xd1\xfb\x84\x85\x6b\x0a\xb4\xb5\x86\x72\xe3\x24\xab\xd1\xff\xdb\x9d\xd2\x4c\xd4\xb7\x02\x17\x15\x79\xb7\x2c\x93\x56\x6d\x76\x6d\x61\xc3\x7b\x76\x2f\x1c\xae\x39\xc4\x8e\x63\x69\x80\xbb\x79\xf4\x70\xca\x62\xb6\xa0\x08\xff\xff\x5c\x0b\xef\x72\x6f\x52\x81\xb2\x7b\x87\xaf\x64\xd0\xb1\x04\x0e\x56\x71\x2a\x52\xf7\x8a\x30\x9e\x28\x09\xa3\xbb\x28\x2c\x68\x5c\x0e\xa6\x05\x7e\xa8\x57\x34\x2e\x89\xd7\xb1\x38\x5e\x94\x9d\x0c\x5c\x5c\x12\x02\x4e\x23\xf0\xe3\x2e\xf9\x05\xed\x3e\x65\x31\x68\x19\x7f\x84\x1b\x45\x46\x23\xac\xb3\x55\xce\x59\x02\x56\xd9\xd2\x9d\x42\xe8\x40\x90\x6c\x40\x14\x8f\x14\x57\x1c\xb5\x9e\x5d\x72\xa9\xec\xfd\xa5\xd6\xf0\x58\x13\xc5\xb9\xba\x6a\x7f\x88\xf2\x2c\x25\xb2\xce\x2a\x9e\x61\x52\x7f\x27\xa1\xc6\xb7\x3e\xbe\xee\x56\xb3\x00\x97\x2f\xde\xc4\xe5\x58\x81\x69\xd9\xef\x20\xfb\x3e\x68\x21\xa5\x5d\x99\x20\x35\x53\xd4\xb7\x27\x4b\xed\x4c\x6e\x25\xc6\xf5\xe8\xa8\x46\x4a\xff\xc1\x8c\x21\xc4\xce\xba\xfd\xeb\x5a\x27\xae\x54\x5c\xb2\x99\xa7\x97\x97\x0a\x0e\x19\x10\x44\xf3\x00\xeb\x38\x06\xf3\x34\x13\xb1\x05\x11\x8d\x8d\x75\xfe\x85\x78\x52\x34\xfa\x63\x1f\x8e\xc3\x79\xb5\xe2\xb2\xf3\x64\xae\x47\x47\xf5\x35\x02\x92\x9b\x26\x69\xdd\x0e\xc4\x43\x91\x9b\x20\xe0\x34\x44\x39\xfb\xe7\xc6\x91\xba\xca\x93\x51\x85\xb7\xe2\x0e\x79\xf3\x93\xb6\xb7\xb3\x50\xb8\x3a\xca\xdb\x40\x2f\x84\xf6\x85\xed\x5c\xa9\x32\xe3\xbd\x73\xe6\xf8\x6e\x31\x67\x4c\xde\x79\xee\x80\x7c\x95\xe6\x3e\xac\xf5\x79\x1f\xa0\x04\x20\xad\xc9\x70\xdd\x80\x74\x63\x08\x80\x70\x06\x15\xfe\xb3\x42\xc0\x7f\x4f\x93\x30\x66\xd9\x26\x6b\x0c\x33\x78\xc5\x32\x02\x13\xa4\x27\x0c\xa3\x65\x53\xfd\xb6\x10\xa9\x19\xc0\x65\xe1\xad\xcd\xe4\x5c\xca\x49\xe8\x19\xc7\x5c\xd7\xee\x04\x4a\x91\x4f\x2c\x5b\x46\x89\x10\x41\x04\xe7\x8d\xa2\x2e\xca\x70\x68\x38\x36\xf5\x13\x75\x65\x12\x51\x42\xa6\xfa\xaf\xd3\x08\x98\xfe\x46\x94\x7a\x9e\xfe\x48\x44\x4c\x10\x0b\xad\x79\x40\x5d\x83\x47\x25\x49\x6f\x61\x34\xd0\x39\xe4\xb1\x27\x3c\xb5\x81\xf5\xad\xe1\xc8\x14\x86\x53\x3e\xa3\x13\x39\xb4\xc1\xb3\x06\xa1\x65\x17\x34\x0f\xf4\x7c\xf6\x9e\xe1\xdf\xa6\x4b\xa0\xba\xf4\x3b\x10\xff\x40\xe4\x90\xa7\xa6\x93\x26\x78\x78\x6e\x85\x32\x18\x60\xb4\x4a\x95\x35\xd4\x73\x18\x76\xa7\x08\xb8\x4a\x7a\x29\xec\x3f\x1e\x39\xbf\xed\x2b\x98\x26\xef\x1b\xf7\x9e\x7a\xb3\x06\xf4\xf2\x5b\x28\xfb\x00\xda\x08\x1c\x1b\x65\xdf\xf8\x5e\x1c\xd4\x19\xa8\x7b\x91\xdf\xb8\x40\xb4\xf4\xc3\xac\xfb\x53\xaa\x79\xf5\xc1\x44\x1b\xac\x1d\xc7\x64\xbf\xaf\x92\xca\xc7\xab\x55\x1c\x19\x7d\xf3\xd8\x78\xa3\x12\xc1\x60\x62\xa3\xe0\x8f\xb6\xa1\x99\x93\xe7\x45\x82\x7b\xef\xc5\x98\x54\xc0\x80\xec\xfb\xa0\xd8\xc0\xbc\x62\xf8\x61\x29\x48\xbd\xb0\xff\x5d\xcf\xbd\x83\x75\x56\x86\x75\x74\xdc\x08\x2d\x82\xe0\x13\xc0\xda\xc6\xf6\xc0\x58\x13\x78\xfb\x5e\xad\xe2\x47\xb5\xe6\xf5\x24\x45\x2b\xb0\x1d\xc7\x74\x47\xea\x2d\xaa\x82\x98\x0a\xf7\x37\x2d\xe2\xf3\x2d\xc3\x52\x72\x86\x4e\x59\x91\x8c\xc9\x34\x54\x8f\x67\x53\x8b\x84\x70\x81\x4a\x13\x22\xb3\x55\x04\x62\xf8\x9c\xdc\xd2\x2c\x04\x97\x6f\x41\x79\x7c\xd3\xab\x75\xc9\x6f\xeb\xef\x71\x10\x21\xee\x7a\xba\x9c\x7a\xbd\x6b\x91\x57\xc0\x23\x36\x2b\x12\x73\x69\x13\xfe\x1f\x18\xe8\xa3\xa7\x53\x0e\x3d\xd5\xeb\x71\x77\xd6\xbd\x84\xbb\x52\xc4\x89\x6e\xaf\x68\x81\xa5\x32\x84\x6f\x2e\xcc\xda\x0d\xa7\xb2\xc6\x7e\x6e\x20\x5e\x6a\xc8\x83\x57\x4f\x09\x4f\xdf\x5e\x84\xa9\xbf\x64\x76\xa5\x91\xe9\x59\x25\x14\x42\x6a\x77\x8a\x45\x4a\x94\xbd\x56\x7b\x51\xb0\x0c\x0d\xe7\xd8\x06\xaf\x07\x51\x6d\xf8\xb0\xd4\x36\xd0\xcd\x74\xc6\x79\x63\x65\x67\x58\x42\x17\x6f\x5a\x57\x53\xb1\x65\x71\xa8\xea\x0f\x30\xcf\x76\x07\x5b\x19\x08\x53\x4b\x50\xd8\x45\x56\xfe\x62\x77\x6d\x12\x23\x96\xa2\x73\x9b\xde\x03\x72\xe5\xa8\x44\x83\xea\xb9\x13\x3a\x01\x74\x2e\x57\xbe\xe2\xbc\x49\x66\xd9\x23\xa8\xe1\x6d\xf7\xb1\x06\x18\x67\x1f\x2f\x26\x6b\x3d\x4d\xc8\x29\xfc\xb4\xe4\x3f\xb1\xc7\xb3\xd3\x16\xe9\xdc\x00\x61\xdd\xa7\x7f\x39\x7e\x97\x97\x95\x26\x9a\x2e\xa2\x05\xbd\x79\xcc\x7b\xbe\x11\x7b\x7a\x29\xd6\xfe\x3b\x79\xb5\xdf\x30\xe7\x4f\xb7\x59\x5a\x2c\x6e\x57\x45\xde\x36\xf3\x26\x20\x4f\x52\x51\x68\xb1\x12\xf9\x0c\x22\x4e\xde\xb1\x84\x65\x34\x26\x17\x45\xb6\x02\x4f\x98\xc9\xe4\x54\x1c\x0a\x8b\xd5\x4b\x7f\x0b\x7c\xa5\xc0\x7c\xe5\xd2\xd2\xa3\xea\x30\xdf\x46\x0b\x08\x86\x55\x4b\xb7\xc5\xde\xf4\x7a\x14\xa5\x07\x08\x56\x14\xdf\x01\xf3\x13\x0b\x09\x30\xa7\x1e\x39\x4a\x0f\x1b\x9a\x48\x4f\x16\x18\x84\x65\x24\x2c\x32\x8c\x2d\x13\xa7\x82\x68\x03\xd1\xbd\xef\xa2\xd7\x02\x14\x9f\xa9\xd1\x4e\xd2\x38\x24\xef\x4f\xe5\xda\x78\xae\x3e\x1b\x12\x11\xed\x52\x0b\xcd\xfa\xed\xef\xb6\x03\x63\xb1\xaa\xa4\x47\xf0\xe1\xbd\xdc\xe9\x65\x97\x4e\x6b\x92\xc2\x1e\x29\x4a\x0f\x6a\x23\xb9\xa9\x53\xee\x75\xd8\xa9\x57\x77\x82\xd9\xd0\xf9\xac\x3e\x27\x43\xc3\x52\xcb\xbc\xde\xb2\x23\x59\x11\x1d\x40\xc2\xc5\xea\x65\x97\x43\x6d\xb1\xaa\xa5\x4f\xa8\xf6\x84\xc7\xb6\xf4\xa0\xfe\xa9\xd6\x91\xcf\x6a\xad\x78\x7e\xe0\x39\x02\x77\x2a\xf2\xa1\x31\x37\x57\xb5\x06\x9d\xc9\x90\x62\x7d\x54\x0a\x80\x70\x0a\x6a\x8c\xd8\xb4\x7e\xac\xdf\x96\xab\xae\x59\x8e\x5f\x3e\x54\xa6\x53\x0d\x92\xb1\x7e\x52\x6f\xe8\x8e\x27\x79\xf7\x91\x60\x7d\x05\x33\x4a\xdd\x4f\xc7\xfa\x52\x7f\x86\xb0\x7e\x14\xd7\x73\xeb\x6f\x70\x7e\xb3\xfe\x84\xbc\x3d\x7e\xb3\xb2\xf5\x4b\xf9\xb1\x67\xd4\xf4\xd4\xd8\x12\x63\xef\xf3\xf8\x77\x1f\x11\xb5\xaf\x55\xac\x57\x55\x09\xff\x11\x5f\xfb\x05\xb6\x69\xfd\xab\xd9\x64\xa3\xb6\xc7\x68\xeb\x77\xaf\xc7\xc2\x78\xc7\x61\x16\x29\x67\x0d\x73\x3a\xf1\x38\xdd\xb0\xad\x8f\x61\x29\xbe\xa8\x12\x5d\xe5\x0f\x29\xb2\x7e\xd1\xaf\xf4\x23\xc7\x6d\xd5\xfa\xe4\xba\x54\x8c\xdc\x41\xaa\xd6\x57\x2b\xe2\xa0\x83\x41\xde\xb1\xbd\x1c\xbe\x89\x95\x8c\x26\xd6\x0f\xa5\x08\x61\xeb\xbb\xd7\x8f\xd8\x31\xe0\xa7\x8a\xaf\x9d\x98\xec\xa8\x6e\xe1\xf0\xa9\xed\x7e\x4f\x35\xff\x13\x55\x2d\xe3\xdc\x3a\x49\x05\x33\x26\x72\xf1\x8b\xcc\x98\x09\xd8\x83\x03\x34\xe2\x18\xd3\x84\x4c\xd0\x2a\x0e\x74\x78\x78\x83\x53\x14\x0c\x5e\xe0\xbf\x84\x25\x6f\x31\x83\x47\x96\xde\x0b\x9f\xb4\x2c\xb3\x30\xdf\xa6\x28\x3c\xd9\x04\x76\xac\xc3\x61\x74\xce\xf2\x2c\x9a\xf1\x93\x34\x06\xc6\x28\x3f\xf0\x79\xb2\xfa\x2d\x32\x9a\x14\x31\x85\x97\xb2\x3a\xaa\x7d\xc9\x88\xed\x4e\xcd\x1a\xaa\xfe\x49\x9f\x5f\x20\x29\xe5\x34\x3b\x1a\xc2\x7c\x10\x4b\x30\xad\x76\xd2\xe4\xb5\x66\x72\x4b\x7b\x65\x8e\x19\xd7\x30\xb4\x0e\x33\x16\x98\xe6\x0e\x2e\xee\xca\x7e\x29\x2f\x8a\x63\xc2\xe1\xb1\x48\xa4\x07\x9c\xeb\xf4\x16\x5b\x4b\x31\x62\xc8\x19\x50\x1e\xe0\x9a\x66\x9a\x59\x2a\x91\x5b\x6d\x2c\xdd\xb6\x8c\xce\xd1\x5c\xdb\x9a\x3a\x24\x9e\xab\x63\xce\xbc\xbe\x54\x76\x89\xcc\xc3\x85\x92\xc9\x70\x9d\x97\xe9\x41\x91\x3d\xb6\x54\x24\x1f\xe7\x77\x79\x22\xe5\x2b\xa8\x68\x88\x81\x52\x92\x0e\x01\xc4\xc9\xb2\x4c\x54\xa0\x8b\x66\x94\x13\x3a\xcb\x52\xce\xf1\xb1\x41\xa8\xd2\xab\x14\x12\xda\xe4\x51\x00\xe1\x25\x89\x52\xa5\x57\x59\x9a\xab\x5a\x1a\x4b\xa9\x73\x53\x72\x91\x86\xa7\x11\xc7\x23\xe4\x75\x11\x2e\x58\x2e\x52\xc3\x0b\x0b\xd0\xa1\x19\x44\x85\x8c\xa9\x0f\xca\x69\xa8\x3c\xfb\x16\x4e\xf8\xde\x56\x23\x2f\x09\xea\xab\x75\x3b\xd0\x05\x30\x4a\x02\x41\xc8\x46\xd9\xb6\xed\xba\xde\x44\x53\xe3\x51\xe5\xc1\x41\x2f\x9c\xb6\x43\x5b\x53\xc2\x39\x66\x53\x67\xed\xad\xc8\xb9\x96\x44\xb8\x95\x75\xd1\x30\xb4\x34\xe3\x0d\x93\xec\x3a\x61\x97\x84\x80\x36\xc0\xb5\x1f\x91\x43\xe2\xdb\x21\xf1\xed\x90\xf8\x76\x48\x7c\x3b\x24\xbe\x1d\x12\xdf\x0e\x89\x6f\x87\xc4\xb7\x43\xe2\xdb\x21\xf1\xed\x90\xf8\xf6\xdf\x3c\xf1\x6d\x93\x29\xad\xbf\x02\x5f\x87\xd6\x71\xf7\xec\x38\x1a\x0d\x79\x79\x87\xbc\xbc\x43\x5e\xde\x21\x2f\xef\x9a\x79\x79\x39\x4f\x67\x11\xcd\xd9\x45\x71\x13\x47\xb3\xb3\x8b\x63\x19\xff\x56\x95\x20\x7d\xcc\x99\xea\x69\x8f\x43\x5e\x4a\x0c\xb2\x53\xd1\x06\x76\x75\x4a\x42\xc9\x4a\x8c\x4a\xce\x2e\x54\xdc\xdd\x18\xfd\x18\x52\xe8\x77\x1f\x89\xc4\x01\x90\x56\x07\xb4\x02\xa6\x72\xc2\xa2\xd8\x8f\x32\xf4\xb4\xc6\x1d\x7f\x51\x05\xc6\xb8\x37\x14\x4c\x0e\x1c\x44\xab\x40\xb7\x0d\xd2\xb9\xc0\x7c\xcf\x6d\xf2\x8d\x56\xdb\x1a\x5f\xd6\xb4\x42\x08\xdb\xab\x23\xab\x81\x4b\x86\xec\xcd\x43\xf6\xe6\xaf\x90\xbd\x19\x3d\x41\xe0\x61\x59\x14\x3f\xa8\xae\xbe\xc2\x4f\x4d\x0b\xfc\xc2\x74\x79\x65\x91\xa9\x45\x7a\xcb\x4a\x4a\x64\x6c\x11\x41\x28\xb8\x30\xde\xc1\xf3\x54\x0e\xbe\x98\xd3\xc9\xc5\xc7\x4f\x52\xa7\xf8\xf8\xe1\x7f\x4e\xdf\x9c\x1f\x7f\x38\x9d\x12\x3a\xcf\x71\x4b\xc7\xd1\x9c\xcd\x1e\x67\xb1\xaa\x88\x1b\x65\x5a\xdd\xdd\x15\x1e\x9c\xba\x62\x1b\x06\x22\xe1\x73\x85\xb1\x02\x91\x30\x25\x50\xb2\x7d\xc1\x72\x33\x31\x14\x5e\xca\x0b\x46\x44\xea\xca\x5f\x7e\x7d\xee\x89\x3c\x9a\x61\xdb\x00\xda\x06\xa2\x6d\x3f\x76\xee\x8f\x1c\x79\x1e\x03\x86\x6a\x87\xb4\x46\x96\xfa\xe5\x2b\xa1\xac\xb6\x1b\x3b\xa0\xe9\x7a\x74\xe4\x40\xb4\x10\x7c\x3e\x4b\x03\xfb\xa2\xf4\x09\xd0\x2c\xe0\x91\x52\xc1\x05\x36\xf5\x30\x72\x0c\x52\x7f\xf6\x73\x4a\xc3\xd7\x52\x57\xcd\xc0\x0d\xe7\xdb\x89\xcd\x63\x75\xcc\x93\x38\xa5\x21\x41\x7d\x2b\x53\x08\x2f\x40\x36\xd9\x8a\x5d\x2f\x6e\xea\x0d\x7c\xc7\xb1\x9c\x11\xa6\x67\x80\x54\xb4\x15\x2c\x55\xd0\xd1\xb4\xce\x2b\x69\x7b\x51\x67\xda\xaf\xcf\x3d\x87\x23\xda\x6b\x71\xcc\x00\x52\xb1\x62\x97\x17\x10\x9f\x2c\xdd\x26\x21\x17\x6b\x9c\xa6\x5f\xca\x9e\x5d\xed\xf8\x68\x3d\x9a\xfd\xa3\x03\x7f\x96\x56\x00\xac\xe9\x9e\x91\x1b\x89\xca\xe6\x73\x09\xe5\x1e\x5b\x1d\xad\x9b\x50\x29\x2e\xac\x98\x9f\x24\x93\xd0\xc8\xf3\x93\xcb\xb3\x17\x76\x9a\x25\x3d\x1e\x57\x97\x84\xa4\xec\xed\xd6\x8e\xad\x4d\xc6\x69\xc6\x41\xd8\xed\xf0\xd4\x76\xb2\xb0\xe6\x97\x54\xc7\x8a\x7c\x69\x34\xaf\x22\x66\x66\x61\xf9\xed\x51\x25\x74\x50\xf5\x6e\xe1\x52\xc4\x12\x32\xad\x52\x48\x3c\xb1\x9b\xaf\x61\xbf\x07\x89\x4d\xa7\x23\xc5\x7a\x75\x4e\x4a\x90\x47\xbc\xda\x20\xc4\x9f\x86\xea\x0b\x43\xf5\x85\xa1\xfa\xc2\x50\x7d\x61\xa8\xbe\x30\x54\x5f\x18\xaa\x2f\x0c\xd5\x17\x86\xea\x0b\x43\xf5\x85\xa1\xfa\xc2\x50\x7d\x61\xa8\xbe\x30\x54\x5f\x18\xaa\x2f\x0c\xd5\x17\x86\xea\x0b\x43\xf5\x85\xa6\xea\x0b\x97\x6c\x9e\xb1\xae\xe9\xce\xce\x2a\x9d\x9a\xf8\x0c\xe2\x19\x44\x8e\x56\x23\x65\x04\x5f\xd0\x44\x93\x09\x4e\x5a\x18\x5c\x11\xdb\xe1\xd6\x20\x6e\xf4\xca\x49\x09\x9b\x69\xe5\x11\xce\x39\xe9\x24\x80\xde\xdf\xe8\x17\x8f\x1f\xcd\x73\xc6\x74\x5c\x4a\x32\x8b\x6f\x1b\xe8\x31\xa0\x5a\xdf\x3c\x56\xfc\x24\x50\x26\x8b\x2c\x25\xd0\xce\x9a\x86\xe5\xc3\xd5\xac\xa1\x17\xd8\x39\xc8\x6f\x99\xf0\x87\x4e\xe7\x01\x35\x2d\xfa\xc9\xf2\x6f\x81\x52\xdb\x7f\x5e\x61\x4a\xb7\xc6\x6d\xb3\x01\x76\xbb\x5d\x11\x5a\xb0\x78\x3d\x3a\x6a\x21\x92\xff\xc0\xa8\xc5\xec\xf6\xda\x08\x8e\x48\xdf\xfa\x4e\x30\xc9\xcc\xdb\xab\x85\xf4\x61\x87\x3e\x70\x1b\xd7\xbe\x69\x15\x92\x52\x42\xc8\xbe\x22\xd2\x09\xc3\x39\x1c\x5e\x33\x4f\x04\x45\x4f\xb3\xe8\x8e\x65\x2d\xb3\x6e\xa2\xca\x4c\x80\x21\xa1\x80\x43\xec\xa0\x49\x1c\xc7\x9c\x19\x4b\x9a\x43\x1d\x8d\x5b\x46\xd2\x84\x95\x9a\x6a\x73\xbc\x7a\x30\xd9\x25\x9f\x41\x62\x15\x89\xb8\x5f\x58\xd0\xa0\x9b\x3c\x47\x71\x44\x75\x8f\x36\xc0\xb4\xd2\x85\xf9\xf6\xf5\x1e\x3e\x3f\xf3\x5b\xa4\x85\x56\x07\x06\x69\x91\x47\x45\x2e\x6a\xce\x7b\xfb\xd7\xff\x1b\x60\xa3\x64\xa7\x95\x68\x69\xf0\x7d\x57\x98\x2a\x35\xe9\x66\x16\x95\xb0\x4b\x4d\x89\xc2\xe0\x9c\xb7\x1b\x45\x11\x07\x6f\x1e\xf2\x8c\xd6\xc2\x5c\x1b\x85\x0e\x18\xc9\x4f\x31\x42\xa9\x91\xb9\xf1\xf5\x35\xfa\x8d\x91\x29\x0e\x37\x45\x1c\xeb\xf3\x6a\x86\x4d\x94\x5c\xc5\x76\x3d\xef\x17\x35\x01\xee\x03\xab\x1f\x54\x61\x52\x92\x56\xf8\x13\x22\x1f\xe7\xe7\x17\xd5\xd8\xfc\x2d\xa3\xe0\x51\xfc\x0e\xee\xd4\x55\xc4\x79\xc2\x21\xed\x36\x2d\xca\x7f\x63\x82\xe6\xd2\x7c\xfa\xe5\xf7\x54\xd6\x9c\x34\x23\x68\xac\xe5\x64\x2e\x57\x42\x16\xb0\x14\xc5\xdf\xb8\xca\xb1\xb9\x8c\x55\xe3\xdb\xa6\xd8\x4f\x60\x60\x0a\xfd\xa6\x75\x96\x9a\xae\x65\x6b\xda\xc2\xec\x24\x69\xed\x29\x2a\xfa\xea\x68\xbc\xfa\x6c\xb1\x89\xcf\xc6\xdb\x50\x57\xe9\xfb\x2f\xfd\xa4\xf3\xa2\x74\xda\xe4\x43\x71\xa3\xa1\xb8\xd1\x77\x5b\xdc\x08\x98\x07\xae\xac\x13\x61\x31\x68\x81\xd0\xc4\xbf\xf7\xf0\x6c\x60\xe8\x08\x2c\x08\xd1\x42\x21\x7a\xac\xa9\x2b\xca\x63\xd9\x07\xce\x2e\x1e\x33\x56\xa2\x88\xac\x22\x78\x4d\x82\x9f\x00\x04\x84\xb9\xb0\x78\x2e\x9f\x82\x85\x1a\x86\xfc\x0c\x44\x12\x91\x35\xbc\xf9\xc6\x06\x33\x0a\x44\x3b\xbf\x1f\x00\xe6\xad\x3a\xfd\x30\x01\x6c\xc0\x13\xbe\xe8\xa0\x56\xa3\xbd\xee\xb0\x9d\x78\x37\x81\x16\x75\xe7\x3b\x15\x4c\x11\xad\x82\x83\xbf\x1d\x06\x07\x7f\x7d\x15\x1c\x04\x07\xbb\x05\x0f\xee\x19\xcf\x83\x43\x70\x8e\x58\x15\x39\xdb\x05\x7a\x66\x09\x8d\xa7\x63\x98\x80\xb2\x88\x34\x0f\x7f\x76\xda\x30\x60\xb0\x7f\x70\xf8\xf2\x87\xbf\xfc\xf5\xbf\x5f\xfd\x8d\xde\xcc\x42\x36\xdf\x6f\x1a\xb5\x9f\x36\xf9\xf5\xc9\xdb\xed\x1e\x69\x68\x7b\x3d\x3a\x32\x0c\x01\x7b\xbc\x5d\xa7\x2c\x13\xbd\xa4\x37\x6e\x4a\x7e\xcc\xaf\xdf\x95\x07\x9c\x0a\xad\xcd\x12\x5d\x26\x77\x76\xda\x36\x9d\x5e\x1c\xd2\x47\x83\x2e\x63\xb2\xd4\x83\x90\x12\x6f\xb7\x2b\xd3\xde\xec\x65\x43\xbd\xb5\xa1\xde\xda\x50\x6f\x6d\xa8\xb7\x36\xd4\x5b\x1b\xea\xad\x0d\xf5\xd6\x86\x7a\x6b\xe5\x7a\x6b\x9c\xcd\x52\x70\x6e\x7c\x44\x92\x9c\x69\x1e\xef\x78\x6e\xb8\x4f\xdb\x89\x0f\xac\x99\x45\x69\x1e\xbd\x0e\x16\x9a\xe7\x74\x76\xcb\x4a\x5e\xe6\x8e\x3d\xaa\x76\x90\x38\x40\x69\x8e\xcf\xa0\xa8\xda\x01\x75\xa1\x04\x48\x84\x07\x04\x28\xea\x09\x03\xcd\xbc\x0e\x0a\xa4\x18\x38\x2a\xae\x68\x06\x24\x28\x45\x78\x9e\x17\x71\x1e\x05\xb7\xe9\x12\x33\x65\x72\x2f\xeb\x2d\x4d\xcb\x75\x82\x3a\xbf\xa3\x45\xb7\x32\x76\x6d\xa9\xd7\xa3\xa3\x1a\xa2\xfc\x42\xa2\x92\xc2\xb8\x93\x7a\xa7\xdf\x51\x1a\x2b\xe3\x0d\x85\xe4\x86\x42\x72\x43\x21\xb9\xa1\x90\xdc\x13\x15\x92\xcb\x69\x96\x63\xe5\xab\xcd\x8e\xcf\xed\x57\xd1\xa2\xe5\x9a\x62\xe8\x34\x51\xb3\x3f\x8d\x09\x15\x51\x13\x42\xc9\x9f\xc2\x23\x66\xce\xa7\xb2\xb8\x33\x48\x2f\xf6\xb0\x62\x33\x2c\xeb\x73\x03\x3e\x16\xcb\xf4\x0e\x53\xdf\xc0\xab\x55\x0e\x9e\x24\x62\x2f\xcc\x98\x35\x0c\xf4\x84\x04\xac\x8f\x78\x0c\x89\xe6\xef\x2e\x7e\x51\x6f\x9e\xb8\xc9\x58\xa6\x44\x88\xc4\x23\x91\xc3\xff\xfa\xbc\xc9\x92\xc5\x65\xdb\x40\xb6\xed\x79\xa4\xae\x81\x13\x4c\x67\x28\x46\xc3\x3d\xf5\x95\xd1\xd3\xcd\xc2\x57\xc6\x0b\xec\xda\x12\x52\x1b\x76\x2a\xd6\x51\xe8\xc6\xbe\xdb\xb7\x1a\xb4\x55\x30\xec\x43\xe0\x36\x58\x3b\x8e\xc9\x0e\xd5\x10\x87\x6a\x88\x4d\xd5\x10\xdd\x02\x5b\xb6\xfd\x0c\x96\x27\x96\x35\x52\xb4\xb5\x02\x61\x1f\x14\xb7\x02\xf3\x2c\x0c\x9c\xb3\x95\x0b\xed\xb7\xdb\xea\x26\x4a\x5f\xba\x8b\xa3\xe9\x73\xbb\x09\x00\x3a\x81\xde\x71\x2c\x65\xa8\xfa\x38\x54\x7d\x1c\xaa\x3e\x0e\x55\x1f\x87\xaa\x8f\x43\xd5\xc7\xa1\xea\xe3\x50\xf5\x71\xa8\xfa\x38\x54\x7d\x1c\xaa\x3e\xaa\xaa\x8f\xa6\xe1\xe8\x9e\x66\xcb\x8b\x34\x8d\xbb\x1d\x7f\x9f\x55\xeb\x26\x29\xf1\x85\xb1\x15\x87\x87\x5a\xf5\x16\x26\x10\x66\x54\x04\x61\x2e\x81\x83\xf0\x7f\xd3\x28\x29\x5f\x79\xa4\x53\x55\x94\x0b\x0d\x1f\xb4\x89\x42\x3d\xd5\xc0\xc8\x64\x95\xa6\xb1\x27\x2d\x23\xac\x23\x10\xbf\xf7\xbb\xe7\x3e\xc5\x64\x9b\xf3\x3a\x9a\x99\x5e\x8f\x8e\xcc\xb2\x2a\x46\x9d\x9d\x0a\xa9\x86\xc2\x9c\x43\x61\xce\xa1\x30\xe7\x50\x98\xf3\x5b\x14\xe6\x2c\x47\xc6\x59\x0d\x9c\x99\xec\xad\xdf\xbd\x79\x2b\x1b\xec\x59\x8d\xf5\x3e\xcb\x8f\x34\xbe\x9b\x9c\xf5\x1d\x9d\xc6\xca\x29\x71\x46\xf5\xd0\x8d\x52\x1f\x15\x00\xa6\x92\x1e\xb6\x44\xef\xb5\x04\xf7\x58\x3f\xeb\xd0\xb2\x70\xe4\xf7\x47\xb7\xdb\xd7\x12\xd8\x76\x73\xfe\xb0\x5a\x79\x13\x72\xbb\x74\x00\x07\xed\x55\x90\x34\xfe\x62\x2a\x94\xad\x5f\xb3\x4d\x5d\x60\x85\x50\x24\x26\x13\xba\x2a\xdd\xc0\x8c\xad\xbf\x5c\x68\x42\xcf\xaf\xed\x50\xdf\x74\x9c\x1d\xeb\xe8\x1d\xe9\x6b\xb5\x9d\x76\xb8\x4b\x49\x47\xb9\xc5\x8e\xc3\x65\x94\x98\xda\x29\x9e\xcb\x57\xe3\x9d\x5b\x25\x3f\xee\xa6\xa3\xf5\x08\xb0\x43\x7e\x84\x07\xef\x47\x72\x65\x8b\x0a\x9d\x70\xd9\x64\x0d\x5a\x44\xf9\x6d\x71\x03\x2e\xd3\x7b\x76\xcb\x20\xe5\xa5\xbf\xf7\x9e\x59\x83\x04\xe9\x3c\x50\x90\xfa\xe9\x65\xa5\xa9\xd5\x93\x07\x6d\x3a\x19\x48\xcb\xe7\x5a\xee\x26\x5a\x98\x93\xde\x66\xcd\x23\x35\xc6\x36\xf7\x12\x28\xa4\x65\x3e\xaf\x25\xc8\x86\xbc\x84\xa1\xd3\xda\xd4\x6d\x1b\xad\x35\x84\x7b\x07\x95\x93\xf1\x7a\x37\x0e\x98\x00\xd2\xe4\xdb\x3d\x6d\xc0\x3b\x50\x12\x1a\x4b\x68\x2d\x91\x58\xd9\x81\x14\x2b\x10\x46\x4b\x96\x16\xf9\xdf\x0f\xa7\xbb\xe4\x27\x8c\xfa\x10\xe9\x1c\x65\x3e\x31\x28\x2b\x03\xf0\x84\x23\xa8\x8e\x13\x99\x9e\xca\x4b\xe3\x54\x44\x57\xc8\x22\x11\xbd\xb6\xc9\x3a\x53\xc5\x57\x70\x35\x5f\xbc\xe9\xf6\x98\xb5\x04\x80\x53\x57\x17\x65\x6b\x01\x3b\x0e\x02\x8c\x64\x72\xb4\x53\x99\x1b\xed\xbb\x21\x6d\x25\x6d\x5c\x19\x5b\xe5\x55\xe3\xfd\x9e\x4c\x4f\xa4\x4e\xf1\x36\xca\x78\x89\x70\x2a\xa9\xf8\xd2\x0a\x9e\x41\xfd\x43\x0d\xb0\x11\x6d\xd7\x98\xab\xa4\x94\x3d\xe1\x3a\xb9\xba\x4c\x7b\x4d\x89\x58\xa6\xb9\x59\xfb\x08\xb9\x73\xdb\x92\x50\x73\xbf\x12\xb5\xe6\xa8\xa7\xa1\xc6\x24\xd8\xba\x6c\xe4\x09\xeb\x16\x6a\x6e\x7a\x92\xdd\x65\xe3\x16\x06\xf5\x48\x4b\x49\x44\xde\x45\x64\x76\x37\xcb\x37\xed\x8e\x8f\x20\xaf\xa2\xe4\x96\x65\x90\x18\x15\x5c\x5f\xb4\x4e\x84\xab\x82\x84\xa2\xb0\x68\x0e\x81\x60\x72\x50\x11\x32\xd0\x8b\xb1\x37\x18\x46\x8f\xf2\xfb\xb8\xba\x78\xeb\x7a\xfa\x1f\x8b\x82\xc1\xbc\x3f\x98\xf7\x07\xf3\xfe\x7f\xba\x79\x7f\xa7\x22\x1f\x1a\xcf\x68\x4b\x72\xd4\xe4\x49\xab\x1d\x70\xcb\xe7\x37\xaa\x1d\xc1\x3d\x84\x98\x22\xc2\x2b\x25\x5d\xf4\x74\xba\x1f\xd0\x5d\xa0\xba\x4f\xe0\xb3\xe3\xf3\x2e\x87\xaf\x8c\xee\xb8\x10\xda\xfb\x93\xfb\x64\xed\x38\x1a\x69\x6b\xcd\x45\x96\xce\xa3\x98\xb5\xe7\x56\x6c\x84\x72\x99\x6e\x05\xc4\xa6\x69\x01\x61\x1a\x17\xe0\xac\xcf\x41\xac\xf3\xd7\x69\x21\x62\x9d\xd6\x01\x09\xe7\xc0\x31\x94\xe9\x17\x44\x8a\x58\x47\x53\x8a\xcd\x08\xe5\xee\x6b\x6e\xb6\x1a\xa7\x38\x96\x6d\xd1\xb0\x81\x36\x9e\x9f\xaa\x8f\x00\x6d\xb8\x6c\xc4\xd1\x16\x77\xb7\x48\x93\x7e\x7c\x6e\x5b\xe1\x44\x02\x42\x8d\xe1\x9e\xfb\xba\x1d\x9e\x77\x47\xfb\xf8\xc0\xbf\xbd\xe3\x9b\xb3\x64\xd1\xa5\x8a\xa1\xfe\x4d\x73\x03\x74\x5f\xad\xce\x1d\xb9\x29\xab\x7d\x4d\x8f\x3a\x0e\x55\x78\xea\xbc\x88\x63\x15\xd7\x90\xa7\xe0\x5c\x2b\x20\x97\xba\xb6\xa0\xaf\x05\x54\xd3\x0a\x2e\x32\x76\x17\xb1\xfb\xa7\x5b\x08\x51\x23\x6c\x6f\x41\x1a\xa4\x7b\x61\x45\x9e\x42\x5a\x49\x96\x6d\x63\x51\xc0\x8f\x78\xa5\x06\xdd\x56\x1d\x3b\xea\xf1\x97\x65\x6b\xad\xab\x1d\xaa\x73\x69\x33\x96\xe5\xe7\xc2\x79\x7a\x2b\x6b\x83\x73\x54\x29\x63\x60\x94\x0f\x43\x92\xb1\x59\x9a\xc1\xc1\x9d\x92\xcb\xb4\xc8\x19\xf9\xcb\x4b\x88\x55\x4b\xc1\x30\x0a\x1f\xc5\xad\x58\x25\xdc\xdf\x3f\x20\xb3\x5b\x88\x83\x48\x16\x6c\x97\x9c\x43\x18\x57\x94\xcc\x55\x16\x4d\xa5\x91\xce\x41\x2c\x91\x2b\x70\xf5\x34\x76\x67\x58\x49\x20\x92\xdc\xb0\x6c\x37\x4a\x45\xe1\x9d\xbd\x92\x41\x72\x8f\xce\x96\x6c\x2f\x4c\xf8\xfe\xc1\x5e\x06\x53\xf9\xcb\xcb\xbd\x67\x9c\xe5\x41\xb1\x0a\x68\x10\xd1\x65\x90\xa5\x31\x7b\xb1\x16\xfa\xbf\xe6\xc2\xeb\x66\xee\x6d\xad\xfd\x7a\x74\x04\x48\xad\x58\xb7\x0d\x3e\x46\x33\xc8\x6a\xfa\x19\x72\x22\xb6\x71\x8b\x93\xdb\xd8\x4d\xab\x6c\xec\xca\x65\x09\xbb\x27\x50\xba\xe0\x64\x72\x46\x9e\xbf\x89\x29\xcf\xa3\x19\x79\x0d\xc5\x36\xc8\x44\xa4\xb4\xd2\xb6\x75\xf1\x37\xd4\x2b\xd2\x2f\x5f\x2f\x30\xea\x66\x6d\x4a\x6f\x65\x70\x37\x86\xe6\xeb\x9d\x1e\xec\x41\x66\xcb\x69\xa8\x63\xd7\x05\xc3\x34\x44\x65\x58\xc1\x83\x2a\x71\x50\xd0\x17\xb2\xc1\x91\x15\x9e\x86\x42\xc2\x1c\x8b\x72\x0c\x9a\xb5\x7b\xe1\x72\x83\x61\x9c\xab\x9f\xf3\x87\xb5\xb0\x16\x2d\xe9\x82\xbd\x2e\xa2\x38\xdc\x4c\xb4\x8b\xec\xf7\x32\x86\x50\x9c\x2f\x6f\x4e\x2e\x0d\x5f\x18\x5e\xb8\x14\x01\x78\xd9\xe3\x0b\x3c\x80\x76\xc9\x27\x08\x63\x84\x2c\xc4\x9c\xcd\x8b\x58\x00\x80\xe4\x0d\x50\x4c\x7b\x2c\xfe\x62\x0f\x74\xb9\x8a\xd9\x98\x50\x72\x72\x26\x4a\xf6\x30\xac\x5d\x0f\x31\xdd\x42\xaa\xae\x0a\x7e\x4b\xc4\x4a\xc4\x9f\x6f\x4e\x2e\xfb\xd1\xe2\x3b\x9b\xbb\x93\x50\x0f\x97\xf4\xb1\x8d\x40\x6b\xea\xda\x25\x1e\x70\x1f\xfa\xd6\x57\xc5\xb0\x15\x4f\x01\xfb\x18\xad\x6b\x44\x8e\x4f\x75\x15\x06\x2a\xbb\xd8\x7f\x02\x4f\xdb\xbf\xce\x4b\xbf\x5a\xca\xa6\xf5\x55\xa0\xc9\x2d\xae\x9f\x42\x49\x07\x0d\x59\xef\x56\x3d\xbb\x9e\x9a\x79\x19\x88\x47\x1d\x77\x7a\x98\x18\x7e\x50\x25\xa8\xc2\x0a\x69\xb1\x1b\x58\x2d\x1c\xd7\x14\x9f\x22\xaf\xdc\x29\x74\x81\xf8\x36\xce\x6b\x12\x0d\x2a\x7f\x89\x02\x4a\x32\x84\x2a\x82\xe5\x9b\x8a\xdc\x28\xd5\x0d\xfc\x16\xd9\xec\x70\xaf\xe0\x2c\x5b\x88\x3a\x81\x0a\x56\xa0\x60\xb1\x5d\x40\xb4\x4c\x67\x52\x8e\x43\xef\x25\x0a\x6a\x39\x4d\xb6\x3a\xbd\xeb\xd1\x91\x0b\x09\xa0\x6c\xb4\x4e\xbc\x5b\x9e\x13\xd5\x59\xd2\xfb\xab\x5b\x57\x20\xf1\x4f\x16\xf9\xd9\x45\x66\x5f\xf2\x2e\x2c\x4d\x48\xc8\xc0\x2f\x0e\x52\xe9\xcd\x98\x7b\x8c\x34\x39\x15\x6d\x5e\x53\xce\xba\x16\x9a\xf3\x0c\xb8\xdf\x38\xc0\x05\xcb\x66\x2c\xc9\xe9\x82\x1d\x43\xf5\xbd\x0d\xc6\x2b\xb1\xd8\x25\x4d\x16\x8c\x5c\xed\x07\x07\xfb\xfb\xbf\xf6\x62\xce\x86\x9e\x66\x4d\x07\xfb\xee\x55\xc1\xa6\x38\x8e\xc1\x39\x10\xf6\xe5\x24\x87\x74\x27\x8b\xb5\x4c\x44\x00\x49\x25\x4f\x05\x87\x68\xee\x03\xd2\x03\x1b\x07\xc1\xe1\x7a\xc8\x70\x74\x34\xb8\x38\x5c\xf7\x40\x2c\xed\x22\x03\xdc\xf0\xb7\x83\x5d\x4a\xfc\xd1\x93\x9d\x1a\xb1\xdb\x4e\x44\xab\x45\x5d\x72\xe3\x6f\xdb\x7a\x39\x2e\xdd\xa9\x84\xd4\xba\x2a\x8b\xad\x5f\x9f\xbb\xb3\x6d\x98\x5b\x65\x0f\x83\x74\x6d\xb0\x9a\xc7\x78\x65\x94\xeb\xd1\x51\x79\x3a\xe6\x26\x57\x3b\x53\x27\xef\x6c\xd6\x6d\x31\x5a\x9f\x9d\x3e\xad\x3c\x2d\xfd\xd4\x21\x29\x52\xb5\x40\x13\xba\x3e\x68\x4b\x7d\xaf\xcd\xb4\xd6\x00\x3b\x8e\x65\x09\xdb\xa8\xc8\x69\x5d\x45\x56\x1f\x8d\x41\x4e\x87\xd0\xca\x1c\x08\x48\xaf\x18\x94\xe6\x72\x9a\x12\xf2\x21\xcd\x09\x2f\x56\xab\x34\xcb\xf1\x8d\x0e\xa3\xe1\x4d\x1b\xbe\x06\x3e\x9e\x72\x02\x46\x48\xe5\x59\xe1\xae\x67\x09\xa8\x9c\x88\x60\xd6\x2d\xe0\x32\xaf\xd5\xf4\x52\x81\xb2\x74\x09\x59\x3f\x40\x19\x35\x73\x25\x18\xc1\x81\x36\xb4\x75\x70\xb7\xc5\x01\x7d\xb8\xda\xa9\xe0\xac\x51\xa6\x9b\x5d\xec\x46\x71\xe5\xab\xe4\xe1\xad\xc8\x4e\x4c\x89\xc2\x2b\xe8\x68\xcc\xe2\xd3\x86\xe4\x3e\x30\x3d\xc2\x6f\xf2\xbe\x93\xf0\x83\xbb\xf1\x26\xfc\x77\x36\x27\xa0\x76\xdc\xc3\x3d\x19\xc8\x27\x84\xc8\x64\xf2\xbe\x22\xdb\x57\xe0\x94\x00\x8e\x47\xd2\x14\x10\x8e\x49\x0a\x59\x2b\xef\x23\x59\xa8\x11\xee\xd9\x8b\x24\xcd\x20\x7f\x95\xf0\x08\x81\xca\x2c\xe9\x9c\x48\x5f\xed\x9f\xd8\xe3\x05\xcd\x6f\xc7\xe6\x4f\xe1\xb8\xa0\xff\x82\xb7\x1e\x65\x40\x54\xc3\xb2\xb0\x17\x57\x7f\xc7\xcb\xd0\xab\xf8\x7d\x5c\x75\xb1\x9d\xf0\xe5\x26\xb4\x7b\xe3\x36\xed\x5e\x01\xf9\x52\x48\xc4\x05\x4c\x06\xf4\x82\xec\x15\x93\xc9\xf9\xaf\xcf\xf7\x22\xe0\xcb\xb0\x98\x01\x36\x9e\x71\x7e\x1b\x48\x5b\x49\x3f\x93\xb2\x67\x5c\xeb\xec\xf7\x0c\x03\x09\x80\x3c\x73\xf3\x5b\x74\x57\x0a\xbf\x2d\xca\x70\x13\xa6\x24\x01\xc9\x17\xf6\x88\x49\x91\x2c\x87\x36\xe5\xc7\x06\x58\xfb\xc2\x1e\x67\xb7\x34\x4a\x76\x89\xcd\x50\x42\x7c\xc8\x6d\x7b\x47\xe3\x82\xd9\x7c\xd2\x0b\x71\x4f\x38\x8d\x66\xd4\x75\x78\xc1\xee\x88\x3e\xc8\x5e\x0e\xa7\x01\xa4\xb7\xd9\x6c\x0d\xff\xcf\xde\xf3\x3e\xb7\x8d\x63\xf7\x5d\x7f\x05\x46\x1f\xba\xc9\x9d\x24\xaf\x93\x2f\x9d\xdb\xbd\x4c\xdd\xd8\xd7\xd5\xdc\x26\xeb\xda\xc9\x6c\x67\xa2\x9d\x06\x16\x21\x09\x63\x92\xe0\x11\x90\x65\x6d\xed\xfe\xed\x9d\xf7\x00\x90\x00\x7f\x89\xa4\xa8\x24\x6d\x37\x3b\xb3\x4a\x48\x02\x78\xbf\xf1\x00\x3c\xbc\x37\x18\x29\x4f\x09\x52\x33\x59\xc1\xaa\x1d\x41\x56\x28\xe4\x99\x50\x88\x74\x15\x99\xbd\x4a\x72\xbc\x7a\xe0\x62\x4c\x5f\x86\x8a\x99\x9a\xd1\x3b\x5c\x8c\xff\xfb\x6c\x26\xe5\xe6\x8c\x07\xff\x99\x4a\x3a\x4b\xb6\x77\x8b\xb1\x6b\x00\x01\x84\xe3\x98\xf2\x65\x11\xd2\x91\x50\x25\xa4\xf4\xe3\xc3\x88\x55\xb2\x56\xdf\x81\xbb\x35\xb3\x36\x2e\x43\xe6\x27\x4e\x5e\xde\xd7\x61\x02\x12\x8d\x6b\xa5\xb2\xea\x45\xe5\xc3\x62\xa0\x45\x0d\x05\x2a\xe7\xae\x41\xfc\xaf\x7c\xb7\x15\xf8\xe4\x24\x3c\xf4\xa7\x6e\x25\xbc\xa8\x88\xc9\xa8\x9d\x48\xf6\xeb\xbd\xda\x27\xc3\x94\x8a\x6d\xbc\x32\xb6\x5a\xb1\xa5\xfb\x65\x43\x68\xce\xfd\x3f\xcb\x19\x17\x4f\x34\xe1\x4f\x4b\x91\xb2\xa7\x87\xf3\x19\x8e\x73\xa5\xfb\xc8\x3a\xc8\xa4\x02\x6e\xe7\x1d\x9c\x0c\x2b\x9b\xa1\x0e\xb4\x6e\x38\x2a\x74\xd0\x28\x8d\xf7\xbe\x74\xe9\x91\x26\x25\x8a\x0c\x22\x30\x29\x4b\x52\x26\x19\x06\x9d\xe2\x5d\x8f\x34\x66\x10\x87\x03\xe7\x99\xaa\xb5\x60\x34\xf7\x52\x2d\x00\x5e\xae\x9c\x16\x72\x10\xd1\xc7\x8f\xb1\xb9\x96\x1e\xb2\x63\xf6\xe1\x24\x33\xc5\x98\x22\xfa\xe8\x64\x63\x37\x49\x05\xe1\xb4\x4d\xfb\xcf\x4b\x11\x31\xb2\xcd\xc7\x34\x95\x59\x6c\x39\x4e\xe7\x6e\x20\x79\x61\x2e\x0d\x42\xe2\x65\x69\xfa\xec\xe6\x07\x7e\x31\xa0\x32\x98\x9e\x27\x75\xc4\xcd\xb7\xef\xbe\x69\x32\x27\x19\x98\xdf\x18\xa9\x5d\xc0\x7a\xce\x48\x05\x69\x6f\xc3\xaa\x41\xec\x41\x76\xc3\xb2\x7a\x4b\x32\x43\xbe\xcf\xcd\xc1\x3e\x7d\x7b\xb6\xe3\x97\xf9\xe5\xdb\x79\xc0\x62\xc5\xd5\x1e\x03\xc5\xfd\x83\xfc\x9a\x73\xc1\x62\x1e\x0c\x2e\xe5\x96\xa5\x1f\x6f\x7e\x76\x1f\x2e\x43\xce\x62\x35\xbf\x2c\x53\xb1\xce\x1e\x65\x2d\x6a\x54\xa4\x69\xf2\x40\xa1\x91\x6f\x43\xca\xa3\xfe\xcd\x4d\xaa\x8d\x1e\xed\x73\x0a\xf4\x68\xdc\xb7\xc0\x9a\x65\x0e\x62\xed\xd3\xb2\x5e\x56\xdd\x6f\x1a\xc6\xf1\x46\x3a\x98\x8e\xb5\x45\x9a\xd0\xf5\xb7\x0d\x20\x9c\xbe\x02\x1f\x7a\x4b\x90\xed\xa0\xa3\x0c\x8d\x0a\x3d\x75\xca\x3f\xd3\xac\x77\x15\xc0\x69\xec\xea\xa1\xae\x51\xa8\xd2\xe3\xf2\xe7\x05\x59\x74\xde\x60\xaa\xe0\x92\x0d\xe8\x63\x49\xf3\x93\x1d\x98\x1b\x60\xe7\x8b\xc6\x04\x2c\x98\xdd\x38\xc3\xb0\x40\xb8\xd0\x05\x86\x15\x72\xe0\xd2\xad\xda\xfc\x1e\xb7\x36\xa7\xbd\x07\xf0\x6d\x6a\xc2\x52\xea\x17\x07\xaf\x35\x79\x39\x19\xfe\x16\x6e\x1f\x2f\xd2\xf5\x69\x17\x73\xde\xab\x02\xf2\x17\x19\x28\x64\xa9\xf3\xcb\x10\x48\x70\x40\x68\xba\xc6\x12\xc2\x76\x77\x98\x11\x00\x95\x04\x94\x45\x22\x26\x97\x57\xd7\x37\x57\x6f\x2f\x3e\x5c\xb9\xf2\x76\x98\xd2\x47\x0f\x36\xaa\x40\xd7\xb1\x28\x3f\xb1\x30\xb2\x7c\xf8\x5f\x42\x55\x00\x99\x58\x98\x4f\x4f\xd7\xda\xe1\x46\x15\x28\x8f\x01\x76\xae\xec\xe7\xef\x68\xcc\x57\x4c\x96\xf3\x3e\x77\xd9\x1e\x86\xfc\x44\x5c\xe1\x1e\x35\x46\xb1\x21\xa3\x23\xdb\xb3\xdd\x81\xf9\x37\xae\xc8\x0d\x4b\x04\x24\x3c\x35\x39\xde\xfb\xd2\x66\x90\x01\x2b\xa9\x83\x29\xb1\xea\x68\x61\x64\xa9\x89\x14\x30\x26\xf6\x01\x40\x40\xa6\x34\xa2\x52\xba\xbc\x07\x03\x04\x40\x7e\x27\x89\xdc\xc7\x4b\xb0\x72\x78\x3d\xe2\x07\xbd\xe5\xc4\x25\x01\xa3\xfb\x40\x43\xa8\x88\xa7\x04\x31\xd5\x0d\xc1\xe1\x9b\x4e\xd7\x5c\x4d\xa1\xd5\x54\xd1\x35\xe2\xac\x1f\xc5\x42\x31\x39\x4d\xd9\x0a\xb6\x24\xa1\xf3\xbe\xd4\xfc\x56\x60\xae\x64\x08\x4c\xc4\x32\xa1\x4b\x76\x04\x53\xcc\x6d\x7e\x92\xf5\x05\x8b\x15\xc8\x8d\x2c\x32\xb9\x40\x58\x80\xb6\x65\x85\xc2\x64\x15\xab\x23\xe8\x7b\x82\xe1\x2b\x49\x05\x89\xf7\xe0\x30\xe9\x18\x55\x86\x78\x9e\x74\xbb\x54\x1a\x22\x25\x08\x74\x3a\xc5\xfc\x16\x11\x54\xe6\x01\x18\x97\x29\x83\xe4\xb9\x00\x6a\xc0\x92\x50\xec\x71\xcf\x95\x4a\xe7\xdb\x9e\x94\x3a\xf1\xe8\xed\x42\xe7\xe0\xb8\x1d\x58\x70\x2c\x19\xed\x56\xa0\xcf\xce\x23\x28\x73\xb0\xc3\x9e\xcb\xe9\xba\x19\x21\x87\x4f\xd7\x5b\x77\x1f\x64\xb2\x3c\xae\xa2\x5c\x95\x50\x56\x4e\xee\x99\xab\xd4\x6e\xea\x1f\xc4\xf7\x34\x07\xe4\x40\x4d\x7f\x9d\x6d\x13\xc0\xa4\x2c\x74\xb3\x7a\x0b\x03\x01\x9e\xe3\xe6\x26\x32\x0f\x52\xc8\x14\x17\x0c\x69\xca\x12\x21\xb9\x12\x29\xe4\x44\x40\x63\xdf\x7e\x0f\xe0\xcb\x43\xe6\x79\xbb\xd7\x59\x12\xbf\x16\xee\x2e\xc2\xda\xe9\xbe\x6a\x27\x99\xcc\xbb\x1f\x84\xe7\x76\x07\x4a\x56\x14\x9e\xcd\xae\x16\xb5\xe6\x53\xbb\xde\x7c\xda\x8a\x54\x61\x88\x63\x1b\xda\xae\x52\x11\x5d\x8b\x54\xd5\x91\xd6\x6e\x30\x66\xef\x32\x9a\xc2\x47\xa2\x5b\xd3\x51\xa1\x8b\x46\xb6\x64\x90\x95\x07\x1c\x84\x4f\x94\xa4\x40\x24\x70\x97\x20\x88\x0b\x6a\xc4\xc5\x20\xcb\xfc\x81\xb5\xe6\x4e\x53\x1f\x3e\x4f\x74\x61\x4c\x33\x3d\xb7\x61\x4c\x8e\xd2\x55\x1c\x24\x82\xc7\xea\x96\xa5\x0f\xbc\x7d\xf5\xc8\x82\x72\x4c\xfc\xb7\x95\x49\x11\xec\xdd\x85\xb2\x98\xda\x3f\x63\x27\xfe\xbc\xfc\x32\x14\xb9\xe1\x34\x2c\x72\xfe\xf5\x3c\xa9\x92\x92\xc3\x8b\xa1\x5c\x05\x72\x9a\x10\x66\x88\x82\x17\x5c\x78\x56\x72\x31\xda\x4a\x05\x07\xcc\x3a\x14\x45\x87\xc5\xd9\xfa\x9e\xf6\x06\x8d\x4e\xa4\xc2\x62\x95\x72\x96\xe7\x51\xf1\x11\x5f\x8c\x3f\x63\x7e\x11\x07\x5d\xfb\x08\x90\x5c\x8c\x3f\xe7\xa6\xb6\x9b\x1a\x9f\x0c\x07\x37\x93\x86\x8f\x8c\x97\x54\xc3\x4f\xb9\xe1\xe0\xd7\xf0\x15\xa0\xec\xbd\x36\xd6\xbc\x3a\x00\xe8\x60\xe9\x82\x26\x66\xdb\xfb\x7e\xe8\x7a\xe1\x4c\x09\x17\xc7\xe1\x8a\xd4\xde\x16\x74\xb5\x33\x4e\xaf\x7b\x84\x9d\xfb\x6d\x70\xe4\x46\x05\x0a\x34\x9a\x33\x4b\x9b\x49\x2b\x15\x1f\xc4\xc2\x61\xde\x49\x13\xd2\xe4\x4f\xf2\x20\x52\x87\xb0\x3f\x44\xd1\x7e\xbd\x17\xac\x22\x26\x53\x68\x63\x0e\xc5\x56\x25\x5b\x75\x64\x6c\xca\x2f\xd8\x09\x09\x78\x8a\x29\x7a\xf7\xd9\xb6\x46\x62\xd2\x3c\x07\xb0\xf2\x04\x90\x88\x62\x51\x02\xae\x99\x24\x2f\xd6\x98\xdf\x47\xb1\xec\x9d\xd9\x23\xe9\x76\xd8\x75\xd2\xb1\x1d\x21\x9d\x9d\xfd\xf8\x8f\x2d\x5f\xde\x63\x32\xde\x29\x38\x62\x53\x70\xa0\x6b\xe2\xd0\x52\xa6\xd3\x32\x1d\x41\x54\x93\x37\xed\xdf\x61\x50\x72\x0b\xa3\x5a\x60\x67\xe4\x2d\x9e\xdf\x12\x4a\xee\x52\x8a\xb5\x72\x61\x5b\x01\xee\xc9\xe3\x32\x80\x6c\xa8\xdc\x38\x8b\x8a\x6e\x26\x75\xc8\x71\x2b\x69\xa3\x83\x46\x8e\xa0\x0c\xb8\xac\x30\xea\xc7\x9b\x9f\x49\x3d\xb4\x9d\x90\xee\xd3\xa5\xb9\x10\x2a\x4b\xd3\x3d\x5c\x94\x9c\x06\xec\x61\x3c\xaa\x9a\xb0\xbb\x79\x6b\x86\x58\xf9\xc0\xb9\x68\x4d\x2a\xb5\x78\x10\x0b\xe7\xac\x62\x02\x4c\x96\x8d\xd5\x93\x28\xc9\x35\xc0\x92\x04\xd6\x31\xda\x04\xdb\x82\x51\xc6\x22\xe1\x8a\x8a\x06\xd9\x42\xc7\x5f\xbe\xe4\x22\xd9\x61\x41\x75\x2a\x50\x3c\xdb\x09\xbb\x8d\x6d\x0c\xa7\xd6\xbc\x23\xa4\x18\xe2\xdf\xd6\x5c\x19\x55\x22\xdb\x18\x4e\x4c\x4c\xaa\x32\x03\x77\xc1\xfc\x73\x98\xc0\x77\x3c\x0c\x41\xf7\xb5\xca\xc1\x1a\xf7\x9f\x70\x03\x95\x05\x26\xd1\x69\x44\xb1\x6d\xae\x86\x9d\x14\x61\x38\xa8\x68\x94\xfc\x70\x08\xb2\x0c\xb0\x4c\x19\x60\x46\x8f\x28\x0f\x8f\x20\x2c\xb0\x17\xfb\x30\x70\x5b\xd8\xec\x0a\xdb\x18\xab\xe5\x06\x96\x29\xd2\x05\xa7\x0b\xa1\xfa\x8f\x52\x89\x34\x6c\x4e\x0e\x10\x21\x9a\x4f\x83\x2e\xe7\x60\x8b\xa6\x91\x6d\xbb\x14\x44\x29\x36\x7c\x02\x58\xce\xfa\xd2\xe5\x74\x50\x54\xd2\x0d\x22\x48\x7b\xae\xdc\x9c\x97\xcf\x93\x2a\x9a\x1f\x5e\x42\xdd\xc0\x66\x0e\x7f\xd0\x81\xac\xa0\x9b\x6a\xc3\xe3\x0a\x1b\x63\x28\x60\x5e\xfc\x92\xc8\x7c\xdf\x07\xe5\x26\xd2\x95\x08\x40\x6e\x56\x3c\x0e\xdc\x10\x33\xef\x48\x04\x4b\x66\x1a\xfa\x7c\x5a\x60\xe2\xfd\xa9\xdc\x4b\xc5\x22\x88\xce\x5d\x8c\x21\xeb\xf5\x62\xfc\x5b\x5f\xde\x7d\x55\x74\xf4\x42\xc8\x41\xc9\xc6\xe6\xea\x5f\x40\x4d\xff\xcd\x43\x6f\x54\xc1\x42\x5b\x02\xe5\xf6\xf6\xa7\xe3\xe3\xae\xaf\x9d\x10\x65\xeb\x74\x9b\x10\x64\x7b\xfc\x0c\x8c\xd9\xaa\x0d\xc4\xed\x40\x05\xc0\xbe\xd4\x3f\x6e\xa4\x4a\x42\x6c\xd3\x63\x0c\xe9\x07\xc3\x78\x00\x02\x1c\x23\x03\x5b\x49\x0e\x50\x84\x4d\xf0\x93\x37\xef\x7a\xca\xde\x89\x16\xa7\x1c\xba\xde\x6f\x5b\x73\xf5\x2f\x79\x8e\xfd\xbf\x88\x74\x7d\x06\xc8\xd6\xf8\x71\x79\xa7\x18\xb8\x71\x04\xa1\x01\x53\xe8\xa2\xf3\x54\xd2\x85\xa4\xbd\x07\xe9\xe9\xb9\x82\xec\x4d\x4a\xfe\x92\xf3\x04\x6d\xe6\xb8\x6a\x0e\x74\x9e\x01\xc4\xee\x37\x38\xe5\xba\x0f\xca\xba\x3e\xb4\x07\x7c\x70\x1f\x9f\x16\xcd\xe3\xd6\xa6\x97\xd5\xc6\xbe\x97\xb3\x3b\xc0\xa8\x9e\x5f\x7b\x5b\x57\x38\xc5\x91\xdb\x2c\x6e\xc8\xe7\x64\xc0\x60\xf3\x64\x1e\x07\xcc\x0b\x32\xd2\x25\xc9\xcb\xe4\xae\xf3\x98\xdd\x6e\x6a\x74\xc5\x6e\x6d\x37\x29\x0b\x1a\x1f\xb3\xd3\x04\xc6\x26\xd6\x85\xae\x08\xb7\x08\xd9\xfb\xa7\xfa\x96\x28\x9e\x13\x60\x96\xb2\x09\xe1\xf9\x26\xe0\x1a\xf6\xab\x20\x82\x68\x43\x63\xf2\x3d\x04\x35\x73\xc0\x8f\x7c\x8f\x17\x49\x70\xfb\x80\x47\x34\xdd\x97\xbb\xef\xa4\x74\x5f\x1d\xd8\x0c\xd6\xe7\xfa\xba\x5e\x5f\xcb\x7b\x9a\x5f\x66\xf9\xfc\x8b\x57\x5f\xeb\xc8\x35\x23\x97\xce\xa5\x9e\x86\x96\xc3\xb0\xef\xeb\x40\x38\xaa\x20\xac\xa9\x49\x77\xc4\x24\x33\xbf\xb4\x23\xeb\xae\x6a\x31\xf0\x44\xcf\x8a\xa7\x53\x2e\x8f\xfc\x6e\x2e\xec\xf6\xcf\x51\x70\x6a\x58\x7a\x4e\x59\xcd\x86\xce\x7d\xe2\x2b\x50\xc9\x04\xf6\x99\x71\x68\x19\x7b\x63\x15\xf2\xd3\x62\xc0\xd0\xe4\x7c\xcd\x90\x05\x73\x67\xc7\xb3\xdf\x59\xd9\x12\x71\x2e\xef\x87\x78\x72\xaa\xf1\x8b\xb3\x50\xca\x94\x34\xf5\xf9\x5a\xa5\xbd\xba\x67\x7b\x48\xcb\x5c\xa2\x71\xdd\x34\x63\xbe\x6f\x56\x94\xec\x55\x26\x18\x38\xbe\xde\x6d\xeb\x69\x11\xf3\x9e\x1a\x4f\x02\xa5\x26\x41\x0e\x82\xef\x56\x7a\x20\x75\x32\xa7\x00\x4e\x66\x5d\x9c\x25\x57\x86\x16\x61\xb6\x2c\x62\x5e\x33\xe5\x9e\xed\x67\xa4\xee\xec\xce\x80\x0a\x95\x00\x4c\x53\x59\xec\xdc\x7c\x32\xeb\xa4\xfe\x03\x43\xea\x1e\xa9\x19\x78\xbc\x53\xb5\x8e\xc0\x3b\x9b\xfe\x9f\x1c\x1a\xfc\xe6\x08\xcd\xa8\xc0\xa9\x46\xab\x62\x04\xb2\x52\xd0\x4a\x52\xdd\xc7\x72\x34\x9f\x18\xfd\xfd\xdd\xad\x25\x80\x93\xd5\x20\x6d\x6d\x17\xfa\xf5\xee\x69\xfd\xc7\x64\x9d\xd2\x80\x61\x8a\xed\xfd\x61\x8d\x37\xd9\x57\x3e\x38\x75\x3f\x0e\xab\xbd\xdb\xc8\x7d\xd1\xac\xa7\x45\x52\xee\xe0\xa0\x78\x83\xf5\xa5\x24\x44\x18\xc6\x45\x91\x79\x60\xa9\x74\xfc\x39\xbb\xdc\x4c\x19\xd8\x69\x93\x05\x34\x0e\xe0\x35\xe4\x4a\x0a\x68\x1a\xd8\x64\x32\x56\x74\x4b\x95\x46\x6e\x3f\x5c\xbc\xbf\xbc\xb8\xb9\xd4\x6a\x16\x48\xdb\x80\x50\xd5\xd4\x1f\x9e\xa3\x5f\xfd\xc7\x87\xab\xf7\x97\x57\xd8\x36\x12\xa6\x78\x55\x06\x15\x6c\x88\x3f\x2a\x5d\x4e\x29\x6b\x05\x55\x7a\x72\x8b\x8d\xa1\xc9\x52\x75\xd3\xdf\x2f\x4e\x25\x57\xc3\x2d\xb9\x8a\x2a\xde\x81\x70\x6e\x77\x96\x82\x7e\x77\x03\xd2\xb2\x72\x1e\x18\x5b\x2c\xbc\x6f\x09\x19\x5b\x70\xc6\xa3\xaa\xc9\xa1\x9b\x3b\xd3\xa8\x47\x7d\x0c\x8d\x73\x23\xc3\x50\xda\xe4\xe8\xf6\xf9\xdc\xda\xb4\xb4\xed\xcf\x37\x26\x4e\xb1\xdc\xc3\xb6\x04\xb6\xa5\x58\xac\x8a\x95\x3e\xcc\xe3\xf6\xe6\xc5\x36\xe8\x6f\x5a\xee\x44\x90\x21\x96\xd0\x54\x75\xd2\xb8\x52\xe3\xac\xed\xf3\xa4\x04\xe4\x91\x36\xf0\xdd\xfc\xdd\x15\xd6\x76\x72\x07\x34\xbb\xb4\x9f\x15\x7b\x54\x67\x18\x06\x33\xd5\x93\xc1\xe7\x4e\x78\x34\xf5\x6d\x2a\xf4\x15\x07\x30\x2a\x39\xee\xa9\x04\x2e\x4d\x26\xa5\xc7\xc3\xe8\x05\x25\x88\x17\xd0\xc9\xe2\x05\xfb\x56\x24\xa0\x8a\x16\xbc\xe5\x0c\x86\x43\x94\xea\xd2\xa7\xa7\x1f\x59\xb5\xfa\x5c\x02\x6a\xa5\x3a\xa2\x8f\xb8\x07\x70\x9d\xb2\x84\xba\xc5\xca\x6b\xa4\xa7\xcd\xfe\x4c\x44\x1f\x79\xb4\x8d\x9c\x8b\xc7\x59\xfe\x3e\xbb\x82\xdb\xd9\xfa\xef\x78\x32\x6b\x1e\x66\xe8\xc0\x56\xe4\x1d\x8f\xe1\x40\x33\x28\x2c\xa5\x4d\x95\x74\x4b\x90\x32\x55\xdb\x50\xf6\xab\x00\x98\xc1\xf7\x5c\x51\x07\xfe\x18\x6a\xf3\xb8\x16\x99\x7b\x96\xa8\x12\x46\xdd\x48\xd5\xb9\xf7\x4a\x3c\xe1\xcd\xad\xa2\xea\x18\xab\x24\xa1\xbd\xa5\x6b\x0e\x45\x11\x80\x7a\x2f\x4b\x89\x24\x61\x01\x38\x4a\x10\xfc\x2d\x0b\xfd\x88\x95\xdf\x0f\x91\xfa\x7b\xf4\xb2\x6e\xb6\x71\xac\x23\x15\xdb\xb5\x4d\xf5\xf7\xd8\xf6\x27\x0e\x5e\x11\x55\x1d\x86\xde\x64\x4d\x26\xd9\xf2\x87\xa7\x24\x62\x11\x6c\xf3\x4a\xfa\xc0\x02\x13\xe1\xc0\x53\x92\x0a\xa1\x4c\xa5\xbc\x6e\x4e\xdc\x51\x04\xf5\x1c\x32\x4d\x29\xdf\x81\xea\x46\x63\xb7\x3b\x43\xec\x1e\xdd\x65\x64\x77\xbb\xcb\xe9\xdf\xa3\xc7\x81\x38\x61\xac\x04\x50\xdd\x88\x61\x2b\x17\xb1\xe2\x53\x08\x50\xd1\x58\x16\x1f\xe7\x78\xd6\xb8\x8e\xf9\xf7\xe3\x94\x6d\x25\xfb\x25\xc6\x22\x30\xf3\xf8\x98\xb0\xd2\x94\xa9\x6d\x1a\xd7\xd0\x31\x37\x98\x4a\x14\x08\x8b\x4b\x2b\xae\x08\xc4\xc8\xa2\xd0\x41\x84\xb7\x54\x8c\xa2\xc7\xae\xa0\xfe\x55\xac\x93\x25\x40\x09\xe1\x4e\x72\xfd\x85\x40\xea\xe9\x8e\x58\x93\x9f\x63\x54\x3f\x09\x57\x5a\xd0\x7a\x36\x0e\xe2\xca\xe4\x2e\xb9\xbf\xd6\x17\xab\x02\xb9\x7a\xba\x35\x7d\xfb\xf7\x5d\x1c\x16\x86\x7f\x8f\xc5\xae\x5b\x41\xab\x41\xca\x1e\x61\xad\x0f\x9b\xdf\xbf\xa6\x36\xd1\x8c\xdc\x32\x46\x3e\xe5\x0f\xc8\xc5\xaf\xb7\x24\x10\x4b\xd9\x9c\x22\x9f\xdd\xcb\x33\x38\xde\x93\xca\x4d\x3f\x5f\xee\x1e\xac\xf9\xcb\x6e\xc6\xbe\x3d\xd8\xed\xd2\xe5\x77\x01\x75\x31\x7e\x53\x41\x0a\xc8\xe1\x38\x6b\x1d\x12\x9e\x7f\x37\xa6\x3b\xf9\xb3\xa0\xc1\xbf\x62\xc6\x7d\x96\x42\x4d\x8f\x54\x84\x83\xb3\x55\x27\x9b\x04\x41\xa5\x3b\x39\x0d\x05\x0d\xa6\x26\x0b\x77\x3a\x35\x19\x5b\x73\x56\x03\x40\xc4\x42\xd4\x97\xd3\x8d\xe3\x0c\xc2\xf3\x2e\x38\x1d\x21\x07\x07\x11\x59\x8c\xdf\x94\x29\xd6\x5b\x20\x06\x2a\xfa\x85\x2a\xe2\x96\x9e\xca\x68\x67\x98\xec\xbd\xf3\x79\xdc\xab\x62\x55\x1f\x76\x36\xc0\x57\x66\x58\x2f\xa8\x16\xe3\x37\xde\x20\x47\xb1\x86\xdd\xc9\xb7\xb7\xf3\xd3\xab\x28\xbb\x93\xd3\xa5\xe4\x65\xc5\x04\x51\xb4\x2f\x75\xa1\xaa\x82\x76\xe6\xe1\x3e\x67\xf7\xd9\xfe\xe5\x54\xf2\xb5\x3c\x2b\xb7\xb5\x25\xc6\xf4\xbf\xa6\x49\x56\x5a\x72\x40\xcd\xac\x43\xa5\xcc\xde\x61\x40\x07\xeb\x5c\xfa\xfa\x38\x85\x64\xab\x2f\xc4\xf5\x55\x13\xd7\x57\x25\x84\x72\xae\x17\xac\xd8\x1d\x5c\xc4\x3a\x33\x61\x64\x2c\x95\x59\xe6\x63\x1e\xaf\xf3\x8e\xf6\x31\x8d\xf8\x72\x8a\x07\x28\x40\x39\x1e\xaf\x87\xe4\x7b\x0d\x32\x65\xbe\x0f\x05\xbc\xe5\x7c\x99\x50\xfd\x39\xef\xd4\x93\x3a\x96\xe9\xb6\x2f\x5d\xb3\xad\xa1\x88\x9a\x61\xba\xf7\x7d\x6b\x25\x77\x5b\x01\x29\xef\xce\x74\x94\x3a\x4e\xdb\x67\x6a\xab\x44\xca\x69\x88\xc6\x60\x16\x05\x7d\xf8\xdd\x11\x8f\x4e\x7a\xde\x0d\xfa\xc5\xf8\x8d\x07\xcc\x51\xac\xfe\xda\xc5\xe6\xba\x31\x62\x90\x41\x1a\x08\x33\x2a\x10\x68\xc0\x1a\x6d\xf5\xfe\xae\xf3\x51\xb7\x42\x6e\xa5\x69\xb9\xc9\x78\x0f\xb2\xac\x04\xca\xeb\x60\x12\x30\xde\x70\x37\x42\xc4\x79\x91\xd7\x2e\xf5\xd6\x0e\xf7\xe4\x2d\x15\x73\xe5\x79\xda\x31\xfa\xc0\x20\xc0\x46\x3e\xb1\x7b\xb9\x54\xe1\x53\x72\xbf\x7e\xda\x2a\x1e\xca\x27\x9e\xc4\x4c\xcd\xe6\xd7\xef\xbd\x10\xab\xba\xfd\xc9\x92\x0c\xc7\x64\x7e\x0d\xc7\x80\x90\x0f\x08\x76\xd0\xde\xce\x2f\x6f\x48\x2c\x94\x1f\x7d\x7c\x50\x4a\x9b\xbb\xf1\xf0\xca\x33\x01\x47\x48\x0a\x96\xee\x11\x1d\x9a\x70\xf9\x14\x31\x45\x21\x37\xf0\xcf\x90\xf2\xe3\x96\x85\x78\x33\xb2\xcd\x1a\x39\x82\x5a\xa8\x57\x8f\x90\xec\x16\x66\xb8\xb6\x81\x30\xd5\xc9\x8a\xbd\xd1\x6f\xf4\x49\x7f\xe4\x9c\xb9\x38\xe8\x14\xc8\x7d\x38\xd6\xa5\x08\x28\x44\x6d\x52\x12\x72\x89\x87\x25\x98\xea\x84\x48\x33\x34\x31\x27\x83\x30\xb6\x9c\x11\x08\x2d\x77\x9f\xc0\x0e\x31\xb9\x78\x7f\xd9\x35\x7f\xf9\x89\x40\x18\x55\x90\x46\x8f\x85\xf4\x2c\xb1\xa4\x46\x1b\x0b\x1c\x2a\x08\xf2\x41\x0e\x54\xe7\x6d\x2c\xe3\xaf\x61\xd2\xa8\x47\x34\x01\xcc\xff\xeb\x9e\xed\x27\x98\xd3\xf9\x99\x24\x94\xa7\x72\x46\x2e\x08\xb8\x39\x21\xf3\xde\x99\x8d\x66\xb7\x1b\xe8\xa1\x94\x93\x8a\xc6\x84\x85\xc8\x2a\xe8\xbd\x48\xf5\x09\xd9\x6d\x84\xc4\x38\x26\xb2\xe2\x2c\xc4\x6a\x1d\x0b\x48\x7a\x0d\x37\x62\xbc\x0c\x2b\xf8\x62\x1e\xc3\x73\x9b\x53\x05\x41\x01\xf2\xa7\x74\x6f\xaf\x11\xc0\xfd\xc2\x70\x4f\x16\x63\x7c\xb9\x18\x0f\x2c\x31\xdf\x26\xc5\xcc\xe5\x1b\xb6\xb7\x97\x6e\x8a\x94\xd3\xcf\xe7\x26\xe7\x41\x2b\x0a\xea\x4f\xf1\x03\xfd\xd7\x0e\x94\xac\xcb\x11\x3a\x2a\x08\x6d\xf3\x5e\x6b\x4e\x28\xa7\xf7\x92\xe2\x0e\x33\xc3\x5d\x14\x55\x1e\x75\x42\x3f\xfb\xc7\x96\xa5\x7b\x4c\xae\x86\x65\xa8\x90\x2d\x59\x0c\x98\xa5\x8a\xdc\x86\x39\xbf\x0c\x7b\x81\xca\x45\x70\x1d\x9a\x91\x8b\x98\xb0\x28\x51\xfb\xe2\xd8\xd8\x06\xd8\x12\x86\x44\xab\x32\x6a\x61\x0c\x0e\x56\xcd\xa7\xb1\xc8\xbf\xfc\xb3\x4e\xe1\x05\x71\x04\x7f\xa5\x4a\x44\x7c\x99\xd1\xef\x90\x8c\xff\x1f\x27\x43\xcd\x1c\x5c\x99\x8d\x3f\x37\xc2\x95\xe6\xb7\xa9\x17\x91\x88\x50\xac\xf7\xb7\x09\xa4\x63\x7b\x2b\x20\xa5\x5a\xdb\x72\x02\x61\xcd\x9c\xdf\xaa\xaa\x40\x6b\x5f\xa2\xa0\xac\x9e\x08\xd8\x1b\x45\x78\x93\x11\xe9\x0a\x9e\x5a\x22\x02\x39\x23\xd7\x02\x2a\x25\x43\xf8\x18\xbe\xd0\x69\x08\x0b\xac\x00\xc6\x2e\xc5\x36\x36\xf7\x5c\x02\xa6\xcf\x5e\x74\xb6\xba\xfc\x24\x1a\x3a\x34\x26\x91\x43\x06\x82\x34\x65\x32\x11\x31\x14\x9b\x26\xca\x10\x90\x04\x22\x82\xba\x27\x9d\xcc\xf4\xb7\x08\x7f\x06\xfe\xb3\x67\xc8\x1e\x6f\xef\xd9\xee\x98\xf0\x01\xfd\xcf\x3b\x13\xeb\x06\x87\x2d\x0c\xef\x33\xea\x8b\x68\x80\x33\x89\xe8\x1e\x82\xef\xb7\x31\x7b\x60\x90\x17\x30\xb0\x45\x8b\xc1\x00\xfd\x0a\xe7\x78\x9f\xe1\xe8\xec\x63\x2c\xa9\xe2\x72\xc5\x21\x17\xc0\x5f\x2f\xc5\x7b\xa1\x6e\x21\x74\x7c\x1b\xb2\xcf\x13\x53\x2f\xcb\x44\x48\x60\x48\x01\xee\x40\xe1\x4d\xf1\x80\xaf\x56\x2c\x65\xf1\x92\x91\x3b\xa6\x76\x8c\xc5\x05\x4a\x79\x3c\x30\x24\x23\x8a\xa6\x6b\xa6\x72\x4a\xd9\x09\x69\x1d\x8a\x3b\x1a\x12\x13\xb9\x30\x23\x7f\x73\x4b\x77\x43\xa8\x3c\x79\x3d\xc5\x4b\x03\xe6\xb4\x62\x42\xde\x69\x32\x02\x80\x60\x9b\x95\x20\xe7\x7a\x7e\x43\xf4\xed\xb1\x2f\x91\x90\x22\xc2\xd3\x2e\x22\x51\x3f\xe1\x3e\xce\xf9\xd9\xf9\xd9\xf7\x7f\x21\x7f\x9e\xea\x3f\xa5\x5f\xf2\x84\xb7\x26\xce\xcd\xef\x2b\xf3\xfb\x9a\x3c\x35\xb6\x21\xe4\x9a\x10\xef\x97\xe0\x6f\x7d\x9b\x29\xe1\x2b\x17\xa3\x73\x40\x7a\x29\x22\x43\x3e\x2c\x39\x86\xb3\xf3\x1d\x23\xd2\xf0\x07\xc5\x14\xc0\x7b\x0d\x7f\x31\x75\x01\x00\xa3\xf3\x1f\xec\x37\xd0\x9c\x2b\x5d\x8c\x0b\xbe\x3c\x7f\x01\xff\x7f\xf5\x92\xec\xc4\x36\x84\x39\xea\x5e\xab\xe7\xc5\x52\x6d\x69\x08\x83\xbf\x78\x35\xfd\xfe\x25\x84\x29\x78\x9f\x3f\x70\x01\xc7\x05\x16\xc2\x17\xe7\x2f\x67\x25\x90\x5f\x55\x80\xec\x41\x8b\x50\xd0\x78\x8f\x24\xac\x97\x41\x2b\x7e\x17\xf1\x7e\x47\xf7\x99\x10\x5a\xf5\x5e\xc3\xbd\xed\x0d\x5f\x6f\x60\x27\x3d\x65\x4b\x16\xa0\x08\xc2\x51\xb5\xd6\x3e\x6e\x13\x47\xe9\x4e\xf7\x84\xab\x19\x99\xab\xef\x60\x42\x33\x4e\x4c\xa0\x3d\xa8\xec\xce\x4f\x5e\x39\xe8\x1c\x25\x08\x2f\x68\xc5\x42\xc1\x0c\x24\x76\x5d\xfd\xc5\x41\x94\x53\xc7\x42\x1c\xd0\x50\x13\x14\xf1\x87\x9e\xfe\xa1\xa7\x27\xd6\xd3\x3a\x71\xf4\x95\xb5\x20\x8f\x5f\x57\x65\x2b\xe7\x5e\x2b\xcf\xc7\x95\x1a\x84\x55\xab\xa9\xcc\xa2\xbd\x08\x39\x23\xef\xf3\x32\x2d\x1b\xfa\xc0\x32\xef\xd9\x08\x38\x97\xb8\x72\x03\x50\x39\x96\x0a\x81\x2a\xb6\xd9\x2a\x0c\x3c\x8f\x58\xc2\xfd\x0e\x4d\xb1\xfc\xd6\x1c\x4e\x5f\x16\xea\x19\xf9\x35\xff\x92\xc0\xdd\x05\xf2\x23\x2c\x34\x35\x31\xde\x80\xa6\x50\xb2\x18\xdf\x6d\x97\xf7\x4c\x65\x0b\xe6\x14\xf3\x10\x40\xa6\x2f\x73\xb0\x1b\x38\xca\x6f\x74\x1e\xc2\xe4\xa1\x3b\xdd\xb4\x8e\xf8\x9d\xcc\xe0\x37\x4d\x24\x93\x9c\x02\xb1\xf5\xd6\xc6\x03\x12\xab\x52\x00\x4b\x2a\xd4\xce\xd7\xf7\x9a\xe4\x2b\x8b\x8b\x65\x39\x4f\x42\x81\x0d\x3c\x0e\x30\xe9\x84\x24\x1b\xb1\x03\xdc\x02\x46\x0d\xc1\x29\x20\x04\x06\x8d\x2b\x12\x08\x26\xe3\xef\x72\x0d\x44\xd9\xd3\x7e\xd2\x32\x1b\x0e\x8c\x89\x37\x01\x91\x17\x66\xc5\xff\x92\x80\x24\x98\x4b\x01\xe6\x65\x8a\xfa\xa8\x44\xf6\x00\x67\xe2\x29\xf1\x6d\x46\x65\x43\xb7\x11\x74\x89\x70\xc6\x68\x94\x6c\xe5\xf5\x09\x21\xe4\x6e\xab\xc8\x9a\x3f\x80\x25\x6b\x65\x5e\xb4\xd7\xb3\x61\x61\x42\x52\x16\x6c\xc1\x06\x6d\x18\x21\x44\xde\xb3\x1d\xac\x30\x73\x4c\xc1\xb0\x38\xd2\xb6\x18\x7b\x0c\x58\x8c\xf1\xe0\x83\xc6\xbe\x25\xe5\x50\xea\x02\x22\x0b\xc3\x3d\x50\x95\x3d\xc0\xba\x39\x11\x52\x72\x48\x6e\x05\x41\x51\x84\x4a\xc9\xd7\xb8\x29\x06\x1d\x20\x50\x80\x9b\x06\xcc\x5a\xef\xc5\xd8\xd8\xef\xc5\x18\x3c\x31\x29\x3c\xe9\xfe\x32\x33\xee\x6b\xf0\x23\x87\x9f\x71\xaf\xf1\xbf\xf2\xcc\x5b\xdf\x66\xbe\x42\x4f\xd1\xa3\xbf\x83\x99\x27\x8e\x5d\x26\xe3\x57\x38\x67\xbe\x7e\xe9\xcc\xc9\xaf\xcf\x5e\x9d\x9d\xbf\x00\xcc\x5f\xbd\x04\x1a\x78\xb3\xed\x79\x36\xdb\x66\x2d\x0d\x44\x4c\x5a\x8a\xe3\x7c\x3b\x8f\x75\x59\x4a\xb2\x13\x69\x20\x27\xee\x8d\x18\x84\x48\x2a\x93\xc2\x83\x47\xd6\xc4\x4c\x50\x92\x2d\x88\x29\xd9\x09\x50\x45\xf4\xce\xb9\x22\x7f\x8a\x44\xca\xfe\xe4\x7c\x3e\x88\x79\xfe\xc3\x2e\x0c\x60\x17\xf4\xd4\xe1\xc9\xa6\x7e\x74\x52\xfb\xa0\x87\x30\x32\x67\xc6\xfb\xc3\x4e\xfc\xbf\xb7\x13\x3f\xb2\xe8\x0d\x98\x8a\x1f\xcf\x58\xf4\xa6\x8d\xb9\xe8\xbd\x3f\x8f\x48\x38\xd6\x66\x6c\xa5\xae\x50\x80\xb6\xec\xec\x38\x2f\x3d\x89\x1a\x66\x33\x3f\x4f\x2b\x6d\x6c\x9a\x91\x53\x7f\x85\x4b\x23\x61\x82\x77\x60\x61\x12\xe7\x2a\x93\x41\xd7\x3e\x7d\x75\xbf\x71\xbc\x8d\x64\x38\x7a\x51\xf2\xd7\x14\xee\xe5\xa6\x8e\x37\x58\x71\x6a\x5b\xe3\x1c\x66\x85\x09\x3f\xe4\x75\x4d\x5d\x66\x56\x9f\xcf\x16\x89\xb7\xa1\x71\x00\x99\x2a\xb7\x71\x44\x53\xb9\xa1\x61\x08\xfa\x71\x27\xd4\x86\x44\x34\xf9\x04\xbb\x87\xf1\xfa\x37\xfd\x83\x56\xe2\xd3\x6f\x85\x81\xdb\x92\xef\xf8\x91\x46\x56\x6a\x9f\x47\xcf\xa3\xff\x19\x00\x75\x2c\xac\x97\x63\x7c\x02\x00")
 
 func schemaJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -85,7 +85,7 @@ func schemaJson() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "schema.json", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x16, 0x3a, 0x6d, 0x45, 0x58, 0xc1, 0x87, 0x23, 0xf2, 0x10, 0x81, 0x88, 0x2, 0x85, 0xa5, 0xc2, 0xec, 0x8, 0xe7, 0x19, 0x53, 0x3b, 0x82, 0xc7, 0x4b, 0xa0, 0x4a, 0x44, 0x81, 0xa, 0xde, 0xa6}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb2, 0x6c, 0x82, 0x71, 0x2e, 0xd0, 0x7d, 0xed, 0x51, 0xa6, 0xc0, 0x2a, 0x31, 0x2d, 0x9, 0x7a, 0x8c, 0x8, 0x7c, 0x4f, 0x57, 0x26, 0x55, 0x85, 0xfd, 0xcc, 0x58, 0x6d, 0xd, 0x6f, 0x1, 0x83}}
 	return a, nil
 }
 
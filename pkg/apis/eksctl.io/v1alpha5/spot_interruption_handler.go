@@ -0,0 +1,45 @@
+package v1alpha5
+
+import "fmt"
+
+// SpotInterruptionHandlerLabel is the label of the nodes the AWS Node Termination Handler runs on
+const SpotInterruptionHandlerLabel = "alpha.eksctl.io/spot-interruption-handler"
+
+// HasSpotInstances returns true if the instances distribution of a nodegroup launches spot instances
+func HasSpotInstances(ng *NodeGroup) bool {
+	if !HasMixedInstances(ng) {
+		return false
+	}
+	onDemandPercentage := ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity
+	return onDemandPercentage != nil && *onDemandPercentage < 100
+}
+
+// HasSpotInterruptionHandler returns true if any nodegroup needs the AWS Node Termination Handler
+func (c *ClusterConfig) HasSpotInterruptionHandler() bool {
+	for _, ng := range c.NodeGroups {
+		if IsEnabled(ng.SpotInterruptionHandler) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateSpotInterruptionHandler(ng *NodeGroup, path string) error {
+	if !IsEnabled(ng.SpotInterruptionHandler) {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) {
+		return fmt.Errorf("%s.spotInterruptionHandler is not supported by Windows nodegroups", path)
+	}
+	if !HasSpotInstances(ng) {
+		return fmt.Errorf("%s.spotInterruptionHandler requires spot instances, set instancesDistribution.onDemandPercentageAboveBaseCapacity below 100", path)
+	}
+	return nil
+}
+
+func validateManagedSpotInterruptionHandler(ng *ManagedNodeGroup, path string) error {
+	if IsEnabled(ng.SpotInterruptionHandler) && !ng.Spot {
+		return fmt.Errorf("%s.spotInterruptionHandler requires spot to be set", path)
+	}
+	return nil
+}
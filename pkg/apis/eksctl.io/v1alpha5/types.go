@@ -14,11 +14,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
 	"github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	"github.com/pkg/errors"
@@ -179,6 +181,7 @@ const (
 	NodeImageFamilyUbuntu2004   = "Ubuntu2004"
 	NodeImageFamilyUbuntu1804   = "Ubuntu1804"
 	NodeImageFamilyBottlerocket = "Bottlerocket"
+	NodeImageFamilyCustom       = "Custom"
 
 	NodeImageFamilyWindowsServer2019CoreContainer = "WindowsServer2019CoreContainer"
 	NodeImageFamilyWindowsServer2019FullContainer = "WindowsServer2019FullContainer"
@@ -191,6 +194,24 @@ const (
 	ContainerRuntimeDockerD    = "dockerd"
 )
 
+// Values for `KubeletCgroupDriver`
+const (
+	KubeletCgroupDriverSystemd  = "systemd"
+	KubeletCgroupDriverCgroupfs = "cgroupfs"
+)
+
+// Values for `NodeNameSource`
+const (
+	// NodeNameSourcePrivateDNSName names nodes after the private DNS name of their instance, e.g. `ip-192-168-1-1.us-west-2.compute.internal`
+	NodeNameSourcePrivateDNSName = "privateDNSName"
+	// NodeNameSourceResourceName names nodes after the ID of their instance, e.g. `i-0123456789abcdef0.us-west-2.compute.internal`
+	NodeNameSourceResourceName = "resourceName"
+)
+
+// DefaultCapacityTypeLabel is the label set to the capacity type of the nodes, `SPOT` or `ON_DEMAND`,
+// the same label as EKS sets on the nodes of managed nodegroups
+const DefaultCapacityTypeLabel = "eks.amazonaws.com/capacityType"
+
 const (
 	// DefaultNodeType is the default instance type to use for nodes
 	DefaultNodeType = "m5.large"
@@ -207,6 +228,9 @@ const (
 	// EksctlVersionTag defines the version of eksctl which is used to provision or update EKS cluster
 	EksctlVersionTag = "alpha.eksctl.io/eksctl-version"
 
+	// RunIDTag defines the tag of the run ID passed with `--run-id`
+	RunIDTag = "alpha.eksctl.io/run-id"
+
 	// ClusterNameTag defines the tag of the cluster name
 	ClusterNameTag = "alpha.eksctl.io/cluster-name"
 
@@ -228,6 +252,10 @@ const (
 	// IAMServiceAccountNameTag defines the tag of the IAM service account name
 	IAMServiceAccountNameTag = "alpha.eksctl.io/iamserviceaccount-name"
 
+	// IAMServiceAccountRoleOnlyTag defines the tag recording whether the role of an IAM service account was created
+	// without a service account, it is missing on the stacks created before it was introduced
+	IAMServiceAccountRoleOnlyTag = "alpha.eksctl.io/iamserviceaccount-role-only"
+
 	// AddonNameTag defines the tag of the IAM service account name
 	AddonNameTag = "alpha.eksctl.io/addon-name"
 
@@ -239,6 +267,10 @@ const (
 
 	EKSNodeGroupNameLabel = "eks.amazonaws.com/nodegroup"
 
+	// LogicalNodeGroupNameLabel defines the label, and tag, of the nodegroup that set `architectures`
+	// and was expanded into a nodegroup per architecture
+	LogicalNodeGroupNameLabel = "alpha.eksctl.io/logical-nodegroup-name"
+
 	// SpotAllocationStrategyLowestPrice defines the ASG spot allocation strategy of lowest-price
 	SpotAllocationStrategyLowestPrice = "lowest-price"
 
@@ -291,6 +323,8 @@ const (
 	NodeVolumeTypeGP3 = "gp3"
 	// NodeVolumeTypeIO1 is Provisioned IOPS SSD
 	NodeVolumeTypeIO1 = "io1"
+	// NodeVolumeTypeIO2 is Provisioned IOPS SSD with higher durability and IOPS per GiB
+	NodeVolumeTypeIO2 = "io2"
 	// NodeVolumeTypeSC1 is Cold HDD
 	NodeVolumeTypeSC1 = "sc1"
 	// NodeVolumeTypeST1 is Throughput Optimized HDD
@@ -311,6 +345,8 @@ const (
 	DefaultNodeVolumeThroughput = 125
 	// DefaultNodeVolumeIO1IOPS defines the default throughput for io1 volumes, set to the min value
 	DefaultNodeVolumeIO1IOPS = 100
+	// DefaultNodeVolumeIO2IOPS defines the default IOPS for io2 volumes, set to the min value
+	DefaultNodeVolumeIO2IOPS = 100
 	// DefaultNodeVolumeGP3IOPS defines the default throughput for gp3, set to the min value
 	DefaultNodeVolumeGP3IOPS = 3000
 )
@@ -452,6 +488,7 @@ func SupportedNodeVolumeTypes() []string {
 		NodeVolumeTypeGP2,
 		NodeVolumeTypeGP3,
 		NodeVolumeTypeIO1,
+		NodeVolumeTypeIO2,
 		NodeVolumeTypeSC1,
 		NodeVolumeTypeST1,
 	}
@@ -464,6 +501,7 @@ func supportedAMIFamilies() []string {
 		NodeImageFamilyUbuntu2004,
 		NodeImageFamilyUbuntu1804,
 		NodeImageFamilyBottlerocket,
+		NodeImageFamilyCustom,
 		NodeImageFamilyWindowsServer2019CoreContainer,
 		NodeImageFamilyWindowsServer2019FullContainer,
 		NodeImageFamilyWindowsServer2004CoreContainer,
@@ -533,10 +571,61 @@ type ClusterMeta struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
+// Values for `IPFamily`
+const (
+	// IPV4Family assigns IPv4 addresses to pods and services
+	IPV4Family = "IPv4"
+	// IPV6Family assigns IPv6 addresses to pods and services
+	IPV6Family = "IPv6"
+)
+
+// IPv6MinimumVersion is the first Kubernetes version for which EKS supports IPv6 clusters
+const IPv6MinimumVersion = Version1_21
+
 // KubernetesNetworkConfig contains cluster networking options
 type KubernetesNetworkConfig struct {
-	// ServiceIPv4CIDR is the CIDR range from where `ClusterIP`s are assigned
+	// IPFamily is the IP family of the addresses assigned to pods and services.
+	// Valid variants are `IPFamily` constants
+	// +optional
+	IPFamily string `json:"ipFamily,omitempty"`
+
+	// ServiceIPv4CIDR is the CIDR range from where `ClusterIP`s are assigned.
+	// It must be a `/12` to `/24` block within one of the private IPv4 ranges
 	ServiceIPv4CIDR string `json:"serviceIPv4CIDR,omitempty"`
+
+	// ServiceIPv6CIDR is the CIDR range from where `ClusterIP`s are assigned in IPv6 clusters. It is
+	// assigned by EKS, and only known from the status of the cluster
+	ServiceIPv6CIDR string `json:"-"`
+}
+
+// IPv6Enabled returns true if the IP family of the cluster is IPv6
+func (k *KubernetesNetworkConfig) IPv6Enabled() bool {
+	return k != nil && strings.EqualFold(k.IPFamily, IPV6Family)
+}
+
+// Values for `SupportType`
+const (
+	// SupportTypeStandard ends support at the end of standard support
+	SupportTypeStandard = "STANDARD"
+	// SupportTypeExtended moves the cluster to extended support, at an additional cost
+	SupportTypeExtended = "EXTENDED"
+)
+
+// Values for `Architecture`
+const (
+	// ArchitectureX8664 defines the x86_64 CPU architecture
+	ArchitectureX8664 = "x86_64"
+	// ArchitectureARM64 defines the arm64 CPU architecture
+	ArchitectureARM64 = "arm64"
+)
+
+// UpgradePolicy holds the support policy of the cluster
+type UpgradePolicy struct {
+	// SupportType is what happens when the Kubernetes version of the cluster
+	// reaches the end of standard support.
+	// Valid variants are `SupportType` constants
+	// +required
+	SupportType string `json:"supportType"`
 }
 
 type EKSCTLCreated string
@@ -588,6 +677,8 @@ type ClusterProvider interface {
 	CloudFormation() cloudformationiface.CloudFormationAPI
 	CloudFormationRoleARN() string
 	CloudFormationDisableRollback() bool
+	DisableAMICache() bool
+	RunID() string
 	ASG() autoscalingiface.AutoScalingAPI
 	EKS() eksiface.EKSAPI
 	EC2() ec2iface.EC2API
@@ -596,14 +687,25 @@ type ClusterProvider interface {
 	STS() stsiface.STSAPI
 	SSM() ssmiface.SSMAPI
 	IAM() iamiface.IAMAPI
+	KMS() kmsiface.KMSAPI
+	EFS() efsiface.EFSAPI
 	CloudTrail() cloudtrailiface.CloudTrailAPI
 	Region() string
 	Profile() string
 	WaitTimeout() time.Duration
+	StackTimeouts() StackTimeouts
 	ConfigProvider() client.ConfigProvider
 	Session() *session.Session
 }
 
+// StackTimeouts holds the maximum waiting times for the creation, update and deletion of
+// CloudFormation stacks. A zero value falls back to ProviderConfig.WaitTimeout
+type StackTimeouts struct {
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
 // ProviderConfig holds global parameters for all interactions with AWS APIs
 type ProviderConfig struct {
 	CloudFormationRoleARN         string
@@ -612,6 +714,30 @@ type ProviderConfig struct {
 	Region      string
 	Profile     string
 	WaitTimeout time.Duration
+
+	// StackTimeouts overrides WaitTimeout for the operations on CloudFormation stacks
+	StackTimeouts StackTimeouts
+
+	// Proxy is the URL of the HTTP(S) proxy used for all AWS and Kubernetes API calls
+	Proxy string
+	// NoProxy lists the hosts that are reached without going through Proxy
+	NoProxy []string
+
+	// MaxRetries is the maximum number of times a failed AWS API call is retried, the default is used if it is nil
+	MaxRetries *int
+	// RetryBaseDelay is the minimum delay before retrying a failed AWS API call
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the exponential backoff between retries of AWS API calls
+	RetryMaxDelay time.Duration
+
+	// DisableAMICache makes AMIs always be resolved, instead of reusing AMIs resolved recently
+	DisableAMICache bool
+
+	// RunID is added as a tag to all the CloudFormation stacks created or updated
+	RunID string
+
+	// AuditCalls records the mutating AWS API calls instead of making them
+	AuditCalls bool
 }
 
 // +genclient
@@ -627,12 +753,27 @@ type ClusterConfig struct {
 	// +optional
 	KubernetesNetworkConfig *KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
 
+	// UpgradePolicy configures the support policy of the cluster
+	// +optional
+	UpgradePolicy *UpgradePolicy `json:"upgradePolicy,omitempty"`
+
+	// CloudFormation configures the capabilities and the stack policy of the stacks created by eksctl
+	// +optional
+	CloudFormation *CloudFormationConfig `json:"cloudFormation,omitempty"`
+
 	// +optional
 	IAM *ClusterIAM `json:"iam,omitempty"`
 
 	// +optional
 	IdentityProviders []IdentityProvider `json:"identityProviders,omitempty"`
 
+	// IAMIdentityMappings are the mappings of IAM roles, users and accounts in the aws-auth ConfigMap.
+	// The ConfigMap is reconciled to match them exactly by `eksctl reconcile`,
+	// apart from the mappings managed by eksctl for nodegroups and Fargate.
+	// See [Manage IAM users and roles](/usage/iam-identity-mappings/)
+	// +optional
+	IAMIdentityMappings []*IAMIdentityMapping `json:"iamIdentityMappings,omitempty"`
+
 	// +optional
 	VPC *ClusterVPC `json:"vpc,omitempty"`
 
@@ -654,6 +795,11 @@ type ClusterConfig struct {
 	// +optional
 	ManagedNodeGroups []*ManagedNodeGroup `json:"managedNodeGroups,omitempty"`
 
+	// NodeGroupDefaults are inherited by all nodegroups and managed nodegroups
+	// unless they set the same fields themselves
+	// +optional
+	NodeGroupDefaults *NodeGroupDefaults `json:"nodeGroupDefaults,omitempty"`
+
 	// +optional
 	FargateProfiles []*FargateProfile `json:"fargateProfiles,omitempty"`
 
@@ -667,6 +813,25 @@ type ClusterConfig struct {
 	// +optional
 	SecretsEncryption *SecretsEncryption `json:"secretsEncryption,omitempty"`
 
+	// CoreDNS holds the configuration of the CoreDNS deployment
+	// +optional
+	CoreDNS *CoreDNSConfig `json:"coreDNS,omitempty"`
+
+	// KubeProxy holds the configuration of the kube-proxy daemonset
+	// +optional
+	KubeProxy *KubeProxyConfig `json:"kubeProxy,omitempty"`
+
+	// AWSNode holds the configuration of the aws-node daemonset, which can be
+	// disabled for clusters that use another CNI plugin.
+	// See [Using another CNI plugin](/usage/vpc-networking/#using-another-cni-plugin)
+	// +optional
+	AWSNode *AWSNodeConfig `json:"awsNode,omitempty"`
+
+	// MetricsServer holds the replicas and high-availability settings of the
+	// `metrics-server` addon, they are preserved when the addon is updated
+	// +optional
+	MetricsServer *MetricsServerConfig `json:"metricsServer,omitempty"`
+
 	Status *ClusterStatus `json:"-"`
 
 	// FLUX V1 DEPRECATION NOTICE. https://github.com/weaveworks/eksctl/issues/2963
@@ -680,13 +845,88 @@ type ClusterConfig struct {
 	GitOps *GitOps `json:"gitops,omitempty"`
 }
 
+// Values for `LocalNVMeMode`
+const (
+	// LocalNVMeModeRAID0 assembles all the instance store volumes into a RAID0 array (default)
+	LocalNVMeModeRAID0 = "raid0"
+	// LocalNVMeModeSingle only uses the first instance store volume
+	LocalNVMeModeSingle = "single"
+)
+
+// DefaultLocalNVMeMountPath is the path at which the instance store volumes are mounted
+const DefaultLocalNVMeMountPath = "/mnt/nvme"
+
+// LocalNVMe holds the configuration of the NVMe instance store volumes of nodes.
+// Instances without instance store volumes are bootstrapped without mounting anything
+type LocalNVMe struct {
+	// Absolute path at which the volumes are mounted
+	// Defaults to `"/mnt/nvme"`
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+
+	// How instances with more than one instance store volume use them.
+	// Valid variants are `LocalNVMeMode` constants
+	// Defaults to `"raid0"`
+	// +optional
+	Mode string `json:"mode,omitempty"`
+}
+
+// EFSMount is an EFS file system mounted on the nodes with the EFS mount helper
+type EFSMount struct {
+	// ID of the file system, e.g. `fs-0123456789abcdef0`
+	// +required
+	FileSystemID string `json:"fileSystemID"`
+
+	// Absolute path at which the file system is mounted
+	// +required
+	MountPath string `json:"mountPath"`
+
+	// Internal fields
+	// MountTargetSecurityGroupIDs are the security groups of the mount targets of the file system,
+	// which are allowed to receive NFS traffic from the nodes
+	MountTargetSecurityGroupIDs []string `json:"-"`
+}
+
+// NodeGroupDNS holds the resolver settings that are added to those of the nodes for kubelet
+type NodeGroupDNS struct {
+	// SearchDomains are appended to the search domains of the nodes, pods with the default
+	// `ClusterFirst` DNS policy get them after the cluster domains
+	// +optional
+	SearchDomains []string `json:"searchDomains,omitempty"`
+
+	// Options are appended to the resolver options of the nodes, e.g. `timeout:2`. Kubelet
+	// only gives them to pods with the `Default` DNS policy
+	// +optional
+	Options []string `json:"options,omitempty"`
+}
+
+// NodeGroupDefaults holds the cluster-wide defaults of nodegroups
+type NodeGroupDefaults struct {
+	// Valid variants are `VolumeType` constants
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+
+	// Only inherited by nodegroups that use the same volume type
+	// +optional
+	VolumeIOPS *int `json:"volumeIOPS,omitempty"`
+
+	// Only inherited by nodegroups that use the same volume type
+	// +optional
+	VolumeThroughput *int `json:"volumeThroughput,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ClusterConfigList is a list of ClusterConfigs
+// ClusterConfigList is a list of ClusterConfigs, used by `eksctl create cluster` to create a fleet of clusters
 type ClusterConfigList struct {
 	metav1.TypeMeta
 	metav1.ListMeta `json:"metadata"`
 
+	// Defaults are merged into each of the items, the fields set in an item take precedence.
+	// Maps are merged key by key, any other value, including lists, replaces the default
+	// +optional
+	Defaults *ClusterConfig `json:"defaults,omitempty"`
+
 	Items []ClusterConfig `json:"items"`
 }
 
@@ -769,11 +1009,15 @@ func (c *ClusterConfig) SetClusterStatus(cluster *eks.Cluster) error {
 
 // NewNodeGroup creates a new NodeGroup, and returns a pointer to it
 func NewNodeGroup() *NodeGroup {
+	var (
+		volumeSize = DefaultNodeVolumeSize
+		volumeType = DefaultNodeVolumeType
+	)
 	return &NodeGroup{
 		NodeGroupBase: &NodeGroupBase{
 			PrivateNetworking: false,
 			InstanceType:      DefaultNodeType,
-			VolumeSize:        &DefaultNodeVolumeSize,
+			VolumeSize:        &volumeSize,
 			IAM: &NodeGroupIAM{
 				WithAddonPolicies: NodeGroupIAMAddonPolicies{
 					ImageBuilder:              Disabled(),
@@ -795,7 +1039,7 @@ func NewNodeGroup() *NodeGroup {
 				Allow:         Disabled(),
 				PublicKeyPath: &DefaultNodeSSHPublicKeyPath,
 			},
-			VolumeType: &DefaultNodeVolumeType,
+			VolumeType: &volumeType,
 			SecurityGroups: &NodeGroupSGs{
 				AttachIDs:  []string{},
 				WithLocal:  Enabled(),
@@ -867,10 +1111,6 @@ type NodeGroup struct {
 	// +optional
 	ASGMetricsCollection []MetricsCollection `json:"asgMetricsCollection,omitempty"`
 
-	// CPUCredits configures [T3 Unlimited](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/burstable-performance-instances-unlimited-mode.html), valid only for T-type instances
-	// +optional
-	CPUCredits *string `json:"cpuCredits,omitempty"`
-
 	// Associate load balancers with auto scaling group
 	// +optional
 	ClassicLoadBalancerNames []string `json:"classicLoadBalancerNames,omitempty"`
@@ -883,6 +1123,12 @@ type NodeGroup struct {
 	// +optional
 	Taints taintsWrapper `json:"taints,omitempty"`
 
+	// StartupTaints are applied to the nodes when they register, along with `taints`, and
+	// are expected to be removed by a controller once the nodes are ready, e.g. by a GPU driver installer.
+	// See [Startup taints](/usage/managing-nodegroups/#startup-taints)
+	// +optional
+	StartupTaints []NodeGroupTaint `json:"startupTaints,omitempty"`
+
 	// UpdateConfig configures how to update NodeGroups.
 	// +optional
 	UpdateConfig *NodeGroupUpdateConfig `json:"updateConfig,omitempty"`
@@ -900,6 +1146,154 @@ type NodeGroup struct {
 	// ContainerRuntime defines the runtime (CRI) to use for containers on the node
 	// +optional
 	ContainerRuntime *string `json:"containerRuntime,omitempty"`
+
+	// KubeletCgroupDriver is the cgroup driver used by the kubelet, it must
+	// match the one used by the container runtime. When unset, the kubelet
+	// uses the driver configured by the bootstrap script of the AMI.
+	// Valid variants are `KubeletCgroupDriver` constants
+	// +optional
+	KubeletCgroupDriver *string `json:"kubeletCgroupDriver,omitempty"`
+
+	// KubeletFeatureGates enables or disables feature gates of the kubelet, they
+	// are merged with the `featureGates` of `kubeletExtraConfig`
+	// +optional
+	KubeletFeatureGates map[string]bool `json:"kubeletFeatureGates,omitempty"`
+
+	// Containerd holds additional containerd configuration, it is only used
+	// when `containerRuntime` is `containerd`
+	// +optional
+	Containerd *ContainerdConfig `json:"containerd,omitempty"`
+
+	// NodeNameSource is what the nodes are named after when they register
+	// with the cluster, kubelet picks the name itself when unset.
+	// See [Node names](/usage/managing-nodegroups/#node-names).
+	// Valid variants are `NodeNameSource` constants
+	// +optional
+	NodeNameSource string `json:"nodeNameSource,omitempty"`
+
+	// CapacityTypeLabel is the key of the label that nodes register with, set to `SPOT` or
+	// `ON_DEMAND` after the lifecycle of their instance. Nodes of nodegroups with a custom AMI do
+	// not get the label.
+	// See [Capacity type label](/usage/spot-instances/#capacity-type-label)
+	// Defaults to `"eks.amazonaws.com/capacityType"`
+	// +optional
+	CapacityTypeLabel string `json:"capacityTypeLabel,omitempty"`
+
+	// SecondaryNetworkInterfaces attaches additional network interfaces to
+	// the nodes at launch, e.g. for appliances that need a network interface
+	// on a separate subnet.
+	// See [Multi-homed nodes](/usage/vpc-networking/#multi-homed-nodes)
+	// +optional
+	SecondaryNetworkInterfaces []SecondaryNetworkInterface `json:"secondaryNetworkInterfaces,omitempty"`
+
+	// AssociatePublicIPAddress overrides whether the nodes are assigned a public IP address,
+	// which otherwise follows the setting of their subnet.
+	// See [Public IP addresses](/usage/vpc-networking/#public-ip-addresses-of-nodes)
+	// +optional
+	AssociatePublicIPAddress *bool `json:"associatePublicIPAddress,omitempty"`
+
+	// WarmPool keeps pre-initialized instances ready to join the nodegroup when it scales out.
+	// See [Warm pools](/usage/autoscaling/#warm-pools)
+	// +optional
+	WarmPool *WarmPool `json:"warmPool,omitempty"`
+
+	// InstanceRefresh replaces the nodes with an instance refresh of the Auto Scaling group
+	// when the AMI of the nodegroup is updated with `eksctl update nodegroup`, rather than
+	// with a rolling update by CloudFormation.
+	// See [Updating the AMI of a nodegroup](/usage/managing-nodegroups/#updating-the-ami-of-a-nodegroup)
+	// +optional
+	InstanceRefresh *InstanceRefresh `json:"instanceRefresh,omitempty"`
+}
+
+// Values for `WarmPoolState`
+const (
+	// WarmPoolStateStopped keeps the instances of the warm pool stopped
+	WarmPoolStateStopped = "Stopped"
+	// WarmPoolStateRunning keeps the instances of the warm pool running
+	WarmPoolStateRunning = "Running"
+	// WarmPoolStateHibernated keeps the instances of the warm pool hibernated, with their memory saved on their root volume
+	WarmPoolStateHibernated = "Hibernated"
+)
+
+// WarmPool holds the configuration of the warm pool of a nodegroup
+type WarmPool struct {
+	// MinSize is the minimum number of instances kept in the warm pool
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+
+	// MaxGroupPreparedCapacity is the maximum number of instances in the warm pool
+	// and in the nodegroup combined, defaults to the maxSize of the nodegroup
+	// +optional
+	MaxGroupPreparedCapacity *int `json:"maxGroupPreparedCapacity,omitempty"`
+
+	// PoolState is the state of the instances in the warm pool.
+	// Valid variants are `WarmPoolState` constants
+	// Defaults to `"Stopped"`
+	// +optional
+	PoolState string `json:"poolState,omitempty"`
+
+	// ReuseOnScaleIn returns the instances of the nodegroup to the warm pool
+	// when it scales in, instead of terminating them
+	// +optional
+	ReuseOnScaleIn *bool `json:"reuseOnScaleIn,omitempty"`
+}
+
+// InstanceRefresh holds the preferences of the instance refreshes of a nodegroup
+type InstanceRefresh struct {
+	// MinHealthyPercentage is the percentage of the desired capacity that must remain
+	// in service while the nodes are replaced, defaults to 90
+	// +optional
+	MinHealthyPercentage *int `json:"minHealthyPercentage,omitempty"`
+
+	// InstanceWarmup is the number of seconds a new node takes to be ready after it
+	// is in service, defaults to the health check grace period of the Auto Scaling group
+	// +optional
+	InstanceWarmup *int `json:"instanceWarmup,omitempty"`
+}
+
+// UserDataPart is a MIME part of the user data of a nodegroup
+type UserDataPart struct {
+	// ContentType is the MIME type of the part, e.g. `text/cloud-config`
+	// +required
+	ContentType string `json:"contentType"`
+
+	// Content is the body of the part
+	// +required
+	Content string `json:"content"`
+}
+
+// SecondaryNetworkInterface is a network interface attached to the nodes of
+// a nodegroup in addition to the primary one
+type SecondaryNetworkInterface struct {
+	// DeviceIndex is the position of the network interface in the attachment
+	// order, it must be greater than 0 as index 0 is the primary network interface
+	// +required
+	DeviceIndex int `json:"deviceIndex"`
+
+	// Subnet is the ID of the subnet of the network interface, it must be in
+	// the availability zone of the nodegroup
+	// +required
+	Subnet string `json:"subnet"`
+
+	// SecurityGroups are the IDs of the security groups of the network
+	// interface. Defaults to the security groups of the primary network interface
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+}
+
+// ContainerdConfig holds the containerd configuration merged into the
+// configuration of the node during bootstrap
+type ContainerdConfig struct {
+	// RegistryMirrors maps registry hosts to the endpoints of their mirrors,
+	// e.g. `docker.io: ["https://mirror.example.com"]`
+	// +optional
+	RegistryMirrors map[string][]string `json:"registryMirrors,omitempty"`
+
+	// ConfigFragment is a TOML fragment of containerd configuration, e.g. to
+	// set `plugins."io.containerd.grpc.v1.cri".sandbox_image`. Registry
+	// mirrors set in `registryMirrors` take precedence over the ones set here
+	// +optional
+	ConfigFragment string `json:"configFragment,omitempty"`
 }
 
 // GetContainerRuntime returns the container runtime.
@@ -910,6 +1304,14 @@ func (n *NodeGroup) GetContainerRuntime() string {
 	return ""
 }
 
+// GetCapacityTypeLabel returns the key of the label set to the capacity type of the nodes
+func (n *NodeGroup) GetCapacityTypeLabel() string {
+	if n.CapacityTypeLabel != "" {
+		return n.CapacityTypeLabel
+	}
+	return DefaultCapacityTypeLabel
+}
+
 func (n *NodeGroup) InstanceTypeList() []string {
 	if HasMixedInstances(n) {
 		return n.InstancesDistribution.InstanceTypes
@@ -922,6 +1324,16 @@ func (n *NodeGroup) NGTaints() []NodeGroupTaint {
 	return n.Taints
 }
 
+// RegisterWithTaints returns the taints the nodes register with, which are the taints and
+// the startup taints of the nodegroup
+func (n *NodeGroup) RegisterWithTaints() []NodeGroupTaint {
+	if len(n.StartupTaints) == 0 {
+		return n.Taints
+	}
+	taints := append([]NodeGroupTaint{}, n.Taints...)
+	return append(taints, n.StartupTaints...)
+}
+
 // BaseNodeGroup implements NodePool
 func (n *NodeGroup) BaseNodeGroup() *NodeGroupBase {
 	return n.NodeGroupBase
@@ -1323,8 +1735,19 @@ type NodeGroupBase struct {
 	// +optional
 	MaxPodsPerNode int `json:"maxPodsPerNode,omitempty"`
 
+	// PrefixDelegation assigns /28 IPv4 prefixes rather than individual addresses
+	// to the network interfaces of the nodes, and enables it in aws-node. Unless
+	// `maxPodsPerNode` is set, it is raised to the number of pods the instance type
+	// can run with prefixes. Requires instance types built on the Nitro System.
+	// See [Prefix delegation](/usage/vpc-networking/#prefix-delegation)
+	// +optional
+	PrefixDelegation *bool `json:"prefixDelegation,omitempty"`
+
+	// ASGSuspendProcesses are suspended on the Auto Scaling group once it is created
+	// and while CloudFormation updates it, e.g. `AZRebalance` or `ReplaceUnhealthy`.
 	// See [relevant AWS
 	// docs](https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-attribute-updatepolicy.html#cfn-attributes-updatepolicy-rollingupdate-suspendprocesses)
+	// and [Suspending Auto Scaling processes](/usage/managing-nodegroups/#suspending-auto-scaling-processes)
 	// +optional
 	ASGSuspendProcesses []string `json:"asgSuspendProcesses,omitempty"`
 
@@ -1356,6 +1779,29 @@ type NodeGroupBase struct {
 	// +optional
 	OverrideBootstrapCommand *string `json:"overrideBootstrapCommand,omitempty"`
 
+	// AdditionalUserDataParts are MIME parts merged with the bootstrap of
+	// `eksctl` into a multipart user data document processed by cloud-init,
+	// e.g. `text/cloud-config` or `text/x-shellscript` parts.
+	// See [Additional user data parts](/usage/managing-nodegroups/#additional-user-data-parts)
+	// +optional
+	AdditionalUserDataParts []UserDataPart `json:"additionalUserDataParts,omitempty"`
+
+	// LocalNVMe formats and mounts the NVMe instance store volumes of the nodes
+	// before they are bootstrapped. See [Instance store volumes](/usage/instance-store-volumes/)
+	// +optional
+	LocalNVMe *LocalNVMe `json:"localNVMe,omitempty"`
+
+	// EFSMounts are the EFS file systems mounted on the nodes before they are bootstrapped.
+	// The security groups of their mount targets are allowed to receive NFS traffic from the nodes.
+	// See [EFS file systems](/usage/efs-mounts/)
+	// +optional
+	EFSMounts []EFSMount `json:"efsMounts,omitempty"`
+
+	// DNS adds search domains and resolver options to the DNS configuration that kubelet
+	// gives pods. See [DNS search domains](/usage/managing-nodegroups/#dns-search-domains)
+	// +optional
+	DNS *NodeGroupDNS `json:"dns,omitempty"`
+
 	// DisableIMDSv1 requires requests to the metadata service to use IMDSv2 tokens
 	// Defaults to `false`
 	// +optional
@@ -1371,14 +1817,49 @@ type NodeGroupBase struct {
 	// +optional
 	Placement *Placement `json:"placement,omitempty"`
 
+	// Where the instances run, `dedicated` instances run on single-tenant hardware and `host`
+	// instances run on the Dedicated Hosts of `hostResourceGroupARN`.
+	// Valid variants are `Tenancy` constants
+	// Defaults to `"default"`
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// HostResourceGroupARN is the ARN of the host resource group whose Dedicated Hosts
+	// the instances are launched on, it must be set with `host` tenancy
+	// +optional
+	HostResourceGroupARN string `json:"hostResourceGroupARN,omitempty"`
+
+	// CPUCredits configures the credit option for CPU usage of [burstable performance
+	// instances](https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/burstable-credits-baseline-concepts.html),
+	// valid only when all the instance types are burstable (T2, T3, T3a and T4g).
+	// Valid variants are `CPUCredits` constants
+	// +optional
+	CPUCredits *string `json:"cpuCredits,omitempty"`
+
 	// EFAEnabled creates the maximum allowed number of EFA-enabled network
-	// cards on nodes in this group.
+	// cards on nodes in this group. A cluster placement group is created
+	// unless `placement` is set, in which case it must refer to a cluster placement group.
 	// +optional
 	EFAEnabled *bool `json:"efaEnabled,omitempty"`
 
+	// SpotInterruptionHandler drains the nodes of spot instances before they are
+	// interrupted. For nodegroups, it installs the AWS Node Termination Handler on
+	// their nodes and requires spot instances in `instancesDistribution`; managed
+	// nodegroups rely on the handling built into EKS and require `spot`.
+	// See [Spot interruption handling](/usage/spot-instances/#spot-interruption-handling)
+	// +optional
+	SpotInterruptionHandler *bool `json:"spotInterruptionHandler,omitempty"`
+
 	// InstanceSelector specifies options for EC2 instance selector
 	InstanceSelector *InstanceSelector `json:"instanceSelector,omitempty"`
 
+	// Architectures expands this nodegroup into a nodegroup per CPU architecture, named
+	// `<name>-<architecture>`, each with the instance types of its architecture and the
+	// AMI for that architecture. Labels, taints and tags are shared by all of them.
+	// Supported architectures are `x86_64` and `arm64`
+	// +optional
+	Architectures []string `json:"architectures,omitempty"`
+
 	// Internal fields
 	// Some AMIs (bottlerocket) have a separate volume for the OS
 	AdditionalEncryptedVolume string `json:"-"`
@@ -1397,6 +1878,24 @@ type NodeGroupBase struct {
 	EnableDetailedMonitoring *bool `json:"enableDetailedMonitoring,omitempty"`
 }
 
+// Values for `Tenancy`
+const (
+	// TenancyDefault runs instances on shared hardware (default)
+	TenancyDefault = "default"
+	// TenancyDedicated runs instances on hardware that is dedicated to the account
+	TenancyDedicated = "dedicated"
+	// TenancyHost runs instances on Dedicated Hosts
+	TenancyHost = "host"
+)
+
+// Values for `CPUCredits`
+const (
+	// CPUCreditsStandard limits the CPU usage of instances to their earned credits
+	CPUCreditsStandard = "standard"
+	// CPUCreditsUnlimited lets instances burst above their baseline for as long as required at additional cost
+	CPUCreditsUnlimited = "unlimited"
+)
+
 // Placement specifies placement group information
 type Placement struct {
 	GroupName string `json:"groupName,omitempty"`
@@ -1547,7 +2046,7 @@ type FargateProfile struct {
 	Tags map[string]string `json:"tags,omitempty"`
 
 	// The current status of the Fargate profile.
-	Status string `json:"status"`
+	Status string `json:"status,omitempty"`
 }
 
 // FargateProfileSelector defines rules to select workload to schedule onto Fargate.
@@ -1562,10 +2061,30 @@ type FargateProfileSelector struct {
 	Labels map[string]string `json:"labels,omitempty"`
 }
 
+// Values for `EncryptedResource`
+const (
+	// EncryptedResourceSecrets encrypts the Kubernetes secrets
+	EncryptedResourceSecrets = "secrets"
+)
+
 // SecretsEncryption defines the configuration for KMS encryption provider
 type SecretsEncryption struct {
 	// +required
 	KeyARN string `json:"keyARN,omitempty"`
+
+	// Resources are the types of the Kubernetes resources encrypted with the key.
+	// Valid entries are `EncryptedResource` constants.
+	// Defaults to `["secrets"]`
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+}
+
+// EncryptedResources returns the types of the resources encrypted with the key
+func (s *SecretsEncryption) EncryptedResources() []string {
+	if len(s.Resources) == 0 {
+		return []string{EncryptedResourceSecrets}
+	}
+	return s.Resources
 }
 
 // PrivateCluster defines the configuration for a fully-private cluster
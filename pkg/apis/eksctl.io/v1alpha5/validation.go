@@ -2,18 +2,23 @@ package v1alpha5
 
 import (
 	"fmt"
+	"mime"
 	"net"
+	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/kris-nova/logger"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/weaveworks/eksctl/pkg/utils"
 	"github.com/weaveworks/eksctl/pkg/utils/taints"
 
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -26,10 +31,20 @@ const (
 	MaxThroughput = 1000
 	MinIO1Iops    = DefaultNodeVolumeIO1IOPS
 	MaxIO1Iops    = 64000
+	MinIO2Iops    = DefaultNodeVolumeIO2IOPS
+	MaxIO2Iops    = 64000
 	MinGP3Iops    = DefaultNodeVolumeGP3IOPS
 	MaxGP3Iops    = 16000
 )
 
+// maxIOPSPerGiB is the maximum ratio of provisioned IOPS to volume size, in GiB, for each volume type
+// https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/ebs-volume-types.html
+var maxIOPSPerGiB = map[string]int{
+	NodeVolumeTypeGP3: 500,
+	NodeVolumeTypeIO1: 50,
+	NodeVolumeTypeIO2: 500,
+}
+
 var (
 	// ErrClusterEndpointNoAccess indicates the config prevents API access
 	ErrClusterEndpointNoAccess = errors.New("Kubernetes API access must have one of public or private clusterEndpoints enabled")
@@ -42,8 +57,14 @@ var (
 		"updates to some AWS resources.  See: " +
 		"https://docs.aws.amazon.com/eks/latest/userguide/cluster-endpoint.html#private-access " +
 		"for more details")
+
+	// ErrSecondaryCIDRsWithExistingVPC indicates secondary CIDRs were set for a VPC not created by eksctl
+	ErrSecondaryCIDRsWithExistingVPC = errors.New("vpc.secondaryCIDRs can only be set when eksctl creates the VPC, associate the CIDRs with an existing VPC and create its subnets instead")
 )
 
+// MaxVPCIPv4CIDRBlocks is the default quota of IPv4 CIDR blocks per VPC, including its primary CIDR
+const MaxVPCIPv4CIDRBlocks = 5
+
 // NOTE: we don't use k8s.io/apimachinery/pkg/util/sets here to keep API package free of dependencies
 type nameSet map[string]struct{}
 
@@ -79,10 +100,38 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		}
 	}
 
+	if err := validateStackTags(cfg.Metadata.Tags); err != nil {
+		return err
+	}
+
+	if err := cfg.validateIAMTags(); err != nil {
+		return err
+	}
+
+	if err := cfg.IAM.validateServiceRoleARN(); err != nil {
+		return err
+	}
+
+	if err := cfg.IAM.validateFargatePodExecutionRolePolicies(); err != nil {
+		return err
+	}
+
+	if err := cfg.IAM.validatePermissionsBoundary(); err != nil {
+		return err
+	}
+
 	if err := cfg.validateKubernetesNetworkConfig(); err != nil {
 		return err
 	}
 
+	if err := cfg.UpgradePolicy.Validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.CloudFormation.Validate(cfg.Metadata.Region); err != nil {
+		return err
+	}
+
 	// names must be unique across both managed and unmanaged nodegroups
 	ngNames := nameSet{}
 	validateNg := func(ng *NodeGroupBase, path string) error {
@@ -102,6 +151,40 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return err
 	}
 
+	if err := ValidateIAMIdentityMappings(cfg.IAMIdentityMappings); err != nil {
+		return err
+	}
+
+	if err := validateNodeGroupDefaults(cfg.NodeGroupDefaults); err != nil {
+		return err
+	}
+
+	if err := cfg.CoreDNS.Validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.KubeProxy.Validate(); err != nil {
+		return err
+	}
+
+	if err := cfg.MetricsServer.Validate(cfg.Addons); err != nil {
+		return err
+	}
+
+	if err := cfg.AWSNode.Validate(cfg); err != nil {
+		return err
+	}
+
+	if err := validatePrefixDelegation(cfg); err != nil {
+		return err
+	}
+
+	for i, addon := range cfg.Addons {
+		if err := addon.validateConfigurationValues(); err != nil {
+			return fmt.Errorf("addons[%d].%w", i, err)
+		}
+	}
+
 	for i, ng := range cfg.NodeGroups {
 		path := fmt.Sprintf("nodeGroups[%d]", i)
 		if err := validateNg(ng.NodeGroupBase, path); err != nil {
@@ -138,6 +221,12 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		cfg.VPC.ExtraCIDRs = cidrs
 	}
 
+	if cfg.VPC != nil && len(cfg.VPC.SecondaryCIDRs) > 0 {
+		if err := cfg.validateSecondaryCIDRs(); err != nil {
+			return err
+		}
+	}
+
 	if cfg.VPC != nil && len(cfg.VPC.PublicAccessCIDRs) > 0 {
 		cidrs, err := validateCIDRs(cfg.VPC.PublicAccessCIDRs)
 		if err != nil {
@@ -146,6 +235,18 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		cfg.VPC.PublicAccessCIDRs = cidrs
 	}
 
+	if cfg.VPC != nil && cfg.VPC.CustomNetworking != nil {
+		if err := validateCustomNetworking(cfg.VPC); err != nil {
+			return err
+		}
+	}
+
+	if cfg.VPC != nil && cfg.VPC.ControlPlaneSecurityGroupRules != nil {
+		if err := validateControlPlaneSecurityGroupRules(cfg.VPC.ControlPlaneSecurityGroupRules); err != nil {
+			return err
+		}
+	}
+
 	if cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN == "" {
 		return errors.New("field secretsEncryption.keyARN is required for enabling secrets encryption")
 	}
@@ -158,6 +259,27 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 	return nil
 }
 
+// validateControlPlaneSecurityGroupRules validates the port ranges and warns if the ports required
+// for a functional cluster are not open
+func validateControlPlaneSecurityGroupRules(rules *ControlPlaneSecurityGroupRules) error {
+	if len(rules.NodePorts) == 0 {
+		return errors.New("vpc.controlPlaneSecurityGroupRules.nodePorts must contain at least one port range")
+	}
+	for i, pr := range rules.NodePorts {
+		if pr.FromPort < 1 || pr.ToPort > 65535 || pr.FromPort > pr.ToPort {
+			return fmt.Errorf("vpc.controlPlaneSecurityGroupRules.nodePorts[%d]: invalid port range %d-%d", i, pr.FromPort, pr.ToPort)
+		}
+	}
+
+	if !rules.HasNodePort(10250) {
+		logger.Warning("vpc.controlPlaneSecurityGroupRules.nodePorts does not include the kubelet port 10250, the control plane will not be able to reach kubelet and commands like `kubectl logs` and `kubectl exec` will not work")
+	}
+	if !rules.HasNodePort(443) {
+		logger.Warning("vpc.controlPlaneSecurityGroupRules.nodePorts does not include port 443, the control plane will not be able to reach extension API servers and webhooks running on nodes")
+	}
+	return nil
+}
+
 // ValidateClusterEndpointConfig checks the endpoint configuration for potential issues
 func (c *ClusterConfig) ValidateClusterEndpointConfig() error {
 	if !c.HasClusterEndpointAccess() {
@@ -191,17 +313,291 @@ func (c *ClusterConfig) ValidatePrivateCluster() error {
 	return nil
 }
 
+// validateFargatePodExecutionRolePolicies validates the policies attached to the fargate pod execution role
+func (iam *ClusterIAM) validateFargatePodExecutionRolePolicies() error {
+	if len(iam.FargatePodExecutionRoleAttachPolicyARNs) == 0 && iam.FargatePodExecutionRoleAttachPolicy == nil {
+		return nil
+	}
+	if IsSetAndNonEmptyString(iam.FargatePodExecutionRoleARN) {
+		return errors.New("iam.fargatePodExecutionRoleAttachPolicyARNs and iam.fargatePodExecutionRoleAttachPolicy cannot be set with iam.fargatePodExecutionRoleARN, " +
+			"policies are only attached to the fargate pod execution role created by eksctl")
+	}
+	for _, policyARN := range iam.FargatePodExecutionRoleAttachPolicyARNs {
+		parsed, err := arn.Parse(policyARN)
+		if err != nil {
+			return errors.Wrapf(err, "invalid ARN %q in iam.fargatePodExecutionRoleAttachPolicyARNs", policyARN)
+		}
+		if parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "policy/") {
+			return fmt.Errorf("invalid ARN %q in iam.fargatePodExecutionRoleAttachPolicyARNs: not an IAM policy ARN", policyARN)
+		}
+	}
+	return nil
+}
+
+func validateCustomNetworking(vpc *ClusterVPC) error {
+	customNetworking := vpc.CustomNetworking
+	if len(customNetworking.PodSubnets) == 0 && len(vpc.SecondaryCIDRs) == 0 {
+		return errors.New("vpc.customNetworking.podSubnets must be set, or vpc.secondaryCIDRs for the pods to be placed in subnets created by eksctl")
+	}
+	for az, subnetID := range customNetworking.PodSubnets {
+		if az == "" {
+			return errors.New("vpc.customNetworking.podSubnets cannot contain an empty availability zone")
+		}
+		if !strings.HasPrefix(subnetID, "subnet-") {
+			return fmt.Errorf("invalid subnet ID %q for availability zone %q in vpc.customNetworking.podSubnets", subnetID, az)
+		}
+	}
+	for _, sg := range customNetworking.SecurityGroups {
+		if !strings.HasPrefix(sg, "sg-") {
+			return fmt.Errorf("invalid security group ID %q in vpc.customNetworking.securityGroups", sg)
+		}
+	}
+	return nil
+}
+
+// validateSecondaryCIDRs checks that the secondary CIDRs can be associated with the VPC created by eksctl,
+// and normalizes them
+func (c *ClusterConfig) validateSecondaryCIDRs() error {
+	if c.VPC.ID != "" {
+		return ErrSecondaryCIDRsWithExistingVPC
+	}
+	if c.KubernetesNetworkConfig.IPv6Enabled() {
+		return errors.New("vpc.secondaryCIDRs is not supported with the IPv6 IP family, as pods get IPv6 addresses")
+	}
+	if len(c.VPC.SecondaryCIDRs) > MaxVPCIPv4CIDRBlocks-1 {
+		return fmt.Errorf("vpc.secondaryCIDRs can contain at most %d CIDRs, as a VPC can have at most %d IPv4 CIDR blocks by default, including its primary CIDR", MaxVPCIPv4CIDRBlocks-1, MaxVPCIPv4CIDRBlocks)
+	}
+
+	var vpcCIDRs []*net.IPNet
+	if c.VPC.CIDR != nil {
+		vpcCIDRs = append(vpcCIDRs, &c.VPC.CIDR.IPNet)
+	}
+	for i, cidr := range c.VPC.SecondaryCIDRs {
+		ip, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid CIDR in vpc.secondaryCIDRs[%d]", i)
+		}
+		if ip.To4() == nil {
+			return fmt.Errorf("vpc.secondaryCIDRs[%d] %q must be an IPv4 CIDR", i, cidr)
+		}
+		if ones, _ := ipNet.Mask.Size(); ones < 16 || ones > 28 {
+			return fmt.Errorf("vpc.secondaryCIDRs[%d] %q must be a /16 to /28 block", i, cidr)
+		}
+		for _, vpcCIDR := range vpcCIDRs {
+			if cidrsOverlap(vpcCIDR, ipNet) {
+				return fmt.Errorf("vpc.secondaryCIDRs[%d] %q overlaps with the VPC CIDR %q", i, cidr, vpcCIDR.String())
+			}
+		}
+		if c.VPC.CIDR != nil {
+			// VPCs cannot have CIDRs from more than one of the private address ranges
+			primaryRange, secondaryRange := privateIPv4Range(&c.VPC.CIDR.IPNet), privateIPv4Range(ipNet)
+			if primaryRange != nil && secondaryRange != nil && primaryRange != secondaryRange {
+				return fmt.Errorf("vpc.secondaryCIDRs[%d] %q is in the %s range, which cannot be associated with a VPC whose CIDR %q is in the %s range", i, cidr, secondaryRange, c.VPC.CIDR.String(), primaryRange)
+			}
+		}
+		vpcCIDRs = append(vpcCIDRs, ipNet)
+		c.VPC.SecondaryCIDRs[i] = ipNet.String()
+	}
+	return nil
+}
+
+func (iam *ClusterIAM) validateServiceRoleARN() error {
+	if !IsSetAndNonEmptyString(iam.ServiceRoleARN) {
+		return nil
+	}
+	parsed, err := arn.Parse(*iam.ServiceRoleARN)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ARN %q in iam.serviceRoleARN", *iam.ServiceRoleARN)
+	}
+	if parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return fmt.Errorf("invalid ARN %q in iam.serviceRoleARN: not an IAM role ARN", *iam.ServiceRoleARN)
+	}
+	return nil
+}
+
+func (iam *ClusterIAM) validatePermissionsBoundary() error {
+	if iam.PermissionsBoundary == "" {
+		return nil
+	}
+	parsed, err := arn.Parse(iam.PermissionsBoundary)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ARN %q in iam.permissionsBoundary", iam.PermissionsBoundary)
+	}
+	if parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "policy/") {
+		return fmt.Errorf("invalid ARN %q in iam.permissionsBoundary: not an IAM policy ARN", iam.PermissionsBoundary)
+	}
+	return nil
+}
+
 // validateKubernetesNetworkConfig validates the network config
 func (c *ClusterConfig) validateKubernetesNetworkConfig() error {
-	if c.KubernetesNetworkConfig != nil {
-		serviceIP := c.KubernetesNetworkConfig.ServiceIPv4CIDR
-		if _, _, err := net.ParseCIDR(serviceIP); serviceIP != "" && err != nil {
-			return errors.Wrap(err, "invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR")
+	if err := c.validateIPFamily(); err != nil {
+		return err
+	}
+	return c.ValidateServiceIPv4CIDR()
+}
+
+// parseIPv4NetworkCIDR parses an IPv4 CIDR given as its network address, e.g. `10.96.0.0/12` rather than `10.100.0.0/12`
+func parseIPv4NetworkCIDR(path, cidr string) (*net.IPNet, error) {
+	ip, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid IPv4 CIDR for %s", path)
+	}
+	if ipNet.IP.To4() == nil {
+		return nil, fmt.Errorf("%s must be an IPv4 CIDR, got %q", path, cidr)
+	}
+	if !ip.Equal(ipNet.IP) {
+		return nil, fmt.Errorf("%s %q is not the network address of its range, use %q", path, cidr, ipNet.String())
+	}
+	return ipNet, nil
+}
+
+func (c *ClusterConfig) validateIPFamily() error {
+	if c.KubernetesNetworkConfig == nil || c.KubernetesNetworkConfig.IPFamily == "" {
+		return nil
+	}
+	ipFamily := c.KubernetesNetworkConfig.IPFamily
+	if !strings.EqualFold(ipFamily, IPV4Family) && !strings.EqualFold(ipFamily, IPV6Family) {
+		return fmt.Errorf("invalid value %q for kubernetesNetworkConfig.ipFamily: must be either %q or %q", ipFamily, IPV4Family, IPV6Family)
+	}
+	if !c.KubernetesNetworkConfig.IPv6Enabled() {
+		return nil
+	}
+
+	if c.KubernetesNetworkConfig.ServiceIPv4CIDR != "" {
+		return errors.New("kubernetesNetworkConfig.serviceIPv4CIDR cannot be set for IPv6 clusters, as EKS assigns the service IPv6 CIDR")
+	}
+	if c.Metadata.Version != "" && c.Metadata.Version != "auto" {
+		supported, err := utils.IsMinVersion(IPv6MinimumVersion, c.Metadata.Version)
+		if err != nil {
+			return errors.Wrapf(err, "checking if IPv6 is supported for Kubernetes version %s", c.Metadata.Version)
+		}
+		if !supported {
+			return fmt.Errorf("IPv6 clusters are only supported for Kubernetes version %s and above, got %s", IPv6MinimumVersion, c.Metadata.Version)
+		}
+	}
+	if c.VPC != nil && c.VPC.ID == "" && !c.HasAnySubnets() && !IsEnabled(c.VPC.AutoAllocateIPv6) {
+		return errors.New("vpc.autoAllocateIPv6 must be enabled for IPv6 clusters when eksctl creates the VPC")
+	}
+	for i, ng := range c.NodeGroups {
+		if IsWindowsImage(ng.AMIFamily) {
+			return fmt.Errorf("nodeGroups[%d]: Windows nodegroups are not supported in IPv6 clusters", i)
+		}
+	}
+	return nil
+}
+
+// Validate validates the types of the resources encrypted with the key
+func (s *SecretsEncryption) Validate() error {
+	if s == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	for _, resource := range s.Resources {
+		if resource != EncryptedResourceSecrets {
+			return fmt.Errorf("invalid resource %q in secretsEncryption.resources, valid resources are: %s", resource, EncryptedResourceSecrets)
+		}
+		if seen[resource] {
+			return fmt.Errorf("secretsEncryption.resources contains %q more than once", resource)
+		}
+		seen[resource] = true
+	}
+	return nil
+}
+
+// Validate checks that the support type of the upgrade policy is known to EKS
+func (u *UpgradePolicy) Validate() error {
+	if u == nil {
+		return nil
+	}
+	switch u.SupportType {
+	case SupportTypeStandard, SupportTypeExtended:
+		return nil
+	case "":
+		return errors.New("upgradePolicy.supportType must be set")
+	default:
+		return fmt.Errorf("invalid value %q for upgradePolicy.supportType: must be either %q or %q", u.SupportType, SupportTypeStandard, SupportTypeExtended)
+	}
+}
+
+// ValidateServiceIPv4CIDR checks that kubernetesNetworkConfig.serviceIPv4CIDR is accepted by EKS: it must be
+// a /12 to /24 block within one of the private IPv4 ranges, and must not overlap any of the VPC CIDRs,
+// including the secondary ones. It should be called again once the CIDRs of an existing VPC are known
+func (c *ClusterConfig) ValidateServiceIPv4CIDR() error {
+	if c.KubernetesNetworkConfig == nil || c.KubernetesNetworkConfig.ServiceIPv4CIDR == "" {
+		return nil
+	}
+
+	serviceIP := c.KubernetesNetworkConfig.ServiceIPv4CIDR
+	serviceCIDR, err := parseIPv4NetworkCIDR("kubernetesNetworkConfig.serviceIPv4CIDR", serviceIP)
+	if err != nil {
+		return err
+	}
+	if ones, _ := serviceCIDR.Mask.Size(); ones < 12 || ones > 24 {
+		return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR must have a prefix length between /12 and /24, got %q", serviceIP)
+	}
+
+	if privateIPv4Range(serviceCIDR) == nil {
+		var ranges []string
+		for _, r := range privateIPv4Ranges {
+			ranges = append(ranges, r.String())
+		}
+		return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR %q must be within one of %s", serviceIP, strings.Join(ranges, ", "))
+	}
+
+	if c.VPC == nil {
+		return nil
+	}
+	var vpcCIDRs []string
+	if c.VPC.CIDR != nil {
+		vpcCIDRs = append(vpcCIDRs, c.VPC.CIDR.String())
+	}
+	vpcCIDRs = append(vpcCIDRs, c.VPC.ExtraCIDRs...)
+	vpcCIDRs = append(vpcCIDRs, c.VPC.SecondaryCIDRs...)
+	for _, vpcCIDR := range vpcCIDRs {
+		_, vpcNet, err := net.ParseCIDR(vpcCIDR)
+		if err != nil {
+			continue
+		}
+		if cidrsOverlap(vpcNet, serviceCIDR) {
+			return fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR %q must not overlap with the VPC CIDR %q", serviceIP, vpcCIDR)
+		}
+	}
+	return nil
+}
+
+// privateIPv4Ranges are the private address ranges of RFC 1918, from which EKS also accepts a service IPv4 CIDR
+var privateIPv4Ranges = func() []*net.IPNet {
+	var ranges []*net.IPNet
+	for _, cidr := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"} {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		ranges = append(ranges, ipNet)
+	}
+	return ranges
+}()
+
+// privateIPv4Range returns the private address range of RFC 1918 that contains the CIDR, if any
+func privateIPv4Range(cidr *net.IPNet) *net.IPNet {
+	for _, r := range privateIPv4Ranges {
+		if cidrContains(r, cidr) {
+			return r
 		}
 	}
 	return nil
 }
 
+// cidrsOverlap returns true if the CIDRs have addresses in common, which is when one of them contains the other
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return cidrContains(a, b) || cidrContains(b, a)
+}
+
+// cidrContains returns true if inner is a subset of outer
+func cidrContains(outer, inner *net.IPNet) bool {
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
 // NoAccess returns true if neither public are private cluster endpoint access is enabled and false otherwise
 func noAccess(ces *ClusterEndpoints) bool {
 	return !(*ces.PublicAccess || *ces.PrivateAccess)
@@ -232,6 +628,26 @@ func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 		return err
 	}
 
+	if err := validateLocalNVMe(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateEFSMounts(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateNodeDNS(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateUserDataParts(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateASGSuspendProcesses(ng, path); err != nil {
+		return err
+	}
+
 	if ng.VolumeEncrypted == nil || IsDisabled(ng.VolumeEncrypted) {
 		if IsSetAndNonEmptyString(ng.VolumeKmsKeyID) {
 			return fmt.Errorf("%s.volumeKmsKeyID can not be set without %s.volumeEncrypted enabled explicitly", path, path)
@@ -241,6 +657,10 @@ func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 		return fmt.Errorf("%s.maxPodsPerNode cannot be negative", path)
 	}
 
+	if IsEnabled(ng.PrefixDelegation) && IsWindowsImage(ng.AMIFamily) {
+		return fmt.Errorf("%s.prefixDelegation is not supported by Windows nodegroups", path)
+	}
+
 	if IsEnabled(ng.DisablePodIMDS) && ng.IAM != nil {
 		fmtFieldConflictErr := func(_ string) error {
 			return fmt.Errorf("%s.disablePodIMDS and %s.iam.withAddonPolicies cannot be set at the same time", path, path)
@@ -259,56 +679,323 @@ func validateNodeGroupBase(ng *NodeGroupBase, path string) error {
 			return fmt.Errorf("%s.placement.groupName must be set and non-empty", path)
 		}
 	}
-
-	if IsEnabled(ng.EFAEnabled) {
-		if len(ng.AvailabilityZones) > 1 || len(ng.Subnets) > 1 {
-			return fmt.Errorf("%s.efaEnabled nodegroups must have only one subnet or one availability zone", path)
+
+	if err := validateTenancy(ng, path); err != nil {
+		return err
+	}
+
+	if IsEnabled(ng.EFAEnabled) {
+		if len(ng.AvailabilityZones) > 1 || len(ng.Subnets) > 1 {
+			return fmt.Errorf("%s.efaEnabled nodegroups must have only one subnet or one availability zone", path)
+		}
+	}
+
+	if ng.AMIFamily != "" && !isSupportedAMIFamily(ng.AMIFamily) {
+		return fmt.Errorf("AMI Family %s is not supported - use one of: %s", ng.AMIFamily, strings.Join(supportedAMIFamilies(), ", "))
+	}
+
+	if ng.SSH != nil {
+		if enableSSM := ng.SSH.EnableSSM; enableSSM != nil {
+			if !*enableSSM {
+				return errors.New("SSM agent is now built into EKS AMIs and cannot be disabled")
+			}
+			logger.Warning("SSM is now enabled by default; `ssh.enableSSM` is deprecated and will be removed in a future release")
+		}
+	}
+
+	return nil
+}
+
+func validateSecondaryNetworkInterfaces(ng *NodeGroup, path string) error {
+	if len(ng.SecondaryNetworkInterfaces) == 0 {
+		return nil
+	}
+	if IsEnabled(ng.EFAEnabled) {
+		return fmt.Errorf("%s.secondaryNetworkInterfaces cannot be used with %s.efaEnabled", path, path)
+	}
+	if len(ng.AvailabilityZones) != 1 && len(ng.Subnets) != 1 {
+		return fmt.Errorf("%s.secondaryNetworkInterfaces requires the nodegroup to have only one subnet or one availability zone, as network interfaces cannot span availability zones", path)
+	}
+	// EC2 does not assign a public IP address to instances launched with more than one network interface
+	if !ng.PrivateNetworking {
+		return fmt.Errorf("%s.secondaryNetworkInterfaces requires %s.privateNetworking, as instances with multiple network interfaces are not assigned a public IP address", path, path)
+	}
+
+	deviceIndices := map[int]bool{}
+	for i, ni := range ng.SecondaryNetworkInterfaces {
+		niPath := fmt.Sprintf("%s.secondaryNetworkInterfaces[%d]", path, i)
+		if ni.DeviceIndex < 1 {
+			return fmt.Errorf("%s.deviceIndex must be greater than 0, as device index 0 is the primary network interface", niPath)
+		}
+		if deviceIndices[ni.DeviceIndex] {
+			return fmt.Errorf("%s.deviceIndex %d is used by another network interface", niPath, ni.DeviceIndex)
+		}
+		deviceIndices[ni.DeviceIndex] = true
+		if !strings.HasPrefix(ni.Subnet, "subnet-") {
+			return fmt.Errorf("%s.subnet must be set to a subnet ID", niPath)
+		}
+	}
+	return nil
+}
+
+func validateAssociatePublicIPAddress(ng *NodeGroup, path string) error {
+	if ng.AssociatePublicIPAddress == nil {
+		return nil
+	}
+	// EC2 only assigns public IP addresses to instances launched with a single network interface
+	if len(ng.SecondaryNetworkInterfaces) > 0 {
+		return fmt.Errorf("%s.associatePublicIPAddress cannot be used with %s.secondaryNetworkInterfaces", path, path)
+	}
+	switch associate := *ng.AssociatePublicIPAddress; {
+	case associate && ng.PrivateNetworking:
+		logger.Warning("%s.associatePublicIPAddress is enabled with %s.privateNetworking, the nodes will be assigned public IP addresses in private subnets that have no route to an internet gateway", path, path)
+	case !associate && !ng.PrivateNetworking:
+		logger.Warning("%s.associatePublicIPAddress is disabled in public subnets, the nodes will need a NAT gateway or VPC endpoints to join the cluster", path)
+	}
+	return nil
+}
+
+func validateVolumeOpts(ng *NodeGroupBase, path string) error {
+	if ng.VolumeType != nil {
+		if _, ok := maxIOPSPerGiB[*ng.VolumeType]; ng.VolumeIOPS != nil && !ok {
+			return fmt.Errorf("%s.volumeIOPS is only supported for %s, %s and %s volume types", path, NodeVolumeTypeIO1, NodeVolumeTypeIO2, NodeVolumeTypeGP3)
+		}
+
+		if *ng.VolumeType == NodeVolumeTypeIO1 {
+			if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinIO1Iops && *ng.VolumeIOPS <= MaxIO1Iops) {
+				return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinIO1Iops, MaxIO1Iops)
+			}
+		}
+
+		if *ng.VolumeType == NodeVolumeTypeIO2 {
+			if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinIO2Iops && *ng.VolumeIOPS <= MaxIO2Iops) {
+				return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinIO2Iops, MaxIO2Iops)
+			}
+		}
+
+		if ng.VolumeThroughput != nil && *ng.VolumeType != NodeVolumeTypeGP3 {
+			return fmt.Errorf("%s.volumeThroughput is only supported for %s volume type", path, NodeVolumeTypeGP3)
+		}
+	}
+
+	if ng.VolumeType == nil || *ng.VolumeType == NodeVolumeTypeGP3 {
+		if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinGP3Iops && *ng.VolumeIOPS <= MaxGP3Iops) {
+			return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinGP3Iops, MaxGP3Iops)
+		}
+
+		if ng.VolumeThroughput != nil && !(*ng.VolumeThroughput >= MinThroughput && *ng.VolumeThroughput <= MaxThroughput) {
+			return fmt.Errorf("value for %s.volumeThroughput must be within range %d-%d", path, MinThroughput, MaxThroughput)
+		}
+	}
+
+	return validateIOPSPerGiB(ng, path)
+}
+
+// mountPathRegex only allows paths that can be used in the bootstrap scripts without quoting
+var mountPathRegex = regexp.MustCompile(`^(/[a-zA-Z0-9._-]+)+$`)
+
+func validateLocalNVMe(ng *NodeGroupBase, path string) error {
+	if ng.LocalNVMe == nil {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket {
+		return &unsupportedFieldError{
+			ng:    ng,
+			path:  path,
+			field: "localNVMe",
+		}
+	}
+	switch ng.LocalNVMe.Mode {
+	case "", LocalNVMeModeRAID0, LocalNVMeModeSingle:
+	default:
+		return fmt.Errorf("invalid value %q for %s.localNVMe.mode, valid options: %s, %s", ng.LocalNVMe.Mode, path, LocalNVMeModeRAID0, LocalNVMeModeSingle)
+	}
+	if mountPath := ng.LocalNVMe.MountPath; mountPath != "" && !mountPathRegex.MatchString(mountPath) {
+		return fmt.Errorf("invalid value %q for %s.localNVMe.mountPath: must be an absolute path made of alphanumeric characters, '.', '_' and '-'", mountPath, path)
+	}
+	return nil
+}
+
+var efsFileSystemIDRegex = regexp.MustCompile(`^fs-[0-9a-f]{8,40}$`)
+
+func validateEFSMounts(ng *NodeGroupBase, path string) error {
+	if len(ng.EFSMounts) == 0 {
+		return nil
+	}
+	// the mount helper is installed with yum, which only the Amazon Linux based AMIs have
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket || ng.AMIFamily == NodeImageFamilyUbuntu2004 || ng.AMIFamily == NodeImageFamilyUbuntu1804 {
+		return &unsupportedFieldError{
+			ng:    ng,
+			path:  path,
+			field: "efsMounts",
+		}
+	}
+	mountPaths := map[string]bool{}
+	if ng.LocalNVMe != nil {
+		mountPaths[ng.LocalNVMe.MountPath] = true
+	}
+	for i, mount := range ng.EFSMounts {
+		mountPath := fmt.Sprintf("%s.efsMounts[%d]", path, i)
+		if !efsFileSystemIDRegex.MatchString(mount.FileSystemID) {
+			return fmt.Errorf("invalid value %q for %s.fileSystemID: must be the ID of an EFS file system, e.g. fs-0123456789abcdef0", mount.FileSystemID, mountPath)
+		}
+		if !mountPathRegex.MatchString(mount.MountPath) {
+			return fmt.Errorf("invalid value %q for %s.mountPath: must be an absolute path made of alphanumeric characters, '.', '_' and '-'", mount.MountPath, mountPath)
+		}
+		if mountPaths[mount.MountPath] {
+			return fmt.Errorf("%s.mountPath: %q is already used by another mount of the nodegroup", mountPath, mount.MountPath)
+		}
+		mountPaths[mount.MountPath] = true
+	}
+	return nil
+}
+
+// maxSearchDomains is the number of search domains the resolver of glibc supported until 2.26
+const maxSearchDomains = 6
+
+// resolverOptionRegex matches the resolver options of resolv.conf(5), those that take a value only
+// accept a number
+var resolverOptionRegex = regexp.MustCompile(`^((ndots|timeout|attempts):[0-9]+|debug|rotate|no-check-names|inet6|edns0|single-request|single-request-reopen|no-tld-query|use-vc|no-reload|trust-ad)$`)
+
+func validateNodeDNS(ng *NodeGroupBase, path string) error {
+	if ng.DNS == nil {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket {
+		return &unsupportedFieldError{
+			ng:    ng,
+			path:  path,
+			field: "dns",
+		}
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%[1]s.dns cannot be set with %[1]s.overrideBootstrapCommand, as kubelet is configured by the bootstrap command", path)
+	}
+	if len(ng.DNS.SearchDomains) == 0 && len(ng.DNS.Options) == 0 {
+		return fmt.Errorf("at least one of %[1]s.dns.searchDomains or %[1]s.dns.options must be set", path)
+	}
+	if len(ng.DNS.SearchDomains) > maxSearchDomains {
+		return fmt.Errorf("%s.dns.searchDomains can have at most %d domains, got %d", path, maxSearchDomains, len(ng.DNS.SearchDomains))
+	}
+	for i, domain := range ng.DNS.SearchDomains {
+		if errs := validation.IsDNS1123Subdomain(strings.ToLower(strings.TrimSuffix(domain, "."))); len(errs) > 0 {
+			return fmt.Errorf("invalid value %q for %s.dns.searchDomains[%d]: %s", domain, path, i, strings.Join(errs, "; "))
+		}
+	}
+	for i, option := range ng.DNS.Options {
+		if !resolverOptionRegex.MatchString(option) {
+			return fmt.Errorf("invalid value %q for %s.dns.options[%d]: must be a resolver option of resolv.conf, e.g. timeout:2 or rotate", option, path, i)
 		}
 	}
+	return nil
+}
 
-	if ng.AMIFamily != "" && !isSupportedAMIFamily(ng.AMIFamily) {
-		return fmt.Errorf("AMI Family %s is not supported - use one of: %s", ng.AMIFamily, strings.Join(supportedAMIFamilies(), ", "))
-	}
+// MaxUserDataSize is the maximum size in bytes of the user data of an EC2 instance, before it is base64-encoded
+const MaxUserDataSize = 16384
 
-	if ng.SSH != nil {
-		if enableSSM := ng.SSH.EnableSSM; enableSSM != nil {
-			if !*enableSSM {
-				return errors.New("SSM agent is now built into EKS AMIs and cannot be disabled")
-			}
-			logger.Warning("SSM is now enabled by default; `ssh.enableSSM` is deprecated and will be removed in a future release")
+func validateUserDataParts(ng *NodeGroupBase, path string) error {
+	if len(ng.AdditionalUserDataParts) == 0 {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket {
+		return &unsupportedFieldError{
+			ng:    ng,
+			path:  path,
+			field: "additionalUserDataParts",
+		}
+	}
+	for i, part := range ng.AdditionalUserDataParts {
+		partPath := fmt.Sprintf("%s.additionalUserDataParts[%d]", path, i)
+		if part.ContentType == "" {
+			return fmt.Errorf("%s.contentType must be set", partPath)
+		}
+		if _, _, err := mime.ParseMediaType(part.ContentType); err != nil {
+			return errors.Wrapf(err, "invalid value %q for %s.contentType", part.ContentType, partPath)
+		}
+		if strings.HasPrefix(part.ContentType, "multipart/") {
+			return fmt.Errorf("invalid value %q for %s.contentType: parts cannot be multipart documents", part.ContentType, partPath)
+		}
+		if part.Content == "" {
+			return fmt.Errorf("%s.content must be set", partPath)
 		}
 	}
-
 	return nil
 }
 
-func validateVolumeOpts(ng *NodeGroupBase, path string) error {
-	if ng.VolumeType != nil {
-		if ng.VolumeIOPS != nil && !(*ng.VolumeType == NodeVolumeTypeIO1 || *ng.VolumeType == NodeVolumeTypeGP3) {
-			return fmt.Errorf("%s.volumeIOPS is only supported for %s and %s volume types", path, NodeVolumeTypeIO1, NodeVolumeTypeGP3)
-		}
+// Limits of CloudFormation stack tags
+const (
+	maxStackTagKeyLength   = 128
+	maxStackTagValueLength = 256
+)
 
-		if *ng.VolumeType == NodeVolumeTypeIO1 {
-			if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinIO1Iops && *ng.VolumeIOPS <= MaxIO1Iops) {
-				return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinIO1Iops, MaxIO1Iops)
-			}
-		}
+// stackTagValueRegex matches the characters allowed in tag values by CloudFormation
+var stackTagValueRegex = regexp.MustCompile(`^[\p{L}\p{Z}\p{N}_.:/=+\-@]*$`)
 
-		if ng.VolumeThroughput != nil && *ng.VolumeType != NodeVolumeTypeGP3 {
-			return fmt.Errorf("%s.volumeThroughput is only supported for %s volume type", path, NodeVolumeTypeGP3)
-		}
+// ValidateRunID validates the run ID used as the value of the RunIDTag stack tag
+func ValidateRunID(runID string) error {
+	if runID == "" {
+		return nil
+	}
+	if n := utf8.RuneCountInString(runID); n > maxStackTagValueLength {
+		return fmt.Errorf("--run-id must be at most %d characters long, got %d", maxStackTagValueLength, n)
 	}
+	if !stackTagValueRegex.MatchString(runID) {
+		return fmt.Errorf("invalid --run-id %q: only letters, numbers, spaces and the characters _ . : / = + - @ are allowed", runID)
+	}
+	return nil
+}
 
-	if ng.VolumeType == nil || *ng.VolumeType == NodeVolumeTypeGP3 {
-		if ng.VolumeIOPS != nil && !(*ng.VolumeIOPS >= MinGP3Iops && *ng.VolumeIOPS <= MaxGP3Iops) {
-			return fmt.Errorf("value for %s.volumeIOPS must be within range %d-%d", path, MinGP3Iops, MaxGP3Iops)
+// validateStackTags checks that metadata.tags can be used as CloudFormation stack tags
+func validateStackTags(tags map[string]string) error {
+	for key, value := range tags {
+		if n := utf8.RuneCountInString(key); n == 0 || n > maxStackTagKeyLength {
+			return fmt.Errorf("invalid key %q in metadata.tags: must be 1-%d characters long", key, maxStackTagKeyLength)
 		}
+		if utf8.RuneCountInString(value) > maxStackTagValueLength {
+			return fmt.Errorf("invalid value for metadata.tags.%s: must be at most %d characters long", key, maxStackTagValueLength)
+		}
+	}
+	return nil
+}
 
-		if ng.VolumeThroughput != nil && !(*ng.VolumeThroughput >= MinThroughput && *ng.VolumeThroughput <= MaxThroughput) {
-			return fmt.Errorf("value for %s.volumeThroughput must be within range %d-%d", path, MinThroughput, MaxThroughput)
+func validateNodeGroupDefaults(defaults *NodeGroupDefaults) error {
+	if defaults == nil {
+		return nil
+	}
+	if defaults.VolumeType != nil && !isSupportedNodeVolumeType(*defaults.VolumeType) {
+		return fmt.Errorf("nodeGroupDefaults.volumeType %q is not supported, valid options: %s", *defaults.VolumeType, strings.Join(SupportedNodeVolumeTypes(), ", "))
+	}
+	return validateVolumeOpts(&NodeGroupBase{
+		VolumeType:       defaults.VolumeType,
+		VolumeIOPS:       defaults.VolumeIOPS,
+		VolumeThroughput: defaults.VolumeThroughput,
+	}, "nodeGroupDefaults")
+}
+
+func isSupportedNodeVolumeType(volumeType string) bool {
+	for _, t := range SupportedNodeVolumeTypes() {
+		if t == volumeType {
+			return true
 		}
 	}
+	return false
+}
 
+func validateIOPSPerGiB(ng *NodeGroupBase, path string) error {
+	if ng.VolumeIOPS == nil || ng.VolumeSize == nil || *ng.VolumeSize == 0 {
+		return nil
+	}
+	volumeType := DefaultNodeVolumeType
+	if ng.VolumeType != nil {
+		volumeType = *ng.VolumeType
+	}
+	// gp3 volumes of any size get a baseline of 3000 IOPS, the ratio only applies above it
+	if volumeType == NodeVolumeTypeGP3 && *ng.VolumeIOPS <= DefaultNodeVolumeGP3IOPS {
+		return nil
+	}
+	if maxRatio, ok := maxIOPSPerGiB[volumeType]; ok && *ng.VolumeIOPS > maxRatio*(*ng.VolumeSize) {
+		return fmt.Errorf("%s.volumeIOPS of %d exceeds the maximum of %d IOPS per GiB for a %dGiB %s volume", path, *ng.VolumeIOPS, maxRatio, *ng.VolumeSize, volumeType)
+	}
 	return nil
 }
 
@@ -377,6 +1064,18 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if err := validateSpotInterruptionHandler(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateSecondaryNetworkInterfaces(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateAssociatePublicIPAddress(ng, path); err != nil {
+		return err
+	}
+
 	if ng.IAM != nil {
 		if err := validateNodeGroupIAM(ng.IAM, ng.IAM.InstanceProfileARN, "instanceProfileARN", path); err != nil {
 			return err
@@ -398,6 +1097,10 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
+	if err := validateStartupTaints(ng, path); err != nil {
+		return err
+	}
+
 	if err := validateNodeGroupLabels(ng.Labels); err != nil {
 		return err
 	}
@@ -413,6 +1116,36 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 			ng.AMIFamily, path)
 	}
 
+	if ng.AMIFamily == NodeImageFamilyCustom {
+		if !IsAMI(ng.AMI) {
+			return fmt.Errorf("%s.ami must be set to an AMI ID when using amiFamily %s", path, NodeImageFamilyCustom)
+		}
+		if ng.OverrideBootstrapCommand == nil {
+			return fmt.Errorf("%s.overrideBootstrapCommand is required when using amiFamily %s", path, NodeImageFamilyCustom)
+		}
+		if ng.KubeletExtraConfig != nil {
+			return &unsupportedFieldError{
+				ng:    ng.NodeGroupBase,
+				path:  path,
+				field: "kubeletExtraConfig",
+			}
+		}
+		if ng.KubeletCgroupDriver != nil {
+			return &unsupportedFieldError{
+				ng:    ng.NodeGroupBase,
+				path:  path,
+				field: "kubeletCgroupDriver",
+			}
+		}
+		if ng.KubeletFeatureGates != nil {
+			return &unsupportedFieldError{
+				ng:    ng.NodeGroupBase,
+				path:  path,
+				field: "kubeletFeatureGates",
+			}
+		}
+	}
+
 	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket {
 		fieldNotSupported := func(field string) error {
 			return &unsupportedFieldError{
@@ -424,6 +1157,12 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		if ng.KubeletExtraConfig != nil {
 			return fieldNotSupported("kubeletExtraConfig")
 		}
+		if ng.KubeletCgroupDriver != nil {
+			return fieldNotSupported("kubeletCgroupDriver")
+		}
+		if ng.KubeletFeatureGates != nil {
+			return fieldNotSupported("kubeletFeatureGates")
+		}
 		if ng.AMIFamily == NodeImageFamilyBottlerocket && ng.PreBootstrapCommands != nil {
 			return fieldNotSupported("preBootstrapCommands")
 
@@ -447,11 +1186,7 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		return err
 	}
 
-	if err := validateCPUCredits(ng); err != nil {
-		return err
-	}
-
-	if err := validateASGSuspendProcesses(ng); err != nil {
+	if err := validateCPUCredits(ng.NodeGroupBase, ng.InstanceTypeList(), path); err != nil {
 		return err
 	}
 
@@ -465,6 +1200,83 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		}
 	}
 
+	if err := validateKubeletCgroupDriver(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateKubeletFeatureGates(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateNodeNameSource(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateCapacityTypeLabel(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateWarmPool(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateInstanceRefresh(ng, path); err != nil {
+		return err
+	}
+
+	if ng.DNS != nil && ng.KubeletExtraConfig != nil {
+		if _, ok := (*ng.KubeletExtraConfig)["resolvConf"]; ok {
+			return fmt.Errorf("%[1]s.dns cannot be set with resolvConf in %[1]s.kubeletExtraConfig", path)
+		}
+	}
+
+	if err := validateContainerdConfig(ng, path); err != nil {
+		return err
+	}
+
+	if err := validateLegacyBootstrapper(ng, path); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// usesLegacyBootstrapper returns true if the nodes are bootstrapped by the legacy bootstrap scripts,
+// which eksctl uses for AmazonLinux2 and Ubuntu nodegroups with a custom AMI
+func usesLegacyBootstrapper(ng *NodeGroup) bool {
+	if !IsAMI(ng.AMI) {
+		return false
+	}
+	switch ng.AMIFamily {
+	case "", NodeImageFamilyAmazonLinux2, NodeImageFamilyUbuntu2004, NodeImageFamilyUbuntu1804:
+		return true
+	}
+	return false
+}
+
+// validateLegacyBootstrapper rejects the fields that the legacy bootstrap scripts do not apply
+func validateLegacyBootstrapper(ng *NodeGroup, path string) error {
+	if !usesLegacyBootstrapper(ng) {
+		return nil
+	}
+	unsupported := func(field string) error {
+		return fmt.Errorf("%[1]s is not supported for %[2]s nodegroups with a custom AMI (path=%[3]s.%[1]s)", field, ng.AMIFamily, path)
+	}
+	if ng.LocalNVMe != nil {
+		return unsupported("localNVMe")
+	}
+	if ng.Containerd != nil {
+		return unsupported("containerd")
+	}
+	if ng.DNS != nil {
+		return unsupported("dns")
+	}
+	if ng.CapacityTypeLabel != "" {
+		return unsupported("capacityTypeLabel")
+	}
+	if ng.WarmPool != nil {
+		return unsupported("warmPool")
+	}
 	return nil
 }
 
@@ -597,6 +1409,10 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		return err
 	}
 
+	if err := validateManagedSpotInterruptionHandler(ng, path); err != nil {
+		return err
+	}
+
 	if ng.IAM != nil {
 		if err := validateNodeGroupIAM(ng.IAM, ng.IAM.InstanceRoleARN, "instanceRoleARN", path); err != nil {
 			return err
@@ -647,6 +1463,12 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		if ng.UpdateConfig.MaxUnavailable != nil && ng.UpdateConfig.MaxUnavailablePercentage != nil {
 			return fmt.Errorf("cannot use maxUnavailable=%d and maxUnavailablePercentage=%d at the same time", *ng.UpdateConfig.MaxUnavailable, *ng.UpdateConfig.MaxUnavailablePercentage)
 		}
+		if ng.UpdateConfig.MaxUnavailable != nil && *ng.UpdateConfig.MaxUnavailable < 1 {
+			return fmt.Errorf("maxUnavailable must be at least 1, got %d", *ng.UpdateConfig.MaxUnavailable)
+		}
+		if p := ng.UpdateConfig.MaxUnavailablePercentage; p != nil && (*p < 1 || *p > 100) {
+			return fmt.Errorf("maxUnavailablePercentage must be between 1 and 100, got %d", *p)
+		}
 		if aws.IntValue(ng.UpdateConfig.MaxUnavailable) > aws.IntValue(ng.MaxSize) {
 			return fmt.Errorf("maxUnavailable=%d cannot be greater than maxSize=%d", *ng.UpdateConfig.MaxUnavailable, *ng.MaxSize)
 		}
@@ -705,12 +1527,14 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		if ng.InstanceType != "" || ng.AMI != "" || IsEnabled(ng.SSH.Allow) || IsEnabled(ng.SSH.EnableSSM) || len(ng.SSH.SourceSecurityGroupIDs) > 0 ||
 			ng.VolumeSize != nil || len(ng.PreBootstrapCommands) > 0 || ng.OverrideBootstrapCommand != nil ||
 			len(ng.SecurityGroups.AttachIDs) > 0 || ng.InstanceName != "" || ng.InstancePrefix != "" || ng.MaxPodsPerNode != 0 ||
-			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil {
+			IsEnabled(ng.DisableIMDSv1) || IsEnabled(ng.DisablePodIMDS) || ng.Placement != nil || ng.Tenancy != "" || ng.HostResourceGroupARN != "" ||
+			ng.CPUCredits != nil || IsEnabled(ng.PrefixDelegation) || len(ng.EFSMounts) > 0 {
 
 			incompatibleFields := []string{
 				"instanceType", "ami", "ssh.allow", "ssh.enableSSM", "ssh.sourceSecurityGroupIds", "securityGroups",
 				"volumeSize", "instanceName", "instancePrefix", "maxPodsPerNode", "disableIMDSv1",
 				"disablePodIMDS", "preBootstrapCommands", "overrideBootstrapCommand", "placement",
+				"tenancy", "hostResourceGroupARN", "cpuCredits", "prefixDelegation", "efsMounts",
 			}
 			return errors.Errorf("cannot set %s in managedNodeGroup when a launch template is supplied", strings.Join(incompatibleFields, ", "))
 		}
@@ -731,6 +1555,9 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		if ng.MaxPodsPerNode != 0 {
 			return notSupportedWithCustomAMIErr("maxPodsPerNode")
 		}
+		if IsEnabled(ng.PrefixDelegation) {
+			return notSupportedWithCustomAMIErr("prefixDelegation")
+		}
 		if ng.SSH != nil && IsEnabled(ng.SSH.EnableSSM) {
 			return notSupportedWithCustomAMIErr("enableSSM")
 		}
@@ -742,6 +1569,25 @@ func ValidateManagedNodeGroup(ng *ManagedNodeGroup, index int) error {
 		return errors.Errorf("%s.overrideBootstrapCommand can only be set when a custom AMI (%s.ami) is specified", path, path)
 	}
 
+	return validateCPUCredits(ng.NodeGroupBase, ng.InstanceTypeList(), path)
+}
+
+func validateTenancy(ng *NodeGroupBase, path string) error {
+	switch ng.Tenancy {
+	case "", TenancyDefault, TenancyDedicated:
+		if ng.HostResourceGroupARN != "" {
+			return fmt.Errorf("%s.hostResourceGroupARN can only be set when %s.tenancy is %q", path, path, TenancyHost)
+		}
+	case TenancyHost:
+		if ng.HostResourceGroupARN == "" {
+			return fmt.Errorf("%s.hostResourceGroupARN must be set when %s.tenancy is %q, as the instances must be launched on a Dedicated Host", path, path, TenancyHost)
+		}
+		if !arn.IsARN(ng.HostResourceGroupARN) {
+			return fmt.Errorf("%s.hostResourceGroupARN %q is not a valid ARN", path, ng.HostResourceGroupARN)
+		}
+	default:
+		return fmt.Errorf("invalid value %q for %s.tenancy, valid options: %s", ng.Tenancy, path, strings.Join([]string{TenancyDefault, TenancyDedicated, TenancyHost}, ", "))
+	}
 	return nil
 }
 
@@ -810,53 +1656,67 @@ func validateInstancesDistribution(ng *NodeGroup) error {
 	return nil
 }
 
-func validateCPUCredits(ng *NodeGroup) error {
-	isTInstance := false
-	instanceTypes := []string{ng.InstanceType}
+// burstableInstanceType matches the instance types of the burstable performance families
+var burstableInstanceType = regexp.MustCompile(`^t(2|3|3a|4g)\.`)
 
+func validateCPUCredits(ng *NodeGroupBase, instanceTypes []string, path string) error {
 	if ng.CPUCredits == nil {
 		return nil
 	}
 
-	if ng.InstancesDistribution != nil {
-		instanceTypes = ng.InstancesDistribution.InstanceTypes
+	switch strings.ToLower(*ng.CPUCredits) {
+	case CPUCreditsStandard:
+	case CPUCreditsUnlimited:
+		logger.Warning("%s.cpuCredits is %q, instances that use more CPU than their baseline for a sustained period incur additional charges", path, CPUCreditsUnlimited)
+	default:
+		return fmt.Errorf("invalid value %q for %s.cpuCredits, valid options: %s, %s", *ng.CPUCredits, path, CPUCreditsStandard, CPUCreditsUnlimited)
 	}
 
+	// the instance types are only known once the instance selector has been resolved
+	if ng.InstanceSelector != nil && !ng.InstanceSelector.IsZero() && len(instanceTypes) == 1 && instanceTypes[0] == "" {
+		return nil
+	}
 	for _, instanceType := range instanceTypes {
-		if strings.HasPrefix(instanceType, "t") {
-			isTInstance = true
+		if !burstableInstanceType.MatchString(instanceType) {
+			return fmt.Errorf("%s.cpuCredits can only be set when all the instance types are burstable performance instances (t2, t3, t3a and t4g), got instance type %q", path, instanceType)
 		}
 	}
+	return nil
+}
 
-	if !isTInstance {
-		return fmt.Errorf("cpuCredits option set for nodegroup, but it has no t2/t3 instance types")
-	}
+// asgProcesses are the processes of an Auto Scaling group that can be suspended, see
+// https://docs.aws.amazon.com/autoscaling/ec2/APIReference/API_SuspendProcesses.html
+var asgProcesses = []string{
+	"Launch",
+	"Terminate",
+	"AddToLoadBalancer",
+	"AlarmNotification",
+	"AZRebalance",
+	"HealthCheck",
+	"InstanceRefresh",
+	"ReplaceUnhealthy",
+	"ScheduledActions",
+}
 
-	if strings.ToLower(*ng.CPUCredits) != "unlimited" && strings.ToLower(*ng.CPUCredits) != "standard" {
-		return fmt.Errorf("cpuCredits option accepts only one of 'standard' or 'unlimited'")
+func isASGProcess(name string) bool {
+	for _, proc := range asgProcesses {
+		if proc == name {
+			return true
+		}
 	}
-
-	return nil
+	return false
 }
 
-func validateASGSuspendProcesses(ng *NodeGroup) error {
-	// Processes list taken from here: https://docs.aws.amazon.com/autoscaling/ec2/APIReference/API_SuspendProcesses.html
+func validateASGSuspendProcesses(ng *NodeGroupBase, path string) error {
+	seen := map[string]bool{}
 	for _, proc := range ng.ASGSuspendProcesses {
-		switch proc {
-		case
-			"Launch",
-			"Terminate",
-			"AddToLoadBalancer",
-			"AlarmNotification",
-			"AZRebalance",
-			"HealthCheck",
-			"InstanceRefresh",
-			"ReplaceUnhealthy",
-			"ScheduledActions":
-			continue
-		default:
-			return fmt.Errorf("asgSuspendProcesses contains invalid process name '%s'", proc)
+		if !isASGProcess(proc) {
+			return fmt.Errorf("%s.asgSuspendProcesses contains invalid process name %q, valid process names are %s", path, proc, strings.Join(asgProcesses, ", "))
+		}
+		if seen[proc] {
+			return fmt.Errorf("%s.asgSuspendProcesses contains process %q more than once", path, proc)
 		}
+		seen[proc] = true
 	}
 	return nil
 }
@@ -906,6 +1766,160 @@ func validateNodeGroupKubeletExtraConfig(kubeletConfig *InlineDocument) error {
 	return nil
 }
 
+func validateKubeletCgroupDriver(ng *NodeGroup, path string) error {
+	if ng.KubeletCgroupDriver == nil {
+		return nil
+	}
+	cgroupDriver := *ng.KubeletCgroupDriver
+	if cgroupDriver != KubeletCgroupDriverSystemd && cgroupDriver != KubeletCgroupDriverCgroupfs {
+		return fmt.Errorf("invalid value %q for %s.kubeletCgroupDriver: must be either %q or %q", cgroupDriver, path, KubeletCgroupDriverSystemd, KubeletCgroupDriverCgroupfs)
+	}
+	if ng.KubeletExtraConfig != nil {
+		if extraCgroupDriver, ok := (*ng.KubeletExtraConfig)["cgroupDriver"]; ok && extraCgroupDriver != cgroupDriver {
+			return fmt.Errorf("%s.kubeletCgroupDriver is %q but %s.kubeletExtraConfig sets cgroupDriver to %v", path, cgroupDriver, path, extraCgroupDriver)
+		}
+	}
+	return nil
+}
+
+func validateNodeNameSource(ng *NodeGroup, path string) error {
+	switch ng.NodeNameSource {
+	case "":
+		return nil
+	case NodeNameSourcePrivateDNSName, NodeNameSourceResourceName:
+	default:
+		return fmt.Errorf("invalid value %q for %s.nodeNameSource, valid options: %s, %s", ng.NodeNameSource, path, NodeNameSourcePrivateDNSName, NodeNameSourceResourceName)
+	}
+	// Windows nodes register with the hostname of the instance rather than its private DNS name
+	if IsWindowsImage(ng.AMIFamily) {
+		return &unsupportedFieldError{
+			ng:    ng.NodeGroupBase,
+			path:  path,
+			field: "nodeNameSource",
+		}
+	}
+	return nil
+}
+
+func validateCapacityTypeLabel(ng *NodeGroup, path string) error {
+	if ng.CapacityTypeLabel == "" {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket || ng.AMIFamily == NodeImageFamilyCustom {
+		return &unsupportedFieldError{
+			ng:    ng.NodeGroupBase,
+			path:  path,
+			field: "capacityTypeLabel",
+		}
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%[1]s.capacityTypeLabel cannot be set with %[1]s.overrideBootstrapCommand, as kubelet is configured by the bootstrap command", path)
+	}
+	if err := validateNodeGroupLabels(map[string]string{ng.CapacityTypeLabel: "ON_DEMAND"}); err != nil {
+		return errors.Wrapf(err, "invalid %s.capacityTypeLabel", path)
+	}
+	if _, ok := ng.Labels[ng.CapacityTypeLabel]; ok {
+		return fmt.Errorf("%[1]s.capacityTypeLabel %[2]q cannot also be set in %[1]s.labels, as it is set after the lifecycle of the instances", path, ng.CapacityTypeLabel)
+	}
+	return nil
+}
+
+func validateWarmPool(ng *NodeGroup, path string) error {
+	wp := ng.WarmPool
+	if wp == nil {
+		return nil
+	}
+	if IsWindowsImage(ng.AMIFamily) || ng.AMIFamily == NodeImageFamilyBottlerocket {
+		return &unsupportedFieldError{
+			ng:    ng.NodeGroupBase,
+			path:  path,
+			field: "warmPool",
+		}
+	}
+	if ng.InstancesDistribution != nil {
+		return fmt.Errorf("%[1]s.warmPool cannot be set with %[1]s.instancesDistribution, as Auto Scaling groups with mixed instances do not support warm pools", path)
+	}
+	switch wp.PoolState {
+	case "", WarmPoolStateStopped, WarmPoolStateRunning:
+	case WarmPoolStateHibernated:
+		if !IsEnabled(ng.VolumeEncrypted) {
+			return fmt.Errorf("%[1]s.warmPool.poolState %[2]s requires %[1]s.volumeEncrypted, as hibernated instances save their memory on their root volume", path, WarmPoolStateHibernated)
+		}
+	default:
+		return fmt.Errorf("invalid value %q for %s.warmPool.poolState, valid options: %s, %s, %s", wp.PoolState, path, WarmPoolStateStopped, WarmPoolStateRunning, WarmPoolStateHibernated)
+	}
+	if wp.MinSize != nil && *wp.MinSize < 0 {
+		return fmt.Errorf("%s.warmPool.minSize cannot be negative", path)
+	}
+	if wp.MaxGroupPreparedCapacity != nil {
+		if *wp.MaxGroupPreparedCapacity < 1 {
+			return fmt.Errorf("%s.warmPool.maxGroupPreparedCapacity must be at least 1", path)
+		}
+		if wp.MinSize != nil && *wp.MaxGroupPreparedCapacity < *wp.MinSize {
+			return fmt.Errorf("%[1]s.warmPool.maxGroupPreparedCapacity cannot be less than %[1]s.warmPool.minSize", path)
+		}
+	}
+	return nil
+}
+
+func validateInstanceRefresh(ng *NodeGroup, path string) error {
+	ir := ng.InstanceRefresh
+	if ir == nil {
+		return nil
+	}
+	for _, proc := range ng.ASGSuspendProcesses {
+		if proc == "InstanceRefresh" {
+			return fmt.Errorf("%[1]s.instanceRefresh cannot be set when %[1]s.asgSuspendProcesses suspends InstanceRefresh", path)
+		}
+	}
+	if ir.MinHealthyPercentage != nil && (*ir.MinHealthyPercentage < 0 || *ir.MinHealthyPercentage > 100) {
+		return fmt.Errorf("%s.instanceRefresh.minHealthyPercentage must be between 0 and 100", path)
+	}
+	if ir.InstanceWarmup != nil && *ir.InstanceWarmup < 0 {
+		return fmt.Errorf("%s.instanceRefresh.instanceWarmup cannot be negative", path)
+	}
+	return nil
+}
+
+func validateContainerdConfig(ng *NodeGroup, path string) error {
+	if ng.Containerd == nil {
+		return nil
+	}
+	if ng.AMIFamily != NodeImageFamilyAmazonLinux2 {
+		return &unsupportedFieldError{
+			ng:    ng.NodeGroupBase,
+			path:  path,
+			field: "containerd",
+		}
+	}
+	if ng.GetContainerRuntime() != ContainerRuntimeContainerD {
+		return fmt.Errorf("%s.containerd can only be set when %s.containerRuntime is %q", path, path, ContainerRuntimeContainerD)
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%s.containerd cannot be used together with %s.overrideBootstrapCommand", path, path)
+	}
+	for host, endpoints := range ng.Containerd.RegistryMirrors {
+		if host == "" {
+			return fmt.Errorf("%s.containerd.registryMirrors: registry host must not be empty", path)
+		}
+		if len(endpoints) == 0 {
+			return fmt.Errorf("%s.containerd.registryMirrors[%q] must contain at least one endpoint", path, host)
+		}
+		for _, endpoint := range endpoints {
+			u, err := url.Parse(endpoint)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return fmt.Errorf("invalid endpoint %q in %s.containerd.registryMirrors[%q]: must be an http or https URL", endpoint, path, host)
+			}
+		}
+	}
+	if ng.Containerd.ConfigFragment != "" {
+		if _, err := toml.Load(ng.Containerd.ConfigFragment); err != nil {
+			return errors.Wrapf(err, "%s.containerd.configFragment is not valid TOML", path)
+		}
+	}
+	return nil
+}
+
 func isSupportedAMIFamily(imageFamily string) bool {
 	for _, image := range supportedAMIFamilies() {
 		if imageFamily == image {
@@ -953,6 +1967,23 @@ func validateTaints(ngTaints []NodeGroupTaint) error {
 	return nil
 }
 
+func validateStartupTaints(ng *NodeGroup, path string) error {
+	if err := validateTaints(ng.StartupTaints); err != nil {
+		return errors.Wrapf(err, "invalid %s.startupTaints", path)
+	}
+	keys := map[string]bool{}
+	for _, t := range ng.Taints {
+		keys[t.Key] = true
+	}
+	for _, t := range ng.StartupTaints {
+		if keys[t.Key] {
+			return fmt.Errorf("%s.startupTaints contains taint %q, which is already set in %s.taints or %s.startupTaints", path, t.Key, path, path)
+		}
+		keys[t.Key] = true
+	}
+	return nil
+}
+
 // ReservedProfileNamePrefix defines the Fargate profile name prefix reserved
 // for AWS, and which therefore, cannot be used by users. AWS' API should
 // reject the creation of profiles starting with this prefix, but we eagerly
@@ -2,6 +2,7 @@ package v1alpha5_test
 
 import (
 	"fmt"
+	gostrings "strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
@@ -9,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 	"github.com/weaveworks/eksctl/pkg/utils/strings"
 )
 
@@ -104,6 +106,133 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("nodeGroups[*].kubeletFeatureGates validation", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		})
+
+		It("accepts feature gates", func() {
+			ng.KubeletFeatureGates = map[string]bool{"InPlacePodVerticalScaling": true, "CPUManager": false}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects invalid feature gate names", func() {
+			ng.KubeletFeatureGates = map[string]bool{"in-place-pod-vertical-scaling": true}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid feature gate "in-place-pod-vertical-scaling" in nodeGroups[0].kubeletFeatureGates, feature gate names are in UpperCamelCase`))
+		})
+
+		It("rejects a different value in kubeletExtraConfig", func() {
+			ng.KubeletFeatureGates = map[string]bool{"CPUManager": true}
+			ng.KubeletExtraConfig = &api.InlineDocument{"featureGates": map[string]interface{}{"CPUManager": false}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].kubeletFeatureGates sets CPUManager to true but nodeGroups[0].kubeletExtraConfig sets it to false"))
+
+			ng.KubeletExtraConfig = &api.InlineDocument{"featureGates": map[string]interface{}{"CPUManager": true, "MemoryManager": true}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			ng.KubeletExtraConfig = &api.InlineDocument{"featureGates": "CPUManager=true"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeGroups[0].kubeletExtraConfig.featureGates must be a map")))
+		})
+
+		It("is not supported for Bottlerocket and Windows", func() {
+			ng.KubeletFeatureGates = map[string]bool{"CPUManager": true}
+			for _, amiFamily := range []string{api.NodeImageFamilyBottlerocket, api.NodeImageFamilyWindowsServer2019CoreContainer} {
+				ng.AMIFamily = amiFamily
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("kubeletFeatureGates is not supported"))
+			}
+		})
+	})
+
+	Describe("nodeGroups[*].kubeletCgroupDriver validation", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		})
+
+		It("accepts systemd and cgroupfs", func() {
+			for _, cgroupDriver := range []string{api.KubeletCgroupDriverSystemd, api.KubeletCgroupDriverCgroupfs} {
+				ng.KubeletCgroupDriver = aws.String(cgroupDriver)
+				Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			}
+		})
+
+		It("rejects other cgroup drivers", func() {
+			ng.KubeletCgroupDriver = aws.String("cgroupv2")
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid value "cgroupv2" for nodeGroups[0].kubeletCgroupDriver: must be either "systemd" or "cgroupfs"`))
+		})
+
+		It("rejects a different cgroup driver in kubeletExtraConfig", func() {
+			ng.KubeletCgroupDriver = aws.String(api.KubeletCgroupDriverSystemd)
+			ng.KubeletExtraConfig = &api.InlineDocument{"cgroupDriver": "cgroupfs"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].kubeletCgroupDriver is "systemd" but nodeGroups[0].kubeletExtraConfig sets cgroupDriver to cgroupfs`))
+
+			ng.KubeletExtraConfig = &api.InlineDocument{"cgroupDriver": "systemd"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("is not supported for Bottlerocket and Windows", func() {
+			ng.KubeletCgroupDriver = aws.String(api.KubeletCgroupDriverSystemd)
+			for _, amiFamily := range []string{api.NodeImageFamilyBottlerocket, api.NodeImageFamilyWindowsServer2019CoreContainer} {
+				ng.AMIFamily = amiFamily
+				err := api.ValidateNodeGroup(0, ng)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("kubeletCgroupDriver is not supported"))
+			}
+		})
+	})
+
+	Describe("nodeGroups[*].containerd validation", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng.ContainerRuntime = aws.String(api.ContainerRuntimeContainerD)
+			ng.Containerd = &api.ContainerdConfig{
+				RegistryMirrors: map[string][]string{"docker.io": {"https://mirror.example.com"}},
+				ConfigFragment:  `[plugins."io.containerd.grpc.v1.cri"]` + "\n" + `sandbox_image = "registry.example.com/pause:3.5"`,
+			}
+		})
+
+		It("accepts registry mirrors and a valid config fragment", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("is only supported by AmazonLinux2 nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+			ng.ContainerRuntime = nil
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("containerd is not supported for Ubuntu2004 nodegroups (path=nodeGroups[0].containerd)"))
+		})
+
+		It("is not supported by nodegroups with a custom AMI", func() {
+			ng.AMI = "ami-123"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("containerd is not supported for AmazonLinux2 nodegroups with a custom AMI (path=nodeGroups[0].containerd)"))
+		})
+
+		It("requires the containerd runtime", func() {
+			ng.ContainerRuntime = aws.String(api.ContainerRuntimeDockerD)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].containerd can only be set when nodeGroups[0].containerRuntime is "containerd"`))
+		})
+
+		It("rejects a config fragment that is not valid TOML", func() {
+			ng.Containerd.ConfigFragment = `[plugins."io.containerd.grpc.v1.cri"`
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("nodeGroups[0].containerd.configFragment is not valid TOML"))
+		})
+
+		It("rejects registry mirror endpoints that are not http or https URLs", func() {
+			ng.Containerd.RegistryMirrors = map[string][]string{"docker.io": {"mirror.example.com"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid endpoint "mirror.example.com" in nodeGroups[0].containerd.registryMirrors["docker.io"]: must be an http or https URL`))
+
+			ng.Containerd.RegistryMirrors = map[string][]string{"docker.io": {}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].containerd.registryMirrors["docker.io"] must contain at least one endpoint`))
+		})
+	})
+
 	Describe("nodeGroups[*].volumeX", func() {
 		var (
 			cfg *api.ClusterConfig
@@ -169,10 +298,62 @@ var _ = Describe("ClusterConfig validation", func() {
 				})
 			})
 
+			When("VolumeType is io2", func() {
+				BeforeEach(func() {
+					*ng0.VolumeType = api.NodeVolumeTypeIO2
+				})
+
+				It("does not fail", func() {
+					Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+				})
+
+				When(fmt.Sprintf("the value of volumeIOPS is < %d", api.MinIO2Iops), func() {
+					It("returns an error", func() {
+						ng0.VolumeIOPS = aws.Int(api.MinIO2Iops - 1)
+						Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError("value for nodeGroups[0].volumeIOPS must be within range 100-64000"))
+					})
+				})
+
+				When(fmt.Sprintf("the value of volumeIOPS is > %d", api.MaxIO2Iops), func() {
+					It("returns an error", func() {
+						ng0.VolumeIOPS = aws.Int(api.MaxIO2Iops + 1)
+						Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError("value for nodeGroups[0].volumeIOPS must be within range 100-64000"))
+					})
+				})
+
+				When("volumeThroughput is set", func() {
+					It("returns an error", func() {
+						ng0.VolumeThroughput = aws.Int(125)
+						Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError("nodeGroups[0].volumeThroughput is only supported for gp3 volume type"))
+					})
+				})
+			})
+
+			When("volumeIOPS is compared to the volume size", func() {
+				DescribeTable("enforces the maximum IOPS per GiB", func(volumeType string, volumeSize, volumeIOPS int, expectedErr string) {
+					*ng0.VolumeType = volumeType
+					ng0.VolumeSize = aws.Int(volumeSize)
+					ng0.VolumeIOPS = aws.Int(volumeIOPS)
+					if expectedErr == "" {
+						Expect(api.ValidateNodeGroup(0, ng0)).To(Succeed())
+						return
+					}
+					Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError(expectedErr))
+				},
+					Entry("io1 at the maximum ratio", api.NodeVolumeTypeIO1, 20, 1000, ""),
+					Entry("io1 above the maximum ratio", api.NodeVolumeTypeIO1, 20, 1001, "nodeGroups[0].volumeIOPS of 1001 exceeds the maximum of 50 IOPS per GiB for a 20GiB io1 volume"),
+					Entry("io2 at the maximum ratio", api.NodeVolumeTypeIO2, 20, 10000, ""),
+					Entry("io2 above the maximum ratio", api.NodeVolumeTypeIO2, 20, 10001, "nodeGroups[0].volumeIOPS of 10001 exceeds the maximum of 500 IOPS per GiB for a 20GiB io2 volume"),
+					Entry("gp3 at the baseline on a small volume", api.NodeVolumeTypeGP3, 1, 3000, ""),
+					Entry("gp3 at the maximum ratio", api.NodeVolumeTypeGP3, 8, 4000, ""),
+					Entry("gp3 above the maximum ratio", api.NodeVolumeTypeGP3, 6, 3001, "nodeGroups[0].volumeIOPS of 3001 exceeds the maximum of 500 IOPS per GiB for a 6GiB gp3 volume"),
+				)
+			})
+
 			When("VolumeType is one for which IOPS is not supported", func() {
 				It("returns an error", func() {
 					*ng0.VolumeType = api.NodeVolumeTypeGP2
-					Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError("nodeGroups[0].volumeIOPS is only supported for io1 and gp3 volume types"))
+					Expect(api.ValidateNodeGroup(0, ng0)).To(MatchError("nodeGroups[0].volumeIOPS is only supported for io1, io2 and gp3 volume types"))
 				})
 			})
 		})
@@ -457,6 +638,134 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("iam.fargatePodExecutionRoleAttachPolicy{ARNs,}", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("should allow extra policies for the pod execution role created by eksctl", func() {
+			cfg.IAM.FargatePodExecutionRoleAttachPolicyARNs = []string{"arn:aws:iam::123456789012:policy/FargateLogging"}
+			cfg.IAM.FargatePodExecutionRoleAttachPolicy = api.InlineDocument{"Version": "2012-10-17"}
+
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should not allow extra policies with an existing pod execution role", func() {
+			cfg.IAM.FargatePodExecutionRoleARN = aws.String("arn:aws:iam::123456789012:role/FargatePodExecutionRole")
+			cfg.IAM.FargatePodExecutionRoleAttachPolicy = api.InlineDocument{"Version": "2012-10-17"}
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(ContainSubstring("cannot be set with iam.fargatePodExecutionRoleARN")))
+		})
+
+		It("should reject invalid ARNs", func() {
+			cfg.IAM.FargatePodExecutionRoleAttachPolicyARNs = []string{"FargateLogging"}
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(ContainSubstring(`invalid ARN "FargateLogging" in iam.fargatePodExecutionRoleAttachPolicyARNs`)))
+		})
+
+		It("should reject ARNs that are not IAM policies", func() {
+			cfg.IAM.FargatePodExecutionRoleAttachPolicyARNs = []string{"arn:aws:iam::123456789012:role/FargateLogging"}
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`invalid ARN "arn:aws:iam::123456789012:role/FargateLogging" in iam.fargatePodExecutionRoleAttachPolicyARNs: not an IAM policy ARN`))
+		})
+	})
+
+	Describe("iam.serviceRoleARN", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("should allow an IAM role ARN", func() {
+			cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/eks/cluster-role")
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject invalid ARNs", func() {
+			cfg.IAM.ServiceRoleARN = aws.String("cluster-role")
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(ContainSubstring(`invalid ARN "cluster-role" in iam.serviceRoleARN`)))
+		})
+
+		It("should reject ARNs that are not IAM roles", func() {
+			cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:policy/cluster-role")
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`invalid ARN "arn:aws:iam::123456789012:policy/cluster-role" in iam.serviceRoleARN: not an IAM role ARN`))
+		})
+	})
+
+	Describe("iam.permissionsBoundary", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("should allow an IAM policy ARN", func() {
+			cfg.IAM.PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject invalid ARNs", func() {
+			cfg.IAM.PermissionsBoundary = "boundary"
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(ContainSubstring(`invalid ARN "boundary" in iam.permissionsBoundary`)))
+		})
+
+		It("should reject ARNs that are not IAM policies", func() {
+			cfg.IAM.PermissionsBoundary = "arn:aws:iam::123456789012:role/boundary"
+
+			err := api.ValidateClusterConfig(cfg)
+			Expect(err).To(MatchError(`invalid ARN "arn:aws:iam::123456789012:role/boundary" in iam.permissionsBoundary: not an IAM policy ARN`))
+		})
+	})
+
+	Describe("iamIdentityMappings", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("should allow role, user and account mappings", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{
+				{ARN: "arn:aws:iam::123456789012:role/admin", Groups: []string{"system:masters"}},
+				{ARN: "arn:aws:iam::123456789012:user/dev", Username: "dev"},
+				{Account: "123456789012"},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should require an ARN or an account", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{{Username: "dev"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("iamIdentityMappings[0].arn or iamIdentityMappings[0].account must be set"))
+		})
+
+		It("should not allow an account with other fields", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{{Account: "123456789012", Username: "dev"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(HavePrefix("iamIdentityMappings[0].account cannot be set with")))
+		})
+
+		It("should require a username or groups", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:role/admin"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("iamIdentityMappings[0].username or iamIdentityMappings[0].groups must be set"))
+		})
+
+		It("should only allow IAM role or user ARNs", func() {
+			cfg.IAMIdentityMappings = []*api.IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:policy/admin", Username: "admin"}}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid ARN "arn:aws:iam::123456789012:policy/admin" in iamIdentityMappings[0].arn: not an IAM role or user ARN`))
+		})
+	})
+
 	Describe("cloudWatch.clusterLogging", func() {
 		var (
 			cfg *api.ClusterConfig
@@ -482,6 +791,237 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("kubernetesNetworkConfig.ipFamily", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Version = "1.21"
+			cfg.VPC.AutoAllocateIPv6 = api.Enabled()
+			cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+		})
+
+		DescribeTable("accepts a valid IP family", func(ipFamily string) {
+			cfg.KubernetesNetworkConfig.IPFamily = ipFamily
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		},
+			Entry("IPv4", api.IPV4Family),
+			Entry("IPv6", api.IPV6Family),
+			Entry("lowercase IPv6", "ipv6"),
+			Entry("not set", ""),
+		)
+
+		It("rejects an unknown IP family", func() {
+			cfg.KubernetesNetworkConfig.IPFamily = "dual-stack"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid value "dual-stack" for kubernetesNetworkConfig.ipFamily: must be either "IPv4" or "IPv6"`))
+		})
+
+		It("rejects a service IPv4 CIDR for IPv6 clusters", func() {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "10.100.0.0/16"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("kubernetesNetworkConfig.serviceIPv4CIDR cannot be set for IPv6 clusters, as EKS assigns the service IPv6 CIDR"))
+		})
+
+		It("rejects Kubernetes versions without IPv6 support", func() {
+			cfg.Metadata.Version = "1.20"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("IPv6 clusters are only supported for Kubernetes version 1.21 and above, got 1.20"))
+		})
+
+		It("requires IPv6 subnets when eksctl creates the VPC", func() {
+			cfg.VPC.AutoAllocateIPv6 = api.Disabled()
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.autoAllocateIPv6 must be enabled for IPv6 clusters when eksctl creates the VPC"))
+
+			cfg.VPC.ID = "vpc-1"
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("rejects Windows nodegroups", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "windows"
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("nodeGroups[0]: Windows nodegroups are not supported in IPv6 clusters"))
+		})
+	})
+
+	Describe("kubernetesNetworkConfig.serviceIPv4CIDR", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{}
+		})
+
+		DescribeTable("accepts a valid CIDR", func(cidr string) {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = cidr
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		},
+			Entry("default EKS range", "10.100.0.0/16"),
+			Entry("alternative EKS range", "172.20.0.0/16"),
+			Entry("/12 block", "172.16.0.0/12"),
+			Entry("/12 block within 10.0.0.0/8", "10.96.0.0/12"),
+			Entry("/24 block", "10.200.10.0/24"),
+			Entry("not set", ""),
+		)
+
+		DescribeTable("rejects an invalid CIDR", func(cidr, expectedErr string) {
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = cidr
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("malformed CIDR", "10.100.0.0/40", "invalid IPv4 CIDR for kubernetesNetworkConfig.serviceIPv4CIDR"),
+			Entry("IPv6 CIDR", "fd00::/108", "must be an IPv4 CIDR"),
+			Entry("prefix too short", "10.0.0.0/8", "must have a prefix length between /12 and /24"),
+			Entry("prefix too long", "10.100.0.0/25", "must have a prefix length between /12 and /24"),
+			Entry("public range", "100.64.0.0/16", "must be within one of 10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16"),
+			Entry("overlapping the default VPC CIDR", "192.168.0.0/24", `must not overlap with the VPC CIDR "192.168.0.0/16"`),
+			Entry("/12 block not given as its network address", "10.100.0.0/12",
+				`kubernetesNetworkConfig.serviceIPv4CIDR "10.100.0.0/12" is not the network address of its range, use "10.96.0.0/12"`),
+		)
+
+		It("rejects a /12 CIDR containing the VPC CIDR", func() {
+			cfg.VPC.CIDR = ipnet.MustParseCIDR("172.20.0.0/16")
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "172.16.0.0/12"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`kubernetesNetworkConfig.serviceIPv4CIDR "172.16.0.0/12" must not overlap with the VPC CIDR "172.20.0.0/16"`))
+		})
+
+		It("rejects a CIDR overlapping an extra VPC CIDR", func() {
+			cfg.VPC.ExtraCIDRs = []string{"100.64.0.0/16", "10.100.0.0/20"}
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "10.100.0.0/16"
+			Expect(cfg.ValidateServiceIPv4CIDR()).To(MatchError(`kubernetesNetworkConfig.serviceIPv4CIDR "10.100.0.0/16" must not overlap with the VPC CIDR "10.100.0.0/20"`))
+		})
+
+		It("does not check overlap when the VPC CIDR is not known yet", func() {
+			cfg.VPC.CIDR = nil
+			cfg.KubernetesNetworkConfig.ServiceIPv4CIDR = "192.168.0.0/24"
+			Expect(cfg.ValidateServiceIPv4CIDR()).To(Succeed())
+		})
+	})
+
+	Describe("vpc.customNetworking", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.CustomNetworking = &api.CustomNetworking{
+				PodSubnets: map[string]string{
+					"us-west-2a": "subnet-1",
+					"us-west-2b": "subnet-2",
+				},
+				SecurityGroups: []string{"sg-1"},
+			}
+		})
+
+		It("accepts pod subnets and security groups", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("requires pod subnets", func() {
+			cfg.VPC.CustomNetworking.PodSubnets = nil
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.customNetworking.podSubnets must be set, or vpc.secondaryCIDRs for the pods to be placed in subnets created by eksctl"))
+
+			cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("rejects an empty availability zone", func() {
+			cfg.VPC.CustomNetworking.PodSubnets = map[string]string{"": "subnet-1"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.customNetworking.podSubnets cannot contain an empty availability zone"))
+		})
+
+		It("rejects an invalid subnet ID", func() {
+			cfg.VPC.CustomNetworking.PodSubnets["us-west-2b"] = "10.1.0.0/16"
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid subnet ID "10.1.0.0/16" for availability zone "us-west-2b" in vpc.customNetworking.podSubnets`))
+		})
+
+		It("rejects an invalid security group ID", func() {
+			cfg.VPC.CustomNetworking.SecurityGroups = []string{"default"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid security group ID "default" in vpc.customNetworking.securityGroups`))
+		})
+	})
+
+	Describe("vpc.secondaryCIDRs", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("accepts CIDRs that do not overlap and normalizes them", func() {
+			cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16", "100.65.1.0/16", "192.169.0.0/24"}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.VPC.SecondaryCIDRs).To(Equal([]string{"100.64.0.0/16", "100.65.0.0/16", "192.169.0.0/24"}))
+		})
+
+		DescribeTable("rejects invalid CIDRs", func(secondaryCIDRs []string, expectedErr string) {
+			cfg.VPC.SecondaryCIDRs = secondaryCIDRs
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("invalid CIDR", []string{"100.64.0.0/33"}, "invalid CIDR in vpc.secondaryCIDRs[0]"),
+			Entry("IPv6 CIDR", []string{"2001:db8::/56"}, `vpc.secondaryCIDRs[0] "2001:db8::/56" must be an IPv4 CIDR`),
+			Entry("too large", []string{"100.64.0.0/10"}, `vpc.secondaryCIDRs[0] "100.64.0.0/10" must be a /16 to /28 block`),
+			Entry("too small", []string{"100.64.0.0/29"}, `vpc.secondaryCIDRs[0] "100.64.0.0/29" must be a /16 to /28 block`),
+			Entry("overlapping the VPC CIDR", []string{"192.168.128.0/17"}, `vpc.secondaryCIDRs[0] "192.168.128.0/17" overlaps with the VPC CIDR "192.168.0.0/16"`),
+			Entry("overlapping another secondary CIDR", []string{"100.64.0.0/16", "100.64.16.0/20"}, `vpc.secondaryCIDRs[1] "100.64.16.0/20" overlaps with the VPC CIDR "100.64.0.0/16"`),
+			Entry("more than the VPC CIDR block quota", []string{"100.64.0.0/16", "100.65.0.0/16", "100.66.0.0/16", "100.67.0.0/16", "100.68.0.0/16"}, "vpc.secondaryCIDRs can contain at most 4 CIDRs"),
+			Entry("in another private address range", []string{"10.0.0.0/16"}, `vpc.secondaryCIDRs[0] "10.0.0.0/16" is in the 10.0.0.0/8 range, which cannot be associated with a VPC whose CIDR "192.168.0.0/16" is in the 192.168.0.0/16 range`),
+		)
+
+		It("requires the VPC to be created by eksctl", func() {
+			cfg.VPC.ID = "vpc-1"
+			cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(api.ErrSecondaryCIDRsWithExistingVPC))
+		})
+
+		It("is not supported with IPv6", func() {
+			cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16"}
+			cfg.Metadata.Version = "1.21"
+			cfg.VPC.AutoAllocateIPv6 = api.Enabled()
+			cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("vpc.secondaryCIDRs is not supported with the IPv6 IP family")))
+		})
+
+		It("rejects a service CIDR overlapping a secondary CIDR", func() {
+			cfg.VPC.SecondaryCIDRs = []string{"192.168.0.0/16", "10.100.0.0/16"}
+			cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{ServiceIPv4CIDR: "10.100.0.0/16"}
+			Expect(cfg.ValidateServiceIPv4CIDR()).To(MatchError(ContainSubstring(`must not overlap with the VPC CIDR "10.100.0.0/16"`)))
+		})
+	})
+
+	Describe("vpc.controlPlaneSecurityGroupRules", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.ControlPlaneSecurityGroupRules = &api.ControlPlaneSecurityGroupRules{}
+		})
+
+		It("accepts narrowed port ranges", func() {
+			cfg.VPC.ControlPlaneSecurityGroupRules.NodePorts = []api.PortRange{
+				{FromPort: 443, ToPort: 443},
+				{FromPort: 10250, ToPort: 10250},
+			}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.VPC.ControlPlaneSecurityGroupRules.HasNodePort(10250)).To(BeTrue())
+			Expect(cfg.VPC.ControlPlaneSecurityGroupRules.HasNodePort(8443)).To(BeFalse())
+		})
+
+		It("does not fail when the required ports are not open", func() {
+			cfg.VPC.ControlPlaneSecurityGroupRules.NodePorts = []api.PortRange{{FromPort: 8443, ToPort: 8443}}
+			Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("rejects an empty list of port ranges", func() {
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("vpc.controlPlaneSecurityGroupRules.nodePorts must contain at least one port range"))
+		})
+
+		DescribeTable("rejects an invalid port range", func(pr api.PortRange) {
+			cfg.VPC.ControlPlaneSecurityGroupRules.NodePorts = []api.PortRange{{FromPort: 443, ToPort: 443}, pr}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(HavePrefix("vpc.controlPlaneSecurityGroupRules.nodePorts[1]: invalid port range")))
+		},
+			Entry("port zero", api.PortRange{FromPort: 0, ToPort: 10250}),
+			Entry("port too high", api.PortRange{FromPort: 10250, ToPort: 70000}),
+			Entry("reversed range", api.PortRange{FromPort: 10250, ToPort: 1025}),
+		)
+	})
+
 	Describe("cluster endpoint access config", func() {
 		var (
 			cfg *api.ClusterConfig
@@ -528,11 +1068,12 @@ var _ = Describe("ClusterConfig validation", func() {
 		BeforeEach(func() {
 			unlimited := "unlimited"
 			ng = &api.NodeGroup{
-				NodeGroupBase: &api.NodeGroupBase{},
+				NodeGroupBase: &api.NodeGroupBase{
+					CPUCredits: &unlimited,
+				},
 				InstancesDistribution: &api.NodeGroupInstancesDistribution{
 					InstanceTypes: []string{"t3.medium", "t3.large"},
 				},
-				CPUCredits: &unlimited,
 			}
 		})
 
@@ -559,6 +1100,54 @@ var _ = Describe("ClusterConfig validation", func() {
 			err := api.ValidateNodeGroup(0, ng)
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("accepts all the burstable performance families", func() {
+			ng.NodeGroupBase.CPUCredits = aws.String("Standard")
+			ng.InstancesDistribution.InstanceTypes = []string{"t2.medium", "t3.large", "t3a.large", "t4g.xlarge"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("errors if any of the instance types is not burstable", func() {
+			ng.InstancesDistribution.InstanceTypes = []string{"t3.large", "m5.large"}
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`nodeGroups[0].cpuCredits can only be set when all the instance types are burstable performance instances (t2, t3, t3a and t4g), got instance type "m5.large"`))
+		})
+
+		It("errors for instance families that start with t but are not burstable", func() {
+			ng.InstancesDistribution = nil
+			ng.InstanceType = "trn1.32xlarge"
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring(`got instance type "trn1.32xlarge"`)))
+		})
+
+		It("errors for an invalid value", func() {
+			ng.NodeGroupBase.CPUCredits = aws.String("major-street-cred")
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`invalid value "major-street-cred" for nodeGroups[0].cpuCredits, valid options: standard, unlimited`))
+		})
+
+		Context("managed nodegroups", func() {
+			newManagedNodeGroup := func(instanceTypes ...string) *api.ManagedNodeGroup {
+				mng := &api.ManagedNodeGroup{
+					NodeGroupBase: &api.NodeGroupBase{
+						Name:       "mng",
+						CPUCredits: aws.String(api.CPUCreditsUnlimited),
+					},
+					InstanceTypes: instanceTypes,
+				}
+				api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+				return mng
+			}
+
+			It("accepts burstable instance types", func() {
+				Expect(api.ValidateManagedNodeGroup(newManagedNodeGroup("t3.large", "t3a.large"), 0)).To(Succeed())
+			})
+
+			It("errors if an instance type is not burstable", func() {
+				err := api.ValidateManagedNodeGroup(newManagedNodeGroup("t3.large", "c5.large"), 0)
+				Expect(err).To(MatchError(ContainSubstring(`managedNodeGroups[0].cpuCredits can only be set when all the instance types are burstable`)))
+			})
+		})
 	})
 
 	Describe("ssh flags", func() {
@@ -803,6 +1392,551 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("upgradePolicy", func() {
+		var cfg *api.ClusterConfig
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+		})
+
+		It("accepts the STANDARD and EXTENDED support types", func() {
+			for _, supportType := range []string{api.SupportTypeStandard, api.SupportTypeExtended} {
+				cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: supportType}
+				Expect(api.ValidateClusterConfig(cfg)).To(Succeed())
+			}
+		})
+
+		It("requires the support type", func() {
+			cfg.UpgradePolicy = &api.UpgradePolicy{}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("upgradePolicy.supportType must be set"))
+		})
+
+		It("rejects unknown support types", func() {
+			cfg.UpgradePolicy = &api.UpgradePolicy{SupportType: "extended"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(`invalid value "extended" for upgradePolicy.supportType: must be either "STANDARD" or "EXTENDED"`))
+		})
+	})
+
+	Describe("run ID and metadata.tags", func() {
+		It("accepts valid run IDs", func() {
+			Expect(api.ValidateRunID("")).To(Succeed())
+			Expect(api.ValidateRunID("github-actions/1234:attempt-2")).To(Succeed())
+		})
+
+		It("rejects run IDs longer than 256 characters", func() {
+			err := api.ValidateRunID(gostrings.Repeat("a", 257))
+			Expect(err).To(MatchError("--run-id must be at most 256 characters long, got 257"))
+		})
+
+		It("rejects run IDs with invalid characters", func() {
+			Expect(api.ValidateRunID("job#1")).NotTo(Succeed())
+		})
+
+		It("rejects tags that are too long for stack tags", func() {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Tags = map[string]string{gostrings.Repeat("k", 129): "value"}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("in metadata.tags: must be 1-128 characters long")))
+
+			cfg.Metadata.Tags = map[string]string{"key": gostrings.Repeat("v", 257)}
+			Expect(api.ValidateClusterConfig(cfg)).To(MatchError("invalid value for metadata.tags.key: must be at most 256 characters long"))
+		})
+	})
+
+	Describe("nodeGroups[*].localNVMe", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.LocalNVMe = &api.LocalNVMe{}
+		})
+
+		It("accepts the defaults", func() {
+			api.SetNodeGroupDefaults(ng, &api.ClusterMeta{})
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			Expect(ng.LocalNVMe.Mode).To(Equal(api.LocalNVMeModeRAID0))
+			Expect(ng.LocalNVMe.MountPath).To(Equal(api.DefaultLocalNVMeMountPath))
+		})
+
+		It("rejects an invalid mode", func() {
+			ng.LocalNVMe.Mode = "raid1"
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`invalid value "raid1" for nodeGroups[0].localNVMe.mode, valid options: raid0, single`))
+		})
+
+		It("rejects a relative mount path", func() {
+			ng.LocalNVMe.MountPath = "mnt/nvme"
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring(`invalid value "mnt/nvme" for nodeGroups[0].localNVMe.mountPath`)))
+		})
+
+		It("rejects a mount path with shell characters", func() {
+			ng.LocalNVMe.MountPath = "/mnt/$(reboot)"
+			Expect(api.ValidateNodeGroup(0, ng)).NotTo(Succeed())
+		})
+
+		It("is not supported by Bottlerocket nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("localNVMe")))
+		})
+
+		It("is not supported by nodegroups with a custom AMI", func() {
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng.AMI = "ami-123"
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError("localNVMe is not supported for AmazonLinux2 nodegroups with a custom AMI (path=nodeGroups[0].localNVMe)"))
+		})
+	})
+
+	Describe("nodeGroups[*].efsMounts", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.EFSMounts = []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}}
+		})
+
+		It("accepts file systems mounted at distinct paths", func() {
+			ng.EFSMounts = append(ng.EFSMounts, api.EFSMount{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/copy"})
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects an invalid file system ID", func() {
+			ng.EFSMounts[0].FileSystemID = "fsap-0123456789abcdef0"
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`invalid value "fsap-0123456789abcdef0" for nodeGroups[0].efsMounts[0].fileSystemID: must be the ID of an EFS file system, e.g. fs-0123456789abcdef0`))
+		})
+
+		It("rejects an invalid or reused mount path", func() {
+			ng.EFSMounts[0].MountPath = "/mnt/$(reboot)"
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring(`invalid value "/mnt/$(reboot)" for nodeGroups[0].efsMounts[0].mountPath`)))
+
+			ng.EFSMounts[0].MountPath = api.DefaultLocalNVMeMountPath
+			ng.LocalNVMe = &api.LocalNVMe{MountPath: api.DefaultLocalNVMeMountPath}
+			err = api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(`nodeGroups[0].efsMounts[0].mountPath: "/mnt/nvme" is already used by another mount of the nodegroup`))
+		})
+
+		It("is not supported by Ubuntu nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("efsMounts is not supported for Ubuntu2004 nodegroups")))
+		})
+	})
+
+	Describe("nodeGroups[*].dns", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.DNS = &api.NodeGroupDNS{
+				SearchDomains: []string{"corp.example.com", "Svc.Example.com."},
+				Options:       []string{"timeout:2", "rotate", "ndots:3"},
+			}
+		})
+
+		It("accepts well-formed search domains and options", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			mng := &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng", DNS: ng.DNS}}
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			Expect(api.ValidateManagedNodeGroup(mng, 0)).To(Succeed())
+		})
+
+		DescribeTable("invalid dns", func(update func(*api.NodeGroup), errMsg string) {
+			update(ng)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(HavePrefix(errMsg)))
+		},
+			Entry("no search domains nor options", func(ng *api.NodeGroup) {
+				ng.DNS = &api.NodeGroupDNS{}
+			}, "at least one of nodeGroups[0].dns.searchDomains or nodeGroups[0].dns.options must be set"),
+			Entry("too many search domains", func(ng *api.NodeGroup) {
+				ng.DNS.SearchDomains = []string{"a.com", "b.com", "c.com", "d.com", "e.com", "f.com", "g.com"}
+			}, "nodeGroups[0].dns.searchDomains can have at most 6 domains, got 7"),
+			Entry("malformed search domain", func(ng *api.NodeGroup) {
+				ng.DNS.SearchDomains = []string{"corp.example.com", "corp_example;reboot"}
+			}, `invalid value "corp_example;reboot" for nodeGroups[0].dns.searchDomains[1]`),
+			Entry("unknown option", func(ng *api.NodeGroup) {
+				ng.DNS.Options = []string{"timeout:two"}
+			}, `invalid value "timeout:two" for nodeGroups[0].dns.options[0]: must be a resolver option of resolv.conf, e.g. timeout:2 or rotate`),
+			Entry("overrideBootstrapCommand", func(ng *api.NodeGroup) {
+				ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster")
+			}, "nodeGroups[0].dns cannot be set with nodeGroups[0].overrideBootstrapCommand, as kubelet is configured by the bootstrap command"),
+			Entry("resolvConf in kubeletExtraConfig", func(ng *api.NodeGroup) {
+				ng.KubeletExtraConfig = &api.InlineDocument{"resolvConf": "/etc/resolv.conf"}
+			}, "nodeGroups[0].dns cannot be set with resolvConf in nodeGroups[0].kubeletExtraConfig"),
+			Entry("custom AMI", func(ng *api.NodeGroup) {
+				ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+				ng.AMI = "ami-123"
+			}, "dns is not supported for Ubuntu2004 nodegroups with a custom AMI (path=nodeGroups[0].dns)"),
+		)
+
+		It("is not supported by Bottlerocket nodegroups", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(MatchError(ContainSubstring("dns")))
+		})
+	})
+
+	Describe("nodeGroups[*].nodeNameSource", func() {
+		DescribeTable("valid node name sources", func(nodeNameSource, amiFamily string) {
+			ng := newNodeGroup()
+			ng.NodeNameSource = nodeNameSource
+			ng.AMIFamily = amiFamily
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		},
+			Entry("unset", "", api.NodeImageFamilyAmazonLinux2),
+			Entry("private DNS name", api.NodeNameSourcePrivateDNSName, api.NodeImageFamilyAmazonLinux2),
+			Entry("resource name", api.NodeNameSourceResourceName, api.NodeImageFamilyAmazonLinux2),
+			Entry("resource name on Ubuntu", api.NodeNameSourceResourceName, api.NodeImageFamilyUbuntu2004),
+			Entry("resource name on Bottlerocket", api.NodeNameSourceResourceName, api.NodeImageFamilyBottlerocket),
+		)
+
+		It("rejects an unknown node name source", func() {
+			ng := newNodeGroup()
+			ng.NodeNameSource = "instanceID"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid value "instanceID" for nodeGroups[0].nodeNameSource, valid options: privateDNSName, resourceName`))
+		})
+
+		It("is not supported by Windows nodegroups", func() {
+			ng := newNodeGroup()
+			ng.NodeNameSource = api.NodeNameSourceResourceName
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("nodeNameSource")))
+		})
+	})
+
+	Describe("nodeGroups[*].capacityTypeLabel", func() {
+		It("accepts a label key", func() {
+			ng := newNodeGroup()
+			ng.CapacityTypeLabel = "example.com/capacity-type"
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+			Expect(ng.GetCapacityTypeLabel()).To(Equal("example.com/capacity-type"))
+
+			ng.CapacityTypeLabel = ""
+			Expect(ng.GetCapacityTypeLabel()).To(Equal(api.DefaultCapacityTypeLabel))
+		})
+
+		It("rejects invalid label keys", func() {
+			ng := newNodeGroup()
+			ng.CapacityTypeLabel = "example.com/capacity/type"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid nodeGroups[0].capacityTypeLabel: node label key "example.com/capacity/type" is of invalid format, can only use one '/' separator`))
+
+			ng.CapacityTypeLabel = "kubernetes.io/capacity-type"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("unknown 'kubernetes.io' or 'k8s.io' labels were specified")))
+		})
+
+		It("rejects a label also set in labels", func() {
+			ng := newNodeGroup()
+			ng.CapacityTypeLabel = "example.com/capacity-type"
+			ng.Labels = map[string]string{"example.com/capacity-type": "SPOT"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityTypeLabel "example.com/capacity-type" cannot also be set in nodeGroups[0].labels, as it is set after the lifecycle of the instances`))
+		})
+
+		It("is not supported by Windows nodegroups", func() {
+			ng := newNodeGroup()
+			ng.CapacityTypeLabel = "example.com/capacity-type"
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("capacityTypeLabel")))
+		})
+
+		It("cannot be set with overrideBootstrapCommand", func() {
+			ng := newNodeGroup()
+			ng.CapacityTypeLabel = "example.com/capacity-type"
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh cluster")
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].capacityTypeLabel cannot be set with nodeGroups[0].overrideBootstrapCommand, as kubelet is configured by the bootstrap command"))
+		})
+
+		It("is not supported by nodegroups with a custom AMI", func() {
+			ng := newNodeGroup()
+			ng.CapacityTypeLabel = "example.com/capacity-type"
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng.AMI = "ami-123"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("capacityTypeLabel is not supported for AmazonLinux2 nodegroups with a custom AMI (path=nodeGroups[0].capacityTypeLabel)"))
+		})
+	})
+
+	Describe("nodeGroups[*].warmPool", func() {
+		DescribeTable("valid warm pools", func(warmPool *api.WarmPool) {
+			ng := newNodeGroup()
+			ng.WarmPool = warmPool
+			ng.VolumeSize = aws.Int(80)
+			ng.VolumeEncrypted = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		},
+			Entry("defaults", &api.WarmPool{}),
+			Entry("stopped", &api.WarmPool{PoolState: api.WarmPoolStateStopped, MinSize: aws.Int(0)}),
+			Entry("running", &api.WarmPool{PoolState: api.WarmPoolStateRunning, MinSize: aws.Int(2), MaxGroupPreparedCapacity: aws.Int(2)}),
+			Entry("hibernated", &api.WarmPool{PoolState: api.WarmPoolStateHibernated, ReuseOnScaleIn: aws.Bool(true)}),
+		)
+
+		DescribeTable("invalid warm pools", func(warmPool *api.WarmPool, errMsg string) {
+			ng := newNodeGroup()
+			ng.WarmPool = warmPool
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(errMsg))
+		},
+			Entry("unknown pool state", &api.WarmPool{PoolState: "Terminated"}, `invalid value "Terminated" for nodeGroups[0].warmPool.poolState, valid options: Stopped, Running, Hibernated`),
+			Entry("hibernated without encrypted volumes", &api.WarmPool{PoolState: api.WarmPoolStateHibernated}, "nodeGroups[0].warmPool.poolState Hibernated requires nodeGroups[0].volumeEncrypted, as hibernated instances save their memory on their root volume"),
+			Entry("negative minSize", &api.WarmPool{MinSize: aws.Int(-1)}, "nodeGroups[0].warmPool.minSize cannot be negative"),
+			Entry("no prepared capacity", &api.WarmPool{MaxGroupPreparedCapacity: aws.Int(0)}, "nodeGroups[0].warmPool.maxGroupPreparedCapacity must be at least 1"),
+			Entry("prepared capacity below minSize", &api.WarmPool{MinSize: aws.Int(3), MaxGroupPreparedCapacity: aws.Int(2)}, "nodeGroups[0].warmPool.maxGroupPreparedCapacity cannot be less than nodeGroups[0].warmPool.minSize"),
+		)
+
+		It("cannot be set with instancesDistribution", func() {
+			ng := newNodeGroup()
+			ng.WarmPool = &api.WarmPool{}
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{InstanceTypes: []string{"m5.large", "m5a.large"}}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].warmPool cannot be set with nodeGroups[0].instancesDistribution, as Auto Scaling groups with mixed instances do not support warm pools"))
+		})
+
+		It("is not supported by Bottlerocket nodegroups", func() {
+			ng := newNodeGroup()
+			ng.WarmPool = &api.WarmPool{}
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("warmPool")))
+		})
+
+		It("is not supported by nodegroups with a custom AMI", func() {
+			ng := newNodeGroup()
+			ng.WarmPool = &api.WarmPool{}
+			ng.AMIFamily = api.NodeImageFamilyUbuntu1804
+			ng.AMI = "ami-123"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("warmPool is not supported for Ubuntu1804 nodegroups with a custom AMI (path=nodeGroups[0].warmPool)"))
+		})
+	})
+
+	Describe("nodeGroups[*].instanceRefresh", func() {
+		It("accepts valid preferences", func() {
+			ng := newNodeGroup()
+			ng.InstanceRefresh = &api.InstanceRefresh{MinHealthyPercentage: aws.Int(100), InstanceWarmup: aws.Int(0)}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		DescribeTable("invalid preferences", func(instanceRefresh *api.InstanceRefresh, errMsg string) {
+			ng := newNodeGroup()
+			ng.InstanceRefresh = instanceRefresh
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(errMsg))
+		},
+			Entry("minHealthyPercentage above 100", &api.InstanceRefresh{MinHealthyPercentage: aws.Int(101)}, "nodeGroups[0].instanceRefresh.minHealthyPercentage must be between 0 and 100"),
+			Entry("negative minHealthyPercentage", &api.InstanceRefresh{MinHealthyPercentage: aws.Int(-1)}, "nodeGroups[0].instanceRefresh.minHealthyPercentage must be between 0 and 100"),
+			Entry("negative instanceWarmup", &api.InstanceRefresh{InstanceWarmup: aws.Int(-30)}, "nodeGroups[0].instanceRefresh.instanceWarmup cannot be negative"),
+		)
+
+		It("cannot be set when the InstanceRefresh process is suspended", func() {
+			ng := newNodeGroup()
+			ng.InstanceRefresh = &api.InstanceRefresh{}
+			ng.ASGSuspendProcesses = []string{"AZRebalance", "InstanceRefresh"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].instanceRefresh cannot be set when nodeGroups[0].asgSuspendProcesses suspends InstanceRefresh"))
+		})
+	})
+
+	Describe("nodeGroups[*].tenancy", func() {
+		const hostResourceGroupARN = "arn:aws:resource-groups:us-west-2:123456789012:group/hosts"
+
+		DescribeTable("valid tenancies", func(tenancy, hostResourceGroup string) {
+			ng := newNodeGroup()
+			ng.Tenancy = tenancy
+			ng.HostResourceGroupARN = hostResourceGroup
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+
+			mng := &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng"}}
+			mng.Tenancy = tenancy
+			mng.HostResourceGroupARN = hostResourceGroup
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			Expect(api.ValidateManagedNodeGroup(mng, 0)).To(Succeed())
+		},
+			Entry("unset", "", ""),
+			Entry("default", api.TenancyDefault, ""),
+			Entry("dedicated", api.TenancyDedicated, ""),
+			Entry("host with a host resource group", api.TenancyHost, hostResourceGroupARN),
+		)
+
+		DescribeTable("invalid tenancies", func(tenancy, hostResourceGroup, errMsg string) {
+			ng := newNodeGroup()
+			ng.Tenancy = tenancy
+			ng.HostResourceGroupARN = hostResourceGroup
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(errMsg))
+		},
+			Entry("unknown tenancy", "shared", "", `invalid value "shared" for nodeGroups[0].tenancy, valid options: default, dedicated, host`),
+			Entry("host without a host resource group", api.TenancyHost, "", `nodeGroups[0].hostResourceGroupARN must be set when nodeGroups[0].tenancy is "host", as the instances must be launched on a Dedicated Host`),
+			Entry("host with an invalid host resource group", api.TenancyHost, "hosts", `nodeGroups[0].hostResourceGroupARN "hosts" is not a valid ARN`),
+			Entry("host resource group without host tenancy", api.TenancyDedicated, hostResourceGroupARN, `nodeGroups[0].hostResourceGroupARN can only be set when nodeGroups[0].tenancy is "host"`),
+		)
+	})
+
+	Describe("nodeGroups[*].spotInterruptionHandler", func() {
+		newSpotNodeGroup := func(onDemandPercentage int) *api.NodeGroup {
+			ng := newNodeGroup()
+			ng.SpotInterruptionHandler = api.Enabled()
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes:                       []string{"m5.large", "m5a.large"},
+				OnDemandPercentageAboveBaseCapacity: &onDemandPercentage,
+			}
+			return ng
+		}
+
+		It("accepts nodegroups with spot instances", func() {
+			Expect(api.ValidateNodeGroup(0, newSpotNodeGroup(0))).To(Succeed())
+			Expect(api.ValidateNodeGroup(0, newSpotNodeGroup(50))).To(Succeed())
+		})
+
+		It("rejects nodegroups without spot instances", func() {
+			const errMsg = "nodeGroups[0].spotInterruptionHandler requires spot instances, set instancesDistribution.onDemandPercentageAboveBaseCapacity below 100"
+			Expect(api.ValidateNodeGroup(0, newSpotNodeGroup(100))).To(MatchError(errMsg))
+
+			ng := newNodeGroup()
+			ng.SpotInterruptionHandler = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(errMsg))
+		})
+
+		It("requires managed nodegroups to use spot", func() {
+			mng := &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng", SpotInterruptionHandler: api.Enabled()}}
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			Expect(api.ValidateManagedNodeGroup(mng, 0)).To(MatchError("managedNodeGroups[0].spotInterruptionHandler requires spot to be set"))
+
+			mng.Spot = true
+			Expect(api.ValidateManagedNodeGroup(mng, 0)).To(Succeed())
+		})
+	})
+
+	Describe("nodeGroups[*].additionalUserDataParts", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.AdditionalUserDataParts = []api.UserDataPart{
+				{ContentType: "text/cloud-config", Content: "#cloud-config"},
+				{ContentType: `text/x-shellscript; charset="us-ascii"`, Content: "#!/bin/bash"},
+			}
+		})
+
+		It("accepts parts with a content type", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires a content type", func() {
+			ng.AdditionalUserDataParts[1].ContentType = ""
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalUserDataParts[1].contentType must be set"))
+		})
+
+		It("rejects invalid content types", func() {
+			ng.AdditionalUserDataParts[0].ContentType = "text/"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`invalid value "text/" for nodeGroups[0].additionalUserDataParts[0].contentType`)))
+
+			ng.AdditionalUserDataParts[0].ContentType = "multipart/mixed"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`invalid value "multipart/mixed" for nodeGroups[0].additionalUserDataParts[0].contentType: parts cannot be multipart documents`))
+		})
+
+		It("requires content", func() {
+			ng.AdditionalUserDataParts[0].Content = ""
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalUserDataParts[0].content must be set"))
+		})
+
+		It("is not supported by Bottlerocket", func() {
+			ng.AMIFamily = api.NodeImageFamilyBottlerocket
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("additionalUserDataParts is not supported for Bottlerocket nodegroups (path=nodeGroups[0].additionalUserDataParts)"))
+		})
+	})
+
+	Describe("nodeGroups[*].secondaryNetworkInterfaces", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			ng.PrivateNetworking = true
+			ng.SecondaryNetworkInterfaces = []api.SecondaryNetworkInterface{
+				{DeviceIndex: 1, Subnet: "subnet-1"},
+				{DeviceIndex: 2, Subnet: "subnet-2", SecurityGroups: []string{"sg-1"}},
+			}
+		})
+
+		It("accepts network interfaces with unique device indices", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects duplicate device indices", func() {
+			ng.SecondaryNetworkInterfaces[1].DeviceIndex = 1
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].secondaryNetworkInterfaces[1].deviceIndex 1 is used by another network interface"))
+		})
+
+		It("rejects the device index of the primary network interface", func() {
+			ng.SecondaryNetworkInterfaces[0].DeviceIndex = 0
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].secondaryNetworkInterfaces[0].deviceIndex must be greater than 0, as device index 0 is the primary network interface"))
+		})
+
+		It("requires a subnet ID", func() {
+			ng.SecondaryNetworkInterfaces[1].Subnet = "my-subnet"
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].secondaryNetworkInterfaces[1].subnet must be set to a subnet ID"))
+		})
+
+		It("requires a single availability zone", func() {
+			ng.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring("requires the nodegroup to have only one subnet or one availability zone")))
+
+			ng.AvailabilityZones = nil
+			ng.Subnets = []string{"subnet-primary"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("requires private networking", func() {
+			ng.PrivateNetworking = false
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].secondaryNetworkInterfaces requires nodeGroups[0].privateNetworking, as instances with multiple network interfaces are not assigned a public IP address"))
+		})
+
+		It("cannot be used with EFA", func() {
+			ng.EFAEnabled = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].secondaryNetworkInterfaces cannot be used with nodeGroups[0].efaEnabled"))
+		})
+	})
+
+	Describe("asgSuspendProcesses", func() {
+		DescribeTable("nodegroups", func(processes []string, errMsg string) {
+			ng := newNodeGroup()
+			ng.ASGSuspendProcesses = processes
+			err := api.ValidateNodeGroup(0, ng)
+			if errMsg == "" {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(errMsg))
+			}
+		},
+			Entry("valid processes", []string{"AZRebalance", "ReplaceUnhealthy"}, ""),
+			Entry("all processes", []string{"Launch", "Terminate", "AddToLoadBalancer", "AlarmNotification", "AZRebalance", "HealthCheck", "InstanceRefresh", "ReplaceUnhealthy", "ScheduledActions"}, ""),
+			Entry("invalid process", []string{"AZRebalance", "Rebalance"}, `nodeGroups[0].asgSuspendProcesses contains invalid process name "Rebalance", valid process names are Launch, Terminate, AddToLoadBalancer, AlarmNotification, AZRebalance, HealthCheck, InstanceRefresh, ReplaceUnhealthy, ScheduledActions`),
+			Entry("process names are case sensitive", []string{"azrebalance"}, `nodeGroups[0].asgSuspendProcesses contains invalid process name "azrebalance", valid process names are Launch, Terminate, AddToLoadBalancer, AlarmNotification, AZRebalance, HealthCheck, InstanceRefresh, ReplaceUnhealthy, ScheduledActions`),
+			Entry("duplicate process", []string{"AZRebalance", "AZRebalance"}, `nodeGroups[0].asgSuspendProcesses contains process "AZRebalance" more than once`),
+		)
+
+		It("validates the processes of managed nodegroups", func() {
+			mng := &api.ManagedNodeGroup{NodeGroupBase: &api.NodeGroupBase{Name: "mng", ASGSuspendProcesses: []string{"AZRebalance"}}}
+			api.SetManagedNodeGroupDefaults(mng, &api.ClusterMeta{Name: "cluster"})
+			Expect(api.ValidateManagedNodeGroup(mng, 0)).To(Succeed())
+
+			mng.ASGSuspendProcesses = []string{"Rebalance"}
+			Expect(api.ValidateManagedNodeGroup(mng, 0)).To(MatchError(ContainSubstring(`managedNodeGroups[0].asgSuspendProcesses contains invalid process name "Rebalance"`)))
+		})
+	})
+
+	Describe("nodeGroups[*].associatePublicIPAddress", func() {
+		It("accepts both values whatever the subnets", func() {
+			for _, privateNetworking := range []bool{true, false} {
+				for _, associate := range []bool{true, false} {
+					ng := newNodeGroup()
+					ng.PrivateNetworking = privateNetworking
+					ng.AssociatePublicIPAddress = aws.Bool(associate)
+					Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+				}
+			}
+		})
+
+		It("cannot be used with secondary network interfaces", func() {
+			ng := newNodeGroup()
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			ng.PrivateNetworking = true
+			ng.SecondaryNetworkInterfaces = []api.SecondaryNetworkInterface{{DeviceIndex: 1, Subnet: "subnet-1"}}
+			ng.AssociatePublicIPAddress = aws.Bool(false)
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].associatePublicIPAddress cannot be used with nodeGroups[0].secondaryNetworkInterfaces"))
+		})
+	})
+
 	Describe("FargateProfile", func() {
 		Describe("Validate", func() {
 			It("returns an error when the profile's name is empty", func() {
@@ -930,6 +2064,20 @@ var _ = Describe("ClusterConfig validation", func() {
 			}
 		})
 
+		It("rejects prefix delegation", func() {
+			ng := newNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			ng.PrefixDelegation = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].prefixDelegation is not supported by Windows nodegroups"))
+		})
+
+		It("rejects the spot interruption handler", func() {
+			ng := newNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+			ng.SpotInterruptionHandler = api.Enabled()
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].spotInterruptionHandler is not supported by Windows nodegroups"))
+		})
+
 		It("has no error with supported fields", func() {
 			x := 32
 			ngs := []*api.NodeGroup{
@@ -951,6 +2099,41 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
+	Describe("Custom node groups", func() {
+		var ng *api.NodeGroup
+		BeforeEach(func() {
+			cmd := "/opt/node-init/start.sh"
+			ng = api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyCustom
+			ng.AMI = "ami-123"
+			ng.OverrideBootstrapCommand = &cmd
+		})
+
+		It("succeeds when an AMI ID and overrideBootstrapCommand are set", func() {
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("fails when the AMI is not an AMI ID", func() {
+			ng.AMI = api.NodeImageResolverAutoSSM
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].ami must be set to an AMI ID when using amiFamily Custom"))
+		})
+
+		It("fails when overrideBootstrapCommand is not set", func() {
+			ng.OverrideBootstrapCommand = nil
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].overrideBootstrapCommand is required when using amiFamily Custom"))
+		})
+
+		It("fails when kubeletExtraConfig is set", func() {
+			ng.KubeletExtraConfig = &api.InlineDocument{"cgroupDriver": "systemd"}
+			Expect(api.ValidateNodeGroup(0, ng)).To(HaveOccurred())
+		})
+
+		It("fails when kubeletCgroupDriver is set", func() {
+			ng.KubeletCgroupDriver = aws.String(api.KubeletCgroupDriverSystemd)
+			Expect(api.ValidateNodeGroup(0, ng)).To(HaveOccurred())
+		})
+	})
+
 	type kmsFieldCase struct {
 		secretsEncryption *api.SecretsEncryption
 		errSubstr         string
@@ -993,7 +2176,7 @@ var _ = Describe("ClusterConfig validation", func() {
 		It("fails when the AMIFamily is not supported", func() {
 			ng.AMIFamily = "SomeTrash"
 			err := api.ValidateNodeGroup(0, ng)
-			Expect(err).To(MatchError("AMI Family SomeTrash is not supported - use one of: AmazonLinux2, Ubuntu2004, Ubuntu1804, Bottlerocket, WindowsServer2019CoreContainer, WindowsServer2019FullContainer, WindowsServer2004CoreContainer"))
+			Expect(err).To(MatchError("AMI Family SomeTrash is not supported - use one of: AmazonLinux2, Ubuntu2004, Ubuntu1804, Bottlerocket, Custom, WindowsServer2019CoreContainer, WindowsServer2019FullContainer, WindowsServer2004CoreContainer"))
 		})
 	})
 
@@ -1124,6 +2307,68 @@ var _ = Describe("ClusterConfig validation", func() {
 			},
 		}),
 	)
+
+	Describe("Nodegroup startup taints validation", func() {
+		var ng *api.NodeGroup
+
+		BeforeEach(func() {
+			ng = newNodeGroup()
+			ng.Taints = []api.NodeGroupTaint{
+				{
+					Key:    "key1",
+					Value:  "value1",
+					Effect: "NoSchedule",
+				},
+			}
+		})
+
+		It("accepts valid startup taints", func() {
+			ng.StartupTaints = []api.NodeGroupTaint{
+				{
+					Key:    "nvidia.com/gpu-driver",
+					Value:  "installing",
+					Effect: "NoSchedule",
+				},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("rejects invalid startup taints", func() {
+			ng.StartupTaints = []api.NodeGroupTaint{
+				{
+					Key:   "nvidia.com/gpu-driver",
+					Value: "installing",
+				},
+			}
+			err := api.ValidateNodeGroup(0, ng)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid nodeGroups[0].startupTaints"))
+		})
+
+		It("rejects startup taints that are also set in taints", func() {
+			ng.StartupTaints = []api.NodeGroupTaint{
+				{
+					Key:    "key1",
+					Effect: "NoExecute",
+				},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].startupTaints contains taint "key1", which is already set in nodeGroups[0].taints or nodeGroups[0].startupTaints`))
+		})
+
+		It("rejects duplicate startup taints", func() {
+			ng.StartupTaints = []api.NodeGroupTaint{
+				{
+					Key:    "key2",
+					Effect: "NoSchedule",
+				},
+				{
+					Key:    "key2",
+					Effect: "NoExecute",
+				},
+			}
+			Expect(api.ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`startupTaints contains taint "key2"`)))
+		})
+	})
 })
 
 func newInt(value int) *int {
@@ -137,6 +137,12 @@ type (
 		// private subnets or any ad-hoc subnets
 		// +optional
 		ExtraCIDRs []string `json:"extraCIDRs,omitempty"`
+		// SecondaryCIDRs are IPv4 CIDR blocks associated with the VPC created by
+		// eksctl, a private subnet of each of them is created in every availability
+		// zone, e.g. to place pods in with custom networking.
+		// See [Secondary CIDRs](/usage/vpc-networking/#secondary-cidrs)
+		// +optional
+		SecondaryCIDRs []string `json:"secondaryCIDRs,omitempty"`
 		// for pre-defined shared node SG
 		SharedNodeSecurityGroup string `json:"sharedNodeSecurityGroup,omitempty"`
 		// Automatically add security group rules to and from the default
@@ -159,6 +165,39 @@ type (
 		// k8s API endpoint
 		// +optional
 		PublicAccessCIDRs []string `json:"publicAccessCIDRs,omitempty"`
+		// ControlPlaneSecurityGroupRules restricts the rules between the
+		// control plane security group and the security groups of unmanaged nodegroups
+		// +optional
+		ControlPlaneSecurityGroupRules *ControlPlaneSecurityGroupRules `json:"controlPlaneSecurityGroupRules,omitempty"`
+		// CustomNetworking enables VPC CNI custom networking, placing pods in other subnets than their nodes.
+		// See [Custom networking](/usage/vpc-networking/#custom-networking)
+		// +optional
+		CustomNetworking *CustomNetworking `json:"customNetworking,omitempty"`
+	}
+	// CustomNetworking holds the subnets and security groups of the pods with VPC CNI custom networking
+	CustomNetworking struct {
+		// PodSubnets maps availability zones to the IDs of the subnets pods are placed in,
+		// e.g. subnets of a secondary CIDR of the VPC. Defaults to the subnets of the
+		// first of `vpc.secondaryCIDRs` when eksctl creates the VPC
+		// +optional
+		PodSubnets map[string]string `json:"podSubnets,omitempty"`
+		// SecurityGroups are the IDs of the security groups of the pod network interfaces,
+		// the security groups of the nodes are used if not set
+		// +optional
+		SecurityGroups []string `json:"securityGroups,omitempty"`
+	}
+	// ControlPlaneSecurityGroupRules holds the ports opened between the control plane and nodes
+	ControlPlaneSecurityGroupRules struct {
+		// NodePorts are the TCP port ranges on nodes that the control plane can communicate with,
+		// these should include 10250 (kubelet) and 443 (extension API servers and webhooks).
+		// Defaults to 443 and 1025-65535
+		// +optional
+		NodePorts []PortRange `json:"nodePorts,omitempty"`
+	}
+	// PortRange is a range of ports, inclusive
+	PortRange struct {
+		FromPort int `json:"fromPort"`
+		ToPort   int `json:"toPort"`
 	}
 	// ClusterSubnets holds private and public subnets
 	ClusterSubnets struct {
@@ -196,6 +235,16 @@ type (
 	}
 )
 
+// HasNodePort returns true if the port is in one of the node port ranges
+func (r *ControlPlaneSecurityGroupRules) HasNodePort(port int) bool {
+	for _, pr := range r.NodePorts {
+		if port >= pr.FromPort && port <= pr.ToPort {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	// MinRequiredSubnets is the minimum required number of subnets
 	MinRequiredSubnets = 2
@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha5
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -67,6 +68,43 @@ func (in *AZSubnetSpec) DeepCopy() *AZSubnetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSNodeConfig) DeepCopyInto(out *AWSNodeConfig) {
+	*out = *in
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(AWSNodeNetworkPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSNodeConfig.
+func (in *AWSNodeConfig) DeepCopy() *AWSNodeConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSNodeConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSNodeNetworkPolicy) DeepCopyInto(out *AWSNodeNetworkPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSNodeNetworkPolicy.
+func (in *AWSNodeNetworkPolicy) DeepCopy() *AWSNodeNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSNodeNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addon) DeepCopyInto(out *Addon) {
 	*out = *in
@@ -84,6 +122,11 @@ func (in *Addon) DeepCopyInto(out *Addon) {
 			(*out)[key] = val
 		}
 	}
+	if in.WebhookCertificates != nil {
+		in, out := &in.WebhookCertificates, &out.WebhookCertificates
+		*out = new(AddonWebhookCertificates)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -97,6 +140,85 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonWebhookCertificates) DeepCopyInto(out *AddonWebhookCertificates) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExpiryThresholdDays != nil {
+		in, out := &in.ExpiryThresholdDays, &out.ExpiryThresholdDays
+		*out = new(int)
+		**out = **in
+	}
+	if in.RestartDeployments != nil {
+		in, out := &in.RestartDeployments, &out.RestartDeployments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonWebhookCertificates.
+func (in *AddonWebhookCertificates) DeepCopy() *AddonWebhookCertificates {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonWebhookCertificates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudFormationConfig) DeepCopyInto(out *CloudFormationConfig) {
+	*out = *in
+	if in.Capabilities != nil {
+		in, out := &in.Capabilities, &out.Capabilities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.StackPolicy.DeepCopyInto(&out.StackPolicy)
+	if in.NotificationARNs != nil {
+		in, out := &in.NotificationARNs, &out.NotificationARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timeouts != nil {
+		in, out := &in.Timeouts, &out.Timeouts
+		*out = new(CloudFormationTimeouts)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudFormationTimeouts) DeepCopyInto(out *CloudFormationTimeouts) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFormationTimeouts.
+func (in *CloudFormationTimeouts) DeepCopy() *CloudFormationTimeouts {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudFormationTimeouts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudFormationConfig.
+func (in *CloudFormationConfig) DeepCopy() *CloudFormationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudFormationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterCloudWatch) DeepCopyInto(out *ClusterCloudWatch) {
 	*out = *in
@@ -153,6 +275,16 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(KubernetesNetworkConfig)
 		**out = **in
 	}
+	if in.UpgradePolicy != nil {
+		in, out := &in.UpgradePolicy, &out.UpgradePolicy
+		*out = new(UpgradePolicy)
+		**out = **in
+	}
+	if in.CloudFormation != nil {
+		in, out := &in.CloudFormation, &out.CloudFormation
+		*out = new(CloudFormationConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.IAM != nil {
 		in, out := &in.IAM, &out.IAM
 		*out = new(ClusterIAM)
@@ -165,6 +297,17 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IAMIdentityMappings != nil {
+		in, out := &in.IAMIdentityMappings, &out.IAMIdentityMappings
+		*out = make([]*IAMIdentityMapping, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IAMIdentityMapping)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.VPC != nil {
 		in, out := &in.VPC, &out.VPC
 		*out = new(ClusterVPC)
@@ -208,6 +351,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 			}
 		}
 	}
+	if in.NodeGroupDefaults != nil {
+		in, out := &in.NodeGroupDefaults, &out.NodeGroupDefaults
+		*out = new(NodeGroupDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.FargateProfiles != nil {
 		in, out := &in.FargateProfiles, &out.FargateProfiles
 		*out = make([]*FargateProfile, len(*in))
@@ -234,6 +382,26 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(SecretsEncryption)
 		**out = **in
 	}
+	if in.CoreDNS != nil {
+		in, out := &in.CoreDNS, &out.CoreDNS
+		*out = new(CoreDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxyConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSNode != nil {
+		in, out := &in.AWSNode, &out.AWSNode
+		*out = new(AWSNodeConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetricsServer != nil {
+		in, out := &in.MetricsServer, &out.MetricsServer
+		*out = new(MetricsServerConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(ClusterStatus)
@@ -275,6 +443,11 @@ func (in *ClusterConfigList) DeepCopyInto(out *ClusterConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Defaults != nil {
+		in, out := &in.Defaults, &out.Defaults
+		*out = new(ClusterConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]ClusterConfig, len(*in))
@@ -352,6 +525,12 @@ func (in *ClusterIAM) DeepCopyInto(out *ClusterIAM) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FargatePodExecutionRoleAttachPolicyARNs != nil {
+		in, out := &in.FargatePodExecutionRoleAttachPolicyARNs, &out.FargatePodExecutionRoleAttachPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.FargatePodExecutionRoleAttachPolicy.DeepCopyInto(&out.FargatePodExecutionRoleAttachPolicy)
 	if in.WithOIDC != nil {
 		in, out := &in.WithOIDC, &out.WithOIDC
 		*out = new(bool)
@@ -373,6 +552,13 @@ func (in *ClusterIAM) DeepCopyInto(out *ClusterIAM) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -599,6 +785,11 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.SecondaryCIDRs != nil {
+		in, out := &in.SecondaryCIDRs, &out.SecondaryCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.ManageSharedNodeSecurityGroupRules != nil {
 		in, out := &in.ManageSharedNodeSecurityGroupRules, &out.ManageSharedNodeSecurityGroupRules
 		*out = new(bool)
@@ -624,6 +815,16 @@ func (in *ClusterVPC) DeepCopyInto(out *ClusterVPC) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ControlPlaneSecurityGroupRules != nil {
+		in, out := &in.ControlPlaneSecurityGroupRules, &out.ControlPlaneSecurityGroupRules
+		*out = new(ControlPlaneSecurityGroupRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomNetworking != nil {
+		in, out := &in.CustomNetworking, &out.CustomNetworking
+		*out = new(CustomNetworking)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -637,6 +838,144 @@ func (in *ClusterVPC) DeepCopy() *ClusterVPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerdConfig) DeepCopyInto(out *ContainerdConfig) {
+	*out = *in
+	if in.RegistryMirrors != nil {
+		in, out := &in.RegistryMirrors, &out.RegistryMirrors
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerdConfig.
+func (in *ContainerdConfig) DeepCopy() *ContainerdConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerdConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneSecurityGroupRules) DeepCopyInto(out *ControlPlaneSecurityGroupRules) {
+	*out = *in
+	if in.NodePorts != nil {
+		in, out := &in.NodePorts, &out.NodePorts
+		*out = make([]PortRange, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneSecurityGroupRules.
+func (in *ControlPlaneSecurityGroupRules) DeepCopy() *ControlPlaneSecurityGroupRules {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneSecurityGroupRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSConfig) DeepCopyInto(out *CoreDNSConfig) {
+	*out = *in
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSConfig.
+func (in *CoreDNSConfig) DeepCopy() *CoreDNSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomNetworking) DeepCopyInto(out *CustomNetworking) {
+	*out = *in
+	if in.PodSubnets != nil {
+		in, out := &in.PodSubnets, &out.PodSubnets
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomNetworking.
+func (in *CustomNetworking) DeepCopy() *CustomNetworking {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomNetworking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFSMount) DeepCopyInto(out *EFSMount) {
+	*out = *in
+	if in.MountTargetSecurityGroupIDs != nil {
+		in, out := &in.MountTargetSecurityGroupIDs, &out.MountTargetSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFSMount.
+func (in *EFSMount) DeepCopy() *EFSMount {
+	if in == nil {
+		return nil
+	}
+	out := new(EFSMount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfile) DeepCopyInto(out *FargateProfile) {
 	*out = *in
@@ -788,6 +1127,27 @@ func (in *GitOps) DeepCopy() *GitOps {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMIdentityMapping) DeepCopyInto(out *IAMIdentityMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMIdentityMapping.
+func (in *IAMIdentityMapping) DeepCopy() *IAMIdentityMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMIdentityMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProvider) DeepCopyInto(out *IdentityProvider) {
 	*out = *in
@@ -817,6 +1177,32 @@ func (in InlineDocument) DeepCopyInto(out *InlineDocument) {
 	}
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceRefresh) DeepCopyInto(out *InstanceRefresh) {
+	*out = *in
+	if in.MinHealthyPercentage != nil {
+		in, out := &in.MinHealthyPercentage, &out.MinHealthyPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.InstanceWarmup != nil {
+		in, out := &in.InstanceWarmup, &out.InstanceWarmup
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceRefresh.
+func (in *InstanceRefresh) DeepCopy() *InstanceRefresh {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceRefresh)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstanceSelector) DeepCopyInto(out *InstanceSelector) {
 	*out = *in
@@ -838,6 +1224,36 @@ func (in *InstanceSelector) DeepCopy() *InstanceSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
+	*out = *in
+	if in.PodLabels != nil {
+		in, out := &in.PodLabels, &out.PodLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PodAnnotations != nil {
+		in, out := &in.PodAnnotations, &out.PodAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxyConfig.
+func (in *KubeProxyConfig) DeepCopy() *KubeProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesNetworkConfig) DeepCopyInto(out *KubernetesNetworkConfig) {
 	*out = *in
@@ -875,6 +1291,22 @@ func (in *LaunchTemplate) DeepCopy() *LaunchTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalNVMe) DeepCopyInto(out *LocalNVMe) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalNVMe.
+func (in *LocalNVMe) DeepCopy() *LocalNVMe {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalNVMe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedNodeGroup) DeepCopyInto(out *ManagedNodeGroup) {
 	*out = *in
@@ -937,6 +1369,32 @@ func (in *MetricsCollection) DeepCopy() *MetricsCollection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsServerConfig) DeepCopyInto(out *MetricsServerConfig) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int)
+		**out = **in
+	}
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsServerConfig.
+func (in *MetricsServerConfig) DeepCopy() *MetricsServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -977,11 +1435,6 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.CPUCredits != nil {
-		in, out := &in.CPUCredits, &out.CPUCredits
-		*out = new(string)
-		**out = **in
-	}
 	if in.ClassicLoadBalancerNames != nil {
 		in, out := &in.ClassicLoadBalancerNames, &out.ClassicLoadBalancerNames
 		*out = make([]string, len(*in))
@@ -997,6 +1450,11 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = make(taintsWrapper, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartupTaints != nil {
+		in, out := &in.StartupTaints, &out.StartupTaints
+		*out = make([]NodeGroupTaint, len(*in))
+		copy(*out, *in)
+	}
 	if in.UpdateConfig != nil {
 		in, out := &in.UpdateConfig, &out.UpdateConfig
 		*out = new(NodeGroupUpdateConfig)
@@ -1011,6 +1469,45 @@ func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.KubeletCgroupDriver != nil {
+		in, out := &in.KubeletCgroupDriver, &out.KubeletCgroupDriver
+		*out = new(string)
+		**out = **in
+	}
+	if in.KubeletFeatureGates != nil {
+		in, out := &in.KubeletFeatureGates, &out.KubeletFeatureGates
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Containerd != nil {
+		in, out := &in.Containerd, &out.Containerd
+		*out = new(ContainerdConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecondaryNetworkInterfaces != nil {
+		in, out := &in.SecondaryNetworkInterfaces, &out.SecondaryNetworkInterfaces
+		*out = make([]SecondaryNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AssociatePublicIPAddress != nil {
+		in, out := &in.AssociatePublicIPAddress, &out.AssociatePublicIPAddress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.WarmPool != nil {
+		in, out := &in.WarmPool, &out.WarmPool
+		*out = new(WarmPool)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceRefresh != nil {
+		in, out := &in.InstanceRefresh, &out.InstanceRefresh
+		*out = new(InstanceRefresh)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1076,6 +1573,11 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(NodeGroupSGs)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PrefixDelegation != nil {
+		in, out := &in.PrefixDelegation, &out.PrefixDelegation
+		*out = new(bool)
+		**out = **in
+	}
 	if in.ASGSuspendProcesses != nil {
 		in, out := &in.ASGSuspendProcesses, &out.ASGSuspendProcesses
 		*out = make([]string, len(*in))
@@ -1126,6 +1628,28 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.AdditionalUserDataParts != nil {
+		in, out := &in.AdditionalUserDataParts, &out.AdditionalUserDataParts
+		*out = make([]UserDataPart, len(*in))
+		copy(*out, *in)
+	}
+	if in.LocalNVMe != nil {
+		in, out := &in.LocalNVMe, &out.LocalNVMe
+		*out = new(LocalNVMe)
+		**out = **in
+	}
+	if in.EFSMounts != nil {
+		in, out := &in.EFSMounts, &out.EFSMounts
+		*out = make([]EFSMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(NodeGroupDNS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DisableIMDSv1 != nil {
 		in, out := &in.DisableIMDSv1, &out.DisableIMDSv1
 		*out = new(bool)
@@ -1141,16 +1665,31 @@ func (in *NodeGroupBase) DeepCopyInto(out *NodeGroupBase) {
 		*out = new(Placement)
 		**out = **in
 	}
+	if in.CPUCredits != nil {
+		in, out := &in.CPUCredits, &out.CPUCredits
+		*out = new(string)
+		**out = **in
+	}
 	if in.EFAEnabled != nil {
 		in, out := &in.EFAEnabled, &out.EFAEnabled
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SpotInterruptionHandler != nil {
+		in, out := &in.SpotInterruptionHandler, &out.SpotInterruptionHandler
+		*out = new(bool)
+		**out = **in
+	}
 	if in.InstanceSelector != nil {
 		in, out := &in.InstanceSelector, &out.InstanceSelector
 		*out = new(InstanceSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Bottlerocket != nil {
 		in, out := &in.Bottlerocket, &out.Bottlerocket
 		*out = new(NodeGroupBottlerocket)
@@ -1199,6 +1738,63 @@ func (in *NodeGroupBottlerocket) DeepCopy() *NodeGroupBottlerocket {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupDNS) DeepCopyInto(out *NodeGroupDNS) {
+	*out = *in
+	if in.SearchDomains != nil {
+		in, out := &in.SearchDomains, &out.SearchDomains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupDNS.
+func (in *NodeGroupDNS) DeepCopy() *NodeGroupDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupDefaults) DeepCopyInto(out *NodeGroupDefaults) {
+	*out = *in
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeIOPS != nil {
+		in, out := &in.VolumeIOPS, &out.VolumeIOPS
+		*out = new(int)
+		**out = **in
+	}
+	if in.VolumeThroughput != nil {
+		in, out := &in.VolumeThroughput, &out.VolumeThroughput
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupDefaults.
+func (in *NodeGroupDefaults) DeepCopy() *NodeGroupDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupIAM) DeepCopyInto(out *NodeGroupIAM) {
 	*out = *in
@@ -1544,6 +2140,22 @@ func (in *Placement) DeepCopy() *Placement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortRange) DeepCopyInto(out *PortRange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortRange.
+func (in *PortRange) DeepCopy() *PortRange {
+	if in == nil {
+		return nil
+	}
+	out := new(PortRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateCluster) DeepCopyInto(out *PrivateCluster) {
 	*out = *in
@@ -1584,6 +2196,16 @@ func (in *Profile) DeepCopy() *Profile {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -1649,6 +2271,27 @@ func (in *ScalingConfig) DeepCopy() *ScalingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecondaryNetworkInterface) DeepCopyInto(out *SecondaryNetworkInterface) {
+	*out = *in
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecondaryNetworkInterface.
+func (in *SecondaryNetworkInterface) DeepCopy() *SecondaryNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(SecondaryNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretsEncryption) DeepCopyInto(out *SecretsEncryption) {
 	*out = *in
@@ -1665,6 +2308,69 @@ func (in *SecretsEncryption) DeepCopy() *SecretsEncryption {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradePolicy) DeepCopyInto(out *UpgradePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradePolicy.
+func (in *UpgradePolicy) DeepCopy() *UpgradePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserDataPart) DeepCopyInto(out *UserDataPart) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserDataPart.
+func (in *UserDataPart) DeepCopy() *UserDataPart {
+	if in == nil {
+		return nil
+	}
+	out := new(UserDataPart)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WarmPool) DeepCopyInto(out *WarmPool) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxGroupPreparedCapacity != nil {
+		in, out := &in.MaxGroupPreparedCapacity, &out.MaxGroupPreparedCapacity
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReuseOnScaleIn != nil {
+		in, out := &in.ReuseOnScaleIn, &out.ReuseOnScaleIn
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarmPool.
+func (in *WarmPool) DeepCopy() *WarmPool {
+	if in == nil {
+		return nil
+	}
+	out := new(WarmPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WellKnownPolicies) DeepCopyInto(out *WellKnownPolicies) {
 	*out = *in
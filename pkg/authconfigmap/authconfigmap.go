@@ -9,6 +9,7 @@ package authconfigmap
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
 	"github.com/kris-nova/logger"
@@ -124,6 +125,11 @@ func (a *AuthConfigMap) RemoveAccount(account string) error {
 	return a.setAccounts(newAccounts)
 }
 
+// Accounts returns the IAM accounts that are currently in the (cached) configmap.
+func (a *AuthConfigMap) Accounts() ([]string, error) {
+	return a.accounts()
+}
+
 func (a *AuthConfigMap) accounts() ([]string, error) {
 	var accounts []string
 	if err := yaml.Unmarshal([]byte(a.cm.Data[accountsData]), &accounts); err != nil {
@@ -221,6 +227,48 @@ func (a *AuthConfigMap) Identities() ([]iam.Identity, error) {
 	return all, nil
 }
 
+// IsManagedIdentity returns true if the identity is one that is added for the
+// instance roles of nodegroups or for the Fargate pod execution role. These
+// are managed by eksctl and EKS and are left alone by SetIdentityMappings.
+func IsManagedIdentity(identity iam.Identity) bool {
+	return strings.HasPrefix(identity.Username(), "system:node:")
+}
+
+// SetIdentityMappings replaces the identities and accounts in the (cached) configmap
+// with the given mappings, keeping the identities for which IsManagedIdentity is true.
+func (a *AuthConfigMap) SetIdentityMappings(mappings []*api.IAMIdentityMapping) error {
+	current, err := a.Identities()
+	if err != nil {
+		return err
+	}
+
+	var (
+		identities []iam.Identity
+		accounts   []string
+	)
+	for _, identity := range current {
+		if IsManagedIdentity(identity) {
+			identities = append(identities, identity)
+		}
+	}
+	for _, m := range mappings {
+		if m.Account != "" {
+			accounts = append(accounts, m.Account)
+			continue
+		}
+		identity, err := iam.NewIdentity(m.ARN, m.Username, m.Groups)
+		if err != nil {
+			return errors.Wrapf(err, "invalid identity mapping for %q", m.ARN)
+		}
+		identities = append(identities, identity)
+	}
+
+	if err := a.setIdentities(identities); err != nil {
+		return err
+	}
+	return a.setAccounts(sets.NewString(accounts...).List())
+}
+
 func (a *AuthConfigMap) setIdentities(identities []iam.Identity) error {
 	// Split identities into list of roles and list of users
 	users, roles := []iam.Identity{}, []iam.Identity{}
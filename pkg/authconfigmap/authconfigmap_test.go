@@ -13,6 +13,7 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	. "github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/iam"
 )
@@ -506,4 +507,57 @@ var _ = Describe("AuthConfigMap{}", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+	Describe("SetIdentityMappings()", func() {
+		var (
+			client *mockClient
+			acm    *AuthConfigMap
+		)
+
+		BeforeEach(func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data: map[string]string{
+					"mapRoles": expectedRoleA + fmt.Sprintf(`- rolearn: %s
+  username: admin
+  groups:
+  - %s
+`, roleB, GroupMasters),
+					"mapUsers":    expectedUserA,
+					"mapAccounts": makeExpectedAccounts(accountA),
+				},
+			}
+			existing.UID = "123456"
+			client = &mockClient{}
+			acm = New(client, existing)
+		})
+
+		It("should replace the mappings and keep the nodegroup roles", func() {
+			err := acm.SetIdentityMappings([]*api.IAMIdentityMapping{
+				{ARN: userB, Username: userBUsername, Groups: userBGroups},
+				{Account: accountB},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acm.Save()).To(Succeed())
+
+			cm := client.updated
+			Expect(cm.Data["mapRoles"]).To(MatchYAML(expectedRoleA))
+			Expect(cm.Data["mapUsers"]).To(MatchYAML(expectedUserB))
+			Expect(cm.Data["mapAccounts"]).To(MatchYAML(makeExpectedAccounts(accountB)))
+		})
+
+		It("should remove all mappings but the nodegroup roles if none are given", func() {
+			Expect(acm.SetIdentityMappings([]*api.IAMIdentityMapping{})).To(Succeed())
+			Expect(acm.Save()).To(Succeed())
+
+			cm := client.updated
+			Expect(cm.Data["mapRoles"]).To(MatchYAML(expectedRoleA))
+			Expect(cm.Data["mapUsers"]).To(MatchYAML("[]"))
+			Expect(cm.Data["mapAccounts"]).To(MatchYAML("[]"))
+		})
+
+		It("should fail for invalid mappings", func() {
+			err := acm.SetIdentityMappings([]*api.IAMIdentityMapping{{ARN: "arn:aws:iam::122333:policy/foo", Groups: []string{groupB}}})
+			Expect(err).To(MatchError(ContainSubstring(`invalid identity mapping for "arn:aws:iam::122333:policy/foo"`)))
+		})
+	})
 })
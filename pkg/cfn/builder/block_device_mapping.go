@@ -27,7 +27,7 @@ func makeBlockDeviceMappings(ng *api.NodeGroupBase) []gfnec2.LaunchTemplate_Bloc
 		mapping.Ebs.KmsKeyId = gfnt.NewString(*ng.VolumeKmsKeyID)
 	}
 
-	if (*ng.VolumeType == api.NodeVolumeTypeIO1 || *ng.VolumeType == api.NodeVolumeTypeGP3) && ng.VolumeIOPS != nil {
+	if (*ng.VolumeType == api.NodeVolumeTypeIO1 || *ng.VolumeType == api.NodeVolumeTypeIO2 || *ng.VolumeType == api.NodeVolumeTypeGP3) && ng.VolumeIOPS != nil {
 		mapping.Ebs.Iops = gfnt.NewInteger(*ng.VolumeIOPS)
 	}
 
@@ -3,12 +3,14 @@ package builder
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
 	gfn "github.com/weaveworks/goformation/v4/cloudformation"
 	gfneks "github.com/weaveworks/goformation/v4/cloudformation/eks"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
@@ -132,6 +134,37 @@ func (c *ClusterResourceSet) newResource(name string, resource gfn.Resource) *gf
 	return c.rs.newResource(name, resource)
 }
 
+// clusterWithExtraProperties is an AWS::EKS::Cluster with the UpgradePolicy and
+// KubernetesNetworkConfig.IpFamily properties, which goformation does not support yet
+type clusterWithExtraProperties struct {
+	gfneks.Cluster
+	UpgradePolicy *clusterUpgradePolicy
+	IPFamily      string
+}
+
+type clusterUpgradePolicy struct {
+	SupportType string
+}
+
+// MarshalJSON adds the extra properties that are set to the properties of the cluster
+func (c clusterWithExtraProperties) MarshalJSON() ([]byte, error) {
+	data, err := c.Cluster.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	if c.UpgradePolicy != nil {
+		if data, err = sjson.SetBytes(data, "Properties.UpgradePolicy", c.UpgradePolicy); err != nil {
+			return nil, err
+		}
+	}
+	if c.IPFamily != "" {
+		if data, err = sjson.SetBytes(data, "Properties.KubernetesNetworkConfig.IpFamily", c.IPFamily); err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
 func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDetails) {
 	clusterVPC := &gfneks.Cluster_ResourcesVpcConfig{
 		SecurityGroupIds: gfnt.NewSlice(c.securityGroups...),
@@ -148,7 +181,7 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDe
 	if c.spec.SecretsEncryption != nil && c.spec.SecretsEncryption.KeyARN != "" {
 		encryptionConfigs = []gfneks.Cluster_EncryptionConfig{
 			{
-				Resources: gfnt.NewSlice(gfnt.NewString("secrets")),
+				Resources: gfnt.NewStringSlice(c.spec.SecretsEncryption.EncryptedResources()...),
 				Provider: &gfneks.Cluster_Provider{
 					KeyArn: gfnt.NewString(c.spec.SecretsEncryption.KeyARN),
 				},
@@ -169,7 +202,23 @@ func (c *ClusterResourceSet) addResourcesForControlPlane(subnetDetails *subnetDe
 		}
 	}
 
-	c.newResource("ControlPlane", &cluster)
+	ipFamily := ""
+	if c.spec.KubernetesNetworkConfig != nil && c.spec.KubernetesNetworkConfig.IPFamily != "" {
+		// the EKS API only accepts the lowercase IP families
+		ipFamily = strings.ToLower(c.spec.KubernetesNetworkConfig.IPFamily)
+	}
+
+	if c.spec.UpgradePolicy != nil || ipFamily != "" {
+		clusterWithProperties := &clusterWithExtraProperties{Cluster: cluster, IPFamily: ipFamily}
+		if c.spec.UpgradePolicy != nil {
+			clusterWithProperties.UpgradePolicy = &clusterUpgradePolicy{
+				SupportType: c.spec.UpgradePolicy.SupportType,
+			}
+		}
+		c.newResource("ControlPlane", clusterWithProperties)
+	} else {
+		c.newResource("ControlPlane", &cluster)
+	}
 
 	if c.spec.Status == nil {
 		c.spec.Status = &api.ClusterStatus{}
@@ -3,6 +3,7 @@ package builder_test
 import (
 	"encoding/json"
 
+	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	. "github.com/onsi/ginkgo"
@@ -203,9 +204,10 @@ var _ = Describe("Cluster Template Builder", func() {
 		})
 
 		Context("when ServiceRoleARN is set", func() {
+			const role = "arn:aws:iam::123456789012:role/cluster-role"
+
 			BeforeEach(func() {
-				role := "foo"
-				cfg.IAM.ServiceRoleARN = &role
+				cfg.IAM.ServiceRoleARN = aws.String(role)
 			})
 
 			It("should not add other iam resources", func() {
@@ -213,6 +215,26 @@ var _ = Describe("Cluster Template Builder", func() {
 				Expect(clusterTemplate.Resources).ToNot(HaveKey("PolicyELBPermissions"))
 				Expect(clusterTemplate.Resources).ToNot(HaveKey("PolicyCloudWatchMetrics"))
 			})
+
+			It("should use the role for the control plane", func() {
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.RoleArn).To(Equal(role))
+				Expect(clusterTemplate.Outputs).To(HaveKey("ServiceRoleARN"))
+				Expect(crs.WithIAM()).To(BeFalse())
+			})
+		})
+
+		Context("when IAM tags are set", func() {
+			BeforeEach(func() {
+				cfg.Metadata.Tags = map[string]string{"team": "platform"}
+				cfg.IAM.Tags = map[string]string{"cost-center": "42"}
+			})
+
+			It("tags the service role", func() {
+				Expect(clusterTemplate.Resources["ServiceRole"].Properties.Tags).To(ContainElements(
+					fakes.Tag{Key: "cost-center", Value: "42"},
+					fakes.Tag{Key: "team", Value: "platform"},
+				))
+			})
 		})
 
 		Context("when ServiceRolePermissionsBoundary is set", func() {
@@ -246,6 +268,33 @@ var _ = Describe("Cluster Template Builder", func() {
 			Expect(clusterTemplate.Resources["ControlPlane"].Properties.ResourcesVpcConfig.SubnetIds).To(HaveLen(4))
 			Expect(clusterTemplate.Resources["ControlPlane"].Properties.RoleArn).To(ContainElement([]interface{}{"ServiceRole", "Arn"}))
 			Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig).To(BeNil())
+			Expect(clusterTemplate.Resources["ControlPlane"].Properties.UpgradePolicy).To(BeNil())
+		})
+
+		When("UpgradePolicy is configured", func() {
+			BeforeEach(func() {
+				cfg.UpgradePolicy = &api.UpgradePolicy{
+					SupportType: api.SupportTypeExtended,
+				}
+			})
+
+			It("should add the support type to the control plane resource", func() {
+				Expect(clusterTemplate.Resources["ControlPlane"].Type).To(Equal("AWS::EKS::Cluster"))
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.Name).To(Equal(cfg.Metadata.Name))
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.UpgradePolicy.SupportType).To(Equal("EXTENDED"))
+			})
+		})
+
+		When("the IP family is IPv6", func() {
+			BeforeEach(func() {
+				cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+			})
+
+			It("should set the lowercase IP family on the control plane resource", func() {
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.KubernetesNetworkConfig.IpFamily).To(Equal("ipv6"))
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.KubernetesNetworkConfig.ServiceIpv4Cidr).To(BeEmpty())
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.UpgradePolicy).To(BeNil())
+			})
 		})
 
 		When("SecretsEncryption is configured", func() {
@@ -257,7 +306,18 @@ var _ = Describe("Cluster Template Builder", func() {
 
 			It("should add the key arn to the control plane resource", func() {
 				Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig[0].Provider.KeyARN).To(Equal("key-thing"))
-				Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig[0].Resources[0]).To(Equal("secrets"))
+				Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig[0].Resources).To(Equal([]string{"secrets"}))
+			})
+
+			When("the encrypted resources are set", func() {
+				BeforeEach(func() {
+					cfg.SecretsEncryption.Resources = []string{api.EncryptedResourceSecrets}
+				})
+
+				It("encrypts these resources", func() {
+					Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig).To(HaveLen(1))
+					Expect(clusterTemplate.Resources["ControlPlane"].Properties.EncryptionConfig[0].Resources).To(Equal([]string{"secrets"}))
+				})
 			})
 		})
 
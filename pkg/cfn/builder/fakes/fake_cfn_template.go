@@ -59,6 +59,13 @@ type Properties struct {
 	TargetGroupARNs                   []string
 	DesiredCapacity, MinSize, MaxSize string
 
+	AutoScalingGroupName     interface{}
+	MaxGroupPreparedCapacity string
+	PoolState                string
+	InstanceReusePolicy      *struct {
+		ReuseOnScaleIn bool
+	}
+
 	CidrIP, CidrIpv6, IPProtocol string
 	FromPort, ToPort             int
 
@@ -85,6 +92,13 @@ type Properties struct {
 		}
 		Resources []string
 	}
+	UpgradePolicy *struct {
+		SupportType string
+	}
+	KubernetesNetworkConfig *struct {
+		IpFamily        string
+		ServiceIpv4Cidr string
+	}
 	LaunchTemplate struct {
 		LaunchTemplateName map[string]interface{}
 		Version            map[string]interface{}
@@ -137,6 +151,12 @@ type LaunchTemplateData struct {
 	CreditSpecification *struct {
 		CPUCredits string
 	}
+	HibernationOptions *struct {
+		Configured bool
+	}
+	PrivateDNSNameOptions *struct {
+		HostnameType string
+	}
 	MetadataOptions   MetadataOptions
 	TagSpecifications []TagSpecification
 	Placement         Placement
@@ -144,7 +164,9 @@ type LaunchTemplateData struct {
 }
 
 type Placement struct {
-	GroupName interface{}
+	GroupName            interface{}
+	Tenancy              string
+	HostResourceGroupArn string
 }
 
 type BlockDeviceMappings struct {
@@ -164,9 +186,12 @@ type TagSpecification struct {
 
 type NetworkInterface struct {
 	DeviceIndex              int
-	AssociatePublicIPAddress bool
+	AssociatePublicIPAddress *bool
 	NetworkCardIndex         int
 	InterfaceType            string
+	SubnetID                 string
+	Groups                   []interface{}
+	DeleteOnTermination      bool
 }
 
 type Monitoring struct {
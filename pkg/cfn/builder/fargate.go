@@ -77,9 +77,18 @@ func addResourcesForFargate(rs *resourceSet, cfg *api.ClusterConfig) error {
 		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(
 			MakeServiceRef("EKSFargatePods"), // Ensure that EKS can schedule pods onto Fargate.
 		),
-		ManagedPolicyArns: gfnt.NewSlice(makePolicyARNs(
-			iamPolicyAmazonEKSFargatePodExecutionRolePolicy,
+		ManagedPolicyArns: gfnt.NewSlice(append(
+			makePolicyARNs(iamPolicyAmazonEKSFargatePodExecutionRolePolicy),
+			makeStringSlice(cfg.IAM.FargatePodExecutionRoleAttachPolicyARNs...)...,
 		)...),
+		Tags: makeIAMTags(cfg.IAMTags()),
+	}
+
+	if cfg.IAM.FargatePodExecutionRoleAttachPolicy != nil {
+		role.Policies = []gfniam.Role_Policy{{
+			PolicyName:     makeName(fargateRoleName),
+			PolicyDocument: cfg.IAM.FargatePodExecutionRoleAttachPolicy,
+		}}
 	}
 
 	if api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRolePermissionsBoundary) {
@@ -0,0 +1,94 @@
+package builder_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+)
+
+var _ = Describe("template builder for Fargate", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+	})
+
+	render := func() *cft.Template {
+		rs := builder.NewFargateResourceSet(cfg)
+		Expect(rs.AddAllResources()).To(Succeed())
+
+		templateBody := []byte{}
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+		return t
+	}
+
+	It("creates a pod execution role with the Fargate pod execution policy", func() {
+		t := render()
+
+		Expect(t).To(HaveResource("FargatePodExecutionRole", "AWS::IAM::Role"))
+		Expect(t).To(HaveResourceWithPropertyValue("FargatePodExecutionRole", "ManagedPolicyArns", `[
+			{ "Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy" }
+		]`))
+		Expect(t.Resources["FargatePodExecutionRole"].Properties).NotTo(HaveKey("Policies"))
+	})
+
+	It("attaches extra managed policies to the pod execution role", func() {
+		cfg.IAM.FargatePodExecutionRoleAttachPolicyARNs = []string{"arn:aws:iam::123456789012:policy/FargateLogging"}
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("FargatePodExecutionRole", "ManagedPolicyArns", `[
+			{ "Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy" },
+			"arn:aws:iam::123456789012:policy/FargateLogging"
+		]`))
+	})
+
+	It("attaches an inline policy to the pod execution role", func() {
+		cfg.IAM.FargatePodExecutionRoleAttachPolicy = api.InlineDocument{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Effect":   "Allow",
+					"Action":   []string{"logs:CreateLogStream", "logs:PutLogEvents"},
+					"Resource": "*",
+				},
+			},
+		}
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("FargatePodExecutionRole", "Policies", `[
+			{
+				"PolicyName": { "Fn::Sub": "${AWS::StackName}-FargatePodExecutionRole" },
+				"PolicyDocument": {
+					"Version": "2012-10-17",
+					"Statement": [
+						{
+							"Effect": "Allow",
+							"Action": ["logs:CreateLogStream", "logs:PutLogEvents"],
+							"Resource": "*"
+						}
+					]
+				}
+			}
+		]`))
+	})
+
+	It("only outputs the pod execution role ARN if it is given", func() {
+		cfg.IAM.FargatePodExecutionRoleARN = aws.String("arn:aws:iam::123456789012:role/FargatePodExecutionRole")
+
+		t := render()
+
+		Expect(t.Resources).To(BeEmpty())
+		Expect(t).To(HaveOutputWithValue("FargatePodExecutionRoleARN", `"arn:aws:iam::123456789012:role/FargatePodExecutionRole"`))
+	})
+})
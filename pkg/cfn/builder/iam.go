@@ -82,6 +82,7 @@ func (c *ClusterResourceSet) addResourcesForIAM() {
 			MakeServiceRef("EKS"),
 		),
 		ManagedPolicyArns: gfnt.NewSlice(makePolicyARNs(managedPolicyArns...)...),
+		Tags:              makeIAMTags(c.spec.IAMTags()),
 	}
 	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRolePermissionsBoundary) {
 		role.PermissionsBoundary = gfnt.NewString(*c.spec.IAM.ServiceRolePermissionsBoundary)
@@ -157,7 +158,7 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() error {
 		n.rs.withNamedIAM = true
 	}
 
-	if err := createRole(n.rs, n.clusterSpec.IAM, n.spec.IAM, false, n.forceAddCNIPolicy); err != nil {
+	if err := createRole(n.rs, n.clusterSpec, n.spec.IAM, false, n.forceAddCNIPolicy); err != nil {
 		return err
 	}
 
@@ -284,6 +285,15 @@ func (rs *IAMRoleResourceSet) AddAllResources() error {
 		RoleName:                 rs.roleName,
 	}
 
+	// tag the role like the OIDC provider it trusts
+	tags := rs.oidc.Tags()
+	for _, k := range sortedTagKeys(tags) {
+		role.Tags = append(role.Tags, cft.Tag{
+			Key:   cft.NewString(k),
+			Value: cft.NewString(tags[k]),
+		})
+	}
+
 	for _, arn := range rs.attachPolicyARNs {
 		role.ManagedPolicyArns = append(role.ManagedPolicyArns, arn)
 	}
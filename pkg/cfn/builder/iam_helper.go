@@ -2,12 +2,14 @@ package builder
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/arn"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
 	gfn "github.com/weaveworks/goformation/v4/cloudformation"
+	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
 	gfniam "github.com/weaveworks/goformation/v4/cloudformation/iam"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -75,9 +77,31 @@ func createWellKnownPolicies(wellKnownPolicies api.WellKnownPolicies) ([]managed
 	return managedPolicies, customPolicies
 }
 
+// sortedTagKeys returns the keys of tags in a stable order, so that templates are reproducible
+func sortedTagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// makeIAMTags makes the tags of an IAM role
+func makeIAMTags(tags map[string]string) []gfncfn.Tag {
+	var iamTags []gfncfn.Tag
+	for _, k := range sortedTagKeys(tags) {
+		iamTags = append(iamTags, gfncfn.Tag{
+			Key:   gfnt.NewString(k),
+			Value: gfnt.NewString(tags[k]),
+		})
+	}
+	return iamTags
+}
+
 // createRole creates an IAM role with policies required for the worker nodes and addons
-func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamConfig *api.NodeGroupIAM, managed, forceAddCNIPolicy bool) error {
-	managedPolicyARNs, err := makeManagedPolicies(clusterIAMConfig, iamConfig, managed, forceAddCNIPolicy)
+func createRole(cfnTemplate cfnTemplate, clusterConfig *api.ClusterConfig, iamConfig *api.NodeGroupIAM, managed, forceAddCNIPolicy bool) error {
+	managedPolicyARNs, err := makeManagedPolicies(clusterConfig.IAM, iamConfig, managed, forceAddCNIPolicy)
 	if err != nil {
 		return err
 	}
@@ -85,6 +109,7 @@ func createRole(cfnTemplate cfnTemplate, clusterIAMConfig *api.ClusterIAM, iamCo
 		Path:                     gfnt.NewString("/"),
 		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(MakeServiceRef("EC2")),
 		ManagedPolicyArns:        managedPolicyARNs,
+		Tags:                     makeIAMTags(clusterConfig.IAMTags()),
 	}
 
 	if iamConfig.InstanceRoleName != "" {
@@ -121,6 +121,31 @@ var _ = Describe("template builder for IAM", func() {
 			Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
 		})
 
+		It("tags the role like the OIDC provider", func() {
+			oidc, err = iamoidc.NewOpenIDConnectManager(nil, "456123987123", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", map[string]string{
+				"team":        "platform",
+				"cost-center": "42",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			serviceAccount := &api.ClusterIAMServiceAccount{}
+			serviceAccount.Name = "sa-1"
+			serviceAccount.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}
+
+			rs := builder.NewIAMRoleResourceSetForServiceAccount(serviceAccount, oidc)
+
+			templateBody := []byte{}
+			Expect(rs).To(RenderWithoutErrors(&templateBody))
+			t := cft.NewTemplate()
+			Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+			Expect(t).To(HaveResourceWithPropertyValue("Role1", "Tags", `[
+				{ "Key": "cost-center", "Value": "42" },
+				{ "Key": "team", "Value": "platform" },
+				{ "Key": "Name", "Value": { "Fn::Sub": "${AWS::StackName}/Role1" } }
+			]`))
+		})
+
 		It("can construct an iamserviceaccount addon template with a custom role name", func() {
 			serviceAccount := &api.ClusterIAMServiceAccount{}
 
@@ -49,6 +49,11 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTe
 		}
 	}
 
+	if len(mng.EFSMounts) > 0 {
+		efsSG := m.addEFSSecurityGroup(m.vpcImporter.VPC(), m.clusterConfig.Metadata.Name, "managed worker nodes in group "+mng.Name, mng.EFSMounts)
+		securityGroupIDs = append(securityGroupIDs, efsSG)
+	}
+
 	if api.IsEnabled(mng.EFAEnabled) {
 		// we don't want to touch the network interfaces at all if we have a
 		// managed nodegroup, unless EFA is enabled
@@ -79,6 +84,8 @@ func (m *ManagedNodeGroupResourceSet) makeLaunchTemplateData() (*gfnec2.LaunchTe
 			GroupName: gfnt.NewString(mng.Placement.GroupName),
 		}
 	}
+	launchTemplateData.Placement = makePlacement(mng.NodeGroupBase, launchTemplateData.Placement)
+	launchTemplateData.CreditSpecification = makeCreditSpecification(mng.NodeGroupBase)
 
 	if mng.EnableDetailedMonitoring != nil {
 		launchTemplateData.Monitoring = &gfnec2.LaunchTemplate_Monitoring{
@@ -36,6 +36,7 @@ var _ = Describe("ManagedNodeGroup builder", func() {
 	DescribeTable("Add resources", func(m *mngCase) {
 		clusterConfig := api.NewClusterConfig()
 		clusterConfig.Metadata.Name = "lt"
+		clusterConfig.VPC.Subnets = &api.ClusterSubnets{}
 		api.SetManagedNodeGroupDefaults(m.ng, clusterConfig.Metadata)
 		Expect(api.ValidateManagedNodeGroup(m.ng, 0)).To(Succeed())
 
@@ -196,6 +197,53 @@ API_SERVER_URL=https://test.com
 			resourcesFilename: "placement.json",
 		}),
 
+		Entry("With host tenancy", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:                 "dedicated-hosts",
+					InstanceType:         "m5.xlarge",
+					Tenancy:              api.TenancyHost,
+					HostResourceGroupARN: "arn:aws:resource-groups:us-west-2:123456789012:group/hosts",
+				},
+			},
+			resourcesFilename: "host_tenancy.json",
+		}),
+
+		Entry("With CPU credits", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:         "burstable",
+					InstanceType: "t3.large",
+					CPUCredits:   aws.String(api.CPUCreditsUnlimited),
+				},
+			},
+			resourcesFilename: "cpu_credits.json",
+		}),
+
+		Entry("With EFS mounts", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:         "efs",
+					InstanceType: "m5.xlarge",
+					EFSMounts: []api.EFSMount{
+						{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared", MountTargetSecurityGroupIDs: []string{"sg-efs-2", "sg-efs-1"}},
+					},
+				},
+			},
+			resourcesFilename: "efs_mounts.json",
+		}),
+
+		Entry("With detailed monitoring", &mngCase{
+			ng: &api.ManagedNodeGroup{
+				NodeGroupBase: &api.NodeGroupBase{
+					Name:                     "standard",
+					InstanceType:             "m5.xlarge",
+					EnableDetailedMonitoring: aws.Bool(true),
+				},
+			},
+			resourcesFilename: "detailed_monitoring.json",
+		}),
+
 		Entry("With Spot instances", &mngCase{
 			ng: &api.ManagedNodeGroup{
 				NodeGroupBase: &api.NodeGroupBase{
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/stretchr/testify/require"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
@@ -179,6 +180,64 @@ func TestManagedNodeRole(t *testing.T) {
 	}
 }
 
+func TestManagedNodeGroupUpdateConfig(t *testing.T) {
+	updateConfigTests := []struct {
+		description          string
+		updateConfig         *api.NodeGroupUpdateConfig
+		expectedUpdateConfig *gfneks.Nodegroup_UpdateConfig
+	}{
+		{
+			description: "updateConfig is not set",
+		},
+		{
+			description: "maxUnavailable is set",
+			updateConfig: &api.NodeGroupUpdateConfig{
+				MaxUnavailable: aws.Int(2),
+			},
+			expectedUpdateConfig: &gfneks.Nodegroup_UpdateConfig{
+				MaxUnavailable: gfnt.NewInteger(2),
+			},
+		},
+		{
+			description: "maxUnavailablePercentage is set",
+			updateConfig: &api.NodeGroupUpdateConfig{
+				MaxUnavailablePercentage: aws.Int(25),
+			},
+			expectedUpdateConfig: &gfneks.Nodegroup_UpdateConfig{
+				MaxUnavailablePercentage: gfnt.NewInteger(25),
+			},
+		},
+	}
+
+	for i, tt := range updateConfigTests {
+		t.Run(fmt.Sprintf("%d: %s", i, tt.description), func(t *testing.T) {
+			require := require.New(t)
+			clusterConfig := api.NewClusterConfig()
+
+			ng := api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(ng, clusterConfig.Metadata)
+			ng.UpdateConfig = tt.updateConfig
+
+			p := mockprovider.NewMockProvider()
+			fakeVPCImporter := new(vpcfakes.FakeImporter)
+			bootstrapper := nodebootstrap.NewManagedBootstrapper(clusterConfig, ng)
+			stack := NewManagedNodeGroup(p.EC2(), clusterConfig, ng, nil, bootstrapper, false, fakeVPCImporter)
+			require.NoError(stack.AddAllResources())
+
+			bytes, err := stack.RenderJSON()
+			require.NoError(err)
+
+			template, err := goformation.ParseJSON(bytes)
+			require.NoError(err)
+			ngResource, ok := template.Resources["ManagedNodeGroup"]
+			require.True(ok)
+			managedNodeGroup, ok := ngResource.(*gfneks.Nodegroup)
+			require.True(ok)
+			require.Equal(tt.expectedUpdateConfig, managedNodeGroup.UpdateConfig)
+		})
+	}
+}
+
 func makePartitionedPolicies(policies ...string) []*gfnt.Value {
 	var partitionedPolicies []*gfnt.Value
 	for _, policy := range policies {
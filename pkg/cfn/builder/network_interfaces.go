@@ -9,6 +9,8 @@ import (
 	"github.com/pkg/errors"
 	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
 func defaultNetworkInterface(securityGroups []*gfnt.Value, device, card int) gfnec2.LaunchTemplate_NetworkInterface {
@@ -65,3 +67,20 @@ func buildNetworkInterfaces(
 	}
 	return nil
 }
+
+// buildSecondaryNetworkInterfaces appends the secondary network interfaces of a nodegroup to the network interfaces
+// of the launch template, the ones without security groups use the security groups of the primary network interface
+func buildSecondaryNetworkInterfaces(launchTemplateData *gfnec2.LaunchTemplate_LaunchTemplateData, secondaryNetworkInterfaces []api.SecondaryNetworkInterface, securityGroups []*gfnt.Value) {
+	for _, sni := range secondaryNetworkInterfaces {
+		groups := gfnt.NewSlice(securityGroups...)
+		if len(sni.SecurityGroups) > 0 {
+			groups = gfnt.NewStringSlice(sni.SecurityGroups...)
+		}
+		launchTemplateData.NetworkInterfaces = append(launchTemplateData.NetworkInterfaces, gfnec2.LaunchTemplate_NetworkInterface{
+			DeleteOnTermination: gfnt.True(),
+			DeviceIndex:         gfnt.NewInteger(sni.DeviceIndex),
+			Groups:              groups,
+			SubnetId:            gfnt.NewString(sni.Subnet),
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
 
 	"github.com/kris-nova/logger"
+	"github.com/tidwall/sjson"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
@@ -138,10 +139,15 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 
 	launchTemplateData.BlockDeviceMappings = makeBlockDeviceMappings(n.spec.NodeGroupBase)
 
-	n.newResource("NodeGroupLaunchTemplate", &gfnec2.LaunchTemplate{
+	launchTemplate := gfnec2.LaunchTemplate{
 		LaunchTemplateName: launchTemplateName,
 		LaunchTemplateData: launchTemplateData,
-	})
+	}
+	if hostnameType := hostnameTypes[n.spec.NodeNameSource]; hostnameType != "" {
+		n.newResource("NodeGroupLaunchTemplate", &launchTemplateWithHostnameType{LaunchTemplate: launchTemplate, HostnameType: hostnameType})
+	} else {
+		n.newResource("NodeGroupLaunchTemplate", &launchTemplate)
+	}
 
 	vpcZoneIdentifier, err := AssignSubnets(n.spec.NodeGroupBase, n.vpcImporter, n.clusterSpec, n.ec2API)
 	if err != nil {
@@ -178,6 +184,10 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 	asg := nodeGroupResource(launchTemplateName, vpcZoneIdentifier, tags, n.spec)
 	n.newResource("NodeGroup", asg)
 
+	if n.spec.WarmPool != nil {
+		n.newResource("NodeGroupWarmPool", warmPoolResource(n.spec.WarmPool))
+	}
+
 	return nil
 }
 
@@ -226,6 +236,29 @@ func AssignSubnets(spec *api.NodeGroupBase, vpcImporter vpc.Importer, clusterSpe
 	return subnets, nil
 }
 
+// hostnameTypes maps the node name sources to the hostname types of the instances, kubelet
+// registers with the private DNS name that the cloud provider reads from the EC2 API
+var hostnameTypes = map[string]string{
+	api.NodeNameSourcePrivateDNSName: "ip-name",
+	api.NodeNameSourceResourceName:   "resource-name",
+}
+
+// launchTemplateWithHostnameType is an AWS::EC2::LaunchTemplate with the
+// LaunchTemplateData.PrivateDnsNameOptions property, which goformation does not support yet
+type launchTemplateWithHostnameType struct {
+	gfnec2.LaunchTemplate
+	HostnameType string
+}
+
+// MarshalJSON adds the hostname type to the properties of the launch template
+func (t launchTemplateWithHostnameType) MarshalJSON() ([]byte, error) {
+	data, err := t.LaunchTemplate.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return sjson.SetBytes(data, "Properties.LaunchTemplateData.PrivateDnsNameOptions.HostnameType", t.HostnameType)
+}
+
 // GetAllOutputs collects all outputs of the nodegroup
 func (n *NodeGroupResourceSet) GetAllOutputs(stack cfn.Stack) error {
 	return n.rs.GetAllOutputs(stack)
@@ -250,6 +283,13 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 	if err := buildNetworkInterfaces(launchTemplateData, n.spec.InstanceTypeList(), api.IsEnabled(n.spec.EFAEnabled), n.securityGroups, n.ec2API); err != nil {
 		return nil, errors.Wrap(err, "couldn't build network interfaces for launch template data")
 	}
+	buildSecondaryNetworkInterfaces(launchTemplateData, n.spec.SecondaryNetworkInterfaces, n.securityGroups)
+	if n.spec.AssociatePublicIPAddress != nil {
+		if len(launchTemplateData.NetworkInterfaces) > 1 {
+			return nil, errors.Errorf("associatePublicIPAddress cannot be set on nodegroup %q, as its EFA-enabled instances have multiple network interfaces", n.spec.Name)
+		}
+		launchTemplateData.NetworkInterfaces[0].AssociatePublicIpAddress = gfnt.NewBoolean(*n.spec.AssociatePublicIPAddress)
+	}
 
 	if api.IsEnabled(n.spec.EFAEnabled) && n.spec.Placement == nil {
 		groupName := n.newResource("NodeGroupPlacementGroup", &gfnec2.PlacementGroup{
@@ -269,17 +309,14 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 		launchTemplateData.EbsOptimized = gfnt.NewBoolean(*n.spec.EBSOptimized)
 	}
 
-	if n.spec.CPUCredits != nil {
-		launchTemplateData.CreditSpecification = &gfnec2.LaunchTemplate_CreditSpecification{
-			CpuCredits: gfnt.NewString(strings.ToLower(*n.spec.CPUCredits)),
-		}
-	}
+	launchTemplateData.CreditSpecification = makeCreditSpecification(n.spec.NodeGroupBase)
 
 	if n.spec.Placement != nil {
 		launchTemplateData.Placement = &gfnec2.LaunchTemplate_Placement{
 			GroupName: gfnt.NewString(n.spec.Placement.GroupName),
 		}
 	}
+	launchTemplateData.Placement = makePlacement(n.spec.NodeGroupBase, launchTemplateData.Placement)
 
 	if n.spec.EnableDetailedMonitoring != nil {
 		launchTemplateData.Monitoring = &gfnec2.LaunchTemplate_Monitoring{
@@ -287,9 +324,39 @@ func newLaunchTemplateData(n *NodeGroupResourceSet) (*gfnec2.LaunchTemplate_Laun
 		}
 	}
 
+	if launchTemplateData.HibernationOptions, err = makeHibernationOptions(n.spec, n.ec2API); err != nil {
+		return nil, err
+	}
+
 	return launchTemplateData, nil
 }
 
+// makeCreditSpecification returns the credit specification of burstable performance instances,
+// or nil if the nodegroup does not set cpuCredits
+func makeCreditSpecification(ng *api.NodeGroupBase) *gfnec2.LaunchTemplate_CreditSpecification {
+	if ng.CPUCredits == nil {
+		return nil
+	}
+	return &gfnec2.LaunchTemplate_CreditSpecification{
+		CpuCredits: gfnt.NewString(strings.ToLower(*ng.CPUCredits)),
+	}
+}
+
+// makePlacement adds the tenancy of the nodegroup to placement, which is created if it is nil
+func makePlacement(ng *api.NodeGroupBase, placement *gfnec2.LaunchTemplate_Placement) *gfnec2.LaunchTemplate_Placement {
+	if ng.Tenancy == "" {
+		return placement
+	}
+	if placement == nil {
+		placement = &gfnec2.LaunchTemplate_Placement{}
+	}
+	placement.Tenancy = gfnt.NewString(ng.Tenancy)
+	if ng.HostResourceGroupARN != "" {
+		placement.HostResourceGroupArn = gfnt.NewString(ng.HostResourceGroupARN)
+	}
+	return placement
+}
+
 func makeMetadataOptions(ng *api.NodeGroupBase) *gfnec2.LaunchTemplate_MetadataOptions {
 	imdsv2TokensRequired := "optional"
 	if api.IsEnabled(ng.DisableIMDSv1) || api.IsEnabled(ng.DisablePodIMDS) {
@@ -342,17 +409,25 @@ func nodeGroupResource(launchTemplateName *gfnt.Value, vpcZoneIdentifier interfa
 		}
 	}
 
+	return &awsCloudFormationResource{
+		Type:         "AWS::AutoScaling::AutoScalingGroup",
+		Properties:   ngProps,
+		UpdatePolicy: nodeGroupUpdatePolicy(ng),
+	}
+}
+
+// nodeGroupUpdatePolicy returns the update policy of the Auto Scaling group, which has no rolling update when
+// instanceRefresh is set, as an instance refresh replaces the nodes once the launch template has been updated
+func nodeGroupUpdatePolicy(ng *api.NodeGroup) map[string]map[string]interface{} {
+	if ng.InstanceRefresh != nil {
+		return nil
+	}
 	rollingUpdate := map[string]interface{}{}
 	if len(ng.ASGSuspendProcesses) > 0 {
 		rollingUpdate["SuspendProcesses"] = ng.ASGSuspendProcesses
 	}
-
-	return &awsCloudFormationResource{
-		Type:       "AWS::AutoScaling::AutoScalingGroup",
-		Properties: ngProps,
-		UpdatePolicy: map[string]map[string]interface{}{
-			"AutoScalingRollingUpdate": rollingUpdate,
-		},
+	return map[string]map[string]interface{}{
+		"AutoScalingRollingUpdate": rollingUpdate,
 	}
 }
 
@@ -3,6 +3,7 @@ package builder_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -161,6 +162,16 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				Expect(ngTemplate.Outputs).To(HaveKey(outputs.NodeGroupInstanceProfileARN))
 			})
 
+			It("does not create a role or an instance profile", func() {
+				Expect(ngTemplate.Resources).NotTo(HaveKey("NodeInstanceRole"))
+				Expect(ngTemplate.Resources).NotTo(HaveKey("NodeInstanceProfile"))
+			})
+
+			It("uses the instance profile in the launch template", func() {
+				properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+				Expect(properties.LaunchTemplateData.IamInstanceProfile.Arn).To(Equal("foo"))
+			})
+
 			Context("iam.InstanceRoleARN is set", func() {
 				BeforeEach(func() {
 					ng.IAM.InstanceRoleARN = "foo"
@@ -214,6 +225,20 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("IAM tags are set", func() {
+				BeforeEach(func() {
+					cfg.Metadata.Tags = map[string]string{"team": "platform"}
+					cfg.IAM.Tags = map[string]string{"cost-center": "42"}
+				})
+
+				It("tags the role", func() {
+					Expect(ngTemplate.Resources["NodeInstanceRole"].Properties.Tags).To(ContainElements(
+						fakes.Tag{Key: "cost-center", Value: "42"},
+						fakes.Tag{Key: "team", Value: "platform"},
+					))
+				})
+			})
+
 			Context("ng.InstanceRolePermissionsBoundary is set", func() {
 				BeforeEach(func() {
 					ng.IAM.InstanceRolePermissionsBoundary = "shall-not-pass"
@@ -224,6 +249,18 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("iam.permissionsBoundary is set for the cluster", func() {
+				BeforeEach(func() {
+					cfg.IAM.PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+					cfg.NodeGroups = []*api.NodeGroup{ng}
+					api.SetClusterConfigDefaults(cfg)
+				})
+
+				It("sets the PermissionsBoundary on the role", func() {
+					Expect(ngTemplate.Resources["NodeInstanceRole"].Properties.PermissionsBoundary).To(Equal("arn:aws:iam::123456789012:policy/boundary"))
+				})
+			})
+
 			// TODO move into IAM tests?
 			Context("attach policy arns are set", func() {
 				BeforeEach(func() {
@@ -534,6 +571,44 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				Expect(properties.ToPort).To(Equal(443))
 			})
 
+			Context("vpc.controlPlaneSecurityGroupRules is set", func() {
+				BeforeEach(func() {
+					cfg.VPC.ControlPlaneSecurityGroupRules = &api.ControlPlaneSecurityGroupRules{
+						NodePorts: []api.PortRange{
+							{FromPort: 443, ToPort: 443},
+							{FromPort: 10250, ToPort: 10250},
+						},
+					}
+				})
+
+				It("only opens the given ports from the control plane to the nodes", func() {
+					properties := ngTemplate.Resources["SG"].Properties
+					Expect(properties.SecurityGroupIngress).To(HaveLen(2))
+					Expect(properties.SecurityGroupIngress[0].Description).To(Equal("[IngressInterClusterPorts0] Allow worker nodes in group ng-abcd1234 to communicate with control plane (TCP ports 443-443)"))
+					Expect(properties.SecurityGroupIngress[0].FromPort).To(Equal(float64(443)))
+					Expect(properties.SecurityGroupIngress[0].ToPort).To(Equal(float64(443)))
+					Expect(properties.SecurityGroupIngress[1].Description).To(Equal("[IngressInterClusterPorts1] Allow worker nodes in group ng-abcd1234 to communicate with control plane (TCP ports 10250-10250)"))
+					Expect(properties.SecurityGroupIngress[1].FromPort).To(Equal(float64(10250)))
+					Expect(properties.SecurityGroupIngress[1].ToPort).To(Equal(float64(10250)))
+
+					Expect(ngTemplate.Resources).NotTo(HaveKey("EgressInterCluster"))
+					Expect(ngTemplate.Resources).NotTo(HaveKey("EgressInterClusterAPI"))
+					Expect(ngTemplate.Resources).To(HaveKey("EgressInterClusterPorts0"))
+					Expect(ngTemplate.Resources).To(HaveKey("EgressInterClusterPorts1"))
+					properties = ngTemplate.Resources["EgressInterClusterPorts1"].Properties
+					Expect(properties.GroupID).To(ContainElement(sgID))
+					Expect(properties.DestinationSecurityGroupID).To(Equal(makeRef("SG")))
+					Expect(properties.Description).To(Equal("Allow control plane to communicate with worker nodes in group ng-abcd1234 (TCP ports 10250-10250)"))
+					Expect(properties.IPProtocol).To(Equal("tcp"))
+					Expect(properties.FromPort).To(Equal(10250))
+					Expect(properties.ToPort).To(Equal(10250))
+				})
+
+				It("still allows the nodes to reach the API server", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("IngressInterClusterCP"))
+				})
+			})
+
 			Context("ng.EFA is enabled", func() {
 				BeforeEach(func() {
 					ng.EFAEnabled = aws.Bool(true)
@@ -577,6 +652,38 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					Expect(properties.Description).To(Equal("Allow worker nodes in group ng-abcd1234 to communicate to itself (EFA-enabled)"))
 				})
 			})
+
+			Context("ng.EFSMounts is set", func() {
+				BeforeEach(func() {
+					ng.EFSMounts = []api.EFSMount{
+						{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared", MountTargetSecurityGroupIDs: []string{"sg-efs-2", "sg-efs-1"}},
+						{FileSystemID: "fs-0fedcba9876543210", MountPath: "/mnt/models", MountTargetSecurityGroupIDs: []string{"sg-efs-1"}},
+					}
+				})
+
+				It("adds a security group allowed to reach the mount targets over NFS", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("EFSSG"))
+					properties := ngTemplate.Resources["EFSSG"].Properties
+					Expect(properties.VpcID).To(ContainElement(vpcID))
+					Expect(properties.GroupDescription).To(Equal("Allow worker nodes in group ng-abcd1234 to mount EFS file systems"))
+
+					for i, sgID := range []string{"sg-efs-1", "sg-efs-2"} {
+						name := fmt.Sprintf("EFSIngress%d", i)
+						Expect(ngTemplate.Resources).To(HaveKey(name))
+						properties = ngTemplate.Resources[name].Properties
+						Expect(properties.GroupID).To(Equal(sgID))
+						Expect(properties.SourceSecurityGroupID).To(Equal(makeRef("EFSSG")))
+						Expect(properties.Description).To(Equal("Allow worker nodes in group ng-abcd1234 to mount EFS file systems over NFS"))
+						Expect(properties.IPProtocol).To(Equal("tcp"))
+						Expect(properties.FromPort).To(Equal(2049))
+						Expect(properties.ToPort).To(Equal(2049))
+					}
+					Expect(ngTemplate.Resources).NotTo(HaveKey("EFSIngress2"))
+
+					groups := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.NetworkInterfaces[0].Groups
+					Expect(groups).To(ContainElement(makeRef("EFSSG")))
+				})
+			})
 		})
 
 		Context("adding resources for nodegroup", func() {
@@ -640,6 +747,18 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.NodeNameSource is set", func() {
+				BeforeEach(func() {
+					ng.NodeNameSource = api.NodeNameSourceResourceName
+				})
+
+				It("sets the hostname type of the instances on the LaunchTemplateData", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.PrivateDNSNameOptions.HostnameType).To(Equal("resource-name"))
+					Expect(properties.LaunchTemplateData.UserData).NotTo(BeEmpty())
+				})
+			})
+
 			Context("ng.EFAEnabled is true and ng.Placement is nil", func() {
 				BeforeEach(func() {
 					ng.EFAEnabled = aws.Bool(true)
@@ -653,6 +772,20 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.EFAEnabled is true and ng.Placement is set", func() {
+				BeforeEach(func() {
+					ng.EFAEnabled = aws.Bool(true)
+					ng.Placement = &api.Placement{GroupName: "efa-cluster-pg"}
+				})
+
+				It("uses the placement group without describing it", func() {
+					Expect(addErr).NotTo(HaveOccurred())
+					Expect(ngTemplate.Resources).NotTo(HaveKey("NodeGroupPlacementGroup"))
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.Placement.GroupName).To(Equal("efa-cluster-pg"))
+				})
+			})
+
 			Context("mixed instances are set", func() {
 				BeforeEach(func() {
 					ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
@@ -679,12 +812,19 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 
 			Context("ng.CPUCredits are set", func() {
 				BeforeEach(func() {
-					ng.CPUCredits = aws.String("major-street-cred")
+					ng.CPUCredits = aws.String("Unlimited")
 				})
 
-				It("enables the value on the launch template", func() {
+				It("sets the lowercased value on the launch template", func() {
 					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
-					Expect(properties.LaunchTemplateData.CreditSpecification.CPUCredits).To(Equal("major-street-cred"))
+					Expect(properties.LaunchTemplateData.CreditSpecification.CPUCredits).To(Equal(api.CPUCreditsUnlimited))
+				})
+			})
+
+			Context("ng.CPUCredits are not set", func() {
+				It("does not set a credit specification on the launch template", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.CreditSpecification).To(BeNil())
 				})
 			})
 
@@ -699,6 +839,34 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.Tenancy is dedicated", func() {
+				BeforeEach(func() {
+					ng.Placement = &api.Placement{GroupName: "one-direction"}
+					ng.Tenancy = api.TenancyDedicated
+				})
+
+				It("sets the tenancy alongside the placement group", func() {
+					placement := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.Placement
+					Expect(placement.GroupName).To(Equal("one-direction"))
+					Expect(placement.Tenancy).To(Equal("dedicated"))
+					Expect(placement.HostResourceGroupArn).To(BeEmpty())
+				})
+			})
+
+			Context("ng.Tenancy is host", func() {
+				BeforeEach(func() {
+					ng.Tenancy = api.TenancyHost
+					ng.HostResourceGroupARN = "arn:aws:resource-groups:us-west-2:123456789012:group/hosts"
+				})
+
+				It("sets the tenancy and the host resource group on the LaunchTemplateData", func() {
+					placement := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.Placement
+					Expect(placement.GroupName).To(BeNil())
+					Expect(placement.Tenancy).To(Equal("host"))
+					Expect(placement.HostResourceGroupArn).To(Equal("arn:aws:resource-groups:us-west-2:123456789012:group/hosts"))
+				})
+			})
+
 			It("creates new NodeGroup resource", func() {
 				Expect(ngTemplate.Resources).To(HaveKey("NodeGroup"))
 				Expect(ngTemplate.Resources["NodeGroup"].Type).To(Equal("AWS::AutoScaling::AutoScalingGroup"))
@@ -901,11 +1069,27 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 
 			Context("ng.ASGSuspendProcesses are set", func() {
 				BeforeEach(func() {
-					ng.ASGSuspendProcesses = []string{"stuff"}
+					ng.ASGSuspendProcesses = []string{"AZRebalance", "ReplaceUnhealthy"}
 				})
 
 				It("sets SuspendProcesses on the update policy", func() {
-					Expect(ngTemplate.Resources["NodeGroup"].UpdatePolicy["AutoScalingRollingUpdate"]["SuspendProcesses"]).To(Equal([]interface{}{"stuff"}))
+					Expect(ngTemplate.Resources["NodeGroup"].UpdatePolicy["AutoScalingRollingUpdate"]["SuspendProcesses"]).To(Equal([]interface{}{"AZRebalance", "ReplaceUnhealthy"}))
+				})
+			})
+
+			Context("ng.ASGSuspendProcesses are not set", func() {
+				It("does not suspend any process during updates", func() {
+					Expect(ngTemplate.Resources["NodeGroup"].UpdatePolicy["AutoScalingRollingUpdate"]).NotTo(HaveKey("SuspendProcesses"))
+				})
+			})
+
+			Context("ng.InstanceRefresh is set", func() {
+				BeforeEach(func() {
+					ng.InstanceRefresh = &api.InstanceRefresh{MinHealthyPercentage: aws.Int(75)}
+				})
+
+				It("does not add a rolling update, as an instance refresh replaces the nodes", func() {
+					Expect(ngTemplate.Resources["NodeGroup"].UpdatePolicy).To(BeEmpty())
 				})
 			})
 
@@ -977,6 +1161,20 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					})
 				})
 
+				Context("ng.VolumeType is IO2", func() {
+					BeforeEach(func() {
+						ng.VolumeType = aws.String(api.NodeVolumeTypeIO2)
+						ng.VolumeIOPS = aws.Int(5000)
+					})
+
+					It("IOPS are set on the block device mapping", func() {
+						mapping := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.BlockDeviceMappings[0]
+						Expect(mapping.Ebs["VolumeType"]).To(Equal("io2"))
+						Expect(mapping.Ebs["Iops"]).To(Equal(float64(5000)))
+						Expect(mapping.Ebs).NotTo(HaveKey("Throughput"))
+					})
+				})
+
 				Context("ng.VolumeType is GP3", func() {
 					BeforeEach(func() {
 						ng.VolumeType = aws.String(api.NodeVolumeTypeGP3)
@@ -1035,6 +1233,86 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 				})
 			})
 
+			Context("ng.AssociatePublicIPAddress is not set", func() {
+				It("leaves the public IP address of the nodes to their subnet", func() {
+					nis := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.NetworkInterfaces
+					Expect(nis).To(HaveLen(1))
+					Expect(nis[0].AssociatePublicIPAddress).To(BeNil())
+				})
+			})
+
+			Context("ng.AssociatePublicIPAddress is set", func() {
+				for _, associate := range []bool{true, false} {
+					associate := associate
+					When(fmt.Sprintf("it is %t", associate), func() {
+						BeforeEach(func() {
+							ng.AssociatePublicIPAddress = aws.Bool(associate)
+						})
+
+						It("sets it on the primary network interface of the launchTemplate", func() {
+							Expect(addErr).NotTo(HaveOccurred())
+							nis := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.NetworkInterfaces
+							Expect(nis).To(HaveLen(1))
+							Expect(nis[0].AssociatePublicIPAddress).To(Equal(aws.Bool(associate)))
+						})
+					})
+				}
+
+				When("the nodegroup has multiple EFA network interfaces", func() {
+					BeforeEach(func() {
+						ng.AssociatePublicIPAddress = aws.Bool(true)
+						ng.EFAEnabled = aws.Bool(true)
+						ng.InstanceType = "p4d.24xlarge"
+						mockEC2.On("DescribeInstanceTypes",
+							&ec2.DescribeInstanceTypesInput{
+								InstanceTypes: aws.StringSlice([]string{"p4d.24xlarge"}),
+							},
+						).Return(
+							&ec2.DescribeInstanceTypesOutput{
+								InstanceTypes: []*ec2.InstanceTypeInfo{
+									{
+										InstanceType: aws.String("p4d.24xlarge"),
+										NetworkInfo: &ec2.NetworkInfo{
+											EfaSupported:        aws.Bool(true),
+											MaximumNetworkCards: aws.Int64(4),
+										},
+									},
+								},
+							}, nil,
+						)
+					})
+
+					It("fails", func() {
+						Expect(addErr).To(MatchError(ContainSubstring(`associatePublicIPAddress cannot be set on nodegroup "ng-abcd1234", as its EFA-enabled instances have multiple network interfaces`)))
+					})
+				})
+			})
+
+			Context("ng.SecondaryNetworkInterfaces are set", func() {
+				BeforeEach(func() {
+					ng.SecondaryNetworkInterfaces = []api.SecondaryNetworkInterface{
+						{DeviceIndex: 1, Subnet: "subnet-1"},
+						{DeviceIndex: 2, Subnet: "subnet-2", SecurityGroups: []string{"sg-1", "sg-2"}},
+					}
+				})
+
+				It("adds them to the launchTemplate after the primary network interface", func() {
+					nis := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.NetworkInterfaces
+					Expect(nis).To(HaveLen(3))
+					Expect(nis[0].DeviceIndex).To(Equal(0))
+					Expect(nis[0].SubnetID).To(BeEmpty())
+
+					Expect(nis[1].DeviceIndex).To(Equal(1))
+					Expect(nis[1].SubnetID).To(Equal("subnet-1"))
+					Expect(nis[1].DeleteOnTermination).To(BeTrue())
+					Expect(nis[1].Groups).To(Equal(nis[0].Groups))
+
+					Expect(nis[2].DeviceIndex).To(Equal(2))
+					Expect(nis[2].SubnetID).To(Equal("subnet-2"))
+					Expect(nis[2].Groups).To(Equal([]interface{}{"sg-1", "sg-2"}))
+				})
+			})
+
 			Context("ng.EnableDetailedMonitoring is true", func() {
 				BeforeEach(func() {
 					ng.EnableDetailedMonitoring = aws.Bool(true)
@@ -1045,6 +1323,107 @@ var _ = Describe("Unmanaged NodeGroup Template Builder", func() {
 					Expect(properties.LaunchTemplateData.Monitoring.Enabled).To(Equal(true))
 				})
 			})
+
+			Context("ng.EnableDetailedMonitoring is not set", func() {
+				It("leaves the basic monitoring of EC2 in place", func() {
+					properties := ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties
+					Expect(properties.LaunchTemplateData.Monitoring).To(BeNil())
+				})
+			})
+
+			Context("ng.WarmPool is not set", func() {
+				It("does not add a warm pool", func() {
+					Expect(ngTemplate.Resources).NotTo(HaveKey("NodeGroupWarmPool"))
+					Expect(ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.HibernationOptions).To(BeNil())
+				})
+			})
+
+			Context("ng.WarmPool is set", func() {
+				BeforeEach(func() {
+					ng.WarmPool = &api.WarmPool{
+						MinSize:                  aws.Int(1),
+						MaxGroupPreparedCapacity: aws.Int(4),
+						PoolState:                api.WarmPoolStateRunning,
+						ReuseOnScaleIn:           aws.Bool(true),
+					}
+				})
+
+				It("adds a warm pool to the Auto Scaling group", func() {
+					Expect(ngTemplate.Resources).To(HaveKey("NodeGroupWarmPool"))
+					warmPool := ngTemplate.Resources["NodeGroupWarmPool"]
+					Expect(warmPool.Type).To(Equal("AWS::AutoScaling::WarmPool"))
+					Expect(warmPool.Properties.AutoScalingGroupName).To(Equal(makeRef("NodeGroup")))
+					Expect(warmPool.Properties.MinSize).To(Equal("1"))
+					Expect(warmPool.Properties.MaxGroupPreparedCapacity).To(Equal("4"))
+					Expect(warmPool.Properties.PoolState).To(Equal("Running"))
+					Expect(warmPool.Properties.InstanceReusePolicy.ReuseOnScaleIn).To(BeTrue())
+					Expect(ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.HibernationOptions).To(BeNil())
+				})
+
+				Context("with only the defaults", func() {
+					BeforeEach(func() {
+						ng.WarmPool = &api.WarmPool{}
+					})
+
+					It("leaves the properties to the Auto Scaling defaults", func() {
+						properties := ngTemplate.Resources["NodeGroupWarmPool"].Properties
+						Expect(properties.AutoScalingGroupName).To(Equal(makeRef("NodeGroup")))
+						Expect(properties.MinSize).To(BeEmpty())
+						Expect(properties.MaxGroupPreparedCapacity).To(BeEmpty())
+						Expect(properties.PoolState).To(BeEmpty())
+						Expect(properties.InstanceReusePolicy).To(BeNil())
+					})
+				})
+
+				Context("instances are hibernated", func() {
+					mockHibernation := func(instanceType string, hibernationSupported bool) {
+						mockEC2.On("DescribeInstanceTypes", &ec2.DescribeInstanceTypesInput{
+							InstanceTypes: aws.StringSlice([]string{instanceType}),
+						}).Return(&ec2.DescribeInstanceTypesOutput{
+							InstanceTypes: []*ec2.InstanceTypeInfo{{
+								InstanceType:         aws.String(instanceType),
+								HibernationSupported: aws.Bool(hibernationSupported),
+								MemoryInfo:           &ec2.MemoryInfo{SizeInMiB: aws.Int64(16384)},
+							}},
+						}, nil)
+					}
+
+					BeforeEach(func() {
+						ng.WarmPool.PoolState = api.WarmPoolStateHibernated
+						ng.VolumeEncrypted = api.Enabled()
+						ng.VolumeSize = aws.Int(80)
+					})
+
+					Context("the instance type supports hibernation", func() {
+						BeforeEach(func() {
+							ng.InstanceType = "m5.xlarge"
+							mockHibernation("m5.xlarge", true)
+						})
+
+						It("configures hibernation on the launch template", func() {
+							Expect(addErr).NotTo(HaveOccurred())
+							Expect(ngTemplate.Resources["NodeGroupWarmPool"].Properties.PoolState).To(Equal("Hibernated"))
+							Expect(ngTemplate.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.HibernationOptions.Configured).To(BeTrue())
+						})
+
+						It("fails if the root volume cannot hold the memory of the instance", func() {
+							ng.VolumeSize = aws.Int(16)
+							Expect(ngrs.AddAllResources()).To(MatchError(ContainSubstring("the root volume of 16 GiB is too small to hibernate instance type m5.xlarge, which has 16384 MiB of memory")))
+						})
+					})
+
+					Context("the instance type does not support hibernation", func() {
+						BeforeEach(func() {
+							ng.InstanceType = "m5.metal"
+							mockHibernation("m5.metal", false)
+						})
+
+						It("fails", func() {
+							Expect(addErr).To(MatchError(ContainSubstring("instance type m5.metal does not support hibernation, which is required by warmPool.poolState Hibernated")))
+						})
+					})
+				})
+			})
 		})
 	})
 
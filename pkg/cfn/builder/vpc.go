@@ -11,6 +11,7 @@ import (
 	gfncfn "github.com/weaveworks/goformation/v4/cloudformation/cloudformation"
 	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
 	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
@@ -32,6 +33,8 @@ type VPCResourceSet struct {
 	ec2API        ec2iface.EC2API
 
 	vpcResource *VPCResource
+	// secondarySubnets are the subnets of each of the secondary CIDRs, in the order of the availability zones
+	secondarySubnets [][]*gfnt.Value
 }
 
 // VPCResource represents a VPC resource
@@ -96,6 +99,9 @@ func (v *VPCResourceSet) AddResources() (*VPCResource, error) {
 	if v.isFullyPrivate() {
 		v.noNAT()
 		v.vpcResource.SubnetDetails.Private = v.addSubnets(nil, api.SubnetTopologyPrivate, vpc.Subnets.Private)
+		if err := v.addSecondaryCIDRs(); err != nil {
+			return nil, err
+		}
 		return v.vpcResource, nil
 	}
 
@@ -124,9 +130,47 @@ func (v *VPCResourceSet) AddResources() (*VPCResource, error) {
 	}
 
 	v.vpcResource.SubnetDetails.Private = v.addSubnets(nil, api.SubnetTopologyPrivate, vpc.Subnets.Private)
+	if err := v.addSecondaryCIDRs(); err != nil {
+		return nil, err
+	}
 	return v.vpcResource, nil
 }
 
+// addSecondaryCIDRs associates the secondary CIDRs with the VPC and creates a subnet of each of them in every
+// availability zone, routed through the private route table of the zone
+func (v *VPCResourceSet) addSecondaryCIDRs() error {
+	for i, cidr := range v.clusterConfig.VPC.SecondaryCIDRs {
+		subnetCIDRs, err := vpc.SplitSecondaryCIDR(cidr, v.clusterConfig.AvailabilityZones)
+		if err != nil {
+			return errors.Wrapf(err, "vpc.secondaryCIDRs[%d]", i)
+		}
+		cidrAlias := fmt.Sprintf("SecondaryCIDR%d", i)
+		v.rs.newResource(cidrAlias, &gfnec2.VPCCidrBlock{
+			VpcId:     v.vpcResource.VPC,
+			CidrBlock: gfnt.NewString(cidr),
+		})
+
+		var subnetRefs []*gfnt.Value
+		for j, az := range v.clusterConfig.AvailabilityZones {
+			alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+			refSubnet := v.rs.newResource("Subnet"+cidrAlias+alphanumericUpperAZ, &gfnec2.Subnet{
+				AvailabilityZone: gfnt.NewString(az),
+				CidrBlock:        gfnt.NewString(subnetCIDRs[j].String()),
+				VpcId:            v.vpcResource.VPC,
+				// subnets cannot be created in a CIDR before it is associated with the VPC
+				AWSCloudFormationDependsOn: []string{cidrAlias},
+			})
+			v.rs.newResource("RouteTableAssociation"+cidrAlias+alphanumericUpperAZ, &gfnec2.SubnetRouteTableAssociation{
+				SubnetId:     refSubnet,
+				RouteTableId: gfnt.MakeRef("PrivateRouteTable" + alphanumericUpperAZ),
+			})
+			subnetRefs = append(subnetRefs, refSubnet)
+		}
+		v.secondarySubnets = append(v.secondarySubnets, subnetRefs)
+	}
+	return nil
+}
+
 func (s *subnetDetails) PublicSubnetRefs() []*gfnt.Value {
 	var subnetRefs []*gfnt.Value
 	for _, subnetAZ := range s.Public {
@@ -167,6 +211,27 @@ func (v *VPCResourceSet) AddOutputs() {
 		addSubnetOutput(subnetAZs, api.SubnetTopologyPublic, outputs.ClusterSubnetsPublic)
 	}
 
+	for i, subnetRefs := range v.secondarySubnets {
+		name := fmt.Sprintf("%s%d", outputs.ClusterSubnetsSecondaryCIDR, i)
+		customNetworking := v.clusterConfig.VPC.CustomNetworking
+		if i > 0 || customNetworking == nil || len(customNetworking.PodSubnets) > 0 {
+			v.rs.defineJoinedOutput(name, subnetRefs, true, func(_ string) error { return nil })
+			continue
+		}
+		// the pods are placed in the subnets of the first secondary CIDR when no pod subnets are set
+		v.rs.defineJoinedOutput(name, subnetRefs, true, func(value string) error {
+			subnetIDs := strings.Split(value, ",")
+			if len(subnetIDs) != len(v.clusterConfig.AvailabilityZones) {
+				return fmt.Errorf("expected %d subnets in output %s, got %q", len(v.clusterConfig.AvailabilityZones), name, value)
+			}
+			customNetworking.PodSubnets = map[string]string{}
+			for j, az := range v.clusterConfig.AvailabilityZones {
+				customNetworking.PodSubnets[az] = subnetIDs[j]
+			}
+			return nil
+		})
+	}
+
 	if v.isFullyPrivate() {
 		v.rs.defineOutputWithoutCollector(outputs.ClusterFullyPrivate, true, true)
 	}
@@ -378,6 +443,7 @@ var (
 
 	sgPortHTTPS = gfnt.NewInteger(443)
 	sgPortSSH   = gfnt.NewInteger(22)
+	sgPortNFS   = gfnt.NewInteger(2049)
 )
 
 type clusterSecurityGroup struct {
@@ -495,6 +561,36 @@ func (rs *resourceSet) addEFASecurityGroup(vpcID *gfnt.Value, clusterName, desc
 	return efaSG
 }
 
+// addEFSSecurityGroup adds a security group for the nodes mounting the EFS file systems, and allows
+// the security groups of the mount targets of the file systems to receive NFS traffic from it
+func (rs *resourceSet) addEFSSecurityGroup(vpcID *gfnt.Value, clusterName, desc string, mounts []api.EFSMount) *gfnt.Value {
+	efsSG := rs.newResource("EFSSG", &gfnec2.SecurityGroup{
+		VpcId:            vpcID,
+		GroupDescription: gfnt.NewString("Allow " + desc + " to mount EFS file systems"),
+		Tags: []gfncfn.Tag{{
+			Key:   gfnt.NewString("kubernetes.io/cluster/" + clusterName),
+			Value: gfnt.NewString("owned"),
+		}},
+	})
+
+	mountTargetSGs := sets.NewString()
+	for _, mount := range mounts {
+		mountTargetSGs.Insert(mount.MountTargetSecurityGroupIDs...)
+	}
+	for i, sgID := range mountTargetSGs.List() {
+		rs.newResource(fmt.Sprintf("EFSIngress%d", i), &gfnec2.SecurityGroupIngress{
+			GroupId:               gfnt.NewString(sgID),
+			SourceSecurityGroupId: efsSG,
+			Description:           gfnt.NewString("Allow " + desc + " to mount EFS file systems over NFS"),
+			IpProtocol:            sgProtoTCP,
+			FromPort:              sgPortNFS,
+			ToPort:                sgPortNFS,
+		})
+	}
+
+	return efsSG
+}
+
 // TODO move this
 func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 	for _, id := range n.spec.SecurityGroups.AttachIDs {
@@ -505,6 +601,11 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		n.securityGroups = append(n.securityGroups, n.vpcImporter.SharedNodeSecurityGroup())
 	}
 
+	if len(n.spec.EFSMounts) > 0 {
+		efsSG := n.rs.addEFSSecurityGroup(n.vpcImporter.VPC(), n.clusterSpec.Metadata.Name, "worker nodes in group "+n.spec.Name, n.spec.EFSMounts)
+		n.securityGroups = append(n.securityGroups, efsSG)
+	}
+
 	if api.IsDisabled(n.spec.SecurityGroups.WithLocal) {
 		return
 	}
@@ -520,7 +621,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 			Key:   gfnt.NewString("kubernetes.io/cluster/" + n.clusterSpec.Metadata.Name),
 			Value: gfnt.NewString("owned"),
 		}},
-		SecurityGroupIngress: makeNodeIngressRules(n.spec.NodeGroupBase, refControlPlaneSG, n.clusterSpec.VPC.CIDR.String(), desc),
+		SecurityGroupIngress: makeNodeIngressRules(n.spec.NodeGroupBase, refControlPlaneSG, n.clusterSpec.VPC, desc),
 	})
 
 	n.securityGroups = append(n.securityGroups, refNodeGroupLocalSG)
@@ -530,22 +631,16 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		n.securityGroups = append(n.securityGroups, efaSG)
 	}
 
-	n.newResource("EgressInterCluster", &gfnec2.SecurityGroupEgress{
-		GroupId:                    refControlPlaneSG,
-		DestinationSecurityGroupId: refNodeGroupLocalSG,
-		Description:                gfnt.NewString("Allow control plane to communicate with " + desc + " (kubelet and workload TCP ports)"),
-		IpProtocol:                 sgProtoTCP,
-		FromPort:                   sgMinNodePort,
-		ToPort:                     sgMaxNodePort,
-	})
-	n.newResource("EgressInterClusterAPI", &gfnec2.SecurityGroupEgress{
-		GroupId:                    refControlPlaneSG,
-		DestinationSecurityGroupId: refNodeGroupLocalSG,
-		Description:                gfnt.NewString("Allow control plane to communicate with " + desc + " (workloads using HTTPS port, commonly used with extension API servers)"),
-		IpProtocol:                 sgProtoTCP,
-		FromPort:                   sgPortHTTPS,
-		ToPort:                     sgPortHTTPS,
-	})
+	for _, rule := range makeNodePortRules(n.clusterSpec.VPC) {
+		n.newResource("EgressInterCluster"+rule.name, &gfnec2.SecurityGroupEgress{
+			GroupId:                    refControlPlaneSG,
+			DestinationSecurityGroupId: refNodeGroupLocalSG,
+			Description:                gfnt.NewString("Allow control plane to communicate with " + desc + " (" + rule.purpose + ")"),
+			IpProtocol:                 sgProtoTCP,
+			FromPort:                   rule.fromPort,
+			ToPort:                     rule.toPort,
+		})
+	}
 	n.newResource("IngressInterClusterCP", &gfnec2.SecurityGroupIngress{
 		GroupId:               refControlPlaneSG,
 		SourceSecurityGroupId: refNodeGroupLocalSG,
@@ -556,25 +651,57 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 	})
 }
 
-func makeNodeIngressRules(ng *api.NodeGroupBase, controlPlaneSG *gfnt.Value, vpcCIDR, description string) []gfnec2.SecurityGroup_Ingress {
-	ingressRules := []gfnec2.SecurityGroup_Ingress{
-		{
-			SourceSecurityGroupId: controlPlaneSG,
-			Description:           gfnt.NewString(fmt.Sprintf("[IngressInterCluster] Allow %s to communicate with control plane (kubelet and workload TCP ports)", description)),
-			IpProtocol:            sgProtoTCP,
-			FromPort:              sgMinNodePort,
-			ToPort:                sgMaxNodePort,
-		},
-		{
+// nodePortRule is a TCP port range on nodes that the control plane can communicate with
+type nodePortRule struct {
+	name             string
+	purpose          string
+	fromPort, toPort *gfnt.Value
+}
+
+// makeNodePortRules returns the default node port rules, unless they have been narrowed
+// with vpc.controlPlaneSecurityGroupRules
+func makeNodePortRules(vpc *api.ClusterVPC) []nodePortRule {
+	if vpc == nil || vpc.ControlPlaneSecurityGroupRules == nil || len(vpc.ControlPlaneSecurityGroupRules.NodePorts) == 0 {
+		return []nodePortRule{
+			{
+				purpose:  "kubelet and workload TCP ports",
+				fromPort: sgMinNodePort,
+				toPort:   sgMaxNodePort,
+			},
+			{
+				name:     "API",
+				purpose:  "workloads using HTTPS port, commonly used with extension API servers",
+				fromPort: sgPortHTTPS,
+				toPort:   sgPortHTTPS,
+			},
+		}
+	}
+
+	var rules []nodePortRule
+	for i, pr := range vpc.ControlPlaneSecurityGroupRules.NodePorts {
+		rules = append(rules, nodePortRule{
+			name:     fmt.Sprintf("Ports%d", i),
+			purpose:  fmt.Sprintf("TCP ports %d-%d", pr.FromPort, pr.ToPort),
+			fromPort: gfnt.NewInteger(pr.FromPort),
+			toPort:   gfnt.NewInteger(pr.ToPort),
+		})
+	}
+	return rules
+}
+
+func makeNodeIngressRules(ng *api.NodeGroupBase, controlPlaneSG *gfnt.Value, vpc *api.ClusterVPC, description string) []gfnec2.SecurityGroup_Ingress {
+	var ingressRules []gfnec2.SecurityGroup_Ingress
+	for _, rule := range makeNodePortRules(vpc) {
+		ingressRules = append(ingressRules, gfnec2.SecurityGroup_Ingress{
 			SourceSecurityGroupId: controlPlaneSG,
-			Description:           gfnt.NewString(fmt.Sprintf("[IngressInterClusterAPI] Allow %s to communicate with control plane (workloads using HTTPS port, commonly used with extension API servers)", description)),
+			Description:           gfnt.NewString(fmt.Sprintf("[IngressInterCluster%s] Allow %s to communicate with control plane (%s)", rule.name, description, rule.purpose)),
 			IpProtocol:            sgProtoTCP,
-			FromPort:              sgPortHTTPS,
-			ToPort:                sgPortHTTPS,
-		},
+			FromPort:              rule.fromPort,
+			ToPort:                rule.toPort,
+		})
 	}
 
-	return append(ingressRules, makeSSHIngressRules(ng, vpcCIDR, description)...)
+	return append(ingressRules, makeSSHIngressRules(ng, vpc.CIDR.String(), description)...)
 }
 
 func (v *VPCResourceSet) haNAT() {
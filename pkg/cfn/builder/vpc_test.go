@@ -414,6 +414,53 @@ var _ = Describe("VPC Template Builder", func() {
 			})
 		})
 
+		Context("when secondary CIDRs are set", func() {
+			BeforeEach(func() {
+				cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16"}
+			})
+
+			It("associates the secondary CIDR with the VPC", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("SecondaryCIDR0"))
+				Expect(vpcTemplate.Resources["SecondaryCIDR0"].Type).To(Equal("AWS::EC2::VPCCidrBlock"))
+				Expect(vpcTemplate.Resources["SecondaryCIDR0"].Properties.VpcID).To(Equal(makeRef(vpcResourceKey)))
+				Expect(vpcTemplate.Resources["SecondaryCIDR0"].Properties.CidrBlock).To(Equal("100.64.0.0/16"))
+			})
+
+			It("adds a subnet of the secondary CIDR in each availability zone", func() {
+				Expect(vpcTemplate.Resources).To(HaveKey("SubnetSecondaryCIDR0USWEST2A"))
+				Expect(vpcTemplate.Resources["SubnetSecondaryCIDR0USWEST2A"].Properties.AvailabilityZone).To(Equal(azA))
+				Expect(vpcTemplate.Resources["SubnetSecondaryCIDR0USWEST2A"].Properties.CidrBlock).To(Equal("100.64.0.0/17"))
+				Expect(vpcTemplate.Resources["SubnetSecondaryCIDR0USWEST2A"].DependsOn).To(Equal([]string{"SecondaryCIDR0"}))
+
+				Expect(vpcTemplate.Resources).To(HaveKey("SubnetSecondaryCIDR0USWEST2B"))
+				Expect(vpcTemplate.Resources["SubnetSecondaryCIDR0USWEST2B"].Properties.AvailabilityZone).To(Equal(azB))
+				Expect(vpcTemplate.Resources["SubnetSecondaryCIDR0USWEST2B"].Properties.CidrBlock).To(Equal("100.64.128.0/17"))
+				Expect(vpcTemplate.Resources["SubnetSecondaryCIDR0USWEST2B"].DependsOn).To(Equal([]string{"SecondaryCIDR0"}))
+			})
+
+			It("routes the subnets through the private route tables", func() {
+				Expect(vpcTemplate.Resources["RouteTableAssociationSecondaryCIDR0USWEST2A"].Properties.SubnetID).To(Equal(makeRef("SubnetSecondaryCIDR0USWEST2A")))
+				Expect(vpcTemplate.Resources["RouteTableAssociationSecondaryCIDR0USWEST2A"].Properties.RouteTableID).To(Equal(makeRef(privRouteTableA)))
+				Expect(vpcTemplate.Resources["RouteTableAssociationSecondaryCIDR0USWEST2B"].Properties.SubnetID).To(Equal(makeRef("SubnetSecondaryCIDR0USWEST2B")))
+				Expect(vpcTemplate.Resources["RouteTableAssociationSecondaryCIDR0USWEST2B"].Properties.RouteTableID).To(Equal(makeRef(privRouteTableB)))
+			})
+
+			It("does not add the secondary subnets to the subnet details", func() {
+				Expect(result.SubnetDetails.Private).To(HaveLen(2))
+				Expect(result.SubnetDetails.Public).To(HaveLen(2))
+			})
+		})
+
+		Context("when a secondary CIDR is too small for the availability zones", func() {
+			BeforeEach(func() {
+				cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/28"}
+			})
+
+			It("returns an error", func() {
+				Expect(addErr).To(MatchError(ContainSubstring("vpc.secondaryCIDRs[0]")))
+			})
+		})
+
 		Context("when the vpc is fully private", func() {
 			BeforeEach(func() {
 				cfg.PrivateCluster.Enabled = true
@@ -495,6 +542,17 @@ var _ = Describe("VPC Template Builder", func() {
 			})
 		})
 
+		Context("if there are secondary CIDRs", func() {
+			BeforeEach(func() {
+				cfg.VPC.SecondaryCIDRs = []string{"100.64.0.0/16", "100.65.0.0/16"}
+			})
+
+			It("adds the secondary subnet refs to the output", func() {
+				Expect(vpcTemplate.Outputs).To(HaveKey("SubnetsSecondaryCIDR0"))
+				Expect(vpcTemplate.Outputs).To(HaveKey("SubnetsSecondaryCIDR1"))
+			})
+		})
+
 		Context("the cluster is fully private", func() {
 			BeforeEach(func() {
 				cfg.PrivateCluster.Enabled = true
@@ -0,0 +1,68 @@
+package builder
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+	gfnec2 "github.com/weaveworks/goformation/v4/cloudformation/ec2"
+	gfnt "github.com/weaveworks/goformation/v4/cloudformation/types"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// warmPoolResource returns the warm pool of the Auto Scaling group of the nodegroup
+func warmPoolResource(warmPool *api.WarmPool) *awsCloudFormationResource {
+	props := map[string]interface{}{
+		"AutoScalingGroupName": gfnt.MakeRef("NodeGroup"),
+	}
+	if warmPool.MinSize != nil {
+		props["MinSize"] = fmt.Sprintf("%d", *warmPool.MinSize)
+	}
+	if warmPool.MaxGroupPreparedCapacity != nil {
+		props["MaxGroupPreparedCapacity"] = fmt.Sprintf("%d", *warmPool.MaxGroupPreparedCapacity)
+	}
+	if warmPool.PoolState != "" {
+		props["PoolState"] = warmPool.PoolState
+	}
+	if warmPool.ReuseOnScaleIn != nil {
+		props["InstanceReusePolicy"] = map[string]interface{}{
+			"ReuseOnScaleIn": *warmPool.ReuseOnScaleIn,
+		}
+	}
+
+	return &awsCloudFormationResource{
+		Type:       "AWS::AutoScaling::WarmPool",
+		Properties: props,
+	}
+}
+
+// makeHibernationOptions returns the hibernation options of the launch template of nodegroups whose
+// warm pool hibernates instances, after checking that the instance type supports it
+func makeHibernationOptions(ng *api.NodeGroup, ec2API ec2iface.EC2API) (*gfnec2.LaunchTemplate_HibernationOptions, error) {
+	if ng.WarmPool == nil || ng.WarmPool.PoolState != api.WarmPoolStateHibernated {
+		return nil, nil
+	}
+
+	output, err := ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice([]string{ng.InstanceType}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "couldn't retrieve instance type description for %s", ng.InstanceType)
+	}
+	for _, it := range output.InstanceTypes {
+		if !aws.BoolValue(it.HibernationSupported) {
+			return nil, errors.Errorf("instance type %s does not support hibernation, which is required by warmPool.poolState %s", aws.StringValue(it.InstanceType), api.WarmPoolStateHibernated)
+		}
+		// the memory of hibernated instances is saved on the root volume
+		if ng.VolumeSize != nil && it.MemoryInfo != nil && int64(*ng.VolumeSize)*1024 <= aws.Int64Value(it.MemoryInfo.SizeInMiB) {
+			return nil, errors.Errorf("the root volume of %d GiB is too small to hibernate instance type %s, which has %d MiB of memory", *ng.VolumeSize, aws.StringValue(it.InstanceType), aws.Int64Value(it.MemoryInfo.SizeInMiB))
+		}
+	}
+
+	return &gfnec2.LaunchTemplate_HibernationOptions{
+		Configured: gfnt.NewBoolean(true),
+	}, nil
+}
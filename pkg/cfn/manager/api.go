@@ -81,9 +81,20 @@ type StackCollection struct {
 	roleARN           string
 	region            string
 	waitTimeout       time.Duration
+	createTimeout     time.Duration
+	updateTimeout     time.Duration
+	deleteTimeout     time.Duration
 	sharedTags        []*cloudformation.Tag
 }
 
+// stackTimeout returns timeout if it is set, or the wait timeout of the provider otherwise
+func stackTimeout(timeout, waitTimeout time.Duration) time.Duration {
+	if timeout > 0 {
+		return timeout
+	}
+	return waitTimeout
+}
+
 func newTag(key, value string) *cloudformation.Tag {
 	return &cloudformation.Tag{Key: &key, Value: &value}
 }
@@ -95,9 +106,13 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 		newTag(api.OldClusterNameTag, spec.Metadata.Name),
 		newTag(api.EksctlVersionTag, version.GetVersion()),
 	}
+	if runID := provider.RunID(); runID != "" {
+		tags = append(tags, newTag(api.RunIDTag, runID))
+	}
 	for key, value := range spec.Metadata.Tags {
 		tags = append(tags, newTag(key, value))
 	}
+	stackTimeouts := spec.CloudFormation.StackTimeouts(provider.StackTimeouts())
 	return &StackCollection{
 		spec:              spec,
 		sharedTags:        tags,
@@ -111,6 +126,9 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 		roleARN:           provider.CloudFormationRoleARN(),
 		region:            provider.Region(),
 		waitTimeout:       provider.WaitTimeout(),
+		createTimeout:     stackTimeout(stackTimeouts.Create, provider.WaitTimeout()),
+		updateTimeout:     stackTimeout(stackTimeouts.Update, provider.WaitTimeout()),
+		deleteTimeout:     stackTimeout(stackTimeouts.Delete, provider.WaitTimeout()),
 	}
 }
 
@@ -134,13 +152,24 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateData TemplateDa
 		return fmt.Errorf("unknown template data type: %T", templateData)
 	}
 
+	var capabilities []*string
 	if withIAM {
-		input.SetCapabilities(stackCapabilitiesIAM)
+		capabilities = stackCapabilitiesIAM
 	}
 
 	if withNamedIAM {
-		input.SetCapabilities(stackCapabilitiesNamedIAM)
+		capabilities = stackCapabilitiesNamedIAM
+	}
+	if capabilities = c.withConfiguredCapabilities(capabilities); len(capabilities) > 0 {
+		input.SetCapabilities(capabilities)
+	}
+
+	stackPolicy, err := c.spec.CloudFormation.StackPolicyBody()
+	if err != nil {
+		return err
 	}
+	input.StackPolicyBody = stackPolicy
+	input.NotificationARNs = c.spec.CloudFormation.StackNotificationARNs()
 
 	if cfnRole := c.roleARN; cfnRole != "" {
 		input = input.SetRoleARN(cfnRole)
@@ -198,7 +227,7 @@ func (c *StackCollection) createClusterStack(stackName string, resourceSet build
 			c.troubleshootStackFailureCause(stack, cloudformation.StackStatusCreateComplete)
 		}
 
-		ctx, cancelFunc := context.WithTimeout(context.Background(), c.waitTimeout)
+		ctx, cancelFunc := context.WithTimeout(context.Background(), c.createTimeout)
 		defer cancelFunc()
 
 		stack, err := waiter.WaitForStack(ctx, c.cloudformationAPI, *stack.StackId, *stack.StackName, func(attempts int) time.Duration {
@@ -645,7 +674,8 @@ func (c *StackCollection) doCreateChangeSetRequest(stackName, changeSetName, des
 		return fmt.Errorf("unknown template data type: %T", templateData)
 	}
 
-	input.SetCapabilities(capabilities)
+	input.SetCapabilities(c.withConfiguredCapabilities(capabilities))
+	input.NotificationARNs = c.spec.CloudFormation.StackNotificationARNs()
 	if cfnRole := c.roleARN; cfnRole != "" {
 		input.SetRoleARN(cfnRole)
 	}
@@ -667,6 +697,27 @@ func (c *StackCollection) doCreateChangeSetRequest(stackName, changeSetName, des
 	return nil
 }
 
+// withConfiguredCapabilities adds the capabilities set in cloudFormation.capabilities to the capabilities of a stack
+func (c *StackCollection) withConfiguredCapabilities(capabilities []*string) []*string {
+	if c.spec.CloudFormation == nil {
+		return capabilities
+	}
+	merged := append([]*string{}, capabilities...)
+	for _, capability := range c.spec.CloudFormation.Capabilities {
+		found := false
+		for _, existing := range merged {
+			if aws.StringValue(existing) == capability {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, aws.String(capability))
+		}
+	}
+	return merged
+}
+
 func (c *StackCollection) doExecuteChangeSet(stackName string, changeSetName string) error {
 	input := &cloudformation.ExecuteChangeSetInput{
 		ChangeSetName: &changeSetName,
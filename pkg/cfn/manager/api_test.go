@@ -118,4 +118,155 @@ var _ = Describe("StackCollection", func() {
 		// Metadata tag
 		Expect(createChangeSetInput.Tags).To(ContainElement(&cfn.Tag{Key: aws.String("meta"), Value: aws.String("data")}))
 	})
+
+	Context("run ID", func() {
+		var (
+			p    *mockprovider.MockProvider
+			spec *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{}, nil)
+			spec = api.NewClusterConfig()
+			spec.Metadata.Name = "clusteur"
+		})
+
+		createStackTags := func() []*cfn.Tag {
+			sm := NewStackCollection(p, spec)
+			err := sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-stack")}, TemplateBody(""), map[string]string{"stack": "tag"}, nil, false, false)
+			Expect(err).NotTo(HaveOccurred())
+			return p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.CreateStackInput).Tags
+		}
+
+		It("tags created stacks with the run ID", func() {
+			p.SetRunID("ci-job-1234")
+			tags := createStackTags()
+			Expect(tags).To(ContainElement(&cfn.Tag{Key: aws.String(api.RunIDTag), Value: aws.String("ci-job-1234")}))
+			Expect(tags).To(ContainElement(&cfn.Tag{Key: aws.String("stack"), Value: aws.String("tag")}))
+		})
+
+		It("does not add the tag without a run ID", func() {
+			for _, tag := range createStackTags() {
+				Expect(*tag.Key).NotTo(Equal(api.RunIDTag))
+			}
+		})
+	})
+	Context("cloudFormation config", func() {
+		var (
+			p    *mockprovider.MockProvider
+			spec *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			spec = api.NewClusterConfig()
+			spec.Metadata.Name = "clusteur"
+			spec.CloudFormation = &api.CloudFormationConfig{
+				Capabilities: []string{cfn.CapabilityCapabilityAutoExpand, cfn.CapabilityCapabilityIam},
+				StackPolicy: api.InlineDocument{
+					"Statement": []interface{}{map[string]interface{}{
+						"Effect":    "Deny",
+						"Action":    "Update:Delete",
+						"Principal": "*",
+						"Resource":  "*",
+					}},
+				},
+			}
+		})
+
+		It("passes the capabilities and the stack policy to the created stacks", func() {
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{}, nil)
+			sm := NewStackCollection(p, spec)
+			err := sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-stack")}, TemplateBody(""), nil, nil, true, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.CreateStackInput)
+			Expect(aws.StringValueSlice(input.Capabilities)).To(Equal([]string{cfn.CapabilityCapabilityIam, cfn.CapabilityCapabilityAutoExpand}))
+			Expect(input.StackPolicyBody).NotTo(BeNil())
+			Expect(*input.StackPolicyBody).To(MatchJSON(`{"Statement": [{"Effect": "Deny", "Action": "Update:Delete", "Principal": "*", "Resource": "*"}]}`))
+		})
+
+		It("passes the notification ARNs to the created stacks", func() {
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{}, nil)
+			spec.CloudFormation.NotificationARNs = []string{"arn:aws:sns:us-west-2:123456789012:stack-events", "arn:aws:sns:us-west-2:123456789012:ops"}
+			sm := NewStackCollection(p, spec)
+			err := sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-stack")}, TemplateBody(""), nil, nil, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.CreateStackInput)
+			Expect(aws.StringValueSlice(input.NotificationARNs)).To(Equal([]string{"arn:aws:sns:us-west-2:123456789012:stack-events", "arn:aws:sns:us-west-2:123456789012:ops"}))
+		})
+
+		It("does not set capabilities or a stack policy by default", func() {
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{}, nil)
+			spec.CloudFormation = nil
+			sm := NewStackCollection(p, spec)
+			err := sm.DoCreateStackRequest(&Stack{StackName: aws.String("eksctl-stack")}, TemplateBody(""), nil, nil, false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.CreateStackInput)
+			Expect(input.Capabilities).To(BeEmpty())
+			Expect(input.StackPolicyBody).To(BeNil())
+			Expect(input.NotificationARNs).To(BeNil())
+		})
+
+		It("adds the capabilities to those of the updated stacks", func() {
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeChangeSetFailed := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        aws.String(cfn.ChangeSetStatusFailed),
+			}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:    &stackName,
+				StackStatus:  aws.String(cfn.StackStatusCreateComplete),
+				Capabilities: aws.StringSlice([]string{cfn.CapabilityCapabilityNamedIam}),
+			}}}, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			sm := NewStackCollection(p, spec)
+			err := sm.UpdateStack(stackName, changeSetName, "description", TemplateBody(""), nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			input := p.MockCloudFormation().Calls[1].Arguments.Get(0).(*cfn.CreateChangeSetInput)
+			Expect(aws.StringValueSlice(input.Capabilities)).To(Equal([]string{cfn.CapabilityCapabilityNamedIam, cfn.CapabilityCapabilityAutoExpand, cfn.CapabilityCapabilityIam}))
+			Expect(input.NotificationARNs).To(BeNil())
+		})
+
+		It("passes the notification ARNs to the change sets of the updated stacks", func() {
+			stackName := "eksctl-stack"
+			changeSetName := "eksctl-changeset"
+			describeChangeSetFailed := &cfn.DescribeChangeSetOutput{
+				StackName:     &stackName,
+				ChangeSetName: &changeSetName,
+				Status:        aws.String(cfn.ChangeSetStatusFailed),
+			}
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   &stackName,
+				StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			}}}, nil)
+			p.MockCloudFormation().On("CreateChangeSet", mock.Anything).Return(nil, nil)
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSetRequest", mock.Anything).Return(req, describeChangeSetFailed)
+			p.MockCloudFormation().On("DescribeChangeSet", mock.Anything).Return(&cfn.DescribeChangeSetOutput{
+				StackName:    &stackName,
+				StatusReason: aws.String("The submitted information didn't contain changes"),
+			}, nil)
+
+			spec.CloudFormation.NotificationARNs = []string{"arn:aws:sns:us-west-2:123456789012:stack-events"}
+			sm := NewStackCollection(p, spec)
+			Expect(sm.UpdateStack(stackName, changeSetName, "description", TemplateBody(""), nil)).To(Succeed())
+
+			input := p.MockCloudFormation().Calls[1].Arguments.Get(0).(*cfn.CreateChangeSetInput)
+			Expect(aws.StringValueSlice(input.NotificationARNs)).To(Equal([]string{"arn:aws:sns:us-west-2:123456789012:stack-events"}))
+		})
+	})
 })
@@ -37,6 +37,42 @@ func (c *StackCollection) createClusterTask(errs chan error, supportsManagedNode
 	return c.createClusterStack(name, stack, errs)
 }
 
+// SubmitClusterStack requests the creation of the cluster stack without waiting for it to be created
+func (c *StackCollection) SubmitClusterStack(supportsManagedNodes bool) (*Stack, error) {
+	name := c.MakeClusterStackName()
+	logger.Info("building cluster stack %q", name)
+	stack := builder.NewClusterResourceSet(c.ec2API, c.region, c.spec, supportsManagedNodes, nil)
+	if err := stack.AddAllResources(); err != nil {
+		return nil, err
+	}
+	return c.createStackRequest(name, stack, nil, nil)
+}
+
+// WaitForClusterStack blocks until the cluster stack has been created, e.g. after it was
+// submitted by SubmitClusterStack, and returns it
+func (c *StackCollection) WaitForClusterStack() (*Stack, error) {
+	stack, err := c.DescribeClusterStack()
+	if err != nil {
+		return nil, err
+	}
+	if stack == nil {
+		return nil, fmt.Errorf("no CloudFormation stack found for cluster %q", c.spec.Metadata.Name)
+	}
+
+	switch status := *stack.StackStatus; status {
+	case cfn.StackStatusCreateComplete, cfn.StackStatusUpdateComplete:
+		return stack, nil
+	case cfn.StackStatusCreateInProgress:
+		if err := c.DoWaitUntilStackIsCreated(stack); err != nil {
+			return nil, err
+		}
+		return c.DescribeStack(stack)
+	default:
+		c.troubleshootStackFailureCause(stack, cfn.StackStatusCreateComplete)
+		return nil, fmt.Errorf("cluster stack %q is in status %q and will not be created", *stack.StackName, status)
+	}
+}
+
 // DescribeClusterStack calls DescribeStacks and filters out cluster stack
 func (c *StackCollection) DescribeClusterStack() (*Stack, error) {
 	stacks, err := c.DescribeStacks()
@@ -241,3 +277,84 @@ func getClusterNameTag(s *Stack) string {
 	}
 	return ""
 }
+
+// vpcResourceTypes are the types of the resources that make up the VPC of the cluster stack
+var vpcResourceTypes = map[string]bool{
+	"AWS::EC2::VPC":                         true,
+	"AWS::EC2::VPCCidrBlock":                true,
+	"AWS::EC2::Subnet":                      true,
+	"AWS::EC2::SubnetCidrBlock":             true,
+	"AWS::EC2::InternetGateway":             true,
+	"AWS::EC2::EgressOnlyInternetGateway":   true,
+	"AWS::EC2::VPCGatewayAttachment":        true,
+	"AWS::EC2::RouteTable":                  true,
+	"AWS::EC2::Route":                       true,
+	"AWS::EC2::SubnetRouteTableAssociation": true,
+	"AWS::EC2::NatGateway":                  true,
+	"AWS::EC2::EIP":                         true,
+}
+
+// billedVPCResourceTypes are the retained resource types that keep incurring charges
+var billedVPCResourceTypes = map[string]bool{
+	"AWS::EC2::NatGateway": true,
+	"AWS::EC2::EIP":        true,
+}
+
+// RetainClusterStackVPC updates the cluster stack so that the VPC it created, along with its subnets,
+// gateways and route tables, is retained when the stack is deleted. It does nothing if the VPC was
+// not created by the cluster stack
+func (c *StackCollection) RetainClusterStackVPC() error {
+	name := c.MakeClusterStackName()
+	currentTemplate, err := c.GetStackTemplate(name)
+	if err != nil {
+		return errors.Wrapf(err, "error getting stack template %s", name)
+	}
+
+	newTemplate, retained, err := retainVPCResources(currentTemplate)
+	if err != nil {
+		return errors.Wrapf(err, "updating template of stack %s", name)
+	}
+	if len(retained) == 0 {
+		logger.Info("the VPC of cluster %q was not created by eksctl, there is nothing to retain", c.spec.Metadata.Name)
+		return nil
+	}
+
+	var billed []string
+	for _, resource := range retained {
+		if billedVPCResourceTypes[gjson.Get(currentTemplate, resourcesRootPath+"."+resource+".Type").String()] {
+			billed = append(billed, resource)
+		}
+	}
+	if len(billed) > 0 {
+		logger.Warning("the retained resources %v will keep incurring charges until they are deleted", billed)
+	}
+
+	description := fmt.Sprintf("updating stack to retain VPC resources %v on deletion", retained)
+	return c.UpdateStack(name, c.MakeChangeSetName("retain-vpc"), description, TemplateBody(newTemplate), nil)
+}
+
+// retainVPCResources sets the deletion policy of the VPC resources of the given template to Retain,
+// and returns the logical IDs of these resources
+func retainVPCResources(template string) (string, []string, error) {
+	resources := gjson.Get(template, resourcesRootPath)
+	if !resources.IsObject() {
+		return "", nil, errors.New("unexpected template format of the current stack")
+	}
+
+	var (
+		retained []string
+		setErr   error
+	)
+	resources.ForEach(func(key, value gjson.Result) bool {
+		if !vpcResourceTypes[value.Get("Type").String()] {
+			return true
+		}
+		retained = append(retained, key.String())
+		template, setErr = sjson.Set(template, resourcesRootPath+"."+key.String()+".DeletionPolicy", "Retain")
+		return setErr == nil
+	})
+	if setErr != nil {
+		return "", nil, setErr
+	}
+	return template, retained, nil
+}
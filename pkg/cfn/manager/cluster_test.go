@@ -0,0 +1,153 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"github.com/tidwall/gjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("Cluster stack", func() {
+	const stackName = "eksctl-async-cluster"
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+		sm  *StackCollection
+	)
+
+	clusterStack := func(status string) *cfn.Stack {
+		return &cfn.Stack{
+			StackName:   aws.String(stackName),
+			StackId:     aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + stackName + "/1"),
+			StackStatus: aws.String(status),
+			Tags: []*cfn.Tag{
+				{Key: aws.String(api.ClusterNameTag), Value: aws.String("async")},
+			},
+		}
+	}
+
+	mockClusterStack := func(statuses ...string) {
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args[1].(func(*cfn.ListStacksOutput, bool) bool)
+			fn(&cfn.ListStacksOutput{
+				StackSummaries: []*cfn.StackSummary{{StackName: aws.String(stackName)}},
+			}, true)
+		}).Return(nil)
+		for _, status := range statuses {
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{clusterStack(status)},
+			}, nil).Once()
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "async"
+		cfg.Metadata.Region = "us-west-2"
+		sm = NewStackCollection(p, cfg)
+	})
+
+	Describe("SubmitClusterStack", func() {
+		It("requests the creation of the cluster stack without waiting for it", func() {
+			cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+			Expect(vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones)).To(Succeed())
+			p.MockCloudFormation().On("CreateStack", mock.Anything).Return(&cfn.CreateStackOutput{
+				StackId: clusterStack(cfn.StackStatusCreateInProgress).StackId,
+			}, nil)
+
+			stack, err := sm.SubmitClusterStack(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackName).To(Equal(stackName))
+			Expect(*stack.StackId).To(Equal(*clusterStack(cfn.StackStatusCreateInProgress).StackId))
+
+			input := p.MockCloudFormation().Calls[0].Arguments.Get(0).(*cfn.CreateStackInput)
+			Expect(*input.StackName).To(Equal(stackName))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacksRequest", mock.Anything)
+		})
+	})
+
+	Describe("WaitForClusterStack", func() {
+		It("returns a cluster stack that has already been created", func() {
+			mockClusterStack(cfn.StackStatusCreateComplete)
+
+			stack, err := sm.WaitForClusterStack()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackStatus).To(Equal(cfn.StackStatusCreateComplete))
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacksRequest", mock.Anything)
+		})
+
+		It("waits for a cluster stack that is being created", func() {
+			mockClusterStack(cfn.StackStatusCreateInProgress, cfn.StackStatusCreateComplete)
+			output := &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{clusterStack(cfn.StackStatusCreateComplete)}}
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, output)
+			p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(req, output)
+
+			stack, err := sm.WaitForClusterStack()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*stack.StackStatus).To(Equal(cfn.StackStatusCreateComplete))
+			p.MockCloudFormation().AssertCalled(GinkgoT(), "DescribeStacksRequest", mock.Anything)
+		})
+
+		It("fails for a cluster stack that failed to be created", func() {
+			mockClusterStack(cfn.StackStatusRollbackComplete)
+			p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Return(nil)
+
+			_, err := sm.WaitForClusterStack()
+			Expect(err).To(MatchError(`cluster stack "eksctl-async-cluster" is in status "ROLLBACK_COMPLETE" and will not be created`))
+		})
+
+		It("fails if there is no cluster stack", func() {
+			p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Return(nil)
+
+			_, err := sm.WaitForClusterStack()
+			Expect(err).To(MatchError(`no CloudFormation stack found for cluster "async"`))
+		})
+	})
+
+	Describe("RetainClusterStackVPC", func() {
+		const template = `{
+			"Resources": {
+				"ControlPlane": {"Type": "AWS::EKS::Cluster", "Properties": {"Name": "async"}},
+				"ControlPlaneSecurityGroup": {"Type": "AWS::EC2::SecurityGroup", "Properties": {"GroupDescription": "cp"}},
+				"VPC": {"Type": "AWS::EC2::VPC", "Properties": {"CidrBlock": "192.168.0.0/16"}},
+				"SubnetPublicUSWEST2A": {"Type": "AWS::EC2::Subnet", "Properties": {"CidrBlock": "192.168.0.0/19"}},
+				"InternetGateway": {"Type": "AWS::EC2::InternetGateway"},
+				"NATGateway": {"Type": "AWS::EC2::NatGateway", "Properties": {"SubnetId": "subnet-1"}},
+				"NATIP": {"Type": "AWS::EC2::EIP", "Properties": {"Domain": "vpc"}},
+				"PublicRouteTable": {"Type": "AWS::EC2::RouteTable", "Properties": {"VpcId": "vpc-1"}}
+			}
+		}`
+
+		It("retains the VPC resources and deletes the other ones", func() {
+			newTemplate, retained, err := retainVPCResources(template)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retained).To(ConsistOf("VPC", "SubnetPublicUSWEST2A", "InternetGateway", "NATGateway", "NATIP", "PublicRouteTable"))
+
+			for _, resource := range retained {
+				Expect(gjson.Get(newTemplate, "Resources."+resource+".DeletionPolicy").String()).To(Equal("Retain"))
+			}
+			for _, resource := range []string{"ControlPlane", "ControlPlaneSecurityGroup"} {
+				Expect(gjson.Get(newTemplate, "Resources."+resource+".DeletionPolicy").Exists()).To(BeFalse())
+			}
+			Expect(gjson.Get(newTemplate, "Resources.VPC.Properties.CidrBlock").String()).To(Equal("192.168.0.0/16"))
+		})
+
+		It("does not update the stack if the VPC was imported", func() {
+			p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+				TemplateBody: aws.String(`{"Resources": {"ControlPlane": {"Type": "AWS::EKS::Cluster", "Properties": {"Name": "async"}}}}`),
+			}, nil)
+
+			Expect(sm.RetainClusterStackVPC()).To(Succeed())
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)
+		})
+	})
+})
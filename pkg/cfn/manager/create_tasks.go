@@ -19,9 +19,10 @@ const (
 )
 
 // NewTasksToCreateClusterWithNodeGroups defines all tasks required to create a cluster along
-// with some nodegroups; see CreateAllNodeGroups for how onlyNodeGroupSubset works
+// with some nodegroups; see CreateAllNodeGroups for how onlyNodeGroupSubset works.
+// At most nodeGroupParallelism nodegroup stacks are created at the same time, or all of them if it is zero
 func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*api.NodeGroup,
-	managedNodeGroups []*api.ManagedNodeGroup, supportsManagedNodes bool, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree {
+	managedNodeGroups []*api.ManagedNodeGroup, supportsManagedNodes bool, nodeGroupParallelism int, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree {
 
 	taskTree := tasks.TaskTree{Parallel: false}
 
@@ -43,6 +44,7 @@ func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*ap
 
 		if nodeGroupTasks.Len() > 0 {
 			nodeGroupTasks.IsSubTask = true
+			nodeGroupTasks.Limit = nodeGroupParallelism
 			taskTree.Append(nodeGroupTasks)
 		}
 	}
@@ -0,0 +1,152 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/waiters"
+)
+
+// StackDrift is the drift of a stack from its template
+type StackDrift struct {
+	StackName string `json:"stackName"`
+	// DriftStatus is a cloudformation.StackDriftStatus, or empty when the drift of the stack could not be detected
+	DriftStatus string `json:"driftStatus,omitempty"`
+	// Reason is why the drift of the stack, or of some of its resources, could not be detected
+	Reason           string            `json:"reason,omitempty"`
+	DriftedResources []DriftedResource `json:"driftedResources,omitempty"`
+}
+
+// Detected returns true if the drift of the stack could be detected
+func (d *StackDrift) Detected() bool {
+	return d.DriftStatus != ""
+}
+
+// DriftedResource is a resource that was modified or deleted outside of CloudFormation
+type DriftedResource struct {
+	LogicalResourceID   string                               `json:"logicalResourceId"`
+	PhysicalResourceID  string                               `json:"physicalResourceId"`
+	ResourceType        string                               `json:"resourceType"`
+	DriftStatus         string                               `json:"driftStatus"`
+	PropertyDifferences []*cloudformation.PropertyDifference `json:"propertyDifferences,omitempty"`
+}
+
+// DetectClusterStacksDrift detects the drift of all the stacks of the cluster
+func (c *StackCollection) DetectClusterStacksDrift() ([]*StackDrift, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	var drifts []*StackDrift
+	for _, s := range stacks {
+		drift, err := c.DetectStackDrift(s)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, drift)
+	}
+	return drifts, nil
+}
+
+// DetectStackDrift detects the drift of the stack from its template, and returns the resources that have
+// been modified or deleted. Stacks whose drift cannot be detected, e.g. while they are being updated,
+// are returned without a DriftStatus and with the reason
+func (c *StackCollection) DetectStackDrift(s *Stack) (*StackDrift, error) {
+	drift := &StackDrift{StackName: *s.StackName}
+	if !c.StackStatusIsNotTransitional(s) {
+		drift.Reason = fmt.Sprintf("the stack is in %s state", aws.StringValue(s.StackStatus))
+		return drift, nil
+	}
+
+	stackName := s.StackName
+	if api.IsSetAndNonEmptyString(s.StackId) {
+		stackName = s.StackId
+	}
+	output, err := c.cloudformationAPI.DetectStackDrift(&cloudformation.DetectStackDriftInput{
+		StackName: stackName,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "ValidationError" {
+			drift.Reason = awsErr.Message()
+			return drift, nil
+		}
+		return nil, errors.Wrapf(err, "detecting drift of stack %q", *s.StackName)
+	}
+
+	status, err := c.waitForStackDriftDetection(*s.StackName, output.StackDriftDetectionId)
+	if err != nil {
+		return nil, err
+	}
+	if aws.StringValue(status.DetectionStatus) == cloudformation.StackDriftDetectionStatusDetectionFailed {
+		// the drift of the other resources is still detected when some resources do not support drift detection
+		drift.Reason = aws.StringValue(status.DetectionStatusReason)
+		logger.Warning("drift detection of stack %q failed for some resources: %s", *s.StackName, drift.Reason)
+	}
+	drift.DriftStatus = aws.StringValue(status.StackDriftStatus)
+	if drift.DriftStatus == cloudformation.StackDriftStatusInSync || drift.DriftStatus == cloudformation.StackDriftStatusNotChecked {
+		return drift, nil
+	}
+
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: stackName,
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			cloudformation.StackResourceDriftStatusModified,
+			cloudformation.StackResourceDriftStatusDeleted,
+		}),
+	}
+	pager := func(p *cloudformation.DescribeStackResourceDriftsOutput, _ bool) bool {
+		for _, r := range p.StackResourceDrifts {
+			drift.DriftedResources = append(drift.DriftedResources, DriftedResource{
+				LogicalResourceID:   aws.StringValue(r.LogicalResourceId),
+				PhysicalResourceID:  aws.StringValue(r.PhysicalResourceId),
+				ResourceType:        aws.StringValue(r.ResourceType),
+				DriftStatus:         aws.StringValue(r.StackResourceDriftStatus),
+				PropertyDifferences: r.PropertyDifferences,
+			})
+		}
+		return true
+	}
+	if err := c.cloudformationAPI.DescribeStackResourceDriftsPages(input, pager); err != nil {
+		return nil, errors.Wrapf(err, "describing drifted resources of stack %q", *s.StackName)
+	}
+	return drift, nil
+}
+
+func (c *StackCollection) waitForStackDriftDetection(stackName string, detectionID *string) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: detectionID,
+	}
+	msg := fmt.Sprintf("waiting for drift detection of CloudFormation stack %q", stackName)
+	acceptors := waiters.MakeAcceptors(
+		"DetectionStatus",
+		cloudformation.StackDriftDetectionStatusDetectionComplete,
+		nil,
+		request.WaiterAcceptor{
+			State:    request.SuccessWaiterState,
+			Matcher:  request.PathWaiterMatch,
+			Argument: "DetectionStatus",
+			Expected: cloudformation.StackDriftDetectionStatusDetectionFailed,
+		},
+	)
+	newRequest := func() *request.Request {
+		req, _ := c.cloudformationAPI.DescribeStackDriftDetectionStatusRequest(input)
+		return req
+	}
+	if err := waiters.Wait(stackName, msg, acceptors, newRequest, c.waitTimeout, nil); err != nil {
+		return nil, err
+	}
+
+	status, err := c.cloudformationAPI.DescribeStackDriftDetectionStatus(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing drift detection of stack %q", stackName)
+	}
+	return status, nil
+}
@@ -0,0 +1,195 @@
+package manager
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Stack drift", func() {
+	const (
+		stackName   = "eksctl-drift-cluster"
+		detectionID = "detection-1"
+	)
+
+	var (
+		p  *mockprovider.MockProvider
+		sm *StackCollection
+	)
+
+	stack := func(status string) *Stack {
+		return &Stack{
+			StackName:   aws.String(stackName),
+			StackId:     aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + stackName + "/1"),
+			StackStatus: aws.String(status),
+		}
+	}
+
+	mockDetection := func(detectionStatus, stackDriftStatus, reason string) {
+		p.MockCloudFormation().On("DetectStackDrift", &cfn.DetectStackDriftInput{
+			StackName: stack(cfn.StackStatusCreateComplete).StackId,
+		}).Return(&cfn.DetectStackDriftOutput{StackDriftDetectionId: aws.String(detectionID)}, nil)
+
+		status := &cfn.DescribeStackDriftDetectionStatusOutput{
+			StackDriftDetectionId: aws.String(detectionID),
+			DetectionStatus:       aws.String(detectionStatus),
+			StackDriftStatus:      aws.String(stackDriftStatus),
+		}
+		if reason != "" {
+			status.DetectionStatusReason = aws.String(reason)
+		}
+		input := &cfn.DescribeStackDriftDetectionStatusInput{StackDriftDetectionId: aws.String(detectionID)}
+		req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, status)
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatusRequest", input).Return(req, status)
+		p.MockCloudFormation().On("DescribeStackDriftDetectionStatus", input).Return(status, nil)
+	}
+
+	mockDriftedResources := func(drifts ...*cfn.StackResourceDrift) {
+		p.MockCloudFormation().On("DescribeStackResourceDriftsPages", &cfn.DescribeStackResourceDriftsInput{
+			StackName:                       stack(cfn.StackStatusCreateComplete).StackId,
+			StackResourceDriftStatusFilters: aws.StringSlice([]string{cfn.StackResourceDriftStatusModified, cfn.StackResourceDriftStatusDeleted}),
+		}, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args[1].(func(*cfn.DescribeStackResourceDriftsOutput, bool) bool)
+			fn(&cfn.DescribeStackResourceDriftsOutput{StackResourceDrifts: drifts}, true)
+		}).Return(nil)
+	}
+
+	securityGroupDrift := &cfn.StackResourceDrift{
+		LogicalResourceId:        aws.String("ControlPlaneSecurityGroup"),
+		PhysicalResourceId:       aws.String("sg-1"),
+		ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+		StackResourceDriftStatus: aws.String(cfn.StackResourceDriftStatusModified),
+		PropertyDifferences: []*cfn.PropertyDifference{{
+			PropertyPath:   aws.String("/SecurityGroupIngress/0/CidrIp"),
+			ExpectedValue:  aws.String("10.0.0.0/16"),
+			ActualValue:    aws.String("0.0.0.0/0"),
+			DifferenceType: aws.String(cfn.DifferenceTypeNotEqual),
+		}},
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "drift"
+		sm = NewStackCollection(p, cfg)
+	})
+
+	It("lists the modified and deleted resources of drifted stacks", func() {
+		mockDetection(cfn.StackDriftDetectionStatusDetectionComplete, cfn.StackDriftStatusDrifted, "")
+		mockDriftedResources(securityGroupDrift, &cfn.StackResourceDrift{
+			LogicalResourceId:        aws.String("NATGateway"),
+			PhysicalResourceId:       aws.String("nat-1"),
+			ResourceType:             aws.String("AWS::EC2::NatGateway"),
+			StackResourceDriftStatus: aws.String(cfn.StackResourceDriftStatusDeleted),
+		})
+
+		drift, err := sm.DetectStackDrift(stack(cfn.StackStatusUpdateComplete))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.Detected()).To(BeTrue())
+		Expect(*drift).To(Equal(StackDrift{
+			StackName:   stackName,
+			DriftStatus: cfn.StackDriftStatusDrifted,
+			DriftedResources: []DriftedResource{
+				{
+					LogicalResourceID:   "ControlPlaneSecurityGroup",
+					PhysicalResourceID:  "sg-1",
+					ResourceType:        "AWS::EC2::SecurityGroup",
+					DriftStatus:         cfn.StackResourceDriftStatusModified,
+					PropertyDifferences: securityGroupDrift.PropertyDifferences,
+				},
+				{
+					LogicalResourceID:  "NATGateway",
+					PhysicalResourceID: "nat-1",
+					ResourceType:       "AWS::EC2::NatGateway",
+					DriftStatus:        cfn.StackResourceDriftStatusDeleted,
+				},
+			},
+		}))
+	})
+
+	It("does not describe the resources of stacks in sync", func() {
+		mockDetection(cfn.StackDriftDetectionStatusDetectionComplete, cfn.StackDriftStatusInSync, "")
+
+		drift, err := sm.DetectStackDrift(stack(cfn.StackStatusCreateComplete))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.DriftStatus).To(Equal(cfn.StackDriftStatusInSync))
+		Expect(drift.DriftedResources).To(BeEmpty())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackResourceDriftsPages", mock.Anything, mock.Anything)
+	})
+
+	It("keeps the drifted resources when the drift of some resources cannot be detected", func() {
+		mockDetection(cfn.StackDriftDetectionStatusDetectionFailed, cfn.StackDriftStatusDrifted, "Failed to detect drift on resource [ClusterSharedNodeSecurityGroup]")
+		mockDriftedResources(securityGroupDrift)
+
+		drift, err := sm.DetectStackDrift(stack(cfn.StackStatusCreateComplete))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.DriftStatus).To(Equal(cfn.StackDriftStatusDrifted))
+		Expect(drift.Reason).To(Equal("Failed to detect drift on resource [ClusterSharedNodeSecurityGroup]"))
+		Expect(drift.DriftedResources).To(HaveLen(1))
+	})
+
+	It("reports stacks whose drift cannot be detected", func() {
+		p.MockCloudFormation().On("DetectStackDrift", mock.Anything).Return(nil, awserr.New("ValidationError", "Stack with id eksctl-drift-cluster does not exist", nil))
+
+		drift, err := sm.DetectStackDrift(stack(cfn.StackStatusCreateComplete))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.Detected()).To(BeFalse())
+		Expect(drift.Reason).To(Equal("Stack with id eksctl-drift-cluster does not exist"))
+	})
+
+	It("does not detect the drift of stacks being updated", func() {
+		drift, err := sm.DetectStackDrift(stack(cfn.StackStatusUpdateInProgress))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift.Detected()).To(BeFalse())
+		Expect(drift.Reason).To(Equal("the stack is in UPDATE_IN_PROGRESS state"))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DetectStackDrift", mock.Anything)
+	})
+
+	It("returns other errors of the drift detection", func() {
+		p.MockCloudFormation().On("DetectStackDrift", mock.Anything).Return(nil, errors.New("throttled"))
+
+		_, err := sm.DetectStackDrift(stack(cfn.StackStatusCreateComplete))
+		Expect(err).To(MatchError(`detecting drift of stack "eksctl-drift-cluster": throttled`))
+	})
+
+	It("detects the drift of all the stacks of the cluster", func() {
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			fn := args[1].(func(*cfn.ListStacksOutput, bool) bool)
+			fn(&cfn.ListStacksOutput{
+				StackSummaries: []*cfn.StackSummary{
+					{StackName: aws.String(stackName)},
+					{StackName: aws.String("eksctl-drift-nodegroup-ng-1")},
+					{StackName: aws.String("eksctl-other-cluster")},
+				},
+			}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String(stackName)}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{stack(cfn.StackStatusCreateComplete)},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("eksctl-drift-nodegroup-ng-1")}).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{{
+				StackName:   aws.String("eksctl-drift-nodegroup-ng-1"),
+				StackStatus: aws.String(cfn.StackStatusDeleteInProgress),
+			}},
+		}, nil)
+		mockDetection(cfn.StackDriftDetectionStatusDetectionComplete, cfn.StackDriftStatusInSync, "")
+
+		drifts, err := sm.DetectClusterStacksDrift()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drifts).To(HaveLen(2))
+		Expect(drifts[0].StackName).To(Equal(stackName))
+		Expect(drifts[0].DriftStatus).To(Equal(cfn.StackDriftStatusInSync))
+		Expect(drifts[1].StackName).To(Equal("eksctl-drift-nodegroup-ng-1"))
+		Expect(drifts[1].Reason).To(Equal("the stack is in DELETE_IN_PROGRESS state"))
+	})
+})
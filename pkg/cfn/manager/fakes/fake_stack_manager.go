@@ -220,6 +220,31 @@ type FakeStackManager struct {
 		result1 []*cloudformation.Stack
 		result2 error
 	}
+	DetectClusterStacksDriftStub        func() ([]*manager.StackDrift, error)
+	detectClusterStacksDriftMutex       sync.RWMutex
+	detectClusterStacksDriftArgsForCall []struct {
+	}
+	detectClusterStacksDriftReturns struct {
+		result1 []*manager.StackDrift
+		result2 error
+	}
+	detectClusterStacksDriftReturnsOnCall map[int]struct {
+		result1 []*manager.StackDrift
+		result2 error
+	}
+	DetectStackDriftStub        func(*cloudformation.Stack) (*manager.StackDrift, error)
+	detectStackDriftMutex       sync.RWMutex
+	detectStackDriftArgsForCall []struct {
+		arg1 *cloudformation.Stack
+	}
+	detectStackDriftReturns struct {
+		result1 *manager.StackDrift
+		result2 error
+	}
+	detectStackDriftReturnsOnCall map[int]struct {
+		result1 *manager.StackDrift
+		result2 error
+	}
 	DoCreateStackRequestStub        func(*cloudformation.Stack, manager.TemplateData, map[string]string, map[string]string, bool, bool) error
 	doCreateStackRequestMutex       sync.RWMutex
 	doCreateStackRequestArgsForCall []struct {
@@ -562,13 +587,14 @@ type FakeStackManager struct {
 	newTaskToDeleteUnownedNodeGroupReturnsOnCall map[int]struct {
 		result1 tasks.Task
 	}
-	NewTasksToCreateClusterWithNodeGroupsStub        func([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, ...tasks.Task) *tasks.TaskTree
+	NewTasksToCreateClusterWithNodeGroupsStub        func([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, int, ...tasks.Task) *tasks.TaskTree
 	newTasksToCreateClusterWithNodeGroupsMutex       sync.RWMutex
 	newTasksToCreateClusterWithNodeGroupsArgsForCall []struct {
 		arg1 []*v1alpha5.NodeGroup
 		arg2 []*v1alpha5.ManagedNodeGroup
 		arg3 bool
-		arg4 []tasks.Task
+		arg4 int
+		arg5 []tasks.Task
 	}
 	newTasksToCreateClusterWithNodeGroupsReturns struct {
 		result1 *tasks.TaskTree
@@ -673,6 +699,30 @@ type FakeStackManager struct {
 	refreshFargatePodExecutionRoleARNReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RenderClusterTemplatesStub        func(bool, *iamoidc.OpenIDConnectManager) ([]manager.RenderedTemplate, error)
+	renderClusterTemplatesMutex       sync.RWMutex
+	renderClusterTemplatesArgsForCall []struct {
+		arg1 bool
+		arg2 *iamoidc.OpenIDConnectManager
+	}
+	renderClusterTemplatesReturns struct {
+		result1 []manager.RenderedTemplate
+		result2 error
+	}
+	renderClusterTemplatesReturnsOnCall map[int]struct {
+		result1 []manager.RenderedTemplate
+		result2 error
+	}
+	RetainClusterStackVPCStub        func() error
+	retainClusterStackVPCMutex       sync.RWMutex
+	retainClusterStackVPCArgsForCall []struct {
+	}
+	retainClusterStackVPCReturns struct {
+		result1 error
+	}
+	retainClusterStackVPCReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StackStatusIsNotReadyStub        func(*cloudformation.Stack) bool
 	stackStatusIsNotReadyMutex       sync.RWMutex
 	stackStatusIsNotReadyArgsForCall []struct {
@@ -695,6 +745,19 @@ type FakeStackManager struct {
 	stackStatusIsNotTransitionalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	SubmitClusterStackStub        func(bool) (*cloudformation.Stack, error)
+	submitClusterStackMutex       sync.RWMutex
+	submitClusterStackArgsForCall []struct {
+		arg1 bool
+	}
+	submitClusterStackReturns struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}
+	submitClusterStackReturnsOnCall map[int]struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}
 	UpdateNodeGroupStackStub        func(string, string) error
 	updateNodeGroupStackMutex       sync.RWMutex
 	updateNodeGroupStackArgsForCall []struct {
@@ -722,6 +785,18 @@ type FakeStackManager struct {
 	updateStackReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WaitForClusterStackStub        func() (*cloudformation.Stack, error)
+	waitForClusterStackMutex       sync.RWMutex
+	waitForClusterStackArgsForCall []struct {
+	}
+	waitForClusterStackReturns struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}
+	waitForClusterStackReturnsOnCall map[int]struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -1700,6 +1775,126 @@ func (fake *FakeStackManager) DescribeStacksReturnsOnCall(i int, result1 []*clou
 	}{result1, result2}
 }
 
+func (fake *FakeStackManager) DetectClusterStacksDrift() ([]*manager.StackDrift, error) {
+	fake.detectClusterStacksDriftMutex.Lock()
+	ret, specificReturn := fake.detectClusterStacksDriftReturnsOnCall[len(fake.detectClusterStacksDriftArgsForCall)]
+	fake.detectClusterStacksDriftArgsForCall = append(fake.detectClusterStacksDriftArgsForCall, struct {
+	}{})
+	stub := fake.DetectClusterStacksDriftStub
+	fakeReturns := fake.detectClusterStacksDriftReturns
+	fake.recordInvocation("DetectClusterStacksDrift", []interface{}{})
+	fake.detectClusterStacksDriftMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) DetectClusterStacksDriftCallCount() int {
+	fake.detectClusterStacksDriftMutex.RLock()
+	defer fake.detectClusterStacksDriftMutex.RUnlock()
+	return len(fake.detectClusterStacksDriftArgsForCall)
+}
+
+func (fake *FakeStackManager) DetectClusterStacksDriftCalls(stub func() ([]*manager.StackDrift, error)) {
+	fake.detectClusterStacksDriftMutex.Lock()
+	defer fake.detectClusterStacksDriftMutex.Unlock()
+	fake.DetectClusterStacksDriftStub = stub
+}
+
+func (fake *FakeStackManager) DetectClusterStacksDriftReturns(result1 []*manager.StackDrift, result2 error) {
+	fake.detectClusterStacksDriftMutex.Lock()
+	defer fake.detectClusterStacksDriftMutex.Unlock()
+	fake.DetectClusterStacksDriftStub = nil
+	fake.detectClusterStacksDriftReturns = struct {
+		result1 []*manager.StackDrift
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) DetectClusterStacksDriftReturnsOnCall(i int, result1 []*manager.StackDrift, result2 error) {
+	fake.detectClusterStacksDriftMutex.Lock()
+	defer fake.detectClusterStacksDriftMutex.Unlock()
+	fake.DetectClusterStacksDriftStub = nil
+	if fake.detectClusterStacksDriftReturnsOnCall == nil {
+		fake.detectClusterStacksDriftReturnsOnCall = make(map[int]struct {
+			result1 []*manager.StackDrift
+			result2 error
+		})
+	}
+	fake.detectClusterStacksDriftReturnsOnCall[i] = struct {
+		result1 []*manager.StackDrift
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) DetectStackDrift(arg1 *cloudformation.Stack) (*manager.StackDrift, error) {
+	fake.detectStackDriftMutex.Lock()
+	ret, specificReturn := fake.detectStackDriftReturnsOnCall[len(fake.detectStackDriftArgsForCall)]
+	fake.detectStackDriftArgsForCall = append(fake.detectStackDriftArgsForCall, struct {
+		arg1 *cloudformation.Stack
+	}{arg1})
+	stub := fake.DetectStackDriftStub
+	fakeReturns := fake.detectStackDriftReturns
+	fake.recordInvocation("DetectStackDrift", []interface{}{arg1})
+	fake.detectStackDriftMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) DetectStackDriftCallCount() int {
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	return len(fake.detectStackDriftArgsForCall)
+}
+
+func (fake *FakeStackManager) DetectStackDriftCalls(stub func(*cloudformation.Stack) (*manager.StackDrift, error)) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = stub
+}
+
+func (fake *FakeStackManager) DetectStackDriftArgsForCall(i int) *cloudformation.Stack {
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
+	argsForCall := fake.detectStackDriftArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) DetectStackDriftReturns(result1 *manager.StackDrift, result2 error) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = nil
+	fake.detectStackDriftReturns = struct {
+		result1 *manager.StackDrift
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) DetectStackDriftReturnsOnCall(i int, result1 *manager.StackDrift, result2 error) {
+	fake.detectStackDriftMutex.Lock()
+	defer fake.detectStackDriftMutex.Unlock()
+	fake.DetectStackDriftStub = nil
+	if fake.detectStackDriftReturnsOnCall == nil {
+		fake.detectStackDriftReturnsOnCall = make(map[int]struct {
+			result1 *manager.StackDrift
+			result2 error
+		})
+	}
+	fake.detectStackDriftReturnsOnCall[i] = struct {
+		result1 *manager.StackDrift
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) DoCreateStackRequest(arg1 *cloudformation.Stack, arg2 manager.TemplateData, arg3 map[string]string, arg4 map[string]string, arg5 bool, arg6 bool) error {
 	fake.doCreateStackRequestMutex.Lock()
 	ret, specificReturn := fake.doCreateStackRequestReturnsOnCall[len(fake.doCreateStackRequestArgsForCall)]
@@ -3392,7 +3587,7 @@ func (fake *FakeStackManager) NewTaskToDeleteUnownedNodeGroupReturnsOnCall(i int
 	}{result1}
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 []*v1alpha5.NodeGroup, arg2 []*v1alpha5.ManagedNodeGroup, arg3 bool, arg4 ...tasks.Task) *tasks.TaskTree {
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 []*v1alpha5.NodeGroup, arg2 []*v1alpha5.ManagedNodeGroup, arg3 bool, arg4 int, arg5 ...tasks.Task) *tasks.TaskTree {
 	var arg1Copy []*v1alpha5.NodeGroup
 	if arg1 != nil {
 		arg1Copy = make([]*v1alpha5.NodeGroup, len(arg1))
@@ -3409,14 +3604,15 @@ func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroups(arg1 []*v1al
 		arg1 []*v1alpha5.NodeGroup
 		arg2 []*v1alpha5.ManagedNodeGroup
 		arg3 bool
-		arg4 []tasks.Task
-	}{arg1Copy, arg2Copy, arg3, arg4})
+		arg4 int
+		arg5 []tasks.Task
+	}{arg1Copy, arg2Copy, arg3, arg4, arg5})
 	stub := fake.NewTasksToCreateClusterWithNodeGroupsStub
 	fakeReturns := fake.newTasksToCreateClusterWithNodeGroupsReturns
-	fake.recordInvocation("NewTasksToCreateClusterWithNodeGroups", []interface{}{arg1Copy, arg2Copy, arg3, arg4})
+	fake.recordInvocation("NewTasksToCreateClusterWithNodeGroups", []interface{}{arg1Copy, arg2Copy, arg3, arg4, arg5})
 	fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4...)
+		return stub(arg1, arg2, arg3, arg4, arg5...)
 	}
 	if specificReturn {
 		return ret.result1
@@ -3430,17 +3626,17 @@ func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCallCount() i
 	return len(fake.newTasksToCreateClusterWithNodeGroupsArgsForCall)
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCalls(stub func([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, ...tasks.Task) *tasks.TaskTree) {
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsCalls(stub func([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, int, ...tasks.Task) *tasks.TaskTree) {
 	fake.newTasksToCreateClusterWithNodeGroupsMutex.Lock()
 	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.Unlock()
 	fake.NewTasksToCreateClusterWithNodeGroupsStub = stub
 }
 
-func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsArgsForCall(i int) ([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, []tasks.Task) {
+func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsArgsForCall(i int) ([]*v1alpha5.NodeGroup, []*v1alpha5.ManagedNodeGroup, bool, int, []tasks.Task) {
 	fake.newTasksToCreateClusterWithNodeGroupsMutex.RLock()
 	defer fake.newTasksToCreateClusterWithNodeGroupsMutex.RUnlock()
 	argsForCall := fake.newTasksToCreateClusterWithNodeGroupsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeStackManager) NewTasksToCreateClusterWithNodeGroupsReturns(result1 *tasks.TaskTree) {
@@ -3925,6 +4121,124 @@ func (fake *FakeStackManager) RefreshFargatePodExecutionRoleARNReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeStackManager) RenderClusterTemplates(arg1 bool, arg2 *iamoidc.OpenIDConnectManager) ([]manager.RenderedTemplate, error) {
+	fake.renderClusterTemplatesMutex.Lock()
+	ret, specificReturn := fake.renderClusterTemplatesReturnsOnCall[len(fake.renderClusterTemplatesArgsForCall)]
+	fake.renderClusterTemplatesArgsForCall = append(fake.renderClusterTemplatesArgsForCall, struct {
+		arg1 bool
+		arg2 *iamoidc.OpenIDConnectManager
+	}{arg1, arg2})
+	stub := fake.RenderClusterTemplatesStub
+	fakeReturns := fake.renderClusterTemplatesReturns
+	fake.recordInvocation("RenderClusterTemplates", []interface{}{arg1, arg2})
+	fake.renderClusterTemplatesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) RenderClusterTemplatesCallCount() int {
+	fake.renderClusterTemplatesMutex.RLock()
+	defer fake.renderClusterTemplatesMutex.RUnlock()
+	return len(fake.renderClusterTemplatesArgsForCall)
+}
+
+func (fake *FakeStackManager) RenderClusterTemplatesCalls(stub func(bool, *iamoidc.OpenIDConnectManager) ([]manager.RenderedTemplate, error)) {
+	fake.renderClusterTemplatesMutex.Lock()
+	defer fake.renderClusterTemplatesMutex.Unlock()
+	fake.RenderClusterTemplatesStub = stub
+}
+
+func (fake *FakeStackManager) RenderClusterTemplatesArgsForCall(i int) (bool, *iamoidc.OpenIDConnectManager) {
+	fake.renderClusterTemplatesMutex.RLock()
+	defer fake.renderClusterTemplatesMutex.RUnlock()
+	argsForCall := fake.renderClusterTemplatesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStackManager) RenderClusterTemplatesReturns(result1 []manager.RenderedTemplate, result2 error) {
+	fake.renderClusterTemplatesMutex.Lock()
+	defer fake.renderClusterTemplatesMutex.Unlock()
+	fake.RenderClusterTemplatesStub = nil
+	fake.renderClusterTemplatesReturns = struct {
+		result1 []manager.RenderedTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) RenderClusterTemplatesReturnsOnCall(i int, result1 []manager.RenderedTemplate, result2 error) {
+	fake.renderClusterTemplatesMutex.Lock()
+	defer fake.renderClusterTemplatesMutex.Unlock()
+	fake.RenderClusterTemplatesStub = nil
+	if fake.renderClusterTemplatesReturnsOnCall == nil {
+		fake.renderClusterTemplatesReturnsOnCall = make(map[int]struct {
+			result1 []manager.RenderedTemplate
+			result2 error
+		})
+	}
+	fake.renderClusterTemplatesReturnsOnCall[i] = struct {
+		result1 []manager.RenderedTemplate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) RetainClusterStackVPC() error {
+	fake.retainClusterStackVPCMutex.Lock()
+	ret, specificReturn := fake.retainClusterStackVPCReturnsOnCall[len(fake.retainClusterStackVPCArgsForCall)]
+	fake.retainClusterStackVPCArgsForCall = append(fake.retainClusterStackVPCArgsForCall, struct {
+	}{})
+	stub := fake.RetainClusterStackVPCStub
+	fakeReturns := fake.retainClusterStackVPCReturns
+	fake.recordInvocation("RetainClusterStackVPC", []interface{}{})
+	fake.retainClusterStackVPCMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStackManager) RetainClusterStackVPCCallCount() int {
+	fake.retainClusterStackVPCMutex.RLock()
+	defer fake.retainClusterStackVPCMutex.RUnlock()
+	return len(fake.retainClusterStackVPCArgsForCall)
+}
+
+func (fake *FakeStackManager) RetainClusterStackVPCCalls(stub func() error) {
+	fake.retainClusterStackVPCMutex.Lock()
+	defer fake.retainClusterStackVPCMutex.Unlock()
+	fake.RetainClusterStackVPCStub = stub
+}
+
+func (fake *FakeStackManager) RetainClusterStackVPCReturns(result1 error) {
+	fake.retainClusterStackVPCMutex.Lock()
+	defer fake.retainClusterStackVPCMutex.Unlock()
+	fake.RetainClusterStackVPCStub = nil
+	fake.retainClusterStackVPCReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStackManager) RetainClusterStackVPCReturnsOnCall(i int, result1 error) {
+	fake.retainClusterStackVPCMutex.Lock()
+	defer fake.retainClusterStackVPCMutex.Unlock()
+	fake.RetainClusterStackVPCStub = nil
+	if fake.retainClusterStackVPCReturnsOnCall == nil {
+		fake.retainClusterStackVPCReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retainClusterStackVPCReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStackManager) StackStatusIsNotReady(arg1 *cloudformation.Stack) bool {
 	fake.stackStatusIsNotReadyMutex.Lock()
 	ret, specificReturn := fake.stackStatusIsNotReadyReturnsOnCall[len(fake.stackStatusIsNotReadyArgsForCall)]
@@ -4047,6 +4361,70 @@ func (fake *FakeStackManager) StackStatusIsNotTransitionalReturnsOnCall(i int, r
 	}{result1}
 }
 
+func (fake *FakeStackManager) SubmitClusterStack(arg1 bool) (*cloudformation.Stack, error) {
+	fake.submitClusterStackMutex.Lock()
+	ret, specificReturn := fake.submitClusterStackReturnsOnCall[len(fake.submitClusterStackArgsForCall)]
+	fake.submitClusterStackArgsForCall = append(fake.submitClusterStackArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	stub := fake.SubmitClusterStackStub
+	fakeReturns := fake.submitClusterStackReturns
+	fake.recordInvocation("SubmitClusterStack", []interface{}{arg1})
+	fake.submitClusterStackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) SubmitClusterStackCallCount() int {
+	fake.submitClusterStackMutex.RLock()
+	defer fake.submitClusterStackMutex.RUnlock()
+	return len(fake.submitClusterStackArgsForCall)
+}
+
+func (fake *FakeStackManager) SubmitClusterStackCalls(stub func(bool) (*cloudformation.Stack, error)) {
+	fake.submitClusterStackMutex.Lock()
+	defer fake.submitClusterStackMutex.Unlock()
+	fake.SubmitClusterStackStub = stub
+}
+
+func (fake *FakeStackManager) SubmitClusterStackArgsForCall(i int) bool {
+	fake.submitClusterStackMutex.RLock()
+	defer fake.submitClusterStackMutex.RUnlock()
+	argsForCall := fake.submitClusterStackArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStackManager) SubmitClusterStackReturns(result1 *cloudformation.Stack, result2 error) {
+	fake.submitClusterStackMutex.Lock()
+	defer fake.submitClusterStackMutex.Unlock()
+	fake.SubmitClusterStackStub = nil
+	fake.submitClusterStackReturns = struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) SubmitClusterStackReturnsOnCall(i int, result1 *cloudformation.Stack, result2 error) {
+	fake.submitClusterStackMutex.Lock()
+	defer fake.submitClusterStackMutex.Unlock()
+	fake.SubmitClusterStackStub = nil
+	if fake.submitClusterStackReturnsOnCall == nil {
+		fake.submitClusterStackReturnsOnCall = make(map[int]struct {
+			result1 *cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.submitClusterStackReturnsOnCall[i] = struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) UpdateNodeGroupStack(arg1 string, arg2 string) error {
 	fake.updateNodeGroupStackMutex.Lock()
 	ret, specificReturn := fake.updateNodeGroupStackReturnsOnCall[len(fake.updateNodeGroupStackArgsForCall)]
@@ -4174,6 +4552,62 @@ func (fake *FakeStackManager) UpdateStackReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeStackManager) WaitForClusterStack() (*cloudformation.Stack, error) {
+	fake.waitForClusterStackMutex.Lock()
+	ret, specificReturn := fake.waitForClusterStackReturnsOnCall[len(fake.waitForClusterStackArgsForCall)]
+	fake.waitForClusterStackArgsForCall = append(fake.waitForClusterStackArgsForCall, struct {
+	}{})
+	stub := fake.WaitForClusterStackStub
+	fakeReturns := fake.waitForClusterStackReturns
+	fake.recordInvocation("WaitForClusterStack", []interface{}{})
+	fake.waitForClusterStackMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStackManager) WaitForClusterStackCallCount() int {
+	fake.waitForClusterStackMutex.RLock()
+	defer fake.waitForClusterStackMutex.RUnlock()
+	return len(fake.waitForClusterStackArgsForCall)
+}
+
+func (fake *FakeStackManager) WaitForClusterStackCalls(stub func() (*cloudformation.Stack, error)) {
+	fake.waitForClusterStackMutex.Lock()
+	defer fake.waitForClusterStackMutex.Unlock()
+	fake.WaitForClusterStackStub = stub
+}
+
+func (fake *FakeStackManager) WaitForClusterStackReturns(result1 *cloudformation.Stack, result2 error) {
+	fake.waitForClusterStackMutex.Lock()
+	defer fake.waitForClusterStackMutex.Unlock()
+	fake.WaitForClusterStackStub = nil
+	fake.waitForClusterStackReturns = struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStackManager) WaitForClusterStackReturnsOnCall(i int, result1 *cloudformation.Stack, result2 error) {
+	fake.waitForClusterStackMutex.Lock()
+	defer fake.waitForClusterStackMutex.Unlock()
+	fake.WaitForClusterStackStub = nil
+	if fake.waitForClusterStackReturnsOnCall == nil {
+		fake.waitForClusterStackReturnsOnCall = make(map[int]struct {
+			result1 *cloudformation.Stack
+			result2 error
+		})
+	}
+	fake.waitForClusterStackReturnsOnCall[i] = struct {
+		result1 *cloudformation.Stack
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -4209,6 +4643,10 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.describeStackEventsMutex.RUnlock()
 	fake.describeStacksMutex.RLock()
 	defer fake.describeStacksMutex.RUnlock()
+	fake.detectClusterStacksDriftMutex.RLock()
+	defer fake.detectClusterStacksDriftMutex.RUnlock()
+	fake.detectStackDriftMutex.RLock()
+	defer fake.detectStackDriftMutex.RUnlock()
 	fake.doCreateStackRequestMutex.RLock()
 	defer fake.doCreateStackRequestMutex.RUnlock()
 	fake.doWaitUntilStackIsCreatedMutex.RLock()
@@ -4281,14 +4719,22 @@ func (fake *FakeStackManager) Invocations() map[string][][]interface{} {
 	defer fake.newUnmanagedNodeGroupTaskMutex.RUnlock()
 	fake.refreshFargatePodExecutionRoleARNMutex.RLock()
 	defer fake.refreshFargatePodExecutionRoleARNMutex.RUnlock()
+	fake.renderClusterTemplatesMutex.RLock()
+	defer fake.renderClusterTemplatesMutex.RUnlock()
+	fake.retainClusterStackVPCMutex.RLock()
+	defer fake.retainClusterStackVPCMutex.RUnlock()
 	fake.stackStatusIsNotReadyMutex.RLock()
 	defer fake.stackStatusIsNotReadyMutex.RUnlock()
 	fake.stackStatusIsNotTransitionalMutex.RLock()
 	defer fake.stackStatusIsNotTransitionalMutex.RUnlock()
+	fake.submitClusterStackMutex.RLock()
+	defer fake.submitClusterStackMutex.RUnlock()
 	fake.updateNodeGroupStackMutex.RLock()
 	defer fake.updateNodeGroupStackMutex.RUnlock()
 	fake.updateStackMutex.RLock()
 	defer fake.updateStackMutex.RUnlock()
+	fake.waitForClusterStackMutex.RLock()
+	defer fake.waitForClusterStackMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -2,6 +2,7 @@ package manager
 
 import (
 	"fmt"
+	"strconv"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/kris-nova/logger"
@@ -30,6 +31,7 @@ func (c *StackCollection) createIAMServiceAccountTask(errs chan error, spec *api
 		spec.Tags = make(map[string]string)
 	}
 	spec.Tags[api.IAMServiceAccountNameTag] = spec.NameString()
+	spec.Tags[api.IAMServiceAccountRoleOnlyTag] = strconv.FormatBool(api.IsEnabled(spec.RoleOnly))
 
 	if err := c.CreateStack(name, stack, spec.Tags, nil, errs); err != nil {
 		logger.Info("an error occurred creating the stack, to cleanup resources, run 'eksctl delete iamserviceaccount --region=%s --name=%s --namespace=%s'", c.spec.Metadata.Region, spec.Name, spec.Namespace)
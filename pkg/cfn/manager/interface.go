@@ -42,17 +42,23 @@ type StackManager interface {
 	HasClusterStack() (bool, error)
 	HasClusterStackUsingCachedList(clusterStackNames []string) (bool, error)
 	DescribeStackEvents(i *Stack) ([]*cloudformation.StackEvent, error)
+	DetectStackDrift(s *Stack) (*StackDrift, error)
+	DetectClusterStacksDrift() ([]*StackDrift, error)
 	LookupCloudTrailEvents(i *Stack) ([]*cloudtrail.Event, error)
 	DescribeStackChangeSet(i *Stack, changeSetName string) (*ChangeSet, error)
 	MakeChangeSetName(action string) string
 	DescribeClusterStack() (*Stack, error)
+	SubmitClusterStack(supportsManagedNodes bool) (*Stack, error)
+	RenderClusterTemplates(supportsManagedNodes bool, oidc *iamoidc.OpenIDConnectManager) ([]RenderedTemplate, error)
+	WaitForClusterStack() (*Stack, error)
 	RefreshFargatePodExecutionRoleARN() error
 	AppendNewClusterStackResource(plan, supportsManagedNodes bool) (bool, error)
+	RetainClusterStackVPC() error
 	GetFargateStack() (*Stack, error)
 	GetStackTemplate(stackName string) (string, error)
 	MakeClusterStackName() string
 	NewTasksToCreateClusterWithNodeGroups(nodeGroups []*v1alpha5.NodeGroup,
-		managedNodeGroups []*v1alpha5.ManagedNodeGroup, supportsManagedNodes bool, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree
+		managedNodeGroups []*v1alpha5.ManagedNodeGroup, supportsManagedNodes bool, nodeGroupParallelism int, postClusterCreationTasks ...tasks.Task) *tasks.TaskTree
 	NewUnmanagedNodeGroupTask(nodeGroups []*v1alpha5.NodeGroup, forceAddCNIPolicy bool, importer vpc.Importer) *tasks.TaskTree
 	NewManagedNodeGroupTask(nodeGroups []*v1alpha5.ManagedNodeGroup, forceAddCNIPolicy bool, importer vpc.Importer) *tasks.TaskTree
 	NewClusterCompatTask() tasks.Task
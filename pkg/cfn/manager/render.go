@@ -0,0 +1,282 @@
+package manager
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// Parameters of the nodegroup templates rendered before the cluster has been created, their user data
+// references them instead of the endpoint and certificate authority of the cluster
+const (
+	clusterEndpointParameter                 = "ClusterEndpoint"
+	clusterCertificateAuthorityDataParameter = "ClusterCertificateAuthorityData"
+
+	// certificateAuthorityDataSize is about the size of the base64-encoded certificate authority of an EKS
+	// cluster, which the user data grows by once the parameter has been substituted
+	certificateAuthorityDataSize = 1500
+)
+
+// the status the user data of the nodegroups is generated with before the cluster has been created,
+// the placeholders are then replaced with references to the parameters of their templates
+var (
+	clusterEndpointPlaceholder                 = "https://eksctl-render-placeholder-endpoint.invalid"
+	clusterCertificateAuthorityDataPlaceholder = []byte("eksctl-render-placeholder-certificate-authority")
+)
+
+// RenderedTemplate is the template of a stack eksctl would create, along with the tags and capabilities
+// the stack would be created with
+type RenderedTemplate struct {
+	StackName    string
+	Template     []byte
+	Tags         map[string]string
+	Capabilities []string
+	// Parameters maps the parameters of the template to the outputs of the cluster stack they must be set to
+	Parameters map[string]string
+}
+
+// RenderClusterTemplates renders the templates of the cluster stack, the nodegroup stacks and the iamserviceaccount
+// stacks without creating them, in the order they would be created. The nodegroup stacks import the outputs of the
+// cluster stack, so they must be created with the same stack names once the cluster stack has been created.
+// Unless the endpoint of the cluster is known, the user data of the nodegroups references the ClusterEndpoint and
+// ClusterCertificateAuthorityData parameters of their template instead. The iamserviceaccount stacks are only
+// rendered with the OIDC provider of an existing cluster, as their roles trust its issuer
+func (c *StackCollection) RenderClusterTemplates(supportsManagedNodes bool, oidc *iamoidc.OpenIDConnectManager) ([]RenderedTemplate, error) {
+	// the cluster resource set sets an empty status
+	statusKnown := c.spec.Status != nil && c.spec.Status.Endpoint != ""
+	cluster := builder.NewClusterResourceSet(c.ec2API, c.region, c.spec, supportsManagedNodes, nil)
+	if err := cluster.AddAllResources(); err != nil {
+		return nil, err
+	}
+	clusterTemplate, err := c.renderTemplate(c.MakeClusterStackName(), cluster, nil)
+	if err != nil {
+		return nil, err
+	}
+	templates := []RenderedTemplate{clusterTemplate}
+
+	nodeGroupSpec := c.spec
+	if !statusKnown && c.spec.KubernetesNetworkConfig.IPv6Enabled() {
+		if len(c.spec.NodeGroups) > 0 || len(c.spec.ManagedNodeGroups) > 0 {
+			logger.Warning("skipping the nodegroups, as the user data of IPv6 nodes needs the service IPv6 CIDR EKS assigns to the cluster, which is only known once it has been created")
+		}
+		return c.appendIAMServiceAccountTemplates(templates, oidc)
+	}
+	if !statusKnown {
+		spec := *c.spec
+		spec.Status = &api.ClusterStatus{
+			Endpoint:                 clusterEndpointPlaceholder,
+			CertificateAuthorityData: clusterCertificateAuthorityDataPlaceholder,
+		}
+		nodeGroupSpec = &spec
+	}
+
+	vpcImporter := vpc.NewStackConfigImporter(c.MakeClusterStackName())
+	for _, ng := range c.spec.NodeGroups {
+		bootstrapper, err := nodebootstrap.NewBootstrapper(nodeGroupSpec, ng)
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating bootstrapper")
+		}
+		stack := builder.NewNodeGroupResourceSet(c.ec2API, c.iamAPI, nodeGroupSpec, ng, bootstrapper, false, vpcImporter)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		tags := map[string]string{}
+		for k, v := range ng.Tags {
+			tags[k] = v
+		}
+		tags[api.NodeGroupNameTag] = ng.Name
+		tags[api.OldNodeGroupNameTag] = ng.Name
+		tags[api.NodeGroupTypeTag] = string(api.NodeGroupTypeUnmanaged)
+		template, err := c.renderTemplate(c.makeNodeGroupStackName(ng.Name), stack, tags)
+		if err != nil {
+			return nil, err
+		}
+		if ok, err := withClusterStatusParameters(&template, statusKnown); err != nil {
+			return nil, errors.Wrapf(err, "rendering the user data of nodegroup %q", ng.Name)
+		} else if !ok {
+			logger.Warning("skipping nodegroup %q, as the user data of its custom AMI needs the certificate authority of the cluster, which is only known once it has been created", ng.Name)
+			continue
+		}
+		templates = append(templates, template)
+	}
+
+	for _, ng := range c.spec.ManagedNodeGroups {
+		bootstrapper := nodebootstrap.NewManagedBootstrapper(nodeGroupSpec, ng)
+		stack := builder.NewManagedNodeGroup(c.ec2API, nodeGroupSpec, ng, builder.NewLaunchTemplateFetcher(c.ec2API), bootstrapper, false, vpcImporter)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		template, err := c.renderTemplate(c.makeNodeGroupStackName(ng.Name), stack, ng.Tags)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := withClusterStatusParameters(&template, statusKnown); err != nil {
+			return nil, errors.Wrapf(err, "rendering the user data of managed nodegroup %q", ng.Name)
+		}
+		templates = append(templates, template)
+	}
+	return c.appendIAMServiceAccountTemplates(templates, oidc)
+}
+
+// appendIAMServiceAccountTemplates appends the templates of the iamserviceaccount stacks to templates, the service
+// accounts are skipped if the OIDC provider of the cluster is not known
+func (c *StackCollection) appendIAMServiceAccountTemplates(templates []RenderedTemplate, oidc *iamoidc.OpenIDConnectManager) ([]RenderedTemplate, error) {
+	if c.spec.IAM == nil || len(c.spec.IAM.ServiceAccounts) == 0 {
+		return templates, nil
+	}
+	if oidc == nil {
+		logger.Warning("skipping the iamserviceaccounts, as their roles trust the OIDC issuer of the cluster, which is only known once it has been created")
+		return templates, nil
+	}
+
+	for _, sa := range c.spec.IAM.ServiceAccounts {
+		stack := builder.NewIAMRoleResourceSetForServiceAccount(sa, oidc)
+		if err := stack.AddAllResources(); err != nil {
+			return nil, err
+		}
+		tags := map[string]string{}
+		for k, v := range sa.Tags {
+			tags[k] = v
+		}
+		tags[api.IAMServiceAccountNameTag] = sa.NameString()
+		tags[api.IAMServiceAccountRoleOnlyTag] = strconv.FormatBool(api.IsEnabled(sa.RoleOnly))
+		template, err := c.renderTemplate(c.makeIAMServiceAccountStackName(sa.Namespace, sa.Name), stack, tags)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// withClusterStatusParameters replaces the placeholders of the endpoint and certificate authority of the cluster in
+// the user data of the launch templates of a nodegroup template with references to the parameters of the template.
+// It returns false if the user data needs the decoded certificate authority, which no parameter can provide
+func withClusterStatusParameters(rendered *RenderedTemplate, statusKnown bool) (bool, error) {
+	if statusKnown {
+		return true, nil
+	}
+	encodedPlaceholder := base64.StdEncoding.EncodeToString(clusterCertificateAuthorityDataPlaceholder)
+	template := string(rendered.Template)
+	parameterised := false
+	for name, resource := range gjson.Get(template, "Resources").Map() {
+		userDataPath := fmt.Sprintf("Resources.%s.Properties.LaunchTemplateData.UserData", name)
+		userData := gjson.Get(template, userDataPath)
+		if resource.Get("Type").String() != "AWS::EC2::LaunchTemplate" || userData.Type != gjson.String {
+			continue
+		}
+		text, err := decodeUserData(userData.String())
+		if err != nil {
+			return false, err
+		}
+		if strings.Contains(text, string(clusterCertificateAuthorityDataPlaceholder)) {
+			return false, nil
+		}
+		if !strings.Contains(text, clusterEndpointPlaceholder) && !strings.Contains(text, encodedPlaceholder) {
+			continue
+		}
+
+		// Fn::Sub leaves ${!Literal} as ${Literal}
+		text = strings.ReplaceAll(text, "${", "${!")
+		text = strings.ReplaceAll(text, clusterEndpointPlaceholder, fmt.Sprintf("${%s}", clusterEndpointParameter))
+		text = strings.ReplaceAll(text, encodedPlaceholder, fmt.Sprintf("${%s}", clusterCertificateAuthorityDataParameter))
+		// the user data can no longer be compressed
+		if size := len(text) + certificateAuthorityDataSize; size > api.MaxUserDataSize {
+			return false, fmt.Errorf("the uncompressed user data would be about %d bytes, which exceeds the limit of %d bytes, render the template once the cluster has been created", size, api.MaxUserDataSize)
+		}
+		if template, err = sjson.Set(template, userDataPath, map[string]interface{}{
+			"Fn::Base64": map[string]interface{}{"Fn::Sub": text},
+		}); err != nil {
+			return false, err
+		}
+		parameterised = true
+	}
+	if !parameterised {
+		return true, nil
+	}
+
+	rendered.Parameters = map[string]string{}
+	for _, parameter := range []struct{ name, output string }{
+		{clusterEndpointParameter, outputs.ClusterEndpoint},
+		{clusterCertificateAuthorityDataParameter, outputs.ClusterCertificateAuthorityData},
+	} {
+		var err error
+		if template, err = sjson.Set(template, "Parameters."+parameter.name, map[string]string{
+			"Type":        "String",
+			"Description": fmt.Sprintf("The %s output of the cluster stack", parameter.output),
+		}); err != nil {
+			return false, err
+		}
+		rendered.Parameters[parameter.name] = parameter.output
+	}
+	rendered.Template = []byte(template)
+	return true, nil
+}
+
+// decodeUserData decodes base64-encoded user data, which is also gzipped by most bootstrappers
+func decodeUserData(userData string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		return "", err
+	}
+	if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		return string(data), nil
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer gr.Close()
+	data, err = ioutil.ReadAll(gr)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (c *StackCollection) renderTemplate(stackName string, resourceSet builder.ResourceSet, tags map[string]string) (RenderedTemplate, error) {
+	templateBody, err := resourceSet.RenderJSON()
+	if err != nil {
+		return RenderedTemplate{}, errors.Wrapf(err, "rendering template for %q stack", stackName)
+	}
+
+	stackTags := map[string]string{}
+	for _, tag := range c.sharedTags {
+		stackTags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	for k, v := range tags {
+		stackTags[k] = v
+	}
+
+	var capabilities []*string
+	if resourceSet.WithIAM() {
+		capabilities = stackCapabilitiesIAM
+	}
+	if resourceSet.WithNamedIAM() {
+		capabilities = stackCapabilitiesNamedIAM
+	}
+
+	return RenderedTemplate{
+		StackName:    stackName,
+		Template:     templateBody,
+		Tags:         stackTags,
+		Capabilities: aws.StringValueSlice(c.withConfiguredCapabilities(capabilities)),
+	}, nil
+}
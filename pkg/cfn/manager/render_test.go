@@ -0,0 +1,182 @@
+package manager
+
+import (
+	"encoding/json"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("RenderClusterTemplates", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	type template struct {
+		Parameters map[string]struct {
+			Type string
+		}
+		Resources map[string]struct {
+			Type       string
+			Properties struct {
+				LaunchTemplateData struct {
+					UserData interface{}
+				}
+			}
+		}
+	}
+
+	decode := func(rendered RenderedTemplate) template {
+		var t template
+		ExpectWithOffset(1, json.Unmarshal(rendered.Template, &t)).To(Succeed())
+		return t
+	}
+
+	resourceTypes := func(rendered RenderedTemplate) map[string]string {
+		var t template
+		ExpectWithOffset(1, json.Unmarshal(rendered.Template, &t)).To(Succeed())
+		types := map[string]string{}
+		for name, resource := range t.Resources {
+			types[name] = resource.Type
+		}
+		return types
+	}
+
+	stackNames := func(templates []RenderedTemplate) []string {
+		var names []string
+		for _, t := range templates {
+			names = append(names, t.StackName)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "render"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Tags = map[string]string{"team": "platform"}
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		Expect(vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones)).To(Succeed())
+
+		ng := cfg.NewNodeGroup()
+		ng.Name = "unmanaged"
+		ng.AMI = "ami-123"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		api.SetNodeGroupDefaults(ng, cfg.Metadata)
+
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "managed"
+		mng.Tags = map[string]string{"nodegroup": "managed"}
+		api.SetManagedNodeGroupDefaults(mng, cfg.Metadata)
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+	})
+
+	It("renders the cluster stack and the nodegroup stacks of a new cluster", func() {
+		templates, err := NewStackCollection(p, cfg).RenderClusterTemplates(true, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stackNames(templates)).To(Equal([]string{"eksctl-render-cluster", "eksctl-render-nodegroup-unmanaged", "eksctl-render-nodegroup-managed"}))
+
+		cluster := resourceTypes(templates[0])
+		Expect(cluster).To(HaveKeyWithValue("ControlPlane", "AWS::EKS::Cluster"))
+		Expect(cluster).To(HaveKeyWithValue("VPC", "AWS::EC2::VPC"))
+		Expect(cluster).To(HaveKeyWithValue("ServiceRole", "AWS::IAM::Role"))
+		Expect(templates[0].Tags).To(HaveKeyWithValue(api.ClusterNameTag, "render"))
+		Expect(templates[0].Tags).To(HaveKeyWithValue("team", "platform"))
+		Expect(templates[0].Capabilities).To(Equal([]string{cfn.CapabilityCapabilityIam}))
+
+		Expect(templates[0].Parameters).To(BeEmpty())
+
+		managed := resourceTypes(templates[2])
+		Expect(managed).To(HaveKeyWithValue("ManagedNodeGroup", "AWS::EKS::Nodegroup"))
+		Expect(managed).To(HaveKeyWithValue("NodeInstanceRole", "AWS::IAM::Role"))
+		Expect(templates[2].Tags).To(HaveKeyWithValue("nodegroup", "managed"))
+		Expect(templates[2].Tags).To(HaveKeyWithValue("team", "platform"))
+	})
+
+	It("references the endpoint and certificate authority of a new cluster as parameters in the user data", func() {
+		templates, err := NewStackCollection(p, cfg).RenderClusterTemplates(true, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		unmanaged := templates[1]
+		Expect(unmanaged.Parameters).To(Equal(map[string]string{
+			"ClusterEndpoint":                 "Endpoint",
+			"ClusterCertificateAuthorityData": "CertificateAuthorityData",
+		}))
+		t := decode(unmanaged)
+		Expect(t.Parameters).To(HaveKey("ClusterEndpoint"))
+		Expect(t.Parameters["ClusterEndpoint"].Type).To(Equal("String"))
+		Expect(t.Parameters).To(HaveKey("ClusterCertificateAuthorityData"))
+		Expect(t.Parameters["ClusterCertificateAuthorityData"].Type).To(Equal("String"))
+
+		userData, ok := t.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.UserData.(map[string]interface{})
+		Expect(ok).To(BeTrue())
+		sub, ok := userData["Fn::Base64"].(map[string]interface{})
+		Expect(ok).To(BeTrue())
+		text, ok := sub["Fn::Sub"].(string)
+		Expect(ok).To(BeTrue())
+		Expect(text).To(ContainSubstring("${ClusterEndpoint}"))
+		Expect(text).To(ContainSubstring("${ClusterCertificateAuthorityData}"))
+		Expect(text).NotTo(ContainSubstring("eksctl-render-placeholder"))
+	})
+
+	It("renders unmanaged nodegroups once the status of the cluster is known", func() {
+		cfg.Status = &api.ClusterStatus{
+			Endpoint:                 "https://render.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("CertificateAuthorityData"),
+		}
+		templates, err := NewStackCollection(p, cfg).RenderClusterTemplates(true, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stackNames(templates)).To(Equal([]string{"eksctl-render-cluster", "eksctl-render-nodegroup-unmanaged", "eksctl-render-nodegroup-managed"}))
+		Expect(templates[1].Parameters).To(BeEmpty())
+		Expect(decode(templates[1]).Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData.UserData).To(BeAssignableToTypeOf(""))
+
+		unmanaged := resourceTypes(templates[1])
+		Expect(unmanaged).To(HaveKeyWithValue("NodeGroup", "AWS::AutoScaling::AutoScalingGroup"))
+		Expect(unmanaged).To(HaveKeyWithValue("NodeGroupLaunchTemplate", "AWS::EC2::LaunchTemplate"))
+		Expect(templates[1].Tags).To(HaveKeyWithValue(api.NodeGroupNameTag, "unmanaged"))
+		Expect(templates[1].Tags).To(HaveKeyWithValue(api.NodeGroupTypeTag, string(api.NodeGroupTypeUnmanaged)))
+	})
+
+	Context("iamserviceaccounts", func() {
+		BeforeEach(func() {
+			cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{
+				ClusterIAMMeta:   api.ClusterIAMMeta{Name: "s3-reader", Namespace: "default"},
+				AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+			}}
+		})
+
+		It("skips them until the cluster has been created", func() {
+			templates, err := NewStackCollection(p, cfg).RenderClusterTemplates(true, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackNames(templates)).NotTo(ContainElement(ContainSubstring("iamserviceaccount")))
+		})
+
+		It("renders them with the OIDC provider of the cluster", func() {
+			oidc, err := iamoidc.NewOpenIDConnectManager(p.IAM(), "123456789012", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E", "aws", nil)
+			Expect(err).NotTo(HaveOccurred())
+			templates, err := NewStackCollection(p, cfg).RenderClusterTemplates(true, oidc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stackNames(templates)).To(ContainElement("eksctl-render-addon-iamserviceaccount-default-s3-reader"))
+
+			sa := templates[len(templates)-1]
+			Expect(resourceTypes(sa)).To(HaveKeyWithValue("Role1", "AWS::IAM::Role"))
+			Expect(string(sa.Template)).To(ContainSubstring("oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E:sub"))
+			Expect(sa.Tags).To(HaveKeyWithValue(api.IAMServiceAccountNameTag, "default/s3-reader"))
+			Expect(sa.Capabilities).To(Equal([]string{cfn.CapabilityCapabilityIam}))
+		})
+	})
+
+	It("does not create any stack", func() {
+		_, err := NewStackCollection(p, cfg).RenderClusterTemplates(true, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.MockCloudFormation().Calls).To(BeEmpty())
+	})
+})
@@ -3,6 +3,7 @@ package manager
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/request"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -23,7 +24,7 @@ const (
 // so this is custom version that is more suitable for our use, as there is no way to add any
 // custom acceptors
 
-func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.WaiterAcceptor) error {
+func (c *StackCollection) waitWithAcceptors(i *Stack, timeout time.Duration, acceptors []request.WaiterAcceptor) error {
 	msg := fmt.Sprintf("waiting for CloudFormation stack %q", *i.StackName)
 
 	newRequest := func() *request.Request {
@@ -48,7 +49,7 @@ func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.Waiter
 		return nil
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.waitTimeout, troubleshoot)
+	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, timeout, troubleshoot)
 }
 
 type noChangeError struct {
@@ -86,7 +87,7 @@ func (c *StackCollection) waitWithAcceptorsChangeSet(i *Stack, changesetName str
 		return nil
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.waitTimeout, troubleshoot)
+	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.updateTimeout, troubleshoot)
 }
 
 func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus string) {
@@ -129,7 +130,7 @@ func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus
 // DoWaitUntilStackIsCreated blocks until the given stack's
 // creation has completed.
 func (c *StackCollection) DoWaitUntilStackIsCreated(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, c.createTimeout,
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusCreateComplete,
@@ -171,7 +172,7 @@ func (c *StackCollection) waitUntilStackIsCreated(i *Stack, stack builder.Resour
 }
 
 func (c *StackCollection) doWaitUntilStackIsDeleted(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, c.deleteTimeout,
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusDeleteComplete,
@@ -215,7 +216,7 @@ func (c *StackCollection) waitUntilStackIsDeleted(i *Stack, errs chan error) {
 }
 
 func (c *StackCollection) doWaitUntilStackIsUpdated(i *Stack) error {
-	return c.waitWithAcceptors(i,
+	return c.waitWithAcceptors(i, c.updateTimeout,
 		waiters.MakeAcceptors(
 			stackStatus,
 			cfn.StackStatusUpdateComplete,
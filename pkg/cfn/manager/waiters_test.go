@@ -0,0 +1,83 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/awstesting"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection waiters", func() {
+	var (
+		p         *mockprovider.MockProvider
+		stackName = "eksctl-stack"
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+	})
+
+	DescribeTable("timeouts of the stack operations", func(stackTimeouts api.StackTimeouts, create, update, del time.Duration) {
+		p.SetStackTimeouts(stackTimeouts)
+		sm := NewStackCollection(p, api.NewClusterConfig())
+		Expect(sm.createTimeout).To(Equal(create))
+		Expect(sm.updateTimeout).To(Equal(update))
+		Expect(sm.deleteTimeout).To(Equal(del))
+	},
+		Entry("default to the wait timeout", api.StackTimeouts{},
+			mockprovider.ProviderConfig.WaitTimeout, mockprovider.ProviderConfig.WaitTimeout, mockprovider.ProviderConfig.WaitTimeout),
+		Entry("are set per operation", api.StackTimeouts{Create: time.Hour, Delete: 2 * time.Hour},
+			time.Hour, mockprovider.ProviderConfig.WaitTimeout, 2*time.Hour),
+	)
+
+	It("uses the timeouts of the config file unless they are set with flags", func() {
+		p.SetStackTimeouts(api.StackTimeouts{Create: time.Hour})
+		cfg := api.NewClusterConfig()
+		cfg.CloudFormation = &api.CloudFormationConfig{Timeouts: &api.CloudFormationTimeouts{Create: "30m", Update: "45m"}}
+		sm := NewStackCollection(p, cfg)
+		Expect(sm.createTimeout).To(Equal(time.Hour))
+		Expect(sm.updateTimeout).To(Equal(45 * time.Minute))
+		Expect(sm.deleteTimeout).To(Equal(mockprovider.ProviderConfig.WaitTimeout))
+	})
+
+	Context("when a stack operation does not complete", func() {
+		mockStackStatus := func(status string) {
+			output := &cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   &stackName,
+				StackStatus: aws.String(status),
+			}}}
+			req := awstesting.NewClient(nil).NewRequest(&request.Operation{Name: "Operation"}, nil, output)
+			p.MockCloudFormation().On("DescribeStacksRequest", mock.Anything).Return(req, output)
+			p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(output, nil)
+			p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Return(nil)
+		}
+
+		// only the timeout of the operation is short, waiting with any other timeout would not return
+		DescribeTable("passes the timeout of the operation to the waiter", func(status string, stackTimeouts api.StackTimeouts, wait func(*StackCollection, *Stack) error) {
+			mockStackStatus(status)
+			p.SetStackTimeouts(stackTimeouts)
+			sm := NewStackCollection(p, api.NewClusterConfig())
+
+			errCh := make(chan error)
+			go func() {
+				errCh <- wait(sm, &Stack{StackName: &stackName})
+			}()
+			var err error
+			Eventually(errCh, 5*time.Second).Should(Receive(&err))
+			Expect(err).To(MatchError(ContainSubstring("waiter context canceled")))
+		},
+			Entry("create", cfn.StackStatusCreateInProgress, api.StackTimeouts{Create: time.Millisecond}, (*StackCollection).DoWaitUntilStackIsCreated),
+			Entry("update", cfn.StackStatusUpdateInProgress, api.StackTimeouts{Update: time.Millisecond}, (*StackCollection).doWaitUntilStackIsUpdated),
+			Entry("delete", cfn.StackStatusDeleteInProgress, api.StackTimeouts{Delete: time.Millisecond}, (*StackCollection).doWaitUntilStackIsDeleted),
+		)
+	})
+})
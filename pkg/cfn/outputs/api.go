@@ -19,6 +19,8 @@ const (
 	ClusterSubnetsPrivate       = string("Subnets" + api.SubnetTopologyPrivate)
 	ClusterSubnetsPublic        = string("Subnets" + api.SubnetTopologyPublic)
 	ClusterFullyPrivate         = "ClusterFullyPrivate"
+	// ClusterSubnetsSecondaryCIDR is followed by the index of the secondary CIDR of the subnets
+	ClusterSubnetsSecondaryCIDR = "SubnetsSecondaryCIDR"
 
 	ClusterSubnetsPublicLegacy = "Subnets"
 
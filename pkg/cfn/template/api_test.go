@@ -106,7 +106,10 @@ const templateExample1 = `{
 		  "Type": "AWS::IAM::Role",
 		  "Properties": {
 			"RoleName": "foo",
-			"ManagedPolicyArns": [ "abc" ]
+			"ManagedPolicyArns": [ "abc" ],
+			"Tags": [
+			  { "Key": "Name", "Value": { "Fn::Sub": "${AWS::StackName}/aRole" } }
+			]
 		  }
 		}
 	},
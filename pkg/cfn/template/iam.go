@@ -27,6 +27,7 @@ type IAMRole struct {
 	AssumeRolePolicyDocument MapOfInterfaces `json:",omitempty"`
 	ManagedPolicyArns        []interface{}   `json:",omitempty"`
 	PermissionsBoundary      string          `json:",omitempty"`
+	Tags                     []Tag           `json:",omitempty"`
 }
 
 // Type will return the full type name for the resource
@@ -10,7 +10,7 @@ type Tag struct {
 	Key   interface{}
 	Value interface{}
 
-	PropagateAtLaunch string
+	PropagateAtLaunch string `json:",omitempty"`
 }
 
 // maybeSetNameTag adds a Name tag to any resource that supports tags
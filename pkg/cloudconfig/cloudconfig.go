@@ -99,17 +99,26 @@ func (c *CloudConfig) RunScript(name, s string) {
 
 // Encode encodes the cloud config
 func (c *CloudConfig) Encode() (string, error) {
-	data, err := yaml.Marshal(c)
+	data, err := c.Marshal()
 	if err != nil {
 		return "", err
 	}
 
-	data = append([]byte(fmt.Sprintln(header)), data...)
+	return EncodeUserData(data)
+}
+
+// Marshal returns the cloud config document with its header, without encoding it as user data
+func (c *CloudConfig) Marshal() ([]byte, error) {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
 
-	return encodeUserData(data)
+	return append([]byte(fmt.Sprintln(header)), data...), nil
 }
 
-func encodeUserData(data []byte) (string, error) {
+// EncodeUserData gzips and base64-encodes a user data document
+func EncodeUserData(data []byte) (string, error) {
 	var (
 		buf bytes.Buffer
 		gw  = gzip.NewWriter(&buf)
@@ -1,6 +1,8 @@
 package cmdutils
 
 import (
+	"os"
+
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
@@ -16,58 +18,87 @@ type Cmd struct {
 
 	Plan, Wait, Validate bool
 
+	// Yes skips the confirmation of destructive actions
+	Yes bool
+
 	NameArg string
 
 	ClusterConfigFile string
 
+	// LoadedClusterConfig, when set, is used by the config loaders instead of reading ClusterConfigFile
+	// again, e.g. for each of the clusters of a ClusterConfigList
+	LoadedClusterConfig *api.ClusterConfig
+
 	ProviderConfig api.ProviderConfig
 	ClusterConfig  *api.ClusterConfig
 
 	Include, Exclude []string
+
+	// auditLogs are the audit logs of the providers created with --audit-calls
+	auditLogs []*eks.AuditLog
 }
 
 // NewCtl performs common defaulting and validation and constructs a new
 // instance of eks.ClusterProvider, it may return an error if configuration
 // is invalid or region is not supported
 func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
-	api.SetClusterConfigDefaults(c.ClusterConfig)
+	if err := setDefaultsAndValidate(c.ClusterConfig, c.Validate); err != nil {
+		return nil, err
+	}
+
+	ctl, err := eks.New(&c.ProviderConfig, c.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := api.ValidateClusterConfig(c.ClusterConfig); err != nil {
-		if c.Validate {
-			return nil, err
+	if !ctl.IsSupportedRegion() {
+		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
+	}
+	if ctl.AuditLog != nil {
+		c.auditLogs = append(c.auditLogs, ctl.AuditLog)
+	}
+
+	return ctl, nil
+}
+
+// ValidateClusterConfig validates a copy of the ClusterConfig with its defaults set, as NewCtl does,
+// without changing the ClusterConfig. Nothing is validated when validation is disabled
+func (c *Cmd) ValidateClusterConfig() error {
+	if !c.Validate {
+		return nil
+	}
+	return setDefaultsAndValidate(c.ClusterConfig.DeepCopy(), true)
+}
+
+func setDefaultsAndValidate(cfg *api.ClusterConfig, validate bool) error {
+	api.SetClusterConfigDefaults(cfg)
+
+	if err := api.ValidateClusterConfig(cfg); err != nil {
+		if validate {
+			return err
 		}
 		logger.Warning("ignoring validation error: %s", err.Error())
 	}
 
-	for i, ng := range c.ClusterConfig.NodeGroups {
+	for i, ng := range cfg.NodeGroups {
 		if err := api.ValidateNodeGroup(i, ng); err != nil {
-			if c.Validate {
-				return nil, err
+			if validate {
+				return err
 			}
 			logger.Warning("ignoring validation error: %s", err.Error())
 		}
 		// defaulting of nodegroup currently depends on validation;
 		// that may change, but at present that's how it's meant to work
-		api.SetNodeGroupDefaults(ng, c.ClusterConfig.Metadata)
+		api.SetNodeGroupDefaults(ng, cfg.Metadata)
 	}
 
-	for i, ng := range c.ClusterConfig.ManagedNodeGroups {
-		api.SetManagedNodeGroupDefaults(ng, c.ClusterConfig.Metadata)
+	for i, ng := range cfg.ManagedNodeGroups {
+		api.SetManagedNodeGroupDefaults(ng, cfg.Metadata)
 		if err := api.ValidateManagedNodeGroup(ng, i); err != nil {
-			return nil, err
+			return err
 		}
 	}
-
-	ctl, err := eks.New(&c.ProviderConfig, c.ClusterConfig)
-	if err != nil {
-		return nil, err
-	}
-
-	if !ctl.IsSupportedRegion() {
-		return nil, ErrUnsupportedRegion(&c.ProviderConfig)
-	}
-
-	return ctl, nil
+	return nil
 }
 
 // NewProviderForExistingCluster is a wrapper for NewCtl that also validates that the cluster exists and is not a
@@ -97,9 +128,35 @@ func AddResourceCmd(flagGrouping *FlagGrouping, parentVerbCmd *cobra.Command, ne
 	c.FlagSetGroup = flagGrouping.New(c.CobraCommand)
 	newCmd(c)
 	c.FlagSetGroup.AddTo(c.CobraCommand)
+	c.printAuditLogsAfterRun()
 	parentVerbCmd.AddCommand(c.CobraCommand)
 }
 
+// printAuditLogsAfterRun makes the command print the AWS API calls recorded with --audit-calls
+// once it has run. As a recorded call fails, the command stops at the first call whose result
+// the next steps depend on, whose error is not reported as a failure
+func (c *Cmd) printAuditLogsAfterRun() {
+	runE := c.CobraCommand.RunE
+	if runE == nil {
+		return
+	}
+	c.CobraCommand.RunE = func(cmd *cobra.Command, args []string) error {
+		if !c.ProviderConfig.AuditCalls {
+			return runE(cmd, args)
+		}
+		logger.Warning("--audit-calls is set, mutating AWS API calls will be recorded instead of being made")
+		err := runE(cmd, args)
+		for _, auditLog := range c.auditLogs {
+			auditLog.Print(os.Stdout)
+		}
+		if eks.IsAuditedCallError(err) {
+			logger.Info("stopped at a call that was recorded instead of being made: %v", err)
+			return nil
+		}
+		return err
+	}
+}
+
 // SetDescription sets usage along with short and long descriptions as well as aliases
 func (c *Cmd) SetDescription(use, short, long string, aliases ...string) {
 	c.CobraCommand.Use = use
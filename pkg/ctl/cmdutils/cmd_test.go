@@ -0,0 +1,40 @@
+package cmdutils
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("audit logs", func() {
+	newCmd := func(runErr error) *Cmd {
+		cmd := &Cmd{
+			CobraCommand: &cobra.Command{
+				RunE: func(_ *cobra.Command, _ []string) error {
+					return runErr
+				},
+			},
+		}
+		cmd.ProviderConfig.AuditCalls = true
+		cmd.auditLogs = []*eks.AuditLog{{}}
+		cmd.printAuditLogsAfterRun()
+		return cmd
+	}
+
+	It("does not report the error of a recorded call as a failure", func() {
+		recorded := awserr.New(eks.ErrCodeAuditedCall, "cloudformation:CreateStack was recorded instead of being made", nil)
+		cmd := newCmd(fmt.Errorf("creating stack: %w", recorded))
+		Expect(cmd.CobraCommand.RunE(cmd.CobraCommand, nil)).To(Succeed())
+	})
+
+	It("reports the other errors", func() {
+		cmd := newCmd(errors.New("cluster \"test\" already exists"))
+		Expect(cmd.CobraCommand.RunE(cmd.CobraCommand, nil)).To(MatchError("cluster \"test\" already exists"))
+	})
+})
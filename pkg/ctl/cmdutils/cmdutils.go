@@ -3,6 +3,7 @@ package cmdutils
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -108,6 +109,12 @@ func GetNameArg(args []string) string {
 func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, addCfnOptions bool) {
 	group.InFlagSet("AWS client", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&p.Profile, "profile", "p", "", "AWS credentials profile to use (overrides the AWS_PROFILE environment variable)")
+		fs.StringVar(&p.Proxy, "proxy", "", "URL of the HTTP(S) proxy to use for AWS and Kubernetes API calls (overrides the HTTPS_PROXY environment variable)")
+		fs.StringSliceVar(&p.NoProxy, "no-proxy", nil, "hosts to reach without going through --proxy (overrides the NO_PROXY environment variable)")
+
+		fs.Var(&optionalIntValue{value: &p.MaxRetries}, "aws-max-retries", "maximum number of retries of throttled or failed AWS API calls (default 13)")
+		fs.DurationVar(&p.RetryBaseDelay, "aws-retry-base-delay", 0, "minimum delay before retrying a throttled or failed AWS API call (defaults to the AWS SDK's, or 1s for throttled calls changing resources)")
+		fs.DurationVar(&p.RetryMaxDelay, "aws-retry-max-delay", 0, "maximum delay between retries of AWS API calls (defaults to the AWS SDK's, 5m)")
 
 		fs.DurationVar(&p.WaitTimeout, "aws-api-timeout", api.DefaultWaitTimeout, "")
 		// TODO deprecate in 0.2.0
@@ -117,10 +124,41 @@ func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, addCf
 		if addCfnOptions {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 			fs.BoolVar(&p.CloudFormationDisableRollback, "cfn-disable-rollback", false, "for debugging: If a stack fails, do not roll it back. Be careful, this may lead to unintentional resource consumption!")
+			fs.BoolVar(&p.DisableAMICache, "disable-ami-cache", false, "always resolve node AMIs instead of reusing AMIs resolved within the last hour")
+			fs.StringVar(&p.RunID, "run-id", "", fmt.Sprintf("ID of this run (e.g. a CI job ID), added as the %q tag to the CloudFormation stacks created or updated", api.RunIDTag))
+			fs.BoolVar(&p.AuditCalls, "audit-calls", false, "record and print the mutating AWS API calls instead of making them, e.g. to write least-privilege IAM policies")
+			fs.DurationVar(&p.StackTimeouts.Create, "cfn-create-timeout", 0, "maximum waiting time for the creation of a CloudFormation stack (defaults to --timeout)")
+			fs.DurationVar(&p.StackTimeouts.Update, "cfn-update-timeout", 0, "maximum waiting time for the update of a CloudFormation stack (defaults to --timeout)")
+			fs.DurationVar(&p.StackTimeouts.Delete, "cfn-delete-timeout", 0, "maximum waiting time for the deletion of a CloudFormation stack (defaults to --timeout)")
 		}
 	})
 }
 
+// optionalIntValue is a flag value that leaves the int unset, and its default used, unless the flag is given
+type optionalIntValue struct {
+	value **int
+}
+
+func (v *optionalIntValue) String() string {
+	if v.value == nil || *v.value == nil {
+		return ""
+	}
+	return strconv.Itoa(**v.value)
+}
+
+func (v *optionalIntValue) Set(s string) error {
+	i, err := strconv.Atoi(s)
+	if err != nil {
+		return err
+	}
+	*v.value = &i
+	return nil
+}
+
+func (v *optionalIntValue) Type() string {
+	return "int"
+}
+
 // AddTimeoutFlagWithValue configures the timeout flag with the provided value.
 func AddTimeoutFlagWithValue(fs *pflag.FlagSet, p *time.Duration, value time.Duration) {
 	fs.DurationVar(p, "timeout", value, "maximum waiting time for any long-running operation")
@@ -189,6 +227,38 @@ func AddSubnetIDs(fs *pflag.FlagSet, subnetIDs *[]string, description string) {
 	fs.StringSliceVar(subnetIDs, "subnet-ids", nil, description)
 }
 
+// DefaultNodeGroupParallelism is the default maximum number of nodegroup stacks that are created at the same time
+const DefaultNodeGroupParallelism = 5
+
+// AddNodeGroupParallelismFlag adds common --nodegroup-parallelism flag
+func AddNodeGroupParallelismFlag(fs *pflag.FlagSet, parallelism *int) {
+	fs.IntVar(parallelism, "nodegroup-parallelism", DefaultNodeGroupParallelism, "maximum number of nodegroup stacks to create at the same time")
+}
+
+// AddSkipInstanceTypeOfferingsCheckFlag adds common --skip-instance-type-offerings-check flag
+func AddSkipInstanceTypeOfferingsCheckFlag(fs *pflag.FlagSet, skip *bool) {
+	fs.BoolVar(skip, "skip-instance-type-offerings-check", false, "skip checking that the instance types of the nodegroups are offered in all of their availability zones")
+}
+
+// ValidateNodeGroupParallelism validates the value of the --nodegroup-parallelism flag
+func ValidateNodeGroupParallelism(parallelism int) error {
+	if parallelism < 1 {
+		return fmt.Errorf("--nodegroup-parallelism must be at least 1, got %d", parallelism)
+	}
+	return nil
+}
+
+// DefaultClusterParallelism is the default maximum number of clusters of a ClusterConfigList that are created at the same time
+const DefaultClusterParallelism = 3
+
+// ValidateClusterParallelism validates the value of the --cluster-parallelism flag
+func ValidateClusterParallelism(parallelism int) error {
+	if parallelism < 1 {
+		return fmt.Errorf("--cluster-parallelism must be at least 1, got %d", parallelism)
+	}
+	return nil
+}
+
 // AddCommonFlagsForKubeconfig adds common flags for controlling how output kubeconfig is written
 func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRoleARN *string, setContext, autoPath *bool, exampleName string) {
 	fs.StringVar(outputPath, "kubeconfig", kubeconfig.DefaultPath(), "path to write kubeconfig (incompatible with --auto-kubeconfig)")
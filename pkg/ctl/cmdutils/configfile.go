@@ -121,7 +121,9 @@ func (l *commonClusterConfigLoader) Load() error {
 	// The reference to ClusterConfig should only be reassigned if ClusterConfigFile is specified
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
-	if l.ClusterConfig, err = eks.LoadConfigFromFile(l.ClusterConfigFile); err != nil {
+	if l.LoadedClusterConfig != nil {
+		l.ClusterConfig = l.LoadedClusterConfig
+	} else if l.ClusterConfig, err = eks.LoadConfigFromFile(l.ClusterConfigFile); err != nil {
 		return err
 	}
 	meta := l.ClusterConfig.Metadata
@@ -252,6 +254,7 @@ func NewCreateClusterLoader(cmd *Cmd, ngFilter *filter.NodeGroupFilter, ng *api.
 		}
 
 		api.SetClusterEndpointAccessDefaults(clusterConfig.VPC)
+		clusterConfig.SetCoreDNSComputeTypeDefaults()
 
 		if !clusterConfig.HasClusterEndpointAccess() {
 			return api.ErrClusterEndpointNoAccess
@@ -497,8 +500,8 @@ func normalizeNodeGroup(ng *api.NodeGroup, l *commonClusterConfigLoader) error {
 		ng.SSH.PublicKeyPath = nil
 	}
 
-	if *ng.VolumeType == api.NodeVolumeTypeIO1 {
-		return fmt.Errorf("%s volume type is not supported via flag --node-volume-type, please use a config file", api.NodeVolumeTypeIO1)
+	if *ng.VolumeType == api.NodeVolumeTypeIO1 || *ng.VolumeType == api.NodeVolumeTypeIO2 {
+		return fmt.Errorf("%s volume type is not supported via flag --node-volume-type, please use a config file", *ng.VolumeType)
 	}
 
 	normalizeBaseNodeGroup(ng, l.CobraCommand)
@@ -526,6 +529,7 @@ func NewDeleteNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *filter.Node
 
 	l.flagsIncompatibleWithoutConfigFile.Insert(
 		"approve",
+		"only-missing",
 	)
 
 	l.validateWithoutConfigFile = func() error {
@@ -655,6 +659,34 @@ func NewUtilsPublicAccessCIDRsLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewUtilsUpgradePolicyLoader loads config or uses flags for `eksctl utils update-cluster-upgrade-policy`
+func NewUtilsUpgradePolicyLoader(cmd *Cmd, supportType string) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("support-type")
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.UpgradePolicy == nil {
+			return errors.New("field upgradePolicy is required")
+		}
+		return l.ClusterConfig.UpgradePolicy.Validate()
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if err := l.validateMetadataWithoutConfigFile(); err != nil {
+			return err
+		}
+		if supportType == "" {
+			return ErrMustBeSet("--support-type")
+		}
+		l.ClusterConfig.UpgradePolicy = &api.UpgradePolicy{
+			SupportType: supportType,
+		}
+		return l.ClusterConfig.UpgradePolicy.Validate()
+	}
+	return l
+}
+
 func parseList(arg string) ([]string, error) {
 	reader := strings.NewReader(arg)
 	csvReader := csv.NewReader(reader)
@@ -794,9 +826,26 @@ func NewUpdateNodegroupLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
 
 	l.validateWithConfigFile = func() error {
-		length := len(l.ClusterConfig.ManagedNodeGroups)
-		if length < 1 {
-			return ErrMustBeSet("managedNodeGroups field")
+		if len(l.ClusterConfig.NodeGroups) == 0 && len(l.ClusterConfig.ManagedNodeGroups) == 0 {
+			return ErrMustBeSet("nodeGroups or managedNodeGroups field")
+		}
+
+		for _, ng := range l.ClusterConfig.NodeGroups {
+			logger.Info("validating nodegroup %q", ng.Name)
+
+			var unsupportedFields []string
+			var err error
+			if unsupportedFields, err = validateSupportedConfigFields(*ng.NodeGroupBase, []string{"Name", "AMI"}, unsupportedFields); err != nil {
+				return err
+			}
+
+			if unsupportedFields, err = validateSupportedConfigFields(*ng, []string{"NodeGroupBase", "InstanceRefresh"}, unsupportedFields); err != nil {
+				return err
+			}
+
+			if len(unsupportedFields) > 0 {
+				logger.Warning("unchanged fields for nodegroup %s: the following fields remain unchanged; they are not supported by `eksctl update nodegroup`: %s", ng.Name, strings.Join(unsupportedFields[:], ", "))
+			}
 		}
 
 		for _, ng := range l.ClusterConfig.ManagedNodeGroups {
@@ -808,7 +857,7 @@ func NewUpdateNodegroupLoader(cmd *Cmd) ClusterConfigLoader {
 				return err
 			}
 
-			if unsupportedFields, err = validateSupportedConfigFields(*ng, []string{"NodeGroupBase", "UpdateConfig"}, unsupportedFields); err != nil {
+			if unsupportedFields, err = validateSupportedConfigFields(*ng, []string{"NodeGroupBase", "UpdateConfig", "InstanceTypes"}, unsupportedFields); err != nil {
 				return err
 			}
 
@@ -829,6 +878,24 @@ func NewUpdateNodegroupLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewReconcileLoader will load config for 'eksctl reconcile', which requires a config file.
+func NewReconcileLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	l.validateWithConfigFile = func() error {
+		if l.ClusterConfig.VPC != nil && l.ClusterConfig.VPC.ClusterEndpoints != nil {
+			api.SetClusterEndpointAccessDefaults(l.ClusterConfig.VPC)
+		}
+		return api.ValidateIAMIdentityMappings(l.ClusterConfig.IAMIdentityMappings)
+	}
+
+	return l
+}
+
 // validateSupportedConfigFields parses a config file's fields, evaluates if non-empty fields are supported,
 // and returns an error if a field is not supported.
 func validateSupportedConfigFields(obj interface{}, supportedFields []string, unsupportedFields []string) ([]string, error) {
@@ -366,6 +366,20 @@ var _ = Describe("cmdutils configfile", func() {
 				testClusterEndpointAccessDefaults("test_data/cluster-with-vpc-private-access.yaml", true, true)
 			})
 		})
+
+		It("should add a Fargate profile selecting CoreDNS when it is scheduled on Fargate", func() {
+			cmd := &Cmd{
+				CobraCommand:      newCmd(),
+				ClusterConfigFile: "test_data/cluster-with-coredns-on-fargate.yaml",
+				ClusterConfig:     api.NewClusterConfig(),
+				ProviderConfig:    api.ProviderConfig{},
+			}
+
+			params := &CreateClusterCmdParams{WithoutNodeGroup: true}
+			Expect(NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, params).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.FargateProfiles).To(HaveLen(1))
+			Expect(cmd.ClusterConfig.FargateProfiles[0].Name).To(Equal(api.CoreDNSFargateProfileName))
+		})
 	})
 })
 
@@ -0,0 +1,58 @@
+package cmdutils
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+)
+
+// the confirmation prompt reads from stdin and is written to stderr, so that it does not mix
+// with the output of commands; these can be replaced in tests
+var (
+	confirmationInput  io.Reader = os.Stdin
+	confirmationOutput io.Writer = os.Stderr
+	stdinIsTerminal              = isTerminal
+)
+
+func isTerminal() bool {
+	stat, err := os.Stdin.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
+
+// AddYesFlag adds the `--yes` flag, and its `--non-interactive` alias, to commands that ask
+// for confirmation before destructive actions
+func AddYesFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.BoolVar(&cmd.Yes, "yes", false, "Do not ask for confirmation")
+	fs.BoolVar(&cmd.Yes, "non-interactive", false, "Alias of --yes")
+}
+
+// ConfirmDestructiveAction asks the user to confirm the action described by format and args.
+// The user is only asked when stdin is a terminal and neither `--yes` nor `--approve` is given, so
+// that scripts and CI jobs keep running as they did. No confirmation is needed in plan mode either,
+// as nothing is changed
+func ConfirmDestructiveAction(cmd *Cmd, format string, args ...interface{}) error {
+	if cmd.Plan || cmd.Yes || !stdinIsTerminal() {
+		return nil
+	}
+	if approve := cmd.CobraCommand.Flag("approve"); approve != nil && approve.Changed {
+		return nil
+	}
+
+	action := fmt.Sprintf(format, args...)
+	fmt.Fprintf(confirmationOutput, "are you sure you want to %s? [y/N]: ", action)
+	answer, err := bufio.NewReader(confirmationInput).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return errors.Wrap(err, "reading confirmation")
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return fmt.Errorf("not confirmed, will not %s", action)
+	}
+}
@@ -0,0 +1,105 @@
+package cmdutils
+
+import (
+	"bytes"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("confirmation of destructive actions", func() {
+	var (
+		cmd      *Cmd
+		terminal bool
+		output   *bytes.Buffer
+	)
+
+	newCmd := func(withApprove bool, args ...string) *Cmd {
+		cmd := &Cmd{
+			Plan: withApprove,
+			CobraCommand: &cobra.Command{
+				Use: "test",
+				Run: func(_ *cobra.Command, _ []string) {},
+			},
+		}
+		fs := cmd.CobraCommand.Flags()
+		AddYesFlag(fs, cmd)
+		if withApprove {
+			AddApproveFlag(fs, cmd)
+		}
+		Expect(fs.Parse(args)).To(Succeed())
+		if withApprove {
+			cmd.CobraCommand.PreRun(cmd.CobraCommand, nil)
+		}
+		return cmd
+	}
+
+	setInput := func(input string) {
+		confirmationInput = strings.NewReader(input)
+	}
+
+	BeforeEach(func() {
+		cmd = newCmd(false)
+		terminal = true
+		output = &bytes.Buffer{}
+		stdinIsTerminal = func() bool { return terminal }
+		confirmationOutput = output
+		setInput("")
+	})
+
+	AfterEach(func() {
+		stdinIsTerminal = isTerminal
+		confirmationInput = os.Stdin
+		confirmationOutput = os.Stderr
+	})
+
+	DescribeTable("does not ask for confirmation", func(withApprove bool, args ...string) {
+		cmd = newCmd(withApprove, args...)
+		Expect(ConfirmDestructiveAction(cmd, "delete cluster %q", "test")).To(Succeed())
+		Expect(output.String()).To(BeEmpty())
+	},
+		Entry("with --yes", false, "--yes"),
+		Entry("with --non-interactive", false, "--non-interactive"),
+		Entry("with --approve", true, "--approve"),
+		Entry("with --approve and --yes", true, "--approve", "--yes"),
+		Entry("in plan mode", true),
+	)
+
+	It("asks for confirmation when plan mode is turned off without --approve", func() {
+		cmd = newCmd(true)
+		cmd.Plan = false
+		setInput("y\n")
+		Expect(ConfirmDestructiveAction(cmd, "delete nodegroup %q", "ng-1")).To(Succeed())
+		Expect(output.String()).To(Equal(`are you sure you want to delete nodegroup "ng-1"? [y/N]: `))
+	})
+
+	It("does not ask for confirmation when stdin is not a terminal", func() {
+		terminal = false
+		setInput("n\n")
+		Expect(ConfirmDestructiveAction(cmd, "delete cluster %q", "test")).To(Succeed())
+		Expect(output.String()).To(BeEmpty())
+	})
+
+	DescribeTable("proceeds when the action is confirmed", func(answer string) {
+		setInput(answer)
+		Expect(ConfirmDestructiveAction(cmd, "delete cluster %q", "test")).To(Succeed())
+		Expect(output.String()).To(Equal(`are you sure you want to delete cluster "test"? [y/N]: `))
+	},
+		Entry("y", "y\n"),
+		Entry("yes", "Yes\n"),
+		Entry("without a newline", "y"),
+	)
+
+	DescribeTable("stops when the action is not confirmed", func(answer string) {
+		setInput(answer)
+		Expect(ConfirmDestructiveAction(cmd, "delete cluster %q", "test")).To(MatchError(`not confirmed, will not delete cluster "test"`))
+	},
+		Entry("n", "n\n"),
+		Entry("an empty answer", "\n"),
+		Entry("no answer", ""),
+	)
+})
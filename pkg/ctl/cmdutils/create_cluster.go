@@ -19,6 +19,11 @@ type CreateClusterCmdParams struct {
 	WithoutNodeGroup      bool
 	Fargate               bool
 	DryRun                bool
+	Async                 bool
+	WaitForAddons         bool
+	ClusterParallelism    int
+	// CheckSubnetRoutes warns about the subnets of an existing VPC that have no default route for the nodes
+	CheckSubnetRoutes bool
 	CreateNGOptions
 	CreateManagedNGOptions
 }
@@ -35,4 +40,7 @@ type CreateNGOptions struct {
 	InstallNeuronDevicePlugin bool
 	InstallNvidiaDevicePlugin bool
 	DryRun                    bool
+	NodeGroupParallelism      int
+	// SkipInstanceTypeOfferingsCheck skips checking that the instance types are offered in the nodegroups' AZs
+	SkipInstanceTypeOfferingsCheck bool
 }
@@ -96,6 +96,66 @@ var _ = Describe("nodegroup filter", func() {
 			)).To(BeTrue())
 		})
 
+		It("only-missing (only-remote) adds remote-only nodegroups to the config so that they can be deleted", func() {
+			mockProvider = mockprovider.NewMockProvider()
+			mockProvider.MockEKS().On("ListNodegroups", mock.Anything).Return(&eks.ListNodegroupsOutput{
+				Nodegroups: aws.StringSlice([]string{"remote-mng", "remote-mng-without-stack"}),
+			}, nil)
+			mockLister := &mockStackLister{
+				nodesResult: []manager.NodeGroupStack{
+					{NodeGroupName: "test-ng1a", Type: api.NodeGroupTypeUnmanaged},
+					{NodeGroupName: "remote-ng", Type: api.NodeGroupTypeUnmanaged},
+					{NodeGroupName: "remote-mng", Type: api.NodeGroupTypeManaged},
+				},
+			}
+
+			err := filter.SetOnlyRemote(mockProvider.EKS(), mockLister, cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(getNodeGroupNames(cfg)).To(ContainElement("remote-ng"))
+			var mngNames []string
+			for _, ng := range cfg.ManagedNodeGroups {
+				mngNames = append(mngNames, ng.NameString())
+			}
+			Expect(mngNames).To(ConsistOf("remote-mng", "remote-mng-without-stack"))
+
+			Expect(filter.Match("remote-ng")).To(BeTrue())
+			Expect(filter.Match("remote-mng")).To(BeTrue())
+			Expect(filter.Match("remote-mng-without-stack")).To(BeTrue())
+			// nodegroups in the config file are never deleted, whether they exist in the cluster or not
+			Expect(filter.Match("test-ng1a")).To(BeFalse())
+			Expect(filter.Match("test-ng2a")).To(BeFalse())
+		})
+
+		It("only-missing (only-remote) honours exclude rules", func() {
+			mockLister := newMockStackLister(
+				"test-ng1a",
+				"non-existing-in-cfg-1",
+				"non-existing-in-cfg-2",
+			)
+			err := filter.SetOnlyRemote(mockProvider.EKS(), mockLister, cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = filter.AppendExcludeGlobs("*-2")
+			Expect(err).ToNot(HaveOccurred())
+
+			included, excluded := filter.matchAll(filter.collectNames(cfg.NodeGroups))
+			Expect(included.List()).To(ConsistOf("non-existing-in-cfg-1"))
+			Expect(excluded.Has("non-existing-in-cfg-2")).To(BeTrue())
+		})
+
+		It("only-missing (only-remote) matches nothing when the cluster is in sync with the config", func() {
+			mockLister := newMockStackLister(
+				"test-ng1a",
+				"test-ng2a",
+			)
+			err := filter.SetOnlyRemote(mockProvider.EKS(), mockLister, cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			included, _ := filter.matchAll(filter.collectNames(cfg.NodeGroups))
+			Expect(included).To(BeEmpty())
+		})
+
 		It("should match only local nodegroups", func() {
 			err := filter.AppendIncludeGlobs(getNodeGroupNames(cfg), "test-ng1?")
 			Expect(err).ToNot(HaveOccurred())
@@ -78,6 +78,10 @@ func createAddonCmd(cmd *cmdutils.Cmd) {
 
 		logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cmd.ClusterConfig.Metadata.Name)
 		cmd.ClusterConfig.Metadata.Version = *output.Cluster.Version
+		// network policies depend on the Kubernetes version of the cluster
+		if err := cmd.ClusterConfig.AWSNode.Validate(cmd.ClusterConfig); err != nil {
+			return err
+		}
 
 		clientSet, err := clusterProvider.NewStdClientSet(cmd.ClusterConfig)
 		if err != nil {
@@ -7,10 +7,12 @@ import (
 	"strings"
 
 	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/weaveworks/eksctl/pkg/kops"
 	"github.com/weaveworks/eksctl/pkg/utils"
 
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
@@ -20,10 +22,12 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
 	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/gitops"
+	"github.com/weaveworks/eksctl/pkg/iam"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 	"github.com/weaveworks/eksctl/pkg/utils/kubectl"
@@ -49,6 +53,34 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.ValidateNodeGroupParallelism(params.NodeGroupParallelism); err != nil {
+			return err
+		}
+		if err := cmdutils.ValidateClusterParallelism(params.ClusterParallelism); err != nil {
+			return err
+		}
+		if params.Async && params.DryRun {
+			return fmt.Errorf("--async and --dry-run %s", cmdutils.IncompatibleFlags)
+		}
+		if params.Async && params.WaitForAddons {
+			return fmt.Errorf("--async and --wait-for-addons %s", cmdutils.IncompatibleFlags)
+		}
+		if params.Async && params.InstallWindowsVPCController {
+			return fmt.Errorf("--async and --install-vpc-controllers %s", cmdutils.IncompatibleFlags)
+		}
+		if cmd.ClusterConfigFile != "" {
+			if err := api.Register(); err != nil {
+				return err
+			}
+			clusterConfigs, isList, err := eks.LoadConfigsFromFile(cmd.ClusterConfigFile)
+			if err != nil {
+				return err
+			}
+			if isList {
+				return createClusters(cmd, clusterConfigs, params, runFunc)
+			}
+			cmd.LoadedClusterConfig = clusterConfigs[0]
+		}
 		ngFilter := filter.NewNodeGroupFilter()
 		if err := cmdutils.NewCreateClusterLoader(cmd, ngFilter, ng, params).Load(); err != nil {
 			return err
@@ -71,6 +103,11 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		fs.BoolVarP(&params.InstallWindowsVPCController, "install-vpc-controllers", "", false, "Install VPC controller that's required for Windows workloads")
 		fs.BoolVarP(&params.Fargate, "fargate", "", false, "Create a Fargate profile scheduling pods in the default and kube-system namespaces onto Fargate")
 		fs.BoolVarP(&params.DryRun, "dry-run", "", false, "Dry-run mode that skips cluster creation and outputs a ClusterConfig")
+		fs.BoolVar(&params.WaitForAddons, "wait-for-addons", false, "wait for kube-proxy, aws-node and coredns to be rolled out and ready before returning, up to --timeout")
+		fs.BoolVar(&params.Async, "async", false, "submit the cluster stack and return without waiting for the cluster to be created, use 'eksctl utils wait-cluster' to wait for it")
+		cmdutils.AddNodeGroupParallelismFlag(fs, &params.NodeGroupParallelism)
+		cmdutils.AddSkipInstanceTypeOfferingsCheckFlag(fs, &params.SkipInstanceTypeOfferingsCheck)
+		fs.IntVar(&params.ClusterParallelism, "cluster-parallelism", cmdutils.DefaultClusterParallelism, "maximum number of clusters of a ClusterConfigList to create at the same time")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("Initial nodegroup", func(fs *pflag.FlagSet) {
@@ -91,6 +128,7 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 		}
 		fs.StringVar(&params.KopsClusterNameForVPC, "vpc-from-kops-cluster", "", "re-use VPC from a given kops cluster")
 		fs.StringVar(cfg.VPC.NAT.Gateway, "vpc-nat-mode", api.ClusterSingleNAT, "VPC NAT mode, valid options: HighlyAvailable, Single, Disable")
+		fs.BoolVar(&params.CheckSubnetRoutes, "check-subnet-routes", false, "warn about the subnets of an existing VPC whose route tables have no default route to an internet gateway, a NAT gateway or a transit gateway")
 	})
 
 	cmdutils.AddInstanceSelectorOptions(cmd.FlagSetGroup, ng)
@@ -103,6 +141,82 @@ func createClusterCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.C
 	})
 }
 
+// createClusters creates each of the clusters of a ClusterConfigList, at most params.ClusterParallelism
+// at a time. All the clusters are validated before any of them is created. A cluster that cannot be
+// created does not stop the creation of the others, the result of each cluster is reported once all
+// of them are done
+func createClusters(cmd *cmdutils.Cmd, clusterConfigs []*api.ClusterConfig, params *cmdutils.CreateClusterCmdParams, runFunc func(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error) error {
+	if params.DryRun {
+		return errors.New("--dry-run cannot be used with a ClusterConfigList")
+	}
+
+	type clusterToCreate struct {
+		cmd      cmdutils.Cmd
+		ngFilter *filter.NodeGroupFilter
+		params   cmdutils.CreateClusterCmdParams
+	}
+	clusters := make([]*clusterToCreate, len(clusterConfigs))
+	invalid := 0
+	for i, clusterConfig := range clusterConfigs {
+		// each cluster gets its own copy of the command and its params; the loader replaces
+		// the ClusterConfig and sets the region of the ProviderConfig
+		cluster := &clusterToCreate{
+			cmd:      *cmd,
+			ngFilter: filter.NewNodeGroupFilter(),
+			params:   *params,
+		}
+		cluster.cmd.ClusterConfig = clusterConfig
+		cluster.cmd.LoadedClusterConfig = clusterConfig
+		err := cmdutils.NewCreateClusterLoader(&cluster.cmd, cluster.ngFilter, api.NewNodeGroup(), &cluster.params).Load()
+		if err == nil {
+			err = cluster.cmd.ValidateClusterConfig()
+		}
+		if err != nil {
+			invalid++
+			logger.Critical("invalid cluster %q: %v", clusterConfig.Metadata.Name, err)
+			continue
+		}
+		clusters[i] = cluster
+	}
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d clusters defined in %q are invalid, no cluster has been created", invalid, len(clusterConfigs), cmd.ClusterConfigFile)
+	}
+
+	logger.Info("creating %d clusters defined in %q, at most %d at a time", len(clusterConfigs), cmd.ClusterConfigFile, params.ClusterParallelism)
+
+	results := make([]error, len(clusterConfigs))
+	taskTree := &tasks.TaskTree{
+		Parallel: true,
+		Limit:    params.ClusterParallelism,
+	}
+	for i, cluster := range clusters {
+		i, cluster := i, cluster
+		taskTree.Append(&tasks.GenericTask{
+			Description: fmt.Sprintf("create cluster %q", cluster.cmd.ClusterConfig.Metadata.Name),
+			Doer: func() error {
+				results[i] = runFunc(&cluster.cmd, cluster.ngFilter, &cluster.params)
+				return results[i]
+			},
+		})
+	}
+	taskTree.DoAllSync()
+
+	failed := 0
+	for i, err := range results {
+		name := clusterConfigs[i].Metadata.Name
+		if err != nil {
+			failed++
+			logger.Critical("failed to create cluster %q: %v", name, err)
+			continue
+		}
+		logger.Success("created cluster %q", name)
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed to create %d of %d clusters defined in %q", failed, len(clusterConfigs), cmd.ClusterConfigFile)
+	}
+	return nil
+}
+
 func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
@@ -158,6 +272,8 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		logger.Warning("security group rules may be added by eksctl; see vpc.manageSharedNodeSecurityGroupRules to disable this behavior")
 	}
 
+	eks.LogExtendedSupportCost(cfg)
+
 	if params.AutoKubeconfigPath {
 		if params.KubeconfigPath != kubeconfig.DefaultPath() {
 			return fmt.Errorf("--kubeconfig and --auto-kubeconfig %s", cmdutils.IncompatibleFlags)
@@ -183,6 +299,15 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	if err := eks.ValidateFeatureCompatibility(cfg, kubeNodeGroups); err != nil {
 		return err
 	}
+	if err := iam.ValidateInstanceProfiles(ctl.Provider.IAM(), cfg.NodeGroups, api.IsEnabled(cfg.IAM.WithOIDC)); err != nil {
+		return err
+	}
+	if err := iam.ValidateServiceRole(ctl.Provider.IAM(), cfg.IAM); err != nil {
+		return err
+	}
+	if err := eks.ResolveKMSKey(ctl.Provider.KMS(), cfg); err != nil {
+		return err
+	}
 	if params.InstallWindowsVPCController {
 		if !eks.SupportsWindowsWorkloads(kubeNodeGroups) {
 			return errors.New("running Windows workloads requires having both Windows and Linux (AmazonLinux2) node groups")
@@ -195,6 +320,11 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return err
 	}
 
+	// the CIDRs of an existing VPC are only known once it has been imported
+	if err := cfg.ValidateServiceIPv4CIDR(); err != nil {
+		return err
+	}
+
 	nodeGroupService := eks.NewNodeGroupService(ctl.Provider, selector.New(ctl.Provider.Session()))
 	nodePools := cmdutils.ToNodePools(cfg)
 	if err := nodeGroupService.ExpandInstanceSelectorOptions(nodePools, cfg.AvailabilityZones); err != nil {
@@ -205,6 +335,34 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 		return cmdutils.PrintDryRunConfig(cfg, os.Stdout)
 	}
 
+	if !params.SkipInstanceTypeOfferingsCheck {
+		if err := vpc.ValidateInstanceTypeOfferings(ctl.Provider.EC2(), cfg); err != nil {
+			return err
+		}
+	}
+
+	if params.CheckSubnetRoutes {
+		gaps, err := vpc.CheckSubnetRoutes(ctl.Provider.EC2(), cfg)
+		if err != nil {
+			return err
+		}
+		for _, gap := range gaps {
+			logger.Warning("nodes may not be able to reach the control plane: %s", gap)
+		}
+	}
+
+	if err := vpc.ValidateEFAPlacementGroups(ctl.Provider.EC2(), cfg); err != nil {
+		return err
+	}
+
+	if err := vpc.ValidateSecondaryNetworkInterfaces(ctl.Provider.EC2(), cfg); err != nil {
+		return err
+	}
+
+	if err := vpc.ValidateEFSMounts(ctl.Provider.EFS(), cfg); err != nil {
+		return err
+	}
+
 	if err := nodeGroupService.Normalize(nodePools, cfg.Metadata); err != nil {
 		return err
 	}
@@ -221,6 +379,13 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	}
 
 	stackManager := ctl.NewStackManager(cfg)
+	if params.Async {
+		// 'eksctl utils wait-cluster' can only configure the cluster once it has been created if it is given the config file
+		if cmd.ClusterConfigFile == "" && ctl.CreateExtraClusterConfigTasks(cfg, false).Len() > 1 {
+			return errors.New("--async requires a config file when the cluster has to be configured once it has been created, e.g. with --with-oidc, --fargate or --tags, so that 'eksctl utils wait-cluster --config-file' can configure it")
+		}
+		return submitCluster(cmd, stackManager)
+	}
 	if cmd.ClusterConfigFile == "" {
 		logMsg := func(resource string) {
 			logger.Info("will create 2 separate CloudFormation stacks for cluster itself and the initial %s", resource)
@@ -255,13 +420,17 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	var taskTree, preNodegroupAddons, postNodegroupAddons *tasks.TaskTree
 	if supported {
 		preNodegroupAddons, postNodegroupAddons = addon.CreateAddonTasks(cfg, ctl, true, cmd.ProviderConfig.WaitTimeout)
-		taskTree = stackManager.NewTasksToCreateClusterWithNodeGroups(cfg.NodeGroups, cfg.ManagedNodeGroups, supportsManagedNodes, postClusterCreationTasks, preNodegroupAddons)
+		taskTree = stackManager.NewTasksToCreateClusterWithNodeGroups(cfg.NodeGroups, cfg.ManagedNodeGroups, supportsManagedNodes, params.NodeGroupParallelism, postClusterCreationTasks, preNodegroupAddons)
 	} else {
-		taskTree = stackManager.NewTasksToCreateClusterWithNodeGroups(cfg.NodeGroups, cfg.ManagedNodeGroups, supportsManagedNodes, postClusterCreationTasks)
+		taskTree = stackManager.NewTasksToCreateClusterWithNodeGroups(cfg.NodeGroups, cfg.ManagedNodeGroups, supportsManagedNodes, params.NodeGroupParallelism, postClusterCreationTasks)
 	}
 
 	logger.Info(taskTree.Describe())
 	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		if len(errs) == 1 && eks.IsAuditedCallError(errs[0]) {
+			// with --audit-calls, the creation stops at the first call that was recorded instead of being made
+			return errs[0]
+		}
 		logger.Warning("%d error(s) occurred and cluster hasn't been created properly, you may wish to check CloudFormation console", len(errs))
 		logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
 		for _, err := range errs {
@@ -314,6 +483,12 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			return err
 		}
 
+		// nodes only become ready once another CNI plugin is installed when aws-node is disabled
+		waitForNodes := !cfg.IsAWSNodeDisabled()
+		if !waitForNodes && (len(cfg.NodeGroups) > 0 || len(cfg.ManagedNodeGroups) > 0) {
+			logger.Info("not waiting for nodes to become ready as awsNode.disable is set, they will be ready once a CNI plugin is installed")
+		}
+
 		for _, ng := range cfg.NodeGroups {
 			// authorise nodes to join
 			if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
@@ -321,14 +496,18 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			}
 
 			// wait for nodes to join
-			if err = ctl.WaitForNodes(clientSet, ng); err != nil {
-				return err
+			if waitForNodes {
+				if err = ctl.WaitForNodes(clientSet, ng); err != nil {
+					return err
+				}
 			}
 		}
 
-		for _, ng := range cfg.ManagedNodeGroups {
-			if err := ctl.WaitForNodes(clientSet, ng); err != nil {
-				return err
+		if waitForNodes {
+			for _, ng := range cfg.ManagedNodeGroups {
+				if err := ctl.WaitForNodes(clientSet, ng); err != nil {
+					return err
+				}
 			}
 		}
 		if postNodegroupAddons != nil && postNodegroupAddons.Len() > 0 {
@@ -341,6 +520,12 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 			}
 		}
 
+		if params.WaitForAddons {
+			if err := waitForDefaultAddons(ctl, cfg); err != nil {
+				return err
+			}
+		}
+
 		// FLUX V1 DEPRECATION NOTICE. https://github.com/weaveworks/eksctl/issues/2963
 		if cfg.HasGitopsRepoConfigured() {
 			logger.Warning("git.X configuration is marked for deprecation: Please see https://github.com/weaveworks/eksctl/issues/2963")
@@ -391,11 +576,83 @@ func doCreateCluster(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params
 	return nil
 }
 
+// waitForDefaultAddons waits for kube-proxy, aws-node and coredns to be rolled out and ready,
+// unless they can't become ready as there are no nodes or no CNI plugin
+func waitForDefaultAddons(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	if cfg.IsAWSNodeDisabled() {
+		logger.Info("not waiting for the default addons to become ready as awsNode.disable is set, they will be ready once a CNI plugin is installed")
+		return nil
+	}
+	if len(cfg.NodeGroups) == 0 && len(cfg.ManagedNodeGroups) == 0 && !cfg.IsFargateEnabled() {
+		logger.Info("not waiting for the default addons to become ready as the cluster has no nodegroups or Fargate profiles")
+		return nil
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	controlPlaneVersion, err := rawClient.ServerVersion()
+	if err != nil {
+		return err
+	}
+	return defaultaddons.WaitForAll(rawClient.ClientSet(), defaultaddons.NewDefaultAddons(defaultaddons.AddonInput{
+		RawClient:           rawClient,
+		ControlPlaneVersion: controlPlaneVersion,
+		ClusterConfig:       cfg,
+	}), ctl.Provider.WaitTimeout())
+}
+
+// asyncCluster is the handle printed for a cluster whose creation has been submitted
+type asyncCluster struct {
+	Name    string `json:"name"`
+	Region  string `json:"region"`
+	StackID string `json:"stackID"`
+}
+
+func submitCluster(cmd *cmdutils.Cmd, stackManager manager.StackManager) error {
+	meta := cmd.ClusterConfig.Metadata
+	supportsManagedNodes, err := eks.VersionSupportsManagedNodes(meta.Version)
+	if err != nil {
+		return err
+	}
+	stack, err := stackManager.SubmitClusterStack(supportsManagedNodes)
+	if err != nil {
+		return errors.Wrapf(err, "submitting creation of cluster %q", meta.Name)
+	}
+
+	logger.Success("creation of cluster %q has been submitted", meta.Name)
+	nodeGroupCount := len(cmd.ClusterConfig.NodeGroups) + len(cmd.ClusterConfig.ManagedNodeGroups)
+	if cmd.ClusterConfigFile != "" {
+		logger.Info("to wait for the cluster to be created and configured, run 'eksctl utils wait-cluster --config-file=%s'", cmd.ClusterConfigFile)
+		if nodeGroupCount > 0 {
+			logger.Info("once it has been created, create its %d nodegroup(s) with 'eksctl create nodegroup --config-file=%s'", nodeGroupCount, cmd.ClusterConfigFile)
+		}
+		if len(cmd.ClusterConfig.Addons) > 0 {
+			logger.Info("once it has been created, create its addons with 'eksctl create addon --config-file=%s'", cmd.ClusterConfigFile)
+		}
+	} else {
+		logger.Info("to wait for the cluster to be created, run 'eksctl utils wait-cluster --region=%s --cluster=%s'", meta.Region, meta.Name)
+		if nodeGroupCount > 0 {
+			logger.Info("once it has been created, create a nodegroup with 'eksctl create nodegroup --cluster=%s'", meta.Name)
+		}
+	}
+
+	return printers.NewJSONPrinter().PrintObj(asyncCluster{
+		Name:    meta.Name,
+		Region:  meta.Region,
+		StackID: aws.StringValue(stack.StackId),
+	}, os.Stdout)
+}
+
 func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmdutils.CreateClusterCmdParams, ctl *eks.ClusterProvider) error {
 	customNetworkingNotice := "custom VPC/subnets will be used; if resulting cluster doesn't function as expected, make sure to review the configuration of VPC/subnets"
 
 	subnetsGiven := cfg.HasAnySubnets() // this will be false when neither flags nor config has any subnets
 	if !subnetsGiven && params.KopsClusterNameForVPC == "" {
+		if cfg.VPC.CustomNetworking != nil && len(cfg.VPC.SecondaryCIDRs) == 0 {
+			return errors.New("vpc.customNetworking requires an existing VPC, as the pod subnets must exist before the cluster is created, or vpc.secondaryCIDRs for eksctl to create them")
+		}
 		if err := ctl.SetAvailabilityZones(cfg, params.AvailabilityZones); err != nil {
 			return err
 		}
@@ -413,6 +670,10 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 		return nil
 	}
 
+	if len(cfg.VPC.SecondaryCIDRs) > 0 {
+		return api.ErrSecondaryCIDRsWithExistingVPC
+	}
+
 	if params.KopsClusterNameForVPC != "" {
 		// import VPC from a given kops cluster
 		if len(params.AvailabilityZones) != 0 {
@@ -439,6 +700,10 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 			return err
 		}
 
+		if err := vpc.ValidatePodSubnets(ctl.Provider.EC2(), cfg); err != nil {
+			return err
+		}
+
 		if err := cfg.CanUseForPrivateNodeGroups(); err != nil {
 			return err
 		}
@@ -470,6 +735,10 @@ func createOrImportVPC(cmd *cmdutils.Cmd, cfg *api.ClusterConfig, params *cmduti
 		return err
 	}
 
+	if err := vpc.ValidatePodSubnets(ctl.Provider.EC2(), cfg); err != nil {
+		return err
+	}
+
 	if err := cfg.CanUseForPrivateNodeGroups(); err != nil {
 		return err
 	}
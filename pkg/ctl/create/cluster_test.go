@@ -1,6 +1,11 @@
 package create
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+
 	. "github.com/onsi/ginkgo/extensions/table"
 
 	. "github.com/onsi/ginkgo"
@@ -82,6 +87,8 @@ var _ = Describe("create cluster", func() {
 			Entry("with appmesh-access flag", "--appmesh-access", "true"),
 			Entry("with alb-ingress-access flag", "--alb-ingress-access", "true"),
 			Entry("with managed flag unset", "--managed", "false"),
+			Entry("with nodegroup-parallelism flag", "--nodegroup-parallelism", "10"),
+			Entry("with async flag", "--async"),
 		)
 
 		DescribeTable("invalid flags or arguments",
@@ -100,6 +107,26 @@ var _ = Describe("create cluster", func() {
 				args:  []string{"cluster", "--invalid", "dummy"},
 				error: "unknown flag: --invalid",
 			}),
+			Entry("with nodegroup-parallelism lower than 1", invalidParamsCase{
+				args:  []string{"--nodegroup-parallelism", "0"},
+				error: "--nodegroup-parallelism must be at least 1, got 0",
+			}),
+			Entry("with cluster-parallelism lower than 1", invalidParamsCase{
+				args:  []string{"--cluster-parallelism", "0"},
+				error: "--cluster-parallelism must be at least 1, got 0",
+			}),
+			Entry("with async and dry-run", invalidParamsCase{
+				args:  []string{"--async", "--dry-run"},
+				error: "--async and --dry-run cannot be used at the same time",
+			}),
+			Entry("with async and wait-for-addons", invalidParamsCase{
+				args:  []string{"--async", "--wait-for-addons"},
+				error: "--async and --wait-for-addons cannot be used at the same time",
+			}),
+			Entry("with async and install-vpc-controllers", invalidParamsCase{
+				args:  []string{"--async", "--install-vpc-controllers"},
+				error: "--async and --install-vpc-controllers cannot be used at the same time",
+			}),
 			Entry("with --name option with invalid characters that are rejected by cloudformation", invalidParamsCase{
 				args:  []string{"test-k8_cluster01"},
 				error: "validation for test-k8_cluster01 failed, name must satisfy regular expression pattern: [a-zA-Z][-a-zA-Z0-9]*",
@@ -194,4 +221,109 @@ var _ = Describe("create cluster", func() {
 			}),
 		)
 	})
+
+	Describe("ClusterConfigList", func() {
+		var configFile string
+
+		BeforeEach(func() {
+			f, err := ioutil.TempFile("", "fleet-*.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			defer f.Close()
+			_, err = f.WriteString(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+defaults:
+  metadata:
+    region: us-west-2
+items:
+  - metadata:
+      name: fleet-1
+  - metadata:
+      name: fleet-2
+      region: eu-west-1
+  - metadata:
+      name: fleet-3
+`)
+			Expect(err).NotTo(HaveOccurred())
+			configFile = f.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(configFile)).To(Succeed())
+		})
+
+		runCreateClusters := func(runFunc func(cmd *cmdutils.Cmd) error, args ...string) error {
+			cmd := newMockEmptyCmd(append([]string{"cluster", "--config-file", configFile}, args...)...)
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
+				createClusterCmdWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ngFilter *filter.NodeGroupFilter, params *cmdutils.CreateClusterCmdParams) error {
+					return runFunc(cmd)
+				})
+			})
+			_, err := cmd.execute()
+			return err
+		}
+
+		It("creates each of the clusters in its own region", func() {
+			var mu sync.Mutex
+			regions := map[string]string{}
+			err := runCreateClusters(func(cmd *cmdutils.Cmd) error {
+				mu.Lock()
+				defer mu.Unlock()
+				regions[cmd.ClusterConfig.Metadata.Name] = cmd.ProviderConfig.Region
+				return nil
+			}, "--cluster-parallelism", "2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(regions).To(Equal(map[string]string{
+				"fleet-1": "us-west-2",
+				"fleet-2": "eu-west-1",
+				"fleet-3": "us-west-2",
+			}))
+		})
+
+		It("creates the other clusters when a cluster fails", func() {
+			var mu sync.Mutex
+			var created []string
+			err := runCreateClusters(func(cmd *cmdutils.Cmd) error {
+				if cmd.ClusterConfig.Metadata.Name == "fleet-2" {
+					return fmt.Errorf("stack failed")
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				created = append(created, cmd.ClusterConfig.Metadata.Name)
+				return nil
+			})
+			Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("failed to create 1 of 3 clusters defined in %q", configFile))))
+			Expect(created).To(ConsistOf("fleet-1", "fleet-3"))
+		})
+
+		It("does not create any cluster when a cluster is invalid", func() {
+			Expect(os.WriteFile(configFile, []byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+defaults:
+  metadata:
+    region: us-west-2
+items:
+  - metadata:
+      name: fleet-1
+  - metadata:
+      name: fleet-2
+    kubernetesNetworkConfig:
+      ipFamily: IPv5
+`), 0644)).To(Succeed())
+			err := runCreateClusters(func(cmd *cmdutils.Cmd) error {
+				Fail("no cluster should be created")
+				return nil
+			})
+			Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("1 of 2 clusters defined in %q are invalid, no cluster has been created", configFile))))
+		})
+
+		It("does not support --dry-run", func() {
+			err := runCreateClusters(func(cmd *cmdutils.Cmd) error {
+				Fail("no cluster should be created")
+				return nil
+			}, "--dry-run")
+			Expect(err).To(MatchError(ContainSubstring("--dry-run cannot be used with a ClusterConfigList")))
+		})
+	})
 })
@@ -64,12 +64,14 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 		manager := nodegroup.New(cmd.ClusterConfig, ctl, clientSet)
 		return manager.Create(nodegroup.CreateOpts{
-			InstallNeuronDevicePlugin: options.InstallNeuronDevicePlugin,
-			InstallNvidiaDevicePlugin: options.InstallNvidiaDevicePlugin,
-			UpdateAuthConfigMap:       options.UpdateAuthConfigMap,
-			DryRun:                    options.DryRun,
-			SkipOutdatedAddonsCheck:   options.SkipOutdatedAddonsCheck,
-			ConfigFileProvided:        cmd.ClusterConfigFile != "",
+			InstallNeuronDevicePlugin:      options.InstallNeuronDevicePlugin,
+			InstallNvidiaDevicePlugin:      options.InstallNvidiaDevicePlugin,
+			UpdateAuthConfigMap:            options.UpdateAuthConfigMap,
+			DryRun:                         options.DryRun,
+			SkipOutdatedAddonsCheck:        options.SkipOutdatedAddonsCheck,
+			ConfigFileProvided:             cmd.ClusterConfigFile != "",
+			NodeGroupParallelism:           options.NodeGroupParallelism,
+			SkipInstanceTypeOfferingsCheck: options.SkipInstanceTypeOfferingsCheck,
 		}, ngFilter)
 	})
 }
@@ -89,6 +91,9 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
+		if err := cmdutils.ValidateNodeGroupParallelism(options.NodeGroupParallelism); err != nil {
+			return err
+		}
 		return runFunc(cmd, ng, options)
 	}
 
@@ -104,6 +109,8 @@ func createNodeGroupCmdWithRunFunc(cmd *cmdutils.Cmd, runFunc runFn) {
 		cmdutils.AddSubnetIDs(fs, &options.SubnetIDs, "Define an optional list of subnet IDs to create the nodegroup in")
 		fs.BoolVarP(&options.DryRun, "dry-run", "", false, "Dry-run mode that skips nodegroup creation and outputs a ClusterConfig")
 		fs.BoolVarP(&options.SkipOutdatedAddonsCheck, "skip-outdated-addons-check", "", false, "whether the creation of ARM nodegroups should proceed when the cluster addons are outdated")
+		cmdutils.AddNodeGroupParallelismFlag(fs, &options.NodeGroupParallelism)
+		cmdutils.AddSkipInstanceTypeOfferingsCheckFlag(fs, &options.SkipInstanceTypeOfferingsCheck)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
@@ -34,6 +34,7 @@ var _ = Describe("create nodegroup", func() {
 			Entry("with nodegroup name with a hyphen as argument", "nodegroup-name"),
 			Entry("with node-type flag", "--node-type", "m5.large"),
 			Entry("with nodes flag", "--nodes", "2"),
+			Entry("with nodegroup-parallelism flag", "--nodegroup-parallelism", "10"),
 			Entry("with nodes-min flag", "--nodes-min", "2"),
 			Entry("with nodes-max flag", "--nodes-max", "2"),
 			Entry("with node-volume-size flag", "--node-volume-size", "2"),
@@ -76,6 +77,10 @@ var _ = Describe("create nodegroup", func() {
 				args:  []string{"--invalid", "dummy"},
 				error: "unknown flag: --invalid",
 			}),
+			Entry("with nodegroup-parallelism lower than 1", invalidParamsCase{
+				args:  []string{"--cluster", "clusterName", "--nodegroup-parallelism", "0"},
+				error: "--nodegroup-parallelism must be at least 1, got 0",
+			}),
 			Entry("with spot flag", invalidParamsCase{
 				args:  []string{"--cluster", "foo", "--spot"},
 				error: "--spot is only valid with managed nodegroups (--managed)",
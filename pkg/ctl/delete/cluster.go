@@ -20,10 +20,10 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("cluster", "Delete a cluster", "")
 
-	var force bool
+	var force, retainVPC bool
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doDeleteCluster(cmd, force)
+		return doDeleteCluster(cmd, force, retainVPC)
 	}
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&cfg.Metadata.Name, "name", "n", "", "EKS cluster name")
@@ -32,15 +32,20 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
 		fs.BoolVar(&force, "force", false, "Force deletion to continue when errors occur")
+		fs.BoolVar(&retainVPC, "retain-vpc", false, "Retain the VPC, subnets, gateways and route tables created by eksctl instead of deleting them")
 
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddYesFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDeleteCluster(cmd *cmdutils.Cmd, force bool) error {
+func doDeleteCluster(cmd *cmdutils.Cmd, force, retainVPC bool) error {
+	// there is no plan mode for deleting clusters
+	cmd.Plan = false
+
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -48,6 +53,10 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
+	if err := cmdutils.ConfirmDestructiveAction(cmd, "delete cluster %q", meta.Name); err != nil {
+		return err
+	}
+
 	printer := printers.NewJSONPrinter()
 
 	ctl, err := cmd.NewProviderForExistingCluster()
@@ -70,5 +79,5 @@ func doDeleteCluster(cmd *cmdutils.Cmd, force bool) error {
 		return err
 	}
 
-	return cluster.Delete(time.Second*20, cmd.Wait, force)
+	return cluster.Delete(time.Second*20, cmd.Wait, force, retainVPC)
 }
@@ -22,7 +22,7 @@ var _ = Describe("delete", func() {
 			cmd := newMockEmptyCmd(args...)
 			count := 0
 			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), cmd.parentCmd, func(cmd *cmdutils.Cmd) {
-				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
+				deleteNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *v1alpha5.NodeGroup, updateAuthConfigMap, deleteNodeGroupDrain, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool, _ string) error {
 					Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("clusterName"))
 					Expect(ng.Name).To(Equal("ng"))
 					count++
@@ -52,5 +52,9 @@ var _ = Describe("delete", func() {
 			args:  []string{"nodegroup", "ng", "--cluster", "dummy", "--name", "ng"},
 			error: fmt.Errorf("Error: --name=ng and argument ng cannot be used at the same time"),
 		}),
+		Entry("setting --only-missing without a config file", invalidParamsCase{
+			args:  []string{"nodegroup", "--cluster", "dummy", "--name", "ng", "--only-missing"},
+			error: fmt.Errorf("Error: cannot use --only-missing unless a config file is specified via --config-file/-f"),
+		}),
 	)
 })
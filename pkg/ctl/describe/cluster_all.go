@@ -0,0 +1,92 @@
+package describe
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func describeClusterAllCmd(cmd *cmdutils.Cmd) {
+	describeClusterAllWithRunFunc(cmd, doDescribeClusterAll)
+}
+
+func describeClusterAllWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, output printers.Type) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output printers.Type
+
+	cmd.SetDescription("cluster-all", "Describe a cluster, its nodegroups, addons and IAM service accounts", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		if output != printers.JSONType && output != printers.YAMLType {
+			return fmt.Errorf("unknown output printer type %q: expected %q or %q", output, printers.JSONType, printers.YAMLType)
+		}
+		return runFunc(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVarP(&output, "output", "o", printers.JSONType, "specifies the output format (valid option: json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDescribeClusterAll(cmd *cmdutils.Cmd, output printers.Type) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	// log warnings and errors to stderr
+	logger.Writer = os.Stderr
+
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	addonManager, err := addon.New(cfg, ctl.Provider.EKS(), stackManager, api.IsEnabled(cfg.IAM.WithOIDC), nil, nil, cmd.ProviderConfig.WaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	describer := cluster.NewDescriber(cfg, ctl.Provider.EKS(),
+		nodegroup.New(cfg, ctl, rawClient.ClientSet()),
+		addonManager,
+		irsa.New(cfg.Metadata.Name, stackManager, nil, nil),
+		rawClient,
+	)
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+	return printer.PrintObj(describer.Describe(), os.Stdout)
+}
@@ -0,0 +1,59 @@
+package describe
+
+import (
+	"bytes"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+var _ = Describe("describe", func() {
+	Describe("cluster-all", func() {
+		execute := func(args ...string) (printers.Type, int, error) {
+			verbCmd := cmdutils.NewVerbCmd("describe", "Describe resource(s)", "")
+			verbCmd.SetArgs(append([]string{"cluster-all"}, args...))
+			var (
+				output printers.Type
+				count  int
+			)
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), verbCmd, func(cmd *cmdutils.Cmd) {
+				describeClusterAllWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o printers.Type) error {
+					Expect(cmd.ClusterConfig.Metadata.Name + cmd.NameArg).To(Equal("clusterName"))
+					output = o
+					count++
+					return nil
+				})
+			})
+			errBuf := new(bytes.Buffer)
+			verbCmd.SetOut(new(bytes.Buffer))
+			verbCmd.SetErr(errBuf)
+			if err := verbCmd.Execute(); err != nil {
+				return "", count, errors.New(errBuf.String())
+			}
+			return output, count, nil
+		}
+
+		DescribeTable("with valid flags",
+			func(expectedOutput printers.Type, args ...string) {
+				output, count, err := execute(args...)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).To(Equal(1))
+				Expect(output).To(Equal(expectedOutput))
+			},
+			Entry("defaults to JSON", printers.JSONType, "--cluster", "clusterName"),
+			Entry("with the cluster name as an argument", printers.JSONType, "clusterName"),
+			Entry("with YAML output", printers.YAMLType, "--cluster", "clusterName", "-o", "yaml"),
+		)
+
+		It("rejects an unknown output format", func() {
+			_, count, err := execute("--cluster", "clusterName", "--output", "table")
+			Expect(err).To(MatchError(ContainSubstring(`unknown output printer type "table": expected "json" or "yaml"`)))
+			Expect(count).To(BeZero())
+		})
+	})
+})
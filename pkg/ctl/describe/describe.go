@@ -0,0 +1,16 @@
+package describe
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `describe` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("describe", "Describe resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeClusterAllCmd)
+
+	return verbCmd
+}
@@ -0,0 +1,11 @@
+package describe
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCtlDescribe(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
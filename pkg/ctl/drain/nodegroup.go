@@ -12,28 +12,30 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/drain"
 )
 
 func drainNodeGroupCmd(cmd *cmdutils.Cmd) {
-	drainNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
-		return doDrainNodeGroup(cmd, ng, undo, onlyMissing, maxGracePeriod, disableEviction)
+	drainNodeGroupWithRunFunc(cmd, func(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction, byPriority bool, eventsFile string) error {
+		return doDrainNodeGroup(cmd, ng, undo, onlyMissing, maxGracePeriod, disableEviction, byPriority, eventsFile)
 	})
 }
 
-func drainNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error) {
+func drainNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction, byPriority bool, eventsFile string) error) {
 	cfg := api.NewClusterConfig()
 	ng := api.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
 	var undo, onlyMissing bool
 	var maxGracePeriod time.Duration
-	var disableEviction bool
+	var disableEviction, byPriority bool
+	var eventsFile string
 
 	cmd.SetDescription("nodegroup", "Cordon and drain a nodegroup", "", "ng")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return runFunc(cmd, ng, undo, onlyMissing, maxGracePeriod, disableEviction)
+		return runFunc(cmd, ng, undo, onlyMissing, maxGracePeriod, disableEviction, byPriority, eventsFile)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -49,13 +51,16 @@ func drainNodeGroupWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		fs.DurationVar(&maxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period")
 		defaultDisableEviction := false
 		fs.BoolVar(&disableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		fs.BoolVar(&byPriority, "by-priority", false, "Evict the pods of each node in ascending order of priority, waiting for the pods of a priority to be gone before evicting the next")
+		fs.StringVar(&eventsFile, "events-file", "", "Append the progress of the drain to this file as newline-delimited JSON events")
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddYesFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, true)
 }
 
-func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction bool) error {
+func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bool, maxGracePeriod time.Duration, disableEviction, byPriority bool, eventsFile string) error {
 	ngFilter := filter.NewNodeGroupFilter()
 
 	if err := cmdutils.NewDeleteNodeGroupLoader(cmd, ng, ngFilter).Load(); err != nil {
@@ -64,6 +69,12 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 
 	cfg := cmd.ClusterConfig
 
+	if !undo {
+		if err := confirmDrain(cmd, ng); err != nil {
+			return err
+		}
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -118,5 +129,22 @@ func doDrainNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, undo, onlyMissing bo
 	}
 	allNodeGroups := cmdutils.ToKubeNodeGroups(cfg)
 
-	return nodegroup.New(cfg, ctl, clientSet).Drain(allNodeGroups, cmd.Plan, maxGracePeriod, disableEviction)
+	nodeGroupManager := nodegroup.New(cfg, ctl, clientSet)
+	if eventsFile != "" {
+		recorder, err := drain.NewFileEventRecorder(eventsFile)
+		if err != nil {
+			return err
+		}
+		defer recorder.Close()
+		nodeGroupManager.SetDrainEventRecorder(recorder)
+	}
+	nodeGroupManager.SetDrainByPriority(byPriority)
+	return nodeGroupManager.Drain(allNodeGroups, cmd.Plan, maxGracePeriod, disableEviction)
+}
+
+func confirmDrain(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
+	if cmd.ClusterConfigFile != "" {
+		return cmdutils.ConfirmDestructiveAction(cmd, "drain the nodegroups selected from %q in cluster %q", cmd.ClusterConfigFile, cmd.ClusterConfig.Metadata.Name)
+	}
+	return cmdutils.ConfirmDestructiveAction(cmd, "drain nodegroup %q in cluster %q", ng.Name, cmd.ClusterConfig.Metadata.Name)
 }
@@ -124,6 +124,12 @@ func getAndPrintCluster(cfg *api.ClusterConfig, ctl *eks.ClusterProvider, params
 
 	if params.output == printers.TableType {
 		addGetClusterSummaryTableColumns(printer.(*printers.TablePrinter))
+
+		upgradePolicy, err := ctl.GetClusterUpgradePolicy(cfg.Metadata.Name)
+		if err != nil {
+			logger.Debug("unable to get the upgrade policy of cluster %q: %v", cfg.Metadata.Name, err)
+		}
+		addUpgradePolicyColumn(printer.(*printers.TablePrinter), upgradePolicy)
 	}
 
 	cluster, err := ctl.GetCluster(cfg.Metadata.Name)
@@ -198,3 +204,12 @@ func addGetClusterSummaryTableColumns(printer *printers.TablePrinter) {
 		return "EKS"
 	})
 }
+
+func addUpgradePolicyColumn(printer *printers.TablePrinter, upgradePolicy *api.UpgradePolicy) {
+	printer.AddColumn("SUPPORT TYPE", func(c *awseks.Cluster) string {
+		if upgradePolicy == nil {
+			return "-"
+		}
+		return upgradePolicy.SupportType
+	})
+}
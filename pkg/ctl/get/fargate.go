@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -16,6 +17,7 @@ import (
 type options struct {
 	fargate.Options
 	getCmdParams
+	asConfig bool
 }
 
 func getFargateProfileWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options *options) error) {
@@ -32,6 +34,9 @@ func getFargateProfileWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.
 		if err := cmdutils.NewGetFargateProfileLoader(cmd, &options.Options).Load(); err != nil {
 			return err
 		}
+		if options.asConfig && options.output == printers.TableType {
+			return errors.New("--as-config requires --output to be yaml or json")
+		}
 		return runFunc(cmd, options)
 	}
 }
@@ -46,6 +51,7 @@ func configureGetFargateProfileCmd(cmd *cmdutils.Cmd) *options {
 	var options options
 	cmd.FlagSetGroup.InFlagSet("Fargate", func(fs *pflag.FlagSet) {
 		cmdutils.AddFlagsForFargate(fs, &options.Options)
+		fs.BoolVar(&options.asConfig, "as-config", false, "print the Fargate profile(s) as a ClusterConfig that can be used with 'eksctl create fargateprofile -f'")
 	})
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddClusterFlag(fs, cmd.ClusterConfig.Metadata)
@@ -87,6 +93,9 @@ func doGetFargateProfile(cmd *cmdutils.Cmd, options *options) error {
 	if err != nil {
 		return err
 	}
+	if options.asConfig {
+		return fargate.PrintProfilesAsConfig(profiles, cmd.ClusterConfig.Metadata, os.Stdout, options.output)
+	}
 	return fargate.PrintProfiles(profiles, os.Stdout, options.output)
 }
 
@@ -59,6 +59,20 @@ var _ = Describe("get", func() {
 			Expect(cmd.cmd.ClusterConfig.Metadata.Name).To(Equal("cluster-1"))
 			Expect(cmd.options.ProfileName).To(Equal("fp-default"))
 		})
+
+		It("accepts --as-config when the output is yaml or json", func() {
+			cmd := newMockGetFargateProfileCmd("fargateprofile", "--cluster", "foo", "--as-config", "-o", "yaml")
+			_, err := cmd.execute()
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cmd.options.asConfig).To(BeTrue())
+		})
+
+		It("rejects --as-config with the table output", func() {
+			cmd := newMockGetFargateProfileCmd("fargateprofile", "--cluster", "foo", "--as-config")
+			_, err := cmd.execute()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error: --as-config requires --output to be yaml or json"))
+		})
 	})
 })
 
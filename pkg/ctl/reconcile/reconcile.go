@@ -0,0 +1,103 @@
+package reconcile
+
+import (
+	"fmt"
+	"time"
+
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/reconcile"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+const (
+	defaultMaxGracePeriod  = 10 * time.Minute
+	defaultDisableEviction = false
+)
+
+// Command will create the `reconcile` command
+func Command(cmd *cmdutils.Cmd) {
+	reconcileWithRunFunc(cmd, doReconcile)
+}
+
+func reconcileWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options reconcile.Options) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var options reconcile.Options
+
+	cmd.SetDescription("reconcile", "Reconcile a cluster with a config file",
+		"Compare addons, nodegroups, cluster logging, endpoint access and IAM identity mappings in the config file against the cluster and apply only the differences. "+
+			"Changes that remove resources are refused unless --allow-destructive is set.")
+
+	cmd.CobraCommand.Args = cobra.NoArgs
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if err := cmdutils.NewReconcileLoader(cmd).Load(); err != nil {
+			return err
+		}
+		options.Plan = cmd.Plan
+		options.Wait = cmd.Wait
+		return runFunc(cmd, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&options.AllowDestructive, "allow-destructive", false, "Allow deleting addons, nodegroups and IAM identity mappings that are not in the config file")
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", defaultMaxGracePeriod, "Maximum pods termination grace period when draining deleted nodegroups")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", defaultDisableEviction, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "all changes to be applied")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doReconcile(cmd *cmdutils.Cmd, options reconcile.Options) error {
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	output, err := ctl.Provider.EKS().DescribeCluster(&awseks.DescribeClusterInput{
+		Name: &cfg.Metadata.Name,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to fetch cluster %q version: %v", cfg.Metadata.Name, err)
+	}
+	logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cfg.Metadata.Name)
+	cfg.Metadata.Version = *output.Cluster.Version
+
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return err
+	}
+
+	oidcProviderExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	addonManager, err := addon.New(cfg, ctl.Provider.EKS(), ctl.NewStackManager(cfg), oidcProviderExists, oidc, clientSet, cmd.ProviderConfig.WaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	return reconcile.New(cfg, ctl, clientSet, addonManager).Reconcile(options)
+}
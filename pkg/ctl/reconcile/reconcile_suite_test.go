@@ -0,0 +1,11 @@
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+func TestCtlReconcile(t *testing.T) {
+	testutils.RegisterAndRun(t)
+}
@@ -0,0 +1,66 @@
+package reconcile
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/actions/reconcile"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
+)
+
+var _ = Describe("reconcile", func() {
+	var (
+		configFile string
+		options    reconcile.Options
+	)
+
+	newReconcileCmd := func(args ...string) *cobra.Command {
+		rootCmd := cmdutils.NewVerbCmd("eksctl", "", "")
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), rootCmd, func(cmd *cmdutils.Cmd) {
+			reconcileWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o reconcile.Options) error {
+				options = o
+				return nil
+			})
+		})
+		rootCmd.SetArgs(append([]string{"reconcile"}, args...))
+		return rootCmd
+	}
+
+	BeforeEach(func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "clus-1"
+		cfg.Metadata.Region = "us-west-2"
+		configFile = ctltest.CreateConfigFile(cfg)
+	})
+
+	AfterEach(func() {
+		Expect(os.Remove(configFile)).To(Succeed())
+	})
+
+	It("requires a config file", func() {
+		err := newReconcileCmd().Execute()
+		Expect(err).To(MatchError("--config-file must be set"))
+	})
+
+	It("runs in plan mode and refuses destructive changes by default", func() {
+		Expect(newReconcileCmd("-f", configFile).Execute()).To(Succeed())
+		Expect(options.Plan).To(BeTrue())
+		Expect(options.AllowDestructive).To(BeFalse())
+	})
+
+	It("accepts --approve and --allow-destructive", func() {
+		Expect(newReconcileCmd("-f", configFile, "--approve", "--allow-destructive").Execute()).To(Succeed())
+		Expect(options.Plan).To(BeFalse())
+		Expect(options.AllowDestructive).To(BeTrue())
+	})
+
+	It("does not accept a name argument", func() {
+		err := newReconcileCmd("-f", configFile, "clus-1").Execute()
+		Expect(err).To(MatchError(ContainSubstring("unknown command")))
+	})
+})
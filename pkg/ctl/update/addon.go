@@ -8,6 +8,8 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	kubeclient "k8s.io/client-go/kubernetes"
+
 	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
@@ -80,8 +82,23 @@ func updateAddon(cmd *cmdutils.Cmd, force, wait bool) error {
 
 	logger.Info("Kubernetes version %q in use by cluster %q", *output.Cluster.Version, cmd.ClusterConfig.Metadata.Name)
 	cmd.ClusterConfig.Metadata.Version = *output.Cluster.Version
+	// network policies depend on the Kubernetes version of the cluster
+	if err := cmd.ClusterConfig.AWSNode.Validate(cmd.ClusterConfig); err != nil {
+		return err
+	}
+
+	var clientSet kubeclient.Interface
+	for _, a := range cmd.ClusterConfig.Addons {
+		// the webhook certificates of the addons are read from the cluster
+		if a.WebhookCertificates != nil {
+			if clientSet, err = clusterProvider.NewStdClientSet(cmd.ClusterConfig); err != nil {
+				return err
+			}
+			break
+		}
+	}
 
-	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, nil, cmd.ProviderConfig.WaitTimeout)
+	addonManager, err := addon.New(cmd.ClusterConfig, clusterProvider.Provider.EKS(), stackManager, oidcProviderExists, oidc, clientSet, cmd.ProviderConfig.WaitTimeout)
 
 	if err != nil {
 		return err
@@ -49,7 +49,7 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 			return err
 		}
 
-		return upgrade.DoUpgradeCluster(cmd)
+		return upgrade.DoUpgradeCluster(cmd, upgrade.UpgradeClusterOptions{})
 	}
 
 }
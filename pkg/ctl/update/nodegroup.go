@@ -20,7 +20,8 @@ func updateNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 		Please consult the eksctl documentation for more info on which config fields can be updated with this command.
 		To upgrade a nodegroup, please use 'eksctl upgrade nodegroup' instead.
-		Note that this is only available for managed nodegroups. 
+		The AMI of unmanaged nodegroups can be updated by setting their ami, and the updateConfig and instance types of
+		managed nodegroups.
 	`),
 	)
 
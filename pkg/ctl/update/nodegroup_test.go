@@ -27,6 +27,6 @@ var _ = Describe("update nodegroup", func() {
 		cmd := newMockCmd("nodegroup", "--config-file", config)
 		_, err := cmd.execute()
 		Expect(err).To(HaveOccurred())
-		Expect(err).To(MatchError(ContainSubstring("managedNodeGroups field must be set")))
+		Expect(err).To(MatchError(ContainSubstring("nodeGroups or managedNodeGroups field must be set")))
 	})
 })
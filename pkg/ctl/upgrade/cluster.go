@@ -3,30 +3,42 @@ package upgrade
 import (
 	"time"
 
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
 	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
 	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
 // updating from 1.15 to 1.16 has been observed to take longer than the default value of 25 minutes
 // increased to 50 for flex fleet changes
 const upgradeClusterTimeout = 65 * time.Minute
 
+// UpgradeClusterOptions holds the options of `eksctl upgrade cluster`
+type UpgradeClusterOptions struct {
+	// ReportPath is the file the upgrade report is written to, no report is written if it is empty
+	ReportPath string
+}
+
 func upgradeCluster(cmd *cmdutils.Cmd) {
 	upgradeClusterWithRunFunc(cmd, DoUpgradeCluster)
 }
 
-func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, options UpgradeClusterOptions) error) {
 	cfg := api.NewClusterConfig()
 	// Reset version
 	cfg.Metadata.Version = ""
 	cmd.ClusterConfig = cfg
 
+	var options UpgradeClusterOptions
+
 	cmd.SetDescription("cluster", "Upgrade control plane to the next version",
 		"Upgrade control plane to the next Kubernetes version if available. Will also perform any updates needed in the cluster stack if resources are missing.")
 
@@ -43,6 +55,8 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		cmdutils.AddApproveFlag(fs, cmd)
 
 		cmdutils.AddTimeoutFlagWithValue(fs, &cmd.ProviderConfig.WaitTimeout, upgradeClusterTimeout)
+
+		fs.StringVar(&options.ReportPath, "report-path", "", "Write a report of the upgrade to this file, as YAML if it has a .yaml or .yml extension and as JSON otherwise")
 	})
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
@@ -51,13 +65,13 @@ func upgradeClusterWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd
 		if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return runFunc(cmd)
+		return runFunc(cmd, options)
 	}
 }
 
 // DoUpgradeCluster made public so that it can be shared with update/cluster.go until this is deprecated
 // TODO Once `eksctl update cluster` is officially deprecated this can be made package private again
-func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
+func DoUpgradeCluster(cmd *cmdutils.Cmd, options UpgradeClusterOptions) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
@@ -71,8 +85,22 @@ func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
+	var reporter *cluster.UpgradeReporter
+	if options.ReportPath != "" {
+		if reporter, err = newUpgradeReporter(cmd, ctl); err != nil {
+			logger.Warning("no upgrade report will be written: %v", err)
+		} else {
+			reporter.Start(ctl.ControlPlaneVersion(), cmd.Plan)
+		}
+	}
+
 	if cmd.ClusterConfigFile != "" {
-		logger.Warning("NOTE: cluster VPC (subnets, routing & NAT Gateway) configuration changes are not yet implemented")
+		const vpcWarning = "NOTE: cluster VPC (subnets, routing & NAT Gateway) configuration changes are not yet implemented"
+		if reporter != nil {
+			reporter.Warning(vpcWarning)
+		} else {
+			logger.Warning(vpcWarning)
+		}
 	}
 
 	c, err := cluster.New(cfg, ctl)
@@ -80,5 +108,34 @@ func DoUpgradeCluster(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	return c.Upgrade(cmd.Plan)
+	upgradeErr := c.Upgrade(cmd.Plan)
+	if reporter == nil {
+		return upgradeErr
+	}
+
+	if err := ctl.RefreshClusterStatus(cfg); err != nil {
+		reporter.Warning("failed to get the control plane version after the upgrade: %v", err)
+	}
+	if err := cluster.WriteUpgradeReport(reporter.Finish(ctl.ControlPlaneVersion(), upgradeErr), options.ReportPath); err != nil {
+		if upgradeErr != nil {
+			logger.Critical(err.Error())
+			return upgradeErr
+		}
+		return err
+	}
+	logger.Info("upgrade report written to %q", options.ReportPath)
+	return upgradeErr
+}
+
+func newUpgradeReporter(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider) (*cluster.UpgradeReporter, error) {
+	cfg := cmd.ClusterConfig
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Kubernetes client for the upgrade report")
+	}
+	addonManager, err := addon.New(cfg, ctl.Provider.EKS(), ctl.NewStackManager(cfg), api.IsEnabled(cfg.IAM.WithOIDC), nil, nil, cmd.ProviderConfig.WaitTimeout)
+	if err != nil {
+		return nil, err
+	}
+	return cluster.NewUpgradeReporter(cfg, nodegroup.New(cfg, ctl, rawClient.ClientSet()), addonManager, rawClient), nil
 }
@@ -8,13 +8,21 @@ import (
 	. "github.com/onsi/gomega"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/ctltest"
 )
 
 var _ = Describe("upgrade cluster", func() {
 
+	var options UpgradeClusterOptions
+
 	newMockUpgradeClusterCmd := func(args ...string) *ctltest.MockCmd {
-		return ctltest.NewMockCmd(upgradeClusterWithRunFunc, "upgrade", args...)
+		return ctltest.NewMockCmd(func(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+			upgradeClusterWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o UpgradeClusterOptions) error {
+				options = o
+				return runFunc(cmd)
+			})
+		}, "upgrade", args...)
 	}
 
 	Describe("without a config file", func() {
@@ -70,6 +78,14 @@ var _ = Describe("upgrade cluster", func() {
 			Expect(cmd.Cmd.ProviderConfig.Region).To(Equal("us-west-2"))
 			Expect(cmd.Cmd.Plan).To(BeFalse())
 			Expect(cmd.Cmd.ProviderConfig.WaitTimeout).To(Equal(123 * time.Minute))
+			Expect(options.ReportPath).To(BeEmpty())
+		})
+
+		It("accepts the --report-path flag", func() {
+			cmd := newMockUpgradeClusterCmd("cluster", "--name", "clus-1", "--report-path", "upgrade-report.yaml")
+			_, err := cmd.Execute()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(options.ReportPath).To(Equal("upgrade-report.yaml"))
 		})
 	})
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/managed"
@@ -33,6 +34,8 @@ func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&options.KubernetesVersion, "kubernetes-version", "", "Kubernetes version")
 		fs.BoolVar(&options.ForceUpgrade, "force-upgrade", false, "Force the update if the existing node group's pods are unable to be drained due to a pod disruption budget issue")
 		fs.StringVar(&options.ReleaseVersion, "release-version", "", "AMI version of the EKS optimized AMI to use")
+		fs.BoolVar(&options.RollbackOnFailure, "rollback-on-failure", false, "Roll the nodegroup back to its previous launch template version if the upgrade fails")
+		fs.StringSliceVar(&options.PreserveLabels, "preserve-labels", nil, "Keys of the node labels to re-apply to the upgraded nodes as they join, e.g. nvidia.com/*,topology.example.com/rack")
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -68,6 +71,14 @@ func upgradeNodeGroup(cmd *cmdutils.Cmd, options managed.UpgradeOptions) error {
 		return cmdutils.ErrMustBeSet("name")
 	}
 
+	if options.RollbackOnFailure && !cmd.Wait {
+		return errors.New("--rollback-on-failure requires --wait, as failures are only detected while waiting for the upgrade")
+	}
+
+	if len(options.PreserveLabels) > 0 && !cmd.Wait {
+		return errors.New("--preserve-labels requires --wait, as labels are only re-applied while waiting for the upgrade")
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func cleanupIAMServiceAccountsCmd(cmd *cmdutils.Cmd) {
+	cleanupIAMServiceAccountsWithRunFunc(cmd, doCleanupIAMServiceAccounts)
+}
+
+func cleanupIAMServiceAccountsWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, includeUntagged bool) error) {
+	var includeUntagged bool
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("cleanup-iamserviceaccounts", "Delete the IAM roles of iamserviceaccounts whose service accounts no longer exist",
+		"Finds the IAM roles created by eksctl for the iamserviceaccounts of a cluster that no longer have a Kubernetes service account, and deletes their stacks")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return runFunc(cmd, includeUntagged)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&includeUntagged, "include-untagged", false, "also consider the stacks created before eksctl recorded whether a role is role-only, which may hold the roles of service accounts managed by other tools")
+
+		cmd.Wait = false
+		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doCleanupIAMServiceAccounts(cmd *cmdutils.Cmd, includeUntagged bool) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	irsaManager := irsa.New(cfg.Metadata.Name, ctl.NewStackManager(cfg), nil, clientSet)
+	return irsaManager.Cleanup(cmd.Plan, cmd.Wait, includeUntagged)
+}
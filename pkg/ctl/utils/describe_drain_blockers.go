@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func describeDrainBlockersCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var nodeGroupName string
+
+	cmd.SetDescription("describe-drain-blockers", "List the pods whose eviction is blocked by a PodDisruptionBudget", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doDescribeDrainBlockers(cmd, nodeGroupName)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVarP(&nodeGroupName, "nodegroup", "n", "", "Only list the pods running on the nodes of this nodegroup")
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDescribeDrainBlockers(cmd *cmdutils.Cmd, nodeGroupName string) error {
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if cmd.NameArg != "" {
+		return cmdutils.ErrUnsupportedNameArg()
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	listOptions := metav1.ListOptions{}
+	if nodeGroupName != "" {
+		listOptions.LabelSelector = fmt.Sprintf("%s=%s", api.NodeGroupNameLabel, nodeGroupName)
+	}
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+	if err != nil {
+		return err
+	}
+	var nodeNames []string
+	for _, node := range nodes.Items {
+		nodeNames = append(nodeNames, node.Name)
+	}
+
+	blocking, err := drain.FindBlockingPods(clientSet, nodeNames)
+	if err != nil {
+		return err
+	}
+
+	printer := printers.NewTablePrinter()
+	addDrainBlockerColumns(printer.(*printers.TablePrinter))
+	return printer.PrintObjWithKind("pods blocking drains", blocking, os.Stdout)
+}
+
+func addDrainBlockerColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAMESPACE", func(p drain.BlockingPod) string {
+		return p.Namespace
+	})
+	printer.AddColumn("POD", func(p drain.BlockingPod) string {
+		return p.Name
+	})
+	printer.AddColumn("NODE", func(p drain.BlockingPod) string {
+		return p.Node
+	})
+	printer.AddColumn("OWNER", func(p drain.BlockingPod) string {
+		return p.Owner
+	})
+	printer.AddColumn("PDB", func(p drain.BlockingPod) string {
+		return p.PodDisruptionBudget
+	})
+	printer.AddColumn("DISRUPTIONS ALLOWED", func(p drain.BlockingPod) int32 {
+		return p.DisruptionsAllowed
+	})
+	printer.AddColumn("HEALTHY", func(p drain.BlockingPod) string {
+		return fmt.Sprintf("%d/%d", p.CurrentHealthy, p.DesiredHealthy)
+	})
+}
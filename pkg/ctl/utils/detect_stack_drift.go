@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// stackDriftRow is a drifted resource of a stack, as listed in the table output
+type stackDriftRow struct {
+	StackName string
+	manager.DriftedResource
+}
+
+func detectStackDriftCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var output printers.Type
+
+	cmd.SetDescription("detect-stack-drift", "Detect the resources of the CloudFormation stacks of a cluster that drifted from their templates", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doDetectStackDrift(cmd, output)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		fs.StringVarP(&output, "output", "o", printers.TableType, "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDetectStackDrift(cmd *cmdutils.Cmd, output printers.Type) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrFlagAndArg(cmdutils.ClusterNameFlag(cmd), cfg.Metadata.Name, cmd.NameArg)
+	}
+	if cmd.NameArg != "" {
+		cfg.Metadata.Name = cmd.NameArg
+	}
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	if output == printers.TableType {
+		cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+	} else {
+		// keep stdout for the drifts
+		logger.Writer = os.Stderr
+	}
+
+	drifts, err := ctl.NewStackManager(cfg).DetectClusterStacksDrift()
+	if err != nil {
+		return err
+	}
+
+	if output != printers.TableType {
+		return printer.PrintObj(drifts, os.Stdout)
+	}
+
+	var rows []stackDriftRow
+	for _, drift := range drifts {
+		switch {
+		case !drift.Detected():
+			logger.Warning("drift of stack %q cannot be detected: %s", drift.StackName, drift.Reason)
+		case drift.DriftStatus == cloudformation.StackDriftStatusInSync:
+			logger.Info("stack %q is in sync with its template", drift.StackName)
+		}
+		for _, resource := range drift.DriftedResources {
+			rows = append(rows, stackDriftRow{StackName: drift.StackName, DriftedResource: resource})
+		}
+	}
+	if len(rows) == 0 {
+		logger.Info("no drifted resources found")
+		return nil
+	}
+
+	addStackDriftColumns(printer.(*printers.TablePrinter))
+	return printer.PrintObjWithKind("drifted resources", rows, os.Stdout)
+}
+
+func addStackDriftColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("STACK", func(r stackDriftRow) string {
+		return r.StackName
+	})
+	printer.AddColumn("RESOURCE", func(r stackDriftRow) string {
+		return r.LogicalResourceID
+	})
+	printer.AddColumn("TYPE", func(r stackDriftRow) string {
+		return r.ResourceType
+	})
+	printer.AddColumn("PHYSICAL ID", func(r stackDriftRow) string {
+		return r.PhysicalResourceID
+	})
+	printer.AddColumn("DRIFT", func(r stackDriftRow) string {
+		return r.DriftStatus
+	})
+	printer.AddColumn("DIFFERENCES", func(r stackDriftRow) int {
+		return len(r.PropertyDifferences)
+	})
+}
@@ -0,0 +1,100 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+func diffConfigCmd(cmd *cmdutils.Cmd) {
+	diffConfigWithRunFunc(cmd, doDiffConfig)
+}
+
+func diffConfigWithRunFunc(cmd *cmdutils.Cmd, runFunc func(oldConfigFile, newConfigFile string) error) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	cmd.SetDescription("diff-config", "Compare two ClusterConfig files",
+		"Lists the nodegroups, addons, IAM service accounts and other settings that differ between two config files, once their defaults have been set")
+
+	var configFiles []string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if len(configFiles) != 2 {
+			return fmt.Errorf("--config-file must be set twice, to the current and to the proposed config files, e.g. -f old.yaml -f new.yaml")
+		}
+		return runFunc(configFiles[0], configFiles[1])
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringArrayVarP(&configFiles, "config-file", "f", nil, "the current config file followed by the proposed config file")
+	})
+}
+
+func doDiffConfig(oldConfigFile, newConfigFile string) error {
+	if err := api.Register(); err != nil {
+		return err
+	}
+	oldConfig, err := eks.LoadConfigFromFile(oldConfigFile)
+	if err != nil {
+		return err
+	}
+	newConfig, err := eks.LoadConfigFromFile(newConfigFile)
+	if err != nil {
+		return err
+	}
+
+	diff, err := cluster.DiffConfigs(oldConfig, newConfig)
+	if err != nil {
+		return err
+	}
+	return printConfigDiff(diff, os.Stdout)
+}
+
+// printConfigDiff prints the sections of the diff, marking added items with +, removed items with - and
+// changed items with ~ followed by their changed fields
+func printConfigDiff(diff *cluster.ConfigDiff, w io.Writer) error {
+	if diff.IsEmpty() {
+		_, err := fmt.Fprintln(w, "no differences found")
+		return err
+	}
+
+	formatValue := func(value string) string {
+		if value == "" {
+			return "(unset)"
+		}
+		return value
+	}
+	var err error
+	printf := func(format string, args ...interface{}) {
+		if err == nil {
+			_, err = fmt.Fprintf(w, format, args...)
+		}
+	}
+	for _, section := range diff.Sections {
+		printf("%s:\n", section.Name)
+		for _, name := range section.Added {
+			printf("  + %s\n", name)
+		}
+		for _, name := range section.Removed {
+			printf("  - %s\n", name)
+		}
+		for _, item := range section.Changed {
+			indent := "  "
+			if item.Name != "" {
+				printf("  ~ %s\n", item.Name)
+				indent = "      "
+			}
+			for _, change := range item.Changes {
+				printf("%s%s: %s -> %s\n", indent, change.Path, formatValue(change.Old), formatValue(change.New))
+			}
+		}
+	}
+	return err
+}
@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("diff-config", func() {
+	newDiffConfigCmd := func(configFiles *[]string, args ...string) mockVerbCmd {
+		verbCmd := cmdutils.NewVerbCmd("utils", "Various utils", "")
+		verbCmd.SetArgs(append([]string{"diff-config"}, args...))
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), verbCmd, func(cmd *cmdutils.Cmd) {
+			diffConfigWithRunFunc(cmd, func(oldConfigFile, newConfigFile string) error {
+				*configFiles = []string{oldConfigFile, newConfigFile}
+				return nil
+			})
+		})
+		return mockVerbCmd{parentCmd: verbCmd}
+	}
+
+	It("requires two config files", func() {
+		var configFiles []string
+		_, err := newDiffConfigCmd(&configFiles, "-f", "old.yaml").execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set twice")))
+
+		_, err = newDiffConfigCmd(&configFiles, "-f", "old.yaml", "-f", "new.yaml", "-f", "other.yaml").execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set twice")))
+
+		_, err = newDiffConfigCmd(&configFiles, "-f", "old.yaml", "--config-file", "new.yaml").execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configFiles).To(Equal([]string{"old.yaml", "new.yaml"}))
+	})
+
+	Describe("printConfigDiff", func() {
+		It("prints the added, removed and changed items", func() {
+			diff := &cluster.ConfigDiff{
+				Sections: []cluster.ConfigDiffSection{
+					{
+						Name:    "nodeGroups",
+						Added:   []string{"ng-3"},
+						Removed: []string{"ng-1"},
+						Changed: []cluster.ChangedItem{
+							{
+								Name:    "ng-2",
+								Changes: []cluster.FieldChange{{Path: "desiredCapacity", New: "4"}},
+							},
+						},
+					},
+					{
+						Name: "other",
+						Changed: []cluster.ChangedItem{
+							{
+								Changes: []cluster.FieldChange{{Path: "vpc.nat.gateway", Old: `"Single"`, New: `"HighlyAvailable"`}},
+							},
+						},
+					},
+				},
+			}
+
+			var out bytes.Buffer
+			Expect(printConfigDiff(diff, &out)).To(Succeed())
+			Expect(out.String()).To(Equal(`nodeGroups:
+  + ng-3
+  - ng-1
+  ~ ng-2
+      desiredCapacity: (unset) -> 4
+other:
+  vpc.nat.gateway: "Single" -> "HighlyAvailable"
+`))
+		})
+
+		It("reports configs without differences", func() {
+			var out bytes.Buffer
+			Expect(printConfigDiff(&cluster.ConfigDiff{}, &out)).To(Succeed())
+			Expect(out.String()).To(Equal("no differences found\n"))
+		})
+	})
+})
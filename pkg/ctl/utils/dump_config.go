@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/addon"
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	"github.com/weaveworks/eksctl/pkg/actions/irsa"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func dumpConfigCmd(cmd *cmdutils.Cmd) {
+	dumpConfigWithRunFunc(cmd, doDumpConfig)
+}
+
+func dumpConfigWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("dump-config", "Generate a best-effort ClusterConfig from a live cluster", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return runFunc(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doDumpConfig(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	// log warnings and errors to stderr
+	logger.Writer = os.Stderr
+
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	addonManager, err := addon.New(cfg, ctl.Provider.EKS(), stackManager, api.IsEnabled(cfg.IAM.WithOIDC), nil, nil, cmd.ProviderConfig.WaitTimeout)
+	if err != nil {
+		return err
+	}
+
+	dumper := cluster.NewConfigDumper(cfg, ctl.Provider.EKS(), ctl.Provider.EC2(),
+		stackManager,
+		addonManager,
+		irsa.New(cfg.Metadata.Name, stackManager, nil, nil),
+	)
+	dumped, err := dumper.Dump()
+	if err != nil {
+		return err
+	}
+	return printDumpedConfig(dumped, os.Stdout)
+}
+
+// printDumpedConfig prints the config as YAML, preceded by a comment listing the fields that could not be recovered
+func printDumpedConfig(dumped *cluster.DumpedConfig, w io.Writer) error {
+	header := fmt.Sprintf("# This config was generated from cluster %q on a best-effort basis.\n", dumped.ClusterConfig.Metadata.Name)
+	if len(dumped.Unrecovered) > 0 {
+		header += "# The following could not be fully recovered and should be reviewed before using it:\n"
+		for _, field := range dumped.Unrecovered {
+			header += fmt.Sprintf("#   - %s\n", field)
+		}
+	}
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+	return printers.NewYAMLPrinter().PrintObj(dumped.ClusterConfig, w)
+}
@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("dump-config", func() {
+	It("accepts the cluster name as a flag or an argument", func() {
+		for _, args := range [][]string{{"--cluster", "my-cluster"}, {"my-cluster"}} {
+			count := 0
+			verbCmd := cmdutils.NewVerbCmd("utils", "Various utils", "")
+			verbCmd.SetArgs(append([]string{"dump-config"}, args...))
+			cmdutils.AddResourceCmd(cmdutils.NewGrouping(), verbCmd, func(cmd *cmdutils.Cmd) {
+				dumpConfigWithRunFunc(cmd, func(cmd *cmdutils.Cmd) error {
+					Expect(cmd.ClusterConfig.Metadata.Name + cmd.NameArg).To(Equal("my-cluster"))
+					count++
+					return nil
+				})
+			})
+			_, err := mockVerbCmd{parentCmd: verbCmd}.execute()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(1))
+		}
+	})
+
+	It("prints the fields that could not be recovered as a comment before the config", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		out := new(bytes.Buffer)
+		Expect(printDumpedConfig(&cluster.DumpedConfig{
+			ClusterConfig: cfg,
+			Unrecovered:   []string{"nodeGroups[ng-1]: volumes were not recovered"},
+		}, out)).To(Succeed())
+
+		Expect(out.String()).To(HavePrefix(`# This config was generated from cluster "my-cluster" on a best-effort basis.
+# The following could not be fully recovered and should be reviewed before using it:
+#   - nodeGroups[ng-1]: volumes were not recovered
+`))
+		Expect(out.String()).To(ContainSubstring("kind: ClusterConfig"))
+	})
+})
@@ -72,6 +72,10 @@ func doEnableSecretsEncryption(cmd *cmdutils.Cmd, encryptExistingSecrets bool) e
 		return err
 	}
 
+	if err := eks.ResolveKMSKey(ctl.Provider.KMS(), clusterConfig); err != nil {
+		return err
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), ctl.Provider.WaitTimeout())
 	defer cancel()
 
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+func generateIAMPolicyCmd(cmd *cmdutils.Cmd) {
+	generateIAMPolicyWithRunFunc(cmd, doGenerateIAMPolicy)
+}
+
+func generateIAMPolicyWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, operation string) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("generate-iam-policy", "Generate the IAM policy eksctl requires for an operation on a ClusterConfig",
+		"Prints a JSON IAM policy allowing the AWS API actions eksctl and CloudFormation make on behalf of the caller to carry out the operation, based on the features the config uses")
+
+	var operation string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if cmd.ClusterConfigFile == "" {
+			return cmdutils.ErrMustBeSet("--config-file")
+		}
+		return runFunc(cmd, operation)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&cmd.ProviderConfig.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf, which the policy allows passing to CloudFormation")
+		fs.StringVar(&operation, "operation", eks.IAMPolicyOperationCreate, fmt.Sprintf("operation to generate the policy for, one of: %s", strings.Join(eks.IAMPolicyOperations(), ", ")))
+	})
+}
+
+func doGenerateIAMPolicy(cmd *cmdutils.Cmd, operation string) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+	return printIAMPolicy(cmd.ClusterConfig, operation, cmd.ProviderConfig.CloudFormationRoleARN, os.Stdout)
+}
+
+// printIAMPolicy prints the IAM policy of the operation on the cluster of the config as indented JSON
+func printIAMPolicy(cfg *api.ClusterConfig, operation, cloudFormationRoleARN string, w io.Writer) error {
+	policy, err := eks.GenerateIAMPolicy(cfg, operation, cloudFormationRoleARN)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
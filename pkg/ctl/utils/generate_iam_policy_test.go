@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("generate-iam-policy", func() {
+	newGenerateIAMPolicyCmd := func(operation *string, args ...string) mockVerbCmd {
+		verbCmd := cmdutils.NewVerbCmd("utils", "Various utils", "")
+		verbCmd.SetArgs(append([]string{"generate-iam-policy"}, args...))
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), verbCmd, func(cmd *cmdutils.Cmd) {
+			generateIAMPolicyWithRunFunc(cmd, func(cmd *cmdutils.Cmd, o string) error {
+				Expect(cmd.ClusterConfigFile).To(Equal("cluster.yaml"))
+				*operation = o
+				return nil
+			})
+		})
+		return mockVerbCmd{parentCmd: verbCmd}
+	}
+
+	It("generates the policy for creating the cluster by default", func() {
+		var operation string
+		_, err := newGenerateIAMPolicyCmd(&operation, "-f", "cluster.yaml").execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation).To(Equal(eks.IAMPolicyOperationCreate))
+
+		_, err = newGenerateIAMPolicyCmd(&operation, "-f", "cluster.yaml", "--operation", "delete").execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation).To(Equal(eks.IAMPolicyOperationDelete))
+	})
+
+	It("requires a config file", func() {
+		var operation string
+		_, err := newGenerateIAMPolicyCmd(&operation).execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set")))
+	})
+
+	It("prints the policy as JSON", func() {
+		cfg := api.NewClusterConfig()
+		cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: "arn:aws:kms:us-west-2:123456789012:key/1"}
+		out := new(bytes.Buffer)
+		Expect(printIAMPolicy(cfg, eks.IAMPolicyOperationCreate, "", out)).To(Succeed())
+
+		var policy eks.IAMPolicyDocument
+		Expect(json.Unmarshal(out.Bytes(), &policy)).To(Succeed())
+		Expect(policy.Actions()).To(ContainElement("kms:CreateGrant"))
+
+		Expect(printIAMPolicy(cfg, "scale", "", out)).To(MatchError(ContainSubstring(`invalid operation "scale"`)))
+	})
+})
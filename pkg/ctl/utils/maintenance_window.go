@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"github.com/spf13/pflag"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+)
+
+// maintenanceWindowOptions are the flags of the commands updating the default add-ons
+type maintenanceWindowOptions struct {
+	window string
+	force  bool
+}
+
+func (o *maintenanceWindowOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.window, "maintenance-window", "", "only apply the updates within this daily window, in UTC and of the form HH:MM-HH:MM (e.g. 22:00-02:00)")
+	fs.BoolVar(&o.force, "force", false, "apply the updates outside of the maintenance window")
+}
+
+// maintenanceWindow returns the window to check the updates against, which is nil when
+// the updates are forced or no window is set
+func (o *maintenanceWindowOptions) maintenanceWindow() (*defaultaddons.MaintenanceWindow, error) {
+	if o.window == "" || o.force {
+		return nil, nil
+	}
+	return defaultaddons.ParseMaintenanceWindow(o.window)
+}
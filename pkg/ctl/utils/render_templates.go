@@ -0,0 +1,222 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/amazon-ec2-instance-selector/v2/pkg/selector"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// renderedStacksFile lists the rendered stacks in the order they have to be created
+const renderedStacksFile = "stacks.json"
+
+func renderTemplatesCmd(cmd *cmdutils.Cmd) {
+	renderTemplatesWithRunFunc(cmd, doRenderTemplates)
+}
+
+func renderTemplatesWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd, outputDir string) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("render-templates", "Render the CloudFormation templates of a ClusterConfig without creating anything",
+		"Writes the templates of the cluster, nodegroup and iamserviceaccount stacks eksctl would create, resolving availability zones, subnets and AMIs with read-only AWS API calls, "+
+			fmt.Sprintf("along with a %s file listing the stacks in the order they have to be created, with their tags and capabilities", renderedStacksFile))
+
+	var outputDir string
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if cmd.ClusterConfigFile == "" {
+			return cmdutils.ErrMustBeSet("--config-file")
+		}
+		if outputDir == "" {
+			return cmdutils.ErrMustBeSet("--output-dir")
+		}
+		return runFunc(cmd, outputDir)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		fs.StringVar(&outputDir, "output-dir", "", "directory to write the templates to, it is created if it does not exist")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doRenderTemplates(cmd *cmdutils.Cmd, outputDir string) error {
+	if err := cmdutils.NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, &cmdutils.CreateClusterCmdParams{}).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Metadata.Version == "" || cfg.Metadata.Version == "auto" {
+		cfg.Metadata.Version = api.DefaultVersion
+	}
+	if !api.IsSupportedVersion(cfg.Metadata.Version) {
+		return fmt.Errorf("unsupported Kubernetes version %s", cfg.Metadata.Version)
+	}
+	if err := eks.ValidateFeatureCompatibility(cfg, cmdutils.ToKubeNodeGroups(cfg)); err != nil {
+		return err
+	}
+	if err := eks.ResolveKMSKey(ctl.Provider.KMS(), cfg); err != nil {
+		return err
+	}
+
+	if cfg.HasAnySubnets() {
+		if len(cfg.VPC.SecondaryCIDRs) > 0 {
+			return api.ErrSecondaryCIDRsWithExistingVPC
+		}
+		if err := vpc.ImportSubnetsFromSpec(ctl.Provider, cfg); err != nil {
+			return err
+		}
+		if err := cfg.HasSufficientSubnets(); err != nil {
+			return err
+		}
+		if err := cfg.CanUseForPrivateNodeGroups(); err != nil {
+			return err
+		}
+	} else {
+		if cfg.VPC.CustomNetworking != nil && len(cfg.VPC.SecondaryCIDRs) == 0 {
+			return errors.New("vpc.customNetworking requires an existing VPC, as the pod subnets must exist before the cluster is created, or vpc.secondaryCIDRs for eksctl to create them")
+		}
+		if err := ctl.SetAvailabilityZones(cfg, nil); err != nil {
+			return err
+		}
+		if err := vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones); err != nil {
+			return err
+		}
+	}
+	if err := cfg.ValidateServiceIPv4CIDR(); err != nil {
+		return err
+	}
+
+	nodeGroupService := eks.NewNodeGroupService(ctl.Provider, selector.New(ctl.Provider.Session()))
+	nodePools := cmdutils.ToNodePools(cfg)
+	if err := nodeGroupService.ExpandInstanceSelectorOptions(nodePools, cfg.AvailabilityZones); err != nil {
+		return err
+	}
+	if err := nodeGroupService.NormalizeWithoutImportingKeys(nodePools, cfg.Metadata); err != nil {
+		return err
+	}
+
+	if err := refreshStatusOfExistingCluster(ctl, cfg); err != nil {
+		return err
+	}
+	oidc, err := newOIDCManagerOfExistingCluster(ctl, cfg)
+	if err != nil {
+		return err
+	}
+
+	supportsManagedNodes, err := eks.VersionSupportsManagedNodes(cfg.Metadata.Version)
+	if err != nil {
+		return err
+	}
+	templates, err := ctl.NewStackManager(cfg).RenderClusterTemplates(supportsManagedNodes, oidc)
+	if err != nil {
+		return err
+	}
+	if err := writeTemplates(templates, outputDir); err != nil {
+		return err
+	}
+	logger.Success("rendered the templates of %d stack(s) for cluster %q to %q", len(templates), cfg.Metadata.Name, outputDir)
+	return nil
+}
+
+// refreshStatusOfExistingCluster sets the endpoint and certificate authority of the cluster in its status
+// if it already exists, as the user data of the nodegroups embeds them
+func refreshStatusOfExistingCluster(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	err := ctl.RefreshClusterStatus(cfg)
+	if err == nil {
+		return nil
+	}
+	if awsError, ok := errors.Cause(err).(awserr.Error); ok && awsError.Code() == awseks.ErrCodeResourceNotFoundException {
+		logger.Info("cluster %q does not exist yet, the user data of its nodegroups will reference its endpoint and certificate authority as parameters of their templates", cfg.Metadata.Name)
+		return nil
+	}
+	return err
+}
+
+// newOIDCManagerOfExistingCluster returns the OIDC manager of the cluster if it already exists and has
+// iamserviceaccounts, whose roles trust its OIDC issuer
+func newOIDCManagerOfExistingCluster(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) (*iamoidc.OpenIDConnectManager, error) {
+	if cfg.Status == nil || cfg.Status.Endpoint == "" || len(cfg.IAM.ServiceAccounts) == 0 {
+		return nil, nil
+	}
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	exists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		logger.Warning("no IAM OIDC provider associated with cluster, it must be associated with 'eksctl utils associate-iam-oidc-provider --region=%s --cluster=%s' before the iamserviceaccount stacks are created", cfg.Metadata.Region, cfg.Metadata.Name)
+	}
+	return oidc, nil
+}
+
+type renderedStack struct {
+	StackName    string            `json:"stackName"`
+	TemplateFile string            `json:"templateFile"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Capabilities []string          `json:"capabilities,omitempty"`
+	// Parameters maps the parameters of the template to the outputs of the cluster stack they must be set to
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// writeTemplates writes each template to a file named after its stack, and the list of the stacks to renderedStacksFile
+func writeTemplates(templates []manager.RenderedTemplate, outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return errors.Wrapf(err, "creating output directory %q", outputDir)
+	}
+
+	stacks := []renderedStack{}
+	for _, template := range templates {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, template.Template, "", "  "); err != nil {
+			return errors.Wrapf(err, "formatting template of stack %q", template.StackName)
+		}
+		buf.WriteString("\n")
+
+		templateFile := template.StackName + ".json"
+		if err := os.WriteFile(filepath.Join(outputDir, templateFile), buf.Bytes(), 0644); err != nil {
+			return errors.Wrapf(err, "writing template of stack %q", template.StackName)
+		}
+		logger.Info("wrote template of stack %q to %q", template.StackName, filepath.Join(outputDir, templateFile))
+
+		stacks = append(stacks, renderedStack{
+			StackName:    template.StackName,
+			TemplateFile: templateFile,
+			Tags:         template.Tags,
+			Capabilities: template.Capabilities,
+			Parameters:   template.Parameters,
+		})
+	}
+
+	data, err := json.MarshalIndent(stacks, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(outputDir, renderedStacksFile), append(data, '\n'), 0644)
+}
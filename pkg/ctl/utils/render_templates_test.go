@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("render-templates", func() {
+	newRenderTemplatesCmd := func(outputDir *string, args ...string) mockVerbCmd {
+		verbCmd := cmdutils.NewVerbCmd("utils", "Various utils", "")
+		verbCmd.SetArgs(append([]string{"render-templates"}, args...))
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), verbCmd, func(cmd *cmdutils.Cmd) {
+			renderTemplatesWithRunFunc(cmd, func(cmd *cmdutils.Cmd, dir string) error {
+				Expect(cmd.ClusterConfigFile).To(Equal("cluster.yaml"))
+				*outputDir = dir
+				return nil
+			})
+		})
+		return mockVerbCmd{parentCmd: verbCmd}
+	}
+
+	It("requires a config file and an output directory", func() {
+		var outputDir string
+		_, err := newRenderTemplatesCmd(&outputDir, "--output-dir", "cfn").execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set")))
+
+		_, err = newRenderTemplatesCmd(&outputDir, "-f", "cluster.yaml").execute()
+		Expect(err).To(MatchError(ContainSubstring("--output-dir must be set")))
+
+		_, err = newRenderTemplatesCmd(&outputDir, "-f", "cluster.yaml", "--output-dir", "cfn").execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(outputDir).To(Equal("cfn"))
+	})
+
+	Describe("refreshStatusOfExistingCluster", func() {
+		var (
+			p   *mockprovider.MockProvider
+			ctl *eks.ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &eks.ClusterProvider{Provider: p, Status: &eks.ProviderStatus{}}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "render"
+		})
+
+		It("sets the endpoint and certificate authority of an existing cluster", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{
+				Cluster: &awseks.Cluster{
+					Name:                 aws.String("render"),
+					Arn:                  aws.String("arn:aws:eks:us-west-2:123456789012:cluster/render"),
+					Status:               aws.String(awseks.ClusterStatusActive),
+					Endpoint:             aws.String("https://render.eks.amazonaws.com"),
+					CertificateAuthority: &awseks.Certificate{Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca")))},
+				},
+			}, nil)
+			Expect(refreshStatusOfExistingCluster(ctl, cfg)).To(Succeed())
+			Expect(cfg.Status.Endpoint).To(Equal("https://render.eks.amazonaws.com"))
+			Expect(cfg.Status.CertificateAuthorityData).To(Equal([]byte("ca")))
+		})
+
+		It("leaves the status unset when the cluster does not exist yet", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "not found", nil))
+			Expect(refreshStatusOfExistingCluster(ctl, cfg)).To(Succeed())
+			Expect(cfg.Status).To(BeNil())
+		})
+
+		It("returns other errors", func() {
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(nil, fmt.Errorf("access denied"))
+			Expect(refreshStatusOfExistingCluster(ctl, cfg)).To(MatchError(ContainSubstring("access denied")))
+		})
+	})
+
+	Describe("writeTemplates", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "render-templates")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpDir)).To(Succeed())
+		})
+
+		It("writes the templates and the list of stacks", func() {
+			outputDir := filepath.Join(tmpDir, "cfn")
+			templates := []manager.RenderedTemplate{
+				{
+					StackName:    "eksctl-test-cluster",
+					Template:     []byte(`{"Resources":{"ControlPlane":{"Type":"AWS::EKS::Cluster"}}}`),
+					Tags:         map[string]string{"alpha.eksctl.io/cluster-name": "test"},
+					Capabilities: []string{"CAPABILITY_IAM"},
+				},
+				{
+					StackName: "eksctl-test-nodegroup-ng-1",
+					Template:  []byte(`{"Resources":{"ManagedNodeGroup":{"Type":"AWS::EKS::Nodegroup"}}}`),
+				},
+			}
+			Expect(writeTemplates(templates, outputDir)).To(Succeed())
+
+			data, err := ioutil.ReadFile(filepath.Join(outputDir, "eksctl-test-cluster.json"))
+			Expect(err).NotTo(HaveOccurred())
+			var template map[string]interface{}
+			Expect(json.Unmarshal(data, &template)).To(Succeed())
+			Expect(template).To(HaveKey("Resources"))
+			Expect(template["Resources"]).To(HaveKey("ControlPlane"))
+			Expect(filepath.Join(outputDir, "eksctl-test-nodegroup-ng-1.json")).To(BeARegularFile())
+
+			data, err = ioutil.ReadFile(filepath.Join(outputDir, renderedStacksFile))
+			Expect(err).NotTo(HaveOccurred())
+			var stacks []renderedStack
+			Expect(json.Unmarshal(data, &stacks)).To(Succeed())
+			Expect(stacks).To(Equal([]renderedStack{
+				{
+					StackName:    "eksctl-test-cluster",
+					TemplateFile: "eksctl-test-cluster.json",
+					Tags:         map[string]string{"alpha.eksctl.io/cluster-name": "test"},
+					Capabilities: []string{"CAPABILITY_IAM"},
+				},
+				{
+					StackName:    "eksctl-test-nodegroup-ng-1",
+					TemplateFile: "eksctl-test-nodegroup-ng-1.json",
+				},
+			}))
+		})
+
+		It("rejects a template that is not valid JSON", func() {
+			templates := []manager.RenderedTemplate{{StackName: "eksctl-test-cluster", Template: []byte(`{"Resources":`)}}
+			Expect(writeTemplates(templates, tmpDir)).To(MatchError(ContainSubstring(`formatting template of stack "eksctl-test-cluster"`)))
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func rotateNodesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		nodeGroupName string
+		options       nodegroup.RotateNodesOptions
+	)
+
+	cmd.SetDescription("rotate-nodes", "Drain and replace the nodes of a nodegroup older than a maximum age", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doRotateNodes(cmd, nodeGroupName, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVarP(&nodeGroupName, "nodegroup", "n", "", "Name of the nodegroup whose nodes are rotated")
+		fs.DurationVar(&options.MaxAge, "max-age", 0, "Rotate the nodes created more than this duration ago, e.g. 720h")
+		fs.IntVar(&options.MaxUnavailable, "max-unavailable", 1, "Number of nodes drained and terminated at a time")
+		fs.DurationVar(&options.MaxGracePeriod, "max-grace-period", 10*time.Minute, "Maximum pods termination grace period")
+		fs.BoolVar(&options.DisableEviction, "disable-eviction", false, "Force drain to use delete, even if eviction is supported. This will bypass checking PodDisruptionBudgets, use with caution.")
+		fs.StringSliceVar(&options.PreserveLabels, "preserve-labels", nil, "Keys of the node labels to re-apply to the replacement nodes as they join, e.g. nvidia.com/*,topology.example.com/rack")
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doRotateNodes(cmd *cmdutils.Cmd, nodeGroupName string, options nodegroup.RotateNodesOptions) error {
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if cmd.NameArg != "" {
+		return cmdutils.ErrUnsupportedNameArg()
+	}
+	if nodeGroupName == "" {
+		return cmdutils.ErrMustBeSet("--nodegroup")
+	}
+	if options.MaxAge <= 0 {
+		return cmdutils.ErrMustBeSet("--max-age")
+	}
+	if options.MaxUnavailable < 1 {
+		return fmt.Errorf("--max-unavailable must be at least 1")
+	}
+	options.Plan = cmd.Plan
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	ng := api.NewNodeGroup()
+	ng.Name = nodeGroupName
+	return nodegroup.New(cfg, ctl, clientSet).RotateNodes(ng, options)
+}
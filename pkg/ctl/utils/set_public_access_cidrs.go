@@ -1,6 +1,9 @@
 package utils
 
 import (
+	"net"
+	"time"
+
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -9,11 +12,21 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
-func publicAccessCIDRsCmdWithHandler(cmd *cmdutils.Cmd, handler func(cmd *cmdutils.Cmd) error) {
+// publicAccessCIDRsOptions makes sure that this machine does not lose access to the Kubernetes API
+type publicAccessCIDRsOptions struct {
+	verifyCallerAccess   bool
+	callerCIDR           string
+	rollbackOnLostAccess bool
+	accessCheckTimeout   time.Duration
+}
+
+func publicAccessCIDRsCmdWithHandler(cmd *cmdutils.Cmd, handler func(cmd *cmdutils.Cmd, options publicAccessCIDRsOptions) error) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
+	var options publicAccessCIDRsOptions
 
 	cmd.SetDescription("set-public-access-cidrs", "Update public access CIDRs", "CIDR blocks that EKS uses to create a security group on the public endpoint")
 
@@ -22,7 +35,7 @@ func publicAccessCIDRsCmdWithHandler(cmd *cmdutils.Cmd, handler func(cmd *cmduti
 		if err := cmdutils.NewUtilsPublicAccessCIDRsLoader(cmd).Load(); err != nil {
 			return err
 		}
-		return handler(cmd)
+		return handler(cmd, options)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -33,6 +46,13 @@ func publicAccessCIDRsCmdWithHandler(cmd *cmdutils.Cmd, handler func(cmd *cmduti
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
+	cmd.FlagSetGroup.InFlagSet("Access Checks", func(fs *pflag.FlagSet) {
+		fs.BoolVar(&options.verifyCallerAccess, "verify-caller-access", false, "check that the new CIDRs include the public IP address of this machine, or --caller-cidr, before updating them")
+		fs.StringVar(&options.callerCIDR, "caller-cidr", "", "CIDR that the Kubernetes API is reached from, e.g. that of a bastion host, checked by --verify-caller-access instead of the public IP address of this machine")
+		fs.BoolVar(&options.rollbackOnLostAccess, "rollback-on-lost-access", false, "restore the current CIDRs if the Kubernetes API endpoint cannot be reached from this machine after the update")
+		fs.DurationVar(&options.accessCheckTimeout, "access-check-timeout", 3*time.Minute, "time the Kubernetes API endpoint has to become reachable after the update with --rollback-on-lost-access")
+	})
+
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
@@ -40,10 +60,19 @@ func publicAccessCIDRsCmd(cmd *cmdutils.Cmd) {
 	publicAccessCIDRsCmdWithHandler(cmd, doUpdatePublicAccessCIDRs)
 }
 
-func doUpdatePublicAccessCIDRs(cmd *cmdutils.Cmd) error {
+func doUpdatePublicAccessCIDRs(cmd *cmdutils.Cmd, options publicAccessCIDRsOptions) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
+	if options.callerCIDR != "" {
+		if !options.verifyCallerAccess {
+			return errors.New("--caller-cidr can only be used with --verify-caller-access")
+		}
+		if _, _, err := net.ParseCIDR(options.callerCIDR); err != nil {
+			return errors.Wrap(err, "invalid --caller-cidr")
+		}
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -67,12 +96,32 @@ func doUpdatePublicAccessCIDRs(cmd *cmdutils.Cmd) error {
 		return nil
 	}
 
+	if options.verifyCallerAccess {
+		if err := eks.CheckPublicAccessCIDRs(cfg.VPC.PublicAccessCIDRs, options.callerCIDR, ctl.DetectCallerIP); err != nil {
+			return err
+		}
+	}
+
 	cmdutils.LogIntendedAction(
 		cmd.Plan, "update Public Endpoint Restrictions for cluster %q in %q to: %v",
 		meta.Name, meta.Region, cfg.VPC.PublicAccessCIDRs)
 
 	if !cmd.Plan {
-		if err := ctl.UpdatePublicAccessCIDRs(cfg); err != nil {
+		desired := cfg.VPC.PublicAccessCIDRs
+		update := func(cidrs []string) error {
+			cfg.VPC.PublicAccessCIDRs = cidrs
+			return ctl.UpdatePublicAccessCIDRs(cfg)
+		}
+		if options.rollbackOnLostAccess {
+			checkAccess, err := ctl.NewAPIAccessCheck(cfg.Status)
+			if err != nil {
+				return err
+			}
+			err = eks.UpdatePublicAccessCIDRsWithRollback(update, clusterVPCConfig.PublicAccessCIDRs, desired, checkAccess, options.accessCheckTimeout, 10*time.Second)
+			if err != nil {
+				return errors.Wrap(err, "error updating CIDRs for public access")
+			}
+		} else if err := update(desired); err != nil {
 			return errors.Wrap(err, "error updating CIDRs for public access")
 		}
 		cmdutils.LogCompletedAction(
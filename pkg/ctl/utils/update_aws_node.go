@@ -15,9 +15,11 @@ func updateAWSNodeCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("update-aws-node", "Update aws-node add-on to latest released version", "")
 
+	var windowOptions maintenanceWindowOptions
+
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doUpdateAWSNode(cmd)
+		return doUpdateAWSNode(cmd, windowOptions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -25,13 +27,14 @@ func updateAWSNodeCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddApproveFlag(fs, cmd)
+		windowOptions.addFlags(fs)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doUpdateAWSNode(cmd *cmdutils.Cmd) error {
+func doUpdateAWSNode(cmd *cmdutils.Cmd, windowOptions maintenanceWindowOptions) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -39,6 +42,11 @@ func doUpdateAWSNode(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
+	maintenanceWindow, err := windowOptions.maintenanceWindow()
+	if err != nil {
+		return err
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -54,7 +62,12 @@ func doUpdateAWSNode(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	updateRequired, err := defaultaddons.UpdateAWSNode(rawClient, meta.Region, cmd.Plan)
+	awsNode := defaultaddons.NewAWSNode(defaultaddons.AddonInput{
+		RawClient:         rawClient,
+		ClusterConfig:     cfg,
+		MaintenanceWindow: maintenanceWindow,
+	})
+	updateRequired, err := awsNode.Update(cmd.Plan)
 	if err != nil {
 		return err
 	}
@@ -1,17 +1,23 @@
 package utils
 
 import (
+	"errors"
+	"net"
+
 	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
 )
 
 var (
 	private bool
 	public  bool
+	staged  bool
+	force   bool
 )
 
 func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
@@ -21,7 +27,7 @@ func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
 	cmd.SetDescription("update-cluster-endpoints", "Update Kubernetes API endpoint access configuration", "")
 
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
-		return doUpdateClusterEndpoints(cmd, private, public)
+		return doUpdateClusterEndpoints(cmd, private, public, staged, force)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -36,11 +42,13 @@ func updateClusterEndpointsCmd(cmd *cmdutils.Cmd) {
 		func(fs *pflag.FlagSet) {
 			fs.BoolVar(&private, "private-access", false, "access for private (VPC) clients")
 			fs.BoolVar(&public, "public-access", false, "access for public clients")
+			fs.BoolVar(&staged, "staged", false, "when disabling public access, enable private access first and check that the private endpoint can be reached before public access is disabled")
+			fs.BoolVar(&force, "force", false, "disable public access without checking that the private endpoint can be reached from this machine")
 		})
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool) error {
+func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate, newPublic, staged, force bool) error {
 	if err := cmdutils.NewUtilsEnableEndpointAccessLoader(cmd, newPrivate, newPublic).Load(); err != nil {
 		return err
 	}
@@ -89,10 +97,6 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 	cfg.VPC.ClusterEndpoints.PrivateAccess = &newPrivate
 	cfg.VPC.ClusterEndpoints.PublicAccess = &newPublic
 
-	cmdutils.LogIntendedAction(
-		cmd.Plan, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v",
-		meta.Name, meta.Region, newPrivate, newPublic)
-
 	if err := cfg.ValidateClusterEndpointConfig(); err != nil {
 		return err
 	}
@@ -102,15 +106,40 @@ func doUpdateClusterEndpoints(cmd *cmdutils.Cmd, newPrivate bool, newPublic bool
 		logger.Warning(api.ErrClusterEndpointPrivateOnly.Error())
 	}
 
-	if !cmd.Plan {
-		if err := ctl.UpdateClusterConfigForEndpoints(cfg); err != nil {
-			return err
+	disablesPublic := curPublic && !newPublic
+	if disablesPublic && !curPrivate && !staged && !force {
+		return errors.New("disabling public access before private access has been enabled could lock out this machine; " +
+			"use --staged to enable private access first and check that the private endpoint can be reached, or --force to update anyway")
+	}
+
+	steps := eks.EndpointAccessSteps(*clusterVPCConfig.ClusterEndpoints, *cfg.VPC.ClusterEndpoints, staged)
+	for i, step := range steps {
+		stepPrivate, stepPublic := *step.PrivateAccess, *step.PublicAccess
+
+		if disablesPublic && !stepPublic && !force {
+			privateEnabled := curPrivate || i > 0
+			if cmd.Plan && !privateEnabled {
+				cmdutils.LogIntendedAction(cmd.Plan, "check that the private Kubernetes API endpoint of cluster %q can be reached from this machine", meta.Name)
+			} else if err := ctl.CheckPrivateEndpointAccess(cfg, net.LookupIP); err != nil {
+				return err
+			}
+		}
+
+		cmdutils.LogIntendedAction(
+			cmd.Plan, "update Kubernetes API endpoint access for cluster %q in %q to: privateAccess=%v, publicAccess=%v",
+			meta.Name, meta.Region, stepPrivate, stepPublic)
+
+		if !cmd.Plan {
+			cfg.VPC.ClusterEndpoints = &steps[i]
+			if err := ctl.UpdateClusterConfigForEndpoints(cfg); err != nil {
+				return err
+			}
+			cmdutils.LogCompletedAction(
+				false,
+				"the Kubernetes API endpoint access for cluster %q in %q has been updated to: "+
+					"privateAccess=%v, publicAccess=%v",
+				meta.Name, meta.Region, stepPrivate, stepPublic)
 		}
-		cmdutils.LogCompletedAction(
-			false,
-			"the Kubernetes API endpoint access for cluster %q in %q has been updated to: "+
-				"privateAccess=%v, publicAccess=%v",
-			meta.Name, meta.Region, newPrivate, newPublic)
 	}
 	cmdutils.LogPlanModeWarning(cmd.Plan)
 
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+func updateClusterUpgradePolicyCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var supportType string
+
+	cmd.SetDescription("update-cluster-upgrade-policy", "Update the upgrade policy (support type) of a cluster", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, _ []string) error {
+		if err := cmdutils.NewUtilsUpgradePolicyLoader(cmd, supportType).Load(); err != nil {
+			return err
+		}
+		return doUpdateClusterUpgradePolicy(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVar(&supportType, "support-type", "", "What happens when the Kubernetes version of the cluster reaches the end of standard support (STANDARD or EXTENDED)")
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateClusterUpgradePolicy(cmd *cmdutils.Cmd) error {
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	current, err := ctl.GetClusterUpgradePolicy(meta.Name)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		logger.Info("current support type: %s", current.SupportType)
+		if current.SupportType == cfg.UpgradePolicy.SupportType {
+			logger.Success("upgrade policy for cluster %q in %q is already up to date", meta.Name, meta.Region)
+			return nil
+		}
+	}
+
+	eks.LogExtendedSupportCost(cfg)
+
+	cmdutils.LogIntendedAction(
+		cmd.Plan, "update upgrade policy for cluster %q in %q to: supportType=%s",
+		meta.Name, meta.Region, cfg.UpgradePolicy.SupportType)
+
+	if !cmd.Plan {
+		if err := ctl.UpdateClusterUpgradePolicy(cfg); err != nil {
+			return errors.Wrap(err, "error updating upgrade policy")
+		}
+		cmdutils.LogCompletedAction(
+			false,
+			"upgrade policy for cluster %q in %q has been updated to: supportType=%s",
+			meta.Name, meta.Region, cfg.UpgradePolicy.SupportType)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
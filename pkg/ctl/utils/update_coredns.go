@@ -15,9 +15,11 @@ func updateCoreDNSCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("update-coredns", "Update coredns add-on to ensure image matches the standard Amazon EKS version", "")
 
+	var windowOptions maintenanceWindowOptions
+
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doUpdateCoreDNS(cmd)
+		return doUpdateCoreDNS(cmd, windowOptions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -25,13 +27,14 @@ func updateCoreDNSCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddApproveFlag(fs, cmd)
+		windowOptions.addFlags(fs)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doUpdateCoreDNS(cmd *cmdutils.Cmd) error {
+func doUpdateCoreDNS(cmd *cmdutils.Cmd, windowOptions maintenanceWindowOptions) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -39,6 +42,15 @@ func doUpdateCoreDNS(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
+	maintenanceWindow, err := windowOptions.maintenanceWindow()
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.CoreDNS.Validate(); err != nil {
+		return err
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -59,7 +71,13 @@ func doUpdateCoreDNS(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	updateRequired, err := defaultaddons.UpdateCoreDNS(rawClient, meta.Region, kubernetesVersion, cmd.Plan)
+	coreDNS := defaultaddons.NewCoreDNS(defaultaddons.AddonInput{
+		RawClient:           rawClient,
+		ControlPlaneVersion: kubernetesVersion,
+		ClusterConfig:       cfg,
+		MaintenanceWindow:   maintenanceWindow,
+	})
+	updateRequired, err := coreDNS.Update(cmd.Plan)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateDefaultAddonsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("update-default-addons", "Update kube-proxy, aws-node, coredns and any registered default add-on", "")
+
+	var windowOptions maintenanceWindowOptions
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateDefaultAddons(cmd, windowOptions)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddApproveFlag(fs, cmd)
+		windowOptions.addFlags(fs)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateDefaultAddons(cmd *cmdutils.Cmd, windowOptions maintenanceWindowOptions) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	maintenanceWindow, err := windowOptions.maintenanceWindow()
+	if err != nil {
+		return err
+	}
+
+	if err := cfg.CoreDNS.Validate(); err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if ok, err := ctl.CanUpdate(cfg); !ok {
+		return err
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	kubernetesVersion, err := rawClient.ServerVersion()
+	if err != nil {
+		return err
+	}
+
+	updateRequired, err := defaultaddons.UpdateAll(defaultaddons.NewDefaultAddons(defaultaddons.AddonInput{
+		RawClient:           rawClient,
+		ControlPlaneVersion: kubernetesVersion,
+		ClusterConfig:       cfg,
+		MaintenanceWindow:   maintenanceWindow,
+	}), cmd.Plan)
+	if err != nil {
+		return err
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -15,9 +16,14 @@ func updateKubeProxyCmd(cmd *cmdutils.Cmd) {
 
 	cmd.SetDescription("update-kube-proxy", "Update kube-proxy add-on to ensure image matches Kubernetes control plane version", "")
 
+	var (
+		restart, skipImageTag bool
+		windowOptions         maintenanceWindowOptions
+	)
+
 	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
 		cmd.NameArg = cmdutils.GetNameArg(args)
-		return doUpdateKubeProxy(cmd)
+		return doUpdateKubeProxy(cmd, restart, skipImageTag, windowOptions)
 	}
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -25,13 +31,16 @@ func updateKubeProxyCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
 		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
 		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&restart, "restart", false, "restart all kube-proxy pods after the update and wait for the rollout to complete")
+		fs.BoolVar(&skipImageTag, "skip-image-tag", false, "do not update the image tag and only reconcile the node selectors and tolerations; for test clusters that do not use the EKS images")
+		windowOptions.addFlags(fs)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
 }
 
-func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
+func doUpdateKubeProxy(cmd *cmdutils.Cmd, restart, skipImageTag bool, windowOptions maintenanceWindowOptions) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -39,6 +48,11 @@ func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
+	maintenanceWindow, err := windowOptions.maintenanceWindow()
+	if err != nil {
+		return err
+	}
+
 	ctl, err := cmd.NewProviderForExistingCluster()
 	if err != nil {
 		return err
@@ -59,12 +73,29 @@ func doUpdateKubeProxy(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
-	updateRequired, err := defaultaddons.UpdateKubeProxy(rawClient.ClientSet(), kubernetesVersion, cmd.Plan)
+	addonInput := defaultaddons.AddonInput{
+		RawClient:           rawClient,
+		ControlPlaneVersion: kubernetesVersion,
+		ClusterConfig:       cfg,
+		MaintenanceWindow:   maintenanceWindow,
+	}
+	kubeProxy := defaultaddons.NewKubeProxy(addonInput, skipImageTag)
+	updateRequired, err := kubeProxy.Update(cmd.Plan)
 	if err != nil {
 		return err
 	}
 
-	cmdutils.LogPlanModeWarning(cmd.Plan && updateRequired)
+	// the pods are not restarted outside of the maintenance window either
+	if restart && (cmd.Plan || addonInput.InMaintenanceWindow()) {
+		cmdutils.LogIntendedAction(cmd.Plan, "restart all %q pods", defaultaddons.KubeProxy)
+		if !cmd.Plan {
+			if err := defaultaddons.RestartDaemonSetAndWait(rawClient.ClientSet(), metav1.NamespaceSystem, defaultaddons.KubeProxy, cmd.ProviderConfig.WaitTimeout); err != nil {
+				return err
+			}
+		}
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && (updateRequired || restart))
 
 	return nil
 }
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/nodegroup"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func updateNodeGroupTagsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		nodeGroupName string
+		options       nodegroup.UpdateTagsOptions
+	)
+
+	cmd.SetDescription("update-nodegroup-tags", "Add or change the tags of the instances of a nodegroup", "")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doUpdateNodeGroupTags(cmd, nodeGroupName, options)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		fs.StringVarP(&nodeGroupName, "nodegroup", "n", "", "Name of the nodegroup whose tags are updated")
+		cmdutils.AddStringToStringVarPFlag(fs, &options.Tags, "tags", "", nil, "Tags to add to, or change on, the instances of the nodegroup")
+
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddApproveFlag(fs, cmd)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doUpdateNodeGroupTags(cmd *cmdutils.Cmd, nodeGroupName string, options nodegroup.UpdateTagsOptions) error {
+	cfg := cmd.ClusterConfig
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet(cmdutils.ClusterNameFlag(cmd))
+	}
+	if cmd.NameArg != "" {
+		return cmdutils.ErrUnsupportedNameArg()
+	}
+	if nodeGroupName == "" {
+		return cmdutils.ErrMustBeSet("--nodegroup")
+	}
+	if len(options.Tags) == 0 {
+		return cmdutils.ErrMustBeSet("--tags")
+	}
+	options.Plan = cmd.Plan
+
+	ctl, err := cmd.NewProviderForExistingCluster()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(cfg.Metadata)
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	return nodegroup.New(cfg, ctl, nil).UpdateTags(nodeGroupName, options)
+}
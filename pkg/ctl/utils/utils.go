@@ -11,19 +11,32 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, writeKubeconfigCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeStacksCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, detectStackDriftCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateKubeProxyCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateAWSNodeCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateCoreDNSCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateDefaultAddonsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateLegacySubnetSettings)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableLoggingCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateIAMOIDCProviderCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installWindowsVPCController)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterEndpointsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, publicAccessCIDRsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterUpgradePolicyCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableSecretsEncryptionCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, schemaCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, nodeGroupHealthCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeDrainBlockersCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, rotateNodesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateNodeGroupTagsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeAddonVersionsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, dumpConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, validateConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, cleanupIAMServiceAccountsCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, diffConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, generateIAMPolicyCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, renderTemplatesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, waitClusterCmd)
 
 	return verbCmd
 }
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/actions/cluster"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils/filter"
+)
+
+func validateConfigCmd(cmd *cmdutils.Cmd) {
+	validateConfigWithRunFunc(cmd, doValidateConfig)
+}
+
+func validateConfigWithRunFunc(cmd *cmdutils.Cmd, runFunc func(cmd *cmdutils.Cmd) error) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("validate-config", "Validate a ClusterConfig against AWS without creating anything",
+		"Runs all defaulting and read-only AWS validations for a config file, such as availability zones, instance types, AMIs and subnets")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		if cmd.ClusterConfigFile == "" {
+			return cmdutils.ErrMustBeSet("--config-file")
+		}
+		return runFunc(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doValidateConfig(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewCreateClusterLoader(cmd, filter.NewNodeGroupFilter(), nil, &cmdutils.CreateClusterCmdParams{}).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	problems := cluster.NewConfigValidator(cfg, ctl.Provider).Validate()
+	for _, problem := range problems {
+		logger.Critical("%s", problem)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("found %d problem(s) validating config for cluster %q", len(problems), cfg.Metadata.Name)
+	}
+
+	logger.Success("config for cluster %q is valid", cfg.Metadata.Name)
+	return nil
+}
@@ -0,0 +1,37 @@
+package utils
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+var _ = Describe("validate-config", func() {
+	newValidateConfigCmd := func(count *int, args ...string) mockVerbCmd {
+		verbCmd := cmdutils.NewVerbCmd("utils", "Various utils", "")
+		verbCmd.SetArgs(append([]string{"validate-config"}, args...))
+		cmdutils.AddResourceCmd(cmdutils.NewGrouping(), verbCmd, func(cmd *cmdutils.Cmd) {
+			validateConfigWithRunFunc(cmd, func(cmd *cmdutils.Cmd) error {
+				Expect(cmd.ClusterConfigFile).To(Equal("cluster.yaml"))
+				*count++
+				return nil
+			})
+		})
+		return mockVerbCmd{parentCmd: verbCmd}
+	}
+
+	It("runs with a config file", func() {
+		count := 0
+		_, err := newValidateConfigCmd(&count, "-f", "cluster.yaml").execute()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(1))
+	})
+
+	It("requires a config file", func() {
+		count := 0
+		_, err := newValidateConfigCmd(&count).execute()
+		Expect(err).To(MatchError(ContainSubstring("--config-file must be set")))
+		Expect(count).To(Equal(0))
+	})
+})
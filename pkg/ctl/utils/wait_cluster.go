@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+func waitClusterCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("wait-cluster", "Wait for a cluster created with 'eksctl create cluster --async' to be ready", "When given the config file of the cluster, the cluster is configured once it is ready, as 'eksctl create cluster' does")
+
+	cmd.CobraCommand.RunE = func(_ *cobra.Command, args []string) error {
+		cmd.NameArg = cmdutils.GetNameArg(args)
+		return doWaitCluster(cmd)
+	}
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddClusterFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, &cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, &cmd.ProviderConfig, false)
+}
+
+func doWaitCluster(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	cmdutils.LogRegionAndVersionInfo(meta)
+
+	if _, err := ctl.NewStackManager(cfg).WaitForClusterStack(); err != nil {
+		return errors.Wrapf(err, "waiting for cluster %q to be created", meta.Name)
+	}
+	if cmd.ClusterConfigFile == "" {
+		if err := ctl.RefreshClusterStatus(cfg); err != nil {
+			return err
+		}
+	} else if err := configureCluster(ctl, cfg); err != nil {
+		return err
+	}
+
+	logger.Success("%s is ready", meta.LogString())
+	logger.Info("to write its kubeconfig, run 'eksctl utils write-kubeconfig --region=%s --cluster=%s'", meta.Region, meta.Name)
+	if cmd.ClusterConfigFile != "" {
+		logger.Info("to create its nodegroups and addons, run 'eksctl create nodegroup --config-file=%[1]s' and 'eksctl create addon --config-file=%[1]s'", cmd.ClusterConfigFile)
+	}
+	return nil
+}
+
+// configureCluster runs the tasks 'eksctl create cluster' runs once the cluster has been created,
+// which it skips with --async
+func configureCluster(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) error {
+	taskTree := ctl.CreateExtraClusterConfigTasks(cfg, false)
+	logger.Info(taskTree.Describe())
+	if errs := taskTree.DoAllSync(); len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to configure cluster %q", cfg.Metadata.Name)
+	}
+
+	if cfg.PrivateCluster.Enabled {
+		logger.Info("disabling public endpoint access for the cluster")
+		cfg.VPC.ClusterEndpoints.PublicAccess = api.Disabled()
+		if err := ctl.UpdateClusterConfigForEndpoints(cfg); err != nil {
+			return errors.Wrap(err, "error disabling public endpoint access for the cluster")
+		}
+	}
+	return nil
+}
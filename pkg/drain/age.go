@@ -0,0 +1,31 @@
+package drain
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// NodesOlderThan returns the nodes matching listOptions that were created more than maxAge
+// before now, the oldest node first
+func NodesOlderThan(clientSet kubernetes.Interface, listOptions metav1.ListOptions, maxAge time.Duration, now time.Time) ([]corev1.Node, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldNodes []corev1.Node
+	for _, node := range nodes.Items {
+		if now.Sub(node.CreationTimestamp.Time) > maxAge {
+			oldNodes = append(oldNodes, node)
+		}
+	}
+	sort.SliceStable(oldNodes, func(i, j int) bool {
+		return oldNodes[i].CreationTimestamp.Before(&oldNodes[j].CreationTimestamp)
+	})
+	return oldNodes, nil
+}
@@ -0,0 +1,57 @@
+package drain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+var _ = Describe("Nodes older than a maximum age", func() {
+	now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	newNode := func(name string, age time.Duration) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+		}
+	}
+
+	var fakeClientSet *fake.Clientset
+
+	BeforeEach(func() {
+		fakeClientSet = fake.NewSimpleClientset(
+			newNode("node-1d", 24*time.Hour),
+			newNode("node-40d", 40*24*time.Hour),
+			newNode("node-31d", 31*24*time.Hour),
+			newNode("node-29d", 29*24*time.Hour),
+		)
+	})
+
+	nodeNames := func(nodes []corev1.Node) []string {
+		var names []string
+		for _, node := range nodes {
+			names = append(names, node.Name)
+		}
+		return names
+	}
+
+	It("returns the nodes older than the maximum age, the oldest first", func() {
+		nodes, err := drain.NodesOlderThan(fakeClientSet, metav1.ListOptions{}, 720*time.Hour, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodeNames(nodes)).To(Equal([]string{"node-40d", "node-31d"}))
+	})
+
+	It("returns no nodes when all of them are younger than the maximum age", func() {
+		nodes, err := drain.NodesOlderThan(fakeClientSet, metav1.ListOptions{}, 60*24*time.Hour, now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodes).To(BeEmpty())
+	})
+})
@@ -0,0 +1,136 @@
+package drain
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// BlockingPod is a pod that cannot be evicted because a PodDisruptionBudget
+// does not allow any more disruptions
+type BlockingPod struct {
+	Namespace string
+	Name      string
+	Node      string
+	// Owner is the controller of the pod, as `<kind>/<name>`
+	Owner string
+
+	PodDisruptionBudget string
+	DisruptionsAllowed  int32
+	CurrentHealthy      int32
+	DesiredHealthy      int32
+}
+
+// String returns a one-line description of the blocking pod
+func (p BlockingPod) String() string {
+	return fmt.Sprintf("pod %s/%s on node %s (owner: %s) is blocked by PodDisruptionBudget %q: %d disruption(s) allowed, %d healthy pod(s), %d desired",
+		p.Namespace, p.Name, p.Node, p.Owner, p.PodDisruptionBudget, p.DisruptionsAllowed, p.CurrentHealthy, p.DesiredHealthy)
+}
+
+// FindBlockingPods returns the pods running on the given nodes whose eviction is
+// prevented by a PodDisruptionBudget at its disruption limit
+func FindBlockingPods(clientSet kubernetes.Interface, nodeNames []string) ([]BlockingPod, error) {
+	pdbsByNamespace := map[string][]policyv1beta1.PodDisruptionBudget{}
+	var blocking []BlockingPod
+
+	for _, nodeName := range nodeNames {
+		pods, err := clientSet.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+			FieldSelector: fields.SelectorFromSet(fields.Set{"spec.nodeName": nodeName}).String(),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "listing pods on node %q", nodeName)
+		}
+
+		for _, pod := range pods.Items {
+			if pod.Spec.NodeName != nodeName || !isEvictable(pod) {
+				continue
+			}
+			pdbs, ok := pdbsByNamespace[pod.Namespace]
+			if !ok {
+				list, err := clientSet.PolicyV1beta1().PodDisruptionBudgets(pod.Namespace).List(context.TODO(), metav1.ListOptions{})
+				if err != nil {
+					return nil, errors.Wrapf(err, "listing PodDisruptionBudgets in namespace %q", pod.Namespace)
+				}
+				pdbs = list.Items
+				pdbsByNamespace[pod.Namespace] = pdbs
+			}
+
+			for _, pdb := range pdbs {
+				if pdb.Status.DisruptionsAllowed > 0 || !selectsPod(pdb, pod) {
+					continue
+				}
+				blocking = append(blocking, BlockingPod{
+					Namespace:           pod.Namespace,
+					Name:                pod.Name,
+					Node:                nodeName,
+					Owner:               podOwner(pod),
+					PodDisruptionBudget: pdb.Name,
+					DisruptionsAllowed:  pdb.Status.DisruptionsAllowed,
+					CurrentHealthy:      pdb.Status.CurrentHealthy,
+					DesiredHealthy:      pdb.Status.DesiredHealthy,
+				})
+			}
+		}
+	}
+
+	sort.Slice(blocking, func(i, j int) bool {
+		if blocking[i].Namespace != blocking[j].Namespace {
+			return blocking[i].Namespace < blocking[j].Namespace
+		}
+		return blocking[i].Name < blocking[j].Name
+	})
+	return blocking, nil
+}
+
+// isEvictable returns false for pods that are not evicted when draining nodes
+func isEvictable(pod corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	if _, isMirrorPod := pod.Annotations[corev1.MirrorPodAnnotationKey]; isMirrorPod {
+		return false
+	}
+	if owner := metav1.GetControllerOf(&pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+	return true
+}
+
+func selectsPod(pdb policyv1beta1.PodDisruptionBudget, pod corev1.Pod) bool {
+	selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+	if err != nil || selector.Empty() {
+		// an empty selector does not match any pod in policy/v1beta1
+		return false
+	}
+	return selector.Matches(labels.Set(pod.Labels))
+}
+
+func podOwner(pod corev1.Pod) string {
+	owner := metav1.GetControllerOf(&pod)
+	if owner == nil {
+		return "none"
+	}
+	return owner.Kind + "/" + owner.Name
+}
+
+func describeBlockingPods(blocking []BlockingPod) string {
+	pdbs := map[string]bool{}
+	for _, p := range blocking {
+		pdbs[p.Namespace+"/"+p.PodDisruptionBudget] = true
+	}
+	names := make([]string, 0, len(pdbs))
+	for name := range pdbs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return fmt.Sprintf("%d pod(s) cannot be evicted because of PodDisruptionBudgets %s", len(blocking), strings.Join(names, ", "))
+}
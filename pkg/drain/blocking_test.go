@@ -0,0 +1,136 @@
+package drain_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/drain/evictor"
+	"github.com/weaveworks/eksctl/pkg/drain/fakes"
+	"github.com/weaveworks/eksctl/pkg/eks/mocks"
+)
+
+var _ = Describe("Pods blocking drains", func() {
+	var fakeClientSet *fake.Clientset
+
+	newPod := func(name, node, owner string, labels map[string]string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    labels,
+			},
+			Spec:   corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if owner != "" {
+			pod.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       owner,
+				Name:       name + "-owner",
+				Controller: func(b bool) *bool { return &b }(true),
+			}}
+		}
+		return pod
+	}
+
+	newPDB := func(name string, labels map[string]string, disruptionsAllowed, currentHealthy, desiredHealthy int32) *policyv1beta1.PodDisruptionBudget {
+		minAvailable := intstr.FromInt(int(desiredHealthy))
+		return &policyv1beta1.PodDisruptionBudget{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: policyv1beta1.PodDisruptionBudgetSpec{
+				MinAvailable: &minAvailable,
+				Selector:     &metav1.LabelSelector{MatchLabels: labels},
+			},
+			Status: policyv1beta1.PodDisruptionBudgetStatus{
+				DisruptionsAllowed: disruptionsAllowed,
+				CurrentHealthy:     currentHealthy,
+				DesiredHealthy:     desiredHealthy,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClientSet = fake.NewSimpleClientset(
+			newPod("web-1", "node-1", "ReplicaSet", map[string]string{"app": "web"}),
+			newPod("web-2", "node-2", "ReplicaSet", map[string]string{"app": "web"}),
+			newPod("db-1", "node-1", "StatefulSet", map[string]string{"app": "db"}),
+			newPod("logs-1", "node-1", "DaemonSet", map[string]string{"app": "web"}),
+			newPod("standalone", "node-1", "", map[string]string{"app": "web"}),
+			newPDB("web", map[string]string{"app": "web"}, 0, 2, 2),
+			newPDB("db", map[string]string{"app": "db"}, 1, 3, 2),
+		)
+	})
+
+	It("lists the pods covered by a PodDisruptionBudget at its disruption limit", func() {
+		blocking, err := drain.FindBlockingPods(fakeClientSet, []string{"node-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(blocking).To(Equal([]drain.BlockingPod{
+			{
+				Namespace:           "default",
+				Name:                "standalone",
+				Node:                "node-1",
+				Owner:               "none",
+				PodDisruptionBudget: "web",
+				DisruptionsAllowed:  0,
+				CurrentHealthy:      2,
+				DesiredHealthy:      2,
+			},
+			{
+				Namespace:           "default",
+				Name:                "web-1",
+				Node:                "node-1",
+				Owner:               "ReplicaSet/web-1-owner",
+				PodDisruptionBudget: "web",
+				DisruptionsAllowed:  0,
+				CurrentHealthy:      2,
+				DesiredHealthy:      2,
+			},
+		}))
+	})
+
+	It("describes a blocking pod", func() {
+		blocking, err := drain.FindBlockingPods(fakeClientSet, []string{"node-2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(blocking).To(HaveLen(1))
+		Expect(blocking[0].String()).To(Equal(`pod default/web-2 on node node-2 (owner: ReplicaSet/web-2-owner) is blocked by PodDisruptionBudget "web": 0 disruption(s) allowed, 2 healthy pod(s), 2 desired`))
+	})
+
+	It("does not list pods on other nodes", func() {
+		blocking, err := drain.FindBlockingPods(fakeClientSet, []string{"node-3"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(blocking).To(BeEmpty())
+	})
+
+	It("reports the blocking pods when a drain times out", func() {
+		_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+		}, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		mockNG := mocks.KubeNodeGroup{}
+		mockNG.Mock.On("NameString").Return("ng-1")
+		mockNG.Mock.On("ListOptions").Return(metav1.ListOptions{})
+		fakeEvictor := new(fakes.FakeEvictor)
+		fakeEvictor.GetPodsForEvictionReturns(&evictor.PodDeleteList{
+			Items: []evictor.PodDelete{{
+				Pod:    corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web-2"}},
+				Status: evictor.PodDeleteStatus{Delete: true},
+			}},
+		}, nil)
+
+		nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second, time.Second, false, false)
+		nodeGroupDrainer.SetDrainer(fakeEvictor)
+
+		err = nodeGroupDrainer.Drain()
+		Expect(err).To(MatchError(`timed out (after 1s) waiting for nodegroup "ng-1" to be drained: 1 pod(s) cannot be evicted because of PodDisruptionBudgets default/web`))
+	})
+})
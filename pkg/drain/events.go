@@ -0,0 +1,76 @@
+package drain
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+)
+
+// EventType is the type of a drain event
+type EventType string
+
+// Values for `EventType`
+const (
+	// EventNodeDrainStarted is recorded when eksctl starts draining a node
+	EventNodeDrainStarted EventType = "NodeDrainStarted"
+	// EventPodEvicted is recorded when a pod is evicted, or deleted, from a node
+	EventPodEvicted EventType = "PodEvicted"
+	// EventNodeDrainCompleted is recorded when no pods are left to evict on a node
+	EventNodeDrainCompleted EventType = "NodeDrainCompleted"
+)
+
+// Event describes the progress of a drain, it is meant to be consumed by external monitoring
+type Event struct {
+	Time      time.Time `json:"time"`
+	Type      EventType `json:"type"`
+	NodeGroup string    `json:"nodeGroup"`
+	Node      string    `json:"node"`
+	Namespace string    `json:"namespace,omitempty"`
+	Pod       string    `json:"pod,omitempty"`
+}
+
+// EventRecorder receives the events of a drain, it is optional and drains behave the same without one
+type EventRecorder interface {
+	RecordEvent(event Event)
+}
+
+// FileEventRecorder writes drain events to a file as newline-delimited JSON
+type FileEventRecorder struct {
+	mu      sync.Mutex
+	file    *os.File
+	encoder *json.Encoder
+}
+
+// NewFileEventRecorder creates a FileEventRecorder that appends events to the file at path,
+// creating it if it does not exist
+func NewFileEventRecorder(path string) (*FileEventRecorder, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, errors.Wrapf(err, "opening drain events file %q", path)
+	}
+	return &FileEventRecorder{
+		file:    file,
+		encoder: json.NewEncoder(file),
+	}, nil
+}
+
+// RecordEvent writes the event as a single line of JSON. Events that cannot be written are
+// only logged, so that a failing events file never fails the drain itself
+func (r *FileEventRecorder) RecordEvent(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.encoder.Encode(event); err != nil {
+		logger.Warning("failed to write drain event to %q: %v", r.file.Name(), err)
+	}
+}
+
+// Close closes the events file
+func (r *FileEventRecorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
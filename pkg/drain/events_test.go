@@ -0,0 +1,100 @@
+package drain_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/drain"
+)
+
+type eventRecorder struct {
+	events []drain.Event
+}
+
+func (r *eventRecorder) RecordEvent(event drain.Event) {
+	r.events = append(r.events, event)
+}
+
+func (r *eventRecorder) types() []drain.EventType {
+	var types []drain.EventType
+	for _, event := range r.events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+var _ = Describe("FileEventRecorder", func() {
+	var (
+		dir  string
+		path string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = os.MkdirTemp("", "drain-events")
+		Expect(err).NotTo(HaveOccurred())
+		path = filepath.Join(dir, "events.ndjson")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	readEvents := func() []drain.Event {
+		file, err := os.Open(path)
+		Expect(err).NotTo(HaveOccurred())
+		defer file.Close()
+
+		var events []drain.Event
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			var event drain.Event
+			Expect(json.Unmarshal(scanner.Bytes(), &event)).To(Succeed())
+			events = append(events, event)
+		}
+		Expect(scanner.Err()).NotTo(HaveOccurred())
+		return events
+	}
+
+	It("writes one JSON event per line, appending to the file", func() {
+		now := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+		started := drain.Event{Time: now, Type: drain.EventNodeDrainStarted, NodeGroup: "ng-1", Node: "node-1"}
+		evicted := drain.Event{Time: now, Type: drain.EventPodEvicted, NodeGroup: "ng-1", Node: "node-1", Namespace: "default", Pod: "pod-1"}
+		completed := drain.Event{Time: now, Type: drain.EventNodeDrainCompleted, NodeGroup: "ng-1", Node: "node-1"}
+
+		recorder, err := drain.NewFileEventRecorder(path)
+		Expect(err).NotTo(HaveOccurred())
+		recorder.RecordEvent(started)
+		recorder.RecordEvent(evicted)
+		Expect(recorder.Close()).To(Succeed())
+
+		recorder, err = drain.NewFileEventRecorder(path)
+		Expect(err).NotTo(HaveOccurred())
+		recorder.RecordEvent(completed)
+		Expect(recorder.Close()).To(Succeed())
+
+		Expect(readEvents()).To(Equal([]drain.Event{started, evicted, completed}))
+	})
+
+	It("omits the pod of node events", func() {
+		recorder, err := drain.NewFileEventRecorder(path)
+		Expect(err).NotTo(HaveOccurred())
+		recorder.RecordEvent(drain.Event{Type: drain.EventNodeDrainStarted, NodeGroup: "ng-1", Node: "node-1"})
+		Expect(recorder.Close()).To(Succeed())
+
+		content, err := os.ReadFile(path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).NotTo(ContainSubstring("pod"))
+	})
+
+	It("fails when the file cannot be opened", func() {
+		_, err := drain.NewFileEventRecorder(filepath.Join(dir, "missing", "events.ndjson"))
+		Expect(err).To(MatchError(ContainSubstring("opening drain events file")))
+	})
+})
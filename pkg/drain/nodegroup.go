@@ -39,6 +39,11 @@ type NodeGroupDrainer struct {
 	ng          eks.KubeNodeGroup
 	waitTimeout time.Duration
 	undo        bool
+	byPriority  bool
+	events      EventRecorder
+	// evictedPods holds the UIDs of the pods for which an eviction event was recorded, as pods
+	// are evicted again on every attempt until they are gone
+	evictedPods sets.String
 }
 
 func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, waitTimeout time.Duration, maxGracePeriod time.Duration, undo bool, disableEviction bool) NodeGroupDrainer {
@@ -74,9 +79,22 @@ func NewNodeGroupDrainer(clientSet kubernetes.Interface, ng eks.KubeNodeGroup, w
 		ng:          ng,
 		waitTimeout: waitTimeout,
 		undo:        undo,
+		evictedPods: sets.NewString(),
 	}
 }
 
+// SetEventRecorder sets a recorder that receives the progress of the drain as events
+func (n *NodeGroupDrainer) SetEventRecorder(events EventRecorder) {
+	n.events = events
+}
+
+// SetEvictByPriority makes the drainer evict the pods of each node in ascending order of priority,
+// only evicting the pods of a priority once all the pods of lower priorities are gone, so that
+// the pods with the highest priority keep running the longest
+func (n *NodeGroupDrainer) SetEvictByPriority(byPriority bool) {
+	n.byPriority = byPriority
+}
+
 // Drain drains a nodegroup
 func (n *NodeGroupDrainer) Drain() error {
 	if err := n.evictor.CanUseEvictions(); err != nil {
@@ -100,15 +118,18 @@ func (n *NodeGroupDrainer) Drain() error {
 	}
 
 	drainedNodes := sets.NewString()
+	startedNodes := sets.NewString()
 	// loop until all nodes are drained to handle accidental scale-up
 	// or any other changes in the ASG
 	timer := time.NewTimer(n.waitTimeout)
 	defer timer.Stop()
 
+	var pendingNodes []string
 	for {
 		select {
 		case <-timer.C:
-			return fmt.Errorf("timed out (after %s) waiting for nodegroup %q to be drained", n.waitTimeout, n.ng.NameString())
+			err := fmt.Errorf("timed out (after %s) waiting for nodegroup %q to be drained", n.waitTimeout, n.ng.NameString())
+			return n.withBlockingPods(err, pendingNodes)
 		default:
 			nodes, err := n.clientSet.CoreV1().Nodes().List(context.TODO(), listOptions)
 			if err != nil {
@@ -129,10 +150,15 @@ func (n *NodeGroupDrainer) Drain() error {
 				return nil // no new nodes were seen
 			}
 
+			pendingNodes = newPendingNodes.List()
 			logger.Debug("already drained: %v", drainedNodes.List())
-			logger.Debug("will drain: %v", newPendingNodes.List())
+			logger.Debug("will drain: %v", pendingNodes)
 
 			for _, node := range newPendingNodes.List() {
+				if !startedNodes.Has(node) {
+					startedNodes.Insert(node)
+					n.recordEvent(EventNodeDrainStarted, node, nil)
+				}
 				pending, err := n.evictPods(node)
 				if err != nil {
 					logger.Warning("pod eviction error (%q) on node %s", err, node)
@@ -142,12 +168,79 @@ func (n *NodeGroupDrainer) Drain() error {
 				logger.Debug("%d pods to be evicted from %s", pending, node)
 				if pending == 0 {
 					drainedNodes.Insert(node)
+					n.recordEvent(EventNodeDrainCompleted, node, nil)
 				}
 			}
 		}
 	}
 }
 
+// DrainNodes cordons the given nodes of the nodegroup and evicts their pods, it returns
+// once no pods are left to evict on any of them
+func (n *NodeGroupDrainer) DrainNodes(nodeNames []string) error {
+	if err := n.evictor.CanUseEvictions(); err != nil {
+		return errors.Wrap(err, "checking if cluster implements policy API")
+	}
+
+	nodes := &corev1.NodeList{}
+	for _, nodeName := range nodeNames {
+		node, err := n.clientSet.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		if err != nil {
+			return errors.Wrapf(err, "getting node %q", nodeName)
+		}
+		nodes.Items = append(nodes.Items, *node)
+	}
+	n.toggleCordon(true, nodes)
+
+	pendingNodes := sets.NewString(nodeNames...)
+	for _, node := range pendingNodes.List() {
+		n.recordEvent(EventNodeDrainStarted, node, nil)
+	}
+	timer := time.NewTimer(n.waitTimeout)
+	defer timer.Stop()
+
+	for pendingNodes.Len() > 0 {
+		select {
+		case <-timer.C:
+			err := fmt.Errorf("timed out (after %s) waiting for nodes %v of nodegroup %q to be drained", n.waitTimeout, pendingNodes.List(), n.ng.NameString())
+			return n.withBlockingPods(err, pendingNodes.List())
+		default:
+			for _, node := range pendingNodes.List() {
+				pending, err := n.evictPods(node)
+				if err != nil {
+					logger.Warning("pod eviction error (%q) on node %s", err, node)
+					time.Sleep(retryDelay)
+					continue
+				}
+				logger.Debug("%d pods to be evicted from %s", pending, node)
+				if pending == 0 {
+					pendingNodes.Delete(node)
+					n.recordEvent(EventNodeDrainCompleted, node, nil)
+				}
+			}
+		}
+	}
+	logger.Success("drained nodes: %v", nodeNames)
+	return nil
+}
+
+// withBlockingPods logs the pods of the given nodes that cannot be evicted because of
+// PodDisruptionBudgets, and adds a summary of them to the drain error
+func (n *NodeGroupDrainer) withBlockingPods(drainErr error, nodeNames []string) error {
+	blocking, err := FindBlockingPods(n.clientSet, nodeNames)
+	if err != nil {
+		logger.Warning("failed to find pods blocking the drain: %v", err)
+		return drainErr
+	}
+	if len(blocking) == 0 {
+		return drainErr
+	}
+	for _, pod := range blocking {
+		logger.Warning(pod.String())
+	}
+	return errors.Errorf("%v: %s", drainErr, describeBlockingPods(blocking))
+}
+
 func (n *NodeGroupDrainer) toggleCordon(cordon bool, nodes *corev1.NodeList) {
 	for _, node := range nodes.Items {
 		c := NewCordonHelper(&node, cordon)
@@ -177,15 +270,68 @@ func (n *NodeGroupDrainer) evictPods(node string) (int, error) {
 	}
 	pods := list.Pods()
 	pending := len(pods)
+	if n.byPriority {
+		pods = lowestPriorityPods(pods)
+	}
 	for _, pod := range pods {
 		// TODO: handle API rate limiter error
 		if err := n.evictor.EvictOrDeletePod(pod); err != nil {
 			return pending, errors.Wrapf(err, "error evicting pod: %s/%s", pod.Namespace, pod.Name)
 		}
+		if key := podKey(pod); !n.evictedPods.Has(key) {
+			n.evictedPods.Insert(key)
+			n.recordEvent(EventPodEvicted, node, &pod)
+		}
 	}
 	return pending, nil
 }
 
+func (n *NodeGroupDrainer) recordEvent(eventType EventType, node string, pod *corev1.Pod) {
+	if n.events == nil {
+		return
+	}
+	event := Event{
+		Time:      time.Now().UTC(),
+		Type:      eventType,
+		NodeGroup: n.ng.NameString(),
+		Node:      node,
+	}
+	if pod != nil {
+		event.Namespace = pod.Namespace
+		event.Pod = pod.Name
+	}
+	n.events.RecordEvent(event)
+}
+
+// lowestPriorityPods returns the pods with the lowest priority, pods without a priority
+// have the default priority of 0
+func lowestPriorityPods(pods []corev1.Pod) []corev1.Pod {
+	var lowest []corev1.Pod
+	for _, pod := range pods {
+		switch {
+		case len(lowest) == 0 || podPriority(pod) == podPriority(lowest[0]):
+			lowest = append(lowest, pod)
+		case podPriority(pod) < podPriority(lowest[0]):
+			lowest = []corev1.Pod{pod}
+		}
+	}
+	return lowest
+}
+
+func podPriority(pod corev1.Pod) int32 {
+	if pod.Spec.Priority == nil {
+		return 0
+	}
+	return *pod.Spec.Priority
+}
+
+func podKey(pod corev1.Pod) string {
+	if pod.UID != "" {
+		return string(pod.UID)
+	}
+	return pod.Namespace + "/" + pod.Name
+}
+
 func cordonStatus(desired bool) string {
 	if desired {
 		return "cordon"
@@ -19,6 +19,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/drain"
 	"github.com/weaveworks/eksctl/pkg/eks/mocks"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
 var _ = Describe("Drain", func() {
@@ -87,6 +88,23 @@ var _ = Describe("Drain", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(node.Spec.Unschedulable).To(BeTrue())
 		})
+		It("records the drain events in order", func() {
+			recorder := &eventRecorder{}
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, false)
+			nodeGroupDrainer.SetDrainer(fakeEvictor)
+			nodeGroupDrainer.SetEventRecorder(recorder)
+
+			err := nodeGroupDrainer.Drain()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(recorder.types()).To(Equal([]drain.EventType{drain.EventNodeDrainStarted, drain.EventPodEvicted, drain.EventNodeDrainCompleted}))
+			for _, event := range recorder.events {
+				Expect(event.NodeGroup).To(Equal("node-1"))
+				Expect(event.Node).To(Equal(nodeName))
+				Expect(event.Time).NotTo(BeZero())
+			}
+			Expect(recorder.events[1].Pod).To(Equal("pod-1"))
+		})
 	})
 
 	When("the nodes never drain successfully", func() {
@@ -219,4 +237,71 @@ var _ = Describe("Drain", func() {
 			Expect(fakeEvictor.EvictOrDeletePodCallCount()).To(BeZero())
 		})
 	})
+
+	When("evicting by priority", func() {
+		newPod := func(name string, priority *int32) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: metav1.NamespaceDefault,
+				},
+				Spec: corev1.PodSpec{
+					NodeName: nodeName,
+					Priority: priority,
+				},
+			}
+		}
+		priority := func(p int32) *int32 { return &p }
+
+		BeforeEach(func() {
+			_, err := fakeClientSet.CoreV1().Nodes().Create(context.TODO(), &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nodeName,
+				},
+			}, metav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			for _, pod := range []*corev1.Pod{
+				newPod("critical", priority(2000000000)),
+				newPod("high", priority(1000)),
+				newPod("default", nil),
+				newPod("low", priority(-10)),
+				newPod("high-2", priority(1000)),
+			} {
+				_, err := fakeClientSet.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		deletedPods := func() []string {
+			var names []string
+			for _, action := range fakeClientSet.Actions() {
+				if deleteAction, ok := action.(k8stesting.DeleteAction); ok && action.GetResource().Resource == "pods" {
+					names = append(names, deleteAction.GetName())
+				}
+			}
+			return names
+		}
+
+		It("evicts the pods of lower priorities first", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, true)
+			nodeGroupDrainer.SetEvictByPriority(true)
+
+			Expect(nodeGroupDrainer.Drain()).To(Succeed())
+
+			deleted := deletedPods()
+			Expect(deleted).To(HaveLen(5))
+			Expect(deleted[:2]).To(Equal([]string{"low", "default"}))
+			Expect(deleted[2:4]).To(ConsistOf("high", "high-2"))
+			Expect(deleted[4]).To(Equal("critical"))
+		})
+
+		It("evicts all the pods at once by default", func() {
+			nodeGroupDrainer := drain.NewNodeGroupDrainer(fakeClientSet, &mockNG, time.Second*10, time.Second, false, true)
+
+			Expect(nodeGroupDrainer.Drain()).To(Succeed())
+
+			Expect(deletedPods()).To(ConsistOf("critical", "high", "default", "low", "high-2"))
+		})
+	})
 })
@@ -21,6 +21,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb"
@@ -29,6 +31,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -56,6 +60,10 @@ type ClusterProvider struct {
 	Provider api.ClusterProvider
 	// informative fields, i.e. used as outputs
 	Status *ProviderStatus
+	// AuditLog records the mutating AWS API calls, which are not made, when ProviderConfig.AuditCalls is set
+	AuditLog *AuditLog
+
+	proxy ProxyFunc
 }
 
 //counterfeiter:generate -o fakes/fake_kube_provider.go . KubeProvider
@@ -82,6 +90,8 @@ type ProviderServices struct {
 	sts   stsiface.STSAPI
 	ssm   ssmiface.SSMAPI
 	iam   iamiface.IAMAPI
+	kms   kmsiface.KMSAPI
+	efs   efsiface.EFSAPI
 
 	cloudtrail cloudtrailiface.CloudTrailAPI
 
@@ -99,6 +109,16 @@ func (p ProviderServices) CloudFormationDisableRollback() bool {
 	return p.spec.CloudFormationDisableRollback
 }
 
+// DisableAMICache returns whether resolved AMIs should not be cached
+func (p ProviderServices) DisableAMICache() bool {
+	return p.spec.DisableAMICache
+}
+
+// RunID returns, if any, the ID of this run used to tag CloudFormation stacks
+func (p ProviderServices) RunID() string {
+	return p.spec.RunID
+}
+
 // ASG returns a representation of the AutoScaling API
 func (p ProviderServices) ASG() autoscalingiface.AutoScalingAPI { return p.asg }
 
@@ -123,6 +143,12 @@ func (p ProviderServices) SSM() ssmiface.SSMAPI { return p.ssm }
 // IAM returns a representation of the IAM API
 func (p ProviderServices) IAM() iamiface.IAMAPI { return p.iam }
 
+// KMS returns a representation of the KMS API
+func (p ProviderServices) KMS() kmsiface.KMSAPI { return p.kms }
+
+// EFS returns a representation of the EFS API
+func (p ProviderServices) EFS() efsiface.EFSAPI { return p.efs }
+
 // CloudTrail returns a representation of the CloudTrail API
 func (p ProviderServices) CloudTrail() cloudtrailiface.CloudTrailAPI { return p.cloudtrail }
 
@@ -135,6 +161,10 @@ func (p ProviderServices) Profile() string { return p.spec.Profile }
 // WaitTimeout returns provider-level duration after which any wait operation has to timeout
 func (p ProviderServices) WaitTimeout() time.Duration { return p.spec.WaitTimeout }
 
+// StackTimeouts returns the durations after which the operations on CloudFormation stacks have to timeout,
+// zero values mean WaitTimeout
+func (p ProviderServices) StackTimeouts() api.StackTimeouts { return p.spec.StackTimeouts }
+
 func (p ProviderServices) ConfigProvider() client.ConfigProvider {
 	return p.session
 }
@@ -155,8 +185,19 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) (*ClusterProv
 	provider := &ProviderServices{
 		spec: spec,
 	}
+	if err := validateRetryConfig(spec); err != nil {
+		return nil, err
+	}
+	if err := api.ValidateRunID(spec.RunID); err != nil {
+		return nil, err
+	}
+	proxy, err := NewProxyFunc(spec)
+	if err != nil {
+		return nil, err
+	}
 	c := &ClusterProvider{
 		Provider: provider,
+		proxy:    proxy,
 	}
 	// Create a new session and save credentials for possible
 	// later re-use if overriding sessions due to custom URL
@@ -178,17 +219,18 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) (*ClusterProv
 	provider.ec2 = ec2.New(s)
 	provider.elb = elb.New(s)
 	provider.elbv2 = elbv2.New(s)
-	provider.sts = sts.New(s,
-		// STS retrier has to be disabled, as it's not very helpful
-		// (see https://github.com/weaveworks/eksctl/issues/705)
-		request.WithRetryer(s.Config.Copy(),
-			&client.DefaultRetryer{
-				NumMaxRetries: 1,
-			},
-		),
+	// STS retrier has to be disabled, as it's not very helpful
+	// (see https://github.com/weaveworks/eksctl/issues/705)
+	stsConfig := request.WithRetryer(s.Config.Copy(),
+		&client.DefaultRetryer{
+			NumMaxRetries: 1,
+		},
 	)
+	provider.sts = sts.New(s, stsConfig)
 	provider.ssm = ssm.New(s)
 	provider.iam = iam.New(s)
+	provider.kms = kms.New(s)
+	provider.efs = efs.New(s)
 	provider.cloudtrail = cloudtrail.New(s)
 
 	c.Status = &ProviderStatus{
@@ -221,7 +263,7 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) (*ClusterProv
 	}
 	if endpoint, ok := os.LookupEnv("AWS_STS_ENDPOINT"); ok {
 		logger.Debug("Setting STS endpoint to %s", endpoint)
-		provider.sts = sts.New(s, s.Config.Copy().WithEndpoint(endpoint))
+		provider.sts = sts.New(s, stsConfig.Copy().WithEndpoint(endpoint))
 	}
 	if endpoint, ok := os.LookupEnv("AWS_IAM_ENDPOINT"); ok {
 		logger.Debug("Setting IAM endpoint to %s", endpoint)
@@ -246,6 +288,9 @@ func ParseConfig(data []byte) (*api.ClusterConfig, error) {
 	// of detecting any unknown keys
 	// NOTE: we must use sigs.k8s.io/yaml, as it behaves differently from
 	// github.com/ghodss/yaml, which didn't handle nested structs well
+	if IsConfigList(data) {
+		return nil, errors.New("a ClusterConfigList can only be used to create clusters, with eksctl create cluster")
+	}
 	if err := yaml.UnmarshalStrict(data, &api.ClusterConfig{}); err != nil {
 		return nil, err
 	}
@@ -259,6 +304,9 @@ func ParseConfig(data []byte) (*api.ClusterConfig, error) {
 	if !ok {
 		return nil, fmt.Errorf("expected to decode object of type %T; got %T", &api.ClusterConfig{}, cfg)
 	}
+	if err := api.ExpandNodeGroupArchitectures(cfg); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
 
@@ -339,6 +387,9 @@ func ResolveAMI(provider api.ClusterProvider, version string, np api.NodePool) e
 	default:
 		return errors.Errorf("invalid AMI value: %q", ng.AMI)
 	}
+	if !provider.DisableAMICache() {
+		resolver = ami.NewCachingResolver(ng.AMI, resolver, ami.DefaultCache())
+	}
 
 	instanceType := SelectInstanceType(np)
 	id, err := resolver.Resolve(provider.Region(), version, instanceType, ng.AMIFamily)
@@ -423,13 +474,18 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 	// we might want to use bits from kops, although right now it seems like too many things we
 	// don't want yet
 	// https://github.com/kubernetes/kops/blob/master/upup/pkg/fi/cloudup/awsup/aws_cloud.go#L179
-	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true)
+	// STS calls use the endpoint of the region of the session, including when the region is read from
+	// the profile or the environment rather than being set with --region
+	config := aws.NewConfig().WithCredentialsChainVerboseErrors(true).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint)
 
 	if c.Provider.Region() != "" {
-		config = config.WithRegion(c.Provider.Region()).WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint)
+		config = config.WithRegion(c.Provider.Region())
 	}
 
-	config = request.WithRetryer(config, newLoggingRetryer())
+	config = request.WithRetryer(config, newLoggingRetryer(spec))
+	if c.proxy != nil {
+		config = config.WithHTTPClient(newHTTPClient(c.proxy))
+	}
 	if logger.Level >= api.AWSDebugLevel {
 		config = config.WithLogLevel(aws.LogDebug |
 			aws.LogDebugWithHTTPBody |
@@ -471,6 +527,11 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		}
 	}
 
+	if spec.AuditCalls {
+		c.AuditLog = &AuditLog{}
+		s.Handlers.Validate.PushBackNamed(c.AuditLog.handler())
+	}
+
 	return s
 }
 
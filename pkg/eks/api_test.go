@@ -127,6 +127,43 @@ var _ = Describe("eksctl API", func() {
 
 			testEnsureAMI(Equal("ami-auto"))
 		})
+
+		It("should resolve the AMI of each architecture for nodegroups that set architectures", func() {
+			Expect(api.Register()).To(Succeed())
+			cfg, err := ParseConfig([]byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-1
+  region: us-west-2
+nodeGroups:
+  - name: workers
+    architectures: [x86_64, arm64]
+    instancesDistribution:
+      instanceTypes: [m5.large, m6g.large]
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.NodeGroups).To(HaveLen(2))
+
+			for arch, amiID := range map[string]string{"amazon-linux-2": "ami-x86", "amazon-linux-2-arm64": "ami-arm"} {
+				provider.MockSSM().On("GetParameter", &ssm.GetParameterInput{
+					Name: aws.String("/aws/service/eks/optimized-ami/1.14/" + arch + "/recommended/image_id"),
+				}).Return(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Value: aws.String(amiID),
+					},
+				}, nil)
+			}
+
+			for _, ng := range cfg.NodeGroups {
+				ng.AMIFamily = api.DefaultNodeImageFamily
+				Expect(ResolveAMI(provider, "1.14", ng)).To(Succeed())
+			}
+			Expect(cfg.NodeGroups[0].Name).To(Equal("workers-x86-64"))
+			Expect(cfg.NodeGroups[0].AMI).To(Equal("ami-x86"))
+			Expect(cfg.NodeGroups[1].Name).To(Equal("workers-arm64"))
+			Expect(cfg.NodeGroups[1].AMI).To(Equal("ami-arm"))
+		})
 	})
 
 })
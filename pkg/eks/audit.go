@@ -0,0 +1,140 @@
+package eks
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/pkg/errors"
+)
+
+// ErrCodeAuditedCall is the error code returned for the calls recorded by an AuditLog instead of being made
+const ErrCodeAuditedCall = "EksctlAuditedCall"
+
+// auditedParameterSuffixes are the suffixes of the names of the parameters recorded in the audit log,
+// which identify the resources an API call acts on
+var auditedParameterSuffixes = []string{"Name", "Names", "Id", "Ids", "Arn", "Arns", "ARN", "Resources"}
+
+// AuditedCall is a mutating AWS API call recorded instead of being made
+type AuditedCall struct {
+	// Service is the IAM service prefix of the call, e.g. cloudformation
+	Service string
+	// Action is the name of the API operation, e.g. CreateStack
+	Action string
+	// Parameters holds the parameters identifying the resources of the call,
+	// in the order of the fields of the input
+	Parameters []AuditedParameter
+}
+
+// AuditedParameter is a parameter of an AuditedCall
+type AuditedParameter struct {
+	Name  string
+	Value string
+}
+
+// String returns the call in the service:Action format of IAM policies, followed by its parameters
+func (c AuditedCall) String() string {
+	var b strings.Builder
+	b.WriteString(c.Service + ":" + c.Action)
+	for _, p := range c.Parameters {
+		fmt.Fprintf(&b, " %s=%s", p.Name, p.Value)
+	}
+	return b.String()
+}
+
+// AuditLog records the mutating AWS API calls of a session, which fail with ErrCodeAuditedCall instead of
+// being sent to AWS, so that callers stop before using an output they did not get. Read-only calls are made as usual
+type AuditLog struct {
+	mu    sync.Mutex
+	calls []AuditedCall
+}
+
+// Calls returns the calls recorded so far
+func (a *AuditLog) Calls() []AuditedCall {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]AuditedCall(nil), a.calls...)
+}
+
+// Print writes the recorded calls to w
+func (a *AuditLog) Print(w io.Writer) {
+	calls := a.Calls()
+	if len(calls) == 0 {
+		fmt.Fprintln(w, "no mutating AWS API calls would be made")
+		return
+	}
+	fmt.Fprintf(w, "the following %d mutating AWS API calls would be made:\n", len(calls))
+	for _, c := range calls {
+		fmt.Fprintf(w, "  %s\n", c)
+	}
+}
+
+func (a *AuditLog) handler() request.NamedHandler {
+	return request.NamedHandler{
+		Name: "eksctlAuditCalls",
+		Fn: func(r *request.Request) {
+			if r.Error != nil || r.Operation == nil || !isMutatingOperationName(r.Operation.Name) {
+				return
+			}
+			service := r.ClientInfo.SigningName
+			if service == "" {
+				service = r.ClientInfo.ServiceName
+			}
+			a.mu.Lock()
+			a.calls = append(a.calls, AuditedCall{
+				Service:    service,
+				Action:     r.Operation.Name,
+				Parameters: auditedParameters(r.Params),
+			})
+			a.mu.Unlock()
+
+			// failing the validation stops the request before it is built and sent, without retrying it
+			r.Error = awserr.New(ErrCodeAuditedCall, fmt.Sprintf("%s:%s was recorded instead of being made", service, r.Operation.Name), nil)
+		},
+	}
+}
+
+// IsAuditedCallError reports whether err was returned for a call recorded by an AuditLog
+func IsAuditedCallError(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == ErrCodeAuditedCall
+}
+
+func auditedParameters(params interface{}) []AuditedParameter {
+	v := reflect.Indirect(reflect.ValueOf(params))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var parameters []AuditedParameter
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if field.PkgPath != "" || !hasAuditedSuffix(field.Name) {
+			continue
+		}
+		switch value := v.Field(i).Interface().(type) {
+		case *string:
+			if value != nil {
+				parameters = append(parameters, AuditedParameter{Name: field.Name, Value: *value})
+			}
+		case []*string:
+			if len(value) > 0 {
+				parameters = append(parameters, AuditedParameter{Name: field.Name, Value: strings.Join(aws.StringValueSlice(value), ",")})
+			}
+		}
+	}
+	return parameters
+}
+
+func hasAuditedSuffix(name string) bool {
+	for _, suffix := range auditedParameterSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package eks_test
+
+import (
+	"bytes"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("Audit log", func() {
+	It("records the mutating calls and fails them instead of making them", func() {
+		s, auditLog := eks.NewAuditedSession(&api.ProviderConfig{Region: "us-west-2"})
+
+		output, err := cloudformation.New(s).CreateStack(&cloudformation.CreateStackInput{
+			StackName:    aws.String("eksctl-my-cluster-cluster"),
+			TemplateBody: aws.String("{}"),
+			RoleARN:      aws.String("arn:aws:iam::123456789012:role/cfn"),
+		})
+		Expect(err).To(MatchError(ContainSubstring("cloudformation:CreateStack was recorded instead of being made")))
+		Expect(eks.IsAuditedCallError(err)).To(BeTrue())
+		Expect(output.StackId).To(BeNil())
+		_, err = ec2.New(s).CreateTags(&ec2.CreateTagsInput{
+			Resources: aws.StringSlice([]string{"i-1", "i-2"}),
+			Tags:      []*ec2.Tag{{Key: aws.String("team"), Value: aws.String("data")}},
+		})
+		Expect(eks.IsAuditedCallError(err)).To(BeTrue())
+
+		Expect(auditLog.Calls()).To(Equal([]eks.AuditedCall{
+			{
+				Service: "cloudformation",
+				Action:  "CreateStack",
+				Parameters: []eks.AuditedParameter{
+					{Name: "RoleARN", Value: "arn:aws:iam::123456789012:role/cfn"},
+					{Name: "StackName", Value: "eksctl-my-cluster-cluster"},
+				},
+			},
+			{
+				Service:    "ec2",
+				Action:     "CreateTags",
+				Parameters: []eks.AuditedParameter{{Name: "Resources", Value: "i-1,i-2"}},
+			},
+		}))
+
+		var out bytes.Buffer
+		auditLog.Print(&out)
+		Expect(out.String()).To(Equal(`the following 2 mutating AWS API calls would be made:
+  cloudformation:CreateStack RoleARN=arn:aws:iam::123456789012:role/cfn StackName=eksctl-my-cluster-cluster
+  ec2:CreateTags Resources=i-1,i-2
+`))
+	})
+
+	It("makes the read-only calls", func() {
+		s, auditLog := eks.NewAuditedSession(&api.ProviderConfig{Region: "us-west-2"})
+
+		req, _ := ec2.New(s).DescribeSubnetsRequest(&ec2.DescribeSubnetsInput{})
+		req.Handlers.Validate.Run(req)
+		Expect(req.Error).NotTo(HaveOccurred())
+		Expect(req.Handlers.Send.Len()).NotTo(BeZero())
+		Expect(auditLog.Calls()).To(BeEmpty())
+	})
+	It("stops creating the cluster stack at the CreateStack call", func() {
+		provider, auditLog := eks.NewAuditedProvider(&api.ProviderConfig{Region: "us-west-2"})
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b"}
+		api.SetClusterConfigDefaults(cfg)
+		Expect(vpc.SetSubnets(cfg.VPC, cfg.AvailabilityZones)).To(Succeed())
+
+		stackManager := manager.NewStackCollection(provider, cfg)
+		taskTree := stackManager.NewTasksToCreateClusterWithNodeGroups(nil, nil, true, 1)
+		errs := taskTree.DoAllSync()
+		Expect(errs).To(HaveLen(1))
+		Expect(eks.IsAuditedCallError(errs[0])).To(BeTrue())
+
+		calls := auditLog.Calls()
+		Expect(calls).To(HaveLen(1))
+		Expect(calls[0].String()).To(Equal("cloudformation:CreateStack StackName=eksctl-my-cluster-cluster"))
+	})
+})
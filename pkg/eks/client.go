@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -32,6 +33,7 @@ type Client struct {
 	Config *clientcmdapi.Config
 
 	rawConfig *restclient.Config
+	proxy     ProxyFunc
 }
 
 // NewClient creates a new client config by embedding the STS token
@@ -39,6 +41,7 @@ func (c *ClusterProvider) NewClient(spec *api.ClusterConfig) (*Client, error) {
 	config := kubeconfig.NewForUser(spec, c.GetUsername())
 	client := &Client{
 		Config: config,
+		proxy:  c.proxy,
 	}
 	return client.new(spec, c.Provider.STS())
 }
@@ -61,6 +64,9 @@ func (c *Client) new(spec *api.ClusterConfig, stsClient stsiface.STSAPI) (*Clien
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create API client configuration from client config")
 	}
+	if c.proxy != nil {
+		rawConfig.Proxy = c.proxy
+	}
 	c.rawConfig = rawConfig
 
 	return c, nil
@@ -90,6 +96,15 @@ func (c *Client) NewClientSet() (*kubernetes.Clientset, error) {
 	return client, nil
 }
 
+// NewDynamicClient creates a new dynamic API client, for resources whose types are not known to eksctl
+func (c *Client) NewDynamicClient() (dynamic.Interface, error) {
+	client, err := dynamic.NewForConfig(c.rawConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create dynamic API client")
+	}
+	return client, nil
+}
+
 // NewStdClientSet creates a new API client in one go with an embedded STS token, this is most commonly used option
 func (c *ClusterProvider) NewStdClientSet(spec *api.ClusterConfig) (*kubernetes.Clientset, error) {
 	_, clientSet, err := c.newClientSetWithEmbeddedToken(spec)
@@ -124,6 +139,15 @@ func (c *ClusterProvider) NewRawClient(spec *api.ClusterConfig) (*kubewrapper.Ra
 	return kubewrapper.NewRawClient(clientSet, client.rawConfig)
 }
 
+// NewDynamicClient creates a new dynamic API client in one go with an embedded STS token
+func (c *ClusterProvider) NewDynamicClient(spec *api.ClusterConfig) (dynamic.Interface, error) {
+	client, err := c.NewClient(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Kubernetes client config with embedded token")
+	}
+	return client.NewDynamicClient()
+}
+
 // ServerVersion will use discovery API to fetch version of Kubernetes control plane
 func (c *ClusterProvider) ServerVersion(rawClient *kubewrapper.RawClient) (string, error) {
 	return rawClient.ServerVersion()
@@ -2,8 +2,14 @@ package eks_test
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
@@ -110,4 +116,57 @@ var _ = Describe("eks auth helpers", func() {
 			})
 		})
 	})
+
+	Describe("NewClient", func() {
+		var (
+			cfg     *api.ClusterConfig
+			stsAPI  *sts.STS
+			proxy   *httptest.Server
+			proxied chan string
+		)
+
+		BeforeEach(func() {
+			cfg = &api.ClusterConfig{
+				Metadata: &api.ClusterMeta{
+					Name:   "proxy-test-cluster",
+					Region: "eu-west-3",
+				},
+				Status: &api.ClusterStatus{
+					Endpoint:                 "https://proxy-test.aws",
+					CertificateAuthorityData: []byte("123"),
+				},
+			}
+
+			sess, err := session.NewSession(&aws.Config{
+				Region:      aws.String("eu-west-3"),
+				Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			stsAPI = sts.New(sess)
+
+			proxied = make(chan string, 10)
+			proxy = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				proxied <- r.Method + " " + r.Host
+				w.WriteHeader(http.StatusForbidden)
+			}))
+		})
+
+		AfterEach(func() {
+			proxy.Close()
+		})
+
+		It("sends the requests to the Kubernetes API through the configured proxy", func() {
+			proxyFunc, err := NewProxyFunc(&api.ProviderConfig{Proxy: proxy.URL})
+			Expect(err).NotTo(HaveOccurred())
+
+			client, err := NewClientWithProxy(cfg, stsAPI, proxyFunc)
+			Expect(err).NotTo(HaveOccurred())
+			clientSet, err := client.NewClientSet()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = clientSet.Discovery().ServerVersion()
+			Expect(err).To(HaveOccurred())
+			Expect(proxied).To(Receive(Equal("CONNECT proxy-test.aws:443")))
+		})
+	})
 })
@@ -0,0 +1,140 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// configList is the untyped form of a ClusterConfigList, the defaults are merged into each of
+// the items before the items are decoded as ClusterConfigs
+type configList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Defaults map[string]interface{}   `json:"defaults,omitempty"`
+	Items    []map[string]interface{} `json:"items"`
+}
+
+// IsConfigList returns true if data is a ClusterConfigList
+func IsConfigList(data []byte) bool {
+	typeMeta := metav1.TypeMeta{}
+	if err := yaml.Unmarshal(data, &typeMeta); err != nil {
+		return false
+	}
+	return typeMeta.Kind == api.ClusterConfigListKind
+}
+
+// ParseConfigList parses data into the ClusterConfigs of a ClusterConfigList. Each of the items is
+// validated independently, and the errors of all the invalid items are returned together
+func ParseConfigList(data []byte) ([]*api.ClusterConfig, error) {
+	// YAML anchors and merge keys are resolved by the conversion to JSON; the strict YAML parser
+	// rejects the keys that override those of a merge key, so it is only given the resulting JSON
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	list := configList{}
+	if err := yaml.UnmarshalStrict(jsonData, &list); err != nil {
+		return nil, err
+	}
+	if len(list.Items) == 0 {
+		return nil, errors.New("items must contain at least one cluster")
+	}
+
+	var (
+		clusterConfigs []*api.ClusterConfig
+		itemErrors     []string
+	)
+	itemsByName := map[string]int{}
+
+	for i, item := range list.Items {
+		cfg, err := parseConfigListItem(list, item)
+		if err == nil {
+			switch existing, found := itemsByName[cfg.Metadata.Name]; {
+			case cfg.Metadata.Name == "":
+				err = errors.New("metadata.name must be set")
+			case found:
+				err = fmt.Errorf("cluster %q is also defined by items[%d]", cfg.Metadata.Name, existing)
+			default:
+				itemsByName[cfg.Metadata.Name] = i
+			}
+		}
+		if err != nil {
+			itemErrors = append(itemErrors, fmt.Sprintf("items[%d]: %v", i, err))
+			continue
+		}
+		clusterConfigs = append(clusterConfigs, cfg)
+	}
+
+	if len(itemErrors) > 0 {
+		return nil, fmt.Errorf("invalid clusters in ClusterConfigList: %s", strings.Join(itemErrors, "; "))
+	}
+	return clusterConfigs, nil
+}
+
+func parseConfigListItem(list configList, item map[string]interface{}) (*api.ClusterConfig, error) {
+	merged := mergeConfigMaps(list.Defaults, item)
+	merged["apiVersion"] = list.APIVersion
+	merged["kind"] = api.ClusterConfigKind
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ParseConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Metadata == nil {
+		return nil, errors.New("metadata must be set")
+	}
+	return cfg, nil
+}
+
+// mergeConfigMaps returns the fields of overrides on top of the fields of defaults, nested maps
+// are merged recursively and any other value of overrides replaces the default
+func mergeConfigMaps(defaults, overrides map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(defaults)+len(overrides))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range overrides {
+		if defaultMap, ok := merged[k].(map[string]interface{}); ok {
+			if overrideMap, ok := v.(map[string]interface{}); ok {
+				merged[k] = mergeConfigMaps(defaultMap, overrideMap)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+// LoadConfigsFromFile loads the ClusterConfigs of configFile, which is either a ClusterConfig or
+// a ClusterConfigList; isList reports which of the two it is
+func LoadConfigsFromFile(configFile string) (clusterConfigs []*api.ClusterConfig, isList bool, err error) {
+	data, err := readConfig(configFile)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "reading config file %q", configFile)
+	}
+	if !IsConfigList(data) {
+		clusterConfig, err := ParseConfig(data)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "loading config file %q", configFile)
+		}
+		return []*api.ClusterConfig{clusterConfig}, false, nil
+	}
+
+	clusterConfigs, err = ParseConfigList(data)
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "loading config file %q", configFile)
+	}
+	return clusterConfigs, true, nil
+}
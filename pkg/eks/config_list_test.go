@@ -0,0 +1,142 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("ClusterConfigList", func() {
+	BeforeEach(func() {
+		Expect(api.Register()).To(Succeed())
+	})
+
+	It("merges the defaults into each of the clusters", func() {
+		clusterConfigs, err := ParseConfigList([]byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+defaults:
+  metadata:
+    region: us-west-2
+    version: "1.21"
+    tags:
+      team: platform
+  iam:
+    withOIDC: true
+items:
+  - metadata:
+      name: fleet-1
+  - metadata:
+      name: fleet-2
+      region: eu-west-1
+      tags:
+        env: staging
+    iam:
+      withOIDC: false
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterConfigs).To(HaveLen(2))
+
+		first, second := clusterConfigs[0], clusterConfigs[1]
+		Expect(first.Kind).To(Equal(api.ClusterConfigKind))
+		Expect(first.Metadata.Name).To(Equal("fleet-1"))
+		Expect(first.Metadata.Region).To(Equal("us-west-2"))
+		Expect(first.Metadata.Version).To(Equal("1.21"))
+		Expect(first.Metadata.Tags).To(Equal(map[string]string{"team": "platform"}))
+		Expect(first.IAM.WithOIDC).To(Equal(aws.Bool(true)))
+
+		Expect(second.Metadata.Name).To(Equal("fleet-2"))
+		Expect(second.Metadata.Region).To(Equal("eu-west-1"))
+		Expect(second.Metadata.Version).To(Equal("1.21"))
+		Expect(second.Metadata.Tags).To(Equal(map[string]string{"team": "platform", "env": "staging"}))
+		Expect(second.IAM.WithOIDC).To(Equal(aws.Bool(false)))
+	})
+
+	It("supports YAML anchors for sharing parts of the clusters", func() {
+		clusterConfigs, err := ParseConfigList([]byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+items:
+  - metadata:
+      name: fleet-1
+      region: us-west-2
+    managedNodeGroups:
+      - &workers
+        name: workers
+        instanceType: m5.large
+        desiredCapacity: 2
+  - metadata:
+      name: fleet-2
+      region: us-west-2
+    managedNodeGroups:
+      - <<: *workers
+        desiredCapacity: 5
+`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusterConfigs).To(HaveLen(2))
+		for i, desiredCapacity := range []int{2, 5} {
+			ng := clusterConfigs[i].ManagedNodeGroups[0]
+			Expect(ng.Name).To(Equal("workers"))
+			Expect(ng.InstanceType).To(Equal("m5.large"))
+			Expect(*ng.DesiredCapacity).To(Equal(desiredCapacity))
+		}
+	})
+
+	It("reports every invalid cluster", func() {
+		_, err := ParseConfigList([]byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+defaults:
+  metadata:
+    region: us-west-2
+items:
+  - metadata:
+      name: fleet-1
+  - metadata:
+      name: fleet-2
+    unknownField: true
+  - metadata:
+      region: eu-west-1
+  - metadata:
+      name: fleet-1
+`))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("invalid clusters in ClusterConfigList: "))
+		Expect(err.Error()).To(ContainSubstring(`items[1]: error unmarshaling JSON: while decoding JSON: json: unknown field "unknownField"`))
+		Expect(err.Error()).To(ContainSubstring("items[2]: metadata.name must be set"))
+		Expect(err.Error()).To(ContainSubstring(`items[3]: cluster "fleet-1" is also defined by items[0]`))
+		Expect(err.Error()).NotTo(ContainSubstring("items[0]:"))
+	})
+
+	It("rejects unknown fields of the list", func() {
+		_, err := ParseConfigList([]byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+clusters: []
+`))
+		Expect(err).To(MatchError(ContainSubstring(`unknown field "clusters"`)))
+	})
+
+	It("rejects a list without clusters", func() {
+		_, err := ParseConfigList([]byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+items: []
+`))
+		Expect(err).To(MatchError("items must contain at least one cluster"))
+	})
+
+	It("is only accepted where clusters are created", func() {
+		data := []byte(`
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfigList
+items: []
+`)
+		Expect(IsConfigList(data)).To(BeTrue())
+		_, err := ParseConfig(data)
+		Expect(err).To(MatchError("a ClusterConfigList can only be used to create clusters, with eksctl create cluster"))
+	})
+})
@@ -74,7 +74,13 @@ func (c *ClusterProvider) RefreshClusterStatus(spec *api.ClusterConfig) error {
 	case awseks.ClusterStatusCreating, awseks.ClusterStatusDeleting, awseks.ClusterStatusFailed:
 		return nil
 	default:
-		return spec.SetClusterStatus(cluster)
+		if err := spec.SetClusterStatus(cluster); err != nil {
+			return err
+		}
+		if spec.KubernetesNetworkConfig.IPv6Enabled() {
+			return c.setServiceIPv6CIDR(spec)
+		}
+		return nil
 	}
 }
 
@@ -276,8 +282,12 @@ func (c *ClusterProvider) NewOpenIDConnectManager(spec *api.ClusterConfig) (*iam
 		return nil, fmt.Errorf("unknown EKS ARN: %q", spec.Status.ARN)
 	}
 
+	tags := spec.IAMTags()
+	for k, v := range sharedTags(c.Status.ClusterInfo.Cluster) {
+		tags[k] = v
+	}
 	return iamoidc.NewOpenIDConnectManager(c.Provider.IAM(), parsedARN.AccountID,
-		*c.Status.ClusterInfo.Cluster.Identity.Oidc.Issuer, parsedARN.Partition, sharedTags(c.Status.ClusterInfo.Cluster))
+		*c.Status.ClusterInfo.Cluster.Identity.Oidc.Issuer, parsedARN.Partition, tags)
 }
 
 func sharedTags(cluster *awseks.Cluster) map[string]string {
@@ -325,7 +335,12 @@ func (c *ClusterProvider) loadClusterKubernetesNetworkConfig(spec *api.ClusterCo
 	}
 	knCfg := c.Status.ClusterInfo.Cluster.KubernetesNetworkConfig
 	if knCfg != nil {
+		ipFamily := ""
+		if spec.KubernetesNetworkConfig != nil {
+			ipFamily = spec.KubernetesNetworkConfig.IPFamily
+		}
 		spec.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+			IPFamily:        ipFamily,
 			ServiceIPv4CIDR: aws.StringValue(knCfg.ServiceIpv4Cidr),
 		}
 	}
@@ -385,6 +385,19 @@ var _ = Describe("EKS API wrapper", func() {
 			_, err := ctl.NewOpenIDConnectManager(cfg)
 			Expect(err).NotTo(HaveOccurred())
 		})
+
+		It("should tag the OIDC provider with the cluster and IAM tags", func() {
+			Expect(ctl.RefreshClusterStatus(cfg)).To(Succeed())
+			cfg.Metadata.Tags = map[string]string{"team": "platform", "env": "dev"}
+			cfg.IAM.Tags = map[string]string{"env": "prod", "cost-center": "42"}
+
+			oidc, err := ctl.NewOpenIDConnectManager(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(oidc.Tags()).To(HaveKeyWithValue("team", "platform"))
+			Expect(oidc.Tags()).To(HaveKeyWithValue("env", "prod"))
+			Expect(oidc.Tags()).To(HaveKeyWithValue("cost-center", "42"))
+			Expect(oidc.Tags()).To(HaveKeyWithValue(api.ClusterNameTag, "testcluster"))
+		})
 	})
 
 	Describe("CanDelete", func() {
@@ -612,6 +625,7 @@ var _ = Describe("EKS API wrapper", func() {
 
 	type kmsSupportCase struct {
 		key               string
+		resources         []string
 		errSubstr         string
 		kubernetesVersion string
 	}
@@ -621,7 +635,8 @@ var _ = Describe("EKS API wrapper", func() {
 		clusterConfig.Metadata.Version = k.kubernetesVersion
 
 		clusterConfig.SecretsEncryption = &api.SecretsEncryption{
-			KeyARN: k.key,
+			KeyARN:    k.key,
+			Resources: k.resources,
 		}
 		err := ValidateFeatureCompatibility(clusterConfig, nil)
 		if k.errSubstr != "" {
@@ -651,5 +666,23 @@ var _ = Describe("EKS API wrapper", func() {
 			errSubstr:         "KMS is only supported for EKS version 1.13 and above",
 			kubernetesVersion: "1.12",
 		}),
+		Entry("Valid resources", kmsSupportCase{
+			key:               "arn:aws:kms:us-west-2:000000000000:key/12345-12345",
+			resources:         []string{"secrets"},
+			errSubstr:         "",
+			kubernetesVersion: "1.20",
+		}),
+		Entry("Invalid resource", kmsSupportCase{
+			key:               "arn:aws:kms:us-west-2:000000000000:key/12345-12345",
+			resources:         []string{"secrets", "configmaps"},
+			errSubstr:         `invalid resource "configmaps" in secretsEncryption.resources, valid resources are: secrets`,
+			kubernetesVersion: "1.20",
+		}),
+		Entry("Duplicate resource", kmsSupportCase{
+			key:               "arn:aws:kms:us-west-2:000000000000:key/12345-12345",
+			resources:         []string{"secrets", "secrets"},
+			errSubstr:         `secretsEncryption.resources contains "secrets" more than once`,
+			kubernetesVersion: "1.20",
+		}),
 	)
 })
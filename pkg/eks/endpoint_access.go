@@ -0,0 +1,81 @@
+package eks
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// LookupIPFunc resolves a host to its IP addresses, like net.LookupIP
+type LookupIPFunc func(host string) ([]net.IP, error)
+
+// EndpointAccessSteps returns the endpoint access configurations a cluster needs to go through
+// to get from current to desired. When staged is set and public access is disabled before private
+// access has been enabled, private access is enabled first while public access is kept, so that
+// the private endpoint can be checked before public access is removed
+func EndpointAccessSteps(current, desired api.ClusterEndpoints, staged bool) []api.ClusterEndpoints {
+	disablesPublic := api.IsEnabled(current.PublicAccess) && api.IsDisabled(desired.PublicAccess)
+	if staged && disablesPublic && api.IsDisabled(current.PrivateAccess) && api.IsEnabled(desired.PrivateAccess) {
+		return []api.ClusterEndpoints{
+			{PrivateAccess: api.Enabled(), PublicAccess: api.Enabled()},
+			desired,
+		}
+	}
+	return []api.ClusterEndpoints{desired}
+}
+
+// CheckPrivateEndpointAccess checks that the Kubernetes API endpoint of the cluster resolves to
+// addresses within the cluster VPC from where eksctl is running, i.e. that the API server can
+// still be reached once public access is disabled. It requires private access to be enabled
+func (c *ClusterProvider) CheckPrivateEndpointAccess(spec *api.ClusterConfig, lookupIP LookupIPFunc) error {
+	if ok, err := c.CanOperate(spec); !ok {
+		return errors.Wrap(err, "unable to retrieve the cluster endpoint")
+	}
+	cluster := c.Status.ClusterInfo.Cluster
+
+	endpoint, err := url.Parse(aws.StringValue(cluster.Endpoint))
+	if err != nil {
+		return errors.Wrapf(err, "parsing cluster endpoint %q", aws.StringValue(cluster.Endpoint))
+	}
+	addresses, err := lookupIP(endpoint.Hostname())
+	if err != nil {
+		return errors.Wrapf(err, "resolving cluster endpoint %q", endpoint.Hostname())
+	}
+
+	vpcID := aws.StringValue(cluster.ResourcesVpcConfig.VpcId)
+	output, err := c.Provider.EC2().DescribeVpcs(&ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing VPC %q", vpcID)
+	}
+	if len(output.Vpcs) == 0 {
+		return fmt.Errorf("VPC %q was not found", vpcID)
+	}
+
+	var cidrs []*net.IPNet
+	for _, association := range output.Vpcs[0].CidrBlockAssociationSet {
+		_, cidr, err := net.ParseCIDR(aws.StringValue(association.CidrBlock))
+		if err != nil {
+			return errors.Wrapf(err, "parsing CIDR of VPC %q", vpcID)
+		}
+		cidrs = append(cidrs, cidr)
+	}
+
+	for _, address := range addresses {
+		for _, cidr := range cidrs {
+			if cidr.Contains(address) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("the Kubernetes API endpoint %q resolves to %v, which is outside of VPC %q; "+
+		"this machine does not appear to have private connectivity to the cluster and would lose access once public access is disabled, "+
+		"run eksctl from within the VPC (e.g. from a bastion host) or use --force", endpoint.Hostname(), addresses, vpcID)
+}
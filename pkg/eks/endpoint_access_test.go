@@ -0,0 +1,103 @@
+package eks_test
+
+import (
+	"errors"
+	"net"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Endpoint access", func() {
+	endpoints := func(private, public bool) api.ClusterEndpoints {
+		return api.ClusterEndpoints{PrivateAccess: aws.Bool(private), PublicAccess: aws.Bool(public)}
+	}
+
+	DescribeTable("EndpointAccessSteps", func(current, desired api.ClusterEndpoints, staged bool, expected []api.ClusterEndpoints) {
+		Expect(EndpointAccessSteps(current, desired, staged)).To(Equal(expected))
+	},
+		Entry("public to private-only", endpoints(false, true), endpoints(true, false), false,
+			[]api.ClusterEndpoints{endpoints(true, false)}),
+		Entry("staged public to private-only", endpoints(false, true), endpoints(true, false), true,
+			[]api.ClusterEndpoints{endpoints(true, true), endpoints(true, false)}),
+		Entry("staged public+private to private-only", endpoints(true, true), endpoints(true, false), true,
+			[]api.ClusterEndpoints{endpoints(true, false)}),
+		Entry("staged private-only to public", endpoints(true, false), endpoints(false, true), true,
+			[]api.ClusterEndpoints{endpoints(false, true)}),
+		Entry("staged public to public+private", endpoints(false, true), endpoints(true, true), true,
+			[]api.ClusterEndpoints{endpoints(true, true)}),
+	)
+
+	Describe("CheckPrivateEndpointAccess", func() {
+		var (
+			ctl *ClusterProvider
+			p   *mockprovider.MockProvider
+			cfg *api.ClusterConfig
+		)
+
+		lookupIP := func(addresses ...string) LookupIPFunc {
+			return func(host string) ([]net.IP, error) {
+				Expect(host).To(Equal("abc.gr7.us-west-2.eks.amazonaws.com"))
+				var ips []net.IP
+				for _, address := range addresses {
+					ips = append(ips, net.ParseIP(address))
+				}
+				return ips, nil
+			}
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+
+			cluster := testutils.NewFakeCluster("testcluster", awseks.ClusterStatusActive)
+			cluster.Endpoint = aws.String("https://abc.gr7.us-west-2.eks.amazonaws.com")
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+			p.MockEC2().On("DescribeVpcs", &ec2.DescribeVpcsInput{
+				VpcIds: aws.StringSlice([]string{"vpc-1234"}),
+			}).Return(&ec2.DescribeVpcsOutput{
+				Vpcs: []*ec2.Vpc{
+					{
+						VpcId: aws.String("vpc-1234"),
+						CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+							{CidrBlock: aws.String("192.168.0.0/16")},
+							{CidrBlock: aws.String("100.64.0.0/16")},
+						},
+					},
+				},
+			}, nil)
+		})
+
+		It("succeeds when the endpoint resolves to addresses within the VPC", func() {
+			Expect(ctl.CheckPrivateEndpointAccess(cfg, lookupIP("100.64.12.1", "100.64.40.2"))).To(Succeed())
+		})
+
+		It("fails when the endpoint resolves to public addresses", func() {
+			err := ctl.CheckPrivateEndpointAccess(cfg, lookupIP("52.10.1.1"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix(`the Kubernetes API endpoint "abc.gr7.us-west-2.eks.amazonaws.com" resolves to [52.10.1.1], which is outside of VPC "vpc-1234"`))
+		})
+
+		It("fails when the endpoint cannot be resolved", func() {
+			err := ctl.CheckPrivateEndpointAccess(cfg, func(string) ([]net.IP, error) {
+				return nil, errors.New("no such host")
+			})
+			Expect(err).To(MatchError(`resolving cluster endpoint "abc.gr7.us-west-2.eks.amazonaws.com": no such host`))
+		})
+	})
+})
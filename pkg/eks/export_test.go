@@ -0,0 +1,82 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+func NewSessionForProviderConfig(spec *api.ProviderConfig) (*session.Session, error) {
+	if err := validateRetryConfig(spec); err != nil {
+		return nil, err
+	}
+	proxy, err := NewProxyFunc(spec)
+	if err != nil {
+		return nil, err
+	}
+	c := &ClusterProvider{
+		Provider: &ProviderServices{spec: spec},
+		proxy:    proxy,
+	}
+	return c.newSession(spec), nil
+}
+
+func NewAuditedSession(spec *api.ProviderConfig) (*session.Session, *AuditLog) {
+	spec.AuditCalls = true
+	c := &ClusterProvider{
+		Provider: &ProviderServices{spec: spec},
+	}
+	s := c.newSession(spec)
+	return s, c.AuditLog
+}
+
+// NewAuditedProvider returns the CloudFormation and EC2 services of an audited session
+func NewAuditedProvider(spec *api.ProviderConfig) (*ProviderServices, *AuditLog) {
+	s, auditLog := NewAuditedSession(spec)
+	return &ProviderServices{
+		spec: spec,
+		cfn:  cloudformation.New(s),
+		ec2:  ec2.New(s),
+	}, auditLog
+}
+
+// NewEKSProvider returns provider services that only have the given EKS API
+func NewEKSProvider(eksAPI eksiface.EKSAPI) *ProviderServices {
+	return &ProviderServices{eks: eksAPI}
+}
+
+// NewClientWithProxy returns a client whose token is embedded using stsAPI and whose requests go through proxy
+func NewClientWithProxy(spec *api.ClusterConfig, stsAPI stsiface.STSAPI, proxy ProxyFunc) (*Client, error) {
+	client := &Client{
+		Config: kubeconfig.NewForUser(spec, "iam-root-account"),
+		proxy:  proxy,
+	}
+	return client.new(spec, stsAPI)
+}
+
+var (
+	DescribeUpgradePolicy = describeUpgradePolicy
+	UpdateUpgradePolicy   = updateUpgradePolicy
+
+	DescribeServiceIPv6CIDR = describeServiceIPv6CIDR
+
+	ResolvePrefixDelegationMaxPods = resolvePrefixDelegationMaxPods
+
+	IsAuditedOperationName = isMutatingOperationName
+)
+
+// NewClusterProviderWithProxy returns a cluster provider whose HTTP requests go through proxy
+func NewClusterProviderWithProxy(proxy ProxyFunc) *ClusterProvider {
+	return &ClusterProvider{proxy: proxy}
+}
+
+func SetCallerIPURL(url string) func() {
+	previous := callerIPURL
+	callerIPURL = url
+	return func() { callerIPURL = previous }
+}
@@ -86,6 +86,20 @@ func DoCreateFargateProfiles(config *api.ClusterConfig, fargateClient FargateCli
 }
 
 func ScheduleCoreDNSOnFargateIfRelevant(config *api.ClusterConfig, ctl *ClusterProvider, clientSet kubernetes.Interface) error {
+	var computeType string
+	if config.CoreDNS != nil {
+		computeType = config.CoreDNS.ComputeType
+	}
+	switch computeType {
+	case api.CoreDNSComputeTypeEC2:
+		return coredns.ScheduleOnEC2(clientSet)
+	case api.CoreDNSComputeTypeFargate:
+		if !coredns.IsSchedulableOnFargate(config.FargateProfiles) {
+			logger.Warning("coreDNS.computeType is set to %q but no Fargate profile selects the CoreDNS pods, CoreDNS will not be scheduled on Fargate", computeType)
+			return nil
+		}
+	}
+
 	if coredns.IsSchedulableOnFargate(config.FargateProfiles) {
 		scheduled, err := coredns.IsScheduledOnFargate(clientSet)
 		if err != nil {
@@ -0,0 +1,374 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// Values for the operations of GenerateIAMPolicy
+const (
+	// IAMPolicyOperationCreate is the creation of a cluster and its resources with `create cluster`
+	IAMPolicyOperationCreate = "create"
+	// IAMPolicyOperationUpgrade is the upgrade of a cluster and its resources with `upgrade cluster`, `upgrade nodegroup`
+	// and `update nodegroup`
+	IAMPolicyOperationUpgrade = "upgrade"
+	// IAMPolicyOperationDelete is the deletion of a cluster and its resources with `delete cluster`
+	IAMPolicyOperationDelete = "delete"
+)
+
+// IAMPolicyOperations returns the operations GenerateIAMPolicy can generate a policy for
+func IAMPolicyOperations() []string {
+	return []string{IAMPolicyOperationCreate, IAMPolicyOperationUpgrade, IAMPolicyOperationDelete}
+}
+
+// IAMPolicyDocument is an IAM policy document
+type IAMPolicyDocument struct {
+	Version   string               `json:"Version"`
+	Statement []IAMPolicyStatement `json:"Statement"`
+}
+
+// IAMPolicyStatement is a statement of an IAMPolicyDocument
+type IAMPolicyStatement struct {
+	Sid      string   `json:"Sid"`
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// iamPolicyFeature is a feature of the config and the actions it requires, per operation.
+// The actions are in the service:Action form of AuditedCall, so that the mutating ones
+// match the calls recorded with --audit-calls. They are allowed on all resources, unless
+// resource returns the only one they act on
+type iamPolicyFeature struct {
+	sid      string
+	enabled  func(cfg *api.ClusterConfig) bool
+	read     []string
+	actions  map[string][]string
+	resource func(cfg *api.ClusterConfig) string
+}
+
+var iamPolicyFeatures = []iamPolicyFeature{
+	{
+		sid:     "EksctlStacks",
+		enabled: func(*api.ClusterConfig) bool { return true },
+		read: []string{
+			"sts:GetCallerIdentity",
+			"cloudformation:DescribeStacks", "cloudformation:ListStacks", "cloudformation:DescribeStackEvents",
+			"cloudformation:DescribeStackResources", "cloudformation:GetTemplate",
+		},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate:  {"cloudformation:CreateStack"},
+			IAMPolicyOperationUpgrade: {
+				"cloudformation:UpdateStack", "cloudformation:CreateChangeSet", "cloudformation:DescribeChangeSet", "cloudformation:ExecuteChangeSet",
+				// `utils detect-stack-drift`, to check the stacks before upgrading them
+				"cloudformation:DetectStackDrift", "cloudformation:DescribeStackDriftDetectionStatus", "cloudformation:DescribeStackResourceDrifts",
+			},
+			IAMPolicyOperationDelete:  {"cloudformation:DeleteStack"},
+		},
+	},
+	{
+		sid:     "EksctlCluster",
+		enabled: func(*api.ClusterConfig) bool { return true },
+		read: []string{
+			"eks:DescribeCluster", "eks:ListClusters", "eks:DescribeUpdate", "eks:ListUpdates",
+			"ec2:DescribeAvailabilityZones", "ec2:DescribeRegions", "ec2:DescribeVpcs", "ec2:DescribeSubnets",
+			"ec2:DescribeRouteTables", "ec2:DescribeSecurityGroups",
+		},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {
+				"eks:CreateCluster", "eks:TagResource", "iam:CreateServiceLinkedRole",
+				"ec2:CreateSecurityGroup", "ec2:AuthorizeSecurityGroupIngress", "ec2:AuthorizeSecurityGroupEgress",
+				"ec2:RevokeSecurityGroupEgress", "ec2:CreateTags",
+			},
+			IAMPolicyOperationUpgrade: {"eks:UpdateClusterVersion", "eks:UpdateClusterConfig"},
+			IAMPolicyOperationDelete: {
+				"eks:DeleteCluster", "ec2:DeleteSecurityGroup", "ec2:RevokeSecurityGroupIngress",
+				"ec2:DescribeNetworkInterfaces", "ec2:DeleteNetworkInterface",
+				"elasticloadbalancing:DescribeLoadBalancers", "elasticloadbalancing:DeleteLoadBalancer",
+			},
+		},
+	},
+	{
+		sid:     "EksctlVPC",
+		enabled: func(cfg *api.ClusterConfig) bool { return cfg.VPC == nil || cfg.VPC.ID == "" },
+		read:    []string{"ec2:DescribeAddresses", "ec2:DescribeInternetGateways", "ec2:DescribeNatGateways"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {
+				"ec2:CreateVpc", "ec2:ModifyVpcAttribute", "ec2:CreateSubnet", "ec2:ModifySubnetAttribute",
+				"ec2:CreateInternetGateway", "ec2:AttachInternetGateway", "ec2:CreateRouteTable", "ec2:CreateRoute",
+				"ec2:AssociateRouteTable", "ec2:AllocateAddress", "ec2:CreateNatGateway",
+			},
+			IAMPolicyOperationDelete: {
+				"ec2:DeleteVpc", "ec2:DeleteSubnet", "ec2:DetachInternetGateway", "ec2:DeleteInternetGateway",
+				"ec2:DeleteRouteTable", "ec2:DeleteRoute", "ec2:DisassociateRouteTable", "ec2:ReleaseAddress",
+				"ec2:DeleteNatGateway",
+			},
+		},
+	},
+	{
+		sid:     "EksctlRoles",
+		enabled: needsIAMRoles,
+		read:    []string{"iam:GetRole", "iam:GetRolePolicy", "iam:ListAttachedRolePolicies"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {
+				"iam:CreateRole", "iam:TagRole", "iam:AttachRolePolicy", "iam:PutRolePolicy", "iam:PassRole",
+			},
+			IAMPolicyOperationUpgrade: {"iam:AttachRolePolicy", "iam:DetachRolePolicy", "iam:PutRolePolicy", "iam:DeleteRolePolicy"},
+			IAMPolicyOperationDelete: {
+				"iam:DeleteRole", "iam:DetachRolePolicy", "iam:DeleteRolePolicy",
+			},
+		},
+	},
+	{
+		// the existing service role still has to be passed to EKS when the cluster is created
+		sid:      "EksctlServiceRole",
+		enabled:  func(cfg *api.ClusterConfig) bool { return cfg.IAM != nil && cfg.IAM.ServiceRoleARN != nil },
+		read:     []string{"iam:GetRole"},
+		actions:  map[string][]string{IAMPolicyOperationCreate: {"iam:PassRole"}},
+		resource: func(cfg *api.ClusterConfig) string { return *cfg.IAM.ServiceRoleARN },
+	},
+	{
+		sid: "EksctlSecretsEncryption",
+		enabled: func(cfg *api.ClusterConfig) bool {
+			return cfg.SecretsEncryption != nil && cfg.SecretsEncryption.KeyARN != ""
+		},
+		read: []string{"kms:DescribeKey"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"kms:CreateGrant"},
+			// `utils enable-secrets-encryption`
+			IAMPolicyOperationUpgrade: {"kms:CreateGrant", "eks:AssociateEncryptionConfig"},
+		},
+	},
+	{
+		sid:     "EksctlOIDCProvider",
+		enabled: func(cfg *api.ClusterConfig) bool { return cfg.IAM != nil && api.IsEnabled(cfg.IAM.WithOIDC) },
+		read:    []string{"iam:GetOpenIDConnectProvider", "iam:ListOpenIDConnectProviders"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"iam:CreateOpenIDConnectProvider", "iam:TagOpenIDConnectProvider"},
+			IAMPolicyOperationDelete: {"iam:DeleteOpenIDConnectProvider"},
+		},
+	},
+	{
+		sid:     "EksctlNodeGroups",
+		enabled: func(cfg *api.ClusterConfig) bool { return len(cfg.NodeGroups) > 0 },
+		read: []string{
+			"ssm:GetParameter", "ec2:DescribeImages", "ec2:DescribeInstanceTypes", "ec2:DescribeInstanceTypeOfferings",
+			"ec2:DescribeLaunchTemplates", "ec2:DescribeLaunchTemplateVersions", "autoscaling:DescribeAutoScalingGroups",
+			"iam:GetInstanceProfile",
+		},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {
+				"ec2:CreateLaunchTemplate", "ec2:RunInstances", "autoscaling:CreateAutoScalingGroup",
+				"autoscaling:CreateOrUpdateTags", "iam:CreateInstanceProfile", "iam:AddRoleToInstanceProfile",
+				"iam:CreateServiceLinkedRole",
+			},
+			IAMPolicyOperationUpgrade: {
+				"ec2:CreateLaunchTemplateVersion", "autoscaling:UpdateAutoScalingGroup",
+				"autoscaling:StartInstanceRefresh", "autoscaling:DescribeInstanceRefreshes",
+				"autoscaling:TerminateInstanceInAutoScalingGroup",
+			},
+			IAMPolicyOperationDelete: {
+				"ec2:DeleteLaunchTemplate", "autoscaling:UpdateAutoScalingGroup", "autoscaling:DeleteAutoScalingGroup",
+				"iam:RemoveRoleFromInstanceProfile", "iam:DeleteInstanceProfile",
+			},
+		},
+	},
+	{
+		sid: "EksctlEFA",
+		enabled: func(cfg *api.ClusterConfig) bool {
+			for _, ng := range cfg.AllNodeGroups() {
+				if api.IsEnabled(ng.EFAEnabled) {
+					return true
+				}
+			}
+			return false
+		},
+		read: []string{"ec2:DescribePlacementGroups"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"ec2:CreatePlacementGroup"},
+			IAMPolicyOperationDelete: {"ec2:DeletePlacementGroup"},
+		},
+	},
+	{
+		sid: "EksctlEFSMounts",
+		enabled: func(cfg *api.ClusterConfig) bool {
+			for _, ng := range cfg.AllNodeGroups() {
+				if len(ng.EFSMounts) > 0 {
+					return true
+				}
+			}
+			return false
+		},
+		read: []string{"elasticfilesystem:DescribeFileSystems", "elasticfilesystem:DescribeMountTargets", "elasticfilesystem:DescribeMountTargetSecurityGroups"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"ec2:AuthorizeSecurityGroupIngress"},
+			IAMPolicyOperationDelete: {"ec2:RevokeSecurityGroupIngress"},
+		},
+	},
+	{
+		sid: "EksctlWarmPools",
+		enabled: func(cfg *api.ClusterConfig) bool {
+			for _, ng := range cfg.NodeGroups {
+				if ng.WarmPool != nil {
+					return true
+				}
+			}
+			return false
+		},
+		read: []string{"autoscaling:DescribeWarmPool"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate:  {"autoscaling:PutWarmPool"},
+			IAMPolicyOperationUpgrade: {"autoscaling:PutWarmPool"},
+			IAMPolicyOperationDelete:  {"autoscaling:DeleteWarmPool"},
+		},
+	},
+	{
+		sid:     "EksctlManagedNodeGroups",
+		enabled: func(cfg *api.ClusterConfig) bool { return len(cfg.ManagedNodeGroups) > 0 },
+		read: []string{
+			"ssm:GetParameter", "eks:ListNodegroups", "eks:DescribeNodegroup", "ec2:DescribeInstanceTypes",
+			"ec2:DescribeInstanceTypeOfferings", "ec2:DescribeLaunchTemplateVersions",
+		},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate:  {"eks:CreateNodegroup", "eks:TagResource", "ec2:CreateLaunchTemplate", "iam:CreateServiceLinkedRole"},
+			IAMPolicyOperationUpgrade: {"eks:UpdateNodegroupVersion", "eks:UpdateNodegroupConfig", "eks:TagResource", "ec2:CreateLaunchTemplateVersion"},
+			IAMPolicyOperationDelete:  {"eks:DeleteNodegroup", "ec2:DeleteLaunchTemplate"},
+		},
+	},
+	{
+		sid: "EksctlSSHKeys",
+		enabled: func(cfg *api.ClusterConfig) bool {
+			for _, ng := range cfg.AllNodeGroups() {
+				if ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) && (ng.SSH.PublicKeyPath != nil || ng.SSH.PublicKey != nil) {
+					return true
+				}
+			}
+			return false
+		},
+		read: []string{"ec2:DescribeKeyPairs"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"ec2:ImportKeyPair"},
+			IAMPolicyOperationDelete: {"ec2:DeleteKeyPair"},
+		},
+	},
+	{
+		sid:     "EksctlFargateProfiles",
+		enabled: func(cfg *api.ClusterConfig) bool { return len(cfg.FargateProfiles) > 0 },
+		read:    []string{"eks:ListFargateProfiles", "eks:DescribeFargateProfile"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"eks:CreateFargateProfile"},
+			IAMPolicyOperationDelete: {"eks:DeleteFargateProfile"},
+		},
+	},
+	{
+		sid:     "EksctlAddons",
+		enabled: func(cfg *api.ClusterConfig) bool { return len(cfg.Addons) > 0 },
+		read:    []string{"eks:ListAddons", "eks:DescribeAddon", "eks:DescribeAddonVersions"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate:  {"eks:CreateAddon", "eks:TagResource"},
+			IAMPolicyOperationUpgrade: {"eks:UpdateAddon", "eks:TagResource"},
+			IAMPolicyOperationDelete:  {"eks:DeleteAddon"},
+		},
+	},
+	{
+		sid:     "EksctlIdentityProviders",
+		enabled: func(cfg *api.ClusterConfig) bool { return len(cfg.IdentityProviders) > 0 },
+		read:    []string{"eks:ListIdentityProviderConfigs", "eks:DescribeIdentityProviderConfig"},
+		actions: map[string][]string{
+			IAMPolicyOperationCreate: {"eks:AssociateIdentityProviderConfig"},
+			IAMPolicyOperationDelete: {"eks:DisassociateIdentityProviderConfig"},
+		},
+	},
+	{
+		sid:     "EksctlClusterLogging",
+		enabled: func(cfg *api.ClusterConfig) bool { return cfg.HasClusterCloudWatchLogging() },
+		actions: map[string][]string{
+			IAMPolicyOperationCreate:  {"eks:UpdateClusterConfig"},
+			IAMPolicyOperationUpgrade: {"eks:UpdateClusterConfig"},
+		},
+	},
+}
+
+// needsIAMRoles returns true if eksctl creates IAM roles for the cluster, its nodegroups or its service accounts
+func needsIAMRoles(cfg *api.ClusterConfig) bool {
+	if cfg.IAM == nil || cfg.IAM.ServiceRoleARN == nil || len(cfg.IAM.ServiceAccounts) > 0 {
+		return true
+	}
+	if len(cfg.FargateProfiles) > 0 && cfg.IAM.FargatePodExecutionRoleARN == nil {
+		return true
+	}
+	for _, ng := range cfg.AllNodeGroups() {
+		if ng.IAM == nil || ng.IAM.InstanceRoleARN == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateIAMPolicy returns the IAM policy allowing the actions eksctl makes to carry out the operation
+// on the cluster of the config, with one statement for each of the features the config uses. The role
+// passed to CloudFormation with --cfn-role-arn, if any, must be passed to it by the caller
+func GenerateIAMPolicy(cfg *api.ClusterConfig, operation, cloudFormationRoleARN string) (*IAMPolicyDocument, error) {
+	valid := false
+	for _, o := range IAMPolicyOperations() {
+		valid = valid || o == operation
+	}
+	if !valid {
+		return nil, fmt.Errorf("invalid operation %q, valid operations: %s", operation, strings.Join(IAMPolicyOperations(), ", "))
+	}
+
+	policy := &IAMPolicyDocument{Version: "2012-10-17"}
+	for _, feature := range iamPolicyFeatures {
+		if !feature.enabled(cfg) {
+			continue
+		}
+		actions := uniqueSortedActions(append(append([]string{}, feature.read...), feature.actions[operation]...))
+		if len(actions) == 0 {
+			continue
+		}
+		resource := "*"
+		if feature.resource != nil {
+			resource = feature.resource(cfg)
+		}
+		policy.Statement = append(policy.Statement, IAMPolicyStatement{
+			Sid:      feature.sid,
+			Effect:   "Allow",
+			Action:   actions,
+			Resource: resource,
+		})
+	}
+	if cloudFormationRoleARN != "" {
+		policy.Statement = append(policy.Statement, IAMPolicyStatement{
+			Sid:      "EksctlCloudFormationRole",
+			Effect:   "Allow",
+			Action:   []string{"iam:PassRole"},
+			Resource: cloudFormationRoleARN,
+		})
+	}
+	return policy, nil
+}
+
+// Actions returns the distinct actions of the policy
+func (p *IAMPolicyDocument) Actions() []string {
+	var actions []string
+	for _, s := range p.Statement {
+		actions = append(actions, s.Action...)
+	}
+	return uniqueSortedActions(actions)
+}
+
+func uniqueSortedActions(actions []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, action := range actions {
+		if !seen[action] {
+			seen[action] = true
+			unique = append(unique, action)
+		}
+	}
+	sort.Strings(unique)
+	return unique
+}
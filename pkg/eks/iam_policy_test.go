@@ -0,0 +1,283 @@
+package eks_test
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("GenerateIAMPolicy", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "my-cluster"
+		cfg.Metadata.Region = "us-west-2"
+	})
+
+	generate := func(operation string) []string {
+		policy, err := eks.GenerateIAMPolicy(cfg, operation, "")
+		Expect(err).NotTo(HaveOccurred())
+		return policy.Actions()
+	}
+
+	It("includes the actions of the cluster, its stacks and VPC", func() {
+		actions := generate(eks.IAMPolicyOperationCreate)
+		Expect(actions).To(ContainElements("cloudformation:CreateStack", "eks:CreateCluster", "ec2:CreateVpc", "iam:CreateRole", "iam:PassRole"))
+		Expect(actions).NotTo(ContainElement("kms:CreateGrant"))
+		Expect(actions).NotTo(ContainElement("iam:CreateOpenIDConnectProvider"))
+		Expect(actions).NotTo(ContainElement("eks:CreateNodegroup"))
+	})
+
+	It("includes the KMS actions when secrets encryption is enabled", func() {
+		cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: "arn:aws:kms:us-west-2:123456789012:key/12345678-1234-1234-1234-123456789012"}
+		Expect(generate(eks.IAMPolicyOperationCreate)).To(ContainElements("kms:DescribeKey", "kms:CreateGrant"))
+	})
+
+	It("includes the actions of the configured features", func() {
+		cfg.IAM.WithOIDC = api.Enabled()
+		cfg.VPC.ID = "vpc-1"
+		cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/cluster")
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{NodeGroupBase: &api.NodeGroupBase{
+			Name: "mng",
+			IAM:  &api.NodeGroupIAM{InstanceRoleARN: "arn:aws:iam::123456789012:role/nodes"},
+		}}}
+		cfg.Addons = []*api.Addon{{Name: "vpc-cni"}}
+
+		actions := generate(eks.IAMPolicyOperationCreate)
+		Expect(actions).To(ContainElements("iam:CreateOpenIDConnectProvider", "eks:CreateNodegroup", "eks:CreateAddon"))
+		Expect(actions).NotTo(ContainElement("ec2:CreateVpc"))
+		Expect(actions).NotTo(ContainElement("iam:CreateRole"))
+		Expect(actions).NotTo(ContainElement("autoscaling:CreateAutoScalingGroup"))
+
+		actions = generate(eks.IAMPolicyOperationDelete)
+		Expect(actions).To(ContainElements("iam:DeleteOpenIDConnectProvider", "eks:DeleteNodegroup", "eks:DeleteAddon", "eks:DeleteCluster"))
+		Expect(actions).NotTo(ContainElement("eks:CreateCluster"))
+
+		actions = generate(eks.IAMPolicyOperationUpgrade)
+		Expect(actions).To(ContainElements("eks:UpdateClusterVersion", "eks:UpdateNodegroupVersion", "eks:UpdateAddon"))
+	})
+
+	It("scopes the actions on an existing service role to it", func() {
+		cfg.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/cluster")
+
+		policy, err := eks.GenerateIAMPolicy(cfg, eks.IAMPolicyOperationCreate, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement).To(ContainElement(eks.IAMPolicyStatement{
+			Sid:      "EksctlServiceRole",
+			Effect:   "Allow",
+			Action:   []string{"iam:GetRole", "iam:PassRole"},
+			Resource: "arn:aws:iam::123456789012:role/cluster",
+		}))
+
+		policy, err = eks.GenerateIAMPolicy(cfg, eks.IAMPolicyOperationDelete, "")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement).To(ContainElement(eks.IAMPolicyStatement{
+			Sid:      "EksctlServiceRole",
+			Effect:   "Allow",
+			Action:   []string{"iam:GetRole"},
+			Resource: "arn:aws:iam::123456789012:role/cluster",
+		}))
+	})
+
+	It("generates a valid policy document", func() {
+		policy, err := eks.GenerateIAMPolicy(cfg, eks.IAMPolicyOperationCreate, "")
+		Expect(err).NotTo(HaveOccurred())
+		data, err := json.Marshal(policy)
+		Expect(err).NotTo(HaveOccurred())
+
+		var document map[string]interface{}
+		Expect(json.Unmarshal(data, &document)).To(Succeed())
+		Expect(document).To(HaveKeyWithValue("Version", "2012-10-17"))
+		statement := document["Statement"].([]interface{})[0].(map[string]interface{})
+		Expect(statement).To(HaveKeyWithValue("Effect", "Allow"))
+		Expect(statement).To(HaveKeyWithValue("Resource", "*"))
+		Expect(statement).To(HaveKey("Sid"))
+		Expect(statement["Action"]).NotTo(BeEmpty())
+	})
+
+	It("uses the actions recorded with --audit-calls", func() {
+		cfg.IAM.WithOIDC = api.Enabled()
+		cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: "arn:aws:kms:us-west-2:123456789012:key/1"}
+		cfg.NodeGroups = []*api.NodeGroup{{NodeGroupBase: &api.NodeGroupBase{
+			Name:      "ng",
+			SSH:       &api.NodeGroupSSH{Allow: api.Enabled(), PublicKey: aws.String("ssh-rsa AAAA")},
+			EFSMounts: []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}},
+		}, WarmPool: &api.WarmPool{}}}
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{NodeGroupBase: &api.NodeGroupBase{Name: "mng"}}}
+		cfg.FargateProfiles = []*api.FargateProfile{{Name: "fp"}}
+		cfg.Addons = []*api.Addon{{Name: "vpc-cni"}}
+		cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api"}
+
+		Expect(generate(eks.IAMPolicyOperationCreate)).To(ContainElements("elasticfilesystem:DescribeMountTargets", "autoscaling:PutWarmPool", "ec2:ImportKeyPair", "eks:CreateFargateProfile"))
+		for _, operation := range eks.IAMPolicyOperations() {
+			for _, action := range generate(operation) {
+				parts := strings.SplitN(action, ":", 2)
+				Expect(parts).To(HaveLen(2), action)
+				if action == "iam:PassRole" {
+					// a permission rather than an API call
+					continue
+				}
+				readOnly := strings.HasPrefix(parts[1], "Describe") || strings.HasPrefix(parts[1], "List") || strings.HasPrefix(parts[1], "Get") ||
+					strings.HasPrefix(parts[1], "Detect")
+				Expect(readOnly != eks.IsAuditedOperationName(parts[1])).To(BeTrue(), "%s must be either read-only or recorded by the audit log", action)
+			}
+		}
+	})
+
+	It("includes the actions of EFA, the service-linked roles and the tags of nodegroups and addons", func() {
+		cfg.NodeGroups = []*api.NodeGroup{{NodeGroupBase: &api.NodeGroupBase{Name: "ng", EFAEnabled: api.Enabled()}}}
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{NodeGroupBase: &api.NodeGroupBase{Name: "mng"}}}
+		cfg.Addons = []*api.Addon{{Name: "vpc-cni"}}
+
+		policy, err := eks.GenerateIAMPolicy(cfg, eks.IAMPolicyOperationCreate, "")
+		Expect(err).NotTo(HaveOccurred())
+		statementActions := map[string][]string{}
+		for _, s := range policy.Statement {
+			statementActions[s.Sid] = s.Action
+		}
+		Expect(statementActions["EksctlEFA"]).To(ConsistOf("ec2:DescribePlacementGroups", "ec2:CreatePlacementGroup"))
+		Expect(statementActions["EksctlCluster"]).To(ContainElement("iam:CreateServiceLinkedRole"))
+		Expect(statementActions["EksctlManagedNodeGroups"]).To(ContainElements("eks:TagResource", "iam:CreateServiceLinkedRole"))
+		Expect(statementActions["EksctlAddons"]).To(ContainElement("eks:TagResource"))
+		Expect(generate(eks.IAMPolicyOperationUpgrade)).To(ContainElement("cloudformation:DetectStackDrift"))
+	})
+
+	It("allows passing the role of --cfn-role-arn to CloudFormation", func() {
+		const roleARN = "arn:aws:iam::123456789012:role/cloudformation"
+		for _, operation := range eks.IAMPolicyOperations() {
+			policy, err := eks.GenerateIAMPolicy(cfg, operation, roleARN)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Statement).To(ContainElement(eks.IAMPolicyStatement{
+				Sid:      "EksctlCloudFormationRole",
+				Effect:   "Allow",
+				Action:   []string{"iam:PassRole"},
+				Resource: roleARN,
+			}))
+		}
+	})
+
+	It("includes every mutating API call eksctl makes", func() {
+		cfg.IAM.WithOIDC = api.Enabled()
+		cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: "arn:aws:kms:us-west-2:123456789012:key/1"}
+		cfg.NodeGroups = []*api.NodeGroup{{NodeGroupBase: &api.NodeGroupBase{
+			Name:       "ng",
+			SSH:        &api.NodeGroupSSH{Allow: api.Enabled(), PublicKey: aws.String("ssh-rsa AAAA")},
+			EFSMounts:  []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}},
+			EFAEnabled: api.Enabled(),
+		}, WarmPool: &api.WarmPool{}}}
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{NodeGroupBase: &api.NodeGroupBase{Name: "mng"}}}
+		cfg.FargateProfiles = []*api.FargateProfile{{Name: "fp"}}
+		cfg.Addons = []*api.Addon{{Name: "vpc-cni"}}
+		cfg.IdentityProviders = []api.IdentityProvider{{Inner: &api.OIDCIdentityProvider{Name: "idp"}}}
+		cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api"}
+
+		allowed := map[string]bool{}
+		for _, operation := range eks.IAMPolicyOperations() {
+			for _, action := range generate(operation) {
+				allowed[action] = true
+			}
+		}
+		// the calls of commands that are not part of the lifecycle of a cluster
+		notInPolicy := map[string]string{
+			"eks:RegisterCluster":   "register cluster",
+			"eks:DeregisterCluster": "deregister cluster",
+		}
+
+		calls := mutatingAPICalls("..")
+		Expect(calls).To(HaveKey("cloudformation:CreateStack"))
+		for action, file := range calls {
+			if _, ok := notInPolicy[action]; ok {
+				continue
+			}
+			Expect(allowed).To(HaveKey(action), "%s is called in %s", action, file)
+		}
+	})
+
+	It("rejects unknown operations", func() {
+		_, err := eks.GenerateIAMPolicy(cfg, "scale", "")
+		Expect(err).To(MatchError(`invalid operation "scale", valid operations: create, upgrade, delete`))
+	})
+})
+
+// iamServicePrefixes are the IAM service prefixes of the AWS SDK packages whose name differs from their prefix
+var iamServicePrefixes = map[string]string{
+	"efs":   "elasticfilesystem",
+	"elb":   "elasticloadbalancing",
+	"elbv2": "elasticloadbalancing",
+}
+
+// mutatingAPICalls returns the mutating AWS API calls whose input is built in the non-test files under dir,
+// in the service:Action form of AuditedCall, with one of the files they are built in
+func mutatingAPICalls(dir string) map[string]string {
+	calls := map[string]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if name := info.Name(); name == "fakes" || name == "mocks" || name == "mocksv2" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly|parser.ParseComments)
+		if err != nil {
+			return err
+		}
+		services := map[string]string{}
+		for _, imp := range file.Imports {
+			importPath := strings.Trim(imp.Path.Value, `"`)
+			if !strings.HasPrefix(importPath, "github.com/aws/aws-sdk-go/service/") || strings.Count(importPath, "/") != 4 {
+				continue
+			}
+			name := filepath.Base(importPath)
+			alias := name
+			if imp.Name != nil {
+				alias = imp.Name.Name
+			}
+			if prefix, ok := iamServicePrefixes[name]; ok {
+				name = prefix
+			}
+			services[alias] = name
+		}
+		if len(services) == 0 {
+			return nil
+		}
+
+		file, err = parser.ParseFile(token.NewFileSet(), path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok || !strings.HasSuffix(sel.Sel.Name, "Input") {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); ok {
+				action := strings.TrimSuffix(sel.Sel.Name, "Input")
+				if service, ok := services[pkg.Name]; ok && eks.IsAuditedOperationName(action) {
+					calls[service+":"+action] = path
+				}
+			}
+			return true
+		})
+		return nil
+	})
+	Expect(err).NotTo(HaveOccurred())
+	return calls
+}
@@ -0,0 +1,78 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// The IP family and the service IPv6 CIDR of the cluster are not part of the EKS API of the AWS SDK yet,
+// so they are read with a request that has its own input and output shapes
+
+type describeKubernetesNetworkConfigInput struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+type kubernetesNetworkConfig struct {
+	_ struct{} `type:"structure"`
+
+	IPFamily        *string `locationName:"ipFamily" type:"string"`
+	ServiceIPv6CIDR *string `locationName:"serviceIpv6Cidr" type:"string"`
+}
+
+type clusterKubernetesNetworkConfig struct {
+	_ struct{} `type:"structure"`
+
+	KubernetesNetworkConfig *kubernetesNetworkConfig `locationName:"kubernetesNetworkConfig" type:"structure"`
+}
+
+type describeKubernetesNetworkConfigOutput struct {
+	_ struct{} `type:"structure"`
+
+	Cluster *clusterKubernetesNetworkConfig `locationName:"cluster" type:"structure"`
+}
+
+// setServiceIPv6CIDR sets the service IPv6 CIDR that EKS assigned to the IPv6 cluster in its status
+func (c *ClusterProvider) setServiceIPv6CIDR(spec *api.ClusterConfig) error {
+	client, err := awsEKSClient(c.Provider.EKS(), "IPv6 clusters")
+	if err != nil {
+		return err
+	}
+	serviceIPv6CIDR, err := describeServiceIPv6CIDR(client, spec.Metadata.Name)
+	if err != nil {
+		return err
+	}
+	if spec.Status.KubernetesNetworkConfig == nil {
+		spec.Status.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{}
+	}
+	spec.Status.KubernetesNetworkConfig.IPFamily = api.IPV6Family
+	spec.Status.KubernetesNetworkConfig.ServiceIPv6CIDR = serviceIPv6CIDR
+	return nil
+}
+
+func describeServiceIPv6CIDR(client *eks.EKS, clusterName string) (string, error) {
+	op := &request.Operation{
+		Name:       "DescribeCluster",
+		HTTPMethod: "GET",
+		HTTPPath:   "/clusters/{name}",
+	}
+	output := &describeKubernetesNetworkConfigOutput{}
+	req := client.NewRequest(op, &describeKubernetesNetworkConfigInput{Name: aws.String(clusterName)}, output)
+	if err := req.Send(); err != nil {
+		return "", errors.Wrapf(err, "describing kubernetes network config of cluster %q", clusterName)
+	}
+
+	if output.Cluster == nil || output.Cluster.KubernetesNetworkConfig == nil || !strings.EqualFold(aws.StringValue(output.Cluster.KubernetesNetworkConfig.IPFamily), api.IPV6Family) ||
+		output.Cluster.KubernetesNetworkConfig.ServiceIPv6CIDR == nil {
+		return "", fmt.Errorf("cluster %q has no service IPv6 CIDR, kubernetesNetworkConfig.ipFamily must match the IP family the cluster was created with", clusterName)
+	}
+	return *output.Cluster.KubernetesNetworkConfig.ServiceIPv6CIDR, nil
+}
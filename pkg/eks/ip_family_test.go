@@ -0,0 +1,95 @@
+package eks_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("Cluster service IPv6 CIDR", func() {
+	var (
+		server   *httptest.Server
+		client   *awseks.EKS
+		response string
+
+		requestMethod, requestPath string
+	)
+
+	BeforeEach(func() {
+		requestMethod, requestPath = "", ""
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestMethod, requestPath = r.Method, r.URL.Path
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(response))
+		}))
+
+		sess, err := session.NewSession(&aws.Config{
+			Endpoint:    aws.String(server.URL),
+			Region:      aws.String("us-west-2"),
+			Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		client = awseks.New(sess)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("describes the service IPv6 CIDR assigned to the cluster", func() {
+		response = `{"cluster": {"name": "test", "kubernetesNetworkConfig": {"ipFamily": "ipv6", "serviceIpv6Cidr": "fd30:1c53:5f8a::/108"}}}`
+
+		serviceIPv6CIDR, err := DescribeServiceIPv6CIDR(client, "test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(serviceIPv6CIDR).To(Equal("fd30:1c53:5f8a::/108"))
+		Expect(requestMethod).To(Equal("GET"))
+		Expect(requestPath).To(Equal("/clusters/test"))
+	})
+
+	It("fails for clusters that are not IPv6 clusters", func() {
+		response = `{"cluster": {"name": "test", "kubernetesNetworkConfig": {"ipFamily": "ipv4", "serviceIpv4Cidr": "10.100.0.0/16"}}}`
+
+		_, err := DescribeServiceIPv6CIDR(client, "test")
+		Expect(err).To(MatchError(`cluster "test" has no service IPv6 CIDR, kubernetesNetworkConfig.ipFamily must match the IP family the cluster was created with`))
+	})
+
+	Context("refreshing the status of the cluster", func() {
+		var (
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			response = `{"cluster": {"name": "test", "status": "ACTIVE", "arn": "arn:aws:eks:us-west-2:123456789012:cluster/test", "endpoint": "https://test.eks.amazonaws.com",
+				"certificateAuthority": {"data": "dGVzdA=="}, "kubernetesNetworkConfig": {"ipFamily": "ipv6", "serviceIpv6Cidr": "fd30:1c53:5f8a::/108"}}}`
+
+			ctl = &ClusterProvider{Provider: NewEKSProvider(client), Status: &ProviderStatus{}}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test"
+		})
+
+		It("sets the service IPv6 CIDR of IPv6 clusters", func() {
+			cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+
+			Expect(ctl.RefreshClusterStatus(cfg)).To(Succeed())
+			Expect(cfg.Status.KubernetesNetworkConfig).To(Equal(&api.KubernetesNetworkConfig{
+				IPFamily:        api.IPV6Family,
+				ServiceIPv6CIDR: "fd30:1c53:5f8a::/108",
+			}))
+		})
+
+		It("does not describe the service IPv6 CIDR of IPv4 clusters", func() {
+			Expect(ctl.RefreshClusterStatus(cfg)).To(Succeed())
+			Expect(cfg.Status.KubernetesNetworkConfig).To(BeNil())
+		})
+	})
+})
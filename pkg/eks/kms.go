@@ -0,0 +1,73 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// multiRegionKeyPrefix is the prefix of the IDs of multi-Region KMS keys, which is shared by
+// the primary key and all of its replicas
+const multiRegionKeyPrefix = "mrk-"
+
+// ResolveKMSKey validates that the KMS key in secretsEncryption.keyARN is usable in the region of the cluster.
+// Multi-Region keys from another region are resolved to their replica in the region of the cluster
+func ResolveKMSKey(kmsAPI kmsiface.KMSAPI, clusterConfig *api.ClusterConfig) error {
+	if clusterConfig.SecretsEncryption == nil {
+		return nil
+	}
+	keyARN := clusterConfig.SecretsEncryption.KeyARN
+	parsed, err := arn.Parse(keyARN)
+	if err != nil {
+		return errors.Wrapf(err, "invalid ARN in secretsEncryption.keyARN: %q", keyARN)
+	}
+
+	region := clusterConfig.Metadata.Region
+	if parsed.Region != region {
+		keyID := strings.TrimPrefix(parsed.Resource, "key/")
+		if !strings.HasPrefix(keyID, multiRegionKeyPrefix) {
+			return fmt.Errorf("KMS key %q is in region %q but the cluster is in region %q; only multi-Region keys can be used from another region", keyARN, parsed.Region, region)
+		}
+		parsed.Region = region
+		replicaARN := parsed.String()
+		output, err := kmsAPI.DescribeKey(&kms.DescribeKeyInput{
+			KeyId: aws.String(replicaARN),
+		})
+		if err != nil {
+			if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == kms.ErrCodeNotFoundException {
+				return fmt.Errorf("multi-Region KMS key %q has no replica in region %q; replicate it with `aws kms replicate-key --key-id %s --replica-region %s`", keyARN, region, keyARN, region)
+			}
+			return errors.Wrapf(err, "describing KMS key %q", replicaARN)
+		}
+		if err := validateKMSKeyState(replicaARN, output.KeyMetadata); err != nil {
+			return err
+		}
+		logger.Info("using the replica %q of multi-Region KMS key %q in region %q", replicaARN, keyARN, region)
+		clusterConfig.SecretsEncryption.KeyARN = replicaARN
+		return nil
+	}
+
+	output, err := kmsAPI.DescribeKey(&kms.DescribeKeyInput{
+		KeyId: aws.String(keyARN),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "describing KMS key %q", keyARN)
+	}
+	return validateKMSKeyState(keyARN, output.KeyMetadata)
+}
+
+func validateKMSKeyState(keyARN string, metadata *kms.KeyMetadata) error {
+	if state := aws.StringValue(metadata.KeyState); state != kms.KeyStateEnabled {
+		return fmt.Errorf("KMS key %q cannot be used for secrets encryption as it is in state %s", keyARN, state)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kms"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("KMS key", func() {
+	const (
+		primaryKeyARN = "arn:aws:kms:us-east-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab"
+		replicaKeyARN = "arn:aws:kms:us-west-2:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab"
+	)
+
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	mockDescribeKey := func(keyARN, state string) {
+		p.MockKMS().On("DescribeKey", &kms.DescribeKeyInput{
+			KeyId: aws.String(keyARN),
+		}).Return(&kms.DescribeKeyOutput{
+			KeyMetadata: &kms.KeyMetadata{
+				Arn:         aws.String(keyARN),
+				KeyState:    aws.String(state),
+				MultiRegion: aws.Bool(true),
+			},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Region = "us-west-2"
+		cfg.SecretsEncryption = &api.SecretsEncryption{}
+	})
+
+	It("accepts a multi-Region key in the region of the cluster", func() {
+		cfg.SecretsEncryption.KeyARN = replicaKeyARN
+		mockDescribeKey(replicaKeyARN, kms.KeyStateEnabled)
+		Expect(eks.ResolveKMSKey(p.KMS(), cfg)).To(Succeed())
+		Expect(cfg.SecretsEncryption.KeyARN).To(Equal(replicaKeyARN))
+	})
+
+	It("resolves a multi-Region key from another region to its replica in the region of the cluster", func() {
+		cfg.SecretsEncryption.KeyARN = primaryKeyARN
+		mockDescribeKey(replicaKeyARN, kms.KeyStateEnabled)
+		Expect(eks.ResolveKMSKey(p.KMS(), cfg)).To(Succeed())
+		Expect(cfg.SecretsEncryption.KeyARN).To(Equal(replicaKeyARN))
+	})
+
+	It("rejects a multi-Region key without a replica in the region of the cluster", func() {
+		cfg.SecretsEncryption.KeyARN = primaryKeyARN
+		p.MockKMS().On("DescribeKey", mock.Anything).Return(nil, awserr.New(kms.ErrCodeNotFoundException, "not found", nil))
+		err := eks.ResolveKMSKey(p.KMS(), cfg)
+		Expect(err).To(MatchError(ContainSubstring(`multi-Region KMS key "arn:aws:kms:us-east-1:123456789012:key/mrk-1234abcd12ab34cd56ef1234567890ab" has no replica in region "us-west-2"`)))
+	})
+
+	It("rejects a replica that is not enabled", func() {
+		cfg.SecretsEncryption.KeyARN = primaryKeyARN
+		mockDescribeKey(replicaKeyARN, kms.KeyStateCreating)
+		Expect(eks.ResolveKMSKey(p.KMS(), cfg)).To(MatchError(`KMS key "` + replicaKeyARN + `" cannot be used for secrets encryption as it is in state Creating`))
+	})
+
+	It("rejects a single-Region key from another region", func() {
+		cfg.SecretsEncryption.KeyARN = "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+		Expect(eks.ResolveKMSKey(p.KMS(), cfg)).To(MatchError(ContainSubstring("only multi-Region keys can be used from another region")))
+		p.MockKMS().AssertNotCalled(GinkgoT(), "DescribeKey", mock.Anything)
+	})
+})
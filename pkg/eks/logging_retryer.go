@@ -19,6 +19,7 @@ package eks
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/service/cloudformation"
@@ -27,34 +28,88 @@ import (
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
 const (
-	maxRetries          = 13
-	cfnMinThrottleDelay = 5 * time.Second
+	maxRetries               = 13
+	cfnMinThrottleDelay      = 5 * time.Second
+	mutatingMinThrottleDelay = 1 * time.Second
 )
 
+// mutatingOperationPrefixes are the prefixes of the API operations that change resources, which are retried
+// more patiently when throttled as they are costlier to fail, and recorded instead of being made in audit mode
+var mutatingOperationPrefixes = []string{
+	"Add", "Allocate", "Associate", "Attach", "Authorize", "Cancel", "Complete", "Create", "Delete", "Deregister",
+	"Detach", "Disable", "Disassociate", "Enable", "Execute", "Import", "Modify", "Put", "Reboot", "Register",
+	"Release", "Remove", "Replace", "Resume", "Revoke", "Run", "Set", "Signal", "Start", "Stop", "Suspend", "Tag",
+	"Terminate", "Untag", "Update",
+}
+
 // LoggingRetryer adds some logging when we are retrying, so we have some idea what is happening
 // Right now it is very basic - e.g. it only logs when we retry (so doesn't log when we fail due to too many retries)
 // It was copied from k8s.io/kops/upup/pkg/fi/cloudup/awsup/logging_retryer.go; the original version used glog, and
 // didn't export the constructor
 type LoggingRetryer struct {
 	client.DefaultRetryer
-	cfnRetryer client.DefaultRetryer
+	mutatingRetryer client.DefaultRetryer
+	cfnRetryer      client.DefaultRetryer
 }
 
 var _ request.Retryer = &LoggingRetryer{}
 
-func newLoggingRetryer() *LoggingRetryer {
+func newLoggingRetryer(spec *api.ProviderConfig) *LoggingRetryer {
+	numMaxRetries := maxRetries
+	if spec.MaxRetries != nil {
+		numMaxRetries = *spec.MaxRetries
+	}
+
+	newRetryer := func(minThrottleDelay time.Duration) client.DefaultRetryer {
+		if spec.RetryBaseDelay > minThrottleDelay {
+			minThrottleDelay = spec.RetryBaseDelay
+		}
+		return client.DefaultRetryer{
+			NumMaxRetries:    numMaxRetries,
+			MinRetryDelay:    spec.RetryBaseDelay,
+			MinThrottleDelay: minThrottleDelay,
+			MaxRetryDelay:    spec.RetryMaxDelay,
+			MaxThrottleDelay: spec.RetryMaxDelay,
+		}
+	}
+
 	return &LoggingRetryer{
-		DefaultRetryer: client.DefaultRetryer{
-			NumMaxRetries: maxRetries,
-		},
-		cfnRetryer: client.DefaultRetryer{
-			NumMaxRetries:    maxRetries,
-			MinThrottleDelay: cfnMinThrottleDelay,
-		},
+		DefaultRetryer:  newRetryer(0),
+		mutatingRetryer: newRetryer(mutatingMinThrottleDelay),
+		cfnRetryer:      newRetryer(cfnMinThrottleDelay),
+	}
+}
+
+// validateRetryConfig checks the retry settings of the given ProviderConfig
+func validateRetryConfig(spec *api.ProviderConfig) error {
+	if spec.MaxRetries != nil && *spec.MaxRetries < 0 {
+		return fmt.Errorf("--aws-max-retries must not be negative")
+	}
+	if spec.RetryBaseDelay < 0 || spec.RetryMaxDelay < 0 {
+		return fmt.Errorf("--aws-retry-base-delay and --aws-retry-max-delay must not be negative")
+	}
+	if spec.RetryMaxDelay > 0 && spec.RetryBaseDelay > spec.RetryMaxDelay {
+		return fmt.Errorf("--aws-retry-base-delay (%v) must not be greater than --aws-retry-max-delay (%v)", spec.RetryBaseDelay, spec.RetryMaxDelay)
+	}
+	return nil
+}
+
+func isMutatingOperation(r *request.Request) bool {
+	return r.Operation != nil && isMutatingOperationName(r.Operation.Name)
+}
+
+func isMutatingOperationName(name string) bool {
+	for _, prefix := range mutatingOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
 	}
+	return false
 }
 
 // ShouldRetry uses DefaultRetryer.ShouldRetry but also checks for non-retryable
@@ -82,6 +137,8 @@ func (l LoggingRetryer) RetryRules(r *request.Request) time.Duration {
 
 	if r.IsErrorThrottle() && service == cloudformation.ServiceName && r.Operation.Name == "DescribeStacks" {
 		duration = l.cfnRetryer.RetryRules(r)
+	} else if isMutatingOperation(r) {
+		duration = l.mutatingRetryer.RetryRules(r)
 	} else {
 		duration = l.DefaultRetryer.RetryRules(r)
 	}
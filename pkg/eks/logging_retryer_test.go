@@ -0,0 +1,90 @@
+package eks_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("LoggingRetryer", func() {
+	newRetryer := func(spec *api.ProviderConfig) *LoggingRetryer {
+		spec.Region = "us-west-2"
+		s, err := NewSessionForProviderConfig(spec)
+		Expect(err).NotTo(HaveOccurred())
+		retryer, ok := s.Config.Retryer.(*LoggingRetryer)
+		Expect(ok).To(BeTrue())
+		return retryer
+	}
+
+	throttledRequest := func(service, operation string, retryCount int) *request.Request {
+		return &request.Request{
+			ClientInfo:   metadata.ClientInfo{ServiceName: service},
+			Operation:    &request.Operation{Name: operation},
+			Error:        awserr.New("Throttling", "Rate exceeded", nil),
+			HTTPResponse: &http.Response{StatusCode: http.StatusBadRequest},
+			RetryCount:   retryCount,
+		}
+	}
+
+	It("is attached to the AWS clients", func() {
+		s, err := NewSessionForProviderConfig(&api.ProviderConfig{Region: "us-west-2", MaxRetries: aws.Int(20)})
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, c := range []request.Retryer{awseks.New(s).Retryer, ec2.New(s).Retryer, cloudformation.New(s).Retryer} {
+			Expect(c).To(BeAssignableToTypeOf(&LoggingRetryer{}))
+			Expect(c.MaxRetries()).To(Equal(20))
+		}
+	})
+
+	It("retries 13 times by default", func() {
+		Expect(newRetryer(&api.ProviderConfig{}).MaxRetries()).To(Equal(13))
+	})
+
+	It("does not retry if max retries is 0", func() {
+		Expect(newRetryer(&api.ProviderConfig{MaxRetries: aws.Int(0)}).MaxRetries()).To(Equal(0))
+	})
+
+	It("backs off longer for throttled operations that change resources", func() {
+		retryer := newRetryer(&api.ProviderConfig{})
+		Expect(retryer.RetryRules(throttledRequest(ec2.ServiceName, "DescribeSubnets", 0))).To(BeNumerically("<", time.Second))
+		Expect(retryer.RetryRules(throttledRequest(ec2.ServiceName, "CreateTags", 0))).To(BeNumerically(">=", time.Second))
+	})
+
+	It("backs off longest for throttled CloudFormation DescribeStacks calls", func() {
+		retryer := newRetryer(&api.ProviderConfig{})
+		Expect(retryer.RetryRules(throttledRequest(cloudformation.ServiceName, "DescribeStacks", 0))).To(BeNumerically(">=", 5*time.Second))
+	})
+
+	It("uses the configured base delay", func() {
+		retryer := newRetryer(&api.ProviderConfig{RetryBaseDelay: 2 * time.Second})
+		Expect(retryer.RetryRules(throttledRequest(ec2.ServiceName, "DescribeSubnets", 0))).To(BeNumerically(">=", 2*time.Second))
+		Expect(retryer.RetryRules(throttledRequest(ec2.ServiceName, "CreateTags", 0))).To(BeNumerically(">=", 2*time.Second))
+	})
+
+	It("caps the backoff at the configured max delay", func() {
+		retryer := newRetryer(&api.ProviderConfig{RetryMaxDelay: 3 * time.Second})
+		Expect(retryer.RetryRules(throttledRequest(ec2.ServiceName, "CreateTags", 10))).To(BeNumerically("<=", 3*time.Second))
+	})
+
+	DescribeTable("rejects an invalid retry config", func(spec api.ProviderConfig, expectedErr string) {
+		_, err := NewSessionForProviderConfig(&spec)
+		Expect(err).To(MatchError(expectedErr))
+	},
+		Entry("negative max retries", api.ProviderConfig{MaxRetries: aws.Int(-1)}, "--aws-max-retries must not be negative"),
+		Entry("negative delay", api.ProviderConfig{RetryBaseDelay: -time.Second}, "--aws-retry-base-delay and --aws-retry-max-delay must not be negative"),
+		Entry("base delay greater than max delay", api.ProviderConfig{RetryBaseDelay: time.Minute, RetryMaxDelay: time.Second}, "--aws-retry-base-delay (1m0s) must not be greater than --aws-retry-max-delay (1s)"),
+	)
+})
@@ -0,0 +1,84 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// ipv4AddressesPerPrefix is the number of addresses in the /28 prefixes assigned with prefix delegation
+	ipv4AddressesPerPrefix = 16
+	// the maximum number of pods recommended by EKS for instance types with less than
+	// maxPodsVCPUThreshold vCPUs, and for the larger ones
+	maxPodsSmallInstances = 110
+	maxPodsLargeInstances = 250
+	maxPodsVCPUThreshold  = 30
+)
+
+// PrefixDelegationMaxPods returns the maximum number of pods of an instance type with prefix delegation,
+// following the EKS max pods calculator: every secondary address slot of each network interface holds
+// a /28 prefix, plus the two host network pods of aws-node and kube-proxy, capped at the number of pods
+// EKS recommends for the number of vCPUs of the instance type
+func PrefixDelegationMaxPods(info *ec2.InstanceTypeInfo) (int, error) {
+	instanceType := aws.StringValue(info.InstanceType)
+	if aws.StringValue(info.Hypervisor) != ec2.InstanceTypeHypervisorNitro && !aws.BoolValue(info.BareMetal) {
+		return 0, fmt.Errorf("instance type %q does not support prefix delegation as it is not built on the Nitro System", instanceType)
+	}
+	if info.NetworkInfo == nil || info.VCpuInfo == nil {
+		return 0, fmt.Errorf("no network or vCPU information for instance type %q", instanceType)
+	}
+	enis := aws.Int64Value(info.NetworkInfo.MaximumNetworkInterfaces)
+	addressesPerENI := aws.Int64Value(info.NetworkInfo.Ipv4AddressesPerInterface)
+	maxPods := int(enis*(addressesPerENI-1)*ipv4AddressesPerPrefix + 2)
+
+	limit := maxPodsLargeInstances
+	if aws.Int64Value(info.VCpuInfo.DefaultVCpus) < maxPodsVCPUThreshold {
+		limit = maxPodsSmallInstances
+	}
+	if maxPods > limit {
+		return limit, nil
+	}
+	return maxPods, nil
+}
+
+// resolvePrefixDelegationMaxPods returns the maximum number of pods with prefix delegation that all
+// of the instance types can run
+func resolvePrefixDelegationMaxPods(ec2API ec2iface.EC2API, instanceTypes []string) (int, error) {
+	output, err := ec2API.DescribeInstanceTypes(&ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(instanceTypes),
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "couldn't retrieve instance type description for %v", instanceTypes)
+	}
+	if len(output.InstanceTypes) == 0 {
+		return 0, fmt.Errorf("couldn't find instance types %v", instanceTypes)
+	}
+
+	minMaxPods := 0
+	for _, info := range output.InstanceTypes {
+		maxPods, err := PrefixDelegationMaxPods(info)
+		if err != nil {
+			return 0, err
+		}
+		if minMaxPods == 0 || maxPods < minMaxPods {
+			minMaxPods = maxPods
+		}
+	}
+	return minMaxPods, nil
+}
+
+func instanceTypeList(np api.NodePool) []string {
+	switch ng := np.(type) {
+	case *api.NodeGroup:
+		return ng.InstanceTypeList()
+	case *api.ManagedNodeGroup:
+		return ng.InstanceTypeList()
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+func nitroInstanceType(instanceType string, enis, addressesPerENI, vCPUs int64) *ec2.InstanceTypeInfo {
+	return &ec2.InstanceTypeInfo{
+		InstanceType: aws.String(instanceType),
+		Hypervisor:   aws.String(ec2.InstanceTypeHypervisorNitro),
+		NetworkInfo: &ec2.NetworkInfo{
+			MaximumNetworkInterfaces:  aws.Int64(enis),
+			Ipv4AddressesPerInterface: aws.Int64(addressesPerENI),
+		},
+		VCpuInfo: &ec2.VCpuInfo{
+			DefaultVCpus: aws.Int64(vCPUs),
+		},
+	}
+}
+
+var _ = Describe("Prefix delegation max pods", func() {
+	DescribeTable("computes the max pods of an instance type", func(info *ec2.InstanceTypeInfo, expectedMaxPods int) {
+		maxPods, err := eks.PrefixDelegationMaxPods(info)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(maxPods).To(Equal(expectedMaxPods))
+	},
+		Entry("t3.nano", nitroInstanceType("t3.nano", 2, 2, 2), 34),
+		Entry("t3.small is capped for small instances", nitroInstanceType("t3.small", 3, 4, 2), 110),
+		Entry("m5.large is capped for small instances", nitroInstanceType("m5.large", 3, 10, 2), 110),
+		Entry("m5.8xlarge is capped for large instances", nitroInstanceType("m5.8xlarge", 8, 30, 32), 250),
+		Entry("m5.24xlarge is capped for large instances", nitroInstanceType("m5.24xlarge", 15, 50, 96), 250),
+		Entry("bare metal instances", &ec2.InstanceTypeInfo{
+			InstanceType: aws.String("i3.metal"),
+			BareMetal:    aws.Bool(true),
+			NetworkInfo: &ec2.NetworkInfo{
+				MaximumNetworkInterfaces:  aws.Int64(15),
+				Ipv4AddressesPerInterface: aws.Int64(50),
+			},
+			VCpuInfo: &ec2.VCpuInfo{
+				DefaultVCpus: aws.Int64(72),
+			},
+		}, 250),
+	)
+
+	It("rejects instance types not built on the Nitro System", func() {
+		_, err := eks.PrefixDelegationMaxPods(&ec2.InstanceTypeInfo{
+			InstanceType: aws.String("m4.large"),
+			Hypervisor:   aws.String(ec2.InstanceTypeHypervisorXen),
+		})
+		Expect(err).To(MatchError(`instance type "m4.large" does not support prefix delegation as it is not built on the Nitro System`))
+	})
+
+	It("uses the lowest max pods of the instance types of a nodegroup", func() {
+		p := mockprovider.NewMockProvider()
+		p.MockEC2().On("DescribeInstanceTypes", &ec2.DescribeInstanceTypesInput{
+			InstanceTypes: aws.StringSlice([]string{"m5.24xlarge", "t3.nano"}),
+		}).Return(&ec2.DescribeInstanceTypesOutput{
+			InstanceTypes: []*ec2.InstanceTypeInfo{
+				nitroInstanceType("m5.24xlarge", 15, 50, 96),
+				nitroInstanceType("t3.nano", 2, 2, 2),
+			},
+		}, nil)
+
+		maxPods, err := eks.ResolvePrefixDelegationMaxPods(p.EC2(), []string{"m5.24xlarge", "t3.nano"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(maxPods).To(Equal(34))
+	})
+})
@@ -5,10 +5,12 @@ import (
 	_ "github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	_ "github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	_ "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	_ "github.com/aws/aws-sdk-go/service/efs/efsiface"
 	_ "github.com/aws/aws-sdk-go/service/eks/eksiface"
 	_ "github.com/aws/aws-sdk-go/service/elb/elbiface"
 	_ "github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	_ "github.com/aws/aws-sdk-go/service/iam/iamiface"
+	_ "github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	_ "github.com/aws/aws-sdk-go/service/sts/stsiface"
 	_ "github.com/vektra/mockery"
 )
@@ -24,4 +26,6 @@ import (
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/iam/iamiface --name=IAMAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/cloudtrail/cloudtrailiface --name=CloudTrailAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/ssm/ssmiface --name=SSMAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/kms/kmsiface --name=KMSAPI --output=./
+//go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/service/efs/efsiface --name=EFSAPI --output=./
 //go:generate "${GOBIN}/mockery" --tags netgo --dir=${AWS_SDK_GO_DIR}/aws/client --name=ConfigProvider --output=./
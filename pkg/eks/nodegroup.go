@@ -151,7 +151,7 @@ func ValidateKMSSupport(clusterConfig *api.ClusterConfig, eksVersion string) err
 	if _, err := arn.Parse(clusterConfig.SecretsEncryption.KeyARN); err != nil {
 		return errors.Wrapf(err, "invalid ARN in secretsEncryption.keyARN: %q", clusterConfig.SecretsEncryption.KeyARN)
 	}
-	return nil
+	return clusterConfig.SecretsEncryption.Validate()
 }
 
 // SupportsWindowsWorkloads reports whether nodeGroups can support running Windows workloads
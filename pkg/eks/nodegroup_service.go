@@ -67,6 +67,17 @@ func (m *NodeGroupService) NewAWSSelectorSession(provider api.ClusterProvider) {
 
 // Normalize normalizes nodegroups
 func (m *NodeGroupService) Normalize(nodePools []api.NodePool, clusterMeta *api.ClusterMeta) error {
+	return m.normalize(nodePools, clusterMeta, true)
+}
+
+// NormalizeWithoutImportingKeys normalizes nodegroups like Normalize, without importing their SSH public keys
+// into EC2, so that only read-only API calls are made. The nodegroups that allow SSH access must use an existing
+// EC2 key pair
+func (m *NodeGroupService) NormalizeWithoutImportingKeys(nodePools []api.NodePool, clusterMeta *api.ClusterMeta) error {
+	return m.normalize(nodePools, clusterMeta, false)
+}
+
+func (m *NodeGroupService) normalize(nodePools []api.NodePool, clusterMeta *api.ClusterMeta, importKeys bool) error {
 	for _, np := range nodePools {
 		switch ng := np.(type) {
 		case *api.ManagedNodeGroup:
@@ -95,11 +106,26 @@ func (m *NodeGroupService) Normalize(nodePools []api.NodePool, clusterMeta *api.
 		// resolve AMI
 		logger.Info("nodegroup %q will use %q [%s/%s]", ng.Name, ng.AMI, ng.AMIFamily, clusterMeta.Version)
 
+		if api.IsEnabled(ng.PrefixDelegation) && ng.MaxPodsPerNode == 0 {
+			maxPods, err := resolvePrefixDelegationMaxPods(m.Provider.EC2(), instanceTypeList(np))
+			if err != nil {
+				return errors.Wrapf(err, "computing maxPodsPerNode of nodegroup %q with prefix delegation", ng.Name)
+			}
+			logger.Info("nodegroup %q will run up to %d pods per node with prefix delegation", ng.Name, maxPods)
+			ng.MaxPodsPerNode = maxPods
+		}
+
 		if ng.AMI != "" {
 			if err := ami.Use(m.Provider.EC2(), ng); err != nil {
 				return err
 			}
 		}
+		if !importKeys {
+			if api.IsEnabled(ng.SSH.Allow) && (ng.SSH.PublicKey != nil || ng.SSH.PublicKeyName == nil || *ng.SSH.PublicKeyName == "") {
+				return fmt.Errorf("nodegroup %q must set ssh.publicKeyName to an existing EC2 key pair, as other SSH public keys are imported into EC2", ng.Name)
+			}
+			continue
+		}
 		// load or use SSH key - name includes cluster name and the
 		// fingerprint, so if unique keys are provided, each will get
 		// loaded and used as intended and there is no need to have
@@ -0,0 +1,56 @@
+package eks
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ProxyFunc selects the proxy to use for a given request
+type ProxyFunc func(*http.Request) (*url.URL, error)
+
+// NewProxyFunc returns the ProxyFunc used for both AWS and Kubernetes API requests, or nil if
+// no proxy is configured, in which case the standard HTTPS_PROXY/NO_PROXY environment variables apply
+func NewProxyFunc(spec *api.ProviderConfig) (ProxyFunc, error) {
+	if spec.Proxy == "" {
+		if len(spec.NoProxy) > 0 {
+			return nil, fmt.Errorf("--no-proxy can only be used with --proxy")
+		}
+		return nil, nil
+	}
+
+	proxyURL, err := url.Parse(spec.Proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", spec.Proxy, err)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be one of http, https or socks5", spec.Proxy)
+	}
+	if proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: host must be set", spec.Proxy)
+	}
+
+	config := &httpproxy.Config{
+		HTTPProxy:  spec.Proxy,
+		HTTPSProxy: spec.Proxy,
+		NoProxy:    strings.Join(spec.NoProxy, ","),
+	}
+	proxyFunc := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}, nil
+}
+
+// newHTTPClient returns an HTTP client for the AWS SDK that sends requests through the given proxy
+func newHTTPClient(proxy ProxyFunc) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxy
+	return &http.Client{Transport: transport}
+}
@@ -0,0 +1,92 @@
+package eks_test
+
+import (
+	"net/http"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("Proxy", func() {
+	newRequest := func(rawURL string) *http.Request {
+		req, err := http.NewRequest(http.MethodGet, rawURL, nil)
+		Expect(err).NotTo(HaveOccurred())
+		return req
+	}
+
+	Context("NewProxyFunc", func() {
+		It("returns nil when no proxy is configured", func() {
+			proxy, err := NewProxyFunc(&api.ProviderConfig{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxy).To(BeNil())
+		})
+
+		It("routes requests through the proxy except for no-proxy hosts", func() {
+			proxy, err := NewProxyFunc(&api.ProviderConfig{
+				Proxy:   "http://proxy.example.com:3128",
+				NoProxy: []string{".internal.example.com", "10.0.0.1"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			proxyURL, err := proxy(newRequest("https://eks.us-west-2.amazonaws.com"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyURL.String()).To(Equal("http://proxy.example.com:3128"))
+
+			proxyURL, err = proxy(newRequest("https://api.internal.example.com"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyURL).To(BeNil())
+
+			proxyURL, err = proxy(newRequest("https://10.0.0.1:443"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyURL).To(BeNil())
+		})
+
+		It("rejects a proxy URL that doesn't parse", func() {
+			_, err := NewProxyFunc(&api.ProviderConfig{Proxy: "http://proxy:port"})
+			Expect(err).To(MatchError(ContainSubstring(`invalid proxy URL "http://proxy:port"`)))
+		})
+
+		It("rejects a proxy URL without a supported scheme", func() {
+			_, err := NewProxyFunc(&api.ProviderConfig{Proxy: "proxy.example.com:3128"})
+			Expect(err).To(MatchError(ContainSubstring("scheme must be one of http, https or socks5")))
+		})
+
+		It("rejects a proxy URL without a host", func() {
+			_, err := NewProxyFunc(&api.ProviderConfig{Proxy: "http://"})
+			Expect(err).To(MatchError(ContainSubstring("host must be set")))
+		})
+
+		It("rejects no-proxy hosts without a proxy", func() {
+			_, err := NewProxyFunc(&api.ProviderConfig{NoProxy: []string{"example.com"}})
+			Expect(err).To(MatchError("--no-proxy can only be used with --proxy"))
+		})
+	})
+
+	Context("AWS session", func() {
+		It("uses the configured proxy in the HTTP transport", func() {
+			s, err := NewSessionForProviderConfig(&api.ProviderConfig{
+				Region: "us-west-2",
+				Proxy:  "https://proxy.example.com:8443",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			transport, ok := s.Config.HTTPClient.Transport.(*http.Transport)
+			Expect(ok).To(BeTrue())
+			Expect(transport.Proxy).NotTo(BeNil())
+
+			proxyURL, err := transport.Proxy(newRequest("https://cloudformation.us-west-2.amazonaws.com"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(proxyURL).To(Equal(&url.URL{Scheme: "https", Host: "proxy.example.com:8443"}))
+		})
+
+		It("leaves the default HTTP client when no proxy is configured", func() {
+			s, err := NewSessionForProviderConfig(&api.ProviderConfig{Region: "us-west-2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(s.Config.HTTPClient).To(Equal(http.DefaultClient))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package eks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// callerIPURL returns the public IP address that requests come from
+var callerIPURL = "https://checkip.amazonaws.com"
+
+// DetectCallerIPFunc returns the public IP address that this machine reaches the internet from
+type DetectCallerIPFunc func() (net.IP, error)
+
+// CheckAPIAccessFunc returns an error if the Kubernetes API endpoint cannot be reached from this machine
+type CheckAPIAccessFunc func() error
+
+// DetectCallerIP returns the public IP address of this machine as seen by AWS, the request is sent through
+// the proxy the AWS and Kubernetes API calls go through
+func (c *ClusterProvider) DetectCallerIP() (net.IP, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	if c.proxy != nil {
+		client = newHTTPClient(c.proxy)
+		client.Timeout = 10 * time.Second
+	}
+	resp, err := client.Get(callerIPURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "detecting the public IP address of this machine")
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "detecting the public IP address of this machine")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("detecting the public IP address of this machine: unexpected status %s from %s", resp.Status, callerIPURL)
+	}
+	ip := net.ParseIP(strings.TrimSpace(string(body)))
+	if ip == nil {
+		return nil, fmt.Errorf("detecting the public IP address of this machine: invalid address %q from %s", strings.TrimSpace(string(body)), callerIPURL)
+	}
+	return ip, nil
+}
+
+// CheckPublicAccessCIDRs checks that the public access CIDRs include callerCIDR, e.g. the CIDR of a bastion
+// host, or the public IP address of this machine if callerCIDR is empty, so that updating the cluster to
+// these CIDRs does not lock this machine out of the Kubernetes API
+func CheckPublicAccessCIDRs(cidrs []string, callerCIDR string, detectCallerIP DetectCallerIPFunc) error {
+	var caller *net.IPNet
+	if callerCIDR != "" {
+		var err error
+		if _, caller, err = net.ParseCIDR(callerCIDR); err != nil {
+			return errors.Wrapf(err, "invalid caller CIDR %q", callerCIDR)
+		}
+	} else {
+		ip, err := detectCallerIP()
+		if err != nil {
+			return err
+		}
+		bits := net.IPv6len * 8
+		if ip.To4() != nil {
+			ip, bits = ip.To4(), net.IPv4len*8
+		}
+		caller = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	}
+
+	callerOnes, callerBits := caller.Mask.Size()
+	for _, cidr := range cidrs {
+		_, allowed, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "invalid public access CIDR %q", cidr)
+		}
+		ones, bits := allowed.Mask.Size()
+		if bits == callerBits && ones <= callerOnes && allowed.Contains(caller.IP) {
+			return nil
+		}
+	}
+	return fmt.Errorf("the public access CIDRs %v do not include %s, which this machine reaches the Kubernetes API from, and would lock it out of the cluster; "+
+		"add it to the CIDRs, or use --caller-cidr when the API is reached through another host", cidrs, caller)
+}
+
+// NewAPIAccessCheck returns a CheckAPIAccessFunc that sends a request to the Kubernetes API endpoint of the cluster.
+// Any HTTP response, including an authentication error, means that the endpoint can be reached
+func (c *ClusterProvider) NewAPIAccessCheck(status *api.ClusterStatus) (CheckAPIAccessFunc, error) {
+	if status == nil || status.Endpoint == "" {
+		return nil, errors.New("the Kubernetes API endpoint of the cluster is not known")
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(status.CertificateAuthorityData) {
+		return nil, errors.New("parsing the certificate authority of the cluster")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12}
+	if c.proxy != nil {
+		transport.Proxy = c.proxy
+	}
+	client := &http.Client{Transport: transport, Timeout: 10 * time.Second}
+	endpoint := strings.TrimSuffix(status.Endpoint, "/") + "/version"
+
+	return func() error {
+		resp, err := client.Get(endpoint)
+		if err != nil {
+			return errors.Wrapf(err, "reaching the Kubernetes API endpoint %s", status.Endpoint)
+		}
+		resp.Body.Close()
+		return nil
+	}, nil
+}
+
+// UpdatePublicAccessCIDRsWithRollback uses update to change the public access CIDRs from current to desired,
+// and checks that the Kubernetes API endpoint can still be reached within timeout, retrying every interval.
+// The current CIDRs are restored if it cannot
+func UpdatePublicAccessCIDRsWithRollback(update func(cidrs []string) error, current, desired []string, checkAccess CheckAPIAccessFunc, timeout, interval time.Duration) error {
+	if err := checkAccess(); err != nil {
+		return errors.Wrap(err, "the Kubernetes API endpoint cannot be reached from this machine before the update, "+
+			"so a loss of access cannot be detected")
+	}
+
+	if err := update(desired); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	var accessErr error
+	for {
+		if accessErr = checkAccess(); accessErr == nil {
+			return nil
+		}
+		logger.Debug("waiting for the Kubernetes API endpoint to be reachable: %v", accessErr)
+		select {
+		case <-ctx.Done():
+			logger.Warning("the Kubernetes API endpoint could not be reached for %v after the update, restoring the public access CIDRs to %v", timeout, current)
+			if err := update(current); err != nil {
+				return errors.Wrapf(err, "restoring the public access CIDRs to %v after losing access (%v)", current, accessErr)
+			}
+			return errors.Wrapf(accessErr, "the public access CIDRs have been restored to %v, as the Kubernetes API endpoint could not be reached with %v", current, desired)
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package eks_test
+
+import (
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("Public access CIDRs", func() {
+	callerIP := func(address string) DetectCallerIPFunc {
+		return func() (net.IP, error) {
+			return net.ParseIP(address), nil
+		}
+	}
+
+	DescribeTable("CheckPublicAccessCIDRs accepts CIDRs that include the caller", func(cidrs []string, callerCIDR, address string) {
+		Expect(CheckPublicAccessCIDRs(cidrs, callerCIDR, callerIP(address))).To(Succeed())
+	},
+		Entry("detected IP", []string{"10.0.0.0/8", "203.0.113.0/24"}, "", "203.0.113.10"),
+		Entry("detected IP in a single-address CIDR", []string{"203.0.113.10/32"}, "", "203.0.113.10"),
+		Entry("detected IPv6 address", []string{"2001:db8::/32"}, "", "2001:db8::1"),
+		Entry("bastion CIDR", []string{"198.51.100.0/24"}, "198.51.100.16/28", ""),
+	)
+
+	DescribeTable("CheckPublicAccessCIDRs rejects CIDRs that lock the caller out", func(cidrs []string, callerCIDR, address, expectedCaller string) {
+		err := CheckPublicAccessCIDRs(cidrs, callerCIDR, callerIP(address))
+		Expect(err).To(MatchError(fmt.Sprintf("the public access CIDRs %v do not include %s, which this machine reaches the Kubernetes API from, and would lock it out of the cluster; "+
+			"add it to the CIDRs, or use --caller-cidr when the API is reached through another host", cidrs, expectedCaller)))
+	},
+		Entry("detected IP", []string{"10.0.0.0/8"}, "", "203.0.113.10", "203.0.113.10/32"),
+		Entry("bastion CIDR wider than the CIDRs", []string{"198.51.100.0/28"}, "198.51.100.0/24", "", "198.51.100.0/24"),
+		Entry("IPv4 address and IPv6 CIDRs", []string{"::/0"}, "", "203.0.113.10", "203.0.113.10/32"),
+	)
+
+	It("returns the errors of the caller IP detection", func() {
+		err := CheckPublicAccessCIDRs([]string{"0.0.0.0/0"}, "", func() (net.IP, error) {
+			return nil, errors.New("no network")
+		})
+		Expect(err).To(MatchError("no network"))
+	})
+
+	It("detects the public IP address of the caller", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = fmt.Fprintln(w, "203.0.113.10")
+		}))
+		defer server.Close()
+		defer SetCallerIPURL(server.URL)()
+
+		ip, err := (&ClusterProvider{}).DetectCallerIP()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ip.String()).To(Equal("203.0.113.10"))
+	})
+
+	It("detects the public IP address of the caller through the configured proxy", func() {
+		proxied := make(chan string, 1)
+		proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			proxied <- r.URL.String()
+			_, _ = fmt.Fprintln(w, "203.0.113.20")
+		}))
+		defer proxy.Close()
+		defer SetCallerIPURL("http://checkip.example.com")()
+
+		proxyFunc, err := NewProxyFunc(&api.ProviderConfig{Proxy: proxy.URL})
+		Expect(err).NotTo(HaveOccurred())
+		ip, err := NewClusterProviderWithProxy(proxyFunc).DetectCallerIP()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ip.String()).To(Equal("203.0.113.20"))
+		Expect(proxied).To(Receive(Equal("http://checkip.example.com/")))
+	})
+
+	Describe("NewAPIAccessCheck", func() {
+		It("succeeds on any response of the API endpoint", func() {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/version"))
+				w.WriteHeader(http.StatusForbidden)
+			}))
+			defer server.Close()
+
+			checkAccess, err := (&ClusterProvider{}).NewAPIAccessCheck(&api.ClusterStatus{
+				Endpoint:                 server.URL,
+				CertificateAuthorityData: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkAccess()).To(Succeed())
+
+			server.Close()
+			Expect(checkAccess()).To(MatchError(ContainSubstring("reaching the Kubernetes API endpoint " + server.URL)))
+		})
+
+		It("requires the endpoint of the cluster", func() {
+			_, err := (&ClusterProvider{}).NewAPIAccessCheck(&api.ClusterStatus{})
+			Expect(err).To(MatchError("the Kubernetes API endpoint of the cluster is not known"))
+		})
+	})
+
+	Describe("UpdatePublicAccessCIDRsWithRollback", func() {
+		var (
+			current, desired []string
+			updates          [][]string
+			reachable        bool
+			checks           int
+		)
+
+		update := func(cidrs []string) error {
+			updates = append(updates, cidrs)
+			return nil
+		}
+
+		checkAccess := func() error {
+			checks++
+			if !reachable {
+				return errors.New("timeout")
+			}
+			return nil
+		}
+
+		BeforeEach(func() {
+			current = []string{"0.0.0.0/0"}
+			desired = []string{"10.0.0.0/8"}
+			updates = nil
+			reachable = true
+			checks = 0
+		})
+
+		It("keeps the new CIDRs when the API endpoint can still be reached", func() {
+			Expect(UpdatePublicAccessCIDRsWithRollback(update, current, desired, checkAccess, time.Second, time.Millisecond)).To(Succeed())
+			Expect(updates).To(Equal([][]string{desired}))
+			Expect(checks).To(Equal(2))
+		})
+
+		It("waits for the API endpoint to be reachable again", func() {
+			attempts := 0
+			flakyCheck := func() error {
+				attempts++
+				if attempts == 2 || attempts == 3 {
+					return errors.New("timeout")
+				}
+				return nil
+			}
+			Expect(UpdatePublicAccessCIDRsWithRollback(update, current, desired, flakyCheck, time.Second, time.Millisecond)).To(Succeed())
+			Expect(updates).To(Equal([][]string{desired}))
+			Expect(attempts).To(Equal(4))
+		})
+
+		It("restores the current CIDRs when the API endpoint cannot be reached", func() {
+			lockOut := func(cidrs []string) error {
+				updates = append(updates, cidrs)
+				reachable = len(updates) != 1
+				return nil
+			}
+			err := UpdatePublicAccessCIDRsWithRollback(lockOut, current, desired, checkAccess, 20*time.Millisecond, time.Millisecond)
+			Expect(err).To(MatchError("the public access CIDRs have been restored to [0.0.0.0/0], as the Kubernetes API endpoint could not be reached with [10.0.0.0/8]: timeout"))
+			Expect(updates).To(Equal([][]string{desired, current}))
+		})
+
+		It("does not update the CIDRs when the API endpoint cannot be reached before the update", func() {
+			reachable = false
+			err := UpdatePublicAccessCIDRsWithRollback(update, current, desired, checkAccess, time.Second, time.Millisecond)
+			Expect(err).To(MatchError("the Kubernetes API endpoint cannot be reached from this machine before the update, so a loss of access cannot be detected: timeout"))
+			Expect(updates).To(BeEmpty())
+		})
+
+		It("returns the errors of the rollback", func() {
+			failingRollback := func(cidrs []string) error {
+				updates = append(updates, cidrs)
+				if len(updates) == 1 {
+					reachable = false
+					return nil
+				}
+				return errors.New("update in progress")
+			}
+			err := UpdatePublicAccessCIDRsWithRollback(failingRollback, current, desired, checkAccess, 10*time.Millisecond, time.Millisecond)
+			Expect(err).To(MatchError("restoring the public access CIDRs to [0.0.0.0/0] after losing access (timeout): update in progress"))
+		})
+	})
+})
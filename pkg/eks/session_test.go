@@ -0,0 +1,178 @@
+package eks_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+const (
+	sharedConfig = `[profile team-a]
+region = eu-west-3
+
+[profile team-b]
+region = ap-south-1
+`
+	sharedCredentials = `[default]
+aws_access_key_id = AKIDDEFAULT
+aws_secret_access_key = secret-default
+
+[team-a]
+aws_access_key_id = AKIDTEAMA
+aws_secret_access_key = secret-a
+
+[team-b]
+aws_access_key_id = AKIDTEAMB
+aws_secret_access_key = secret-b
+`
+	getCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/eksctl</Arn>
+    <UserId>AIDAEKSCTL</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+)
+
+var _ = Describe("AWS session scoping", func() {
+	var (
+		savedEnv       map[string]*string
+		dir            string
+		stsServer      *httptest.Server
+		stsAuthHeaders []string
+		mu             sync.Mutex
+	)
+
+	setEnv := func(name, value string) {
+		if _, saved := savedEnv[name]; !saved {
+			if old, ok := os.LookupEnv(name); ok {
+				savedEnv[name] = &old
+			} else {
+				savedEnv[name] = nil
+			}
+		}
+		if value == "" {
+			Expect(os.Unsetenv(name)).To(Succeed())
+		} else {
+			Expect(os.Setenv(name, value)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		savedEnv = map[string]*string{}
+		var err error
+		dir, err = ioutil.TempDir("", "eksctl-session")
+		Expect(err).NotTo(HaveOccurred())
+		configFile := filepath.Join(dir, "config")
+		credentialsFile := filepath.Join(dir, "credentials")
+		Expect(ioutil.WriteFile(configFile, []byte(sharedConfig), 0600)).To(Succeed())
+		Expect(ioutil.WriteFile(credentialsFile, []byte(sharedCredentials), 0600)).To(Succeed())
+
+		stsAuthHeaders = nil
+		stsServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			stsAuthHeaders = append(stsAuthHeaders, r.Header.Get("Authorization"))
+			mu.Unlock()
+			w.Header().Set("Content-Type", "text/xml")
+			_, _ = w.Write([]byte(getCallerIdentityResponse))
+		}))
+
+		setEnv("AWS_CONFIG_FILE", configFile)
+		setEnv("AWS_SHARED_CREDENTIALS_FILE", credentialsFile)
+		setEnv("AWS_STS_ENDPOINT", stsServer.URL)
+		setEnv("AWS_PROFILE", "team-b")
+		setEnv("AWS_REGION", "us-east-2")
+		setEnv("AWS_DEFAULT_REGION", "")
+		setEnv("AWS_ACCESS_KEY_ID", "")
+		setEnv("AWS_SECRET_ACCESS_KEY", "")
+		setEnv("AWS_SESSION_TOKEN", "")
+		setEnv("AWS_SDK_LOAD_CONFIG", "")
+	})
+
+	AfterEach(func() {
+		stsServer.Close()
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		for name, value := range savedEnv {
+			if value == nil {
+				Expect(os.Unsetenv(name)).To(Succeed())
+			} else {
+				Expect(os.Setenv(name, *value)).To(Succeed())
+			}
+		}
+	})
+
+	accessKeyID := func(ctl *ClusterProvider) string {
+		creds, err := ctl.Provider.Session().Config.Credentials.Get()
+		Expect(err).NotTo(HaveOccurred())
+		return creds.AccessKeyID
+	}
+
+	It("builds the clients and signs the STS calls with --profile and --region", func() {
+		cfg := api.NewClusterConfig()
+		ctl, err := New(&api.ProviderConfig{Profile: "team-a", Region: "eu-west-1"}, cfg)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(ctl.Provider.Profile()).To(Equal("team-a"))
+		Expect(ctl.Provider.Region()).To(Equal("eu-west-1"))
+		Expect(cfg.Metadata.Region).To(Equal("eu-west-1"))
+		Expect(accessKeyID(ctl)).To(Equal("AKIDTEAMA"))
+		Expect(aws.StringValue(ctl.Provider.EKS().(*awseks.EKS).Client.Config.Region)).To(Equal("eu-west-1"))
+		Expect(aws.StringValue(ctl.Provider.STS().(*sts.STS).Client.Config.Region)).To(Equal("eu-west-1"))
+
+		Expect(stsAuthHeaders).To(HaveLen(1))
+		Expect(stsAuthHeaders[0]).To(ContainSubstring("Credential=AKIDTEAMA/"))
+		Expect(stsAuthHeaders[0]).To(ContainSubstring("/eu-west-1/sts/aws4_request"))
+
+		By("leaving the environment untouched")
+		Expect(os.Getenv("AWS_PROFILE")).To(Equal("team-b"))
+		Expect(os.Getenv("AWS_REGION")).To(Equal("us-east-2"))
+	})
+
+	It("keeps the providers of different profiles and regions apart", func() {
+		ctlA, err := New(&api.ProviderConfig{Profile: "team-a", Region: "eu-west-1"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		ctlB, err := New(&api.ProviderConfig{Profile: "team-b", Region: "ap-south-1"}, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(accessKeyID(ctlA)).To(Equal("AKIDTEAMA"))
+		Expect(accessKeyID(ctlB)).To(Equal("AKIDTEAMB"))
+		Expect(aws.StringValue(ctlA.Provider.EKS().(*awseks.EKS).Client.Config.Region)).To(Equal("eu-west-1"))
+		Expect(aws.StringValue(ctlB.Provider.EKS().(*awseks.EKS).Client.Config.Region)).To(Equal("ap-south-1"))
+		Expect(stsAuthHeaders).To(HaveLen(2))
+		Expect(stsAuthHeaders[0]).To(ContainSubstring("Credential=AKIDTEAMA/"))
+		Expect(stsAuthHeaders[1]).To(ContainSubstring("Credential=AKIDTEAMB/"))
+	})
+
+	It("uses the region of --profile when --region is not set", func() {
+		setEnv("AWS_REGION", "")
+		provider := &api.ProviderConfig{Profile: "team-a"}
+		ctl, err := New(provider, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(provider.Region).To(Equal("eu-west-3"))
+		Expect(aws.StringValue(ctl.Provider.EKS().(*awseks.EKS).Client.Config.Region)).To(Equal("eu-west-3"))
+		Expect(stsAuthHeaders[0]).To(ContainSubstring("/eu-west-3/sts/aws4_request"))
+	})
+
+	It("uses the regional STS endpoint when the region is read from the profile", func() {
+		setEnv("AWS_REGION", "")
+		s, err := NewSessionForProviderConfig(&api.ProviderConfig{Profile: "team-a"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sts.New(s).Client.Endpoint).To(Equal("https://sts.eu-west-3.amazonaws.com"))
+	})
+})
@@ -1,9 +1,7 @@
 package eks
 
 import (
-	"context"
 	"fmt"
-	"time"
 
 	"github.com/weaveworks/eksctl/pkg/actions/identityproviders"
 	"github.com/weaveworks/eksctl/pkg/actions/irsa"
@@ -11,12 +9,9 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/weaveworks/eksctl/pkg/addons"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
 	"github.com/weaveworks/eksctl/pkg/fargate"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/utils"
@@ -167,24 +162,8 @@ func (t *restartDaemonsetTask) Do(errCh chan error) error {
 	if err != nil {
 		return err
 	}
-	ds := clientSet.AppsV1().DaemonSets(t.namespace)
-	dep, err := ds.Get(context.TODO(), t.name, metav1.GetOptions{})
-	if err != nil {
-		return err
-	}
-	if dep.Spec.Template.Annotations == nil {
-		dep.Spec.Template.Annotations = make(map[string]string)
-	}
-	dep.Spec.Template.Annotations["eksctl.io/restartedAt"] = time.Now().Format(time.RFC3339)
-	bytes, err := runtime.Encode(unstructured.UnstructuredJSONScheme, dep)
-	if err != nil {
-		return errors.Wrapf(err, "failed to marshal %q deployment", t.name)
-	}
-	if _, err := ds.Patch(context.TODO(), t.name, types.MergePatchType, bytes, metav1.PatchOptions{}); err != nil {
-		return errors.Wrap(err, "failed to patch deployment")
-	}
-	logger.Info(`daemonset "%s/%s" restarted`, t.namespace, t.name)
-	return nil
+	_, err = defaultaddons.RestartDaemonSet(clientSet, t.namespace, t.name)
+	return err
 }
 
 // CreateExtraClusterConfigTasks returns all tasks for updating cluster configuration not depending on the control plane availability
@@ -246,6 +225,89 @@ func (c *ClusterProvider) CreateExtraClusterConfigTasks(cfg *api.ClusterConfig,
 		})
 	}
 
+	if cfg.IsAWSNodeDisabled() && cfg.AWSNode.DeleteIfPresent {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "delete aws-node",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				_, err = defaultaddons.DeleteAWSNode(clientSet, false)
+				return err
+			},
+		})
+	}
+
+	if cfg.HasPrefixDelegation() && !cfg.IsAWSNodeDisabled() {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "enable prefix delegation in aws-node",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				return defaultaddons.EnablePrefixDelegation(clientSet)
+			},
+		})
+	}
+
+	if cfg.CoreDNS != nil && len(cfg.CoreDNS.TopologySpreadConstraints) > 0 {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "set topology spread constraints on CoreDNS",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				return defaultaddons.SetCoreDNSTopologySpreadConstraints(clientSet, cfg.CoreDNS.TopologySpreadConstraints)
+			},
+		})
+	}
+
+	if cfg.CoreDNS != nil && (len(cfg.CoreDNS.PodLabels) > 0 || len(cfg.CoreDNS.PodAnnotations) > 0) {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "set pod labels and annotations on CoreDNS",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				return defaultaddons.SetCoreDNSPodMetadata(clientSet, cfg.CoreDNS.PodLabels, cfg.CoreDNS.PodAnnotations)
+			},
+		})
+	}
+
+	if cfg.KubeProxy != nil && (len(cfg.KubeProxy.PodLabels) > 0 || len(cfg.KubeProxy.PodAnnotations) > 0) {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "set pod labels and annotations on kube-proxy",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				return defaultaddons.SetKubeProxyPodMetadata(clientSet, cfg.KubeProxy.PodLabels, cfg.KubeProxy.PodAnnotations)
+			},
+		})
+	}
+
+	if cfg.VPC.CustomNetworking != nil {
+		newTasks.Append(&tasks.GenericTask{
+			Description: "configure VPC CNI custom networking",
+			Doer: func() error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				dynamicClient, err := c.NewDynamicClient(cfg)
+				if err != nil {
+					return err
+				}
+				return defaultaddons.ConfigureCustomNetworking(dynamicClient, clientSet, cfg.VPC.CustomNetworking)
+			},
+		})
+	}
+
 	if api.IsEnabled(cfg.IAM.WithOIDC) {
 		c.appendCreateTasksForIAMServiceAccounts(cfg, newTasks)
 	}
@@ -319,9 +381,34 @@ func (c *ClusterProvider) ClusterTasksForNodeGroups(cfg *api.ClusterConfig, inst
 		tasks.Append(newEFADevicePluginTask(c, cfg))
 	}
 
+	if cfg.HasSpotInterruptionHandler() {
+		tasks.Append(newSpotInterruptionHandlerTask(c, cfg))
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if api.IsEnabled(ng.SpotInterruptionHandler) {
+			logger.Info("managed nodegroup %q uses the spot interruption handling built into EKS, its nodes are drained when they receive a spot interruption notice", ng.Name)
+		}
+	}
+
 	return tasks
 }
 
+func newSpotInterruptionHandlerTask(clusterProvider *ClusterProvider, spec *api.ClusterConfig) tasks.Task {
+	return &tasks.GenericTask{
+		Description: "install spot interruption handler",
+		Doer: func() error {
+			rawClient, err := clusterProvider.NewRawClient(spec)
+			if err != nil {
+				return err
+			}
+			if err := addons.NewSpotInterruptionHandler(rawClient, false).Deploy(); err != nil {
+				return errors.Wrap(err, "error installing spot interruption handler")
+			}
+			return nil
+		},
+	}
+}
+
 func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(cfg *api.ClusterConfig, tasks *tasks.TaskTree) {
 	// we don't have all the information to construct full iamoidc.OpenIDConnectManager now,
 	// instead we just create a reference that gets updated when first task runs, and gets
@@ -163,21 +163,33 @@ func (c *ClusterProvider) EnableKMSEncryption(ctx context.Context, clusterConfig
 	if err != nil {
 		return errors.Wrap(err, "error describing cluster")
 	}
+	encryptedResources := map[string]string{}
 	for _, e := range clusterOutput.Cluster.EncryptionConfig {
-		if len(e.Resources) == 1 && *e.Resources[0] == "secrets" {
-			if existingKey := *e.Provider.KeyArn; existingKey != clusterConfig.SecretsEncryption.KeyARN {
-				return errors.Errorf("KMS encryption is already enabled with key %q, changing the key is not supported", existingKey)
-			}
-			logger.Info("KMS encryption is already enabled on the cluster")
-			return nil
+		for _, resource := range e.Resources {
+			encryptedResources[*resource] = *e.Provider.KeyArn
+		}
+	}
+	var resources []string
+	for _, resource := range clusterConfig.SecretsEncryption.EncryptedResources() {
+		existingKey, ok := encryptedResources[resource]
+		if !ok {
+			resources = append(resources, resource)
+			continue
 		}
+		if existingKey != clusterConfig.SecretsEncryption.KeyARN {
+			return errors.Errorf("KMS encryption of %s is already enabled with key %q, changing the key is not supported", resource, existingKey)
+		}
+	}
+	if len(resources) == 0 {
+		logger.Info("KMS encryption is already enabled on the cluster for %s", strings.Join(clusterConfig.SecretsEncryption.EncryptedResources(), ", "))
+		return nil
 	}
 
 	output, err := c.Provider.EKS().AssociateEncryptionConfigWithContext(ctx, &eks.AssociateEncryptionConfigInput{
 		ClusterName: clusterName,
 		EncryptionConfig: []*eks.EncryptionConfig{
 			{
-				Resources: aws.StringSlice([]string{"secrets"}),
+				Resources: aws.StringSlice(resources),
 				Provider: &eks.Provider{
 					KeyArn: aws.String(clusterConfig.SecretsEncryption.KeyARN),
 				},
@@ -189,7 +201,7 @@ func (c *ClusterProvider) EnableKMSEncryption(ctx context.Context, clusterConfig
 		return errors.Wrap(err, "error enabling KMS encryption")
 	}
 
-	logger.Info("initiated KMS encryption, this may take up to 45 minutes to complete")
+	logger.Info("initiated KMS encryption of %s, this may take up to 45 minutes to complete", strings.Join(resources, ", "))
 
 	err = waitForUpdate(ctx, c.Provider.EKS(), &eks.DescribeUpdateInput{
 		Name:     clusterName,
@@ -204,7 +216,7 @@ func (c *ClusterProvider) EnableKMSEncryption(ctx context.Context, clusterConfig
 		return errors.Errorf("failed to enable KMS encryption: %s", e.UpdateError)
 
 	case nil:
-		logger.Info("KMS encryption successfully enabled on cluster %q", clusterConfig.Metadata.Name)
+		logger.Info("KMS encryption of %s successfully enabled on cluster %q", strings.Join(resources, ", "), clusterConfig.Metadata.Name)
 		return nil
 
 	default:
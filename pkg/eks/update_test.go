@@ -1,6 +1,8 @@
 package eks_test
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 
@@ -203,4 +205,70 @@ var _ = Describe("EKS API wrapper", func() {
 			Expect(sentClusterLogging[1].Types).To(Equal(aws.StringSlice([]string{"api", "audit", "scheduler"})))
 		})
 	})
+
+	Describe("EnableKMSEncryption", func() {
+		const keyARN = "arn:aws:kms:us-west-2:000000000000:key/12345-12345"
+
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		mockEncryptionConfig := func(encryptionConfig ...*awseks.EncryptionConfig) {
+			cluster := testutils.NewFakeCluster("testcluster", awseks.ClusterStatusActive)
+			cluster.EncryptionConfig = encryptionConfig
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "testcluster"
+			cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: keyARN}
+		})
+
+		It("encrypts the resources that are not encrypted yet", func() {
+			mockEncryptionConfig()
+			p.MockEKS().On("AssociateEncryptionConfigWithContext", mock.Anything, &awseks.AssociateEncryptionConfigInput{
+				ClusterName: aws.String("testcluster"),
+				EncryptionConfig: []*awseks.EncryptionConfig{{
+					Resources: aws.StringSlice([]string{"secrets"}),
+					Provider:  &awseks.Provider{KeyArn: aws.String(keyARN)},
+				}},
+			}, mock.Anything).Return(&awseks.AssociateEncryptionConfigOutput{
+				Update: &awseks.Update{Id: aws.String("update-1")},
+			}, nil)
+			p.MockEKS().On("DescribeUpdate", mock.Anything).Return(&awseks.DescribeUpdateOutput{
+				Update: &awseks.Update{Id: aws.String("update-1"), Status: aws.String(awseks.UpdateStatusSuccessful)},
+			}, nil)
+
+			Expect(ctl.EnableKMSEncryption(context.Background(), cfg)).To(Succeed())
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "AssociateEncryptionConfigWithContext", 1)
+		})
+
+		It("does not encrypt resources already encrypted with the key", func() {
+			mockEncryptionConfig(&awseks.EncryptionConfig{
+				Resources: aws.StringSlice([]string{"secrets"}),
+				Provider:  &awseks.Provider{KeyArn: aws.String(keyARN)},
+			})
+
+			Expect(ctl.EnableKMSEncryption(context.Background(), cfg)).To(Succeed())
+			p.MockEKS().AssertNotCalled(GinkgoT(), "AssociateEncryptionConfigWithContext", mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("does not change the key of encrypted resources", func() {
+			mockEncryptionConfig(&awseks.EncryptionConfig{
+				Resources: aws.StringSlice([]string{"secrets"}),
+				Provider:  &awseks.Provider{KeyArn: aws.String("arn:aws:kms:us-west-2:000000000000:key/other")},
+			})
+
+			err := ctl.EnableKMSEncryption(context.Background(), cfg)
+			Expect(err).To(MatchError(`KMS encryption of secrets is already enabled with key "arn:aws:kms:us-west-2:000000000000:key/other", changing the key is not supported`))
+		})
+	})
 })
@@ -0,0 +1,137 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// The upgrade policy is not part of the EKS API of the AWS SDK yet, so the requests below are
+// sent to the DescribeCluster and UpdateClusterConfig operations with their own input and output shapes
+
+type upgradePolicy struct {
+	_ struct{} `type:"structure"`
+
+	SupportType *string `locationName:"supportType" type:"string"`
+}
+
+type describeUpgradePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+type clusterUpgradePolicy struct {
+	_ struct{} `type:"structure"`
+
+	UpgradePolicy *upgradePolicy `locationName:"upgradePolicy" type:"structure"`
+}
+
+type describeUpgradePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	Cluster *clusterUpgradePolicy `locationName:"cluster" type:"structure"`
+}
+
+type updateUpgradePolicyInput struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+
+	UpgradePolicy *upgradePolicy `locationName:"upgradePolicy" type:"structure"`
+}
+
+type updateUpgradePolicyOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *eks.Update `locationName:"update" type:"structure"`
+}
+
+// LogExtendedSupportCost warns that extended support is billed when the cluster opts in to it
+func LogExtendedSupportCost(cfg *api.ClusterConfig) {
+	if cfg.UpgradePolicy != nil && cfg.UpgradePolicy.SupportType == api.SupportTypeExtended {
+		logger.Warning("cluster %q uses extended support, which is billed at an additional hourly rate once its Kubernetes version reaches the end of standard support; see https://aws.amazon.com/eks/pricing/", cfg.Metadata.Name)
+	}
+}
+
+// GetClusterUpgradePolicy returns the upgrade policy of the cluster, or nil if EKS does not report one
+func (c *ClusterProvider) GetClusterUpgradePolicy(clusterName string) (*api.UpgradePolicy, error) {
+	client, err := awsEKSClient(c.Provider.EKS(), "upgrade policies")
+	if err != nil {
+		return nil, err
+	}
+	return describeUpgradePolicy(client, clusterName)
+}
+
+// UpdateClusterUpgradePolicy calls eks.UpdateClusterConfig to set the upgrade policy of the cluster
+func (c *ClusterProvider) UpdateClusterUpgradePolicy(cfg *api.ClusterConfig) error {
+	client, err := awsEKSClient(c.Provider.EKS(), "upgrade policies")
+	if err != nil {
+		return err
+	}
+	update, err := updateUpgradePolicy(client, cfg.Metadata.Name, cfg.UpgradePolicy)
+	if err != nil {
+		return err
+	}
+	return c.waitForUpdateToSucceed(cfg.Metadata.Name, update)
+}
+
+// awsEKSClient returns the AWS EKS client, which is needed to send requests for the cluster
+// settings that the AWS SDK does not model yet
+func awsEKSClient(eksAPI eksiface.EKSAPI, feature string) (*eks.EKS, error) {
+	client, ok := eksAPI.(*eks.EKS)
+	if !ok {
+		return nil, fmt.Errorf("the AWS EKS client is required for %s, got %T", feature, eksAPI)
+	}
+	return client, nil
+}
+
+func describeUpgradePolicy(client *eks.EKS, clusterName string) (*api.UpgradePolicy, error) {
+	op := &request.Operation{
+		Name:       "DescribeCluster",
+		HTTPMethod: "GET",
+		HTTPPath:   "/clusters/{name}",
+	}
+	output := &describeUpgradePolicyOutput{}
+	req := client.NewRequest(op, &describeUpgradePolicyInput{Name: aws.String(clusterName)}, output)
+	if err := req.Send(); err != nil {
+		return nil, errors.Wrapf(err, "describing upgrade policy of cluster %q", clusterName)
+	}
+
+	if output.Cluster == nil || output.Cluster.UpgradePolicy == nil || output.Cluster.UpgradePolicy.SupportType == nil {
+		return nil, nil
+	}
+	return &api.UpgradePolicy{
+		SupportType: *output.Cluster.UpgradePolicy.SupportType,
+	}, nil
+}
+
+func updateUpgradePolicy(client *eks.EKS, clusterName string, policy *api.UpgradePolicy) (*eks.Update, error) {
+	op := &request.Operation{
+		Name:       "UpdateClusterConfig",
+		HTTPMethod: "POST",
+		HTTPPath:   "/clusters/{name}/update-config",
+	}
+	input := &updateUpgradePolicyInput{
+		Name: aws.String(clusterName),
+		UpgradePolicy: &upgradePolicy{
+			SupportType: aws.String(policy.SupportType),
+		},
+	}
+	output := &updateUpgradePolicyOutput{}
+	req := client.NewRequest(op, input, output)
+	if err := req.Send(); err != nil {
+		return nil, errors.Wrapf(err, "updating upgrade policy of cluster %q", clusterName)
+	}
+	if output.Update == nil {
+		return nil, fmt.Errorf("updating upgrade policy of cluster %q: no update was returned", clusterName)
+	}
+	return output.Update, nil
+}
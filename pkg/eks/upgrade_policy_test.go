@@ -0,0 +1,94 @@
+package eks_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Cluster upgrade policy", func() {
+	var (
+		server   *httptest.Server
+		client   *awseks.EKS
+		response string
+
+		requestMethod, requestPath string
+		requestBody                map[string]interface{}
+	)
+
+	BeforeEach(func() {
+		requestMethod, requestPath, requestBody = "", "", nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			requestMethod, requestPath = r.Method, r.URL.Path
+			body, err := ioutil.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			if len(body) > 0 {
+				Expect(json.Unmarshal(body, &requestBody)).To(Succeed())
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(response))
+		}))
+
+		sess, err := session.NewSession(&aws.Config{
+			Endpoint:    aws.String(server.URL),
+			Region:      aws.String("us-west-2"),
+			Credentials: credentials.NewStaticCredentials("id", "secret", ""),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		client = awseks.New(sess)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("describes the support type of the cluster", func() {
+		response = `{"cluster": {"name": "test", "version": "1.21", "upgradePolicy": {"supportType": "EXTENDED"}}}`
+
+		policy, err := DescribeUpgradePolicy(client, "test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(Equal(&api.UpgradePolicy{SupportType: api.SupportTypeExtended}))
+		Expect(requestMethod).To(Equal("GET"))
+		Expect(requestPath).To(Equal("/clusters/test"))
+	})
+
+	It("returns no policy when EKS does not report one", func() {
+		response = `{"cluster": {"name": "test", "version": "1.21"}}`
+
+		policy, err := DescribeUpgradePolicy(client, "test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy).To(BeNil())
+	})
+
+	It("updates the support type of the cluster", func() {
+		response = `{"update": {"id": "update-1", "type": "UpgradePolicyUpdate", "status": "InProgress"}}`
+
+		update, err := UpdateUpgradePolicy(client, "test", &api.UpgradePolicy{SupportType: api.SupportTypeStandard})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*update.Id).To(Equal("update-1"))
+		Expect(requestMethod).To(Equal("POST"))
+		Expect(requestPath).To(Equal("/clusters/test/update-config"))
+		Expect(requestBody).To(Equal(map[string]interface{}{
+			"upgradePolicy": map[string]interface{}{"supportType": "STANDARD"},
+		}))
+	})
+
+	It("fails without the AWS EKS client", func() {
+		ctl := &ClusterProvider{Provider: mockprovider.NewMockProvider()}
+		_, err := ctl.GetClusterUpgradePolicy("test")
+		Expect(err).To(MatchError("the AWS EKS client is required for upgrade policies, got *mocks.EKSAPI"))
+	})
+})
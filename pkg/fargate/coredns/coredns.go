@@ -12,9 +12,6 @@ import (
 	"github.com/weaveworks/eksctl/pkg/utils/retry"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/types"
 	kubeclient "k8s.io/client-go/kubernetes"
 )
 
@@ -26,7 +23,8 @@ const (
 	// ComputeTypeAnnotationKey is the key of the annotation driving CoreDNS'
 	// scheduling.
 	ComputeTypeAnnotationKey = "eks.amazonaws.com/compute-type"
-	computeTypeFargate       = "fargate"
+	computeTypeFargate       = api.CoreDNSComputeTypeFargate
+	computeTypeEC2           = api.CoreDNSComputeTypeEC2
 )
 
 // IsSchedulableOnFargate analyzes the provided profiles to determine whether
@@ -34,7 +32,7 @@ const (
 func IsSchedulableOnFargate(profiles []*api.FargateProfile) bool {
 	for _, profile := range profiles {
 		for _, selector := range profile.Selectors {
-			if selectsCoreDNS(selector) {
+			if api.SelectsCoreDNS(selector) {
 				return true
 			}
 		}
@@ -42,10 +40,6 @@ func IsSchedulableOnFargate(profiles []*api.FargateProfile) bool {
 	return false
 }
 
-func selectsCoreDNS(selector api.FargateProfileSelector) bool {
-	return selector.Namespace == Namespace && len(selector.Labels) == 0
-}
-
 // IsScheduledOnFargate checks if EKS' coredns is scheduled onto Fargate.
 func IsScheduledOnFargate(clientSet kubeclient.Interface) (bool, error) {
 	isDepOnFargate, err := isDeploymentScheduledOnFargate(clientSet)
@@ -106,38 +100,94 @@ func isRunningOnFargate(pod *v1.Pod) bool {
 // ScheduleOnFargate modifies EKS' coredns deployment so that it can be scheduled
 // on Fargate.
 func ScheduleOnFargate(clientSet kubeclient.Interface) error {
-	if err := scheduleOnFargate(clientSet); err != nil {
+	if err := setComputeType(clientSet, computeTypeFargate); err != nil {
 		return errors.Wrapf(err, "failed to make %q deployment schedulable on Fargate", Name)
 	}
 	logger.Info("%q is now schedulable onto Fargate", Name)
 	return nil
 }
 
-func scheduleOnFargate(clientSet kubeclient.Interface) error {
+// ScheduleOnEC2 modifies EKS' coredns deployment so that it is scheduled
+// on EC2 nodes.
+func ScheduleOnEC2(clientSet kubeclient.Interface) error {
+	if err := setComputeType(clientSet, computeTypeEC2); err != nil {
+		return errors.Wrapf(err, "failed to make %q deployment schedulable on EC2", Name)
+	}
+	logger.Info("%q is now schedulable onto EC2", Name)
+	return nil
+}
+
+func setComputeType(clientSet kubeclient.Interface, computeType string) error {
 	deployments := clientSet.AppsV1().Deployments(Namespace)
 	coredns, err := deployments.Get(context.TODO(), Name, metav1.GetOptions{})
 	if err != nil {
 		return err
 	}
-	coredns.Spec.Template.Annotations[ComputeTypeAnnotationKey] = computeTypeFargate
-	bytes, err := runtime.Encode(unstructured.UnstructuredJSONScheme, coredns)
-	if err != nil {
-		return errors.Wrapf(err, "failed to marshal %q deployment", Name)
+	if coredns.Spec.Template.Annotations == nil {
+		coredns.Spec.Template.Annotations = map[string]string{}
+	}
+	coredns.Spec.Template.Annotations[ComputeTypeAnnotationKey] = computeType
+	if computeType == computeTypeFargate {
+		removeComputeTypeScheduling(&coredns.Spec.Template.Spec)
 	}
-	patched, err := deployments.Patch(context.TODO(), Name, types.MergePatchType, bytes, metav1.PatchOptions{})
+	// the deployment is updated rather than patched so that removed scheduling constraints are not merged back
+	updated, err := deployments.Update(context.TODO(), coredns, metav1.UpdateOptions{})
 	if err != nil {
-		return errors.Wrap(err, "failed to patch deployment")
+		return errors.Wrap(err, "failed to update deployment")
 	}
-	value, exists := patched.Spec.Template.Annotations[ComputeTypeAnnotationKey]
+	value, exists := updated.Spec.Template.Annotations[ComputeTypeAnnotationKey]
 	if !exists {
-		return fmt.Errorf("could not find annotation %q on patched deployment %q: patching must have failed", ComputeTypeAnnotationKey, Name)
+		return fmt.Errorf("could not find annotation %q on updated deployment %q: updating must have failed", ComputeTypeAnnotationKey, Name)
 	}
-	if value != computeTypeFargate {
-		return fmt.Errorf("unexpected value %q for annotation %q on %q patched deployment", value, ComputeTypeAnnotationKey, Name)
+	if value != computeType {
+		return fmt.Errorf("unexpected value %q for annotation %q on %q updated deployment", value, ComputeTypeAnnotationKey, Name)
 	}
 	return nil
 }
 
+// removeComputeTypeScheduling removes the node selector and node affinity terms on the compute type,
+// which would otherwise keep the pods from being scheduled on Fargate
+func removeComputeTypeScheduling(podSpec *v1.PodSpec) {
+	delete(podSpec.NodeSelector, ComputeTypeAnnotationKey)
+	if podSpec.Affinity == nil || podSpec.Affinity.NodeAffinity == nil {
+		return
+	}
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+		var terms []v1.NodeSelectorTerm
+		for _, term := range required.NodeSelectorTerms {
+			term.MatchExpressions = withoutComputeType(term.MatchExpressions)
+			// an empty term matches no nodes
+			if len(term.MatchExpressions) > 0 || len(term.MatchFields) > 0 {
+				terms = append(terms, term)
+			}
+		}
+		if len(terms) == 0 {
+			nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = nil
+		} else {
+			required.NodeSelectorTerms = terms
+		}
+	}
+	var preferred []v1.PreferredSchedulingTerm
+	for _, term := range nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		term.Preference.MatchExpressions = withoutComputeType(term.Preference.MatchExpressions)
+		if len(term.Preference.MatchExpressions) > 0 || len(term.Preference.MatchFields) > 0 {
+			preferred = append(preferred, term)
+		}
+	}
+	nodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution = preferred
+}
+
+func withoutComputeType(requirements []v1.NodeSelectorRequirement) []v1.NodeSelectorRequirement {
+	var filtered []v1.NodeSelectorRequirement
+	for _, requirement := range requirements {
+		if requirement.Key != ComputeTypeAnnotationKey {
+			filtered = append(filtered, requirement)
+		}
+	}
+	return filtered
+}
+
 // WaitForScheduleOnFargate waits for coredns to be scheduled on Fargate.
 // It will wait until it has detected that the scheduling has been successful,
 // or until the retry policy times out, whichever happens first.
@@ -102,6 +102,20 @@ var _ = Describe("coredns", func() {
 			Expect(coredns.IsSchedulableOnFargate(cfg.FargateProfiles)).To(BeTrue())
 		})
 
+		It("should return true when a Fargate profile matches kube-system and the CoreDNS pod labels", func() {
+			Expect(coredns.IsSchedulableOnFargate([]*api.FargateProfile{
+				{
+					Name: "fp-coredns",
+					Selectors: []api.FargateProfileSelector{
+						{
+							Namespace: "kube-system",
+							Labels:    map[string]string{"k8s-app": "kube-dns"},
+						},
+					},
+				},
+			})).To(BeTrue())
+		})
+
 		It("should return false when a Fargate profile matches kube-system but has labels", func() {
 			Expect(coredns.IsSchedulableOnFargate(profileNotSelectingCoreDNSBecauseOfLabels)).To(BeFalse())
 		})
@@ -130,6 +144,62 @@ var _ = Describe("coredns", func() {
 			Expect(err).To(Not(HaveOccurred()))
 			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(coredns.ComputeTypeAnnotationKey, "fargate"))
 		})
+		It("should remove the node selector and node affinity on the EC2 compute type", func() {
+			// Given:
+			dep := deployment("ec2", 0, 2)
+			dep.Spec.Template.Spec.NodeSelector = map[string]string{
+				coredns.ComputeTypeAnnotationKey: "ec2",
+				"kubernetes.io/os":               "linux",
+			}
+			dep.Spec.Template.Spec.Affinity = &v1.Affinity{
+				NodeAffinity: &v1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+						NodeSelectorTerms: []v1.NodeSelectorTerm{
+							{
+								MatchExpressions: []v1.NodeSelectorRequirement{
+									{Key: "kubernetes.io/arch", Operator: v1.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}},
+									{Key: coredns.ComputeTypeAnnotationKey, Operator: v1.NodeSelectorOpNotIn, Values: []string{"fargate"}},
+								},
+							},
+							{
+								MatchExpressions: []v1.NodeSelectorRequirement{
+									{Key: coredns.ComputeTypeAnnotationKey, Operator: v1.NodeSelectorOpIn, Values: []string{"ec2"}},
+								},
+							},
+						},
+					},
+				},
+			}
+			mockClientset := mockClientsetWith(dep)
+			// When:
+			err := coredns.ScheduleOnFargate(mockClientset)
+			Expect(err).To(Not(HaveOccurred()))
+			// Then:
+			deployment, err := mockClientset.AppsV1().Deployments(coredns.Namespace).Get(context.TODO(), coredns.Name, metav1.GetOptions{})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(deployment.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"kubernetes.io/os": "linux"}))
+			Expect(deployment.Spec.Template.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal([]v1.NodeSelectorTerm{
+				{
+					MatchExpressions: []v1.NodeSelectorRequirement{
+						{Key: "kubernetes.io/arch", Operator: v1.NodeSelectorOpIn, Values: []string{"amd64", "arm64"}},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("ScheduleOnEC2", func() {
+		It("should set the compute-type annotation to 'ec2'", func() {
+			// Given:
+			mockClientset := mockClientsetWith(deployment("fargate", 2, 2))
+			// When:
+			err := coredns.ScheduleOnEC2(mockClientset)
+			Expect(err).To(Not(HaveOccurred()))
+			// Then:
+			deployment, err := mockClientset.AppsV1().Deployments(coredns.Namespace).Get(context.TODO(), coredns.Name, metav1.GetOptions{})
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(deployment.Spec.Template.Annotations).To(HaveKeyWithValue(coredns.ComputeTypeAnnotationKey, "ec2"))
+		})
 	})
 
 	Describe("WaitForScheduleOnFargate", func() {
@@ -1,6 +1,7 @@
 package fargate
 
 import (
+	"fmt"
 	"io"
 	"strings"
 
@@ -29,6 +30,33 @@ func PrintProfiles(profiles []*api.FargateProfile, writer io.Writer, printerType
 	}
 }
 
+// PrintProfilesAsConfig prints the provided profiles as the fargateProfiles of a
+// ClusterConfig in the provided printer type ("json", "yaml"), so that they can
+// be re-created with `eksctl create fargateprofile -f`.
+func PrintProfilesAsConfig(profiles []*api.FargateProfile, meta *api.ClusterMeta, writer io.Writer, printerType printers.Type) error {
+	if printerType == printers.TableType {
+		return fmt.Errorf("Fargate profiles cannot be printed as a ClusterConfig in %q format", printerType)
+	}
+	printer, err := printers.NewPrinter(printerType)
+	if err != nil {
+		return err
+	}
+	cfg := &api.ClusterConfig{
+		TypeMeta: api.ClusterConfigTypeMeta(),
+		Metadata: &api.ClusterMeta{
+			Name:   meta.Name,
+			Region: meta.Region,
+		},
+	}
+	for _, profile := range profiles {
+		fp := profile.DeepCopy()
+		// the status is reported by EKS and is not part of the desired config
+		fp.Status = ""
+		cfg.FargateProfiles = append(cfg.FargateProfiles, fp)
+	}
+	return printer.PrintObj(cfg, writer)
+}
+
 type row struct {
 	Name                string
 	PodExecutionRoleARN string
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/fargate"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
@@ -36,6 +37,33 @@ var _ = Describe("fargate", func() {
 			Expect(out.String()).To(Equal(expectedJSON))
 		})
 
+		It("formats profiles & prints them as a ClusterConfig which can be loaded back", func() {
+			profiles := sampleProfiles()
+			out := bytes.NewBufferString("")
+			err := fargate.PrintProfilesAsConfig(profiles, &api.ClusterMeta{Name: "my-cluster", Region: "us-west-2", Version: "1.20"}, out, printers.YAMLType)
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(out.String()).To(Equal(expectedConfigYAML))
+
+			Expect(api.Register()).To(Succeed())
+			cfg, err := eks.ParseConfig(out.Bytes())
+			Expect(err).To(Not(HaveOccurred()))
+			Expect(cfg.Metadata.Name).To(Equal("my-cluster"))
+			Expect(cfg.FargateProfiles).To(HaveLen(2))
+			for i, fp := range cfg.FargateProfiles {
+				Expect(fp.Validate()).To(Succeed())
+				Expect(fp.Status).To(BeEmpty())
+				Expect(fp.Selectors).To(Equal(profiles[i].Selectors))
+				Expect(fp.Subnets).To(Equal(profiles[i].Subnets))
+				Expect(fp.PodExecutionRoleARN).To(Equal(profiles[i].PodExecutionRoleARN))
+			}
+			Expect(profiles[0].Status).To(Equal("ACTIVE"), "the given profiles should not be modified")
+		})
+
+		It("returns an error when printing profiles as a ClusterConfig table", func() {
+			err := fargate.PrintProfilesAsConfig(sampleProfiles(), &api.ClusterMeta{Name: "my-cluster"}, bytes.NewBufferString(""), printers.TableType)
+			Expect(err).To(MatchError(`Fargate profiles cannot be printed as a ClusterConfig in "table" format`))
+		})
+
 		It("returns an error for unsupported printer type", func() {
 			profiles := sampleProfiles()
 			out := bytes.NewBufferString("")
@@ -76,6 +104,34 @@ const expectedYAML = `- name: fp-test
   - subnet-d34dc0w
 `
 
+const expectedConfigYAML = `apiVersion: eksctl.io/v1alpha5
+fargateProfiles:
+- name: fp-test
+  podExecutionRoleARN: arn:aws:iam::123:role/root
+  selectors:
+  - labels:
+      app: my-app
+      env: test
+    namespace: kube-system
+  - namespace: default
+  tags:
+    app: my-app
+    env: test
+- name: fp-prod
+  podExecutionRoleARN: arn:aws:iam::123:role/root
+  selectors:
+  - labels:
+      env: prod
+    namespace: prod
+  subnets:
+  - subnet-prod
+  - subnet-d34dc0w
+kind: ClusterConfig
+metadata:
+  name: my-cluster
+  region: us-west-2
+`
+
 const expectedJSON = `[
     {
         "name": "fp-test",
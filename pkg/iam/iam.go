@@ -7,6 +7,8 @@ import (
 	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	awsiam "github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
@@ -15,6 +17,21 @@ import (
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 )
 
+// requiredNodePolicies are the managed policies that the instance role of a node requires to join a cluster
+var requiredNodePolicies = []string{
+	"AmazonEKSWorkerNodePolicy",
+	"AmazonEC2ContainerRegistryReadOnly",
+	api.IAMPolicyAmazonEKSCNIPolicy,
+}
+
+// eksServicePrincipal is the service principal the service role of a cluster must trust
+const eksServicePrincipal = "eks.amazonaws.com"
+
+// requiredServiceRolePolicies are the managed policies that the service role of a cluster requires
+var requiredServiceRolePolicies = []string{
+	"AmazonEKSClusterPolicy",
+}
+
 // ImportInstanceRoleFromProfileARN fetches first role ARN from instance profile
 func ImportInstanceRoleFromProfileARN(iamAPI iamiface.IAMAPI, ng *api.NodeGroup, profileARN string) error {
 	partsOfProfileARN := strings.Split(profileARN, "/")
@@ -43,6 +60,145 @@ func ImportInstanceRoleFromProfileARN(iamAPI iamiface.IAMAPI, ng *api.NodeGroup,
 	return nil
 }
 
+// ValidateInstanceProfiles checks the existing instance profiles that nodegroups are configured to use
+func ValidateInstanceProfiles(iamAPI iamiface.IAMAPI, nodeGroups []*api.NodeGroup, awsNodeUsesIRSA bool) error {
+	for _, ng := range nodeGroups {
+		if ng.IAM == nil || ng.IAM.InstanceProfileARN == "" {
+			continue
+		}
+		if err := ValidateInstanceProfile(iamAPI, ng.IAM.InstanceProfileARN, awsNodeUsesIRSA); err != nil {
+			return errors.Wrapf(err, "validating iam.instanceProfileARN of nodegroup %q", ng.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateInstanceProfile checks that the instance profile exists and has a role, and warns if
+// any of the policies required by nodes are not attached to that role. The CNI policy is not required
+// when aws-node gets its permissions from its own role through IRSA
+func ValidateInstanceProfile(iamAPI iamiface.IAMAPI, profileARN string, awsNodeUsesIRSA bool) error {
+	parts := strings.Split(profileARN, "/")
+	if len(parts) < 2 {
+		return fmt.Errorf("unexpected format of instance profile ARN: %q", profileARN)
+	}
+	profileName := parts[len(parts)-1]
+
+	output, err := iamAPI.GetInstanceProfile(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: &profileName,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "getting instance profile %q", profileName)
+	}
+
+	roles := output.InstanceProfile.Roles
+	if len(roles) == 0 {
+		return fmt.Errorf("instance profile %q has no roles", profileName)
+	}
+
+	roleName := *roles[0].RoleName
+	missing, err := missingPolicies(iamAPI, roleName, nodePolicies(awsNodeUsesIRSA))
+	if err != nil {
+		logger.Warning("unable to list the policies attached to role %q of instance profile %q: %v", roleName, profileName, err)
+		return nil
+	}
+	for _, policy := range missing {
+		logger.Warning("policy %s is not attached to role %q of instance profile %q, nodes using it may not be able to join the cluster", policy, roleName, profileName)
+	}
+	return nil
+}
+
+// nodePolicies returns the policies required by the instance role of a node
+func nodePolicies(awsNodeUsesIRSA bool) []string {
+	if !awsNodeUsesIRSA {
+		return requiredNodePolicies
+	}
+	var policies []string
+	for _, policy := range requiredNodePolicies {
+		if policy != api.IAMPolicyAmazonEKSCNIPolicy {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
+// ValidateServiceRole checks that the existing service role of the cluster exists and can be assumed by EKS,
+// and warns if any of the policies required by the control plane are not attached to it. The role is not
+// checked if the caller is not allowed to get it
+func ValidateServiceRole(iamAPI iamiface.IAMAPI, iamConfig *api.ClusterIAM) error {
+	if !api.IsSetAndNonEmptyString(iamConfig.ServiceRoleARN) {
+		return nil
+	}
+	roleARN := *iamConfig.ServiceRoleARN
+	parts := strings.Split(roleARN, "/")
+	if len(parts) < 2 {
+		return fmt.Errorf("unexpected format of role ARN: %q", roleARN)
+	}
+	roleName := parts[len(parts)-1]
+
+	output, err := iamAPI.GetRole(&awsiam.GetRoleInput{RoleName: &roleName})
+	if err != nil {
+		if awsErr, ok := errors.Cause(err).(awserr.Error); ok && awsErr.Code() == "AccessDenied" {
+			logger.Warning("unable to get iam.serviceRoleARN %q, not checking that it can be used by the cluster: %v", roleARN, err)
+			return nil
+		}
+		return errors.Wrapf(err, "getting iam.serviceRoleARN %q", roleARN)
+	}
+	policy, err := ParseTrustPolicy(aws.StringValue(output.Role.AssumeRolePolicyDocument))
+	if err != nil {
+		return errors.Wrapf(err, "parsing trust relationship of iam.serviceRoleARN %q", roleARN)
+	}
+	if !trustsService(policy, eksServicePrincipal) {
+		return fmt.Errorf("the trust relationship of iam.serviceRoleARN %q does not allow %s to assume it", roleARN, eksServicePrincipal)
+	}
+
+	required := append([]string{}, requiredServiceRolePolicies...)
+	if !api.IsDisabled(iamConfig.VPCResourceControllerPolicy) {
+		required = append(required, "AmazonEKSVPCResourceController")
+	}
+	missing, err := missingPolicies(iamAPI, roleName, required)
+	if err != nil {
+		logger.Warning("unable to list the policies attached to service role %q: %v", roleName, err)
+		return nil
+	}
+	for _, policy := range missing {
+		logger.Warning("policy %s is not attached to service role %q, the control plane may not be able to manage the resources of the cluster", policy, roleName)
+	}
+	return nil
+}
+
+// trustsService returns true if the trust relationship allows the service to assume the role
+func trustsService(policy *TrustPolicy, service string) bool {
+	for _, statement := range policy.Statement {
+		if statement.Allows("sts:AssumeRole") && (statement.Principal.Everyone || statement.Principal.Service.Has(service)) {
+			return true
+		}
+	}
+	return false
+}
+
+func missingPolicies(iamAPI iamiface.IAMAPI, roleName string, required []string) ([]string, error) {
+	attached := map[string]bool{}
+	err := iamAPI.ListAttachedRolePoliciesPages(&awsiam.ListAttachedRolePoliciesInput{
+		RoleName: &roleName,
+	}, func(page *awsiam.ListAttachedRolePoliciesOutput, _ bool) bool {
+		for _, p := range page.AttachedPolicies {
+			attached[*p.PolicyName] = true
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, policy := range required {
+		if !attached[policy] {
+			missing = append(missing, policy)
+		}
+	}
+	return missing, nil
+}
+
 // UseFromNodeGroup retrieves the IAM configuration from an existing nodegroup
 // based on stack outputs
 func UseFromNodeGroup(stack *cfn.Stack, ng *api.NodeGroup) error {
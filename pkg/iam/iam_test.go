@@ -0,0 +1,186 @@
+package iam
+
+import (
+	"errors"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go/service/iam"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Instance profile validation", func() {
+	var p *mockprovider.MockProvider
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+	})
+
+	mockInstanceProfile := func(roles ...string) {
+		profile := &awsiam.InstanceProfile{}
+		for _, role := range roles {
+			profile.Roles = append(profile.Roles, &awsiam.Role{RoleName: aws.String(role)})
+		}
+		p.MockIAM().On("GetInstanceProfile", &awsiam.GetInstanceProfileInput{
+			InstanceProfileName: aws.String("node-profile"),
+		}).Return(&awsiam.GetInstanceProfileOutput{InstanceProfile: profile}, nil)
+	}
+
+	mockAttachedPolicies := func(policies ...string) {
+		output := &awsiam.ListAttachedRolePoliciesOutput{}
+		for _, policy := range policies {
+			output.AttachedPolicies = append(output.AttachedPolicies, &awsiam.AttachedPolicy{PolicyName: aws.String(policy)})
+		}
+		p.MockIAM().On("ListAttachedRolePoliciesPages", &awsiam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String("node-role"),
+		}, mock.Anything).Run(func(args mock.Arguments) {
+			pageFn := args[1].(func(*awsiam.ListAttachedRolePoliciesOutput, bool) bool)
+			pageFn(output, true)
+		}).Return(nil)
+	}
+
+	It("accepts an instance profile with a path", func() {
+		mockInstanceProfile("node-role")
+		mockAttachedPolicies("AmazonEKSWorkerNodePolicy", "AmazonEC2ContainerRegistryReadOnly", "AmazonEKS_CNI_Policy")
+
+		Expect(ValidateInstanceProfile(p.IAM(), "arn:aws:iam::123456789012:instance-profile/nodes/node-profile", false)).To(Succeed())
+	})
+
+	It("does not fail when the role is missing required policies", func() {
+		mockInstanceProfile("node-role")
+		mockAttachedPolicies("AmazonEKSWorkerNodePolicy")
+
+		Expect(ValidateInstanceProfile(p.IAM(), "arn:aws:iam::123456789012:instance-profile/node-profile", false)).To(Succeed())
+		missing, err := missingPolicies(p.IAM(), "node-role", nodePolicies(false))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(missing).To(ConsistOf("AmazonEC2ContainerRegistryReadOnly", "AmazonEKS_CNI_Policy"))
+	})
+
+	It("does not require the CNI policy when aws-node uses IRSA", func() {
+		mockInstanceProfile("node-role")
+		mockAttachedPolicies("AmazonEKSWorkerNodePolicy", "AmazonEC2ContainerRegistryReadOnly")
+
+		Expect(ValidateInstanceProfile(p.IAM(), "arn:aws:iam::123456789012:instance-profile/node-profile", true)).To(Succeed())
+		missing, err := missingPolicies(p.IAM(), "node-role", nodePolicies(true))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(missing).To(BeEmpty())
+	})
+
+	It("fails when the instance profile does not exist", func() {
+		p.MockIAM().On("GetInstanceProfile", mock.Anything).Return(nil, errors.New("NoSuchEntity"))
+
+		err := ValidateInstanceProfiles(p.IAM(), []*api.NodeGroup{
+			{NodeGroupBase: &api.NodeGroupBase{Name: "ng-1", IAM: &api.NodeGroupIAM{}}},
+			{NodeGroupBase: &api.NodeGroupBase{Name: "ng-2", IAM: &api.NodeGroupIAM{
+				InstanceProfileARN: "arn:aws:iam::123456789012:instance-profile/node-profile",
+			}}},
+		}, false)
+		Expect(err).To(MatchError(`validating iam.instanceProfileARN of nodegroup "ng-2": getting instance profile "node-profile": NoSuchEntity`))
+	})
+
+	It("fails when the instance profile has no roles", func() {
+		mockInstanceProfile()
+
+		Expect(ValidateInstanceProfile(p.IAM(), "arn:aws:iam::123456789012:instance-profile/node-profile", false)).To(MatchError(`instance profile "node-profile" has no roles`))
+	})
+
+	It("fails when the ARN is malformed", func() {
+		Expect(ValidateInstanceProfile(p.IAM(), "node-profile", false)).To(MatchError(`unexpected format of instance profile ARN: "node-profile"`))
+	})
+})
+
+var _ = Describe("Service role validation", func() {
+	const roleARN = "arn:aws:iam::123456789012:role/eks/cluster-role"
+
+	var (
+		p         *mockprovider.MockProvider
+		iamConfig *api.ClusterIAM
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		iamConfig = &api.ClusterIAM{ServiceRoleARN: aws.String(roleARN)}
+	})
+
+	mockRoleWithTrust := func(service string) {
+		document := `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"` + service + `"},"Action":"sts:AssumeRole"}]}`
+		p.MockIAM().On("GetRole", &awsiam.GetRoleInput{
+			RoleName: aws.String("cluster-role"),
+		}).Return(&awsiam.GetRoleOutput{Role: &awsiam.Role{
+			RoleName:                 aws.String("cluster-role"),
+			AssumeRolePolicyDocument: aws.String(url.QueryEscape(document)),
+		}}, nil)
+	}
+
+	mockRole := func() {
+		mockRoleWithTrust("eks.amazonaws.com")
+	}
+
+	mockAttachedPolicies := func(policies ...string) {
+		output := &awsiam.ListAttachedRolePoliciesOutput{}
+		for _, policy := range policies {
+			output.AttachedPolicies = append(output.AttachedPolicies, &awsiam.AttachedPolicy{PolicyName: aws.String(policy)})
+		}
+		p.MockIAM().On("ListAttachedRolePoliciesPages", &awsiam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String("cluster-role"),
+		}, mock.Anything).Run(func(args mock.Arguments) {
+			pageFn := args[1].(func(*awsiam.ListAttachedRolePoliciesOutput, bool) bool)
+			pageFn(output, true)
+		}).Return(nil)
+	}
+
+	It("does nothing when no service role is provided", func() {
+		Expect(ValidateServiceRole(p.IAM(), &api.ClusterIAM{})).To(Succeed())
+		p.MockIAM().AssertNotCalled(GinkgoT(), "GetRole", mock.Anything)
+	})
+
+	It("accepts a role with the required policies", func() {
+		mockRole()
+		mockAttachedPolicies("AmazonEKSClusterPolicy", "AmazonEKSVPCResourceController")
+
+		Expect(ValidateServiceRole(p.IAM(), iamConfig)).To(Succeed())
+		missing, err := missingPolicies(p.IAM(), "cluster-role", []string{"AmazonEKSClusterPolicy", "AmazonEKSVPCResourceController"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(missing).To(BeEmpty())
+	})
+
+	It("does not fail when the role is missing required policies", func() {
+		mockRole()
+		mockAttachedPolicies("AmazonEKSVPCResourceController")
+
+		Expect(ValidateServiceRole(p.IAM(), iamConfig)).To(Succeed())
+		p.MockIAM().AssertCalled(GinkgoT(), "ListAttachedRolePoliciesPages", mock.Anything, mock.Anything)
+	})
+
+	It("does not fail when the policies cannot be listed", func() {
+		mockRole()
+		p.MockIAM().On("ListAttachedRolePoliciesPages", mock.Anything, mock.Anything).Return(errors.New("AccessDenied"))
+
+		Expect(ValidateServiceRole(p.IAM(), iamConfig)).To(Succeed())
+	})
+
+	It("fails when the role cannot be assumed by EKS", func() {
+		mockRoleWithTrust("ec2.amazonaws.com")
+
+		Expect(ValidateServiceRole(p.IAM(), iamConfig)).To(MatchError(`the trust relationship of iam.serviceRoleARN "arn:aws:iam::123456789012:role/eks/cluster-role" does not allow eks.amazonaws.com to assume it`))
+	})
+
+	It("does not fail when the role cannot be read", func() {
+		p.MockIAM().On("GetRole", mock.Anything).Return(nil, awserr.New("AccessDenied", "not authorized to perform: iam:GetRole", nil))
+
+		Expect(ValidateServiceRole(p.IAM(), iamConfig)).To(Succeed())
+		p.MockIAM().AssertNotCalled(GinkgoT(), "ListAttachedRolePoliciesPages", mock.Anything, mock.Anything)
+	})
+
+	It("fails when the role does not exist", func() {
+		p.MockIAM().On("GetRole", mock.Anything).Return(nil, errors.New("NoSuchEntity"))
+
+		Expect(ValidateServiceRole(p.IAM(), iamConfig)).To(MatchError(`getting iam.serviceRoleARN "arn:aws:iam::123456789012:role/eks/cluster-role": NoSuchEntity`))
+	})
+})
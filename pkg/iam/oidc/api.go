@@ -61,6 +61,11 @@ func NewOpenIDConnectManager(iamapi iamiface.IAMAPI, accountID, issuer, partitio
 	return m, nil
 }
 
+// Tags returns the tags of the provider, they are also applied to the IAM roles that trust the provider
+func (m *OpenIDConnectManager) Tags() map[string]string {
+	return m.tags
+}
+
 // CheckProviderExists will return true when the provider exists, it may return errors
 // if it was unable to call IAM API
 func (m *OpenIDConnectManager) CheckProviderExists() (bool, error) {
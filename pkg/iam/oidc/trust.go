@@ -0,0 +1,80 @@
+package iamoidc
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	awsiam "github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/iam"
+)
+
+const assumeRoleWithWebIdentity = "sts:AssumeRoleWithWebIdentity"
+
+// ValidateRoleTrust checks that the trust relationship of the given IAM role allows it to be
+// assumed through this provider and, when serviceAccountName is set, by that service account
+func (m *OpenIDConnectManager) ValidateRoleTrust(roleARN, serviceAccountNamespace, serviceAccountName string) error {
+	parsed, err := arn.Parse(roleARN)
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return fmt.Errorf("unexpected format of IAM role ARN: %q", roleARN)
+	}
+	roleName := parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:]
+
+	output, err := m.iam.GetRole(&awsiam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		return errors.Wrapf(err, "getting IAM role %q", roleName)
+	}
+	policy, err := iam.ParseTrustPolicy(aws.StringValue(output.Role.AssumeRolePolicyDocument))
+	if err != nil {
+		return errors.Wrapf(err, "parsing trust relationship of IAM role %q", roleName)
+	}
+
+	subject := fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, serviceAccountName)
+	subjectKey := m.hostnameAndPath() + ":sub"
+	for _, statement := range policy.Statement {
+		if !statement.Allows(assumeRoleWithWebIdentity) || !(statement.Principal.Everyone || statement.Principal.Federated.Has(m.ProviderARN)) {
+			continue
+		}
+		if serviceAccountName == "" || allowsSubject(statement.Condition, subjectKey, subject) {
+			return nil
+		}
+	}
+
+	if serviceAccountName == "" {
+		return fmt.Errorf("the trust relationship of IAM role %q does not allow %s through the OIDC provider %q of the cluster",
+			roleName, assumeRoleWithWebIdentity, m.ProviderARN)
+	}
+	return fmt.Errorf("the trust relationship of IAM role %q does not allow %s through the OIDC provider %q of the cluster for service account %q",
+		roleName, assumeRoleWithWebIdentity, m.ProviderARN, serviceAccountNamespace+"/"+serviceAccountName)
+}
+
+// allowsSubject reports whether the conditions of a statement allow the given subject, a statement
+// without conditions on the subject allows any service account
+func allowsSubject(conditions map[string]map[string]iam.StringOrSlice, subjectKey, subject string) bool {
+	restricted := false
+	for operator, values := range conditions {
+		allowed, ok := values[subjectKey]
+		if !ok {
+			continue
+		}
+		switch operator {
+		case "StringEquals":
+			restricted = true
+			if allowed.Has(subject) {
+				return true
+			}
+		case "StringLike":
+			restricted = true
+			for _, pattern := range allowed {
+				if matched, _ := path.Match(pattern, subject); matched {
+					return true
+				}
+			}
+		}
+	}
+	return !restricted
+}
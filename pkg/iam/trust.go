@@ -0,0 +1,117 @@
+package iam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// StringOrSlice is a policy element that can be either a single value or a list of values, condition
+// values can also be booleans or numbers, which are kept in their string form
+type StringOrSlice []string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (s *StringOrSlice) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	values, ok := value.([]interface{})
+	if !ok {
+		values = []interface{}{value}
+	}
+	*s = nil
+	for _, v := range values {
+		switch v := v.(type) {
+		case string:
+			*s = append(*s, v)
+		case bool, float64:
+			*s = append(*s, fmt.Sprint(v))
+		}
+	}
+	return nil
+}
+
+// Has returns true if the element contains the value
+func (s StringOrSlice) Has(value string) bool {
+	for _, v := range s {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Principal is the principal of a policy statement, either "*" for everyone or the principals by type
+type Principal struct {
+	Everyone  bool
+	Federated StringOrSlice
+	Service   StringOrSlice
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Principal) UnmarshalJSON(data []byte) error {
+	var everyone string
+	if err := json.Unmarshal(data, &everyone); err == nil {
+		p.Everyone = everyone == "*"
+		return nil
+	}
+	var principals struct {
+		Federated StringOrSlice
+		Service   StringOrSlice
+	}
+	if err := json.Unmarshal(data, &principals); err != nil {
+		return err
+	}
+	p.Federated, p.Service = principals.Federated, principals.Service
+	return nil
+}
+
+// TrustStatement is a statement of the trust relationship of a role
+type TrustStatement struct {
+	Effect    string
+	Action    StringOrSlice
+	Principal Principal
+	Condition map[string]map[string]StringOrSlice
+}
+
+// Allows returns true if the statement allows the action
+func (s TrustStatement) Allows(action string) bool {
+	return s.Effect == "Allow" && (s.Action.Has(action) || s.Action.Has("*") || s.Action.Has("sts:*"))
+}
+
+// TrustPolicy is the trust relationship of a role
+type TrustPolicy struct {
+	Statement []TrustStatement
+}
+
+// ParseTrustPolicy parses the URL-encoded trust relationship of a role returned by the IAM API
+func ParseTrustPolicy(document string) (*TrustPolicy, error) {
+	decoded, err := url.QueryUnescape(document)
+	if err != nil {
+		return nil, err
+	}
+	var raw struct {
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(decoded), &raw); err != nil {
+		return nil, err
+	}
+	var policy TrustPolicy
+	if len(raw.Statement) == 0 {
+		return &policy, nil
+	}
+	// a policy with a single statement does not need to list it
+	if raw.Statement[0] == '{' {
+		var statement TrustStatement
+		if err := json.Unmarshal(raw.Statement, &statement); err != nil {
+			return nil, err
+		}
+		policy.Statement = []TrustStatement{statement}
+		return &policy, nil
+	}
+	if err := json.Unmarshal(raw.Statement, &policy.Statement); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
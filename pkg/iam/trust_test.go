@@ -0,0 +1,35 @@
+package iam
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trust policy", func() {
+	It("parses a policy with a single statement", func() {
+		policy, err := ParseTrustPolicy(url.QueryEscape(`{"Statement":{"Effect":"Allow","Principal":{"Service":["eks.amazonaws.com"]},"Action":"sts:AssumeRole"}}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement).To(HaveLen(1))
+		Expect(policy.Statement[0].Allows("sts:AssumeRole")).To(BeTrue())
+		Expect(policy.Statement[0].Principal.Service).To(Equal(StringOrSlice{"eks.amazonaws.com"}))
+	})
+
+	It("parses principals for everyone and conditions on non-string values", func() {
+		policy, err := ParseTrustPolicy(url.QueryEscape(`{"Statement":[{"Effect":"Allow","Principal":"*","Action":["sts:*"],` +
+			`"Condition":{"Bool":{"aws:SecureTransport":true},"NumericLessThan":{"aws:MultiFactorAuthAge":[3600]}}}]}`))
+		Expect(err).NotTo(HaveOccurred())
+		statement := policy.Statement[0]
+		Expect(statement.Principal.Everyone).To(BeTrue())
+		Expect(statement.Allows("sts:AssumeRole")).To(BeTrue())
+		Expect(statement.Condition["Bool"]["aws:SecureTransport"]).To(Equal(StringOrSlice{"true"}))
+		Expect(statement.Condition["NumericLessThan"]["aws:MultiFactorAuthAge"]).To(Equal(StringOrSlice{"3600"}))
+	})
+
+	It("does not allow actions of denied statements", func() {
+		policy, err := ParseTrustPolicy(url.QueryEscape(`{"Statement":[{"Effect":"Deny","Principal":"*","Action":"sts:AssumeRole"}]}`))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Statement[0].Allows("sts:AssumeRole")).To(BeFalse())
+	})
+})
@@ -60,6 +60,12 @@ type UpgradeOptions struct {
 	ForceUpgrade bool
 	// ReleaseVersion AMI version of the EKS optimized AMI to use
 	ReleaseVersion string
+	// RollbackOnFailure rolls the nodegroup back to its previous launch template version if the
+	// upgrade fails; nodegroups with a stack are rolled back by CloudFormation
+	RollbackOnFailure bool
+	// PreserveLabels are the keys of the labels of the nodes being replaced that are re-applied to
+	// the new nodes as they join, a key ending with `*` matches all the keys with that prefix
+	PreserveLabels []string
 }
 
 // TODO use goformation types
@@ -269,6 +275,9 @@ func (m *Service) UpgradeNodeGroup(options UpgradeOptions) error {
 
 	logger.Info("upgrading nodegroup version")
 	if err := updateStack(stack); err != nil {
+		if options.RollbackOnFailure {
+			logger.Warning("the upgrade of nodegroup %q failed, CloudFormation rolls its stack back to the previous version", options.NodegroupName)
+		}
 		return err
 	}
 	logger.Info("nodegroup successfully upgraded")
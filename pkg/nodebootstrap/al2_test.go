@@ -1,14 +1,20 @@
 package nodebootstrap_test
 
 import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	"github.com/pelletier/go-toml"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/bindata"
 )
 
 var _ = Describe("AmazonLinux2 User Data", func() {
@@ -98,6 +104,7 @@ API_SERVER_URL=
 B64_CLUSTER_CA=
 NODE_LABELS=
 NODE_TAINTS=
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
 CONTAINER_RUNTIME=`,
 		}),
 		Entry("maxPods set", bootScriptEntry{
@@ -112,6 +119,7 @@ API_SERVER_URL=
 B64_CLUSTER_CA=
 NODE_LABELS=
 NODE_TAINTS=
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
 MAX_PODS=123
 CONTAINER_RUNTIME=`,
 		}),
@@ -136,6 +144,7 @@ API_SERVER_URL=
 B64_CLUSTER_CA=
 NODE_LABELS=role=worker
 NODE_TAINTS=key1=value1:NoSchedule
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
 CONTAINER_RUNTIME=`,
 		}),
 		Entry("container runtime set", bootScriptEntry{
@@ -150,6 +159,7 @@ API_SERVER_URL=
 B64_CLUSTER_CA=
 NODE_LABELS=
 NODE_TAINTS=
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
 CONTAINER_RUNTIME=containerd`,
 		}),
 
@@ -170,7 +180,35 @@ API_SERVER_URL=
 B64_CLUSTER_CA=
 NODE_LABELS=
 NODE_TAINTS=
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
 CLUSTER_DNS=172.16.0.10
+CONTAINER_RUNTIME=`,
+		}),
+
+		Entry("IPv6 cluster", bootScriptEntry{
+			clusterConfig: func() *api.ClusterConfig {
+				clusterConfig := api.NewClusterConfig()
+				clusterConfig.Metadata.Name = "ipv6"
+				clusterConfig.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{
+					IPFamily: api.IPV6Family,
+				}
+				clusterConfig.Status = &api.ClusterStatus{
+					KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+						IPFamily:        api.IPV6Family,
+						ServiceIPv6CIDR: "fd30:1c53:5f8a::/108",
+					},
+				}
+				return clusterConfig
+			}(),
+			ng: api.NewNodeGroup(),
+			expectedUserData: `CLUSTER_NAME=ipv6
+API_SERVER_URL=
+B64_CLUSTER_CA=
+NODE_LABELS=
+NODE_TAINTS=
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
+CLUSTER_DNS=fd30:1c53:5f8a::a
+SERVICE_IPV6_CIDR=fd30:1c53:5f8a::/108
 CONTAINER_RUNTIME=`,
 		}),
 	)
@@ -197,6 +235,7 @@ API_SERVER_URL=
 B64_CLUSTER_CA=
 NODE_LABELS=
 NODE_TAINTS=
+CAPACITY_TYPE_LABEL=eks.amazonaws.com/capacityType
 CONTAINER_RUNTIME=`, "\n")))
 			Expect(cloudCfg.WriteFiles[1].Permissions).To(Equal("0644"))
 		})
@@ -235,6 +274,115 @@ CONTAINER_RUNTIME=`, "\n")))
 		})
 	})
 
+	When("KubeletCgroupDriver is set", func() {
+		BeforeEach(func() {
+			ng.KubeletExtraConfig = &api.InlineDocument{"foo": "bar"}
+			ng.KubeletCgroupDriver = aws.String(api.KubeletCgroupDriverSystemd)
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("adds the cgroup driver to the kubelet extra args file in the userdata", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[0].Path).To(Equal("/etc/eksctl/kubelet-extra.json"))
+			Expect(cloudCfg.WriteFiles[0].Content).To(Equal("{\"cgroupDriver\":\"systemd\",\"foo\":\"bar\"}"))
+			Expect(*ng.KubeletExtraConfig).To(Equal(api.InlineDocument{"foo": "bar"}))
+		})
+	})
+
+	When("KubeletFeatureGates is set", func() {
+		BeforeEach(func() {
+			ng.KubeletExtraConfig = &api.InlineDocument{"featureGates": map[string]interface{}{"RotateKubeletServerCertificate": true}}
+			ng.KubeletFeatureGates = map[string]bool{"InPlacePodVerticalScaling": true, "GracefulNodeShutdown": false}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("merges the feature gates into the kubelet extra args file in the userdata", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[0].Path).To(Equal("/etc/eksctl/kubelet-extra.json"))
+			Expect(cloudCfg.WriteFiles[0].Content).To(MatchJSON(`{"featureGates":{"GracefulNodeShutdown":false,"InPlacePodVerticalScaling":true,"RotateKubeletServerCertificate":true}}`))
+			Expect(*ng.KubeletExtraConfig).To(Equal(api.InlineDocument{"featureGates": map[string]interface{}{"RotateKubeletServerCertificate": true}}))
+		})
+	})
+
+	When("containerd config is set", func() {
+		BeforeEach(func() {
+			ng.ContainerRuntime = aws.String(api.ContainerRuntimeContainerD)
+			ng.Containerd = &api.ContainerdConfig{
+				RegistryMirrors: map[string][]string{
+					"docker.io": {"https://mirror.example.com", "https://registry-1.docker.io"},
+					"quay.io":   {"https://quay-mirror.example.com"},
+				},
+				ConfigFragment: `
+[plugins."io.containerd.grpc.v1.cri"]
+  sandbox_image = "registry.example.com/pause:3.5"
+
+[plugins."io.containerd.grpc.v1.cri".registry.mirrors."docker.io"]
+  endpoint = ["https://overridden.example.com"]
+`,
+			}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("adds the merged containerd config to the userdata", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[1].Path).To(Equal("/etc/eksctl/containerd-extra.toml"))
+			containerdConfig, err := toml.Load(cloudCfg.WriteFiles[1].Content)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containerdConfig.Get("version")).To(Equal(int64(2)))
+			cri := []string{"plugins", "io.containerd.grpc.v1.cri"}
+			Expect(containerdConfig.GetPath(append(cri, "sandbox_image"))).To(Equal("registry.example.com/pause:3.5"))
+			Expect(containerdConfig.GetPath(append(cri, "registry", "mirrors", "docker.io", "endpoint"))).To(Equal([]interface{}{"https://mirror.example.com", "https://registry-1.docker.io"}))
+			Expect(containerdConfig.GetPath(append(cri, "registry", "mirrors", "quay.io", "endpoint"))).To(Equal([]interface{}{"https://quay-mirror.example.com"}))
+		})
+
+		It("sets the config version if the fragment does not", func() {
+			ng.Containerd = &api.ContainerdConfig{
+				RegistryMirrors: map[string][]string{"docker.io": {"https://mirror.example.com"}},
+			}
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[1].Content).To(HavePrefix("version = 2\n"))
+		})
+
+		It("merges the containerd config into the config of the AMI on the node", func() {
+			ng.Containerd.ConfigFragment = `
+[plugins."io.containerd.grpc.v1.cri"]
+  sandbox_image = "registry.example.com/pause:3.5"
+  disabled_plugins = ["zfs"]
+
+[[plugins."io.containerd.example".hooks]]
+  name = "extra"
+`
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			containerdConfig, err := toml.Load(mergeTOML(amiContainerdConfig, cloudCfg.WriteFiles[1].Content))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containerdConfig.Get("root")).To(Equal("/var/lib/containerd"))
+			cri := []string{"plugins", "io.containerd.grpc.v1.cri"}
+			Expect(containerdConfig.GetPath(append(cri, "sandbox_image"))).To(Equal("registry.example.com/pause:3.5"))
+			Expect(containerdConfig.GetPath(append(cri, "disabled_plugins"))).To(Equal([]interface{}{"zfs"}))
+			Expect(containerdConfig.GetPath(append(cri, "registry", "config_path"))).To(Equal("/etc/containerd/certs.d"))
+			Expect(containerdConfig.GetPath(append(cri, "registry", "mirrors", "docker.io", "endpoint"))).To(Equal([]interface{}{"https://mirror.example.com", "https://registry-1.docker.io"}))
+			hooks := containerdConfig.GetPath([]string{"plugins", "io.containerd.example", "hooks"}).([]*toml.Tree)
+			Expect(hooks).To(HaveLen(2))
+			Expect(hooks[0].Get("name")).To(Equal("ami"))
+			Expect(hooks[1].Get("name")).To(Equal("extra"))
+		})
+	})
+
 	When("labels are set on the node config", func() {
 		BeforeEach(func() {
 			ng.Labels = map[string]string{"foo": "bar"}
@@ -274,6 +422,34 @@ CONTAINER_RUNTIME=`, "\n")))
 		})
 	})
 
+	When("startup taints are set on the node config", func() {
+		BeforeEach(func() {
+			ng.Taints = []api.NodeGroupTaint{
+				{
+					Key:    "foo",
+					Effect: "NoSchedule",
+				},
+			}
+			ng.StartupTaints = []api.NodeGroupTaint{
+				{
+					Key:    "nvidia.com/gpu-driver",
+					Value:  "installing",
+					Effect: "NoExecute",
+				},
+			}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("registers the nodes with the taints and the startup taints", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles[1].Path).To(Equal("/etc/eksctl/kubelet.env"))
+			Expect(cloudCfg.WriteFiles[1].Content).To(MatchRegexp(`(?m)^NODE_TAINTS=foo=:NoSchedule,nvidia.com/gpu-driver=installing:NoExecute$`))
+		})
+	})
+
 	When("clusterDNS is set on the node config", func() {
 		BeforeEach(func() {
 			ng.ClusterDNS = "1.2.3.4"
@@ -291,6 +467,35 @@ CONTAINER_RUNTIME=`, "\n")))
 		})
 	})
 
+	It("leaves the node name to the cloud provider when nodeNameSource is set", func() {
+		ng.NodeNameSource = api.NodeNameSourceResourceName
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+
+		cloudCfg := decode(userData)
+		Expect(cloudCfg.WriteFiles[1].Path).To(Equal("/etc/eksctl/kubelet.env"))
+		Expect(cloudCfg.WriteFiles[1].Content).NotTo(ContainSubstring("NODE_NAME_SOURCE"))
+		Expect(cloudCfg.WriteFiles[2].Content).NotTo(ContainSubstring("NODE_NAME_SOURCE"))
+	})
+
+	DescribeTable("nodes are labelled with their capacity type", func(capacityTypeLabel, expectedLabel string) {
+		ng.CapacityTypeLabel = capacityTypeLabel
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+
+		cloudCfg := decode(userData)
+		Expect(cloudCfg.WriteFiles[1].Path).To(Equal("/etc/eksctl/kubelet.env"))
+		Expect(cloudCfg.WriteFiles[1].Content).To(ContainSubstring("CAPACITY_TYPE_LABEL=" + expectedLabel))
+		Expect(cloudCfg.WriteFiles[2].Path).To(Equal("/var/lib/cloud/scripts/eksctl/bootstrap.helper.sh"))
+		Expect(cloudCfg.WriteFiles[2].Content).To(ContainSubstring(`case "${INSTANCE_LIFECYCLE}" in
+  spot) NODE_LABELS+=",${CAPACITY_TYPE_LABEL}=SPOT" ;;
+  *) NODE_LABELS+=",${CAPACITY_TYPE_LABEL}=ON_DEMAND" ;;
+  esac`))
+	},
+		Entry("default label", "", api.DefaultCapacityTypeLabel),
+		Entry("custom label", "example.com/capacity-type", "example.com/capacity-type"),
+	)
+
 	When("PreBootstrapCommands are set", func() {
 		BeforeEach(func() {
 			ng.PreBootstrapCommands = []string{"echo 'rubarb'"}
@@ -337,3 +542,40 @@ CONTAINER_RUNTIME=`, "\n")))
 		})
 	})
 })
+
+// amiContainerdConfig is a containerd config with the multi-line arrays and arrays of tables the config of an AMI can have
+const amiContainerdConfig = `version = 2
+root = "/var/lib/containerd"
+
+[plugins."io.containerd.grpc.v1.cri"]
+sandbox_image = "602401143452.dkr.ecr.us-west-2.amazonaws.com/eks/pause:3.5"
+disabled_plugins = [
+  "aufs",
+  "btrfs",
+]
+
+[[plugins."io.containerd.example".hooks]]
+name = "ami"
+
+[plugins."io.containerd.grpc.v1.cri".registry]
+config_path = "/etc/containerd/certs.d"
+`
+
+// mergeTOML runs the merge_toml function of the AmazonLinux2 bootstrap script on the given configs
+func mergeTOML(config, extraConfig string) string {
+	script, err := bindata.Asset("bindata/assets/bootstrap.al2.sh")
+	Expect(err).NotTo(HaveOccurred())
+	mergeFunction := regexp.MustCompile(`(?ms)^function merge_toml\(\) \{$.*?^\}$`).Find(script)
+	Expect(mergeFunction).NotTo(BeEmpty())
+
+	dir, err := os.MkdirTemp("", "merge-toml")
+	Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(dir)
+	configPath, extraConfigPath := filepath.Join(dir, "config.toml"), filepath.Join(dir, "extra.toml")
+	Expect(os.WriteFile(configPath, []byte(config), 0644)).To(Succeed())
+	Expect(os.WriteFile(extraConfigPath, []byte(extraConfig), 0644)).To(Succeed())
+
+	out, err := exec.Command("bash", "-c", string(mergeFunction)+`; merge_toml "$1" "$2"`, "merge_toml", configPath, extraConfigPath).Output()
+	Expect(err).NotTo(HaveOccurred())
+	return string(out)
+}
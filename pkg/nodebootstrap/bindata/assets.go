@@ -1,8 +1,8 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
 // bindata/assets/10-eksctl.al2.conf (1.025kB)
-// bindata/assets/bootstrap.al2.sh (755B)
-// bindata/assets/bootstrap.helper.sh (1.403kB)
+// bindata/assets/bootstrap.al2.sh (3.581kB)
+// bindata/assets/bootstrap.helper.sh (1.755kB)
 // bindata/assets/bootstrap.legacy.al2.sh (1.286kB)
 // bindata/assets/bootstrap.legacy.ubuntu.sh (2.275kB)
 // bindata/assets/bootstrap.ubuntu.sh (597B)
@@ -78,7 +78,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _bindataAssets10EksctlAl2Conf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xdf\x6b\xdb\x30\x10\x7e\xf7\x5f\x71\x90\x3e\x6c\x10\xd9\xac\x7d\x2b\xf8\xc1\x8b\xdd\x12\x96\x3a\x25\x76\xb7\xc1\x36\x8c\x2c\x5d\xd2\x23\xb2\x64\x24\x39\x69\x57\xf2\xbf\x0f\xc7\xf1\x96\xb2\x32\xf6\x26\xdd\x77\xf7\x7d\xdf\xfd\x98\x00\x6e\x9d\xf0\x8a\xb9\x16\x05\xad\x49\x80\x7b\x76\x1e\x1b\x09\xd2\x9a\x96\x91\x86\x4e\x93\x87\xb5\xb1\xb0\xed\x6a\x54\xe8\xa7\xc7\x4f\xd2\xf0\x9f\x46\xc3\x82\x74\xf7\x04\x97\xf0\x2e\x59\x5c\xbe\x0f\x26\x50\x2e\xd3\x25\xa4\xd8\x5a\x14\xdc\xa3\x9c\xc2\x9e\x94\x82\x1a\xc1\x62\x63\x76\x28\xc1\x19\xa3\x83\xe0\x5b\x81\x76\x47\x02\x7f\x04\x13\x58\x18\xc1\x15\x34\xe8\xb9\xe4\x9e\x43\xcb\x2d\x6f\xd0\xa3\x75\xd7\xb0\xca\x6e\xe7\xcb\x7c\x0a\xc9\x97\xa2\x4a\xb3\x9b\xe4\x61\x51\x56\x43\x2c\xc8\xf4\x8e\xac\xd1\x0d\x6a\x7f\x43\x0a\xe3\x08\xbd\x88\x86\x56\xa2\x91\x2b\x44\xbd\x0b\x26\x70\xab\x4c\xcd\x15\x70\x2d\xc1\x79\xee\x49\xbc\xd2\x98\x2d\x1e\x8a\x32\x5b\x55\x69\x5e\x4c\x21\x5f\xa6\x59\xb5\x48\x3e\x66\x8b\xf1\x53\x26\xf3\xbc\x2c\xfe\x29\x77\x9a\xcb\x49\x6d\x68\x47\x1b\xcd\xde\x10\x3b\x52\xce\xef\xa7\x30\xcf\x8b\x32\xc9\x67\x59\x35\x4f\xff\x8b\x5b\xf5\xac\x47\x85\x20\x7b\x42\x51\x78\x6e\x7d\x7c\xf6\x8c\x3a\x67\xa3\x9a\xf4\x58\x00\xdf\x03\x00\xc6\xb4\x91\xc8\xa8\x8d\x2f\x5e\x4e\xca\x87\x73\x40\xf1\x1a\x95\x1b\xc1\xa1\xed\xc3\x94\xab\xf6\x91\x87\x83\x7e\x48\x26\x22\xed\x3c\xd7\x02\x19\xc9\xf8\xe2\xe5\xcc\xf8\xc8\xd5\xf0\x27\xd6\x1a\xd9\x13\xdd\x25\x5f\xab\xfb\x65\x5a\x8c\x90\xc5\x0d\x39\x8f\xf6\xa8\x17\x7b\xdb\xe1\x79\x70\x4f\xfe\x91\x79\x4e\xda\xff\x36\x31\x8c\x7b\x2c\x17\xca\x74\x92\xb5\xd6\xec\x48\xa2\x8d\xf9\xde\x8d\x80\xd1\x7d\x1d\x5a\x66\x3b\xed\xa9\xc1\x58\x1a\xb1\x45\x3b\x76\x87\x7e\x6f\xec\x96\xb5\xaa\xdb\x90\x8e\x85\xa6\xb1\x4e\x13\xab\x49\x33\x49\x36\x8e\x4c\xeb\x23\xa1\xa9\x1f\xdb\x19\x2c\x8c\x5e\x0f\x78\xbf\x86\x1e\xd7\xe8\x43\x79\xca\x68\x8d\x64\xa4\xd7\x96\x9f\x59\xa0\x86\x6f\x30\xbe\x78\xe9\xaf\x34\xfb\x54\x54\xd9\x6c\x55\x25\xb3\xd9\xf2\x21\x2f\x0f\xa1\xdc\xda\x10\x85\x0d\x07\xf8\xf5\x11\x1f\x4e\xd1\x22\x5b\x7d\x9e\xcf\xb2\xa2\x4a\x97\x77\xc9\x3c\x3f\xf4\xcb\x8f\x5a\xde\x39\xbc\xbe\x0a\xaf\x18\x6e\x5d\xdd\x91\x92\xe1\x87\x93\x89\x7e\xc7\xbd\x4d\xda\xfc\x75\x2b\x43\x38\x7c\xe6\x8d\xfa\x33\xaa\xb7\x12\xfb\xa3\xea\xb3\x82\x5f\x01\x00\x00\xff\xff\x04\xfc\xe9\x45\x01\x04\x00\x00")
+var _bindataAssets10EksctlAl2Conf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\x10\xb0\x0f\xbb\x80\x29\x61\x93\x5b\x00\x1d\xb4\x96\x12\x18\xeb\xc8\x81\xe5\x6c\x0b\xb4\x85\x40\x91\x63\x67\x60\x6a\x28\x90\x94\x9d\xd4\xf0\x7f\x2f\x64\x49\x85\x8b\xb4\x45\x6f\xe4\xbc\x99\xf7\xde\x7c\x4c\x18\xec\x9d\xf4\x9a\xbb\x06\x24\x6e\x51\x32\xf7\xe6\x3c\xd4\x8a\x29\x6b\x1a\x8e\xc4\x5a\x42\xcf\xb6\xc6\xb2\x7d\x5b\x81\x06\x3f\xbb\x7c\x92\x5a\x7c\x35\xc4\x96\x48\xed\x2b\xbb\x61\x7f\x25\xcb\x9b\xbf\x83\x09\xdb\xac\xd2\x15\x4b\xa1\xb1\x20\x85\x07\x35\x63\x47\xd4\x9a\x55\xc0\x2c\xd4\xe6\x00\x8a\x39\x63\x28\x08\x3e\x15\x60\x0f\x28\xe1\x4b\x30\x61\x4b\x23\x85\x66\x35\x78\xa1\x84\x17\xac\x11\x56\xd4\xe0\xc1\xba\x3b\xb6\xce\x1e\x16\xab\x7c\xc6\x92\x0f\x45\x99\x66\xf7\xc9\xf3\x72\x53\xf6\xb1\x20\xa3\x03\x5a\x43\x35\x90\xbf\x47\x0d\x71\x04\x5e\x46\x7d\x2b\xd1\xc8\x15\x02\x1d\x82\x09\x7b\xd0\xa6\x12\x9a\x09\x52\xcc\x79\xe1\x51\xfe\xa0\x31\x5f\x3e\x17\x9b\x6c\x5d\xa6\x79\x31\x63\xf9\x2a\xcd\xca\x65\xf2\x6f\xb6\x1c\x3f\x9b\x64\x91\x6f\x8a\xdf\xca\x0d\x73\x19\xd4\xfa\x76\xc8\x10\xff\x89\xd8\x85\x7f\xf1\x34\x63\x8b\xbc\xd8\x24\xf9\x3c\x2b\x17\xe9\x1f\x71\xeb\x8e\xf5\xa2\x10\x64\xaf\x20\x0b\x2f\xac\x8f\xaf\x9e\x51\xeb\x6c\x54\x21\x8d\x05\xec\x73\xc0\x18\xe7\x64\x14\x70\x6c\xe2\xe9\x69\x50\x3e\x5f\x03\x5a\x54\xa0\xdd\x08\xf6\x6d\x9f\x67\x42\x37\x2f\x22\xec\xf5\x43\x34\x11\x92\xf3\x82\x24\x70\x54\xf1\xf4\x74\x65\x7c\xe4\xaa\xc5\x2b\x6f\x8c\xea\x88\x1e\x93\x8f\xe5\xd3\x2a\x2d\x46\xc8\xc2\x0e\x9d\x07\x7b\xd1\x8b\xbd\x6d\xe1\x3a\x78\x44\xff\xc2\xbd\x40\xf2\xdf\x4d\xf4\xe3\x1e\xcb\xa5\x36\xad\xe2\x8d\x35\x07\x54\x60\x63\x71\x74\x23\x60\xa8\xab\x03\xcb\x6d\x4b\x1e\x6b\x88\x95\x91\x7b\xb0\x03\x4c\xe0\x8f\xc6\xee\x79\xa3\xdb\x1d\x52\x2c\x09\xc7\x3a\x42\x5e\x21\x71\x85\x36\x8e\x4c\xe3\x23\x49\xd8\x8d\xed\x0a\x96\x86\xb6\x3d\xde\xad\xa1\xc3\x09\x7c\xa8\x86\x8c\xc6\x28\x8e\xb4\xb5\xe2\xca\x02\xd6\x62\x07\xf1\xf4\xd4\x5d\x69\xf6\x5f\x51\x66\xf3\x75\x99\xcc\xe7\xab\xe7\x7c\x73\x0e\xd5\xde\x86\x20\x6d\x38\x3d\xbd\x3f\xe2\xf3\x10\x2d\xb2\xf5\xff\x8b\x79\x56\x94\xe9\xea\x31\x59\xe4\xe7\xee\x8e\xa3\x46\xb4\x0e\xee\x6e\xc3\x5b\x0e\x7b\x57\xb5\xa8\x55\xf8\xcf\x60\xa2\xdb\x71\x67\x13\x77\xef\x6e\xa5\x0f\x87\x6f\xa2\xd6\x43\xf2\x2f\x12\x35\xf8\xf0\x4d\xd4\x3a\xf8\x36\x00\x04\xfc\xe9\x45\x01\x04\x00\x00")
 
 func bindataAssets10EksctlAl2ConfBytes() ([]byte, error) {
 	return bindataRead(
@@ -98,7 +98,7 @@ func bindataAssets10EksctlAl2Conf() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsBootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xd1\x6a\xdb\x4c\x10\x85\xef\xf7\x29\xe6\xd7\x1f\x30\x14\x56\x6a\x21\xe4\xa2\x17\x05\xc5\x55\x83\xa9\xa3\x04\x59\x2e\x81\x34\x88\xd5\x6a\x6c\x6f\x2c\xed\xaa\x3b\x23\x93\x12\xfc\xee\x65\xdb\x5a\x71\x9d\x5c\xee\xcc\x77\xce\xcc\x1c\xf6\xff\xff\x92\xda\xd8\xa4\x56\xb4\x11\x82\x90\x41\x3a\x40\xef\xf1\xc9\xf0\xe1\xd9\x9b\x1e\x57\xca\xb4\x87\xb7\x75\x83\x25\x64\x21\xc8\x0d\x5e\x23\x24\x3b\xe5\x93\xd6\xd4\x89\x6e\xdd\xd0\x24\xa4\xbd\xe9\x99\x12\xdc\x92\xe6\x36\xa9\x9d\x63\x62\xaf\xfa\x78\x83\x6d\x8f\x3e\x0e\x83\x50\x6f\x1c\x44\x7f\x88\x8f\xe0\x07\x6b\x8d\x5d\x43\x82\xac\x83\xec\x45\x13\x89\xd7\xb5\x98\x36\x10\x9d\x3d\x4f\xe7\xcb\x45\x99\x15\x55\x9e\x5e\x67\xfb\x08\xbe\x0b\x00\x29\x55\x6f\x08\xfd\x0e\xbd\x44\xdb\xf4\xce\x58\x0e\x68\x7a\x3b\xab\x16\x59\xf1\x2d\x2b\xaa\x65\x31\x1f\xe1\xfa\xe2\x5c\xea\x76\x20\x46\x2f\xb5\x0a\xe0\xe5\xc5\x79\x75\xf0\x9d\xa6\x23\xd8\x58\x1a\x41\xd3\x1f\x0f\xff\x9c\x2f\x46\x6a\x3b\xd4\xd8\x22\x4b\x7c\x62\xaf\xa4\xf2\x6b\x0a\xe4\xd7\xe5\x65\x36\xcf\xca\x2a\xbb\x2b\x8b\xb4\x4a\x8b\xab\x17\x81\x76\x96\x95\xb1\xe8\xa5\x1f\x2c\x9b\x0e\x7f\x3b\xdf\xe4\x65\x3a\xcb\xb3\xa2\x2a\x96\x79\x39\x0b\xb7\x9d\xc6\xd5\xa1\x5f\x87\xb8\x06\x42\x0f\xae\x67\xe3\x2c\x81\xb1\xec\xe0\xb0\x82\x76\x76\x65\xd6\xf1\x23\x39\x1b\x89\x90\x19\x4c\x7c\x07\x72\x05\x67\xcf\xe5\xf5\x6d\x15\x76\xaa\xa6\x37\xf9\x97\xfd\x04\xb2\xbb\x59\x29\x1e\x7f\x80\x24\x98\xc4\xf7\xef\x1f\xe0\x1d\xc4\xf7\x1f\x1e\x26\xc7\xbb\x07\x74\x76\xb5\x8f\x5e\xdf\x33\x76\x3e\x85\xde\xbf\xde\x91\xe8\x76\x6f\x54\xdf\x32\x16\x82\x7e\x12\x63\xa7\xb9\x85\x46\x61\xe7\xac\xf4\xd8\x3a\xd5\x9c\x7e\x14\x24\x56\x9e\xc3\xf1\x63\xda\x5b\x8a\x8e\xd4\x7f\x89\x43\xfb\x44\xdf\x38\x8b\x91\xf8\x15\x00\x00\xff\xff\xb9\x79\x57\x7c\xf3\x02\x00\x00")
+var _bindataAssetsBootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x6d\x73\xe2\x38\x12\xfe\xae\x5f\xd1\x2b\xbc\x0b\x0e\x18\x87\xd4\xd6\x7c\x18\x8e\xbd\xcb\x64\x98\x2d\xea\x12\x26\x45\xc8\xd6\x5e\x19\x86\x12\x76\x13\xb4\x18\xd9\x27\x09\x66\x52\x39\xee\xb7\x6f\x49\x7e\xc1\x10\x48\xe5\xc3\x54\x59\xea\xa7\x9f\x7e\x79\xba\x83\xa6\xf6\x93\x3f\xe7\xc2\x9f\x33\xb5\x24\x44\xa1\x06\x2f\x01\x94\x12\x7f\x70\x5d\x1c\x53\x9e\xe2\x82\xf1\xb8\x38\x8b\x64\x23\x14\x6a\x42\x54\xb2\x91\x21\x82\xbf\x65\xd2\x8f\xf9\xdc\x0f\xe3\x64\x13\xf9\x2a\x94\x3c\xd5\xca\xc7\x95\x0a\x75\xec\xcf\x93\x44\x2b\x2d\x59\xda\x5e\x62\x9c\xa2\x6c\x9b\x40\x35\x58\xa3\x7c\xc2\x99\x4e\xd6\x31\xa4\x92\x0b\xad\x40\x2f\x11\xc6\x5f\xef\x6e\x61\xc1\x63\x04\xa7\x03\xdf\xb9\x5e\xda\xdb\x15\x3e\x2b\x48\x16\xc7\x88\xab\x8c\x24\x02\x2e\x3e\x1e\xe0\x18\x68\x36\x8f\xd1\xb8\x38\x57\xa4\x06\x12\xd3\x98\x85\x68\x31\x8a\xad\xab\x84\x4c\xe7\x58\x2e\xc0\xe9\xb4\x80\x89\xc8\xc2\xec\xa5\x25\x73\xae\x32\x98\xd3\x81\x28\x41\x05\x22\xd1\xb0\x64\x5b\x04\x26\x11\x58\x9a\xa2\x88\x30\x6a\x93\x1a\xdc\x6d\x62\xcd\xbd\x98\x0b\x63\x92\xec\x59\x59\xc4\x0a\x53\x0d\xdf\x97\x49\x8c\x25\x37\xc6\xb8\x46\x53\xb1\x49\x35\x43\x9a\xe2\xb2\x88\xc6\x47\xe0\x16\x65\x5e\x9c\xa9\x2c\x51\x59\x2d\x9d\x92\x91\xd4\x80\xd9\x8e\x3d\xdb\xab\x8c\xb9\x80\x5d\xbd\x4a\x2d\x4c\x84\x66\x5c\xa0\x8c\x8a\x5e\xa8\x3c\xa7\x34\xde\x3c\x71\x51\x04\x2f\x3b\x9e\x08\xb4\xe9\xf1\x75\x9a\x48\x8d\x91\xd5\x44\xb5\x40\x25\xd6\x1c\x26\x62\xc1\x9f\x60\xbd\x51\x1a\xe6\xb8\x97\x41\x69\x64\x11\x59\x6c\x44\xa8\x79\x22\x2a\x1a\x37\x5c\x78\x21\x00\xec\xfb\x0a\xea\x04\x00\xa0\xc4\x68\xc9\xd7\x0d\xe5\xc2\x0b\x3c\xa9\xcd\xbc\xe1\x7f\x0b\x82\x8f\x2a\x65\x21\x7e\x9c\x4e\x9b\xff\xab\x1e\x1c\xbf\x05\x94\xb6\x40\xb9\x5d\x90\xa8\x37\x52\x80\x82\xdd\x21\xdb\x0a\x9f\x1b\x46\x01\xc3\xa7\xd2\x98\x6b\x7b\x6a\x41\xca\xa4\x56\x2d\xa0\x3d\xba\x77\xb6\x91\xad\x21\xe8\x4c\xdd\x63\xa6\xb9\x64\xe1\x0a\xb5\x2a\xe9\xb2\xf4\x68\xf0\x8d\x4e\x2f\x68\x9e\x8a\xb5\x75\xc1\x5a\x6a\xed\x8b\xc3\xdb\x3c\x4c\xe6\x37\x09\xaa\x46\xf0\xf2\x6a\x27\xd3\x83\xeb\xa3\x1c\x6c\xff\x1a\x56\x1b\x53\x10\x5f\x40\xa3\x1c\x56\x33\xc2\xe6\xd2\x2e\xcf\x02\xe8\xcf\x8a\xb6\xec\x5c\x07\x16\x32\xed\xe6\xaa\xe4\x47\xe8\x41\x07\x76\xc7\x45\xb2\x28\x3a\xa4\xff\x69\x1f\x80\x45\x11\x46\xae\x31\xd8\xaf\x0a\x4f\x17\x12\x19\xa1\x0c\x9a\x4d\x7b\xa7\xcc\xa5\xfd\x2a\x03\x7c\x19\x8e\xa0\xd7\x83\xe1\xc8\xaa\x6e\x6a\x32\xe4\x49\x8a\x02\x7e\x83\x4b\xc3\x59\xc9\x14\x7a\x07\x27\xe7\x12\xe8\x44\xd0\x2e\x58\x78\xb3\xb7\x57\xc2\xb9\x74\xbb\x20\xf0\x87\xce\xa3\x64\xac\xce\x25\xfc\x1f\x0e\xc6\xe6\x62\x12\x4c\x02\xdf\x44\xb1\x94\x26\x3b\x23\xb5\x73\xe9\xc2\xc3\xe3\xa7\x87\xfe\x3d\x34\x9a\xcd\x62\x09\xdd\x2e\x2c\x91\x45\x28\x2b\xe9\x14\xf0\x6e\xa5\x41\xef\x8d\x7c\x32\xee\xa9\x18\x06\xf2\x3e\xd2\x46\xad\x7d\xe1\xfe\xd3\xf1\x5d\x8b\xce\xb1\xfb\xcc\xf2\x8b\xf3\x1d\x2d\xb2\xb0\x7d\xcd\xd1\xc9\x16\xa5\xe4\x51\x84\x22\x43\xd9\xd9\x31\x20\xe3\xdc\x29\x40\x46\x81\x43\x01\x72\x4b\x99\xc8\x81\xde\x1d\x78\xa9\xce\x2c\xf4\x80\xd2\x62\xa8\xd5\x8a\xa7\x46\x7c\xb3\x98\xe6\xf3\x2d\x61\x57\x88\x05\xd6\x7e\xbe\xc2\xda\xa1\x3f\x70\x39\x96\x61\x12\xf8\x15\x1d\x32\xd9\xdf\xe3\xe7\x9f\x54\x2f\xf7\xab\xee\xe3\x1b\x2c\xc1\xb7\xda\xfe\xe4\xc3\x2f\xbf\x40\xa3\x71\xd8\x64\xd7\x2c\xd8\x5e\x02\xb7\x68\xca\x1b\x3d\xc9\x5b\x71\xb2\x13\x39\xa4\x3f\xfc\x5c\xee\xdb\x22\x91\xd0\xe0\x46\xcb\x2e\x70\xf8\x47\x3e\x6f\xaa\x0b\xbc\xd9\x74\x4b\x54\xbe\x97\x76\x9b\xf9\xd4\x24\x65\x4b\x8c\x5c\xfb\x8b\xc1\xc5\x06\x4f\x03\x7b\x99\xb2\xaf\xff\xf6\x14\x88\x69\xe9\x87\xb1\xc2\x12\x38\x11\x3f\x2b\xf3\x8f\xb6\xca\x85\x28\x3d\xce\x30\xec\xca\x31\xab\x03\x75\xae\x28\x50\xa7\x43\xc9\x8e\x90\xc1\xfd\xec\xcb\xf5\xdd\xe0\xf6\x3f\xb3\xeb\xd1\xef\x0f\xbd\x86\x4b\x82\x00\x3c\x01\xd4\x79\x79\xe8\x8f\xfe\x18\xdc\xf4\x67\x83\xfb\x3f\x3e\xcc\x6e\x06\x9f\x47\x3b\x0a\xd3\xa9\x11\xe2\xd8\xc9\xf3\x78\xea\x2d\xd8\x9a\xc7\xcf\xc0\xd3\xed\x07\xf0\x3c\x85\x72\xcb\x43\xf4\xcc\xd1\x0b\x79\x24\xcf\x30\xba\x84\x60\xb8\x4c\x80\x66\xef\x9c\x8f\x20\x37\x42\x70\xf1\x04\x3e\xea\xd0\x3c\x7e\xf6\x2f\x1f\x4a\x5e\xdf\xb5\xd5\xd2\x10\xdf\xdc\x3e\x3e\x8c\xfb\xa3\xd9\xf0\xfa\xae\xbf\xa3\x30\x21\x00\x9e\xc7\x52\x6e\xb2\x40\xe9\xa1\x88\xd2\xc4\x28\x4c\x9d\x97\xeb\xfb\xc1\xcc\xe4\xd1\x1f\xcd\x1e\x47\xb7\x25\x78\xfe\xe1\x57\x2f\x8c\x37\x4a\xa3\xf4\x42\x66\x38\x3f\x7d\xf8\x75\x56\xf0\xde\x5c\x97\xc0\x48\xa8\x12\xc8\xd3\x6a\xf0\xcf\xc3\x87\x12\xb5\xda\xcc\x31\x46\xed\xe1\x0f\x2d\x99\xc7\xe4\x93\x32\xc8\x7f\x3f\x7e\xea\xdf\xf6\xc7\xb3\xfe\x9f\xe3\xd1\xb5\x6d\x78\xe9\x50\xbe\x2d\x3c\xb9\x11\x9a\xaf\xd1\xe0\x6f\xbe\x0e\xc7\xd7\x83\x61\x7f\x34\x1b\x3d\x0e\xc7\x83\xb2\x36\xe7\xe5\x50\x81\xe0\x5f\xd3\x26\x3d\x71\xb9\xa3\xbb\xe3\xf6\x9a\xc9\x34\xed\xdd\x28\x94\x90\xa4\xe6\x07\x52\x01\x17\x3a\x81\x22\xe5\xec\x55\xd2\xfe\x4b\x25\x82\x12\xd3\x63\xa8\xcb\x35\x78\x0b\x70\x5e\xc6\x77\xf7\x33\x53\xc3\xec\xe6\xeb\xf0\xcb\xae\x0e\xfd\x3f\x07\x63\xf2\xd7\x7f\xc1\x53\x50\x6f\x07\x97\x53\xb8\x80\x76\xd0\x99\xd6\xab\xb5\x1a\xe8\xe0\xf7\x1d\x7d\x5d\x7f\x69\xf9\x0d\xe8\x31\x37\x25\xeb\xed\x89\xdb\x53\xc4\x84\x94\x74\x59\xb3\x3e\xe7\xa6\x5e\xbd\x98\x17\xf3\x80\xde\x3f\xde\x32\x51\xda\xe6\xd1\x5c\x27\xe7\xbc\xf6\x70\x3f\x6f\x48\x86\x37\x09\x1d\xf9\xf4\xea\xbe\x5e\xa7\xd5\x00\x07\x1e\x7c\x01\x41\x70\x4e\x4d\xf3\x27\x60\xef\x48\xcd\x72\x79\x0b\x03\x3e\x53\x93\x5d\xc1\xae\x79\x3d\x0a\x02\xf0\x5e\x69\xf7\x11\xa0\x92\x1a\x25\x50\xfd\xff\x43\x35\xc3\x4a\xb8\x37\x73\x29\x95\x3b\x32\xee\x2c\xf7\xf6\xac\xf5\x4c\xb0\x57\x25\x49\x54\x9a\x49\x6d\xaa\xaa\x74\x89\x00\xa8\x67\xa5\x71\x1d\xea\xb8\x80\x54\xec\x64\xc1\x09\xd9\x03\x22\x86\xeb\x44\x78\x12\xe3\x84\x45\xe4\x2c\x7f\xb9\xb1\x2b\x45\xc9\x6b\xfa\xdc\x7c\xe4\x1f\x25\x02\x29\xf9\x7b\x00\x3c\xb9\x28\xe8\xfd\x0d\x00\x00")
 
 func bindataAssetsBootstrapAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -114,11 +114,11 @@ func bindataAssetsBootstrapAl2Sh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bindata/assets/bootstrap.al2.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3d, 0x1a, 0x18, 0x7b, 0x3b, 0xc, 0x5a, 0xfe, 0xcd, 0xaf, 0x4a, 0x56, 0xd7, 0xbb, 0x38, 0x7e, 0x27, 0xdf, 0x36, 0xa8, 0x4d, 0x27, 0x12, 0xce, 0x6d, 0x48, 0x6f, 0x84, 0xab, 0x31, 0x57, 0xc9}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf6, 0x37, 0xf4, 0x4b, 0xd6, 0xbe, 0x2d, 0xc, 0x44, 0x70, 0x6e, 0x48, 0xbf, 0xc4, 0xfd, 0xeb, 0x17, 0x3c, 0x4b, 0xda, 0xfd, 0x35, 0x99, 0x6d, 0xd9, 0xec, 0x31, 0x9a, 0x8f, 0xb9, 0x42, 0xbc}}
 	return a, nil
 }
 
-var _bindataAssetsBootstrapHelperSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x54\x7f\x6f\xda\x48\x10\xfd\x7f\x3f\xc5\xdc\x82\x9a\xa0\xcb\xe2\x36\xea\x45\x3a\x24\xa4\x23\xe0\xf6\xac\x12\x83\xc0\x9c\x72\x8a\x22\x6b\xb1\x87\xb0\xc5\xec\x5a\xbb\xe3\x92\x2a\xf2\x77\x3f\xd9\xc4\x89\xc9\xa9\xf9\x6b\x3d\x3f\xde\x9b\x37\xe3\xd1\x74\x7e\xf3\xd6\x4a\x7b\x6b\xe9\xb6\x8c\x39\x24\x10\x06\xd0\x5a\x7c\x54\xd4\x98\xb9\xca\x71\x23\x55\xd6\xd8\xda\x14\xda\x21\x31\xe6\x4c\x61\x13\x04\x0f\x29\xf1\x70\xe7\x12\xca\xbc\x5d\xb1\xc6\x0c\xa9\x8f\xfa\x07\x74\x60\xa3\x32\x84\x83\x55\x44\xa8\x61\xfd\x13\xd6\xc6\x90\x23\x2b\xf3\x1c\x2d\x63\x1d\x58\x39\x84\xe0\x66\xb2\xfc\x71\x09\x64\xe0\x01\x09\xf6\x48\x32\x95\x24\x59\x34\xfb\xe6\x87\x43\xde\x3d\x4f\x0a\x9b\x81\x10\x4e\x65\xa8\x09\xc4\x2d\xcc\x57\x11\x88\xbf\x81\xdf\x0a\x79\x70\x02\x93\x4b\xd1\x80\x04\x99\x1d\x6a\x41\x94\x09\x87\x89\xd1\xa9\x1b\xc0\xd5\xc7\x8f\x1c\xb6\x44\xf9\xc0\xf3\x3e\x5d\xfd\xd9\xbf\xfc\xe3\x73\xff\xf9\xf5\x32\x49\xe8\xc8\x93\xb9\xf2\x6a\x64\x8f\xb3\x4d\xa1\x13\x52\x46\x57\x62\xe2\x86\xf7\xbc\x07\x4f\x0c\xe0\x8d\x92\x77\x24\x0c\xa0\x5b\xeb\xe7\xc0\xdf\x2f\x5d\xc1\x44\x85\xf3\xba\x9f\x38\x2b\x19\x1b\xcd\x83\x78\xe9\x2f\xfe\xf1\x17\xf1\x6a\x31\x1d\xf2\xee\xd3\xa9\xa7\xe4\xec\xfa\xea\x73\x3c\x9e\xae\x96\x91\xbf\x88\xc7\xa3\x2a\xe5\xd4\x53\x72\x16\x84\xcb\x68\x14\x8e\xfd\x38\x98\x54\x23\x6c\xf7\x02\x4a\x3b\x92\x3a\x41\xa1\xd2\x5e\x2b\x73\x1a\x7c\xf1\xc7\xff\x8e\xa7\xfe\xaf\x01\x99\xda\xa0\x48\x7e\x26\x19\xf6\x38\x6b\xea\x4d\xc2\x65\x25\xa1\x65\x0e\x44\xc9\x59\x38\x9b\xf8\x71\x34\x0a\xc2\xa8\x0e\xb7\xcc\x3a\x7c\x33\xba\x8d\xe7\xb3\x49\x1d\x6b\xbe\x5f\x71\xd3\xd1\xb5\x3f\x7d\xc5\x1d\xcd\xf2\x42\x9b\xf4\x28\xa2\xd6\x30\xec\x3e\xfd\x5f\x7c\x79\x21\xb3\x7c\x2b\xfb\xc7\x6d\xec\x2b\xe3\xb5\xda\x6d\x23\x82\x49\xc9\x19\xfb\xb6\xba\xf6\xa7\x7e\x14\x8f\x16\x5f\x97\xc3\x73\x2e\xc4\xb1\x84\x5c\x63\xe6\x86\xa7\xd5\x79\x8f\xdd\xdd\x81\xd0\x70\xda\x4d\xc9\xe1\xfe\x1e\x3e\x7c\x80\x36\xd5\xef\x35\x97\xc5\x07\xe5\x08\xad\x38\x28\xda\x0a\x92\x4a\xd3\x0b\x69\x03\xee\xb1\x0e\x08\xb1\x97\x8f\x22\x37\xa9\x03\xe9\x40\xc2\x78\x1a\x80\xb4\x0f\xc5\xbe\xda\x32\xe5\x20\xc5\xdc\x62\x22\x09\xd3\x0b\xa0\xad\x72\x95\x4f\xc2\xc1\xd8\x9d\xb4\xa6\xd0\x29\x14\x9a\x54\x06\x07\x7c\xcd\x04\x57\xe4\xb9\xb1\x04\x1b\x63\x61\x2f\x1f\xe7\x26\x75\x73\xb4\xa1\x49\xf1\xb5\x8b\x66\xee\xef\xb4\xd0\x08\x1b\xb6\xb3\x7b\x2f\x63\xf3\x6f\xa3\xc5\xe8\x38\x3c\xde\x7d\x6a\xc3\xef\xfe\xba\xaf\xe6\xdb\x6c\x45\x38\xba\xf1\xdb\x5b\x52\xd9\x25\x7f\xe1\x19\xcf\xc2\x2f\xc1\xd7\xe1\x59\x7d\x48\xaa\x0b\x62\x35\x12\xba\xe6\x98\x34\xaf\x48\x8c\xde\xa8\x87\xfe\x77\x67\xf4\xd9\x1b\x11\x27\x14\xa7\xb7\x48\xe0\x23\x59\xf9\x8c\x8a\x6e\xe6\x71\x85\xac\x01\xc3\x33\x8f\xf6\xf9\x09\xfd\x73\xda\x78\x16\x56\xbf\xc8\x5f\xc4\x8b\x55\x18\x05\xcf\xea\xdf\x3a\x07\x22\x35\xc9\x0e\x6d\x5a\x72\xe8\x40\x8a\x1b\x59\x64\xc7\x99\xcb\xec\x12\xbe\x17\x8e\x40\x69\x48\xa4\xc3\x0b\xd0\x86\xa0\x70\x98\x56\x9e\x62\x5d\x68\x2a\xd8\x7f\x01\x00\x00\xff\xff\xf7\xbf\x51\x00\x7b\x05\x00\x00")
+var _bindataAssetsBootstrapHelperSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x6f\x4f\xdb\xc8\x13\x7e\xbf\x9f\x62\x7e\x1b\xd4\x92\x5f\xd9\xb8\x45\x3d\xa4\x03\x59\x3a\xe3\xb8\x3d\xab\xc1\x89\x12\xa7\x02\x21\x64\x6d\xec\x49\xb2\xc5\xd9\xb5\xbc\x63\xfe\x08\xf9\xbb\x9f\x6c\x08\x38\x94\x43\xf7\xca\x3b\xb3\xf3\xcc\x3c\xf3\x78\x76\x7a\xff\x73\x16\x4a\x3b\x0b\x69\xd7\x8c\x59\x24\x10\x06\xb0\x2c\xf1\x4e\xd1\xd6\x2c\x54\x81\x4b\xa9\xf2\xad\xad\x4d\xa5\x2d\x12\x63\xd6\x54\x65\x8a\xe0\x20\xa5\x0e\x5e\xdb\x94\x72\xe7\xba\x5a\x60\x8e\x34\x40\x7d\x03\x3d\x58\xaa\x1c\xe1\xb6\x54\x44\xa8\x61\x71\x0f\x0b\x63\xc8\x52\x29\x8b\x02\x4b\xc6\x7a\x30\xb7\x08\xe1\xd9\x70\x76\x73\x08\x64\x60\x85\x04\x1b\x24\x99\x49\x92\x2c\x1e\xff\x08\x22\x97\xef\xed\xa7\x55\x99\x83\x10\x56\xe5\xa8\x09\xc4\x39\x4c\xe6\x31\x88\xbf\x81\x9f\x0b\x79\x6b\x05\xa6\x87\x62\x0b\x12\x64\xae\x51\x0b\xa2\x5c\x58\x4c\x8d\xce\xec\x31\x1c\x7d\xfe\xcc\x61\x4d\x54\x1c\x3b\xce\x97\xa3\x3f\x07\x87\x7f\x7c\x1d\x3c\x7d\x9d\x5c\x12\x5a\x72\x64\xa1\x9c\x16\xd9\xe7\x6c\x59\xe9\x94\x94\xd1\x0d\x99\x64\x4b\x66\xbf\x0f\x0f\x0c\xe0\x15\x93\x77\x28\x1c\xc3\x5e\xcb\x9f\x03\x7f\xbf\x74\x53\x41\x34\x25\x9c\xbd\x2f\x9c\xd5\x8c\x79\x93\x30\x99\x05\xd3\x9f\xc1\x34\x99\x4f\x47\x2e\xdf\x7b\xd8\xf5\xd4\x9c\x9d\x1e\x7d\x4d\xfc\xd1\x7c\x16\x07\xd3\xc4\xf7\x9a\x90\x5d\x4f\xcd\x59\x18\xcd\x62\x2f\xf2\x83\x24\x1c\x36\x12\x76\x7b\x01\xa5\x2d\x49\x9d\xa2\x50\x59\xbf\x13\x39\x0a\xbf\x05\xfe\x85\x3f\x0a\xfe\x1d\x90\xab\x25\x8a\xf4\x3e\xcd\xb1\xcf\xd9\xb6\xde\x30\x9a\x35\x14\x3a\xe6\xb1\xa8\x39\x6b\x18\x87\x0d\x81\xc9\xcf\xa3\xc4\x0f\x87\xd3\x26\xe8\x37\x67\x1b\x1a\x8d\x87\x41\x12\x7b\x61\x14\xb7\x99\x3a\x66\x7b\x7d\xe6\x9d\x27\x93\xf1\xb0\xbd\xdb\x9e\xdb\x0b\xdf\x9b\x78\x7e\x18\x5f\x24\xf1\xc5\x24\x48\x46\xde\x69\xd0\xea\xf5\x86\xfb\xa5\x4c\x6b\xbe\x94\x79\x34\xeb\x03\x6d\xb2\xc7\xf6\xda\xee\xdc\xbd\x87\xdf\x65\xa9\x0f\x64\x5e\xac\xe5\xe0\x71\xce\x07\xca\x38\x1d\x21\xbb\x88\x70\x58\x73\xa6\x96\x70\x79\x09\x42\xc3\xdb\x84\x6a\x0e\x57\x57\x27\x40\x6b\xd4\x0c\xa0\xd7\x1c\xc0\xca\x0d\xc2\x8d\xcc\x2b\xb4\x20\x6d\xeb\x4a\x65\x21\x53\x45\xf7\x40\xf7\x05\x82\x59\xc2\x46\x6a\xb9\xc2\x0c\x1a\xbe\xab\xd2\x54\x85\x6d\xc6\x52\x5a\x04\xde\x61\xf0\xc2\x99\x83\x6a\x0a\xd8\xc2\x50\x1f\x3a\x1d\x7f\x72\xf9\xc1\xdb\xc4\xdc\xd9\x64\x1c\x73\x38\x39\x61\x00\xff\xff\xaf\x98\x71\x94\x0c\x83\x33\x2f\x1a\x3e\x01\xd1\xca\x94\x2d\x15\x63\x3f\xe6\xa7\xc1\x28\x88\x13\x6f\xfa\x7d\xe6\xee\x73\x21\x1a\xe2\x22\x97\x0b\xcc\xad\xbb\xfb\x0f\x78\x9f\x3d\x2b\xd6\x19\x81\x56\x29\xf8\xf0\x01\xba\xa9\x3e\xb5\xb9\x4a\x5c\x29\x4b\x58\x8a\x5b\x45\x6b\x41\x52\x69\xb2\xee\x2b\x70\x9f\xf5\x40\x88\x8d\xbc\x13\x85\xc9\x5a\x5d\x25\xf8\xa3\x10\x64\xb9\xaa\x36\xcd\x2b\x56\x16\x32\x2c\x4a\x4c\x25\x61\x76\x00\xb4\x56\x16\x94\x05\x09\xb7\xa6\xbc\x96\xa5\xa9\x74\x06\x95\x26\x95\xc3\x2d\xbe\x44\x82\xad\x8a\xc2\x94\x04\x4b\x53\xc2\x46\xde\x4d\x4c\x66\x27\x58\x46\x26\xc3\x97\x2e\xb6\xc3\xfa\x4e\x0b\x5b\x62\x6e\x37\xba\xff\x2c\x5b\x70\x1e\x4f\xbd\x36\xbc\x99\xd8\x2e\xfc\xf2\xaf\xab\x9a\xb3\xe7\x47\x18\x79\x67\x41\xf7\x15\x36\x76\xcd\x9f\xf3\xf8\xe3\xe8\x5b\xf8\xdd\xfd\xd8\x2e\xea\x66\x43\x97\x1a\x09\xed\x76\x59\x6f\xbf\x22\x35\x7a\xa9\x56\x83\x5f\xd6\xe8\x8f\xaf\x48\xec\xa4\xd8\xdd\xf5\x02\xef\xa8\x94\x4f\xa8\xf8\x6c\x92\x34\xc8\x16\xe0\x7e\x74\x68\x53\xec\xa4\x7f\x0a\xf3\xc7\x51\xf3\xe2\x83\x69\x32\x9d\x47\x71\xf8\xc4\xfe\xb5\xf3\x58\x64\x26\xbd\xc6\x32\xab\x39\xf4\x20\xc3\xa5\xac\xf2\x47\xcd\x65\x7e\x08\xbf\x2a\x4b\xa0\x74\x3b\xff\x07\xa0\x0d\x41\x65\x31\x03\xa5\xa1\x5a\x54\x9a\x2a\xf6\xcf\x00\xe2\xae\x49\x58\xdb\x06\x00\x00")
 
 func bindataAssetsBootstrapHelperShBytes() ([]byte, error) {
 	return bindataRead(
@@ -134,11 +134,11 @@ func bindataAssetsBootstrapHelperSh() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "bindata/assets/bootstrap.helper.sh", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x45, 0x8c, 0xa1, 0xd0, 0x1e, 0x75, 0xe, 0x4b, 0x2f, 0x73, 0xb3, 0x29, 0x78, 0xe7, 0x17, 0x48, 0xff, 0x1e, 0x0, 0xa7, 0xca, 0xe1, 0x49, 0x7b, 0x15, 0x9c, 0xb8, 0xf8, 0x11, 0x53, 0x96, 0x8a}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x77, 0xd, 0x8d, 0x2a, 0x55, 0x5d, 0xe1, 0x10, 0x27, 0xab, 0x67, 0x88, 0x59, 0xd7, 0xbc, 0x2, 0xc4, 0xac, 0x1a, 0xdb, 0x99, 0x6a, 0xc2, 0xd, 0x2c, 0xe, 0x85, 0x30, 0x15, 0x10, 0xac, 0x2}}
 	return a, nil
 }
 
-var _bindataAssetsBootstrapLegacyAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x93\x61\x4f\x02\x47\x10\x86\xbf\xef\xaf\x98\x1e\x17\x23\xa9\xcb\xa9\xb1\x26\xa2\x34\xa1\xdc\x99\x5e\x8a\x40\x0a\xb6\x1a\x63\x2f\xcb\xde\x50\x36\x2e\xbb\x97\xdb\x01\x35\xe4\xfa\xdb\x9b\xc5\xc3\x82\x5a\x3f\xc1\xcc\xbc\xb3\xf3\xee\x33\xb7\x8d\x1f\xa2\xa9\x32\xd1\x54\xb8\x39\x6b\xc0\x64\x18\x0f\x21\xc6\xa2\x44\x29\x08\xf3\x23\x78\x56\x5a\xc3\x14\xa1\xc4\x85\x5d\x61\x0e\xce\x5a\xc3\x98\x43\x02\x6e\x01\xcb\x12\x5f\x14\x6d\xc3\x42\x15\x38\x13\x4a\x6f\x63\x63\x97\xc6\x21\x31\x36\x5b\x1a\x49\xca\x1a\xf8\x1b\x29\x5b\x88\x97\xac\xb0\xb9\x3b\x6c\xc2\x9a\x01\x3c\xcf\x95\xf6\xc7\x8b\x1c\x94\x71\x24\x8c\xc4\x8c\x5e\x0b\x04\xaf\xb9\x84\xdc\x32\x00\x00\x35\x03\x78\x78\x80\x20\x5c\xef\x89\xaa\x00\x3a\x1d\x9f\x3d\xa9\x02\x78\x7c\x84\x83\x83\x5a\xe5\x9b\x7d\xf1\x1f\xf8\xeb\xe1\x98\x5f\x3c\xfe\x18\xfa\xf2\x25\xd0\x1c\xcd\xe6\x40\x00\x94\x73\x0b\xb5\xb2\x4e\x95\x48\xcb\xf2\xad\x3e\x53\x0c\x20\xb7\x06\xe1\x0a\x22\x24\x19\xe1\x93\x93\xa4\xa3\xad\xfb\xd6\x42\x14\xac\x62\xac\x01\xb7\x0e\x21\xbd\x89\xc7\xab\x53\x20\xeb\x6f\x08\x0b\x24\x91\x0b\x12\x6c\x32\xfc\x2d\x19\x74\x82\xf0\x50\x2e\x4b\x0d\x9c\x3b\xa5\xd1\x10\xf0\x3b\x18\xdd\x4e\x80\xff\x0a\xc1\x1d\x17\xcf\x8e\xa3\x3c\xe5\xdb\x26\x4e\xf6\x09\x0d\x27\xd2\xdc\xa1\xb4\x26\x77\x6d\x38\x3f\x3e\x0e\x60\x4e\x54\xb4\xa3\xe8\xe4\xfc\xa2\x75\xfa\xd3\x59\xab\xfe\x8d\xb4\x20\x74\x14\x89\x42\x45\x9b\xce\x66\xf0\x01\x77\x7d\x6e\x8d\xfb\x83\x93\x6f\x2c\xb4\x21\xdc\xf8\x0f\x20\xf8\x7e\xb4\x6f\xe3\xbe\x2f\x0a\x4f\x02\xcf\x64\x30\x8c\x93\x2c\x1d\xf9\x8b\xef\x3a\x00\x6d\xa5\xd0\x5c\x15\xab\xb3\x66\xc0\xd2\xc1\x78\xd2\x1d\xf4\x92\x2c\x8d\x3f\x09\xb7\x3b\xe6\x2a\xdf\x55\x4e\xee\x47\xc9\xff\x6b\xfd\xf7\xd0\x0c\x58\xf7\xcf\x71\x36\x4e\x7e\xff\x23\xed\x25\xe3\x2c\x1e\xde\x74\xd3\xc1\xa7\x1e\x87\xe5\x4a\x49\x74\x51\x6e\x17\x42\x79\x64\x8c\x39\xbb\x2c\x25\xee\xed\xfa\x69\x39\x45\x8d\xd4\x42\xb3\x82\x06\xd0\x5c\x39\x90\xc2\x80\x5d\x61\x59\xaa\x1c\xe1\xa6\x7b\x97\x8d\x86\xf1\x98\xfd\x67\xb1\x9f\x5e\x27\xbd\xfb\x5e\xff\x1b\x9f\x5a\xcd\x90\xcb\x57\xa9\xbd\xdb\x0d\xaa\x7e\xf7\x97\xa4\x3f\xee\x04\xe1\x7a\x27\xac\x8e\x8c\xcd\xdf\xd4\x1b\x71\x27\x5c\x7f\x9e\x52\x79\xe7\x52\x10\xfc\xfc\xa5\xf1\x0d\xf0\x8d\xfd\xab\xab\x64\x78\xfd\xbe\x98\x7a\x50\x3a\xaa\xf6\xd6\xb0\x33\x21\x8d\xab\x0f\xdc\x77\x8a\x3e\xae\xbe\x04\x1d\xae\xbf\xc8\x56\x6c\x0b\xaa\x13\xae\xb7\x7f\xdb\xbc\xe6\x53\xbf\x27\xff\x68\xf7\x07\x04\xcd\x6a\x0f\xcf\x3e\x1d\xe6\xef\xc3\xdc\xab\x23\x5c\x48\xd2\x90\x0b\x5c\x58\xc3\x4b\xd4\x56\xe4\x3b\x79\x34\x62\xaa\x11\x6a\x22\x3b\x05\x47\xa2\xa4\xf7\xfc\xbf\x01\x00\x00\xff\xff\x46\x01\xeb\xc2\x06\x05\x00\x00")
+var _bindataAssetsBootstrapLegacyAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x94\x61\x6f\xe2\x46\x10\x86\xbf\xef\xaf\x98\x2e\xd6\x29\xa8\xb7\x38\x89\xae\x27\x1d\x17\x2a\x51\xec\xa8\x56\x09\xa0\x42\xda\x44\x51\x6a\x2d\xeb\xa1\xac\x58\x76\x2d\xef\x40\x12\x21\xf7\xb7\x57\x26\xa6\x31\x49\x9a\x4f\xf6\xcc\xbc\xb3\xf3\xec\x3b\x96\x5b\x3f\x84\x73\x6d\xc3\xb9\xf4\x4b\xd6\x82\xd9\x38\x1a\x43\x84\x79\x81\x4a\x12\x66\x9f\xe1\x41\x1b\x03\x73\x84\x02\xd7\x6e\x8b\x19\x78\xe7\x2c\x63\x1e\x09\x84\x03\x2c\x0a\x7c\xd4\x74\x08\x73\x9d\xe3\x42\x6a\x73\x88\xad\xdb\x58\x8f\xc4\xd8\x62\x63\x15\x69\x67\xe1\x6f\xa4\x74\x2d\x1f\xd3\xdc\x65\xfe\xa4\x0d\x3b\x06\xf0\xb0\xd4\xa6\x3a\x5e\x66\xa0\xad\x27\x69\x15\xa6\xf4\x94\x23\x54\x9a\xef\x90\x39\x06\x00\xa0\x17\x00\x77\x77\xc0\x83\xdd\x91\xa8\xe4\xd0\xeb\x55\xd9\xb3\x92\xc3\xfd\x3d\x7c\xfa\x54\xab\xaa\xe6\xaa\xf8\x0f\xfc\x75\x77\x2a\xbe\xdd\xff\x18\x54\xe5\xef\x40\x4b\xb4\xfb\x03\x01\x50\x2d\x1d\xd4\xca\x3a\x55\x20\x6d\x8a\xe7\xfa\x42\x33\x80\xcc\x59\x84\x0b\x08\x91\x54\x88\x2b\xaf\xc8\x84\x07\xfa\xce\x5a\xe6\xac\x64\xac\x05\xd7\x1e\x21\xb9\x8a\xa6\xdb\x73\x20\x57\xdd\x10\xd6\x48\x32\x93\x24\xd9\x6c\xfc\x5b\x3c\xea\xf1\xe0\x44\x6d\x0a\x03\x42\x78\x6d\xd0\x12\x88\x1b\x98\x5c\xcf\x40\xfc\x0a\xfc\x46\xc8\x07\x2f\x50\x9d\x8b\x43\x93\x20\xb7\x42\x2b\x88\x8c\xf0\xa8\x9c\xcd\x7c\x17\xbe\x9e\x9e\x72\x58\x12\xe5\xdd\x30\x3c\xfb\xfa\xad\x73\xfe\xd3\x97\x4e\xfd\x0c\x8d\x24\xf4\x14\xca\x5c\x87\xfb\xce\x36\x7f\x65\x77\x7d\x6e\x6d\xf7\x2b\x92\x0f\x10\xba\x10\xec\xf9\x39\xf0\x8f\x47\x57\xe4\xa2\x42\x0f\x83\x33\x5e\x79\x32\x1a\x47\x71\x9a\x4c\xaa\x8b\x37\x09\xc0\x38\x25\x8d\xd0\xf9\xf6\x4b\x9b\xb3\x64\x34\x9d\xf5\x47\x83\x38\x4d\xa2\x37\xc2\xc3\x8e\x85\xce\x9a\xca\xd9\xed\x24\xfe\x7f\x6d\xf5\xd1\xb4\x39\xeb\xff\x39\x4d\xa7\xf1\xef\x7f\x24\x83\x78\x9a\x46\xe3\xab\x7e\x32\x7a\xd3\xe3\xb1\xd8\x6a\x85\x3e\xcc\xdc\x5a\x6a\xdb\xe6\x8c\x31\xef\x36\x85\xc2\xa3\x5d\xaf\x36\x73\x34\x48\x1d\xb4\x5b\x68\x01\x2d\xb5\x07\x25\x2d\xb8\x2d\x16\x85\xce\x10\xae\xfa\x37\xe9\x64\x1c\x4d\xd9\x0b\xe2\x30\xb9\x8c\x07\xb7\x83\xe1\x07\x9c\x46\x2f\x50\xa8\x27\x65\xb0\xcd\x9f\xad\x1a\xf6\x7f\x89\x87\xd3\x1e\x0f\x76\x8d\xb0\xfc\x6c\x5d\xf6\xac\xde\x8b\x7b\xc1\xee\xed\x94\xb2\x22\x57\x92\xe0\xe7\x77\xc1\xf7\x86\xef\xf1\x2f\x2e\xe2\xf1\xe5\x7f\x8b\xa9\x07\x25\x93\xf2\x85\x3c\x89\x9a\x13\x92\xa8\x51\xda\xfb\xde\x28\x56\x71\xf9\xae\xd1\xc1\xee\x9d\x6c\xc9\x0e\x46\xf5\x82\xdd\xe1\xb5\x2b\x82\x93\xe6\xdf\x00\xf8\xeb\x01\xbc\x5d\x1e\xd9\x73\xec\x0e\xab\xee\xc3\xfc\x93\x27\x5c\x2b\x32\x90\x49\x5c\x3b\x2b\x0a\x34\x4e\x66\x8d\x3c\x5a\x39\x37\x08\xb5\x23\x8d\x82\x27\x59\x10\xac\x36\x73\x34\x48\xec\xdf\x01\x00\x46\x01\xeb\xc2\x06\x05\x00\x00")
 
 func bindataAssetsBootstrapLegacyAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -158,7 +158,7 @@ func bindataAssetsBootstrapLegacyAl2Sh() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsBootstrapLegacyUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x55\xf1\x6f\x1a\xb9\x12\xfe\xdd\x7f\xc5\xbc\x0d\xea\x0b\x7a\x31\xdb\xa4\x7d\x95\x9a\x96\xd3\x71\x81\xde\xa1\xa6\x10\x15\x72\xd7\x2a\xca\x21\x63\x0f\x59\x0b\xaf\xbd\xb2\x67\xa1\x51\xc4\xfd\xed\x27\x2f\xbb\x84\xd0\x36\x3f\xb1\xf6\xf7\xcd\xf8\xf3\xf8\x9b\xe1\xe8\x3f\xe9\x5c\xdb\x74\x2e\x42\xc6\x8e\x60\x3a\xee\x8f\xa1\x8f\x85\x47\x29\x08\xd5\x09\xac\xb5\x31\x30\x47\xf0\x98\xbb\x15\x2a\x08\xce\x59\xc6\x02\x12\x70\x07\xe8\x3d\x7e\xd3\xd4\x2c\x0b\x5d\xe0\x42\x68\xd3\xac\xad\x2b\x6d\x40\x62\x6c\x51\x5a\x49\xda\x59\xb8\x43\x9a\xe5\xe2\xdb\xac\x70\x2a\x1c\xb7\xe1\x81\x01\xac\x33\x6d\x62\x7a\xa1\x40\xdb\x40\xc2\x4a\x9c\xd1\x7d\x81\x10\x39\xef\x40\x39\x06\x00\xa0\x17\x00\x37\x37\x90\xb4\x1e\x9e\x90\x36\x09\x74\xbb\x71\xf7\x74\x93\xc0\xed\x2d\xbc\x78\x51\xb3\x62\x70\x04\xff\x81\xbf\x6f\x5e\xf2\xb7\xb7\xff\x6b\x45\xf8\x1d\x50\x86\xb6\x4a\x08\x80\x32\x73\x50\x33\xdf\xd5\x7b\x1e\xa9\xf4\x5b\xc2\x42\x33\x00\xe5\x2c\xc2\x7b\x48\x91\x64\x8a\xcb\x20\xc9\xa4\x8d\xfc\x4e\x2e\x0a\xb6\x61\xec\x08\xae\x03\xc2\xf0\x53\x7f\xb2\x3a\x03\x72\xf1\x8a\x90\x23\x09\x25\x48\xb0\xe9\xf8\xe3\x60\xd4\x4d\x5a\xc7\xb2\xf4\x06\x38\x0f\xda\xa0\x25\xe0\x5f\xe0\xea\x7a\x0a\xfc\x0f\x48\xbe\x70\xb1\x0e\x1c\xe5\x19\x6f\x82\x38\xb9\x25\x5a\x4e\x64\x78\x40\xe9\xac\x0a\xe7\xf0\xe6\xe5\xcb\x04\x32\xa2\xe2\x3c\x4d\x4f\xdf\xbc\xed\x9c\xfd\xff\x75\xa7\xfe\x4d\x8d\x20\x0c\x94\x8a\x42\xa7\x55\x64\x3b\x39\xa8\x77\x9d\xb7\xae\xf7\x81\x92\x67\x24\x9c\x43\xab\xd2\x9f\x40\xf2\xfc\xd1\x31\x8c\xc7\xb8\xb4\x75\x9a\xc4\x9a\x8c\xc6\xfd\xc1\x6c\x78\x15\x2f\xbe\xaf\x00\x8c\x93\xc2\x70\x5d\xac\x5e\xb7\x13\x36\x1c\x4d\xa6\xbd\xd1\xc5\x60\x36\xec\x7f\x47\x6c\x1e\x99\x6b\xb5\xcf\x9c\x7e\xbd\x1a\xfc\x9c\x1b\x0d\xd1\x4e\x58\xef\xaf\xc9\x6c\x32\xf8\xfc\xe7\xf0\x62\x30\x99\xf5\xc7\x9f\x7a\xc3\xd1\x77\x31\x01\xfd\x4a\x4b\x0c\xa9\x72\xb9\xd0\xb1\x64\x2c\xb8\xd2\x4b\x7c\xf2\xd4\xcb\x72\x8e\x06\xa9\x83\x76\x05\x47\x40\x99\x0e\x20\x85\x05\xb7\x42\xef\xb5\x42\xf8\xd4\xfb\x32\xbb\x1a\xf7\x27\x8c\x3d\x4a\xbc\x1c\x7e\x18\x5c\x7c\xbd\xb8\x7c\x46\xa7\xd1\x0b\xe4\xf2\x5e\x9a\xa8\x96\x49\x41\xf0\xcb\x0f\x8f\xad\xaa\x55\x1d\xfe\xfe\xfd\x60\xfc\x61\x57\xd5\xd6\x43\xfd\xb5\x79\x52\xc3\xd6\xc3\xde\x6a\x73\x50\xb4\x3d\x30\xae\x37\xac\xd1\xde\x6d\x3d\x34\x9f\xe7\xbc\x56\x5c\x3b\x3c\xf6\xd1\xd3\xa8\xa4\xbd\x61\x51\x09\x0b\x56\x14\x20\x8c\x16\x01\x6a\xb5\x1c\x97\xa1\x53\x7f\x37\x7b\x87\x34\x49\x66\x47\x93\x64\x9a\xbd\x2d\x2d\x90\x2b\xf6\x93\xb1\x70\x1f\x08\xf3\xc8\xf3\x18\x90\x78\x9c\x2c\xa8\x18\x3b\x66\x00\xdb\x41\x75\x1e\xdb\x39\x20\x84\xcc\x95\x46\xc5\x29\x65\x9c\x5b\xa2\x02\x41\x80\x2b\xf4\xf7\x40\x3a\xc7\x26\x29\x04\x12\x9e\x02\x94\xc5\x49\x95\x61\x9d\x69\x99\x81\x0e\xb0\xce\x04\xc1\x1a\x41\x39\xd0\x16\x7a\x97\x67\x70\xbc\xc3\xe6\x22\xa0\x02\x67\xa1\x30\x42\x5b\xd8\x6a\x52\xdb\x04\xc2\x2a\xc8\x51\x58\x8a\x6d\x3f\x8f\x03\xcb\x93\x98\x1b\x8c\xcb\xdc\x05\x6a\xd8\xa0\x74\x20\xef\x42\xfb\x04\xe6\x25\x81\xa6\xff\x86\x2a\xde\x3a\x02\x69\x50\x78\xc8\xdc\x3a\x06\x19\x27\x54\x7d\xa5\x85\x77\xf9\xa3\xf0\x58\x9f\xb5\xa6\xcc\x95\x04\x99\x58\x69\x7b\x57\x25\x20\x07\xb2\x0c\xe4\x72\x1d\x30\xc6\x6d\x89\x9a\x02\x9a\x05\x03\x78\xc6\xd1\x3b\x6b\x3d\x4f\xfb\x29\xa1\x31\x75\xc5\x60\x00\x0b\x23\xee\x42\xf7\xb8\x1a\x9c\x89\x75\x0a\xb9\x2e\xf6\x7c\x9a\x6c\x81\x5c\x7c\xe3\xd1\x58\x7b\x9e\x6b\xa0\x2a\xc6\x88\x39\x9a\xd0\xc4\x5d\xf6\x7e\x1b\x5c\x4e\x36\x27\xc2\x14\x99\xe8\x6c\x0f\xee\x68\x97\xee\xcd\x86\x03\xcf\x9f\x6c\xb3\xe8\x05\x56\xdd\xb5\x8f\xee\xda\xb2\x39\xb0\x70\x8a\x6b\xbb\xf0\x82\x4b\x67\x49\x68\x8b\x9e\xeb\x5c\xdc\xc5\xa8\x38\x41\x06\x1f\x27\xb3\xc1\xc5\xe7\x59\xef\xe2\x62\x7c\x3d\x9a\x6e\x3a\x6a\xe9\x3b\x28\x7d\x67\x0b\xf7\x07\x1f\x7a\xd7\x97\xd3\xd9\xe7\xc1\xef\xc3\xf1\x68\x53\xef\x1e\x8c\x9d\x4d\x2c\x57\x5a\x88\x32\xe0\xf9\xab\xce\xab\xe8\xea\x79\xa9\x8d\xea\x9c\xd6\x22\xa4\x71\xa5\xe2\x85\x77\x2b\xad\xd0\x77\xc5\x3a\x34\x80\xd5\x7c\xae\x2d\x57\xda\x77\x53\x57\x50\x2a\xad\x8e\x7f\xd3\x7b\xb0\x74\x76\xb1\xc5\xe3\xbb\x44\xdc\x22\x75\x54\xc3\xd8\x5d\xca\x97\x36\x76\x41\x57\x39\xb9\x44\xdf\x94\x1b\x69\xed\xfc\x92\x17\xa6\xbc\xd3\xb6\x2b\xad\xae\x01\x8f\x77\x3a\x10\x7a\x1e\x4b\xd9\x25\x5f\xe2\x21\x10\x7d\xc8\x63\x6e\xda\xbd\xd4\xb4\x37\x1c\x4d\x77\x4f\x59\x75\xb5\xb3\x0b\x7d\xd7\x3d\xf4\xd4\x76\xbb\x73\x2f\x72\xf3\xa8\xf3\x47\xc4\x68\xbe\x86\xd5\x8e\x06\xdb\x8e\x88\xc7\xd1\x12\x6b\x19\xe7\x53\x65\xbc\x9b\x5f\x6f\x37\x09\x6b\xb3\x66\x90\x08\xff\x84\xc7\xfe\x0d\x00\x00\xff\xff\x76\x63\xcb\x48\xe3\x08\x00\x00")
+var _bindataAssetsBootstrapLegacyUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x55\xf1\x6f\x1a\xb9\x12\xfe\xdd\x7f\xc5\xbc\x0d\xea\x0b\x7a\x31\xdb\xa4\x7d\x95\x9a\x96\xa7\xc7\x05\x7a\x87\x9a\x42\x54\xc8\x5d\xab\x28\x87\x8c\x3d\x64\x2d\xbc\xf6\xca\x9e\x85\x46\x88\xfb\xdb\x4f\x5e\x76\x09\xa5\xd7\xfc\xc4\xda\xdf\x37\xe3\xcf\xe3\x6f\x86\x93\x7f\xa5\x73\x6d\xd3\xb9\x08\x19\x3b\x81\xe9\xb8\x3f\x86\x3e\x16\x1e\xa5\x20\x54\x67\xb0\xd6\xc6\xc0\x1c\xc1\x63\xee\x56\xa8\x20\x38\x67\x19\x0b\x48\xc0\x1d\xa0\xf7\xf8\x4d\x53\xb3\x2c\x74\x81\x0b\xa1\x4d\xb3\xb6\xae\xb4\x01\x89\xb1\x45\x69\x25\x69\x67\xe1\x01\x69\x96\x8b\x6f\xb3\xc2\xa9\x70\xda\x86\x0d\x03\x58\x67\xda\xc4\xf4\x42\x81\xb6\x81\x84\x95\x38\xa3\xc7\x02\x21\x72\xde\x81\x72\x0c\x00\x40\x2f\x00\xee\xee\x20\x69\x6d\xbe\x23\x6d\x13\xe8\x76\xe3\xee\xf9\x36\x81\xfb\x7b\x78\xf1\xa2\x66\xc5\xe0\x08\xfe\x05\x7f\xde\xbd\xe4\x6f\xef\xff\xd3\x8a\xf0\x3b\xa0\x0c\x6d\x95\x10\x00\x65\xe6\xa0\x66\xbe\xab\xf7\x3c\x52\xe9\x77\x84\x85\x66\x00\xca\x59\x84\xf7\x90\x22\xc9\x14\x97\x41\x92\x49\x1b\xf9\x9d\x5c\x14\x6c\xcb\xd8\x09\xdc\x06\x84\xe1\xa7\xfe\x64\x75\x01\xe4\xe2\x15\x21\x47\x12\x4a\x90\x60\xd3\xf1\xc7\xc1\xa8\x9b\xb4\x4e\x65\xe9\x0d\x70\x1e\xb4\x41\x4b\xc0\xbf\xc0\xcd\xed\x14\xf8\x6f\x90\x7c\xe1\x62\x1d\x38\xca\x0b\xde\x04\x71\x72\x4b\xb4\x9c\xc8\xf0\x80\xd2\x59\x15\x2e\xe1\xcd\xcb\x97\x09\x64\x44\xc5\x65\x9a\x9e\xbf\x79\xdb\xb9\xf8\xef\xeb\x4e\xfd\x9b\x1a\x41\x18\x28\x15\x85\x4e\xab\xc8\x76\x72\x54\xef\x3a\x6f\x5d\xef\x23\x25\xcf\x48\xb8\x84\x56\xa5\x3f\x81\xe4\xf9\xa3\xa3\x72\x1e\xa5\xa7\xad\xf3\x24\xd6\x64\x34\xee\x0f\x66\xc3\x9b\x78\xf1\x43\x05\x60\x9c\x14\x86\xeb\x62\xf5\xba\x9d\xb0\xe1\x68\x32\xed\x8d\xae\x06\xb3\x61\xff\x07\x62\xf3\xc8\x5c\xab\x43\xe6\xf4\xeb\xcd\xe0\xe7\xdc\xe8\x9a\x76\xc2\x7a\x7f\x4c\x66\x93\xc1\xe7\xdf\x87\x57\x83\xc9\xac\x3f\xfe\xd4\x1b\x8e\x7e\x88\x09\xe8\x57\x5a\x62\x48\x95\xcb\x85\xb6\xed\x84\xb1\xe0\x4a\x2f\xf1\xbb\xa7\x5e\x96\x73\x34\x48\x1d\xb4\x2b\x38\x01\xca\x74\x00\x29\x2c\xb8\x15\x7a\xaf\x15\xc2\xa7\xde\x97\xd9\xcd\xb8\x3f\x61\xec\x49\xe2\xf5\xf0\xc3\xe0\xea\xeb\xd5\xf5\x33\x3a\x8d\x5e\x20\x97\x8f\xd2\x60\x3c\x57\x0a\x82\xff\xfd\xe3\xb1\x55\xb5\xaa\xc3\xdf\xbf\x1f\x8c\x3f\xec\xab\xda\xda\xd4\x5f\xdb\xa7\x63\x87\xfd\x6e\x6b\x73\xb0\x3a\x80\xaa\xa2\x1d\x80\x71\xbd\x65\x8d\xf6\x6e\x6b\xd3\x7c\x5e\xf2\xd6\xe9\x61\x83\x42\x72\x1c\x95\xb4\xb7\x2c\x2a\x61\xc1\x8a\x02\x84\xd1\x22\x40\xad\x96\xe3\x32\x74\xea\xef\x66\xef\x98\x26\xc9\xec\x69\x92\x4c\xb3\xb7\xa3\x05\x72\xc5\x61\x32\x16\x1e\x03\x61\x1e\x79\x1e\x03\x12\x8f\x93\x05\x15\x63\xa7\x0c\x60\x37\xa8\x2e\x63\x3b\x07\x84\x90\xb9\xd2\xa8\x38\xa5\x8c\x73\x4b\x54\x20\x08\x70\x85\xfe\x11\x48\xe7\xd8\x24\x85\x40\xc2\x53\x80\xb2\x38\xab\x32\xac\x33\x2d\x33\xd0\x01\xd6\x99\x20\x58\x23\x28\x07\xda\x42\xef\xfa\x02\x4e\xf7\xd8\x5c\x04\x54\xe0\x2c\x14\x46\x68\x0b\x3b\x4d\x6a\x97\x40\x58\x05\x39\x0a\x4b\xb1\xed\xe7\x71\x60\x79\x12\x73\x83\x71\x99\xbb\x40\x0d\x1b\x94\x0e\xe4\x5d\x68\x9f\xc1\xbc\x24\xd0\xf4\xef\x50\xc5\x5b\x47\x20\x0d\x0a\x0f\x99\x5b\xc7\x20\xe3\x84\xaa\xaf\xb4\xf0\x2e\x7f\x12\x1e\xeb\xb3\xd6\x94\xb9\x92\x20\x13\x2b\x6d\x1f\xaa\x04\xe4\x40\x96\x81\x5c\xae\x03\xc6\xb8\x1d\x51\x53\x40\xb3\x60\x00\xcf\x38\x7a\x6f\xad\xe7\x69\x3f\x25\x34\xa6\x8e\xee\x64\x0c\x60\x61\xc4\x43\xe8\xc6\x97\x01\x48\xac\x53\xc8\x75\x71\xe0\xd3\x64\x07\xe4\xe2\x1b\x8f\xc6\x3a\xf0\x5c\x03\x55\x31\x46\xcc\xd1\x84\x26\xee\xba\xf7\xcb\xe0\x7a\xb2\x3d\x13\xa6\xc8\x44\x67\x77\x70\x47\xbb\x74\xdf\x47\x5a\x1d\x79\xfe\x6c\x97\x45\x2f\xb0\xea\xae\x43\x74\xdf\x96\xcd\x81\x85\x53\x5c\xdb\x85\x17\x5c\x3a\x4b\x42\x5b\xf4\x5c\xe7\xe2\x01\xbb\xad\x4d\x9c\x20\x83\x8f\x93\xd9\xe0\xea\xf3\xac\x77\x75\x35\xbe\x1d\x4d\xb7\x1d\xb5\xf4\x1d\x94\xbe\xb3\x83\xfb\x83\x0f\xbd\xdb\xeb\xe9\xec\xf3\xe0\xd7\xe1\x78\xb4\xad\x77\x8f\xc6\xce\x36\xd6\x33\x2d\x44\x19\xf0\xf2\x55\xe7\x55\x74\xf5\xbc\xd4\x46\x75\xce\x6b\x11\xd2\xb8\x52\xf1\xc2\xbb\x95\x56\xe8\xbb\x62\x1d\x1a\xc0\x6a\x3e\xd7\x96\x2b\xed\xbb\xa9\x2b\x28\x95\x56\xc7\xbf\xe9\x03\x58\x3a\xbb\xd8\xe1\xf1\x5d\x22\x6e\x91\x3a\xaa\x61\xec\x2f\xe5\x4b\x1b\xbb\xa0\xab\x9c\x5c\xa2\xaf\x61\x8b\xb4\x76\x7e\xc9\x0b\x53\x3e\x68\xdb\x95\x56\xd7\x80\xc7\x07\x1d\x08\x3d\x8f\xa5\xec\x92\x2f\xf1\x18\x88\x3e\xe4\x31\x37\xed\x5f\x6a\xda\x1b\x8e\xa6\x93\xa6\xb2\xd1\x3c\x51\x9c\x7e\xe8\x1e\x7b\x6a\xb7\xdd\x79\x14\xb9\xa9\xc9\x3f\x21\x46\xf3\x35\xac\x76\x34\x58\xe5\xec\xf0\x34\x5a\x62\x2d\x21\x69\x6d\x2a\xe3\xdd\xfd\xff\x7e\x9b\xb0\x76\x3d\x96\xaa\x36\x3f\xe4\xb1\xbf\x07\x00\x76\x63\xcb\x48\xe3\x08\x00\x00")
 
 func bindataAssetsBootstrapLegacyUbuntuShBytes() ([]byte, error) {
 	return bindataRead(
@@ -178,7 +178,7 @@ func bindataAssetsBootstrapLegacyUbuntuSh() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsBootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xdf\x6b\xdb\x30\x10\xc7\xdf\xf5\x57\xdc\xb4\x82\x61\x20\x6b\x7b\xdd\xc3\x20\xeb\xbc\x52\xd6\x66\x23\x71\xa1\x90\x05\x23\x2b\x17\x5b\x89\x23\x69\x3a\x39\x04\x82\xff\xf7\x21\x86\xb3\x34\xc9\xe3\xdd\xf7\xc7\x7d\x6c\xbd\x7f\x27\x6b\x63\x65\xad\xa8\x65\x8c\x30\x82\x70\x80\x21\xe0\xc1\xc4\x71\xf4\xc6\xe3\x5a\x99\x6e\x9c\xad\xeb\x2d\x61\x64\x8c\x5c\x1f\x34\x82\xdc\xab\x20\x3b\x53\x4b\xdd\xb9\x7e\x25\x49\x07\xe3\x23\x49\xdc\x92\x8e\x9d\xac\x9d\x8b\x14\x83\xf2\x79\x8b\x9d\xc7\x90\xa7\x43\xa8\x5b\x07\xfc\x9f\xe3\x33\x84\xde\x5a\x63\x1b\x90\x18\x75\x8a\xfd\xcf\x70\x76\xbd\xcb\xa9\x05\x7e\x77\xbc\x7f\x7a\x99\x97\xc5\xac\x9a\x4e\x9e\x8b\x81\xc3\x6f\x06\x20\xc4\xca\x92\xd0\x5d\x4f\x11\x83\x30\xfe\xdc\xf6\x6d\x3a\x3f\xb9\xb6\x7d\x8d\x1d\x46\x81\x87\x18\x94\x50\xa1\xa1\xe4\xfc\xf1\xf2\xb5\x78\x2a\xca\xaa\x78\x2d\x67\x93\x6a\x32\x7b\x98\x0f\xfc\x92\x74\x87\xa1\x49\xa4\x3d\x61\x00\xe7\xa3\x71\x96\xc0\xd8\xe8\x60\xec\xd4\xce\xae\x4d\x93\x6f\xc8\x59\xce\x12\x2e\x64\x61\x07\x62\x0d\x77\xc7\xf2\xf9\x57\x95\x8e\x54\xf7\x3f\xa7\xdf\x87\x0c\x8a\xd7\xc7\x92\x6d\xfe\x80\x20\xc8\xf2\xc5\xc7\x25\x7c\x80\x7c\xf1\x69\x99\x9d\xc3\x24\xeb\xe3\xc3\xc0\xaf\x01\x4f\xca\x97\xa4\xbd\xed\xe6\x6c\xb7\xbf\xb1\xbd\x55\x7c\xf5\x16\x48\x51\x85\x98\x3e\xf2\xf4\x9b\xb6\xc4\x19\x59\xe5\x47\xf1\x5c\xb9\x88\xaf\x9c\x45\xce\xfe\x06\x00\x00\xff\xff\xad\x08\x49\xa5\x55\x02\x00\x00")
+var _bindataAssetsBootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xcd\x8a\xdb\x30\x10\xc7\xef\x7a\x8a\xa9\xba\x60\x28\xc8\x6a\xaf\x3d\x14\xd2\xad\xbb\x2c\xdd\x4d\x4b\xe2\x85\x40\x1a\x8c\xac\x4c\x6c\x25\x8e\xa4\x6a\xe4\x10\x08\x7e\xf7\x22\x8a\xd3\x90\xe4\x38\xf3\xff\x98\x9f\xad\xf7\xef\x64\x6d\xac\xac\x15\xb5\x8c\x11\x46\x10\x0e\x30\x04\x3c\x9a\x38\x8e\xde\x78\xdc\x28\xd3\x8d\xb3\x75\xbd\x25\x8c\x8c\x91\xeb\x83\x46\x90\x07\x15\x64\x67\x6a\xa9\x3b\xd7\xaf\x25\xe9\x60\x7c\x24\x89\x3b\xd2\xb1\x93\xb5\x73\x91\x62\x50\x3e\x6f\xb1\xf3\x18\xf2\x74\x08\x75\xeb\x80\xff\x73\x7c\x86\xd0\x5b\x6b\x6c\x03\x12\xa3\x4e\xb1\xff\x19\xce\x6e\x77\x39\xb5\xc0\x1f\x4e\x8f\x2f\x6f\xf3\xb2\x98\x55\xd3\xc9\x6b\x31\x70\xf8\xcd\x00\x84\x58\x5b\x12\xba\xeb\x29\x62\x10\xc6\x5f\xda\xbe\x4d\xe7\x67\xd7\xae\xaf\xb1\xc3\x28\xf0\x18\x83\x12\x2a\x34\x94\x9c\x3f\xde\xbe\x16\x2f\x45\x59\x15\x8b\x72\x36\xa9\x26\xb3\xa7\xf9\xc0\xaf\x49\xf7\x18\x9a\x44\xda\x13\x06\x70\x3e\x1a\x67\x09\x8c\x8d\x0e\xc6\x4e\xed\xec\xc6\x34\xf9\x96\x9c\xe5\x2c\xe1\x42\x16\xf6\x20\x36\xf0\x70\x2a\x5f\x7f\x55\xe9\x48\xf5\xf8\x73\xfa\x7d\xc8\xa0\x58\x3c\x97\x6c\xfb\x07\x04\x41\x96\x2f\x3f\xae\xe0\x03\xe4\xcb\x4f\xab\xec\x12\x26\x59\x9f\x9f\x06\x7e\x0b\x78\x56\xbe\x00\xbf\xee\xe6\x6c\x7f\xb8\xb3\xbd\x57\x7c\xf3\x16\x48\x51\x85\x98\x3e\xf2\xfc\x9b\x76\xc4\x19\x59\xe5\x47\xf1\x52\xb9\x8a\xaf\x9d\x45\xce\xfe\x0e\x00\xad\x08\x49\xa5\x55\x02\x00\x00")
 
 func bindataAssetsBootstrapUbuntuShBytes() ([]byte, error) {
 	return bindataRead(
@@ -198,7 +198,7 @@ func bindataAssetsBootstrapUbuntuSh() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsEfaAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd0\x4d\x6a\xc3\x40\x0c\x05\xe0\xbd\x4e\xa1\xd2\xb5\x46\x25\xdd\x15\xba\xea\x01\x7a\x84\xa0\xa4\x1a\x7b\xc0\xf3\xd3\x91\x8c\x93\x9c\xbe\x38\x4d\xe8\xc2\x34\x9b\x81\x37\x7c\x12\x3c\x3d\x3f\xf1\x21\x15\x3e\x88\x8d\x00\xa6\x8e\x54\x51\x7b\xd7\x53\xf2\x7b\x6c\xa9\x69\x94\x34\xdd\x73\xa9\x73\x31\x75\x80\xf3\x9c\x31\x15\x73\x99\x26\xa4\x33\x2e\x83\x3a\xac\x0f\xd2\x37\x12\x79\xca\x5a\x67\x7f\xdf\xbd\xe0\xe8\xde\xec\x8d\xd9\x5e\x69\x36\x5a\xd4\x9c\x76\x41\xb2\x5c\x6a\x91\xc5\xc2\xb1\x66\x96\xc5\x48\xa3\xd0\x6d\x9f\xf6\xed\x0f\x4d\xe2\x6a\x1e\x5c\x7a\x18\x2e\x48\x9f\xc8\x9e\xdb\xd6\xdd\x00\xb8\x74\xa4\x53\x7c\xac\x90\x3e\xae\x00\x8e\x5f\xff\x40\x08\xac\x51\xf6\x7f\x83\x36\xae\x6d\x69\x00\xae\xcd\xf9\xb7\xc6\x4a\xae\x87\x8c\x69\x9f\x4a\xac\x48\x0d\x35\x0a\xfc\x04\x00\x00\xff\xff\x8f\x52\xee\x9a\x5f\x01\x00\x00")
+var _bindataAssetsEfaAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xd0\x4d\x6a\xc3\x40\x0c\x05\xe0\xbd\x4e\xa1\xd2\xb5\x46\x25\xdd\x15\xba\xea\x01\x7a\x84\xa0\xa4\x1a\x7b\xc0\xf3\xd3\x91\x8c\x93\x9c\xbe\x38\x4d\xe8\xc2\x34\x9b\x81\x37\x7c\x12\x3c\x3d\x3f\xf1\x21\x15\x3e\x88\x8d\x00\xa6\x8e\x54\x51\x7b\xd7\x53\xf2\x7b\x6c\xa9\x69\x94\x34\xdd\x73\xa9\x73\x31\x75\x80\xf3\x9c\x31\x15\x73\x99\x26\xa4\x33\x2e\x83\x3a\xac\x0f\xd2\x37\x12\x79\xca\x5a\x67\x7f\xdf\xbd\xe0\xe8\xde\xec\x8d\xd9\x5e\x69\x36\x5a\xd4\x9c\x76\x41\xb2\x5c\x6a\x91\xc5\xc2\xb1\x66\x96\xc5\x48\xa3\xd0\x6d\x9f\xf6\xed\x0f\x4d\xe2\x6a\x1e\x5c\x7a\x18\x2e\x48\x9f\xc8\x9e\xdb\xd6\xdd\x00\xb8\x74\xa4\x53\x7c\xac\x90\x3e\xae\x00\x8e\x5f\xff\x40\x08\xac\x51\xf6\x7f\x83\x36\xae\x6d\x69\x00\xae\xcd\xf9\xb7\xc6\x4a\xae\x87\x8c\x69\x9f\x4a\xac\x48\x0d\x35\x0a\xfc\x0c\x00\x8f\x52\xee\x9a\x5f\x01\x00\x00")
 
 func bindataAssetsEfaAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -218,7 +218,7 @@ func bindataAssetsEfaAl2Sh() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsEfaManagedBoothook = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x4d\x4e\xc4\x30\x0c\x85\xf7\x39\x85\x2f\xe0\x04\x0d\x3b\x24\x56\x1c\x80\x23\x54\xa6\xe3\xb4\x91\x9a\x1f\x62\x47\x65\xe6\xf4\x28\xed\x88\x0d\xad\x60\x67\xf9\x3d\x7f\xcf\x7a\xe3\x92\xdb\x15\x43\x0a\x8a\x85\x2b\xe4\x34\x32\xdc\x5a\x1c\xd6\x89\xb5\x0f\x10\x92\x28\x2d\x0b\xe0\x0d\xfa\xce\x1c\x1d\x74\x61\x60\x4f\xdb\x00\xf8\x09\x88\x1a\x22\xe7\xa6\xaf\x97\x27\x98\x55\x8b\xbc\x38\x27\xcf\xd8\x04\x57\x16\xc5\x8b\xa5\x48\xf7\x9c\x68\x15\x3b\xe6\xe8\x68\x15\x64\x4f\xf8\x08\xe3\xfa\x7b\x83\x0b\x29\x8b\x5a\xa5\x6a\xa7\x3b\xe0\x3b\x38\x8d\xe5\x2f\x9f\x39\xfc\x57\xa9\x6e\xef\x2a\x55\xc0\x2f\xff\x2f\x12\xe0\xdb\xe6\x33\xa5\xc9\x7c\x3d\x39\x39\x4c\x7b\xa8\x5b\xa2\x75\xec\x69\xf8\xf1\x5b\x99\x7b\xb1\x38\x99\x92\xcb\x19\xf3\x10\xba\x63\x7c\x06\x97\x8b\xba\xbd\xcd\xce\x76\x1f\x21\x39\x1f\x76\x0d\x4b\xf7\x99\xef\x00\x00\x00\xff\xff\xd7\xd4\x31\xc2\xe4\x01\x00\x00")
+var _bindataAssetsEfaManagedBoothook = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\x4d\x4e\xc4\x30\x0c\x85\xf7\x39\x85\x2f\xe0\x04\x0d\x3b\x24\x56\x1c\x80\x23\x54\xa6\xe3\xb4\x91\x9a\x1f\x62\x47\x65\xe6\xf4\x28\xed\x88\x0d\xad\x60\x67\xf9\x3d\x7f\xcf\x7a\xe3\x92\xdb\x15\x43\x0a\x8a\x85\x2b\xe4\x34\x32\xdc\x5a\x1c\xd6\x89\xb5\x0f\x10\x92\x28\x2d\x0b\xe0\x0d\xfa\xce\x1c\x1d\x74\x61\x60\x4f\x9b\x03\xf0\x13\x10\x35\x44\xce\x4d\x5f\x2f\x4f\x30\xab\x16\x79\x71\x4e\x9e\xb1\x09\xae\x2c\x8a\x17\x4b\x91\xee\x39\xd1\x2a\x76\xcc\xd1\xd1\x2a\xc8\x9e\xf0\x11\xc6\xf5\xf7\x06\x17\x52\x16\xb5\x4a\xd5\x4e\x77\xc0\x77\x70\x1a\xcb\x5f\x3e\x73\xf8\xaf\x52\xdd\xde\x55\xaa\x80\x5f\xfe\x5f\x24\xc0\xb7\xcd\x67\x4a\x93\xf9\x7a\x72\x72\x98\xf6\x50\xb7\x44\xeb\xd8\xd3\xf0\xe3\xb7\x32\xf7\x62\x71\x32\x25\x97\x33\xe6\x21\x74\xc7\xf8\x0c\x2e\x17\x75\x7b\x9b\x9d\xed\x3e\x42\x72\x3e\xec\x1a\x16\x60\x4f\xe6\x7b\x00\xd7\xd4\x31\xc2\xe4\x01\x00\x00")
 
 func bindataAssetsEfaManagedBoothookBytes() ([]byte, error) {
 	return bindataRead(
@@ -238,7 +238,7 @@ func bindataAssetsEfaManagedBoothook() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsInstallSsmAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xca\x41\x0a\x02\x31\x0c\x05\xd0\x7d\x4f\x11\x71\x5d\xe6\x4c\xa9\x44\x0d\xa4\xe9\xd0\xff\x07\xac\xa7\x77\x35\x2b\x61\x96\x0f\xde\xfd\xb6\x35\xcf\xad\x29\xde\xa5\xc0\x28\x75\x88\xcd\x69\x1f\xe7\xc9\xdd\x77\x7b\xaa\xc7\xe9\x1c\x47\xc2\x58\xca\x3a\xba\x78\x82\x1a\x21\x75\x89\x76\xfd\x8e\xac\x40\xaf\xfa\xb2\x64\xc1\x02\xad\x3f\x18\x62\xa9\x2d\xec\x6a\x80\x3a\xf9\x1f\x7e\x01\x00\x00\xff\xff\x93\x2c\xf6\x43\x9f\x00\x00\x00")
+var _bindataAssetsInstallSsmAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xca\x41\x0a\x02\x31\x0c\x05\xd0\x7d\x4f\x11\x71\x5d\xe6\x4c\xa9\x44\x0d\xa4\xe9\xd0\xff\x07\xac\xa7\x77\x35\x2b\x61\x96\x0f\xde\xfd\xb6\x35\xcf\xad\x29\xde\xa5\xc0\x28\x75\x88\xcd\x69\x1f\xe7\xc9\xdd\x77\x7b\xaa\xc7\xe9\x1c\x47\xc2\x58\xca\x3a\xba\x78\x82\x1a\x21\x75\x89\x76\xfd\x8e\xac\x40\xaf\xfa\xb2\x64\xc1\x02\xad\x3f\x18\x62\xa9\x2d\xec\x6a\x80\x3a\xf9\x1f\x7e\x03\x00\x93\x2c\xf6\x43\x9f\x00\x00\x00")
 
 func bindataAssetsInstallSsmAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -258,7 +258,7 @@ func bindataAssetsInstallSsmAl2Sh() (*asset, error) {
 	return a, nil
 }
 
-var _bindataAssetsKubeletYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xc1\xca\xdb\x30\x10\x84\xef\x7a\x8a\x85\x5e\x8b\xed\xb4\x04\x5a\xdd\xd2\x84\xf6\xd0\x40\xa0\x71\xdb\xf3\x5a\x1a\x37\xc2\xb2\x36\x48\xeb\xa4\xed\xd3\x97\xd8\xee\x0f\x81\x1f\x9d\x86\x99\x61\x3e\x49\x6f\xa8\x3d\x1d\x4e\x74\xc0\x35\xc3\xb1\xc2\xbf\xa5\x7b\x88\x91\x3a\x50\xc6\x28\x37\x78\x2a\x22\xc9\x0c\x21\x79\x4b\x5f\xa7\x0e\x11\xba\x97\xd4\x87\x5f\x53\x66\x0d\x92\x0c\x5f\xc3\x0f\xe4\x12\x24\x59\x1a\x96\x40\xe5\xe6\x44\x35\x7c\x28\x55\x90\xfa\xb6\xe9\xa0\xbc\x31\x86\xbd\xcf\x28\xc5\x52\x53\xcd\xc7\xb8\x38\x15\x45\x3e\xc8\xc8\x21\x59\x5a\x65\x15\xc5\x71\x34\x86\x27\xbd\x20\x69\x70\xf3\x90\x35\x44\x9c\x24\xfd\x19\x65\x2a\x0f\x41\x84\xc4\x5d\x84\xb7\xd4\x73\x2c\x30\x44\x77\x74\x17\x91\x61\x71\x1d\xbb\x0b\xda\xf6\x68\xe9\xdd\xd8\x94\xe7\x82\xe6\xe9\x91\xff\xbd\x6d\x3e\xae\xe1\x18\x90\x74\xbf\xfb\x1c\x22\x2c\xd5\x50\x57\x63\x28\x4e\x63\xed\xb8\x72\x59\x17\x1a\xc9\xe1\xef\x0b\xcc\x28\x1e\x96\x7e\x2e\x93\xaf\x8e\xef\xd6\x0a\xfc\x8c\xb1\xfd\x8f\x31\x9b\xdf\x13\x3f\xdb\xef\x9b\x62\x4c\x41\xbe\x21\xb7\xc7\xf3\x27\x11\x2d\x9a\xf9\xba\xc2\x9a\x1e\xac\x53\xc6\x17\x56\xcc\xd7\xff\x26\xca\x8a\xf5\x4b\xce\x73\x6d\x8f\xac\xa1\x7f\xbc\x17\xd6\xd6\xbf\x00\x00\x00\xff\xff\x46\x42\xbb\xf2\xe0\x01\x00\x00")
+var _bindataAssetsKubeletYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xc1\xcb\x13\x31\x14\xc4\xef\xf9\x2b\x1e\x78\x95\xdd\xfd\x94\x0f\x34\xb7\xcf\x16\x3d\x58\x28\xd8\x55\xcf\x6f\x93\x59\x1b\x36\x9b\x57\x92\x97\x56\xfd\xeb\xa5\xbb\xab\x50\x90\x9c\x86\x99\x61\x7e\x49\x5e\x51\x7f\xdc\x1f\x69\x8f\x4b\x86\x63\x85\x7f\x4d\xb7\x10\x23\x0d\xa0\x8c\x59\xae\xf0\x54\x44\x92\x99\x42\xf2\x96\x3e\xd7\x01\x11\xba\x93\x34\x86\x1f\x35\xb3\x06\x49\x86\x2f\xe1\x1b\x72\x09\x92\x2c\x4d\x6b\xa0\x71\x4b\xa2\x99\xde\x95\x26\x48\x7b\x7d\x1a\xa0\xfc\x64\x0c\x7b\x9f\x51\x8a\xa5\xae\x59\x8e\x71\xb1\x16\x45\xde\xcb\xcc\x21\x59\xda\x64\x13\xc5\x71\x34\x86\xab\x9e\x91\x34\xb8\x65\xc8\x1a\x22\x4e\x92\x7e\xcd\x52\xcb\x5d\x10\x21\xf1\x10\xe1\x2d\x8d\x1c\x0b\x0c\xd1\x0d\xc3\x59\x64\x5a\x5d\xc7\xee\x8c\xbe\x3f\x58\x7a\x33\x77\xe5\xb1\xa0\xb9\xde\xf3\x3f\x9f\xbb\xf7\x5b\x38\x06\x24\xdd\xbd\x7c\x0c\x11\x96\x5a\xa8\x6b\x31\x15\xa7\xb1\x75\xdc\xb8\xac\x2b\x8d\xe4\xf0\xfb\x1f\xcc\x2c\x1e\x96\xbe\xaf\x93\xff\x1d\x7f\xd9\x2a\xf0\x0b\xc6\xf3\x5f\x8c\xc5\xfc\x9a\xf8\xd1\x7e\xdb\x15\x63\x0a\xf2\x15\xb9\x3f\x9c\x3e\x88\x68\xd1\xcc\x97\x0d\xd6\x8c\x60\xad\x19\x9f\x58\xb1\x5c\xff\x8b\x28\x2b\xb6\x2f\x39\x2d\xb5\x1d\xb2\x86\xf1\xfe\x5e\xb0\xa4\xb9\xc2\xfc\x19\x00\x46\x42\xbb\xf2\xe0\x01\x00\x00")
 
 func bindataAssetsKubeletYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -6,19 +6,74 @@ set -o nounset
 
 source /var/lib/cloud/scripts/eksctl/bootstrap.helper.sh
 
+# merge_toml prints the TOML file $1 with the keys of the TOML file $2 merged in: the keys of a table of $2
+# replace the same keys of that table in $1, and the tables of $2 that $1 does not have are appended.
+# Multi-line arrays are kept whole and the elements of arrays of tables are never merged: those of $1 are kept
+# as they are and those of $2 are appended.
+# containerd replaces whole plugin tables with the ones of imported files, so the config must be merged instead
+function merge_toml() {
+  awk '
+    function trim(s) { gsub(/^[[:space:]]+|[[:space:]]+$/, "", s); return s }
+    function key(line) { split(line, parts, "="); return trim(parts[1]) }
+    function brackets(line) { gsub(/"[^"]*"/, "", line); sub(/#.*/, "", line); return gsub(/\[/, "", line) - gsub(/\]/, "", line) }
+    function merge(table) { if (table in keys) { printf "%s", keys[table]; merged[table] = 1 } }
+    function add(table) { if (!(table in added)) { added[table] = 1; order[++tables] = table } }
+    FNR == NR {
+      if (open > 0) { keys[table] = keys[table] $0 "\n"; open += brackets($0); next }
+      if ($0 ~ /^[[:space:]]*\[\[/) { table = trim($0) SUBSEP (++elements); headers[table] = trim($0); add(table); next }
+      if ($0 ~ /^[[:space:]]*\[/) { table = trim($0); headers[table] = table; next }
+      if ($0 ~ /^[[:space:]]*(#.*)?$/) next
+      add(table)
+      keys[table] = keys[table] trim($0) "\n"
+      overridden[table, key($0)] = 1
+      open = brackets($0)
+      next
+    }
+    FNR == 1 { merge(table = "") }
+    skip > 0 { skip += brackets($0); next }
+    keep > 0 { keep += brackets($0); print; next }
+    /^[[:space:]]*\[\[/ { table = SUBSEP; print; next }
+    /^[[:space:]]*\[/ { table = trim($0); print; merge(table); next }
+    /^[[:space:]]*[^#[:space:]]/ && ((table, key($0)) in overridden) { skip = brackets($0); next }
+    { keep = brackets($0); print }
+    END {
+      for (i = 1; i <= tables; i++) {
+        if (order[i] in merged) continue
+        if (order[i] == "") printf "%s", keys[order[i]]
+        else printf "\n%s\n%s", headers[order[i]], keys[order[i]]
+      }
+    }
+  ' "$2" "$1"
+}
+
+IP_FAMILY_ARGS=()
+[[ -n "${SERVICE_IPV6_CIDR}" ]] && IP_FAMILY_ARGS=(--ip-family ipv6 --service-ipv6-cidr "${SERVICE_IPV6_CIDR}")
+
 echo "eksctl: running /etc/eks/bootstrap"
 /etc/eks/bootstrap.sh "${CLUSTER_NAME}" \
   --apiserver-endpoint "${API_SERVER_URL}" \
   --b64-cluster-ca "${B64_CLUSTER_CA}" \
   --dns-cluster-ip "${CLUSTER_DNS}" \
   --kubelet-extra-args "${KUBELET_EXTRA_ARGS}" \
-  --container-runtime "${CONTAINER_RUNTIME}"
+  --container-runtime "${CONTAINER_RUNTIME}" \
+  ${IP_FAMILY_ARGS[@]+"${IP_FAMILY_ARGS[@]}"}
 
 echo "eksctl: merging user options into kubelet-config.json"
 trap 'rm -f ${TMP_KUBE_CONF}' EXIT
 jq -s '.[0] * .[1]' "${KUBELET_CONFIG}" "${KUBELET_EXTRA_CONFIG}" > "${TMP_KUBE_CONF}"
 mv "${TMP_KUBE_CONF}" "${KUBELET_CONFIG}"
 
+EXTRA_CONTAINERD_CONFIG='/etc/eksctl/containerd-extra.toml'
+CONTAINERD_CONFIG='/etc/containerd/config.toml'
+TMP_CONTAINERD_CONF='/tmp/containerd-config.toml'
+if [[ "${CONTAINER_RUNTIME}" == "containerd" && -f "${EXTRA_CONTAINERD_CONFIG}" ]]; then
+  echo "eksctl: merging user options into containerd config.toml"
+  merge_toml "${CONTAINERD_CONFIG}" "${EXTRA_CONTAINERD_CONFIG}" > "${TMP_CONTAINERD_CONF}"
+  mv "${TMP_CONTAINERD_CONF}" "${CONTAINERD_CONFIG}"
+  echo "eksctl: restarting containerd"
+  systemctl restart containerd
+fi
+
 systemctl daemon-reload
 echo "eksctl: restarting kubelet-eks"
 systemctl restart kubelet
@@ -17,9 +17,18 @@ B64_CLUSTER_CA="${B64_CLUSTER_CA}"
 INSTANCE_ID="$(get_metadata instance-id)"
 INSTANCE_LIFECYCLE="$(get_metadata instance-life-cycle)"
 CLUSTER_DNS="${CLUSTER_DNS:-}"
+SERVICE_IPV6_CIDR="${SERVICE_IPV6_CIDR:-}"
 NODE_TAINTS="${NODE_TAINTS:-}"
 MAX_PODS="${MAX_PODS:-}"
+CAPACITY_TYPE_LABEL="${CAPACITY_TYPE_LABEL:-}"
 NODE_LABELS="${NODE_LABELS},node-lifecycle=${INSTANCE_LIFECYCLE},alpha.eksctl.io/instance-id=${INSTANCE_ID}"
+if [[ -n "${CAPACITY_TYPE_LABEL}" ]]; then
+  # the same values as the capacity type of managed nodegroups
+  case "${INSTANCE_LIFECYCLE}" in
+  spot) NODE_LABELS+=",${CAPACITY_TYPE_LABEL}=SPOT" ;;
+  *) NODE_LABELS+=",${CAPACITY_TYPE_LABEL}=ON_DEMAND" ;;
+  esac
+fi
 
 KUBELET_ARGS=("--node-labels=${NODE_LABELS}")
 [[ -n "${NODE_TAINTS}" ]] && KUBELET_ARGS+=("--register-with-taints=${NODE_TAINTS}")
@@ -78,7 +78,7 @@ func setDerivedBottlerocketSettings(np api.NodePool) error {
 	if ng.MaxPodsPerNode != 0 {
 		kubernetesSettings["max-pods"] = ng.MaxPodsPerNode
 	}
-	if taints := np.NGTaints(); len(taints) != 0 {
+	if taints := registerWithTaints(np); len(taints) != 0 {
 		kubernetesSettings["node-taints"] = taintsToMap(taints)
 	}
 
@@ -212,6 +212,37 @@ var _ = Describe("Bottlerocket", func() {
 			})
 		})
 
+		When("startup taints are set on the node", func() {
+			BeforeEach(func() {
+				ng.Taints = []api.NodeGroupTaint{
+					{
+						Key:    "foo",
+						Value:  "bar",
+						Effect: "NoExecute",
+					},
+				}
+				ng.StartupTaints = []api.NodeGroupTaint{
+					{
+						Key:    "nvidia.com/gpu-driver",
+						Value:  "installing",
+						Effect: "NoSchedule",
+					},
+				}
+			})
+
+			It("adds the taints and the startup taints to the userdata", func() {
+				bootstrapper := newBootstrapper(clusterConfig, ng)
+				userdata, err := bootstrapper.UserData()
+				Expect(err).ToNot(HaveOccurred())
+
+				tree, parseErr := userdataTOML(userdata)
+				Expect(parseErr).ToNot(HaveOccurred())
+
+				Expect(tree.GetPath(append(taintsPath, "foo"))).To(Equal("bar:NoExecute"))
+				Expect(tree.GetPath(append(taintsPath, "nvidia.com/gpu-driver"))).To(Equal("installing:NoSchedule"))
+			})
+		})
+
 		When("clusterDNS is set", func() {
 			It("adds clusterDNS to the userdata", func() {
 				ng.ClusterDNS = "192.2.0.53"
@@ -8,14 +8,18 @@ import (
 )
 
 type clusterDNSEntry struct {
-	clusterStatus *api.ClusterStatus
+	clusterStatus           *api.ClusterStatus
+	kubernetesNetworkConfig *api.KubernetesNetworkConfig
 
 	expectedClusterDNS string
 	expectedErr        string
 }
 
 var _ = DescribeTable("Cluster DNS", func(c clusterDNSEntry) {
-	clusterDNS, err := nodebootstrap.GetClusterDNS(&api.ClusterConfig{Status: c.clusterStatus})
+	clusterDNS, err := nodebootstrap.GetClusterDNS(&api.ClusterConfig{
+		Status:                  c.clusterStatus,
+		KubernetesNetworkConfig: c.kubernetesNetworkConfig,
+	})
 	if c.expectedErr != "" {
 		Expect(err).To(HaveOccurred())
 		Expect(err).To(MatchError(ContainSubstring(c.expectedErr)))
@@ -43,11 +47,111 @@ var _ = DescribeTable("Cluster DNS", func(c clusterDNSEntry) {
 		expectedClusterDNS: "172.16.0.10",
 	}),
 
+	Entry("172.20.0.0/16 ServiceIPv4CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv4CIDR: "172.20.0.0/16",
+			},
+		},
+		expectedClusterDNS: "172.20.0.10",
+	}),
+
+	Entry("ServiceIPv4CIDR not given as a network address", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv4CIDR: "10.100.5.1/16",
+			},
+		},
+		expectedClusterDNS: "10.100.0.10",
+	}),
+
+	Entry("/12 ServiceIPv4CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv4CIDR: "10.96.0.0/12",
+			},
+		},
+		expectedClusterDNS: "10.96.0.10",
+	}),
+
+	Entry("/12 ServiceIPv4CIDR not given as a network address", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv4CIDR: "10.100.0.0/12",
+			},
+		},
+		expectedClusterDNS: "10.96.0.10",
+	}),
+
+	Entry("ServiceIPv4CIDR from the config when the status is not known", clusterDNSEntry{
+		kubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+			ServiceIPv4CIDR: "172.20.0.0/16",
+		},
+		expectedClusterDNS: "172.20.0.10",
+	}),
+
+	Entry("ServiceIPv4CIDR from the status takes precedence over the config", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv4CIDR: "10.100.0.0/16",
+			},
+		},
+		kubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+			ServiceIPv4CIDR: "172.20.0.0/16",
+		},
+		expectedClusterDNS: "10.100.0.10",
+	}),
+
 	Entry("empty ServiceIPv4CIDR", clusterDNSEntry{
 		clusterStatus:      &api.ClusterStatus{},
 		expectedClusterDNS: "",
 	}),
 
+	Entry("ServiceIPv6CIDR of an IPv6 cluster", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				IPFamily:        api.IPV6Family,
+				ServiceIPv6CIDR: "fd30:1c53:5f8a::/108",
+			},
+		},
+		kubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+			IPFamily: api.IPV6Family,
+		},
+		expectedClusterDNS: "fd30:1c53:5f8a::a",
+	}),
+
+	Entry("ServiceIPv6CIDR not given as a network address", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv6CIDR: "fd30:1c53:5f8a::5:1/108",
+			},
+		},
+		kubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+			IPFamily: "ipv6",
+		},
+		expectedClusterDNS: "fd30:1c53:5f8a::a",
+	}),
+
+	Entry("IPv6 cluster without a known ServiceIPv6CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{},
+		kubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+			IPFamily: api.IPV6Family,
+		},
+		expectedErr: "the service IPv6 CIDR of the cluster must be known to derive the cluster DNS address of IPv6 nodes",
+	}),
+
+	Entry("IPv4 CIDR reported as the ServiceIPv6CIDR", clusterDNSEntry{
+		clusterStatus: &api.ClusterStatus{
+			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+				ServiceIPv6CIDR: "10.100.0.0/16",
+			},
+		},
+		kubernetesNetworkConfig: &api.KubernetesNetworkConfig{
+			IPFamily: api.IPV6Family,
+		},
+		expectedErr: `the service IPv6 CIDR of the cluster is not an IPv6 CIDR: "10.100.0.0/16"`,
+	}),
+
 	Entry("invalid CIDR", clusterDNSEntry{
 		clusterStatus: &api.ClusterStatus{
 			KubernetesNetworkConfig: &api.KubernetesNetworkConfig{
@@ -0,0 +1,48 @@
+package nodebootstrap
+
+import (
+	"sort"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+const extraContainerdConfFile = "containerd-extra.toml"
+
+// makeContainerdConfig returns the containerd configuration the bootstrap script merges key by key into
+// the configuration of the AmazonLinux2 AMI. Registry mirrors are set in the CRI plugin configuration,
+// overriding the mirrors of the same registries set in the config fragment
+func makeContainerdConfig(containerd *api.ContainerdConfig) (cloudconfig.File, error) {
+	tree, err := toml.Load(containerd.ConfigFragment)
+	if err != nil {
+		return cloudconfig.File{}, errors.Wrap(err, "parsing containerd config fragment")
+	}
+	if !tree.Has("version") {
+		tree.Set("version", int64(2))
+	}
+
+	hosts := make([]string, 0, len(containerd.RegistryMirrors))
+	for host := range containerd.RegistryMirrors {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		var endpoints []interface{}
+		for _, endpoint := range containerd.RegistryMirrors[host] {
+			endpoints = append(endpoints, endpoint)
+		}
+		tree.SetPath([]string{"plugins", "io.containerd.grpc.v1.cri", "registry", "mirrors", host, "endpoint"}, endpoints)
+	}
+
+	data, err := tree.ToTomlString()
+	if err != nil {
+		return cloudconfig.File{}, errors.Wrap(err, "encoding containerd config")
+	}
+	return cloudconfig.File{
+		Path:    configDir + extraContainerdConfFile,
+		Content: data,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package nodebootstrap
+
+import (
+	"fmt"
+
+	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/utils"
+)
+
+// Custom is a bootstrapper for custom AMIs that have their own node init mechanism,
+// it only runs the overrideBootstrapCommand with the bootstrap env file loaded
+type Custom struct {
+	clusterConfig *api.ClusterConfig
+	ng            *api.NodeGroup
+}
+
+func NewCustomBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) *Custom {
+	return &Custom{
+		clusterConfig: clusterConfig,
+		ng:            ng,
+	}
+}
+
+func (b *Custom) UserData() (string, error) {
+	if b.ng.OverrideBootstrapCommand == nil {
+		return "", errors.Errorf("overrideBootstrapCommand is required when using amiFamily %s", api.NodeImageFamilyCustom)
+	}
+
+	config := cloudconfig.New()
+	if b.ng.LocalNVMe != nil {
+		config.RunScript(localNVMeScript, makeLocalNVMeScript(b.ng.LocalNVMe))
+	}
+	if len(b.ng.EFSMounts) > 0 {
+		config.RunScript(efsMountsScript, makeEFSMountsScript(b.ng.EFSMounts))
+	}
+	for _, command := range b.ng.PreBootstrapCommands {
+		config.AddShellCommand(command)
+	}
+
+	envFile := makeBootstrapEnv(b.clusterConfig, b.ng)
+	config.AddFile(envFile)
+	config.AddShellCommand(fmt.Sprintf("set -a && source %s && set +a && %s", envFile.Path, *b.ng.OverrideBootstrapCommand))
+
+	body, err := utils.EncodeUserData(config, b.ng.NodeGroupBase)
+	if err != nil {
+		return "", errors.Wrap(err, "encoding user data")
+	}
+
+	logger.Debug("user-data = %s", body)
+	return body, nil
+}
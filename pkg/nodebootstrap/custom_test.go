@@ -0,0 +1,99 @@
+package nodebootstrap_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+var _ = Describe("Custom User Data", func() {
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+		bootstrapper  nodebootstrap.Bootstrapper
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "something-awesome"
+		clusterConfig.Status = &api.ClusterStatus{
+			Endpoint:                 "https://test.xxx.us-west-2.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("CA"),
+		}
+		override := "/opt/node-init/start.sh"
+		ng = &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				AMIFamily:                api.NodeImageFamilyCustom,
+				AMI:                      "ami-123",
+				OverrideBootstrapCommand: &override,
+			},
+		}
+	})
+
+	Context("standard userdata", func() {
+		var (
+			err      error
+			userData string
+		)
+
+		BeforeEach(func() {
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+			userData, err = bootstrapper.UserData()
+		})
+
+		It("only adds the boot script environment variable file to the userdata", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.WriteFiles).To(HaveLen(1))
+			Expect(cloudCfg.WriteFiles[0].Path).To(Equal("/etc/eksctl/kubelet.env"))
+			contentLines := strings.Split(cloudCfg.WriteFiles[0].Content, "\n")
+			Expect(contentLines).To(ContainElements(
+				"CLUSTER_NAME=something-awesome",
+				"API_SERVER_URL=https://test.xxx.us-west-2.eks.amazonaws.com",
+				"B64_CLUSTER_CA=Q0E=",
+			))
+		})
+
+		It("runs only the override command with the environment loaded", func() {
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.Commands).To(HaveLen(1))
+			Expect(cloudCfg.Commands[0]).To(ContainElement("set -a && source /etc/eksctl/kubelet.env && set +a && /opt/node-init/start.sh"))
+		})
+	})
+
+	When("PreBootstrapCommands are set", func() {
+		BeforeEach(func() {
+			ng.PreBootstrapCommands = []string{"echo 'rubarb'"}
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("runs them before the override command", func() {
+			userData, err := bootstrapper.UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			cloudCfg := decode(userData)
+			Expect(cloudCfg.Commands).To(HaveLen(2))
+			Expect(cloudCfg.Commands[0]).To(ContainElement("echo 'rubarb'"))
+			Expect(cloudCfg.Commands[1]).To(ContainElement(HaveSuffix("/opt/node-init/start.sh")))
+		})
+	})
+
+	When("OverrideBootstrapCommand is not set", func() {
+		BeforeEach(func() {
+			ng.OverrideBootstrapCommand = nil
+			bootstrapper = newBootstrapper(clusterConfig, ng)
+		})
+
+		It("returns an error", func() {
+			_, err := bootstrapper.UserData()
+			Expect(err).To(MatchError("overrideBootstrapCommand is required when using amiFamily Custom"))
+		})
+	})
+})
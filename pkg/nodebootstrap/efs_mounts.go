@@ -0,0 +1,37 @@
+package nodebootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const efsMountsScript = "efs-mounts.sh"
+
+// makeEFSMountsScript returns a script that installs the EFS mount helper and mounts the file systems.
+// The mounts are added to /etc/fstab with nofail, and a failure to mount them is only logged, so that
+// nodes still join the cluster and mount them on reboot when a file system cannot be reached
+func makeEFSMountsScript(mounts []api.EFSMount) string {
+	var fstab strings.Builder
+	for _, mount := range mounts {
+		fmt.Fprintf(&fstab, "mkdir -p %s\n", mount.MountPath)
+		fmt.Fprintf(&fstab, "echo '%s:/ %s efs _netdev,tls,nofail 0 0' >> /etc/fstab\n", mount.FileSystemID, mount.MountPath)
+	}
+
+	return fmt.Sprintf(`#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+if ! command -v mount.efs >/dev/null 2>&1; then
+  yum install -y amazon-efs-utils
+fi
+
+%s
+if ! mount -a -t efs; then
+  echo "failed to mount the EFS file systems, they will be mounted again on reboot" >&2
+fi
+`, fstab.String())
+}
@@ -0,0 +1,94 @@
+package nodebootstrap_test
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+var _ = Describe("EFS mounts", func() {
+	const scriptPath = "/var/lib/cloud/scripts/eksctl/efs-mounts.sh"
+
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "efs"
+		clusterConfig.Status = &api.ClusterStatus{}
+		ng = &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				AMIFamily:            api.NodeImageFamilyAmazonLinux2,
+				SSH:                  &api.NodeGroupSSH{},
+				PreBootstrapCommands: []string{"echo pre-bootstrap"},
+			},
+		}
+	})
+
+	efsMountsScript := func() string {
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		cloudCfg := decode(userData)
+		for _, f := range cloudCfg.WriteFiles {
+			if f.Path == scriptPath {
+				return f.Content
+			}
+		}
+		return ""
+	}
+
+	It("does not add the script if efsMounts is not set", func() {
+		Expect(efsMountsScript()).To(BeEmpty())
+	})
+
+	It("installs the mount helper and mounts the file systems", func() {
+		ng.EFSMounts = []api.EFSMount{
+			{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"},
+			{FileSystemID: "fs-0fedcba9876543210", MountPath: "/mnt/models"},
+		}
+		script := efsMountsScript()
+		Expect(script).To(ContainSubstring(`if ! command -v mount.efs >/dev/null 2>&1; then
+  yum install -y amazon-efs-utils
+fi
+`))
+		Expect(script).To(ContainSubstring(`mkdir -p /mnt/shared
+echo 'fs-0123456789abcdef0:/ /mnt/shared efs _netdev,tls,nofail 0 0' >> /etc/fstab
+mkdir -p /mnt/models
+echo 'fs-0fedcba9876543210:/ /mnt/models efs _netdev,tls,nofail 0 0' >> /etc/fstab
+
+if ! mount -a -t efs; then
+  echo "failed to mount the EFS file systems, they will be mounted again on reboot" >&2
+fi
+`))
+	})
+
+	It("runs the script before the pre-bootstrap commands", func() {
+		ng.EFSMounts = []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}}
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+
+		cloudCfg := decode(userData)
+		Expect(cloudCfg.Commands[0]).To(Equal([]interface{}{scriptPath}))
+		Expect(cloudCfg.Commands[1]).To(Equal([]interface{}{"/bin/bash", "-c", "echo pre-bootstrap"}))
+	})
+
+	It("adds the script to the user data of managed nodegroups", func() {
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "efs"
+		mng.EFSMounts = []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}}
+		api.SetManagedNodeGroupDefaults(mng, clusterConfig.Metadata)
+
+		userData, err := nodebootstrap.NewManagedAL2Bootstrapper(mng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		decoded, err := base64.StdEncoding.DecodeString(userData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.ReplaceAll(string(decoded), "\r\n", "\n")).To(ContainSubstring("echo 'fs-0123456789abcdef0:/ /mnt/shared efs _netdev,tls,nofail 0 0' >> /etc/fstab\n"))
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/utils"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 )
 
@@ -49,7 +50,7 @@ func (b AL2Bootstrapper) UserData() (string, error) {
 		return "", err
 	}
 
-	body, err := config.Encode()
+	body, err := utils.EncodeUserData(config, b.ng.NodeGroupBase)
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
 	}
@@ -138,12 +138,17 @@ func makeKubeletConfigYAML(spec *api.ClusterConfig, ng *api.NodeGroup) ([]byte,
 		}
 	}
 
+	if ng.KubeletCgroupDriver != nil {
+		obj["cgroupDriver"] = *ng.KubeletCgroupDriver
+	}
+
 	// Add extra configuration from configfile
 	if ng.KubeletExtraConfig != nil {
 		for k, v := range *ng.KubeletExtraConfig {
 			obj[k] = v
 		}
 	}
+	obj = *utils.WithKubeletFeatureGates(&obj, ng.KubeletFeatureGates)
 
 	data, err = yaml.Marshal(obj)
 	if err != nil {
@@ -169,7 +174,7 @@ func kvs(kv map[string]string) string {
 func makeCommonKubeletEnvParams(ng *api.NodeGroup) []string {
 	variables := []string{
 		fmt.Sprintf("NODE_LABELS=%s", kvs(ng.Labels)),
-		fmt.Sprintf("NODE_TAINTS=%s", utils.FormatTaints(ng.Taints)),
+		fmt.Sprintf("NODE_TAINTS=%s", utils.FormatTaints(ng.RegisterWithTaints())),
 	}
 
 	if ng.MaxPodsPerNode != 0 {
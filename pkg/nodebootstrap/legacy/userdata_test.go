@@ -7,6 +7,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/aws/aws-sdk-go/aws"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	kubeletapi "k8s.io/kubelet/config/v1beta1"
 	"sigs.k8s.io/yaml"
@@ -48,6 +49,34 @@ var _ = Describe("User data", func() {
 			Expect(errUnmarshal).ToNot(HaveOccurred())
 		})
 
+		It("only sets the cgroup driver when kubeletCgroupDriver is set", func() {
+			getCgroupDriver := func() string {
+				data, err := makeKubeletConfigYAML(clusterConfig, ng)
+				Expect(err).ToNot(HaveOccurred())
+
+				kubelet := kubeletapi.KubeletConfiguration{}
+				Expect(yaml.UnmarshalStrict(data, &kubelet)).To(Succeed())
+				return kubelet.CgroupDriver
+			}
+
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+			ng.ContainerRuntime = aws.String(api.ContainerRuntimeContainerD)
+			Expect(getCgroupDriver()).To(BeEmpty())
+
+			ng.KubeletCgroupDriver = aws.String(api.KubeletCgroupDriverSystemd)
+			Expect(getCgroupDriver()).To(Equal(api.KubeletCgroupDriverSystemd))
+		})
+
+		It("merges the feature gates into the default feature gates", func() {
+			ng.KubeletFeatureGates = map[string]bool{"GracefulNodeShutdown": true}
+			data, err := makeKubeletConfigYAML(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			kubelet := kubeletapi.KubeletConfiguration{}
+			Expect(yaml.UnmarshalStrict(data, &kubelet)).To(Succeed())
+			Expect(kubelet.FeatureGates).To(Equal(map[string]bool{"RotateKubeletServerCertificate": true, "GracefulNodeShutdown": true}))
+		})
+
 		It("does not contain default kube reservations for unknown instances", func() {
 			ng.InstanceType = "dne.small"
 			data, err := makeKubeletConfigYAML(clusterConfig, ng)
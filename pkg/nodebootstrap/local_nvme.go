@@ -0,0 +1,59 @@
+package nodebootstrap
+
+import (
+	"fmt"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const localNVMeScript = "local-nvme.sh"
+
+// makeLocalNVMeScript returns a script that formats and mounts the NVMe instance store volumes.
+// Their number is only known on the instance, so the script handles instances without any,
+// with a single one and with several ones
+func makeLocalNVMeScript(nvme *api.LocalNVMe) string {
+	mountPath, mode := nvme.MountPath, nvme.Mode
+	if mountPath == "" {
+		mountPath = api.DefaultLocalNVMeMountPath
+	}
+	if mode == "" {
+		mode = api.LocalNVMeModeRAID0
+	}
+
+	multipleDevices := `  echo "using the first of ${#DEVICES[@]} NVMe instance store volumes"
+  DEVICE="${DEVICES[0]}"`
+	if mode == api.LocalNVMeModeRAID0 {
+		multipleDevices = `  echo "assembling ${#DEVICES[@]} NVMe instance store volumes into a RAID0 array"
+  DEVICE=/dev/md/local-nvme
+  mdadm --create "${DEVICE}" --run --level=0 --raid-devices="${#DEVICES[@]}" "${DEVICES[@]}"`
+	}
+
+	return fmt.Sprintf(`#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+MOUNT_PATH=%s
+
+mapfile -t DEVICES < <(find /dev/disk/by-id/ -xtype l -name '*NVMe_Instance_Storage_*' -exec realpath {} \; | sort -u)
+
+case "${#DEVICES[@]}" in
+0)
+  echo "no NVMe instance store volumes found, not mounting ${MOUNT_PATH}"
+  exit 0
+  ;;
+1)
+  DEVICE="${DEVICES[0]}"
+  ;;
+*)
+%s
+  ;;
+esac
+
+mkfs.xfs -f "${DEVICE}"
+mkdir -p "${MOUNT_PATH}"
+mount -o defaults,noatime "${DEVICE}" "${MOUNT_PATH}"
+echo "UUID=$(blkid -s UUID -o value "${DEVICE}") ${MOUNT_PATH} xfs defaults,noatime,nofail 0 2" >> /etc/fstab
+`, mountPath, multipleDevices)
+}
@@ -0,0 +1,119 @@
+package nodebootstrap_test
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+var _ = Describe("Local NVMe instance store volumes", func() {
+	const scriptPath = "/var/lib/cloud/scripts/eksctl/local-nvme.sh"
+
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "nvme"
+		clusterConfig.Status = &api.ClusterStatus{}
+		ng = &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				AMIFamily:            api.NodeImageFamilyAmazonLinux2,
+				SSH:                  &api.NodeGroupSSH{},
+				PreBootstrapCommands: []string{"echo pre-bootstrap"},
+			},
+		}
+	})
+
+	localNVMeScript := func() string {
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		cloudCfg := decode(userData)
+		for _, f := range cloudCfg.WriteFiles {
+			if f.Path == scriptPath {
+				return f.Content
+			}
+		}
+		return ""
+	}
+
+	It("does not add the script if localNVMe is not set", func() {
+		Expect(localNVMeScript()).To(BeEmpty())
+	})
+
+	It("runs the script before the pre-bootstrap commands", func() {
+		ng.LocalNVMe = &api.LocalNVMe{}
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+
+		cloudCfg := decode(userData)
+		Expect(cloudCfg.Commands[0]).To(Equal([]interface{}{scriptPath}))
+		Expect(cloudCfg.Commands[1]).To(Equal([]interface{}{"/bin/bash", "-c", "echo pre-bootstrap"}))
+	})
+
+	It("mounts the volumes at the given path", func() {
+		ng.LocalNVMe = &api.LocalNVMe{MountPath: "/mnt/cache"}
+		Expect(localNVMeScript()).To(ContainSubstring("MOUNT_PATH=/mnt/cache\n"))
+
+		ng.LocalNVMe = &api.LocalNVMe{}
+		Expect(localNVMeScript()).To(ContainSubstring("MOUNT_PATH=/mnt/nvme\n"))
+	})
+
+	type diskCountEntry struct {
+		mode     string
+		expected []string
+	}
+
+	DescribeTable("handles each number of instance store volumes", func(e diskCountEntry) {
+		ng.LocalNVMe = &api.LocalNVMe{Mode: e.mode}
+		script := localNVMeScript()
+		Expect(script).To(ContainSubstring(`case "${#DEVICES[@]}" in`))
+		for _, expected := range e.expected {
+			Expect(script).To(ContainSubstring(expected))
+		}
+	},
+		Entry("without volumes", diskCountEntry{
+			expected: []string{`0)
+  echo "no NVMe instance store volumes found, not mounting ${MOUNT_PATH}"
+  exit 0`},
+		}),
+		Entry("with one volume", diskCountEntry{
+			expected: []string{`1)
+  DEVICE="${DEVICES[0]}"`, `mkfs.xfs -f "${DEVICE}"`, `mount -o defaults,noatime "${DEVICE}" "${MOUNT_PATH}"`},
+		}),
+		Entry("with several volumes in raid0 mode", diskCountEntry{
+			mode: api.LocalNVMeModeRAID0,
+			expected: []string{`*)
+  echo "assembling ${#DEVICES[@]} NVMe instance store volumes into a RAID0 array"
+  DEVICE=/dev/md/local-nvme
+  mdadm --create "${DEVICE}" --run --level=0 --raid-devices="${#DEVICES[@]}" "${DEVICES[@]}"`},
+		}),
+		Entry("with several volumes in single mode", diskCountEntry{
+			mode: api.LocalNVMeModeSingle,
+			expected: []string{`*)
+  echo "using the first of ${#DEVICES[@]} NVMe instance store volumes"
+  DEVICE="${DEVICES[0]}"`},
+		}),
+	)
+
+	It("adds the script to the user data of managed nodegroups", func() {
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "nvme"
+		mng.LocalNVMe = &api.LocalNVMe{MountPath: "/mnt/cache"}
+		api.SetManagedNodeGroupDefaults(mng, clusterConfig.Metadata)
+
+		userData, err := nodebootstrap.NewManagedAL2Bootstrapper(mng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		decoded, err := base64.StdEncoding.DecodeString(userData)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.ReplaceAll(string(decoded), "\r\n", "\n")).To(ContainSubstring("MOUNT_PATH=/mnt/cache\n"))
+	})
+})
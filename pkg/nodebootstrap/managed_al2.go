@@ -11,6 +11,7 @@ import (
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap/utils"
 )
 
 // ManagedAL2 is a bootstrapper for managed Amazon Linux 2 nodegroups
@@ -41,14 +42,27 @@ func (m *ManagedAL2) UserData() (string, error) {
 		cloudboot []string
 	)
 
+	if ng.LocalNVMe != nil {
+		scripts = append(scripts, makeLocalNVMeScript(ng.LocalNVMe))
+	}
+
+	if len(ng.EFSMounts) > 0 {
+		scripts = append(scripts, makeEFSMountsScript(ng.EFSMounts))
+	}
+
 	if len(ng.PreBootstrapCommands) > 0 {
 		scripts = append(scripts, ng.PreBootstrapCommands...)
 	}
 
 	if ng.OverrideBootstrapCommand != nil {
 		scripts = append(scripts, *ng.OverrideBootstrapCommand)
-	} else if ng.MaxPodsPerNode != 0 {
-		scripts = append(scripts, makeMaxPodsScript(ng.MaxPodsPerNode))
+	} else {
+		if ng.MaxPodsPerNode != 0 {
+			scripts = append(scripts, makeMaxPodsScript(ng.MaxPodsPerNode))
+		}
+		if ng.DNS != nil {
+			scripts = append(scripts, makeManagedNodeDNSScript(ng.DNS))
+		}
 	}
 
 	if api.IsEnabled(ng.EFAEnabled) {
@@ -59,11 +73,14 @@ func (m *ManagedAL2) UserData() (string, error) {
 		cloudboot = append(cloudboot, data)
 	}
 
-	if len(scripts) == 0 && len(cloudboot) == 0 {
+	if len(scripts) == 0 && len(cloudboot) == 0 && len(ng.AdditionalUserDataParts) == 0 {
 		return "", nil
 	}
 
-	if err := createMimeMessage(&buf, scripts, cloudboot, m.UserDataMimeBoundary); err != nil {
+	if err := createMimeMessage(&buf, scripts, cloudboot, ng.AdditionalUserDataParts, m.UserDataMimeBoundary); err != nil {
+		return "", err
+	}
+	if err := utils.ValidateUserDataSize(buf.Len()); err != nil {
 		return "", err
 	}
 
@@ -76,6 +93,14 @@ func makeCustomAMIUserData(ng *api.NodeGroupBase, mimeBoundary string) (string,
 		scripts []string
 	)
 
+	if ng.LocalNVMe != nil {
+		scripts = append(scripts, makeLocalNVMeScript(ng.LocalNVMe))
+	}
+
+	if len(ng.EFSMounts) > 0 {
+		scripts = append(scripts, makeEFSMountsScript(ng.EFSMounts))
+	}
+
 	if len(ng.PreBootstrapCommands) > 0 {
 		scripts = append(scripts, ng.PreBootstrapCommands...)
 	}
@@ -84,11 +109,14 @@ func makeCustomAMIUserData(ng *api.NodeGroupBase, mimeBoundary string) (string,
 		scripts = append(scripts, *ng.OverrideBootstrapCommand)
 	}
 
-	if len(scripts) == 0 {
+	if len(scripts) == 0 && len(ng.AdditionalUserDataParts) == 0 {
 		return "", nil
 	}
 
-	if err := createMimeMessage(&buf, scripts, nil, mimeBoundary); err != nil {
+	if err := createMimeMessage(&buf, scripts, nil, ng.AdditionalUserDataParts, mimeBoundary); err != nil {
+		return "", err
+	}
+	if err := utils.ValidateUserDataSize(buf.Len()); err != nil {
 		return "", err
 	}
 
@@ -105,7 +133,7 @@ KUBELET_CONFIG=/etc/kubernetes/kubelet/kubelet-config.json
 	return script
 }
 
-func createMimeMessage(writer io.Writer, scripts, cloudboots []string, mimeBoundary string) error {
+func createMimeMessage(writer io.Writer, scripts, cloudboots []string, userDataParts []api.UserDataPart, mimeBoundary string) error {
 	mw := multipart.NewWriter(writer)
 	if mimeBoundary != "" {
 		if err := mw.SetBoundary(mimeBoundary); err != nil {
@@ -141,5 +169,8 @@ func createMimeMessage(writer io.Writer, scripts, cloudboots []string, mimeBound
 			return err
 		}
 	}
+	if err := utils.WriteUserDataParts(mw, userDataParts); err != nil {
+		return err
+	}
 	return mw.Close()
 }
@@ -0,0 +1,78 @@
+package nodebootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	nodeDNSScript = "node-dns.sh"
+	// nodeResolvConf is the resolv.conf kubelet is pointed at, it is written by the node DNS script
+	nodeResolvConf = configDir + "resolv.conf"
+)
+
+// makeNodeDNSScript returns a script that writes a resolv.conf with the nameservers of the node,
+// and its search domains and options followed by those of dns. The resolv.conf of systemd-resolved
+// is used when there is one, as /etc/resolv.conf only points at its local stub resolver then
+func makeNodeDNSScript(dns *api.NodeGroupDNS) string {
+	return fmt.Sprintf(`#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+SEARCH_DOMAINS="%s"
+OPTIONS="%s"
+RESOLV_CONF=%s
+
+SOURCE=/etc/resolv.conf
+if [ -f /run/systemd/resolve/resolv.conf ]; then
+  SOURCE=/run/systemd/resolve/resolv.conf
+fi
+
+existing() {
+  awk -v key="$1" '$1 == key { for (i = 2; i <= NF; i++) printf "%%s ", $i }' "${SOURCE}"
+}
+
+SEARCH_DOMAINS="$(existing search)${SEARCH_DOMAINS}"
+OPTIONS="$(existing options)${OPTIONS}"
+
+mkdir -p "$(dirname "${RESOLV_CONF}")"
+{
+  grep '^nameserver' "${SOURCE}" || true
+  if [ -n "${SEARCH_DOMAINS}" ]; then
+    echo "search ${SEARCH_DOMAINS}"
+  fi
+  if [ -n "${OPTIONS}" ]; then
+    echo "options ${OPTIONS}"
+  fi
+} > "${RESOLV_CONF}"
+`, strings.Join(dns.SearchDomains, " "), strings.Join(dns.Options, " "), nodeResolvConf)
+}
+
+// makeManagedNodeDNSScript returns the node DNS script for the AMIs of managed nodegroups, whose
+// kubelet config is patched before the bootstrap script of the AMI is run
+func makeManagedNodeDNSScript(dns *api.NodeGroupDNS) string {
+	return makeNodeDNSScript(dns) + fmt.Sprintf(`
+KUBELET_CONFIG=/etc/kubernetes/kubelet/kubelet-config.json
+echo "$(jq '.resolvConf="%s"' "${KUBELET_CONFIG}")" > "${KUBELET_CONFIG}"
+`, nodeResolvConf)
+}
+
+// withNodeDNS returns a copy of kubeletExtraConf pointing kubelet at the resolv.conf written by
+// the node DNS script, which kubelet passes on to the pods
+func withNodeDNS(kubeletExtraConf *api.InlineDocument, dns *api.NodeGroupDNS) *api.InlineDocument {
+	if dns == nil {
+		return kubeletExtraConf
+	}
+	conf := api.InlineDocument{}
+	if kubeletExtraConf != nil {
+		for k, v := range *kubeletExtraConf {
+			conf[k] = v
+		}
+	}
+	conf["resolvConf"] = nodeResolvConf
+	return &conf
+}
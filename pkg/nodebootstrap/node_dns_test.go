@@ -0,0 +1,110 @@
+package nodebootstrap_test
+
+import (
+	"encoding/base64"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+var _ = Describe("Node DNS", func() {
+	const (
+		scriptPath     = "/var/lib/cloud/scripts/eksctl/node-dns.sh"
+		kubeletExtra   = "/etc/eksctl/kubelet-extra.json"
+		nodeResolvConf = "/etc/eksctl/resolv.conf"
+	)
+
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "dns"
+		clusterConfig.Status = &api.ClusterStatus{}
+		ng = &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				AMIFamily:            api.NodeImageFamilyAmazonLinux2,
+				SSH:                  &api.NodeGroupSSH{},
+				PreBootstrapCommands: []string{"echo pre-bootstrap"},
+			},
+		}
+	})
+
+	files := func() map[string]string {
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		files := map[string]string{}
+		for _, f := range decode(userData).WriteFiles {
+			files[f.Path] = f.Content
+		}
+		return files
+	}
+
+	It("does not add the script nor set resolvConf if dns is not set", func() {
+		userData := files()
+		Expect(userData).NotTo(HaveKey(scriptPath))
+		Expect(userData[kubeletExtra]).NotTo(ContainSubstring("resolvConf"))
+	})
+
+	It("writes a resolv.conf with the search domains and options", func() {
+		ng.DNS = &api.NodeGroupDNS{
+			SearchDomains: []string{"corp.example.com", "svc.example.com"},
+			Options:       []string{"timeout:2", "rotate"},
+		}
+		script := files()[scriptPath]
+		Expect(script).To(ContainSubstring("SEARCH_DOMAINS=\"corp.example.com svc.example.com\"\n"))
+		Expect(script).To(ContainSubstring("OPTIONS=\"timeout:2 rotate\"\n"))
+		Expect(script).To(ContainSubstring("RESOLV_CONF=" + nodeResolvConf + "\n"))
+		Expect(script).To(ContainSubstring(`SEARCH_DOMAINS="$(existing search)${SEARCH_DOMAINS}"`))
+		Expect(script).To(ContainSubstring(`printf "%s ", $i`))
+		Expect(script).NotTo(ContainSubstring("kubelet-config.json"))
+	})
+
+	It("runs the script before the pre-bootstrap commands", func() {
+		ng.DNS = &api.NodeGroupDNS{SearchDomains: []string{"corp.example.com"}}
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+
+		cloudCfg := decode(userData)
+		Expect(cloudCfg.Commands[0]).To(Equal([]interface{}{scriptPath}))
+		Expect(cloudCfg.Commands[1]).To(Equal([]interface{}{"/bin/bash", "-c", "echo pre-bootstrap"}))
+	})
+
+	It("points kubelet at the resolv.conf along with the kubelet extra config", func() {
+		ng.DNS = &api.NodeGroupDNS{Options: []string{"ndots:2"}}
+		ng.KubeletExtraConfig = &api.InlineDocument{"maxPods": 20}
+		Expect(files()[kubeletExtra]).To(MatchJSON(`{"maxPods": 20, "resolvConf": "/etc/eksctl/resolv.conf"}`))
+		Expect(*ng.KubeletExtraConfig).NotTo(HaveKey("resolvConf"))
+	})
+
+	It("points kubelet at the resolv.conf on Ubuntu", func() {
+		ng.AMIFamily = api.NodeImageFamilyUbuntu2004
+		ng.DNS = &api.NodeGroupDNS{SearchDomains: []string{"corp.example.com"}}
+		userData := files()
+		Expect(userData).To(HaveKey(scriptPath))
+		Expect(userData[kubeletExtra]).To(MatchJSON(`{"resolvConf": "/etc/eksctl/resolv.conf"}`))
+	})
+
+	It("patches the kubelet config of managed nodegroups before they are bootstrapped", func() {
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "dns"
+		mng.MaxPodsPerNode = 20
+		mng.DNS = &api.NodeGroupDNS{SearchDomains: []string{"corp.example.com"}}
+		api.SetManagedNodeGroupDefaults(mng, clusterConfig.Metadata)
+
+		userData, err := nodebootstrap.NewManagedAL2Bootstrapper(mng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		decoded, err := base64.StdEncoding.DecodeString(userData)
+		Expect(err).NotTo(HaveOccurred())
+		actual := strings.ReplaceAll(string(decoded), "\r\n", "\n")
+		Expect(actual).To(ContainSubstring("SEARCH_DOMAINS=\"corp.example.com\"\n"))
+		Expect(actual).To(ContainSubstring(`echo "$(jq '.resolvConf="/etc/eksctl/resolv.conf"' "${KUBELET_CONFIG}")" > "${KUBELET_CONFIG}"`))
+		Expect(strings.Index(actual, ".maxPods=20")).To(BeNumerically("<", strings.Index(actual, ".resolvConf=")))
+	})
+})
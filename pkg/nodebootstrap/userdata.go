@@ -49,6 +49,9 @@ func NewBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) (Boots
 		}
 		ng.ClusterDNS = clusterDNS
 	}
+	for _, gate := range api.UnknownKubeletFeatureGates(ng.KubeletFeatureGates, clusterConfig.Metadata.Version) {
+		logger.Warning("kubelet feature gate %s of nodegroup %s is not known to exist in Kubernetes %s, the kubelet will fail to start if it does not", gate, ng.Name, clusterConfig.Metadata.Version)
+	}
 	if api.IsWindowsImage(ng.AMIFamily) {
 		return NewWindowsBootstrapper(clusterConfig, ng), nil
 	}
@@ -62,6 +65,8 @@ func NewBootstrapper(clusterConfig *api.ClusterConfig, ng *api.NodeGroup) (Boots
 		return NewUbuntuBootstrapper(clusterConfig, ng), nil
 	case api.NodeImageFamilyBottlerocket:
 		return NewBottlerocketBootstrapper(clusterConfig, ng), nil
+	case api.NodeImageFamilyCustom:
+		return NewCustomBootstrapper(clusterConfig, ng), nil
 	case api.NodeImageFamilyAmazonLinux2:
 		// TODO remove
 		if ng.CustomAMI {
@@ -88,26 +93,74 @@ func NewManagedBootstrapper(clusterConfig *api.ClusterConfig, ng *api.ManagedNod
 	return nil
 }
 
-// GetClusterDNS returns the DNS address to use
+// GetClusterDNS returns the DNS address to use, which is the tenth address of the service IPv4 CIDR,
+// or of the service IPv6 CIDR assigned by EKS for IPv6 clusters.
+// The CIDR reported by the cluster takes precedence over the one in the config
 func GetClusterDNS(clusterConfig *api.ClusterConfig) (string, error) {
-	networkConfig := clusterConfig.Status.KubernetesNetworkConfig
+	if clusterConfig.KubernetesNetworkConfig.IPv6Enabled() {
+		if clusterConfig.Status == nil || clusterConfig.Status.KubernetesNetworkConfig == nil || clusterConfig.Status.KubernetesNetworkConfig.ServiceIPv6CIDR == "" {
+			return "", errors.New("the service IPv6 CIDR of the cluster must be known to derive the cluster DNS address of IPv6 nodes")
+		}
+		return clusterDNSFromServiceIPv6CIDR(clusterConfig.Status.KubernetesNetworkConfig.ServiceIPv6CIDR)
+	}
+
+	var networkConfig *api.KubernetesNetworkConfig
+	if clusterConfig.Status != nil && clusterConfig.Status.KubernetesNetworkConfig != nil {
+		networkConfig = clusterConfig.Status.KubernetesNetworkConfig
+	} else if clusterConfig.KubernetesNetworkConfig != nil && clusterConfig.KubernetesNetworkConfig.ServiceIPv4CIDR != "" {
+		networkConfig = clusterConfig.KubernetesNetworkConfig
+	}
 	if networkConfig == nil {
 		return "", nil
 	}
 
-	ip, _, err := net.ParseCIDR(networkConfig.ServiceIPv4CIDR)
+	_, serviceCIDR, err := net.ParseCIDR(networkConfig.ServiceIPv4CIDR)
 	if err != nil {
 		return "", errors.Wrapf(err, "unexpected error parsing kubernetesNetworkConfig.serviceIPv4CIDR: %q", networkConfig.ServiceIPv4CIDR)
 	}
-	ip = ip.To4()
+	ip := serviceCIDR.IP.To4()
+	if ip == nil {
+		return "", fmt.Errorf("kubernetesNetworkConfig.serviceIPv4CIDR is not an IPv4 CIDR: %q", networkConfig.ServiceIPv4CIDR)
+	}
 	ip[net.IPv4len-1] = 10
 	return ip.String(), nil
 }
 
+func clusterDNSFromServiceIPv6CIDR(serviceIPv6CIDR string) (string, error) {
+	_, serviceCIDR, err := net.ParseCIDR(serviceIPv6CIDR)
+	if err != nil {
+		return "", errors.Wrapf(err, "unexpected error parsing the service IPv6 CIDR of the cluster: %q", serviceIPv6CIDR)
+	}
+	if serviceCIDR.IP.To4() != nil {
+		return "", fmt.Errorf("the service IPv6 CIDR of the cluster is not an IPv6 CIDR: %q", serviceIPv6CIDR)
+	}
+	ip := serviceCIDR.IP.To16()
+	ip[net.IPv6len-1] = 10
+	return ip.String(), nil
+}
+
 func linuxConfig(clusterConfig *api.ClusterConfig, bootScript string, np api.NodePool, scripts ...string) (string, error) {
 	config := cloudconfig.New()
 	ng := np.BaseNodeGroup()
 
+	// the bootstrap of nodes in a warm pool waits for them to be put in service, so this runs first
+	if unmanaged, ok := np.(*api.NodeGroup); ok && unmanaged.WarmPool != nil {
+		config.RunScript(warmPoolScript, makeWarmPoolScript())
+		config.AddFile(makeWarmPoolResumeScript())
+	}
+
+	if ng.LocalNVMe != nil {
+		config.RunScript(localNVMeScript, makeLocalNVMeScript(ng.LocalNVMe))
+	}
+
+	if len(ng.EFSMounts) > 0 {
+		config.RunScript(efsMountsScript, makeEFSMountsScript(ng.EFSMounts))
+	}
+
+	if ng.DNS != nil {
+		config.RunScript(nodeDNSScript, makeNodeDNSScript(ng.DNS))
+	}
+
 	for _, command := range ng.PreBootstrapCommands {
 		config.AddShellCommand(command)
 	}
@@ -123,13 +176,21 @@ func linuxConfig(clusterConfig *api.ClusterConfig, bootScript string, np api.Nod
 		scripts = append(scripts, commonLinuxBootScript, bootScript)
 		var kubeletExtraConf *api.InlineDocument
 		if unmanaged, ok := np.(*api.NodeGroup); ok {
-			kubeletExtraConf = unmanaged.KubeletExtraConfig
+			kubeletExtraConf = utils.WithKubeletFeatureGates(withKubeletCgroupDriver(unmanaged.KubeletExtraConfig, unmanaged.KubeletCgroupDriver), unmanaged.KubeletFeatureGates)
 		}
-		kubeletConf, err := makeKubeletExtraConf(kubeletExtraConf)
+		kubeletConf, err := makeKubeletExtraConf(withNodeDNS(kubeletExtraConf, ng.DNS))
 		if err != nil {
 			return "", err
 		}
 		files = append(files, kubeletConf)
+		// only the bootstrap script of AmazonLinux2 merges the containerd config
+		if unmanaged, ok := np.(*api.NodeGroup); ok && unmanaged.Containerd != nil && ng.AMIFamily == api.NodeImageFamilyAmazonLinux2 {
+			containerdConf, err := makeContainerdConfig(unmanaged.Containerd)
+			if err != nil {
+				return "", err
+			}
+			files = append(files, containerdConf)
+		}
 		envFile := makeBootstrapEnv(clusterConfig, np)
 		files = append(files, envFile)
 	}
@@ -138,7 +199,7 @@ func linuxConfig(clusterConfig *api.ClusterConfig, bootScript string, np api.Nod
 		return "", err
 	}
 
-	body, err := config.Encode()
+	body, err := utils.EncodeUserData(config, ng)
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
 	}
@@ -166,6 +227,30 @@ func makeKubeletExtraConf(kubeletExtraConf *api.InlineDocument) (cloudconfig.Fil
 	}, nil
 }
 
+// withKubeletCgroupDriver returns a copy of kubeletExtraConf setting the cgroup driver, the
+// bootstrap script of the AMI configures the driver matching its container runtime otherwise
+func withKubeletCgroupDriver(kubeletExtraConf *api.InlineDocument, cgroupDriver *string) *api.InlineDocument {
+	if cgroupDriver == nil {
+		return kubeletExtraConf
+	}
+	conf := api.InlineDocument{}
+	if kubeletExtraConf != nil {
+		for k, v := range *kubeletExtraConf {
+			conf[k] = v
+		}
+	}
+	conf["cgroupDriver"] = *cgroupDriver
+	return &conf
+}
+
+// registerWithTaints returns the taints the nodes register with, which include the startup taints of unmanaged nodegroups
+func registerWithTaints(np api.NodePool) []api.NodeGroupTaint {
+	if unmanaged, ok := np.(*api.NodeGroup); ok {
+		return unmanaged.RegisterWithTaints()
+	}
+	return np.NGTaints()
+}
+
 func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudconfig.File {
 	ng := np.BaseNodeGroup()
 	variables := map[string]string{
@@ -173,7 +258,7 @@ func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudco
 		"API_SERVER_URL": clusterConfig.Status.Endpoint,
 		"B64_CLUSTER_CA": base64.StdEncoding.EncodeToString(clusterConfig.Status.CertificateAuthorityData),
 		"NODE_LABELS":    formatLabels(ng.Labels),
-		"NODE_TAINTS":    utils.FormatTaints(np.NGTaints()),
+		"NODE_TAINTS":    utils.FormatTaints(registerWithTaints(np)),
 	}
 
 	if ng.MaxPodsPerNode > 0 {
@@ -184,6 +269,14 @@ func makeBootstrapEnv(clusterConfig *api.ClusterConfig, np api.NodePool) cloudco
 		variables["CLUSTER_DNS"] = unmanaged.ClusterDNS
 	}
 
+	if unmanaged, ok := np.(*api.NodeGroup); ok {
+		variables["CAPACITY_TYPE_LABEL"] = unmanaged.GetCapacityTypeLabel()
+	}
+
+	if clusterConfig.KubernetesNetworkConfig.IPv6Enabled() && clusterConfig.Status != nil && clusterConfig.Status.KubernetesNetworkConfig != nil {
+		variables["SERVICE_IPV6_CIDR"] = clusterConfig.Status.KubernetesNetworkConfig.ServiceIPv6CIDR
+	}
+
 	if unmanaged, ok := np.(*api.NodeGroup); ok && ng.AMIFamily == api.NodeImageFamilyAmazonLinux2 {
 		variables["CONTAINER_RUNTIME"] = unmanaged.GetContainerRuntime()
 	}
@@ -0,0 +1,175 @@
+package nodebootstrap_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
+)
+
+type userDataPart struct {
+	contentType string
+	mergeType   string
+	content     string
+}
+
+func decodeMultipartUserData(userData string, gzipped bool) []userDataPart {
+	data, err := base64.StdEncoding.DecodeString(userData)
+	Expect(err).NotTo(HaveOccurred())
+	if gzipped {
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		Expect(err).NotTo(HaveOccurred())
+		data, err = ioutil.ReadAll(gr)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(msg.Header.Get("MIME-Version")).To(Equal("1.0"))
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(mediaType).To(Equal("multipart/mixed"))
+
+	var parts []userDataPart
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			return parts
+		}
+		Expect(err).NotTo(HaveOccurred())
+		content, err := ioutil.ReadAll(part)
+		Expect(err).NotTo(HaveOccurred())
+		parts = append(parts, userDataPart{
+			contentType: strings.Join(part.Header.Values("Content-Type"), "\n"),
+			mergeType:   part.Header.Get("Merge-Type"),
+			content:     string(content),
+		})
+	}
+}
+
+var _ = Describe("Additional user data parts", func() {
+	additionalParts := []api.UserDataPart{
+		{ContentType: "text/cloud-config", Content: "#cloud-config\nruncmd:\n  - echo hello\n"},
+		{ContentType: "text/x-shellscript; charset=\"us-ascii\"", Content: "#!/bin/bash\necho world\n"},
+	}
+
+	Context("unmanaged nodegroups", func() {
+		var (
+			clusterConfig *api.ClusterConfig
+			ng            *api.NodeGroup
+		)
+
+		BeforeEach(func() {
+			clusterConfig = api.NewClusterConfig()
+			clusterConfig.Metadata.Name = "cluster"
+			clusterConfig.Status = &api.ClusterStatus{}
+			ng = api.NewNodeGroup()
+			ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		})
+
+		It("keeps the cloud config as the user data without additional parts", func() {
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decode(userData).WriteFiles).NotTo(BeEmpty())
+		})
+
+		It("merges the cloud config of eksctl with the additional parts", func() {
+			ng.AdditionalUserDataParts = additionalParts
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			parts := decodeMultipartUserData(userData, true)
+			Expect(parts).To(HaveLen(3))
+			Expect(parts[0].contentType).To(Equal(`text/cloud-config; charset="us-ascii"`))
+			Expect(parts[0].content).To(HavePrefix("#cloud-config\n"))
+			Expect(parts[0].content).To(ContainSubstring("/etc/eksctl/kubelet.env"))
+
+			Expect(parts[1]).To(Equal(userDataPart{
+				contentType: "text/cloud-config",
+				mergeType:   "list(append)+dict(recurse_array,no_replace)+str()",
+				content:     "#cloud-config\nruncmd:\n  - echo hello\n",
+			}))
+			Expect(parts[2]).To(Equal(userDataPart{
+				contentType: "text/x-shellscript; charset=\"us-ascii\"",
+				content:     "#!/bin/bash\necho world\n",
+			}))
+		})
+
+		It("merges the additional parts with the bootstrap of custom AMI families", func() {
+			ng.AMIFamily = api.NodeImageFamilyCustom
+			ng.AMI = "ami-123"
+			overrideBootstrapCommand := "/etc/bootstrap.sh"
+			ng.OverrideBootstrapCommand = &overrideBootstrapCommand
+			ng.AdditionalUserDataParts = additionalParts[1:]
+			userData, err := newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			parts := decodeMultipartUserData(userData, true)
+			Expect(parts).To(HaveLen(2))
+			Expect(parts[0].content).To(ContainSubstring("/etc/bootstrap.sh"))
+			Expect(parts[1].content).To(Equal("#!/bin/bash\necho world\n"))
+		})
+
+		It("fails when the user data exceeds the size limit", func() {
+			content := make([]byte, 30000)
+			_, err := rand.New(rand.NewSource(1)).Read(content)
+			Expect(err).NotTo(HaveOccurred())
+			ng.AdditionalUserDataParts = []api.UserDataPart{
+				{ContentType: "text/x-shellscript", Content: base64.StdEncoding.EncodeToString(content)},
+			}
+			_, err = newBootstrapper(clusterConfig, ng).UserData()
+			Expect(err).To(MatchError(MatchRegexp(`user data is \d+ bytes, which exceeds the limit of 16384 bytes, reduce the size of additionalUserDataParts`)))
+		})
+	})
+
+	Context("managed nodegroups", func() {
+		var ng *api.ManagedNodeGroup
+
+		BeforeEach(func() {
+			ng = api.NewManagedNodeGroup()
+			api.SetManagedNodeGroupDefaults(ng, &api.ClusterMeta{Name: "cluster"})
+		})
+
+		It("adds the additional parts after the scripts of eksctl", func() {
+			ng.PreBootstrapCommands = []string{"echo pre-bootstrap"}
+			ng.AdditionalUserDataParts = additionalParts
+			userData, err := nodebootstrap.NewManagedAL2Bootstrapper(ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+
+			parts := decodeMultipartUserData(userData, false)
+			Expect(parts).To(HaveLen(3))
+			Expect(parts[0].content).To(Equal("echo pre-bootstrap"))
+			Expect(parts[1].contentType).To(Equal("text/cloud-config"))
+			Expect(parts[1].mergeType).NotTo(BeEmpty())
+			Expect(parts[2].content).To(Equal("#!/bin/bash\necho world\n"))
+		})
+
+		It("creates user data from the additional parts alone", func() {
+			ng.AdditionalUserDataParts = additionalParts[1:]
+			userData, err := nodebootstrap.NewManagedAL2Bootstrapper(ng).UserData()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decodeMultipartUserData(userData, false)).To(HaveLen(1))
+		})
+
+		It("fails when the user data exceeds the size limit", func() {
+			ng.AdditionalUserDataParts = []api.UserDataPart{
+				{ContentType: "text/x-shellscript", Content: strings.Repeat("a", api.MaxUserDataSize)},
+			}
+			_, err := nodebootstrap.NewManagedAL2Bootstrapper(ng).UserData()
+			Expect(err).To(MatchError(ContainSubstring("which exceeds the limit of 16384 bytes")))
+		})
+	})
+})
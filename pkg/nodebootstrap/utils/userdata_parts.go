@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"mime/multipart"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+const cloudConfigContentType = "text/cloud-config"
+
+// cloudConfigMergeType makes cloud-init append the lists of additional cloud-config parts, e.g. runcmd, to those
+// of the cloud config of eksctl instead of replacing them, so that the bootstrap of the node still runs
+const cloudConfigMergeType = "list(append)+dict(recurse_array,no_replace)+str()"
+
+// EncodeUserData encodes a cloud config as the user data of a nodegroup. When the nodegroup has
+// additional user data parts, the cloud config is the first part of a multipart document followed by them
+func EncodeUserData(config *cloudconfig.CloudConfig, ng *api.NodeGroupBase) (string, error) {
+	if len(ng.AdditionalUserDataParts) == 0 {
+		return config.Encode()
+	}
+
+	data, err := config.Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprint(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%s\r\n\r\n", mw.Boundary())
+	part, err := mw.CreatePart(map[string][]string{
+		"Content-Type": {cloudConfigContentType + `; charset="us-ascii"`},
+	})
+	if err != nil {
+		return "", err
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", err
+	}
+	if err := WriteUserDataParts(mw, ng.AdditionalUserDataParts); err != nil {
+		return "", err
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	body, err := cloudconfig.EncodeUserData(buf.Bytes())
+	if err != nil {
+		return "", err
+	}
+	decoded, err := base64.StdEncoding.DecodeString(body)
+	if err != nil {
+		return "", err
+	}
+	if err := ValidateUserDataSize(len(decoded)); err != nil {
+		return "", err
+	}
+	return body, nil
+}
+
+// WriteUserDataParts writes the additional user data parts of a nodegroup to a multipart document
+func WriteUserDataParts(mw *multipart.Writer, parts []api.UserDataPart) error {
+	for _, userDataPart := range parts {
+		header := map[string][]string{
+			"Content-Type": {userDataPart.ContentType},
+		}
+		if mediaType, _, err := mime.ParseMediaType(userDataPart.ContentType); err == nil && mediaType == cloudConfigContentType {
+			header["Merge-Type"] = []string{cloudConfigMergeType}
+		}
+		part, err := mw.CreatePart(header)
+		if err != nil {
+			return errors.Wrap(err, "creating user data part")
+		}
+		if _, err := part.Write([]byte(userDataPart.Content)); err != nil {
+			return errors.Wrap(err, "writing user data part")
+		}
+	}
+	return nil
+}
+
+// ValidateUserDataSize checks that user data of the given size in bytes, before it is base64-encoded, fits in the
+// user data of an EC2 instance
+func ValidateUserDataSize(size int) error {
+	if size > api.MaxUserDataSize {
+		return fmt.Errorf("user data is %d bytes, which exceeds the limit of %d bytes, reduce the size of additionalUserDataParts", size, api.MaxUserDataSize)
+	}
+	return nil
+}
@@ -16,3 +16,28 @@ func FormatTaints(taints []api.NodeGroupTaint) string {
 	}
 	return strings.Join(params, ",")
 }
+
+// WithKubeletFeatureGates returns a copy of kubeletExtraConf with featureGates merged into
+// its featureGates
+func WithKubeletFeatureGates(kubeletExtraConf *api.InlineDocument, featureGates map[string]bool) *api.InlineDocument {
+	if len(featureGates) == 0 {
+		return kubeletExtraConf
+	}
+	conf := api.InlineDocument{}
+	gates := map[string]interface{}{}
+	if kubeletExtraConf != nil {
+		for k, v := range *kubeletExtraConf {
+			conf[k] = v
+		}
+		if extraGates, ok := conf["featureGates"].(map[string]interface{}); ok {
+			for gate, enabled := range extraGates {
+				gates[gate] = enabled
+			}
+		}
+	}
+	for gate, enabled := range featureGates {
+		gates[gate] = enabled
+	}
+	conf["featureGates"] = gates
+	return &conf
+}
@@ -0,0 +1,58 @@
+package nodebootstrap
+
+import (
+	"fmt"
+
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+const (
+	warmPoolScript = "warm-pool.sh"
+	// warmPoolMarker exists while a node waits in the warm pool, it is removed once the node is put in service
+	warmPoolMarker = configDir + "warm-pool.wait"
+	// warmPoolResumeScript is run by cloud-init on every boot
+	warmPoolResumeScript = "/var/lib/cloud/scripts/per-boot/eksctl-warm-pool.sh"
+)
+
+// makeWarmPoolScript returns a script that holds the bootstrap of the node until the Auto Scaling group
+// moves it out of the warm pool, so that warmed instances do not join the cluster
+func makeWarmPoolScript() string {
+	return fmt.Sprintf(`#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+MARKER=%s
+
+mkdir -p "$(dirname "${MARKER}")"
+touch "${MARKER}"
+
+target_lifecycle_state() {
+  local token
+  token=$(curl -sf -X PUT "http://169.254.169.254/latest/api/token" -H "X-aws-ec2-metadata-token-ttl-seconds: 60") || return 0
+  curl -sf -H "X-aws-ec2-metadata-token: ${token}" "http://169.254.169.254/latest/meta-data/autoscaling/target-lifecycle-state" || true
+}
+
+until [ "$(target_lifecycle_state)" = "InService" ]; do
+  sleep 5
+done
+
+rm -f "${MARKER}"
+`, warmPoolMarker)
+}
+
+// makeWarmPoolResumeScript returns a script that resumes the bootstrap of nodes that were stopped in the
+// warm pool, as cloud-init only runs the commands of the user data on the first boot of an instance
+func makeWarmPoolResumeScript() cloudconfig.File {
+	return cloudconfig.File{
+		Path: warmPoolResumeScript,
+		Content: fmt.Sprintf(`#!/bin/bash
+
+if [ -f %s ]; then
+  exec /var/lib/cloud/instance/scripts/runcmd
+fi
+`, warmPoolMarker),
+		Permissions: "0755",
+	}
+}
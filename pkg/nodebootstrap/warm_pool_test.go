@@ -0,0 +1,84 @@
+package nodebootstrap_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+var _ = Describe("Warm pools", func() {
+	const (
+		scriptPath       = "/var/lib/cloud/scripts/eksctl/warm-pool.sh"
+		resumeScriptPath = "/var/lib/cloud/scripts/per-boot/eksctl-warm-pool.sh"
+	)
+
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "warm-pool"
+		clusterConfig.Status = &api.ClusterStatus{}
+		ng = &api.NodeGroup{
+			NodeGroupBase: &api.NodeGroupBase{
+				AMIFamily:            api.NodeImageFamilyAmazonLinux2,
+				SSH:                  &api.NodeGroupSSH{},
+				EFSMounts:            []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}},
+				PreBootstrapCommands: []string{"echo pre-bootstrap"},
+			},
+		}
+	})
+
+	userData := func() *cloudconfig.CloudConfig {
+		userData, err := newBootstrapper(clusterConfig, ng).UserData()
+		Expect(err).NotTo(HaveOccurred())
+		return decode(userData)
+	}
+
+	file := func(cloudCfg *cloudconfig.CloudConfig, path string) *cloudconfig.File {
+		for i, f := range cloudCfg.WriteFiles {
+			if f.Path == path {
+				return &cloudCfg.WriteFiles[i]
+			}
+		}
+		return nil
+	}
+
+	It("does not add the scripts if warmPool is not set", func() {
+		cloudCfg := userData()
+		Expect(file(cloudCfg, scriptPath)).To(BeNil())
+		Expect(file(cloudCfg, resumeScriptPath)).To(BeNil())
+	})
+
+	It("waits for the node to be put in service before any other command", func() {
+		ng.WarmPool = &api.WarmPool{PoolState: api.WarmPoolStateStopped}
+		cloudCfg := userData()
+
+		Expect(cloudCfg.Commands[0]).To(Equal([]interface{}{scriptPath}))
+		Expect(cloudCfg.Commands[1]).To(Equal([]interface{}{"/var/lib/cloud/scripts/eksctl/efs-mounts.sh"}))
+		Expect(cloudCfg.Commands[2]).To(Equal([]interface{}{"/bin/bash", "-c", "echo pre-bootstrap"}))
+
+		script := file(cloudCfg, scriptPath)
+		Expect(script).NotTo(BeNil())
+		Expect(script.Content).To(ContainSubstring("MARKER=/etc/eksctl/warm-pool.wait\n"))
+		Expect(script.Content).To(ContainSubstring(`until [ "$(target_lifecycle_state)" = "InService" ]; do`))
+		Expect(script.Content).To(ContainSubstring("meta-data/autoscaling/target-lifecycle-state"))
+	})
+
+	It("resumes the bootstrap of nodes started from the warm pool", func() {
+		ng.WarmPool = &api.WarmPool{}
+		resume := file(userData(), resumeScriptPath)
+		Expect(resume).NotTo(BeNil())
+		Expect(resume.Permissions).To(Equal("0755"))
+		Expect(resume.Content).To(Equal(`#!/bin/bash
+
+if [ -f /etc/eksctl/warm-pool.wait ]; then
+  exec /var/lib/cloud/instance/scripts/runcmd
+fi
+`))
+	})
+})
@@ -82,7 +82,7 @@ func (b *Windows) makeKubeletOptions() string {
 		},
 		{
 			key:   "register-with-taints",
-			value: utils.FormatTaints(b.ng.Taints),
+			value: utils.FormatTaints(b.ng.RegisterWithTaints()),
 		},
 	}
 
@@ -66,6 +66,31 @@ var _ = Describe("Windows", func() {
 `,
 		}),
 
+		Entry("with startup taints", windowsEntry{
+			updateNodeGroup: func(ng *api.NodeGroup) {
+				ng.Taints = []api.NodeGroupTaint{
+					{
+						Key:    "foo",
+						Value:  "bar",
+						Effect: "NoSchedule",
+					},
+				}
+				ng.StartupTaints = []api.NodeGroupTaint{
+					{
+						Key:    "example.com/agent-not-ready",
+						Effect: "NoSchedule",
+					},
+				}
+			},
+
+			expectedUserData: `
+<powershell>
+[string]$EKSBootstrapScriptFile = "$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1"
+& $EKSBootstrapScriptFile -EKSClusterName "windohs" -APIServerEndpoint "https://test.com" -Base64ClusterCA "dGVzdA==" -KubeletExtraArgs "--node-labels= --register-with-taints=foo=bar:NoSchedule,example.com/agent-not-ready=:NoSchedule" 3>&1 4>&1 5>&1 6>&1
+</powershell>
+`,
+		}),
+
 		Entry("with taints", windowsEntry{
 			updateNodeGroup: func(ng *api.NodeGroup) {
 				ng.Taints = []api.NodeGroupTaint{
@@ -9,10 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
@@ -52,6 +54,8 @@ type MockProvider struct {
 
 	region         string
 	cfnRoleARN     string
+	runID          string
+	stackTimeouts  api.StackTimeouts
 	asg            *mocks.AutoScalingAPI
 	cfn            *mocks.CloudFormationAPI
 	eks            *mocks.EKSAPI
@@ -61,6 +65,8 @@ type MockProvider struct {
 	sts            *mocks.STSAPI
 	ssm            *mocks.SSMAPI
 	iam            *mocks.IAMAPI
+	kms            *mocks.KMSAPI
+	efs            *mocks.EFSAPI
 	cloudtrail     *mocks.CloudTrailAPI
 	configProvider *mocks.ConfigProvider
 }
@@ -79,6 +85,8 @@ func NewMockProvider() *MockProvider {
 		sts:            &mocks.STSAPI{},
 		ssm:            &mocks.SSMAPI{},
 		iam:            &mocks.IAMAPI{},
+		kms:            &mocks.KMSAPI{},
+		efs:            &mocks.EFSAPI{},
 		cloudtrail:     &mocks.CloudTrailAPI{},
 		configProvider: &mocks.ConfigProvider{},
 	}
@@ -95,6 +103,20 @@ func (m MockProvider) CloudFormationDisableRollback() bool {
 	return false
 }
 
+// DisableAMICache returns whether resolved AMIs should not be cached, which is always the case
+// so that tests do not share resolved AMIs
+func (m MockProvider) DisableAMICache() bool {
+	return true
+}
+
+// RunID returns the run ID set with SetRunID
+func (m MockProvider) RunID() string { return m.runID }
+
+// SetRunID can be used to set the run ID of the provider
+func (m *MockProvider) SetRunID(runID string) {
+	m.runID = runID
+}
+
 // MockCloudFormation returns a mocked CloudFormation API
 func (m MockProvider) MockCloudFormation() *mocks.CloudFormationAPI {
 	return m.CloudFormation().(*mocks.CloudFormationAPI)
@@ -142,6 +164,18 @@ func (m MockProvider) IAM() iamiface.IAMAPI { return m.iam }
 // MockIAM returns a mocked IAM API
 func (m MockProvider) MockIAM() *mocks.IAMAPI { return m.IAM().(*mocks.IAMAPI) }
 
+// KMS returns a representation of the KMS API
+func (m MockProvider) KMS() kmsiface.KMSAPI { return m.kms }
+
+// MockKMS returns a mocked KMS API
+func (m MockProvider) MockKMS() *mocks.KMSAPI { return m.KMS().(*mocks.KMSAPI) }
+
+// EFS returns a representation of the EFS API
+func (m MockProvider) EFS() efsiface.EFSAPI { return m.efs }
+
+// MockEFS returns a mocked EFS API
+func (m MockProvider) MockEFS() *mocks.EFSAPI { return m.EFS().(*mocks.EFSAPI) }
+
 // CloudTrail returns a representation of the CloudTrail API
 func (m MockProvider) CloudTrail() cloudtrailiface.CloudTrailAPI { return m.cloudtrail }
 
@@ -169,6 +203,14 @@ func (m *MockProvider) SetRegion(r string) {
 // WaitTimeout returns current timeout setting
 func (m MockProvider) WaitTimeout() time.Duration { return ProviderConfig.WaitTimeout }
 
+// StackTimeouts returns the timeouts set with SetStackTimeouts
+func (m MockProvider) StackTimeouts() api.StackTimeouts { return m.stackTimeouts }
+
+// SetStackTimeouts sets the timeouts of the operations on CloudFormation stacks
+func (m *MockProvider) SetStackTimeouts(timeouts api.StackTimeouts) {
+	m.stackTimeouts = timeouts
+}
+
 // ConfigProvider returns a representation of the ConfigProvider
 func (m MockProvider) ConfigProvider() client.ConfigProvider {
 	return m.configProvider
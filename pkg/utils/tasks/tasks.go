@@ -47,6 +47,9 @@ type TaskTree struct {
 	Parallel  bool
 	PlanMode  bool
 	IsSubTask bool
+	// Limit is the maximum number of tasks that are run at the same time in parallel mode,
+	// tasks are not limited when it is zero
+	Limit int
 }
 
 // Append new tasks to the set
@@ -88,6 +91,9 @@ func (t *TaskTree) Describe() string {
 		}
 	default:
 		noun += "s"
+		if t.Parallel && t.Limit > 0 && t.Limit < count {
+			noun += fmt.Sprintf(" (at most %d at a time)", t.Limit)
+		}
 		msg = fmt.Sprintf("%d %s %s: { %s }", count, mode, noun, strings.Join(descriptions, ", "))
 	}
 	if t.PlanMode {
@@ -109,7 +115,7 @@ func (t *TaskTree) Do(allErrs chan error) error {
 	errs := make(chan error)
 
 	if t.Parallel {
-		go doParallelTasks(errs, t.Tasks)
+		go doParallelTasks(errs, t.Tasks, t.Limit)
 	} else {
 		go doSequentialTasks(errs, t.Tasks)
 	}
@@ -135,7 +141,7 @@ func (t *TaskTree) DoAllSync() []error {
 	errs := make(chan error)
 
 	if t.Parallel {
-		go doParallelTasks(errs, t.Tasks)
+		go doParallelTasks(errs, t.Tasks, t.Limit)
 	} else {
 		go doSequentialTasks(errs, t.Tasks)
 	}
@@ -163,15 +169,32 @@ func doSingleTask(allErrs chan error, task Task) bool {
 	return true
 }
 
-func doParallelTasks(allErrs chan error, tasks []Task) {
+func doParallelTasks(allErrs chan error, tasks []Task, limit int) {
+	if limit <= 0 || limit > len(tasks) {
+		limit = len(tasks)
+	}
+	limited := limit < len(tasks)
+	sem := make(chan struct{}, limit)
+	completed := 0
+	mu := &sync.Mutex{}
+
 	wg := &sync.WaitGroup{}
 	wg.Add(len(tasks))
 	for t := range tasks {
 		go func(t int) {
 			defer wg.Done()
-			if ok := doSingleTask(allErrs, tasks[t]); !ok {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			ok := doSingleTask(allErrs, tasks[t])
+			if !ok {
 				logger.Debug("failed task: %s (will continue until other parallel tasks are completed)", tasks[t].Describe())
 			}
+			if limited {
+				mu.Lock()
+				completed++
+				logger.Info("%d of %d parallel tasks finished: %s", completed, len(tasks), tasks[t].Describe())
+				mu.Unlock()
+			}
 		}(t)
 	}
 	logger.Debug("waiting for %d parallel tasks to complete", len(tasks))
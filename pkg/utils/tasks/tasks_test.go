@@ -586,4 +586,78 @@ var _ = Describe("TaskTree", func() {
 			}
 		})
 	})
+
+	Context("With a limit on parallel tasks", func() {
+		var (
+			running, maxRunning int32
+			started             int32
+		)
+
+		newTask := func(info string) Task {
+			return &TaskWithoutParams{
+				Info: info,
+				Call: func(errs chan error) error {
+					atomic.AddInt32(&started, 1)
+					n := atomic.AddInt32(&running, 1)
+					for {
+						max := atomic.LoadInt32(&maxRunning)
+						if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+							break
+						}
+					}
+					go func() {
+						time.Sleep(20 * time.Millisecond)
+						atomic.AddInt32(&running, -1)
+						errs <- nil
+						close(errs)
+					}()
+					return nil
+				},
+			}
+		}
+
+		newTasks := func(limit, count int) *TaskTree {
+			tasks := &TaskTree{Parallel: true, Limit: limit}
+			for i := 0; i < count; i++ {
+				tasks.Append(newTask(fmt.Sprintf("t%d", i)))
+			}
+			return tasks
+		}
+
+		BeforeEach(func() {
+			running, maxRunning, started = 0, 0, 0
+		})
+
+		It("should not run more tasks at the same time than the limit", func() {
+			Expect(newTasks(3, 10).DoAllSync()).To(BeEmpty())
+			Expect(started).To(Equal(int32(10)))
+			Expect(maxRunning).To(Equal(int32(3)))
+		})
+
+		It("should run all tasks at the same time when there is no limit", func() {
+			Expect(newTasks(0, 10).DoAllSync()).To(BeEmpty())
+			Expect(started).To(Equal(int32(10)))
+			Expect(maxRunning).To(Equal(int32(10)))
+		})
+
+		It("should continue running the other tasks when a task fails", func() {
+			tasks := newTasks(2, 4)
+			tasks.Append(&TaskWithoutParams{
+				Info: "failing task",
+				Call: func(errs chan error) error {
+					return fmt.Errorf("failing task always fails")
+				},
+			})
+			errs := tasks.DoAllSync()
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0]).To(MatchError("failing task always fails"))
+			Expect(started).To(Equal(int32(4)))
+			Expect(maxRunning).To(BeNumerically("<=", 2))
+		})
+
+		It("should describe the limit", func() {
+			Expect(newTasks(2, 3).Describe()).To(Equal("3 parallel tasks (at most 2 at a time): { t0, t1, t2 }"))
+			Expect(newTasks(3, 3).Describe()).To(Equal("3 parallel tasks: { t0, t1, t2 }"))
+		})
+	})
 })
@@ -0,0 +1,112 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/aws/aws-sdk-go/service/efs/efsiface"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// efsFileSystem is the part of an EFS file system that nodes mounting it depend on
+type efsFileSystem struct {
+	vpcIDs         sets.String
+	zones          sets.String
+	securityGroups []string
+}
+
+// ValidateEFSMounts checks that the EFS file systems mounted by the nodegroups exist and have a mount target
+// in the VPC of the cluster in each of the availability zones of the nodegroups, and sets the security groups
+// of the mount targets, which the nodegroups allow to receive NFS traffic from their nodes
+func ValidateEFSMounts(efsAPI efsiface.EFSAPI, spec *api.ClusterConfig) error {
+	fileSystems := map[string]*efsFileSystem{}
+	for _, ng := range spec.AllNodeGroups() {
+		if len(ng.EFSMounts) == 0 {
+			continue
+		}
+		if spec.VPC == nil || spec.VPC.ID == "" {
+			return fmt.Errorf("nodegroup %q: EFS file systems can only be mounted by nodegroups of a cluster in an existing VPC, which has their mount targets", ng.Name)
+		}
+		zones := nodeGroupZones(spec, ng)
+		for i, mount := range ng.EFSMounts {
+			fileSystem, ok := fileSystems[mount.FileSystemID]
+			if !ok {
+				var err error
+				if fileSystem, err = describeEFSFileSystem(efsAPI, mount.FileSystemID); err != nil {
+					return errors.Wrapf(err, "nodegroup %q", ng.Name)
+				}
+				fileSystems[mount.FileSystemID] = fileSystem
+			}
+
+			if !fileSystem.vpcIDs.Has(spec.VPC.ID) {
+				return fmt.Errorf("nodegroup %q: EFS file system %q has no mount target in the VPC of the cluster (%s)", ng.Name, mount.FileSystemID, spec.VPC.ID)
+			}
+			var missing []string
+			for _, zone := range zones {
+				if !fileSystem.zones.Has(zone) {
+					missing = append(missing, zone)
+				}
+			}
+			if len(missing) > 0 {
+				return fmt.Errorf("nodegroup %q: EFS file system %q has no mount target in %s, create mount targets in these availability zones or choose other ones for the nodegroup", ng.Name, mount.FileSystemID, strings.Join(missing, ", "))
+			}
+			ng.EFSMounts[i].MountTargetSecurityGroupIDs = fileSystem.securityGroups
+		}
+	}
+	return nil
+}
+
+func describeEFSFileSystem(efsAPI efsiface.EFSAPI, fileSystemID string) (*efsFileSystem, error) {
+	output, err := efsAPI.DescribeFileSystems(&efs.DescribeFileSystemsInput{
+		FileSystemId: aws.String(fileSystemID),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == efs.ErrCodeFileSystemNotFound {
+			return nil, fmt.Errorf("EFS file system %q does not exist", fileSystemID)
+		}
+		return nil, errors.Wrapf(err, "describing EFS file system %q", fileSystemID)
+	}
+	if len(output.FileSystems) == 0 {
+		return nil, fmt.Errorf("EFS file system %q does not exist", fileSystemID)
+	}
+	if state := aws.StringValue(output.FileSystems[0].LifeCycleState); state != efs.LifeCycleStateAvailable {
+		return nil, fmt.Errorf("EFS file system %q is %s, not %s", fileSystemID, state, efs.LifeCycleStateAvailable)
+	}
+
+	fileSystem := &efsFileSystem{vpcIDs: sets.NewString(), zones: sets.NewString()}
+	securityGroups := sets.NewString()
+	input := &efs.DescribeMountTargetsInput{FileSystemId: aws.String(fileSystemID)}
+	for {
+		output, err := efsAPI.DescribeMountTargets(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing mount targets of EFS file system %q", fileSystemID)
+		}
+		for _, mountTarget := range output.MountTargets {
+			if aws.StringValue(mountTarget.LifeCycleState) != efs.LifeCycleStateAvailable {
+				continue
+			}
+			fileSystem.vpcIDs.Insert(aws.StringValue(mountTarget.VpcId))
+			fileSystem.zones.Insert(aws.StringValue(mountTarget.AvailabilityZoneName))
+
+			sgOutput, err := efsAPI.DescribeMountTargetSecurityGroups(&efs.DescribeMountTargetSecurityGroupsInput{
+				MountTargetId: mountTarget.MountTargetId,
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "describing security groups of mount target %q", aws.StringValue(mountTarget.MountTargetId))
+			}
+			securityGroups.Insert(aws.StringValueSlice(sgOutput.SecurityGroups)...)
+		}
+		if output.NextMarker == nil {
+			break
+		}
+		input.Marker = output.NextMarker
+	}
+	fileSystem.securityGroups = securityGroups.List()
+	return fileSystem, nil
+}
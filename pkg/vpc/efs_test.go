@@ -0,0 +1,107 @@
+package vpc
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/efs"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("ValidateEFSMounts", func() {
+	var (
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+		p   *mockprovider.MockProvider
+	)
+
+	mockFileSystem := func(fileSystemID string, mountTargets map[string][]string) {
+		p.MockEFS().On("DescribeFileSystems", &efs.DescribeFileSystemsInput{FileSystemId: aws.String(fileSystemID)}).Return(&efs.DescribeFileSystemsOutput{
+			FileSystems: []*efs.FileSystemDescription{{
+				FileSystemId:   aws.String(fileSystemID),
+				LifeCycleState: aws.String(efs.LifeCycleStateAvailable),
+			}},
+		}, nil)
+
+		var output efs.DescribeMountTargetsOutput
+		for zone, securityGroups := range mountTargets {
+			mountTargetID := "fsmt-" + zone
+			output.MountTargets = append(output.MountTargets, &efs.MountTargetDescription{
+				MountTargetId:        aws.String(mountTargetID),
+				AvailabilityZoneName: aws.String(zone),
+				VpcId:                aws.String("vpc-1"),
+				LifeCycleState:       aws.String(efs.LifeCycleStateAvailable),
+			})
+			p.MockEFS().On("DescribeMountTargetSecurityGroups", &efs.DescribeMountTargetSecurityGroupsInput{MountTargetId: aws.String(mountTargetID)}).Return(&efs.DescribeMountTargetSecurityGroupsOutput{
+				SecurityGroups: aws.StringSlice(securityGroups),
+			}, nil)
+		}
+		p.MockEFS().On("DescribeMountTargets", &efs.DescribeMountTargetsInput{FileSystemId: aws.String(fileSystemID)}).Return(&output, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-east-1a": {ID: "private-1", AZ: "us-east-1a"},
+				"us-east-1b": {ID: "private-2", AZ: "us-east-1b"},
+			}),
+		}
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.PrivateNetworking = true
+		ng.EFSMounts = []api.EFSMount{{FileSystemID: "fs-0123456789abcdef0", MountPath: "/mnt/shared"}}
+		cfg.NodeGroups = []*api.NodeGroup{ng}
+	})
+
+	It("sets the security groups of the mount targets", func() {
+		mockFileSystem("fs-0123456789abcdef0", map[string][]string{
+			"us-east-1a": {"sg-efs-1"},
+			"us-east-1b": {"sg-efs-2", "sg-efs-1"},
+		})
+
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(Succeed())
+		Expect(ng.EFSMounts[0].MountTargetSecurityGroupIDs).To(Equal([]string{"sg-efs-1", "sg-efs-2"}))
+	})
+
+	It("does not describe anything without EFS mounts", func() {
+		ng.EFSMounts = nil
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(Succeed())
+		p.MockEFS().AssertNotCalled(GinkgoT(), "DescribeFileSystems", Anything)
+	})
+
+	It("rejects file systems that do not exist", func() {
+		p.MockEFS().On("DescribeFileSystems", Anything).Return(nil, awserr.New(efs.ErrCodeFileSystemNotFound, "not found", nil))
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(MatchError(`nodegroup "ng-1": EFS file system "fs-0123456789abcdef0" does not exist`))
+	})
+
+	It("rejects file systems without a mount target in an AZ of the nodegroup", func() {
+		mockFileSystem("fs-0123456789abcdef0", map[string][]string{
+			"us-east-1a": {"sg-efs-1"},
+		})
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(MatchError(`nodegroup "ng-1": EFS file system "fs-0123456789abcdef0" has no mount target in us-east-1b, create mount targets in these availability zones or choose other ones for the nodegroup`))
+
+		ng.AvailabilityZones = []string{"us-east-1a"}
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(Succeed())
+	})
+
+	It("rejects file systems in another VPC", func() {
+		mockFileSystem("fs-0123456789abcdef0", map[string][]string{
+			"us-east-1a": {"sg-efs-1"},
+			"us-east-1b": {"sg-efs-1"},
+		})
+		cfg.VPC.ID = "vpc-2"
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(MatchError(`nodegroup "ng-1": EFS file system "fs-0123456789abcdef0" has no mount target in the VPC of the cluster (vpc-2)`))
+	})
+
+	It("requires an existing VPC", func() {
+		cfg.VPC.ID = ""
+		Expect(ValidateEFSMounts(p.EFS(), cfg)).To(MatchError(`nodegroup "ng-1": EFS file systems can only be mounted by nodegroups of a cluster in an existing VPC, which has their mount targets`))
+	})
+})
@@ -0,0 +1,130 @@
+package vpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const defaultRouteIPv4 = "0.0.0.0/0"
+
+// CheckSubnetRoutes checks that the route tables of the subnets of an existing VPC have a default route the nodes
+// can reach the endpoint of the cluster and the AWS APIs through, such as a route to a transit gateway, and returns
+// the gaps found. Subnets that are not associated with a route table use the main route table of the VPC. Fully-private
+// clusters are not checked, as their nodes reach the AWS APIs through VPC endpoints
+func CheckSubnetRoutes(ec2API ec2iface.EC2API, spec *api.ClusterConfig) ([]string, error) {
+	if spec.VPC == nil || spec.VPC.ID == "" || (spec.PrivateCluster != nil && spec.PrivateCluster.Enabled) {
+		return nil, nil
+	}
+
+	type subnet struct {
+		id       string
+		az       string
+		topology api.SubnetTopology
+	}
+	var subnets []subnet
+	addSubnets := func(mapping api.AZSubnetMapping, topology api.SubnetTopology) {
+		for _, s := range mapping {
+			if s.ID != "" {
+				subnets = append(subnets, subnet{id: s.ID, az: s.AZ, topology: topology})
+			}
+		}
+	}
+	if spec.VPC.Subnets != nil {
+		addSubnets(spec.VPC.Subnets.Public, api.SubnetTopologyPublic)
+		addSubnets(spec.VPC.Subnets.Private, api.SubnetTopologyPrivate)
+	}
+	if len(subnets) == 0 {
+		return nil, nil
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i].id < subnets[j].id
+	})
+
+	var (
+		mainRouteTable    *ec2.RouteTable
+		subnetRouteTables = map[string]*ec2.RouteTable{}
+	)
+	err := ec2API.DescribeRouteTablesPages(&ec2.DescribeRouteTablesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("vpc-id"),
+				Values: aws.StringSlice([]string{spec.VPC.ID}),
+			},
+		},
+	}, func(output *ec2.DescribeRouteTablesOutput, _ bool) bool {
+		for _, rt := range output.RouteTables {
+			for _, association := range rt.Associations {
+				if aws.BoolValue(association.Main) {
+					mainRouteTable = rt
+				} else if association.SubnetId != nil {
+					subnetRouteTables[*association.SubnetId] = rt
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "describing route tables")
+	}
+
+	var gaps []string
+	for _, s := range subnets {
+		rt, ok := subnetRouteTables[s.id]
+		if !ok {
+			rt = mainRouteTable
+		}
+		if rt == nil {
+			gaps = append(gaps, fmt.Sprintf("%s subnet %q (%s) is not associated with a route table", strings.ToLower(string(s.topology)), s.id, s.az))
+			continue
+		}
+		if gap := checkDefaultRoute(rt, s.topology); gap != "" {
+			gaps = append(gaps, fmt.Sprintf("%s subnet %q (%s) %s in route table %q", strings.ToLower(string(s.topology)), s.id, s.az, gap, aws.StringValue(rt.RouteTableId)))
+		}
+	}
+	return gaps, nil
+}
+
+// checkDefaultRoute describes what is wrong with the default route of a route table for a subnet of the given
+// topology, or returns an empty string if the nodes can reach destinations outside of the VPC through it
+func checkDefaultRoute(rt *ec2.RouteTable, topology api.SubnetTopology) string {
+	for _, route := range rt.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) != defaultRouteIPv4 {
+			continue
+		}
+		if aws.StringValue(route.State) == ec2.RouteStateBlackhole {
+			return fmt.Sprintf("has a default route (%s) whose target %s no longer exists", defaultRouteIPv4, routeTarget(route))
+		}
+		switch {
+		case route.TransitGatewayId != nil, route.NatGatewayId != nil, route.InstanceId != nil, route.NetworkInterfaceId != nil:
+			return ""
+		case route.GatewayId != nil && strings.HasPrefix(*route.GatewayId, "igw-"):
+			if topology == api.SubnetTopologyPublic {
+				return ""
+			}
+			return fmt.Sprintf("has a default route (%s) to internet gateway %q, which nodes without public IPs cannot use", defaultRouteIPv4, *route.GatewayId)
+		default:
+			return fmt.Sprintf("has a default route (%s) to %s, which is not an internet gateway, a NAT gateway or a transit gateway", defaultRouteIPv4, routeTarget(route))
+		}
+	}
+	return fmt.Sprintf("has no default route (%s) to an internet gateway, a NAT gateway or a transit gateway", defaultRouteIPv4)
+}
+
+func routeTarget(route *ec2.Route) string {
+	for _, target := range []*string{
+		route.TransitGatewayId, route.NatGatewayId, route.GatewayId, route.InstanceId, route.NetworkInterfaceId,
+		route.VpcPeeringConnectionId, route.LocalGatewayId, route.CarrierGatewayId,
+	} {
+		if target != nil {
+			return fmt.Sprintf("%q", *target)
+		}
+	}
+	return "an unknown target"
+}
@@ -0,0 +1,130 @@
+package vpc
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CheckSubnetRoutes", func() {
+	var (
+		cfg         *api.ClusterConfig
+		p           *mockprovider.MockProvider
+		routeTables []*ec2.RouteTable
+	)
+
+	routeTable := func(id string, subnetIDs []string, main bool, routes ...*ec2.Route) *ec2.RouteTable {
+		rt := &ec2.RouteTable{
+			RouteTableId: aws.String(id),
+			Routes: append([]*ec2.Route{{
+				DestinationCidrBlock: aws.String("192.168.0.0/16"),
+				GatewayId:            aws.String("local"),
+				State:                aws.String(ec2.RouteStateActive),
+			}}, routes...),
+		}
+		if main {
+			rt.Associations = append(rt.Associations, &ec2.RouteTableAssociation{Main: aws.Bool(true)})
+		}
+		for _, subnetID := range subnetIDs {
+			rt.Associations = append(rt.Associations, &ec2.RouteTableAssociation{Main: aws.Bool(false), SubnetId: aws.String(subnetID)})
+		}
+		return rt
+	}
+
+	defaultRoute := func(route *ec2.Route) *ec2.Route {
+		route.DestinationCidrBlock = aws.String("0.0.0.0/0")
+		if route.State == nil {
+			route.State = aws.String(ec2.RouteStateActive)
+		}
+		return route
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {ID: "subnet-public-a", AZ: "us-west-2a"},
+			}),
+			Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"us-west-2a": {ID: "subnet-private-a", AZ: "us-west-2a"},
+				"us-west-2b": {ID: "subnet-private-b", AZ: "us-west-2b"},
+			}),
+		}
+		routeTables = nil
+
+		p.MockEC2().On("DescribeRouteTablesPages", &ec2.DescribeRouteTablesInput{
+			Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{"vpc-1"})}},
+		}, Anything).Run(func(args Arguments) {
+			args[1].(func(*ec2.DescribeRouteTablesOutput, bool) bool)(&ec2.DescribeRouteTablesOutput{RouteTables: routeTables}, true)
+		}).Return(nil)
+	})
+
+	It("reports no gaps when every subnet has a default route", func() {
+		routeTables = []*ec2.RouteTable{
+			routeTable("rtb-public", []string{"subnet-public-a"}, false, defaultRoute(&ec2.Route{GatewayId: aws.String("igw-1")})),
+			routeTable("rtb-tgw", []string{"subnet-private-a"}, false, defaultRoute(&ec2.Route{TransitGatewayId: aws.String("tgw-1")})),
+			routeTable("rtb-nat", nil, true, defaultRoute(&ec2.Route{NatGatewayId: aws.String("nat-1")})),
+		}
+		Expect(CheckSubnetRoutes(p.EC2(), cfg)).To(BeEmpty())
+	})
+
+	It("reports the subnets whose route tables have no usable default route", func() {
+		routeTables = []*ec2.RouteTable{
+			routeTable("rtb-public", []string{"subnet-public-a"}, false),
+			routeTable("rtb-igw", []string{"subnet-private-a"}, false, defaultRoute(&ec2.Route{GatewayId: aws.String("igw-1")})),
+			routeTable("rtb-main", nil, true, defaultRoute(&ec2.Route{
+				TransitGatewayId: aws.String("tgw-1"),
+				State:            aws.String(ec2.RouteStateBlackhole),
+			})),
+		}
+		gaps, err := CheckSubnetRoutes(p.EC2(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gaps).To(Equal([]string{
+			`private subnet "subnet-private-a" (us-west-2a) has a default route (0.0.0.0/0) to internet gateway "igw-1", which nodes without public IPs cannot use in route table "rtb-igw"`,
+			`private subnet "subnet-private-b" (us-west-2b) has a default route (0.0.0.0/0) whose target "tgw-1" no longer exists in route table "rtb-main"`,
+			`public subnet "subnet-public-a" (us-west-2a) has no default route (0.0.0.0/0) to an internet gateway, a NAT gateway or a transit gateway in route table "rtb-public"`,
+		}))
+	})
+
+	It("reports default routes to other targets", func() {
+		routeTables = []*ec2.RouteTable{
+			routeTable("rtb-peering", []string{"subnet-public-a", "subnet-private-a", "subnet-private-b"}, false,
+				defaultRoute(&ec2.Route{VpcPeeringConnectionId: aws.String("pcx-1")})),
+		}
+		gaps, err := CheckSubnetRoutes(p.EC2(), cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gaps).To(HaveLen(3))
+		Expect(gaps[0]).To(ContainSubstring(`has a default route (0.0.0.0/0) to "pcx-1", which is not an internet gateway, a NAT gateway or a transit gateway`))
+	})
+
+	It("reports subnets without a route table", func() {
+		cfg.VPC.Subnets.Private = nil
+		Expect(CheckSubnetRoutes(p.EC2(), cfg)).To(Equal([]string{`public subnet "subnet-public-a" (us-west-2a) is not associated with a route table`}))
+	})
+
+	It("does not check VPCs created by eksctl or fully-private clusters", func() {
+		cfg.PrivateCluster = &api.PrivateCluster{Enabled: true}
+		Expect(CheckSubnetRoutes(p.EC2(), cfg)).To(BeEmpty())
+
+		cfg.PrivateCluster = nil
+		cfg.VPC.ID = ""
+		Expect(CheckSubnetRoutes(p.EC2(), cfg)).To(BeEmpty())
+		p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeRouteTablesPages", Anything, Anything)
+	})
+
+	It("returns the error of describing the route tables", func() {
+		p = mockprovider.NewMockProvider()
+		p.MockEC2().On("DescribeRouteTablesPages", Anything, Anything).Return(errors.New("access denied"))
+		_, err := CheckSubnetRoutes(p.EC2(), cfg)
+		Expect(err).To(MatchError("describing route tables: access denied"))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
@@ -101,6 +102,40 @@ func SplitInto16(parent *net.IPNet) ([]*net.IPNet, error) {
 	return subnets, nil
 }
 
+// SplitSecondaryCIDR divides a secondary CIDR of the VPC into the smallest number of equal subnets, a power of two,
+// that leaves one subnet for each of the availability zones, and returns the subnets of the availability zones in order
+func SplitSecondaryCIDR(cidr string, availabilityZones []string) ([]*net.IPNet, error) {
+	_, parent, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, err
+	}
+	if parent.IP.To4() == nil {
+		return nil, fmt.Errorf("secondary CIDR %q is not an IPv4 CIDR", cidr)
+	}
+
+	networkLength, _ := parent.Mask.Size()
+	for n := 1; n < len(availabilityZones); n *= 2 {
+		networkLength++
+	}
+	if networkLength > 28 {
+		return nil, fmt.Errorf("secondary CIDR %q is too small to be divided into %d subnets of at least /28", cidr, len(availabilityZones))
+	}
+
+	var subnets []*net.IPNet
+	for i := range availabilityZones {
+		n := binary.BigEndian.Uint32(parent.IP.To4())
+		n += uint32(i) << uint(32-networkLength)
+		subnetIP := make(net.IP, net.IPv4len)
+		binary.BigEndian.PutUint32(subnetIP, n)
+
+		subnets = append(subnets, &net.IPNet{
+			IP:   subnetIP,
+			Mask: net.CIDRMask(networkLength, 32),
+		})
+	}
+	return subnets, nil
+}
+
 func SplitInto8(parent *net.IPNet) ([]*net.IPNet, error) {
 	networkLength, _ := parent.Mask.Size()
 	networkLength += 3
@@ -377,6 +412,288 @@ func ImportSubnetsFromIDList(ec2API ec2iface.EC2API, spec *api.ClusterConfig, to
 	return importSubnetsFromList(ec2API, spec, topology, subnetIDs, []string{}, []string{})
 }
 
+// ValidateNodeGroupAvailabilityZones checks that the cluster has subnets in every availability zone
+// listed in a nodegroup's availabilityZones, so that they can be resolved to the cluster's subnets
+func ValidateNodeGroupAvailabilityZones(spec *api.ClusterConfig) error {
+	validate := func(ng *api.NodeGroupBase, path string) error {
+		if len(ng.AvailabilityZones) == 0 {
+			return nil
+		}
+
+		typ := "public"
+		if ng.PrivateNetworking {
+			typ = "private"
+		}
+
+		clusterAZs := sets.NewString()
+		if spec.VPC != nil && spec.VPC.Subnets != nil {
+			subnets := spec.VPC.Subnets.Public
+			if ng.PrivateNetworking {
+				subnets = spec.VPC.Subnets.Private
+			}
+			for _, subnet := range subnets {
+				clusterAZs.Insert(subnet.AZ)
+			}
+		}
+		for _, az := range ng.AvailabilityZones {
+			if !clusterAZs.Has(az) {
+				return fmt.Errorf("%s.availabilityZones: the cluster has no %s subnets in availability zone %q, %s subnets exist in: %s",
+					path, typ, az, typ, strings.Join(clusterAZs.List(), ", "))
+			}
+		}
+		return nil
+	}
+
+	for i, ng := range spec.NodeGroups {
+		if err := validate(ng.NodeGroupBase, fmt.Sprintf("nodeGroups[%d]", i)); err != nil {
+			return err
+		}
+	}
+	for i, ng := range spec.ManagedNodeGroups {
+		if err := validate(ng.NodeGroupBase, fmt.Sprintf("managedNodeGroups[%d]", i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidatePodSubnets checks that the pod subnets of VPC CNI custom networking exist,
+// are in the VPC of the cluster and in the availability zone they are listed for
+func ValidatePodSubnets(ec2API ec2iface.EC2API, spec *api.ClusterConfig) error {
+	if spec.VPC == nil || spec.VPC.CustomNetworking == nil {
+		return nil
+	}
+	podSubnets := spec.VPC.CustomNetworking.PodSubnets
+	var azs, subnetIDs []string
+	for az := range podSubnets {
+		azs = append(azs, az)
+	}
+	sort.Strings(azs)
+	for _, az := range azs {
+		subnetIDs = append(subnetIDs, podSubnets[az])
+	}
+
+	subnets, err := describeSubnets(ec2API, spec.VPC.ID, subnetIDs, nil, nil)
+	if err != nil {
+		return errors.Wrap(err, "describing vpc.customNetworking.podSubnets")
+	}
+	subnetsByID := map[string]*ec2.Subnet{}
+	for _, subnet := range subnets {
+		subnetsByID[*subnet.SubnetId] = subnet
+	}
+
+	for _, az := range azs {
+		subnetID := podSubnets[az]
+		subnet, ok := subnetsByID[subnetID]
+		if !ok {
+			return fmt.Errorf("vpc.customNetworking.podSubnets: subnet %q of availability zone %q does not exist", subnetID, az)
+		}
+		if *subnet.VpcId != spec.VPC.ID {
+			return fmt.Errorf("vpc.customNetworking.podSubnets: subnet %q of availability zone %q is in VPC %q, not in the VPC of the cluster %q", subnetID, az, *subnet.VpcId, spec.VPC.ID)
+		}
+		if *subnet.AvailabilityZone != az {
+			return fmt.Errorf("vpc.customNetworking.podSubnets: subnet %q is in availability zone %q, not in %q", subnetID, *subnet.AvailabilityZone, az)
+		}
+	}
+	return nil
+}
+
+// ValidateInstanceTypeOfferings checks that the instance types of every nodegroup are offered in each of the
+// availability zones the nodegroup may launch instances in, and reports all the missing offerings at once
+func ValidateInstanceTypeOfferings(ec2API ec2iface.EC2API, spec *api.ClusterConfig) error {
+	type nodeGroupInstances struct {
+		name          string
+		instanceTypes []string
+		zones         []string
+	}
+
+	var (
+		nodeGroups    []nodeGroupInstances
+		instanceTypes = sets.NewString()
+		zones         = sets.NewString()
+	)
+	addNodeGroup := func(ng *api.NodeGroupBase, types ...string) {
+		ngTypes := sets.NewString()
+		for _, instanceType := range types {
+			if instanceType != "" && instanceType != "mixed" {
+				ngTypes.Insert(instanceType)
+			}
+		}
+		ngZones := nodeGroupZones(spec, ng)
+		if ngTypes.Len() == 0 || len(ngZones) == 0 {
+			return
+		}
+		nodeGroups = append(nodeGroups, nodeGroupInstances{name: ng.Name, instanceTypes: ngTypes.List(), zones: ngZones})
+		instanceTypes.Insert(ngTypes.UnsortedList()...)
+		zones.Insert(ngZones...)
+	}
+	for _, ng := range spec.NodeGroups {
+		types := []string{ng.InstanceType}
+		if ng.InstancesDistribution != nil {
+			types = append(types, ng.InstancesDistribution.InstanceTypes...)
+		}
+		addNodeGroup(ng.NodeGroupBase, types...)
+	}
+	for _, ng := range spec.ManagedNodeGroups {
+		addNodeGroup(ng.NodeGroupBase, append([]string{ng.InstanceType}, ng.InstanceTypes...)...)
+	}
+	if len(nodeGroups) == 0 {
+		return nil
+	}
+
+	offered := sets.NewString()
+	err := ec2API.DescribeInstanceTypeOfferingsPages(&ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("instance-type"),
+				Values: aws.StringSlice(instanceTypes.List()),
+			},
+			{
+				Name:   aws.String("location"),
+				Values: aws.StringSlice(zones.List()),
+			},
+		},
+	}, func(output *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range output.InstanceTypeOfferings {
+			offered.Insert(aws.StringValue(offering.Location) + "/" + aws.StringValue(offering.InstanceType))
+		}
+		return true
+	})
+	if err != nil {
+		return errors.Wrap(err, "describing instance type offerings")
+	}
+
+	var gaps []string
+	for _, ng := range nodeGroups {
+		for _, instanceType := range ng.instanceTypes {
+			var missing []string
+			for _, zone := range ng.zones {
+				if !offered.Has(zone + "/" + instanceType) {
+					missing = append(missing, zone)
+				}
+			}
+			if len(missing) > 0 {
+				gaps = append(gaps, fmt.Sprintf("nodegroup %q: instance type %q is not offered in %s", ng.name, instanceType, strings.Join(missing, ", ")))
+			}
+		}
+	}
+	if len(gaps) > 0 {
+		return fmt.Errorf("instance types are not available in all availability zones of their nodegroups, choose other instance types or availability zones:\n\t%s", strings.Join(gaps, "\n\t"))
+	}
+	return nil
+}
+
+// ValidateEFAPlacementGroups checks that the existing placement groups of EFA-enabled nodegroups are cluster
+// placement groups, as EFA traffic only works between instances in the same cluster placement group
+func ValidateEFAPlacementGroups(ec2API ec2iface.EC2API, spec *api.ClusterConfig) error {
+	for _, ng := range spec.AllNodeGroups() {
+		if !api.IsEnabled(ng.EFAEnabled) || ng.Placement == nil || ng.Placement.GroupName == "" {
+			continue
+		}
+		groupName := ng.Placement.GroupName
+		output, err := ec2API.DescribePlacementGroups(&ec2.DescribePlacementGroupsInput{
+			GroupNames: aws.StringSlice([]string{groupName}),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "nodegroup %q: couldn't retrieve placement group %q", ng.Name, groupName)
+		}
+		if len(output.PlacementGroups) != 1 {
+			return errors.Errorf("nodegroup %q: couldn't find placement group %q", ng.Name, groupName)
+		}
+		if strategy := aws.StringValue(output.PlacementGroups[0].Strategy); strategy != ec2.PlacementStrategyCluster {
+			return errors.Errorf("nodegroup %q: placement group %q of an EFA-enabled nodegroup must use the %q strategy, got %q", ng.Name, groupName, ec2.PlacementStrategyCluster, strategy)
+		}
+	}
+	return nil
+}
+
+// ValidateSecondaryNetworkInterfaces checks that the subnets of the secondary network interfaces of the nodegroups
+// exist and are in the availability zone of their nodegroup, as network interfaces cannot span availability zones
+func ValidateSecondaryNetworkInterfaces(ec2API ec2iface.EC2API, spec *api.ClusterConfig) error {
+	for _, ng := range spec.NodeGroups {
+		if len(ng.SecondaryNetworkInterfaces) == 0 {
+			continue
+		}
+		subnetIDs := sets.NewString()
+		for _, ni := range ng.SecondaryNetworkInterfaces {
+			subnetIDs.Insert(ni.Subnet)
+		}
+		zones := nodeGroupZones(spec, ng.NodeGroupBase)
+		if len(ng.AvailabilityZones) == 0 && len(zones) == 0 && len(ng.Subnets) == 1 {
+			// the subnet of the nodegroup is not part of the VPC spec of the cluster
+			subnetIDs.Insert(ng.Subnets[0])
+		}
+
+		subnets, err := describeSubnets(ec2API, "", subnetIDs.List(), nil, nil)
+		if err != nil {
+			return errors.Wrapf(err, "nodegroup %q: describing the subnets of secondaryNetworkInterfaces", ng.Name)
+		}
+		subnetZones := map[string]string{}
+		for _, subnet := range subnets {
+			subnetZones[aws.StringValue(subnet.SubnetId)] = aws.StringValue(subnet.AvailabilityZone)
+		}
+		if len(zones) == 0 && len(ng.Subnets) == 1 {
+			if zone, ok := subnetZones[ng.Subnets[0]]; ok {
+				zones = []string{zone}
+			}
+		}
+		if len(zones) != 1 {
+			continue
+		}
+
+		for i, ni := range ng.SecondaryNetworkInterfaces {
+			zone, ok := subnetZones[ni.Subnet]
+			if !ok {
+				return fmt.Errorf("nodegroup %q: subnet %q of secondaryNetworkInterfaces[%d] does not exist", ng.Name, ni.Subnet, i)
+			}
+			if zone != zones[0] {
+				return fmt.Errorf("nodegroup %q: subnet %q of secondaryNetworkInterfaces[%d] is in availability zone %q, not in the availability zone %q of the nodegroup", ng.Name, ni.Subnet, i, zone, zones[0])
+			}
+		}
+	}
+	return nil
+}
+
+// nodeGroupZones returns the availability zones a nodegroup may launch instances in, which are the ones of its
+// availabilityZones or subnets, or else the ones the cluster has subnets in for the nodegroup's networking
+func nodeGroupZones(spec *api.ClusterConfig, ng *api.NodeGroupBase) []string {
+	if len(ng.AvailabilityZones) > 0 {
+		return sets.NewString(ng.AvailabilityZones...).List()
+	}
+
+	var subnets, otherSubnets api.AZSubnetMapping
+	if spec.VPC != nil && spec.VPC.Subnets != nil {
+		subnets, otherSubnets = spec.VPC.Subnets.Public, spec.VPC.Subnets.Private
+		if ng.PrivateNetworking {
+			subnets, otherSubnets = otherSubnets, subnets
+		}
+	}
+
+	zones := sets.NewString()
+	if len(ng.Subnets) > 0 {
+		for _, subnet := range ng.Subnets {
+			// subnets that are not part of the cluster's VPC spec have an unknown AZ and are not checked
+			for _, mapping := range []api.AZSubnetMapping{subnets, otherSubnets} {
+				for alias, subnetSpec := range mapping {
+					if subnet == subnetSpec.ID || subnet == alias {
+						zones.Insert(subnetSpec.AZ)
+					}
+				}
+			}
+		}
+		return zones.List()
+	}
+
+	for _, subnet := range subnets {
+		zones.Insert(subnet.AZ)
+	}
+	if zones.Len() == 0 {
+		zones.Insert(spec.AvailabilityZones...)
+	}
+	return zones.List()
+}
+
 func ValidateLegacySubnetsForNodeGroups(spec *api.ClusterConfig, provider api.ClusterProvider) error {
 	subnetsToValidate := sets.NewString()
 
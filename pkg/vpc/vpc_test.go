@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -156,6 +157,34 @@ var _ = Describe("VPC", func() {
 		})
 	})
 
+	Describe("SplitSecondaryCIDR", func() {
+		It("splits the block into a subnet per availability zone", func() {
+			subnets, err := SplitSecondaryCIDR("100.64.0.0/16", []string{"us-west-2a", "us-west-2b", "us-west-2c"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(HaveLen(3))
+			Expect(subnets[0].String()).To(Equal("100.64.0.0/18"))
+			Expect(subnets[1].String()).To(Equal("100.64.64.0/18"))
+			Expect(subnets[2].String()).To(Equal("100.64.128.0/18"))
+		})
+
+		It("uses the whole block for a single availability zone", func() {
+			subnets, err := SplitSecondaryCIDR("100.64.0.0/16", []string{"us-west-2a"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subnets).To(HaveLen(1))
+			Expect(subnets[0].String()).To(Equal("100.64.0.0/16"))
+		})
+
+		It("fails when the subnets would be smaller than /28", func() {
+			_, err := SplitSecondaryCIDR("100.64.0.0/27", []string{"us-west-2a", "us-west-2b", "us-west-2c"})
+			Expect(err).To(MatchError(`secondary CIDR "100.64.0.0/27" is too small to be divided into 3 subnets of at least /28`))
+		})
+
+		It("fails for an IPv6 block", func() {
+			_, err := SplitSecondaryCIDR("2002::1234:abcd:ffff:c0a8:101/64", []string{"us-west-2a"})
+			Expect(err).To(MatchError(ContainSubstring("is not an IPv4 CIDR")))
+		})
+	})
+
 	DescribeTable("Set subnets",
 		func(subnetsCase setSubnetsCase) {
 			err := SetSubnets(subnetsCase.vpc, subnetsCase.availabilityZones)
@@ -960,8 +989,383 @@ var _ = Describe("VPC", func() {
 			}),
 			expectIDs: []string{"id-1", "id-2"},
 		}),
+		Entry("multiple AZs", selectSubnetsCase{
+			nodegroupAZs: []string{"us-east-1a", "us-east-1b"},
+			subnets: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+				"a": {
+					ID: "id-1",
+					AZ: "us-east-1a",
+				},
+				"b": {
+					ID: "id-2",
+					AZ: "us-east-1b",
+				},
+				"c": {
+					ID: "id-3",
+					AZ: "us-east-1c",
+				},
+			}),
+			expectIDs: []string{"id-1", "id-2"},
+		}),
 	)
 
+	It("fails to select subnets for an AZ with no subnets", func() {
+		_, err := SelectNodeGroupSubnets([]string{"us-east-1a", "us-east-1d"}, nil, api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+			"a": {
+				ID: "id-1",
+				AZ: "us-east-1a",
+			},
+			"b": {
+				ID: "id-2",
+				AZ: "us-east-1b",
+			},
+		}), nil, "")
+		Expect(err).To(MatchError(ContainSubstring("mapping doesn't have subnet with AZ us-east-1d")))
+	})
+
+	Describe("ValidateNodeGroupAvailabilityZones", func() {
+		var cfg *api.ClusterConfig
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+					"us-east-1a": {ID: "public-1", AZ: "us-east-1a"},
+					"us-east-1b": {ID: "public-2", AZ: "us-east-1b"},
+				}),
+				Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+					"us-east-1a": {ID: "private-1", AZ: "us-east-1a"},
+				}),
+			}
+		})
+
+		It("accepts nodegroups whose AZs all have cluster subnets", func() {
+			ng := api.NewNodeGroup()
+			ng.AvailabilityZones = []string{"us-east-1a", "us-east-1b"}
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+
+			mng := api.NewManagedNodeGroup()
+			mng.AvailabilityZones = []string{"us-east-1a"}
+			mng.PrivateNetworking = true
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+			Expect(ValidateNodeGroupAvailabilityZones(cfg)).To(Succeed())
+		})
+
+		It("accepts nodegroups without AZs", func() {
+			cfg.NodeGroups = []*api.NodeGroup{api.NewNodeGroup()}
+			Expect(ValidateNodeGroupAvailabilityZones(cfg)).To(Succeed())
+		})
+
+		It("rejects an AZ without public subnets", func() {
+			ng := api.NewNodeGroup()
+			ng.AvailabilityZones = []string{"us-east-1a", "us-east-1c"}
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+
+			err := ValidateNodeGroupAvailabilityZones(cfg)
+			Expect(err).To(MatchError(`nodeGroups[0].availabilityZones: the cluster has no public subnets in availability zone "us-east-1c", public subnets exist in: us-east-1a, us-east-1b`))
+		})
+
+		It("only considers private subnets for private nodegroups", func() {
+			mng := api.NewManagedNodeGroup()
+			mng.AvailabilityZones = []string{"us-east-1b"}
+			mng.PrivateNetworking = true
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+			err := ValidateNodeGroupAvailabilityZones(cfg)
+			Expect(err).To(MatchError(`managedNodeGroups[0].availabilityZones: the cluster has no private subnets in availability zone "us-east-1b", private subnets exist in: us-east-1a`))
+		})
+	})
+
+	Describe("ValidateEFAPlacementGroups", func() {
+		var (
+			cfg *api.ClusterConfig
+			ng  *api.ManagedNodeGroup
+			p   *mockprovider.MockProvider
+		)
+
+		mockPlacementGroup := func(groupName, strategy string) {
+			p.MockEC2().On("DescribePlacementGroups", &ec2.DescribePlacementGroupsInput{
+				GroupNames: aws.StringSlice([]string{groupName}),
+			}).Return(&ec2.DescribePlacementGroupsOutput{
+				PlacementGroups: []*ec2.PlacementGroup{{
+					GroupName: aws.String(groupName),
+					Strategy:  aws.String(strategy),
+				}},
+			}, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg = api.NewClusterConfig()
+			ng = api.NewManagedNodeGroup()
+			ng.Name = "efa"
+			ng.EFAEnabled = aws.Bool(true)
+			ng.Placement = &api.Placement{GroupName: "efa-pg"}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+		})
+
+		It("accepts cluster placement groups", func() {
+			mockPlacementGroup("efa-pg", ec2.PlacementStrategyCluster)
+			Expect(ValidateEFAPlacementGroups(p.EC2(), cfg)).To(Succeed())
+		})
+
+		It("rejects placement groups that are not cluster placement groups", func() {
+			mockPlacementGroup("efa-pg", ec2.PlacementStrategySpread)
+			Expect(ValidateEFAPlacementGroups(p.EC2(), cfg)).To(MatchError(`nodegroup "efa": placement group "efa-pg" of an EFA-enabled nodegroup must use the "cluster" strategy, got "spread"`))
+		})
+
+		It("does not describe the placement groups of nodegroups without EFA", func() {
+			ng.EFAEnabled = nil
+			Expect(ValidateEFAPlacementGroups(p.EC2(), cfg)).To(Succeed())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribePlacementGroups", Anything)
+		})
+	})
+
+	Describe("ValidateSecondaryNetworkInterfaces", func() {
+		var (
+			cfg *api.ClusterConfig
+			ng  *api.NodeGroup
+			p   *mockprovider.MockProvider
+		)
+
+		mockSubnets := func(zones map[string]string) {
+			var ids []string
+			output := &ec2.DescribeSubnetsOutput{}
+			for id := range zones {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			for _, id := range ids {
+				output.Subnets = append(output.Subnets, &ec2.Subnet{SubnetId: aws.String(id), AvailabilityZone: aws.String(zones[id])})
+			}
+			p.MockEC2().On("DescribeSubnets", &ec2.DescribeSubnetsInput{SubnetIds: aws.StringSlice(ids)}).Return(output, nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cfg = api.NewClusterConfig()
+			ng = api.NewNodeGroup()
+			ng.Name = "multi-eni"
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			ng.SecondaryNetworkInterfaces = []api.SecondaryNetworkInterface{{DeviceIndex: 1, Subnet: "subnet-eni"}}
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+		})
+
+		It("accepts subnets in the availability zone of the nodegroup", func() {
+			mockSubnets(map[string]string{"subnet-eni": "us-west-2a"})
+			Expect(ValidateSecondaryNetworkInterfaces(p.EC2(), cfg)).To(Succeed())
+		})
+
+		It("rejects subnets in another availability zone", func() {
+			mockSubnets(map[string]string{"subnet-eni": "us-west-2b"})
+			Expect(ValidateSecondaryNetworkInterfaces(p.EC2(), cfg)).To(MatchError(`nodegroup "multi-eni": subnet "subnet-eni" of secondaryNetworkInterfaces[0] is in availability zone "us-west-2b", not in the availability zone "us-west-2a" of the nodegroup`))
+		})
+
+		It("uses the availability zone of the subnet of the nodegroup", func() {
+			ng.AvailabilityZones = nil
+			ng.Subnets = []string{"subnet-ng"}
+			mockSubnets(map[string]string{"subnet-eni": "us-west-2b", "subnet-ng": "us-west-2a"})
+			Expect(ValidateSecondaryNetworkInterfaces(p.EC2(), cfg)).To(MatchError(ContainSubstring(`not in the availability zone "us-west-2a" of the nodegroup`)))
+		})
+
+		It("rejects subnets that do not exist", func() {
+			p.MockEC2().On("DescribeSubnets", Anything).Return(&ec2.DescribeSubnetsOutput{}, nil)
+			Expect(ValidateSecondaryNetworkInterfaces(p.EC2(), cfg)).To(MatchError(`nodegroup "multi-eni": subnet "subnet-eni" of secondaryNetworkInterfaces[0] does not exist`))
+		})
+	})
+
+	Describe("ValidateInstanceTypeOfferings", func() {
+		var (
+			cfg   *api.ClusterConfig
+			p     *mockprovider.MockProvider
+			input *ec2.DescribeInstanceTypeOfferingsInput
+		)
+
+		mockOfferings := func(offerings map[string][]string) {
+			p.MockEC2().On("DescribeInstanceTypeOfferingsPages", Anything, Anything).Run(func(args Arguments) {
+				input = args[0].(*ec2.DescribeInstanceTypeOfferingsInput)
+				var output ec2.DescribeInstanceTypeOfferingsOutput
+				for zone, instanceTypes := range offerings {
+					for _, instanceType := range instanceTypes {
+						output.InstanceTypeOfferings = append(output.InstanceTypeOfferings, &ec2.InstanceTypeOffering{
+							InstanceType: aws.String(instanceType),
+							Location:     aws.String(zone),
+							LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+						})
+					}
+				}
+				args[1].(func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool)(&output, true)
+			}).Return(nil)
+		}
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			input = nil
+			cfg = api.NewClusterConfig()
+			cfg.AvailabilityZones = []string{"us-east-1a", "us-east-1b", "us-east-1c"}
+			cfg.VPC.Subnets = &api.ClusterSubnets{
+				Public: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+					"us-east-1a": {ID: "public-1", AZ: "us-east-1a"},
+					"us-east-1b": {ID: "public-2", AZ: "us-east-1b"},
+				}),
+				Private: api.AZSubnetMappingFromMap(map[string]api.AZSubnetSpec{
+					"us-east-1a": {ID: "private-1", AZ: "us-east-1a"},
+					"us-east-1c": {ID: "private-3", AZ: "us-east-1c"},
+				}),
+			}
+		})
+
+		It("accepts instance types offered in all the AZs of the nodegroups", func() {
+			mockOfferings(map[string][]string{
+				"us-east-1a": {"m5.large", "c5.large"},
+				"us-east-1b": {"m5.large", "c5.large"},
+				"us-east-1c": {"m5.large"},
+			})
+			ng := api.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.InstanceType = "c5.large"
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+			mng := api.NewManagedNodeGroup()
+			mng.Name = "mng-1"
+			mng.InstanceTypes = []string{"m5.large"}
+			mng.PrivateNetworking = true
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+			Expect(ValidateInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+			Expect(*input.LocationType).To(Equal(ec2.LocationTypeAvailabilityZone))
+			Expect(input.Filters).To(ConsistOf(
+				&ec2.Filter{Name: aws.String("instance-type"), Values: aws.StringSlice([]string{"c5.large", "m5.large"})},
+				&ec2.Filter{Name: aws.String("location"), Values: aws.StringSlice([]string{"us-east-1a", "us-east-1b", "us-east-1c"})},
+			))
+		})
+
+		It("reports each instance type that is missing in an AZ of a nodegroup", func() {
+			mockOfferings(map[string][]string{
+				"us-east-1a": {"m5.large", "p3.2xlarge"},
+				"us-east-1b": {"m5.large"},
+				"us-east-1c": {"m5.large", "p3.2xlarge"},
+			})
+			ng := api.NewNodeGroup()
+			ng.Name = "gpu"
+			ng.InstanceType = "mixed"
+			ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+				InstanceTypes: []string{"m5.large", "p3.2xlarge"},
+			}
+			private := api.NewNodeGroup()
+			private.Name = "private"
+			private.InstanceType = "p3.2xlarge"
+			private.PrivateNetworking = true
+			cfg.NodeGroups = []*api.NodeGroup{ng, private}
+
+			err := ValidateInstanceTypeOfferings(p.EC2(), cfg)
+			Expect(err).To(MatchError("instance types are not available in all availability zones of their nodegroups, choose other instance types or availability zones:\n" +
+				"\tnodegroup \"gpu\": instance type \"p3.2xlarge\" is not offered in us-east-1b"))
+		})
+
+		It("only checks the AZs of the nodegroup's availabilityZones or subnets", func() {
+			mockOfferings(map[string][]string{
+				"us-east-1a": {"m5.large"},
+				"us-east-1b": {"m5.large", "g4dn.xlarge"},
+				"us-east-1c": {"g4dn.xlarge"},
+			})
+			byZone := api.NewManagedNodeGroup()
+			byZone.Name = "by-zone"
+			byZone.InstanceType = "g4dn.xlarge"
+			byZone.AvailabilityZones = []string{"us-east-1b", "us-east-1c"}
+			bySubnet := api.NewManagedNodeGroup()
+			bySubnet.Name = "by-subnet"
+			bySubnet.InstanceType = "m5.large"
+			bySubnet.Subnets = []string{"public-1", "subnet-unknown"}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{byZone, bySubnet}
+
+			Expect(ValidateInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+
+			bySubnet.Subnets = append(bySubnet.Subnets, "private-3")
+			Expect(ValidateInstanceTypeOfferings(p.EC2(), cfg)).To(MatchError(ContainSubstring(`nodegroup "by-subnet": instance type "m5.large" is not offered in us-east-1c`)))
+		})
+
+		It("does not describe offerings without instance types", func() {
+			ng := api.NewNodeGroup()
+			ng.InstanceType = ""
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+			Expect(ValidateInstanceTypeOfferings(p.EC2(), cfg)).To(Succeed())
+			p.MockEC2().AssertNotCalled(GinkgoT(), "DescribeInstanceTypeOfferingsPages", Anything, Anything)
+		})
+
+		It("returns the error of describing the offerings", func() {
+			p.MockEC2().On("DescribeInstanceTypeOfferingsPages", Anything, Anything).Return(errors.New("access denied"))
+			ng := api.NewNodeGroup()
+			ng.InstanceType = "m5.large"
+			cfg.NodeGroups = []*api.NodeGroup{ng}
+			Expect(ValidateInstanceTypeOfferings(p.EC2(), cfg)).To(MatchError("describing instance type offerings: access denied"))
+		})
+	})
+
+	Describe("ValidatePodSubnets", func() {
+		var (
+			cfg     *api.ClusterConfig
+			mockEC2 *mocks.EC2API
+		)
+
+		podSubnet := func(subnetID, vpcID, az string) *ec2.Subnet {
+			return &ec2.Subnet{
+				SubnetId:         aws.String(subnetID),
+				VpcId:            aws.String(vpcID),
+				AvailabilityZone: aws.String(az),
+			}
+		}
+
+		BeforeEach(func() {
+			cfg = api.NewClusterConfig()
+			cfg.VPC.ID = "vpc-1"
+			cfg.VPC.CustomNetworking = &api.CustomNetworking{
+				PodSubnets: map[string]string{
+					"us-west-2b": "subnet-2",
+					"us-west-2a": "subnet-1",
+				},
+			}
+			mockEC2 = &mocks.EC2API{}
+		})
+
+		mockDescribePodSubnets := func(subnets ...*ec2.Subnet) {
+			mockEC2.On("DescribeSubnets", &ec2.DescribeSubnetsInput{
+				SubnetIds: aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+			}).Return(&ec2.DescribeSubnetsOutput{Subnets: subnets}, nil)
+		}
+
+		It("accepts pod subnets in the VPC and availability zones they are listed for", func() {
+			mockDescribePodSubnets(podSubnet("subnet-1", "vpc-1", "us-west-2a"), podSubnet("subnet-2", "vpc-1", "us-west-2b"))
+			Expect(ValidatePodSubnets(mockEC2, cfg)).To(Succeed())
+		})
+
+		It("does nothing without custom networking", func() {
+			cfg.VPC.CustomNetworking = nil
+			Expect(ValidatePodSubnets(mockEC2, cfg)).To(Succeed())
+			mockEC2.AssertNotCalled(GinkgoT(), "DescribeSubnets", Anything)
+		})
+
+		It("rejects pod subnets that do not exist", func() {
+			mockEC2.On("DescribeSubnets", Anything).Return(nil, errors.New("InvalidSubnetID.NotFound"))
+			Expect(ValidatePodSubnets(mockEC2, cfg)).To(MatchError("describing vpc.customNetworking.podSubnets: InvalidSubnetID.NotFound"))
+		})
+
+		It("rejects pod subnets missing from the response", func() {
+			mockDescribePodSubnets(podSubnet("subnet-2", "vpc-1", "us-west-2b"))
+			Expect(ValidatePodSubnets(mockEC2, cfg)).To(MatchError(`vpc.customNetworking.podSubnets: subnet "subnet-1" of availability zone "us-west-2a" does not exist`))
+		})
+
+		It("rejects pod subnets in another VPC", func() {
+			mockDescribePodSubnets(podSubnet("subnet-1", "vpc-1", "us-west-2a"), podSubnet("subnet-2", "vpc-2", "us-west-2b"))
+			Expect(ValidatePodSubnets(mockEC2, cfg)).To(MatchError(`vpc.customNetworking.podSubnets: subnet "subnet-2" of availability zone "us-west-2b" is in VPC "vpc-2", not in the VPC of the cluster "vpc-1"`))
+		})
+
+		It("rejects pod subnets in another availability zone", func() {
+			mockDescribePodSubnets(podSubnet("subnet-1", "vpc-1", "us-west-2c"), podSubnet("subnet-2", "vpc-1", "us-west-2b"))
+			Expect(ValidatePodSubnets(mockEC2, cfg)).To(MatchError(`vpc.customNetworking.podSubnets: subnet "subnet-1" is in availability zone "us-west-2c", not in "us-west-2a"`))
+		})
+	})
+
 	Context("the user provides an optional subnet id", func() {
 		var (
 			subnetID string